@@ -3,6 +3,7 @@ package config
 import (
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/joho/godotenv"
@@ -37,6 +38,29 @@ type KafkaConfig struct {
 	AuditTopic string `yaml:"audit_topic" env:"KAFKA_AUDIT_TOPIC" env-default:"audit.object-changes"`
 }
 
+type TasksConfig struct {
+	// How often open tasks are checked for missed due dates
+	SlaCheckInterval time.Duration `yaml:"sla_check_interval" env:"TASK_SLA_CHECK_INTERVAL" env-default:"1m"`
+	// How often task templates are checked for due scheduled runs
+	SchedulerInterval time.Duration `yaml:"scheduler_interval" env:"TASK_SCHEDULER_INTERVAL" env-default:"30s"`
+	// Largest accepted task attachment, in bytes
+	AttachmentMaxSize int64 `yaml:"attachment_max_size" env:"TASK_ATTACHMENT_MAX_SIZE" env-default:"10485760"`
+}
+
+type BlobStorageConfig struct {
+	// Either "local" or "s3"
+	Backend string `yaml:"backend" env:"BLOB_STORAGE_BACKEND" env-default:"local"`
+	// Directory for the local backend
+	LocalPath string `yaml:"local_path" env:"BLOB_STORAGE_LOCAL_PATH" env-default:"./data/blobs"`
+
+	S3Endpoint        string `yaml:"s3_endpoint" env:"BLOB_STORAGE_S3_ENDPOINT"`
+	S3Region          string `yaml:"s3_region" env:"BLOB_STORAGE_S3_REGION" env-default:"us-east-1"`
+	S3Bucket          string `yaml:"s3_bucket" env:"BLOB_STORAGE_S3_BUCKET"`
+	S3AccessKeyID     string `yaml:"s3_access_key_id" env:"BLOB_STORAGE_S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `yaml:"s3_secret_access_key" env:"BLOB_STORAGE_S3_SECRET_ACCESS_KEY"`
+	S3UsePathStyle    bool   `yaml:"s3_use_path_style" env:"BLOB_STORAGE_S3_USE_PATH_STYLE" env-default:"true"`
+}
+
 // GetBrokersList returns the list of Kafka brokers
 func (k *KafkaConfig) GetBrokersList() []string {
 	return strings.Split(k.Brokers, ",")
@@ -48,6 +72,8 @@ type Config struct {
 	Database    DatabaseConfig    `yaml:"database"`
 	YandexOAuth YandexOAuthConfig `yaml:"yandex_oauth"`
 	Kafka       KafkaConfig       `yaml:"kafka"`
+	Tasks       TasksConfig       `yaml:"tasks"`
+	BlobStorage BlobStorageConfig `yaml:"blob_storage"`
 }
 
 func GetConfigOrDie() *Config {
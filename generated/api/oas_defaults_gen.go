@@ -0,0 +1,139 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// setDefaults set default value of fields.
+func (s *CreateItemVariantRequest) setDefaults() {
+	{
+		val := CreateItemVariantRequestTrackingMode("serialized")
+		s.TrackingMode.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.RequiresSerial.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CreatePickWaveRequest) setDefaults() {
+	{
+		val := int(20)
+		s.MaxTasks.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CreateTaskRequest) setDefaults() {
+	{
+		val := CreateTaskRequestPriority("normal")
+		s.Priority.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CreateTaskTemplateRequest) setDefaults() {
+	{
+		val := CreateTaskTemplateRequestPriority("normal")
+		s.Priority.SetTo(val)
+	}
+	{
+		val := bool(true)
+		s.IsActive.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *ItemVariant) setDefaults() {
+	{
+		val := ItemVariantTrackingMode("serialized")
+		s.TrackingMode.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.RequiresSerial.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *Organization) setDefaults() {
+	{
+		val := AllocationPolicy("fifo")
+		s.AllocationPolicy = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *OrganizationUpdate) setDefaults() {
+	{
+		val := AllocationPolicy("fifo")
+		s.AllocationPolicy.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *ReceiveItemsRequest) setDefaults() {
+	{
+		val := int(1)
+		s.Quantity.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *ReportTaskItemExceptionRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.AutoSubstitute.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *TaskTemplate) setDefaults() {
+	{
+		val := TaskTemplatePriority("normal")
+		s.Priority = val
+	}
+	{
+		val := bool(true)
+		s.IsActive = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *Unit) setDefaults() {
+	{
+		val := UnitPickPathStrategy("serpentine")
+		s.PickPathStrategy = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *UnitBase) setDefaults() {
+	{
+		val := UnitBasePickPathStrategy("serpentine")
+		s.PickPathStrategy.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *UpdateItemVariantRequest) setDefaults() {
+	{
+		val := UpdateItemVariantRequestTrackingMode("serialized")
+		s.TrackingMode.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.RequiresSerial.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *UpdateTaskTemplateRequest) setDefaults() {
+	{
+		val := UpdateTaskTemplateRequestPriority("normal")
+		s.Priority.SetTo(val)
+	}
+	{
+		val := bool(true)
+		s.IsActive.SetTo(val)
+	}
+}
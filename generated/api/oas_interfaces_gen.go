@@ -1,6 +1,34 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type ApplyInventoryCountAdjustmentsRes interface {
+	applyInventoryCountAdjustmentsRes()
+}
+
+type AssignTaskRes interface {
+	assignTaskRes()
+}
+
+type CancelPickWaveRes interface {
+	cancelPickWaveRes()
+}
+
+type CancelTaskRes interface {
+	cancelTaskRes()
+}
+
+type CancelTransferOrderRes interface {
+	cancelTransferOrderRes()
+}
+
+type ClaimTaskRes interface {
+	claimTaskRes()
+}
+
+type CompletePickWaveRes interface {
+	completePickWaveRes()
+}
+
 type CreateApiTokenRes interface {
 	createApiTokenRes()
 }
@@ -29,14 +57,34 @@ type CreateOrganizationRes interface {
 	createOrganizationRes()
 }
 
+type CreatePickWaveRes interface {
+	createPickWaveRes()
+}
+
+type CreateStockLineRes interface {
+	createStockLineRes()
+}
+
 type CreateStorageGroupRes interface {
 	createStorageGroupRes()
 }
 
+type CreateTaskCommentRes interface {
+	createTaskCommentRes()
+}
+
 type CreateTaskRes interface {
 	createTaskRes()
 }
 
+type CreateTaskTemplateRes interface {
+	createTaskTemplateRes()
+}
+
+type CreateTransferOrderRes interface {
+	createTransferOrderRes()
+}
+
 type CreateTvBoardRes interface {
 	createTvBoardRes()
 }
@@ -81,10 +129,26 @@ type DeleteStorageGroupRes interface {
 	deleteStorageGroupRes()
 }
 
+type DeleteTaskAttachmentRes interface {
+	deleteTaskAttachmentRes()
+}
+
+type DeleteTaskCommentRes interface {
+	deleteTaskCommentRes()
+}
+
+type DeleteTaskTemplateRes interface {
+	deleteTaskTemplateRes()
+}
+
 type DeleteTvBoardRes interface {
 	deleteTvBoardRes()
 }
 
+type DownloadTaskAttachmentRes interface {
+	downloadTaskAttachmentRes()
+}
+
 type ExchangeYandexAccessTokenRes interface {
 	exchangeYandexAccessTokenRes()
 }
@@ -125,6 +189,10 @@ type GetEmployeesRes interface {
 	getEmployeesRes()
 }
 
+type GetExpiringInstancesRes interface {
+	getExpiringInstancesRes()
+}
+
 type GetInstanceByIdRes interface {
 	getInstanceByIdRes()
 }
@@ -137,6 +205,10 @@ type GetInstancesRes interface {
 	getInstancesRes()
 }
 
+type GetInventoryCountReportRes interface {
+	getInventoryCountReportRes()
+}
+
 type GetItemByIdRes interface {
 	getItemByIdRes()
 }
@@ -153,6 +225,10 @@ type GetItemsRes interface {
 	getItemsRes()
 }
 
+type GetMyTasksRes interface {
+	getMyTasksRes()
+}
+
 type GetOrganizationByIdRes interface {
 	getOrganizationByIdRes()
 }
@@ -169,10 +245,30 @@ type GetOrganizationsRes interface {
 	getOrganizationsRes()
 }
 
+type GetPickWaveByIdRes interface {
+	getPickWaveByIdRes()
+}
+
+type GetPickWavesRes interface {
+	getPickWavesRes()
+}
+
 type GetRolesRes interface {
 	getRolesRes()
 }
 
+type GetStockLevelsRes interface {
+	getStockLevelsRes()
+}
+
+type GetStockLineByIdRes interface {
+	getStockLineByIdRes()
+}
+
+type GetStockLinesRes interface {
+	getStockLinesRes()
+}
+
 type GetStorageGroupByIdRes interface {
 	getStorageGroupByIdRes()
 }
@@ -181,14 +277,54 @@ type GetStorageGroupsRes interface {
 	getStorageGroupsRes()
 }
 
+type GetTaskAttachmentsRes interface {
+	getTaskAttachmentsRes()
+}
+
 type GetTaskByIdRes interface {
 	getTaskByIdRes()
 }
 
+type GetTaskCommentsRes interface {
+	getTaskCommentsRes()
+}
+
+type GetTaskExceptionsReportRes interface {
+	getTaskExceptionsReportRes()
+}
+
+type GetTaskItemSubstitutesRes interface {
+	getTaskItemSubstitutesRes()
+}
+
+type GetTaskPickupCodeRes interface {
+	getTaskPickupCodeRes()
+}
+
+type GetTaskStatusHistoryRes interface {
+	getTaskStatusHistoryRes()
+}
+
+type GetTaskTemplateByIdRes interface {
+	getTaskTemplateByIdRes()
+}
+
+type GetTaskTemplatesRes interface {
+	getTaskTemplatesRes()
+}
+
 type GetTasksRes interface {
 	getTasksRes()
 }
 
+type GetTransferOrderByIdRes interface {
+	getTransferOrderByIdRes()
+}
+
+type GetTransferOrdersRes interface {
+	getTransferOrdersRes()
+}
+
 type GetTvBoardsDataRes interface {
 	getTvBoardsDataRes()
 }
@@ -197,6 +333,22 @@ type GetTvBoardsRes interface {
 	getTvBoardsRes()
 }
 
+type GetWorkerProfileRes interface {
+	getWorkerProfileRes()
+}
+
+type ImportTasksReq interface {
+	importTasksReq()
+}
+
+type ImportTasksRes interface {
+	importTasksRes()
+}
+
+type InspectReturnedInstanceRes interface {
+	inspectReturnedInstanceRes()
+}
+
 type InviteEmployeeRes interface {
 	inviteEmployeeRes()
 }
@@ -213,6 +365,10 @@ type MarkTaskAsCompletedRes interface {
 	markTaskAsCompletedRes()
 }
 
+type MergeStockLinesRes interface {
+	mergeStockLinesRes()
+}
+
 type PatchEmployeeByIdRes interface {
 	patchEmployeeByIdRes()
 }
@@ -221,10 +377,74 @@ type PickInstanceFromCellRes interface {
 	pickInstanceFromCellRes()
 }
 
+type PickStockLineFromCellRes interface {
+	pickStockLineFromCellRes()
+}
+
+type PickWaveInstanceRes interface {
+	pickWaveInstanceRes()
+}
+
+type PutItemInTargetCellRes interface {
+	putItemInTargetCellRes()
+}
+
+type PutStockLineInTargetCellRes interface {
+	putStockLineInTargetCellRes()
+}
+
+type ReassignTaskRes interface {
+	reassignTaskRes()
+}
+
+type ReceiveItemsRes interface {
+	receiveItemsRes()
+}
+
+type RegenerateTaskPickupCodeRes interface {
+	regenerateTaskPickupCodeRes()
+}
+
+type ReportTaskItemExceptionRes interface {
+	reportTaskItemExceptionRes()
+}
+
+type ResolveBarcodeRes interface {
+	resolveBarcodeRes()
+}
+
 type RevokeApiTokenRes interface {
 	revokeApiTokenRes()
 }
 
+type RunTaskTemplateRes interface {
+	runTaskTemplateRes()
+}
+
+type SetMyShiftRes interface {
+	setMyShiftRes()
+}
+
+type SetWorkerProfileRes interface {
+	setWorkerProfileRes()
+}
+
+type SortWaveInstanceRes interface {
+	sortWaveInstanceRes()
+}
+
+type SplitStockLineRes interface {
+	splitStockLineRes()
+}
+
+type SubmitInventoryCountRes interface {
+	submitInventoryCountRes()
+}
+
+type UnassignTaskRes interface {
+	unassignTaskRes()
+}
+
 type UpdateCellRes interface {
 	updateCellRes()
 }
@@ -256,3 +476,15 @@ type UpdateOrganizationUnitRes interface {
 type UpdateStorageGroupRes interface {
 	updateStorageGroupRes()
 }
+
+type UpdateTaskCommentRes interface {
+	updateTaskCommentRes()
+}
+
+type UpdateTaskTemplateRes interface {
+	updateTaskTemplateRes()
+}
+
+type UploadTaskAttachmentRes interface {
+	uploadTaskAttachmentRes()
+}
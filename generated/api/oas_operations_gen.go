@@ -6,68 +6,125 @@ package api
 type OperationName = string
 
 const (
-	CreateApiTokenOperation            OperationName = "CreateApiToken"
-	CreateCellOperation                OperationName = "CreateCell"
-	CreateCellsGroupOperation          OperationName = "CreateCellsGroup"
-	CreateInstanceForItemOperation     OperationName = "CreateInstanceForItem"
-	CreateItemOperation                OperationName = "CreateItem"
-	CreateItemVariantOperation         OperationName = "CreateItemVariant"
-	CreateOrganizationOperation        OperationName = "CreateOrganization"
-	CreateStorageGroupOperation        OperationName = "CreateStorageGroup"
-	CreateTaskOperation                OperationName = "CreateTask"
-	CreateTvBoardOperation             OperationName = "CreateTvBoard"
-	CreateUnitOperation                OperationName = "CreateUnit"
-	DeleteCellOperation                OperationName = "DeleteCell"
-	DeleteCellsGroupOperation          OperationName = "DeleteCellsGroup"
-	DeleteEmployeeByIdOperation        OperationName = "DeleteEmployeeById"
-	DeleteInstanceByIdOperation        OperationName = "DeleteInstanceById"
-	DeleteItemOperation                OperationName = "DeleteItem"
-	DeleteItemVariantOperation         OperationName = "DeleteItemVariant"
-	DeleteOrganizationOperation        OperationName = "DeleteOrganization"
-	DeleteOrganizationUnitOperation    OperationName = "DeleteOrganizationUnit"
-	DeleteStorageGroupOperation        OperationName = "DeleteStorageGroup"
-	DeleteTvBoardOperation             OperationName = "DeleteTvBoard"
-	ExchangeYandexAccessTokenOperation OperationName = "ExchangeYandexAccessToken"
-	GetApiTokensOperation              OperationName = "GetApiTokens"
-	GetAuditLogsOperation              OperationName = "GetAuditLogs"
-	GetCellByIdOperation               OperationName = "GetCellById"
-	GetCellsOperation                  OperationName = "GetCells"
-	GetCellsGroupByIdOperation         OperationName = "GetCellsGroupById"
-	GetCellsGroupsOperation            OperationName = "GetCellsGroups"
-	GetCurrentUserOperation            OperationName = "GetCurrentUser"
-	GetEmployeeByIdOperation           OperationName = "GetEmployeeById"
-	GetEmployeesOperation              OperationName = "GetEmployees"
-	GetInstanceByIdOperation           OperationName = "GetInstanceById"
-	GetInstancesOperation              OperationName = "GetInstances"
-	GetInstancesByItemIdOperation      OperationName = "GetInstancesByItemId"
-	GetItemByIdOperation               OperationName = "GetItemById"
-	GetItemVariantByIdOperation        OperationName = "GetItemVariantById"
-	GetItemVariantsOperation           OperationName = "GetItemVariants"
-	GetItemsOperation                  OperationName = "GetItems"
-	GetOrganizationByIdOperation       OperationName = "GetOrganizationById"
-	GetOrganizationUnitByIdOperation   OperationName = "GetOrganizationUnitById"
-	GetOrganizationUnitsOperation      OperationName = "GetOrganizationUnits"
-	GetOrganizationsOperation          OperationName = "GetOrganizations"
-	GetRolesOperation                  OperationName = "GetRoles"
-	GetStorageGroupByIdOperation       OperationName = "GetStorageGroupById"
-	GetStorageGroupsOperation          OperationName = "GetStorageGroups"
-	GetTaskByIdOperation               OperationName = "GetTaskById"
-	GetTasksOperation                  OperationName = "GetTasks"
-	GetTvBoardsOperation               OperationName = "GetTvBoards"
-	GetTvBoardsDataOperation           OperationName = "GetTvBoardsData"
-	InviteEmployeeOperation            OperationName = "InviteEmployee"
-	LogoutOperation                    OperationName = "Logout"
-	MarkTaskAsAwaitingOperation        OperationName = "MarkTaskAsAwaiting"
-	MarkTaskAsCompletedOperation       OperationName = "MarkTaskAsCompleted"
-	PatchEmployeeByIdOperation         OperationName = "PatchEmployeeById"
-	PickInstanceFromCellOperation      OperationName = "PickInstanceFromCell"
-	RevokeApiTokenOperation            OperationName = "RevokeApiToken"
-	UpdateCellOperation                OperationName = "UpdateCell"
-	UpdateCellsGroupOperation          OperationName = "UpdateCellsGroup"
-	UpdateInstanceByIdOperation        OperationName = "UpdateInstanceById"
-	UpdateItemOperation                OperationName = "UpdateItem"
-	UpdateItemVariantOperation         OperationName = "UpdateItemVariant"
-	UpdateOrganizationOperation        OperationName = "UpdateOrganization"
-	UpdateOrganizationUnitOperation    OperationName = "UpdateOrganizationUnit"
-	UpdateStorageGroupOperation        OperationName = "UpdateStorageGroup"
+	ApplyInventoryCountAdjustmentsOperation OperationName = "ApplyInventoryCountAdjustments"
+	AssignTaskOperation                     OperationName = "AssignTask"
+	CancelPickWaveOperation                 OperationName = "CancelPickWave"
+	CancelTaskOperation                     OperationName = "CancelTask"
+	CancelTransferOrderOperation            OperationName = "CancelTransferOrder"
+	ClaimTaskOperation                      OperationName = "ClaimTask"
+	CompletePickWaveOperation               OperationName = "CompletePickWave"
+	CreateApiTokenOperation                 OperationName = "CreateApiToken"
+	CreateCellOperation                     OperationName = "CreateCell"
+	CreateCellsGroupOperation               OperationName = "CreateCellsGroup"
+	CreateInstanceForItemOperation          OperationName = "CreateInstanceForItem"
+	CreateItemOperation                     OperationName = "CreateItem"
+	CreateItemVariantOperation              OperationName = "CreateItemVariant"
+	CreateOrganizationOperation             OperationName = "CreateOrganization"
+	CreatePickWaveOperation                 OperationName = "CreatePickWave"
+	CreateStockLineOperation                OperationName = "CreateStockLine"
+	CreateStorageGroupOperation             OperationName = "CreateStorageGroup"
+	CreateTaskOperation                     OperationName = "CreateTask"
+	CreateTaskCommentOperation              OperationName = "CreateTaskComment"
+	CreateTaskTemplateOperation             OperationName = "CreateTaskTemplate"
+	CreateTransferOrderOperation            OperationName = "CreateTransferOrder"
+	CreateTvBoardOperation                  OperationName = "CreateTvBoard"
+	CreateUnitOperation                     OperationName = "CreateUnit"
+	DeleteCellOperation                     OperationName = "DeleteCell"
+	DeleteCellsGroupOperation               OperationName = "DeleteCellsGroup"
+	DeleteEmployeeByIdOperation             OperationName = "DeleteEmployeeById"
+	DeleteInstanceByIdOperation             OperationName = "DeleteInstanceById"
+	DeleteItemOperation                     OperationName = "DeleteItem"
+	DeleteItemVariantOperation              OperationName = "DeleteItemVariant"
+	DeleteOrganizationOperation             OperationName = "DeleteOrganization"
+	DeleteOrganizationUnitOperation         OperationName = "DeleteOrganizationUnit"
+	DeleteStorageGroupOperation             OperationName = "DeleteStorageGroup"
+	DeleteTaskAttachmentOperation           OperationName = "DeleteTaskAttachment"
+	DeleteTaskCommentOperation              OperationName = "DeleteTaskComment"
+	DeleteTaskTemplateOperation             OperationName = "DeleteTaskTemplate"
+	DeleteTvBoardOperation                  OperationName = "DeleteTvBoard"
+	DownloadTaskAttachmentOperation         OperationName = "DownloadTaskAttachment"
+	ExchangeYandexAccessTokenOperation      OperationName = "ExchangeYandexAccessToken"
+	GetApiTokensOperation                   OperationName = "GetApiTokens"
+	GetAuditLogsOperation                   OperationName = "GetAuditLogs"
+	GetCellByIdOperation                    OperationName = "GetCellById"
+	GetCellsOperation                       OperationName = "GetCells"
+	GetCellsGroupByIdOperation              OperationName = "GetCellsGroupById"
+	GetCellsGroupsOperation                 OperationName = "GetCellsGroups"
+	GetCurrentUserOperation                 OperationName = "GetCurrentUser"
+	GetEmployeeByIdOperation                OperationName = "GetEmployeeById"
+	GetEmployeesOperation                   OperationName = "GetEmployees"
+	GetExpiringInstancesOperation           OperationName = "GetExpiringInstances"
+	GetInstanceByIdOperation                OperationName = "GetInstanceById"
+	GetInstancesOperation                   OperationName = "GetInstances"
+	GetInstancesByItemIdOperation           OperationName = "GetInstancesByItemId"
+	GetInventoryCountReportOperation        OperationName = "GetInventoryCountReport"
+	GetItemByIdOperation                    OperationName = "GetItemById"
+	GetItemVariantByIdOperation             OperationName = "GetItemVariantById"
+	GetItemVariantsOperation                OperationName = "GetItemVariants"
+	GetItemsOperation                       OperationName = "GetItems"
+	GetMyTasksOperation                     OperationName = "GetMyTasks"
+	GetOrganizationByIdOperation            OperationName = "GetOrganizationById"
+	GetOrganizationUnitByIdOperation        OperationName = "GetOrganizationUnitById"
+	GetOrganizationUnitsOperation           OperationName = "GetOrganizationUnits"
+	GetOrganizationsOperation               OperationName = "GetOrganizations"
+	GetPickWaveByIdOperation                OperationName = "GetPickWaveById"
+	GetPickWavesOperation                   OperationName = "GetPickWaves"
+	GetRolesOperation                       OperationName = "GetRoles"
+	GetStockLevelsOperation                 OperationName = "GetStockLevels"
+	GetStockLineByIdOperation               OperationName = "GetStockLineById"
+	GetStockLinesOperation                  OperationName = "GetStockLines"
+	GetStorageGroupByIdOperation            OperationName = "GetStorageGroupById"
+	GetStorageGroupsOperation               OperationName = "GetStorageGroups"
+	GetTaskAttachmentsOperation             OperationName = "GetTaskAttachments"
+	GetTaskByIdOperation                    OperationName = "GetTaskById"
+	GetTaskCommentsOperation                OperationName = "GetTaskComments"
+	GetTaskExceptionsReportOperation        OperationName = "GetTaskExceptionsReport"
+	GetTaskItemSubstitutesOperation         OperationName = "GetTaskItemSubstitutes"
+	GetTaskPickupCodeOperation              OperationName = "GetTaskPickupCode"
+	GetTaskStatusHistoryOperation           OperationName = "GetTaskStatusHistory"
+	GetTaskTemplateByIdOperation            OperationName = "GetTaskTemplateById"
+	GetTaskTemplatesOperation               OperationName = "GetTaskTemplates"
+	GetTasksOperation                       OperationName = "GetTasks"
+	GetTransferOrderByIdOperation           OperationName = "GetTransferOrderById"
+	GetTransferOrdersOperation              OperationName = "GetTransferOrders"
+	GetTvBoardsOperation                    OperationName = "GetTvBoards"
+	GetTvBoardsDataOperation                OperationName = "GetTvBoardsData"
+	GetWorkerProfileOperation               OperationName = "GetWorkerProfile"
+	ImportTasksOperation                    OperationName = "ImportTasks"
+	InspectReturnedInstanceOperation        OperationName = "InspectReturnedInstance"
+	InviteEmployeeOperation                 OperationName = "InviteEmployee"
+	LogoutOperation                         OperationName = "Logout"
+	MarkTaskAsAwaitingOperation             OperationName = "MarkTaskAsAwaiting"
+	MarkTaskAsCompletedOperation            OperationName = "MarkTaskAsCompleted"
+	MergeStockLinesOperation                OperationName = "MergeStockLines"
+	PatchEmployeeByIdOperation              OperationName = "PatchEmployeeById"
+	PickInstanceFromCellOperation           OperationName = "PickInstanceFromCell"
+	PickStockLineFromCellOperation          OperationName = "PickStockLineFromCell"
+	PickWaveInstanceOperation               OperationName = "PickWaveInstance"
+	PutItemInTargetCellOperation            OperationName = "PutItemInTargetCell"
+	PutStockLineInTargetCellOperation       OperationName = "PutStockLineInTargetCell"
+	ReassignTaskOperation                   OperationName = "ReassignTask"
+	ReceiveItemsOperation                   OperationName = "ReceiveItems"
+	RegenerateTaskPickupCodeOperation       OperationName = "RegenerateTaskPickupCode"
+	ReportTaskItemExceptionOperation        OperationName = "ReportTaskItemException"
+	ResolveBarcodeOperation                 OperationName = "ResolveBarcode"
+	RevokeApiTokenOperation                 OperationName = "RevokeApiToken"
+	RunTaskTemplateOperation                OperationName = "RunTaskTemplate"
+	SetMyShiftOperation                     OperationName = "SetMyShift"
+	SetWorkerProfileOperation               OperationName = "SetWorkerProfile"
+	SortWaveInstanceOperation               OperationName = "SortWaveInstance"
+	SplitStockLineOperation                 OperationName = "SplitStockLine"
+	SubmitInventoryCountOperation           OperationName = "SubmitInventoryCount"
+	UnassignTaskOperation                   OperationName = "UnassignTask"
+	UpdateCellOperation                     OperationName = "UpdateCell"
+	UpdateCellsGroupOperation               OperationName = "UpdateCellsGroup"
+	UpdateInstanceByIdOperation             OperationName = "UpdateInstanceById"
+	UpdateItemOperation                     OperationName = "UpdateItem"
+	UpdateItemVariantOperation              OperationName = "UpdateItemVariant"
+	UpdateOrganizationOperation             OperationName = "UpdateOrganization"
+	UpdateOrganizationUnitOperation         OperationName = "UpdateOrganizationUnit"
+	UpdateStorageGroupOperation             OperationName = "UpdateStorageGroup"
+	UpdateTaskCommentOperation              OperationName = "UpdateTaskComment"
+	UpdateTaskTemplateOperation             OperationName = "UpdateTaskTemplate"
+	UploadTaskAttachmentOperation           OperationName = "UploadTaskAttachment"
 )
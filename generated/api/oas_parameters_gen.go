@@ -3,8 +3,10 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -16,24 +18,24 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-// CreateCellParams is parameters of createCell operation.
-type CreateCellParams struct {
-	GroupId uuid.UUID
+// ApplyInventoryCountAdjustmentsParams is parameters of applyInventoryCountAdjustments operation.
+type ApplyInventoryCountAdjustmentsParams struct {
+	ID uuid.UUID
 }
 
-func unpackCreateCellParams(packed middleware.Parameters) (params CreateCellParams) {
+func unpackApplyInventoryCountAdjustmentsParams(packed middleware.Parameters) (params ApplyInventoryCountAdjustmentsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "groupId",
+			Name: "id",
 			In:   "path",
 		}
-		params.GroupId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeCreateCellParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateCellParams, _ error) {
-	// Decode path: groupId.
+func decodeApplyInventoryCountAdjustmentsParams(args [1]string, argsEscaped bool, r *http.Request) (params ApplyInventoryCountAdjustmentsParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -45,7 +47,7 @@ func decodeCreateCellParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "groupId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -62,7 +64,7 @@ func decodeCreateCellParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.GroupId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -73,7 +75,7 @@ func decodeCreateCellParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "groupId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -81,25 +83,24 @@ func decodeCreateCellParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// CreateInstanceForItemParams is parameters of createInstanceForItem operation.
-type CreateInstanceForItemParams struct {
-	// Item ID.
-	ItemId uuid.UUID
+// AssignTaskParams is parameters of assignTask operation.
+type AssignTaskParams struct {
+	ID uuid.UUID
 }
 
-func unpackCreateInstanceForItemParams(packed middleware.Parameters) (params CreateInstanceForItemParams) {
+func unpackAssignTaskParams(packed middleware.Parameters) (params AssignTaskParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "itemId",
+			Name: "id",
 			In:   "path",
 		}
-		params.ItemId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeCreateInstanceForItemParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateInstanceForItemParams, _ error) {
-	// Decode path: itemId.
+func decodeAssignTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params AssignTaskParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -111,7 +112,7 @@ func decodeCreateInstanceForItemParams(args [1]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "itemId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -128,7 +129,7 @@ func decodeCreateInstanceForItemParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.ItemId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -139,7 +140,7 @@ func decodeCreateInstanceForItemParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "itemId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -147,13 +148,12 @@ func decodeCreateInstanceForItemParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// CreateItemVariantParams is parameters of createItemVariant operation.
-type CreateItemVariantParams struct {
-	// Item ID.
+// CancelPickWaveParams is parameters of cancelPickWave operation.
+type CancelPickWaveParams struct {
 	ID uuid.UUID
 }
 
-func unpackCreateItemVariantParams(packed middleware.Parameters) (params CreateItemVariantParams) {
+func unpackCancelPickWaveParams(packed middleware.Parameters) (params CancelPickWaveParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -164,7 +164,7 @@ func unpackCreateItemVariantParams(packed middleware.Parameters) (params CreateI
 	return params
 }
 
-func decodeCreateItemVariantParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateItemVariantParams, _ error) {
+func decodeCancelPickWaveParams(args [1]string, argsEscaped bool, r *http.Request) (params CancelPickWaveParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -213,12 +213,12 @@ func decodeCreateItemVariantParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// DeleteCellParams is parameters of deleteCell operation.
-type DeleteCellParams struct {
+// CancelTaskParams is parameters of cancelTask operation.
+type CancelTaskParams struct {
 	ID uuid.UUID
 }
 
-func unpackDeleteCellParams(packed middleware.Parameters) (params DeleteCellParams) {
+func unpackCancelTaskParams(packed middleware.Parameters) (params CancelTaskParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -229,7 +229,7 @@ func unpackDeleteCellParams(packed middleware.Parameters) (params DeleteCellPara
 	return params
 }
 
-func decodeDeleteCellParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteCellParams, _ error) {
+func decodeCancelTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params CancelTaskParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -278,25 +278,24 @@ func decodeDeleteCellParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// DeleteCellsGroupParams is parameters of deleteCellsGroup operation.
-type DeleteCellsGroupParams struct {
-	// Cells Group ID.
-	GroupId uuid.UUID
+// CancelTransferOrderParams is parameters of cancelTransferOrder operation.
+type CancelTransferOrderParams struct {
+	ID uuid.UUID
 }
 
-func unpackDeleteCellsGroupParams(packed middleware.Parameters) (params DeleteCellsGroupParams) {
+func unpackCancelTransferOrderParams(packed middleware.Parameters) (params CancelTransferOrderParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "groupId",
+			Name: "id",
 			In:   "path",
 		}
-		params.GroupId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteCellsGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteCellsGroupParams, _ error) {
-	// Decode path: groupId.
+func decodeCancelTransferOrderParams(args [1]string, argsEscaped bool, r *http.Request) (params CancelTransferOrderParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -308,7 +307,7 @@ func decodeDeleteCellsGroupParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "groupId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -325,7 +324,7 @@ func decodeDeleteCellsGroupParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.GroupId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -336,7 +335,7 @@ func decodeDeleteCellsGroupParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "groupId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -344,12 +343,12 @@ func decodeDeleteCellsGroupParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// DeleteEmployeeByIdParams is parameters of deleteEmployeeById operation.
-type DeleteEmployeeByIdParams struct {
+// ClaimTaskParams is parameters of claimTask operation.
+type ClaimTaskParams struct {
 	ID uuid.UUID
 }
 
-func unpackDeleteEmployeeByIdParams(packed middleware.Parameters) (params DeleteEmployeeByIdParams) {
+func unpackClaimTaskParams(packed middleware.Parameters) (params ClaimTaskParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -360,7 +359,7 @@ func unpackDeleteEmployeeByIdParams(packed middleware.Parameters) (params Delete
 	return params
 }
 
-func decodeDeleteEmployeeByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteEmployeeByIdParams, _ error) {
+func decodeClaimTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params ClaimTaskParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -409,25 +408,24 @@ func decodeDeleteEmployeeByIdParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// DeleteInstanceByIdParams is parameters of deleteInstanceById operation.
-type DeleteInstanceByIdParams struct {
-	// Instance ID.
-	InstanceId uuid.UUID
+// CompletePickWaveParams is parameters of completePickWave operation.
+type CompletePickWaveParams struct {
+	ID uuid.UUID
 }
 
-func unpackDeleteInstanceByIdParams(packed middleware.Parameters) (params DeleteInstanceByIdParams) {
+func unpackCompletePickWaveParams(packed middleware.Parameters) (params CompletePickWaveParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "instanceId",
+			Name: "id",
 			In:   "path",
 		}
-		params.InstanceId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteInstanceByIdParams, _ error) {
-	// Decode path: instanceId.
+func decodeCompletePickWaveParams(args [1]string, argsEscaped bool, r *http.Request) (params CompletePickWaveParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -439,7 +437,7 @@ func decodeDeleteInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "instanceId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -456,7 +454,7 @@ func decodeDeleteInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.InstanceId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -467,7 +465,7 @@ func decodeDeleteInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "instanceId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -475,25 +473,24 @@ func decodeDeleteInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// DeleteItemParams is parameters of deleteItem operation.
-type DeleteItemParams struct {
-	// Item ID.
-	ID uuid.UUID
+// CreateCellParams is parameters of createCell operation.
+type CreateCellParams struct {
+	GroupId uuid.UUID
 }
 
-func unpackDeleteItemParams(packed middleware.Parameters) (params DeleteItemParams) {
+func unpackCreateCellParams(packed middleware.Parameters) (params CreateCellParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "groupId",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.GroupId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteItemParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteItemParams, _ error) {
-	// Decode path: id.
+func decodeCreateCellParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateCellParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -505,7 +502,7 @@ func decodeDeleteItemParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "groupId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -522,7 +519,7 @@ func decodeDeleteItemParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.ID = c
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -533,7 +530,7 @@ func decodeDeleteItemParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "groupId",
 			In:   "path",
 			Err:  err,
 		}
@@ -541,34 +538,25 @@ func decodeDeleteItemParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// DeleteItemVariantParams is parameters of deleteItemVariant operation.
-type DeleteItemVariantParams struct {
+// CreateInstanceForItemParams is parameters of createInstanceForItem operation.
+type CreateInstanceForItemParams struct {
 	// Item ID.
-	ID uuid.UUID
-	// Variant ID.
-	VariantId uuid.UUID
+	ItemId uuid.UUID
 }
 
-func unpackDeleteItemVariantParams(packed middleware.Parameters) (params DeleteItemVariantParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
+func unpackCreateInstanceForItemParams(packed middleware.Parameters) (params CreateInstanceForItemParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "variantId",
+			Name: "itemId",
 			In:   "path",
 		}
-		params.VariantId = packed[key].(uuid.UUID)
+		params.ItemId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteItemVariantParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteItemVariantParams, _ error) {
-	// Decode path: id.
+func decodeCreateInstanceForItemParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateInstanceForItemParams, _ error) {
+	// Decode path: itemId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -580,7 +568,7 @@ func decodeDeleteItemVariantParams(args [2]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "itemId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -597,7 +585,7 @@ func decodeDeleteItemVariantParams(args [2]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.ID = c
+				params.ItemId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -608,16 +596,37 @@ func decodeDeleteItemVariantParams(args [2]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "itemId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: variantId.
+	return params, nil
+}
+
+// CreateItemVariantParams is parameters of createItemVariant operation.
+type CreateItemVariantParams struct {
+	// Item ID.
+	ID uuid.UUID
+}
+
+func unpackCreateItemVariantParams(packed middleware.Parameters) (params CreateItemVariantParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeCreateItemVariantParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateItemVariantParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -625,7 +634,7 @@ func decodeDeleteItemVariantParams(args [2]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "variantId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -642,7 +651,7 @@ func decodeDeleteItemVariantParams(args [2]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.VariantId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -653,7 +662,7 @@ func decodeDeleteItemVariantParams(args [2]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "variantId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -661,13 +670,12 @@ func decodeDeleteItemVariantParams(args [2]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// DeleteOrganizationParams is parameters of deleteOrganization operation.
-type DeleteOrganizationParams struct {
-	// Organization ID.
+// CreateTaskCommentParams is parameters of createTaskComment operation.
+type CreateTaskCommentParams struct {
 	ID uuid.UUID
 }
 
-func unpackDeleteOrganizationParams(packed middleware.Parameters) (params DeleteOrganizationParams) {
+func unpackCreateTaskCommentParams(packed middleware.Parameters) (params CreateTaskCommentParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -678,7 +686,7 @@ func unpackDeleteOrganizationParams(packed middleware.Parameters) (params Delete
 	return params
 }
 
-func decodeDeleteOrganizationParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteOrganizationParams, _ error) {
+func decodeCreateTaskCommentParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateTaskCommentParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -727,13 +735,12 @@ func decodeDeleteOrganizationParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// DeleteOrganizationUnitParams is parameters of deleteOrganizationUnit operation.
-type DeleteOrganizationUnitParams struct {
-	// Unit ID.
+// DeleteCellParams is parameters of deleteCell operation.
+type DeleteCellParams struct {
 	ID uuid.UUID
 }
 
-func unpackDeleteOrganizationUnitParams(packed middleware.Parameters) (params DeleteOrganizationUnitParams) {
+func unpackDeleteCellParams(packed middleware.Parameters) (params DeleteCellParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -744,7 +751,7 @@ func unpackDeleteOrganizationUnitParams(packed middleware.Parameters) (params De
 	return params
 }
 
-func decodeDeleteOrganizationUnitParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteOrganizationUnitParams, _ error) {
+func decodeDeleteCellParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteCellParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -793,25 +800,25 @@ func decodeDeleteOrganizationUnitParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// DeleteStorageGroupParams is parameters of deleteStorageGroup operation.
-type DeleteStorageGroupParams struct {
-	// Storage Group ID.
-	ID uuid.UUID
+// DeleteCellsGroupParams is parameters of deleteCellsGroup operation.
+type DeleteCellsGroupParams struct {
+	// Cells Group ID.
+	GroupId uuid.UUID
 }
 
-func unpackDeleteStorageGroupParams(packed middleware.Parameters) (params DeleteStorageGroupParams) {
+func unpackDeleteCellsGroupParams(packed middleware.Parameters) (params DeleteCellsGroupParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "groupId",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.GroupId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteStorageGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteStorageGroupParams, _ error) {
-	// Decode path: id.
+func decodeDeleteCellsGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteCellsGroupParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -823,7 +830,7 @@ func decodeDeleteStorageGroupParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "groupId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -840,7 +847,7 @@ func decodeDeleteStorageGroupParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.ID = c
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -851,7 +858,7 @@ func decodeDeleteStorageGroupParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "groupId",
 			In:   "path",
 			Err:  err,
 		}
@@ -859,12 +866,12 @@ func decodeDeleteStorageGroupParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// DeleteTvBoardParams is parameters of deleteTvBoard operation.
-type DeleteTvBoardParams struct {
+// DeleteEmployeeByIdParams is parameters of deleteEmployeeById operation.
+type DeleteEmployeeByIdParams struct {
 	ID uuid.UUID
 }
 
-func unpackDeleteTvBoardParams(packed middleware.Parameters) (params DeleteTvBoardParams) {
+func unpackDeleteEmployeeByIdParams(packed middleware.Parameters) (params DeleteEmployeeByIdParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -875,7 +882,7 @@ func unpackDeleteTvBoardParams(packed middleware.Parameters) (params DeleteTvBoa
 	return params
 }
 
-func decodeDeleteTvBoardParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTvBoardParams, _ error) {
+func decodeDeleteEmployeeByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteEmployeeByIdParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -924,41 +931,987 @@ func decodeDeleteTvBoardParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// GetAuditLogsParams is parameters of getAuditLogs operation.
-type GetAuditLogsParams struct {
-	// The type of the object to filter by.
-	ObjectTypeID OptInt
-	// The id of the object to filter by.
-	ObjectID OptUUID
+// DeleteInstanceByIdParams is parameters of deleteInstanceById operation.
+type DeleteInstanceByIdParams struct {
+	// Instance ID.
+	InstanceId uuid.UUID
 }
 
-func unpackGetAuditLogsParams(packed middleware.Parameters) (params GetAuditLogsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "object_type_id",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ObjectTypeID = v.(OptInt)
-		}
-	}
+func unpackDeleteInstanceByIdParams(packed middleware.Parameters) (params DeleteInstanceByIdParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "object_id",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ObjectID = v.(OptUUID)
+			Name: "instanceId",
+			In:   "path",
 		}
+		params.InstanceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetAuditLogsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAuditLogsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: object_type_id.
+func decodeDeleteInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteInstanceByIdParams, _ error) {
+	// Decode path: instanceId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "instanceId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.InstanceId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "instanceId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteItemParams is parameters of deleteItem operation.
+type DeleteItemParams struct {
+	// Item ID.
+	ID uuid.UUID
+}
+
+func unpackDeleteItemParams(packed middleware.Parameters) (params DeleteItemParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteItemParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteItemParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteItemVariantParams is parameters of deleteItemVariant operation.
+type DeleteItemVariantParams struct {
+	// Item ID.
+	ID uuid.UUID
+	// Variant ID.
+	VariantId uuid.UUID
+}
+
+func unpackDeleteItemVariantParams(packed middleware.Parameters) (params DeleteItemVariantParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "variantId",
+			In:   "path",
+		}
+		params.VariantId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteItemVariantParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteItemVariantParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: variantId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "variantId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.VariantId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "variantId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteOrganizationParams is parameters of deleteOrganization operation.
+type DeleteOrganizationParams struct {
+	// Organization ID.
+	ID uuid.UUID
+}
+
+func unpackDeleteOrganizationParams(packed middleware.Parameters) (params DeleteOrganizationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteOrganizationParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteOrganizationParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteOrganizationUnitParams is parameters of deleteOrganizationUnit operation.
+type DeleteOrganizationUnitParams struct {
+	// Unit ID.
+	ID uuid.UUID
+}
+
+func unpackDeleteOrganizationUnitParams(packed middleware.Parameters) (params DeleteOrganizationUnitParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteOrganizationUnitParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteOrganizationUnitParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteStorageGroupParams is parameters of deleteStorageGroup operation.
+type DeleteStorageGroupParams struct {
+	// Storage Group ID.
+	ID uuid.UUID
+}
+
+func unpackDeleteStorageGroupParams(packed middleware.Parameters) (params DeleteStorageGroupParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteStorageGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteStorageGroupParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteTaskAttachmentParams is parameters of deleteTaskAttachment operation.
+type DeleteTaskAttachmentParams struct {
+	ID           uuid.UUID
+	AttachmentId uuid.UUID
+}
+
+func unpackDeleteTaskAttachmentParams(packed middleware.Parameters) (params DeleteTaskAttachmentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "attachmentId",
+			In:   "path",
+		}
+		params.AttachmentId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteTaskAttachmentParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteTaskAttachmentParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: attachmentId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "attachmentId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AttachmentId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "attachmentId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteTaskCommentParams is parameters of deleteTaskComment operation.
+type DeleteTaskCommentParams struct {
+	ID        uuid.UUID
+	CommentId uuid.UUID
+}
+
+func unpackDeleteTaskCommentParams(packed middleware.Parameters) (params DeleteTaskCommentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "commentId",
+			In:   "path",
+		}
+		params.CommentId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteTaskCommentParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteTaskCommentParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: commentId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "commentId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CommentId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "commentId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteTaskTemplateParams is parameters of deleteTaskTemplate operation.
+type DeleteTaskTemplateParams struct {
+	ID uuid.UUID
+}
+
+func unpackDeleteTaskTemplateParams(packed middleware.Parameters) (params DeleteTaskTemplateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteTaskTemplateParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTaskTemplateParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteTvBoardParams is parameters of deleteTvBoard operation.
+type DeleteTvBoardParams struct {
+	ID uuid.UUID
+}
+
+func unpackDeleteTvBoardParams(packed middleware.Parameters) (params DeleteTvBoardParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteTvBoardParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTvBoardParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DownloadTaskAttachmentParams is parameters of downloadTaskAttachment operation.
+type DownloadTaskAttachmentParams struct {
+	ID           uuid.UUID
+	AttachmentId uuid.UUID
+}
+
+func unpackDownloadTaskAttachmentParams(packed middleware.Parameters) (params DownloadTaskAttachmentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "attachmentId",
+			In:   "path",
+		}
+		params.AttachmentId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDownloadTaskAttachmentParams(args [2]string, argsEscaped bool, r *http.Request) (params DownloadTaskAttachmentParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: attachmentId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "attachmentId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AttachmentId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "attachmentId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAuditLogsParams is parameters of getAuditLogs operation.
+type GetAuditLogsParams struct {
+	// The type of the object to filter by.
+	ObjectTypeID OptInt
+	// The id of the object to filter by.
+	ObjectID OptUUID
+	// Returns the history of every instance which carried the serial number, deleted ones included,
+	// instead of filtering by object.
+	SerialNumber OptString
+}
+
+func unpackGetAuditLogsParams(packed middleware.Parameters) (params GetAuditLogsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "object_type_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ObjectTypeID = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "object_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ObjectID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "serial_number",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SerialNumber = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetAuditLogsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAuditLogsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: object_type_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
 			Name:    "object_type_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
@@ -966,65 +1919,4120 @@ func decodeGetAuditLogsParams(args [0]string, argsEscaped bool, r *http.Request)
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotObjectTypeIDVal int
+				var paramsDotObjectTypeIDVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotObjectTypeIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ObjectTypeID.SetTo(paramsDotObjectTypeIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "object_type_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: object_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "object_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotObjectIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotObjectIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ObjectID.SetTo(paramsDotObjectIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "object_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: serial_number.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "serial_number",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSerialNumberVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSerialNumberVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SerialNumber.SetTo(paramsDotSerialNumberVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SerialNumber.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    1,
+							MinLengthSet: true,
+							MaxLength:    255,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "serial_number",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCellByIdParams is parameters of getCellById operation.
+type GetCellByIdParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetCellByIdParams(packed middleware.Parameters) (params GetCellByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetCellByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCellByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCellsParams is parameters of getCells operation.
+type GetCellsParams struct {
+	GroupId uuid.UUID
+}
+
+func unpackGetCellsParams(packed middleware.Parameters) (params GetCellsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetCellsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCellsParams, _ error) {
+	// Decode path: groupId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "groupId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GroupId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "groupId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCellsGroupByIdParams is parameters of getCellsGroupById operation.
+type GetCellsGroupByIdParams struct {
+	// Cells Group ID.
+	GroupId uuid.UUID
+}
+
+func unpackGetCellsGroupByIdParams(packed middleware.Parameters) (params GetCellsGroupByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetCellsGroupByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCellsGroupByIdParams, _ error) {
+	// Decode path: groupId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "groupId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GroupId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "groupId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetEmployeeByIdParams is parameters of getEmployeeById operation.
+type GetEmployeeByIdParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetEmployeeByIdParams(packed middleware.Parameters) (params GetEmployeeByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetEmployeeByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEmployeeByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetExpiringInstancesParams is parameters of getExpiringInstances operation.
+type GetExpiringInstancesParams struct {
+	// Number of days ahead to look for expiring instances.
+	Days OptInt
+	// The id of the unit to filter by.
+	UnitID OptUUID
+}
+
+func unpackGetExpiringInstancesParams(packed middleware.Parameters) (params GetExpiringInstancesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "days",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Days = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "unit_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UnitID = v.(OptUUID)
+		}
+	}
+	return params
+}
+
+func decodeGetExpiringInstancesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetExpiringInstancesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: days.
+	{
+		val := int(30)
+		params.Days.SetTo(val)
+	}
+	// Decode query: days.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "days",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDaysVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDaysVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Days.SetTo(paramsDotDaysVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Days.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           3650,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "days",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: unit_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "unit_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUnitIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUnitIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UnitID.SetTo(paramsDotUnitIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "unit_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetInstanceByIdParams is parameters of getInstanceById operation.
+type GetInstanceByIdParams struct {
+	// Instance ID.
+	InstanceId uuid.UUID
+}
+
+func unpackGetInstanceByIdParams(packed middleware.Parameters) (params GetInstanceByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "instanceId",
+			In:   "path",
+		}
+		params.InstanceId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetInstanceByIdParams, _ error) {
+	// Decode path: instanceId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "instanceId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.InstanceId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "instanceId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetInstancesParams is parameters of getInstances operation.
+type GetInstancesParams struct {
+	// Exact serial number to look up, it can match instances of several variants.
+	SerialNumber OptString
+	// Part of the serial number to search for, case insensitive.
+	Search OptString
+}
+
+func unpackGetInstancesParams(packed middleware.Parameters) (params GetInstancesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "serial_number",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SerialNumber = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Search = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetInstancesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetInstancesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: serial_number.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "serial_number",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSerialNumberVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSerialNumberVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SerialNumber.SetTo(paramsDotSerialNumberVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SerialNumber.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    1,
+							MinLengthSet: true,
+							MaxLength:    255,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "serial_number",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: search.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "search",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Search.SetTo(paramsDotSearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Search.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    1,
+							MinLengthSet: true,
+							MaxLength:    255,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetInstancesByItemIdParams is parameters of getInstancesByItemId operation.
+type GetInstancesByItemIdParams struct {
+	// Item ID.
+	ItemId uuid.UUID
+}
+
+func unpackGetInstancesByItemIdParams(packed middleware.Parameters) (params GetInstancesByItemIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "itemId",
+			In:   "path",
+		}
+		params.ItemId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetInstancesByItemIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetInstancesByItemIdParams, _ error) {
+	// Decode path: itemId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "itemId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ItemId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "itemId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetInventoryCountReportParams is parameters of getInventoryCountReport operation.
+type GetInventoryCountReportParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetInventoryCountReportParams(packed middleware.Parameters) (params GetInventoryCountReportParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetInventoryCountReportParams(args [1]string, argsEscaped bool, r *http.Request) (params GetInventoryCountReportParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetItemByIdParams is parameters of getItemById operation.
+type GetItemByIdParams struct {
+	// Item ID.
+	ID uuid.UUID
+}
+
+func unpackGetItemByIdParams(packed middleware.Parameters) (params GetItemByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetItemByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetItemByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetItemVariantByIdParams is parameters of getItemVariantById operation.
+type GetItemVariantByIdParams struct {
+	// Item ID.
+	ID uuid.UUID
+	// Variant ID.
+	VariantId uuid.UUID
+}
+
+func unpackGetItemVariantByIdParams(packed middleware.Parameters) (params GetItemVariantByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "variantId",
+			In:   "path",
+		}
+		params.VariantId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetItemVariantByIdParams(args [2]string, argsEscaped bool, r *http.Request) (params GetItemVariantByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: variantId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "variantId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.VariantId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "variantId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetItemVariantsParams is parameters of getItemVariants operation.
+type GetItemVariantsParams struct {
+	// Item ID.
+	ID uuid.UUID
+}
+
+func unpackGetItemVariantsParams(packed middleware.Parameters) (params GetItemVariantsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetItemVariantsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetItemVariantsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOrganizationByIdParams is parameters of getOrganizationById operation.
+type GetOrganizationByIdParams struct {
+	// Organization ID.
+	ID uuid.UUID
+}
+
+func unpackGetOrganizationByIdParams(packed middleware.Parameters) (params GetOrganizationByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetOrganizationByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrganizationByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOrganizationUnitByIdParams is parameters of getOrganizationUnitById operation.
+type GetOrganizationUnitByIdParams struct {
+	// Unit ID.
+	ID uuid.UUID
+}
+
+func unpackGetOrganizationUnitByIdParams(packed middleware.Parameters) (params GetOrganizationUnitByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetOrganizationUnitByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrganizationUnitByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetPickWaveByIdParams is parameters of getPickWaveById operation.
+type GetPickWaveByIdParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetPickWaveByIdParams(packed middleware.Parameters) (params GetPickWaveByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetPickWaveByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPickWaveByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetStockLevelsParams is parameters of getStockLevels operation.
+type GetStockLevelsParams struct {
+	// Storage hierarchy level to aggregate stock at.
+	GroupBy OptGetStockLevelsGroupBy
+	// The id of the item to filter by.
+	ItemID OptUUID
+	// The id of the item variant to filter by.
+	VariantID OptUUID
+	// The id of the unit to filter by.
+	UnitID OptUUID
+	// Instance statuses to count, all of them by default.
+	Status []GetStockLevelsStatusItem
+}
+
+func unpackGetStockLevelsParams(packed middleware.Parameters) (params GetStockLevelsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "group_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.GroupBy = v.(OptGetStockLevelsGroupBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "item_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ItemID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "variant_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.VariantID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "unit_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UnitID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.([]GetStockLevelsStatusItem)
+		}
+	}
+	return params
+}
+
+func decodeGetStockLevelsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetStockLevelsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: group_by.
+	{
+		val := GetStockLevelsGroupBy("unit")
+		params.GroupBy.SetTo(val)
+	}
+	// Decode query: group_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "group_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotGroupByVal GetStockLevelsGroupBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotGroupByVal = GetStockLevelsGroupBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.GroupBy.SetTo(paramsDotGroupByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.GroupBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "group_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: item_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "item_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotItemIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotItemIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ItemID.SetTo(paramsDotItemIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "item_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: variant_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "variant_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotVariantIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotVariantIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.VariantID.SetTo(paramsDotVariantIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "variant_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: unit_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "unit_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUnitIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUnitIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UnitID.SetTo(paramsDotUnitIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "unit_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotStatusVal GetStockLevelsStatusItem
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotStatusVal = GetStockLevelsStatusItem(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Status = append(params.Status, paramsDotStatusVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Status {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetStockLineByIdParams is parameters of getStockLineById operation.
+type GetStockLineByIdParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetStockLineByIdParams(packed middleware.Parameters) (params GetStockLineByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetStockLineByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetStockLineByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetStockLinesParams is parameters of getStockLines operation.
+type GetStockLinesParams struct {
+	// The id of the item to filter by.
+	ItemID OptUUID
+	// The id of the item variant to filter by.
+	VariantID OptUUID
+	// The id of the cell to filter by.
+	CellID OptUUID
+	// The stock line status to filter by.
+	Status OptGetStockLinesStatus
+}
+
+func unpackGetStockLinesParams(packed middleware.Parameters) (params GetStockLinesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "item_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ItemID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "variant_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.VariantID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cell_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CellID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptGetStockLinesStatus)
+		}
+	}
+	return params
+}
+
+func decodeGetStockLinesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetStockLinesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: item_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "item_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotItemIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotItemIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ItemID.SetTo(paramsDotItemIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "item_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: variant_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "variant_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotVariantIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotVariantIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.VariantID.SetTo(paramsDotVariantIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "variant_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cell_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cell_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCellIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCellIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CellID.SetTo(paramsDotCellIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cell_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal GetStockLinesStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = GetStockLinesStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetStorageGroupByIdParams is parameters of getStorageGroupById operation.
+type GetStorageGroupByIdParams struct {
+	// Storage Group ID.
+	ID uuid.UUID
+}
+
+func unpackGetStorageGroupByIdParams(packed middleware.Parameters) (params GetStorageGroupByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetStorageGroupByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetStorageGroupByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTaskAttachmentsParams is parameters of getTaskAttachments operation.
+type GetTaskAttachmentsParams struct {
+	// Only files attached to the task item of this instance.
+	InstanceId OptUUID
+	ID         uuid.UUID
+}
+
+func unpackGetTaskAttachmentsParams(packed middleware.Parameters) (params GetTaskAttachmentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "instanceId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.InstanceId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTaskAttachmentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaskAttachmentsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: instanceId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "instanceId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotInstanceIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotInstanceIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.InstanceId.SetTo(paramsDotInstanceIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "instanceId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTaskByIdParams is parameters of getTaskById operation.
+type GetTaskByIdParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetTaskByIdParams(packed middleware.Parameters) (params GetTaskByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTaskByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaskByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTaskCommentsParams is parameters of getTaskComments operation.
+type GetTaskCommentsParams struct {
+	// Only comments left on the task item of this instance.
+	InstanceId OptUUID
+	ID         uuid.UUID
+}
+
+func unpackGetTaskCommentsParams(packed middleware.Parameters) (params GetTaskCommentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "instanceId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.InstanceId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTaskCommentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaskCommentsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: instanceId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "instanceId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotInstanceIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotInstanceIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.InstanceId.SetTo(paramsDotInstanceIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "instanceId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTaskExceptionsReportParams is parameters of getTaskExceptionsReport operation.
+type GetTaskExceptionsReportParams struct {
+	// The id of the unit to filter by.
+	UnitID OptUUID
+	Reason OptGetTaskExceptionsReportReason
+	// Include exceptions reported at or after this time.
+	CreatedFrom OptDateTime
+	// Include exceptions reported before this time.
+	CreatedTo OptDateTime
+	Limit     OptInt
+}
+
+func unpackGetTaskExceptionsReportParams(packed middleware.Parameters) (params GetTaskExceptionsReportParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "unit_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UnitID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "reason",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Reason = v.(OptGetTaskExceptionsReportReason)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "created_from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedFrom = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "created_to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedTo = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetTaskExceptionsReportParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTaskExceptionsReportParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: unit_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "unit_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUnitIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUnitIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UnitID.SetTo(paramsDotUnitIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "unit_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: reason.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "reason",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReasonVal GetTaskExceptionsReportReason
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotReasonVal = GetTaskExceptionsReportReason(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Reason.SetTo(paramsDotReasonVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Reason.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "reason",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: created_from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "created_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatedFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatedFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreatedFrom.SetTo(paramsDotCreatedFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "created_from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: created_to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "created_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatedToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatedToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreatedTo.SetTo(paramsDotCreatedToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "created_to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTaskItemSubstitutesParams is parameters of getTaskItemSubstitutes operation.
+type GetTaskItemSubstitutesParams struct {
+	ID         uuid.UUID
+	InstanceId uuid.UUID
+}
+
+func unpackGetTaskItemSubstitutesParams(packed middleware.Parameters) (params GetTaskItemSubstitutesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "instanceId",
+			In:   "path",
+		}
+		params.InstanceId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTaskItemSubstitutesParams(args [2]string, argsEscaped bool, r *http.Request) (params GetTaskItemSubstitutesParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: instanceId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "instanceId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.InstanceId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "instanceId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTaskPickupCodeParams is parameters of getTaskPickupCode operation.
+type GetTaskPickupCodeParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetTaskPickupCodeParams(packed middleware.Parameters) (params GetTaskPickupCodeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTaskPickupCodeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaskPickupCodeParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTaskStatusHistoryParams is parameters of getTaskStatusHistory operation.
+type GetTaskStatusHistoryParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetTaskStatusHistoryParams(packed middleware.Parameters) (params GetTaskStatusHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTaskStatusHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaskStatusHistoryParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTaskTemplateByIdParams is parameters of getTaskTemplateById operation.
+type GetTaskTemplateByIdParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetTaskTemplateByIdParams(packed middleware.Parameters) (params GetTaskTemplateByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTaskTemplateByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaskTemplateByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTasksParams is parameters of getTasks operation.
+type GetTasksParams struct {
+	// Task statuses to filter by.
+	Status []GetTasksStatusItem
+	// Task type to filter by.
+	Type OptGetTasksType
+	// The id of the unit to filter by.
+	UnitID OptUUID
+	// The id of the assigned user to filter by.
+	AssignedToUserID OptUUID
+	// Include tasks created at or after this time.
+	CreatedFrom OptDateTime
+	// Include tasks created before this time.
+	CreatedTo OptDateTime
+	// Include tasks completed at or after this time.
+	CompletedFrom OptDateTime
+	// Include tasks completed before this time.
+	CompletedTo OptDateTime
+	// Case-insensitive substring of the task name.
+	Search    OptString
+	SortBy    OptGetTasksSortBy
+	SortOrder OptGetTasksSortOrder
+	// Page size.
+	Limit OptInt
+	// Value of nextCursor from the previous page.
+	Cursor OptString
+}
+
+func unpackGetTasksParams(packed middleware.Parameters) (params GetTasksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.([]GetTasksStatusItem)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Type = v.(OptGetTasksType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "unit_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UnitID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "assigned_to_user_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AssignedToUserID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "created_from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedFrom = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "created_to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedTo = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "completed_from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CompletedFrom = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "completed_to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CompletedTo = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Search = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortBy = v.(OptGetTasksSortBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort_order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortOrder = v.(OptGetTasksSortOrder)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetTasksParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTasksParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotStatusVal GetTasksStatusItem
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotStatusVal = GetTasksStatusItem(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Status = append(params.Status, paramsDotStatusVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Status {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: type.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTypeVal GetTasksType
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTypeVal = GetTasksType(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Type.SetTo(paramsDotTypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Type.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "type",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: unit_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "unit_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUnitIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUnitIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UnitID.SetTo(paramsDotUnitIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "unit_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: assigned_to_user_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "assigned_to_user_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAssignedToUserIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAssignedToUserIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AssignedToUserID.SetTo(paramsDotAssignedToUserIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "assigned_to_user_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: created_from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "created_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatedFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatedFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreatedFrom.SetTo(paramsDotCreatedFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "created_from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: created_to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "created_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatedToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatedToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreatedTo.SetTo(paramsDotCreatedToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "created_to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: completed_from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "completed_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCompletedFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCompletedFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CompletedFrom.SetTo(paramsDotCompletedFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "completed_from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: completed_to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "completed_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCompletedToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCompletedToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CompletedTo.SetTo(paramsDotCompletedToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "completed_to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: search.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "search",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Search.SetTo(paramsDotSearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Search.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    255,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort_by.
+	{
+		val := GetTasksSortBy("created_at")
+		params.SortBy.SetTo(val)
+	}
+	// Decode query: sort_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortByVal GetTasksSortBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortByVal = GetTasksSortBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortBy.SetTo(paramsDotSortByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SortBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort_order.
+	{
+		val := GetTasksSortOrder("desc")
+		params.SortOrder.SetTo(val)
+	}
+	// Decode query: sort_order.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort_order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortOrderVal GetTasksSortOrder
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortOrderVal = GetTasksSortOrder(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortOrder.SetTo(paramsDotSortOrderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SortOrder.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort_order",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTransferOrderByIdParams is parameters of getTransferOrderById operation.
+type GetTransferOrderByIdParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetTransferOrderByIdParams(packed middleware.Parameters) (params GetTransferOrderByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTransferOrderByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTransferOrderByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTransferOrdersParams is parameters of getTransferOrders operation.
+type GetTransferOrdersParams struct {
+	Status OptGetTransferOrdersStatus
+}
+
+func unpackGetTransferOrdersParams(packed middleware.Parameters) (params GetTransferOrdersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptGetTransferOrdersStatus)
+		}
+	}
+	return params
+}
+
+func decodeGetTransferOrdersParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTransferOrdersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal GetTransferOrdersStatus
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotObjectTypeIDVal = c
+					paramsDotStatusVal = GetTransferOrdersStatus(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ObjectTypeID.SetTo(paramsDotObjectTypeIDVal)
+				params.Status.SetTo(paramsDotStatusVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "object_type_id",
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTvBoardsDataParams is parameters of getTvBoardsData operation.
+type GetTvBoardsDataParams struct {
+	TvToken string
+}
+
+func unpackGetTvBoardsDataParams(packed middleware.Parameters) (params GetTvBoardsDataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "tvToken",
+			In:   "path",
+		}
+		params.TvToken = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTvBoardsDataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTvBoardsDataParams, _ error) {
+	// Decode path: tvToken.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "tvToken",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TvToken = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "tvToken",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWorkerProfileParams is parameters of getWorkerProfile operation.
+type GetWorkerProfileParams struct {
+	ID uuid.UUID
+}
+
+func unpackGetWorkerProfileParams(packed middleware.Parameters) (params GetWorkerProfileParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetWorkerProfileParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWorkerProfileParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ImportTasksParams is parameters of importTasks operation.
+type ImportTasksParams struct {
+	// Only validate the rows and report the tasks that would be created.
+	DryRun OptBool
+}
+
+func unpackImportTasksParams(packed middleware.Parameters) (params ImportTasksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "dry_run",
 			In:   "query",
-			Err:  err,
+		}
+		if v, ok := packed[key]; ok {
+			params.DryRun = v.(OptBool)
 		}
 	}
-	// Decode query: object_id.
+	return params
+}
+
+func decodeImportTasksParams(args [0]string, argsEscaped bool, r *http.Request) (params ImportTasksParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: dry_run.
+	{
+		val := bool(false)
+		params.DryRun.SetTo(val)
+	}
+	// Decode query: dry_run.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "object_id",
+			Name:    "dry_run",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotObjectIDVal uuid.UUID
+				var paramsDotDryRunVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotObjectIDVal = c
+					paramsDotDryRunVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ObjectID.SetTo(paramsDotObjectIDVal)
+				params.DryRun.SetTo(paramsDotDryRunVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1033,7 +6041,7 @@ func decodeGetAuditLogsParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "object_id",
+			Name: "dry_run",
 			In:   "query",
 			Err:  err,
 		}
@@ -1041,12 +6049,12 @@ func decodeGetAuditLogsParams(args [0]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetCellByIdParams is parameters of getCellById operation.
-type GetCellByIdParams struct {
+// InspectReturnedInstanceParams is parameters of inspectReturnedInstance operation.
+type InspectReturnedInstanceParams struct {
 	ID uuid.UUID
 }
 
-func unpackGetCellByIdParams(packed middleware.Parameters) (params GetCellByIdParams) {
+func unpackInspectReturnedInstanceParams(packed middleware.Parameters) (params InspectReturnedInstanceParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1057,7 +6065,7 @@ func unpackGetCellByIdParams(packed middleware.Parameters) (params GetCellByIdPa
 	return params
 }
 
-func decodeGetCellByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCellByIdParams, _ error) {
+func decodeInspectReturnedInstanceParams(args [1]string, argsEscaped bool, r *http.Request) (params InspectReturnedInstanceParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1106,24 +6114,24 @@ func decodeGetCellByIdParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetCellsParams is parameters of getCells operation.
-type GetCellsParams struct {
-	GroupId uuid.UUID
+// MarkTaskAsAwaitingParams is parameters of markTaskAsAwaiting operation.
+type MarkTaskAsAwaitingParams struct {
+	ID uuid.UUID
 }
 
-func unpackGetCellsParams(packed middleware.Parameters) (params GetCellsParams) {
+func unpackMarkTaskAsAwaitingParams(packed middleware.Parameters) (params MarkTaskAsAwaitingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "groupId",
+			Name: "id",
 			In:   "path",
 		}
-		params.GroupId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetCellsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCellsParams, _ error) {
-	// Decode path: groupId.
+func decodeMarkTaskAsAwaitingParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkTaskAsAwaitingParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1135,7 +6143,7 @@ func decodeGetCellsParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "groupId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1152,7 +6160,7 @@ func decodeGetCellsParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.GroupId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1163,7 +6171,7 @@ func decodeGetCellsParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "groupId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1171,25 +6179,24 @@ func decodeGetCellsParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// GetCellsGroupByIdParams is parameters of getCellsGroupById operation.
-type GetCellsGroupByIdParams struct {
-	// Cells Group ID.
-	GroupId uuid.UUID
+// MarkTaskAsCompletedParams is parameters of markTaskAsCompleted operation.
+type MarkTaskAsCompletedParams struct {
+	ID uuid.UUID
 }
 
-func unpackGetCellsGroupByIdParams(packed middleware.Parameters) (params GetCellsGroupByIdParams) {
+func unpackMarkTaskAsCompletedParams(packed middleware.Parameters) (params MarkTaskAsCompletedParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "groupId",
+			Name: "id",
 			In:   "path",
 		}
-		params.GroupId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetCellsGroupByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCellsGroupByIdParams, _ error) {
-	// Decode path: groupId.
+func decodeMarkTaskAsCompletedParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkTaskAsCompletedParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1201,7 +6208,7 @@ func decodeGetCellsGroupByIdParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "groupId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1218,7 +6225,7 @@ func decodeGetCellsGroupByIdParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.GroupId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1229,7 +6236,7 @@ func decodeGetCellsGroupByIdParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "groupId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1237,12 +6244,12 @@ func decodeGetCellsGroupByIdParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetEmployeeByIdParams is parameters of getEmployeeById operation.
-type GetEmployeeByIdParams struct {
+// PatchEmployeeByIdParams is parameters of patchEmployeeById operation.
+type PatchEmployeeByIdParams struct {
 	ID uuid.UUID
 }
 
-func unpackGetEmployeeByIdParams(packed middleware.Parameters) (params GetEmployeeByIdParams) {
+func unpackPatchEmployeeByIdParams(packed middleware.Parameters) (params PatchEmployeeByIdParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1253,7 +6260,7 @@ func unpackGetEmployeeByIdParams(packed middleware.Parameters) (params GetEmploy
 	return params
 }
 
-func decodeGetEmployeeByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEmployeeByIdParams, _ error) {
+func decodePatchEmployeeByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params PatchEmployeeByIdParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1302,25 +6309,24 @@ func decodeGetEmployeeByIdParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// GetInstanceByIdParams is parameters of getInstanceById operation.
-type GetInstanceByIdParams struct {
-	// Instance ID.
-	InstanceId uuid.UUID
+// PickInstanceFromCellParams is parameters of pickInstanceFromCell operation.
+type PickInstanceFromCellParams struct {
+	ID uuid.UUID
 }
 
-func unpackGetInstanceByIdParams(packed middleware.Parameters) (params GetInstanceByIdParams) {
+func unpackPickInstanceFromCellParams(packed middleware.Parameters) (params PickInstanceFromCellParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "instanceId",
+			Name: "id",
 			In:   "path",
 		}
-		params.InstanceId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetInstanceByIdParams, _ error) {
-	// Decode path: instanceId.
+func decodePickInstanceFromCellParams(args [1]string, argsEscaped bool, r *http.Request) (params PickInstanceFromCellParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1332,7 +6338,7 @@ func decodeGetInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "instanceId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1349,7 +6355,7 @@ func decodeGetInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.InstanceId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1360,7 +6366,7 @@ func decodeGetInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "instanceId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1368,25 +6374,24 @@ func decodeGetInstanceByIdParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// GetInstancesByItemIdParams is parameters of getInstancesByItemId operation.
-type GetInstancesByItemIdParams struct {
-	// Item ID.
-	ItemId uuid.UUID
+// PickStockLineFromCellParams is parameters of pickStockLineFromCell operation.
+type PickStockLineFromCellParams struct {
+	ID uuid.UUID
 }
 
-func unpackGetInstancesByItemIdParams(packed middleware.Parameters) (params GetInstancesByItemIdParams) {
+func unpackPickStockLineFromCellParams(packed middleware.Parameters) (params PickStockLineFromCellParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "itemId",
+			Name: "id",
 			In:   "path",
 		}
-		params.ItemId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetInstancesByItemIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetInstancesByItemIdParams, _ error) {
-	// Decode path: itemId.
+func decodePickStockLineFromCellParams(args [1]string, argsEscaped bool, r *http.Request) (params PickStockLineFromCellParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1398,7 +6403,7 @@ func decodeGetInstancesByItemIdParams(args [1]string, argsEscaped bool, r *http.
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "itemId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1415,7 +6420,7 @@ func decodeGetInstancesByItemIdParams(args [1]string, argsEscaped bool, r *http.
 					return err
 				}
 
-				params.ItemId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1426,7 +6431,7 @@ func decodeGetInstancesByItemIdParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "itemId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1434,13 +6439,12 @@ func decodeGetInstancesByItemIdParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// GetItemByIdParams is parameters of getItemById operation.
-type GetItemByIdParams struct {
-	// Item ID.
+// PickWaveInstanceParams is parameters of pickWaveInstance operation.
+type PickWaveInstanceParams struct {
 	ID uuid.UUID
 }
 
-func unpackGetItemByIdParams(packed middleware.Parameters) (params GetItemByIdParams) {
+func unpackPickWaveInstanceParams(packed middleware.Parameters) (params PickWaveInstanceParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1451,7 +6455,7 @@ func unpackGetItemByIdParams(packed middleware.Parameters) (params GetItemByIdPa
 	return params
 }
 
-func decodeGetItemByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetItemByIdParams, _ error) {
+func decodePickWaveInstanceParams(args [1]string, argsEscaped bool, r *http.Request) (params PickWaveInstanceParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1500,15 +6504,12 @@ func decodeGetItemByIdParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetItemVariantByIdParams is parameters of getItemVariantById operation.
-type GetItemVariantByIdParams struct {
-	// Item ID.
+// PutItemInTargetCellParams is parameters of putItemInTargetCell operation.
+type PutItemInTargetCellParams struct {
 	ID uuid.UUID
-	// Variant ID.
-	VariantId uuid.UUID
 }
 
-func unpackGetItemVariantByIdParams(packed middleware.Parameters) (params GetItemVariantByIdParams) {
+func unpackPutItemInTargetCellParams(packed middleware.Parameters) (params PutItemInTargetCellParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1516,17 +6517,10 @@ func unpackGetItemVariantByIdParams(packed middleware.Parameters) (params GetIte
 		}
 		params.ID = packed[key].(uuid.UUID)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "variantId",
-			In:   "path",
-		}
-		params.VariantId = packed[key].(uuid.UUID)
-	}
 	return params
 }
 
-func decodeGetItemVariantByIdParams(args [2]string, argsEscaped bool, r *http.Request) (params GetItemVariantByIdParams, _ error) {
+func decodePutItemInTargetCellParams(args [1]string, argsEscaped bool, r *http.Request) (params PutItemInTargetCellParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1572,11 +6566,31 @@ func decodeGetItemVariantByIdParams(args [2]string, argsEscaped bool, r *http.Re
 			Err:  err,
 		}
 	}
-	// Decode path: variantId.
+	return params, nil
+}
+
+// PutStockLineInTargetCellParams is parameters of putStockLineInTargetCell operation.
+type PutStockLineInTargetCellParams struct {
+	ID uuid.UUID
+}
+
+func unpackPutStockLineInTargetCellParams(packed middleware.Parameters) (params PutStockLineInTargetCellParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePutStockLineInTargetCellParams(args [1]string, argsEscaped bool, r *http.Request) (params PutStockLineInTargetCellParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1584,7 +6598,7 @@ func decodeGetItemVariantByIdParams(args [2]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "variantId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1601,7 +6615,7 @@ func decodeGetItemVariantByIdParams(args [2]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.VariantId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1612,7 +6626,7 @@ func decodeGetItemVariantByIdParams(args [2]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "variantId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1620,13 +6634,12 @@ func decodeGetItemVariantByIdParams(args [2]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetItemVariantsParams is parameters of getItemVariants operation.
-type GetItemVariantsParams struct {
-	// Item ID.
+// ReassignTaskParams is parameters of reassignTask operation.
+type ReassignTaskParams struct {
 	ID uuid.UUID
 }
 
-func unpackGetItemVariantsParams(packed middleware.Parameters) (params GetItemVariantsParams) {
+func unpackReassignTaskParams(packed middleware.Parameters) (params ReassignTaskParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1637,7 +6650,7 @@ func unpackGetItemVariantsParams(packed middleware.Parameters) (params GetItemVa
 	return params
 }
 
-func decodeGetItemVariantsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetItemVariantsParams, _ error) {
+func decodeReassignTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params ReassignTaskParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1686,13 +6699,12 @@ func decodeGetItemVariantsParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// GetOrganizationByIdParams is parameters of getOrganizationById operation.
-type GetOrganizationByIdParams struct {
-	// Organization ID.
+// ReceiveItemsParams is parameters of receiveItems operation.
+type ReceiveItemsParams struct {
 	ID uuid.UUID
 }
 
-func unpackGetOrganizationByIdParams(packed middleware.Parameters) (params GetOrganizationByIdParams) {
+func unpackReceiveItemsParams(packed middleware.Parameters) (params ReceiveItemsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1703,7 +6715,7 @@ func unpackGetOrganizationByIdParams(packed middleware.Parameters) (params GetOr
 	return params
 }
 
-func decodeGetOrganizationByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrganizationByIdParams, _ error) {
+func decodeReceiveItemsParams(args [1]string, argsEscaped bool, r *http.Request) (params ReceiveItemsParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1752,13 +6764,12 @@ func decodeGetOrganizationByIdParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetOrganizationUnitByIdParams is parameters of getOrganizationUnitById operation.
-type GetOrganizationUnitByIdParams struct {
-	// Unit ID.
+// RegenerateTaskPickupCodeParams is parameters of regenerateTaskPickupCode operation.
+type RegenerateTaskPickupCodeParams struct {
 	ID uuid.UUID
 }
 
-func unpackGetOrganizationUnitByIdParams(packed middleware.Parameters) (params GetOrganizationUnitByIdParams) {
+func unpackRegenerateTaskPickupCodeParams(packed middleware.Parameters) (params RegenerateTaskPickupCodeParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1769,7 +6780,7 @@ func unpackGetOrganizationUnitByIdParams(packed middleware.Parameters) (params G
 	return params
 }
 
-func decodeGetOrganizationUnitByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrganizationUnitByIdParams, _ error) {
+func decodeRegenerateTaskPickupCodeParams(args [1]string, argsEscaped bool, r *http.Request) (params RegenerateTaskPickupCodeParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1818,13 +6829,12 @@ func decodeGetOrganizationUnitByIdParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetStorageGroupByIdParams is parameters of getStorageGroupById operation.
-type GetStorageGroupByIdParams struct {
-	// Storage Group ID.
+// ReportTaskItemExceptionParams is parameters of reportTaskItemException operation.
+type ReportTaskItemExceptionParams struct {
 	ID uuid.UUID
 }
 
-func unpackGetStorageGroupByIdParams(packed middleware.Parameters) (params GetStorageGroupByIdParams) {
+func unpackReportTaskItemExceptionParams(packed middleware.Parameters) (params ReportTaskItemExceptionParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1835,7 +6845,7 @@ func unpackGetStorageGroupByIdParams(packed middleware.Parameters) (params GetSt
 	return params
 }
 
-func decodeGetStorageGroupByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetStorageGroupByIdParams, _ error) {
+func decodeReportTaskItemExceptionParams(args [1]string, argsEscaped bool, r *http.Request) (params ReportTaskItemExceptionParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1884,12 +6894,86 @@ func decodeGetStorageGroupByIdParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetTaskByIdParams is parameters of getTaskById operation.
-type GetTaskByIdParams struct {
+// ResolveBarcodeParams is parameters of resolveBarcode operation.
+type ResolveBarcodeParams struct {
+	// The scanned code.
+	Code string
+}
+
+func unpackResolveBarcodeParams(packed middleware.Parameters) (params ResolveBarcodeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "query",
+		}
+		params.Code = packed[key].(string)
+	}
+	return params
+}
+
+func decodeResolveBarcodeParams(args [0]string, argsEscaped bool, r *http.Request) (params ResolveBarcodeParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: code.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    1,
+					MinLengthSet: true,
+					MaxLength:    512,
+					MaxLengthSet: true,
+					Email:        false,
+					Hostname:     false,
+					Regex:        nil,
+				}).Validate(string(params.Code)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RevokeApiTokenParams is parameters of revokeApiToken operation.
+type RevokeApiTokenParams struct {
 	ID uuid.UUID
 }
 
-func unpackGetTaskByIdParams(packed middleware.Parameters) (params GetTaskByIdParams) {
+func unpackRevokeApiTokenParams(packed middleware.Parameters) (params RevokeApiTokenParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1900,7 +6984,7 @@ func unpackGetTaskByIdParams(packed middleware.Parameters) (params GetTaskByIdPa
 	return params
 }
 
-func decodeGetTaskByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaskByIdParams, _ error) {
+func decodeRevokeApiTokenParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeApiTokenParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1949,24 +7033,24 @@ func decodeGetTaskByIdParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetTvBoardsDataParams is parameters of getTvBoardsData operation.
-type GetTvBoardsDataParams struct {
-	TvToken string
+// RunTaskTemplateParams is parameters of runTaskTemplate operation.
+type RunTaskTemplateParams struct {
+	ID uuid.UUID
 }
 
-func unpackGetTvBoardsDataParams(packed middleware.Parameters) (params GetTvBoardsDataParams) {
+func unpackRunTaskTemplateParams(packed middleware.Parameters) (params RunTaskTemplateParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "tvToken",
+			Name: "id",
 			In:   "path",
 		}
-		params.TvToken = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetTvBoardsDataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTvBoardsDataParams, _ error) {
-	// Decode path: tvToken.
+func decodeRunTaskTemplateParams(args [1]string, argsEscaped bool, r *http.Request) (params RunTaskTemplateParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1978,7 +7062,7 @@ func decodeGetTvBoardsDataParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "tvToken",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1990,12 +7074,12 @@ func decodeGetTvBoardsDataParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.TvToken = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2006,7 +7090,7 @@ func decodeGetTvBoardsDataParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "tvToken",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -2014,12 +7098,12 @@ func decodeGetTvBoardsDataParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// MarkTaskAsAwaitingParams is parameters of markTaskAsAwaiting operation.
-type MarkTaskAsAwaitingParams struct {
+// SetWorkerProfileParams is parameters of setWorkerProfile operation.
+type SetWorkerProfileParams struct {
 	ID uuid.UUID
 }
 
-func unpackMarkTaskAsAwaitingParams(packed middleware.Parameters) (params MarkTaskAsAwaitingParams) {
+func unpackSetWorkerProfileParams(packed middleware.Parameters) (params SetWorkerProfileParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2030,7 +7114,7 @@ func unpackMarkTaskAsAwaitingParams(packed middleware.Parameters) (params MarkTa
 	return params
 }
 
-func decodeMarkTaskAsAwaitingParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkTaskAsAwaitingParams, _ error) {
+func decodeSetWorkerProfileParams(args [1]string, argsEscaped bool, r *http.Request) (params SetWorkerProfileParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2079,12 +7163,12 @@ func decodeMarkTaskAsAwaitingParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// MarkTaskAsCompletedParams is parameters of markTaskAsCompleted operation.
-type MarkTaskAsCompletedParams struct {
+// SortWaveInstanceParams is parameters of sortWaveInstance operation.
+type SortWaveInstanceParams struct {
 	ID uuid.UUID
 }
 
-func unpackMarkTaskAsCompletedParams(packed middleware.Parameters) (params MarkTaskAsCompletedParams) {
+func unpackSortWaveInstanceParams(packed middleware.Parameters) (params SortWaveInstanceParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2095,7 +7179,7 @@ func unpackMarkTaskAsCompletedParams(packed middleware.Parameters) (params MarkT
 	return params
 }
 
-func decodeMarkTaskAsCompletedParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkTaskAsCompletedParams, _ error) {
+func decodeSortWaveInstanceParams(args [1]string, argsEscaped bool, r *http.Request) (params SortWaveInstanceParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2144,12 +7228,12 @@ func decodeMarkTaskAsCompletedParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// PatchEmployeeByIdParams is parameters of patchEmployeeById operation.
-type PatchEmployeeByIdParams struct {
+// SplitStockLineParams is parameters of splitStockLine operation.
+type SplitStockLineParams struct {
 	ID uuid.UUID
 }
 
-func unpackPatchEmployeeByIdParams(packed middleware.Parameters) (params PatchEmployeeByIdParams) {
+func unpackSplitStockLineParams(packed middleware.Parameters) (params SplitStockLineParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2160,7 +7244,7 @@ func unpackPatchEmployeeByIdParams(packed middleware.Parameters) (params PatchEm
 	return params
 }
 
-func decodePatchEmployeeByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params PatchEmployeeByIdParams, _ error) {
+func decodeSplitStockLineParams(args [1]string, argsEscaped bool, r *http.Request) (params SplitStockLineParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2209,12 +7293,12 @@ func decodePatchEmployeeByIdParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// PickInstanceFromCellParams is parameters of pickInstanceFromCell operation.
-type PickInstanceFromCellParams struct {
+// SubmitInventoryCountParams is parameters of submitInventoryCount operation.
+type SubmitInventoryCountParams struct {
 	ID uuid.UUID
 }
 
-func unpackPickInstanceFromCellParams(packed middleware.Parameters) (params PickInstanceFromCellParams) {
+func unpackSubmitInventoryCountParams(packed middleware.Parameters) (params SubmitInventoryCountParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2225,7 +7309,7 @@ func unpackPickInstanceFromCellParams(packed middleware.Parameters) (params Pick
 	return params
 }
 
-func decodePickInstanceFromCellParams(args [1]string, argsEscaped bool, r *http.Request) (params PickInstanceFromCellParams, _ error) {
+func decodeSubmitInventoryCountParams(args [1]string, argsEscaped bool, r *http.Request) (params SubmitInventoryCountParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2274,12 +7358,12 @@ func decodePickInstanceFromCellParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// RevokeApiTokenParams is parameters of revokeApiToken operation.
-type RevokeApiTokenParams struct {
+// UnassignTaskParams is parameters of unassignTask operation.
+type UnassignTaskParams struct {
 	ID uuid.UUID
 }
 
-func unpackRevokeApiTokenParams(packed middleware.Parameters) (params RevokeApiTokenParams) {
+func unpackUnassignTaskParams(packed middleware.Parameters) (params UnassignTaskParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2290,7 +7374,7 @@ func unpackRevokeApiTokenParams(packed middleware.Parameters) (params RevokeApiT
 	return params
 }
 
-func decodeRevokeApiTokenParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeApiTokenParams, _ error) {
+func decodeUnassignTaskParams(args [1]string, argsEscaped bool, r *http.Request) (params UnassignTaskParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2919,3 +8003,251 @@ func decodeUpdateStorageGroupParams(args [1]string, argsEscaped bool, r *http.Re
 	}
 	return params, nil
 }
+
+// UpdateTaskCommentParams is parameters of updateTaskComment operation.
+type UpdateTaskCommentParams struct {
+	ID        uuid.UUID
+	CommentId uuid.UUID
+}
+
+func unpackUpdateTaskCommentParams(packed middleware.Parameters) (params UpdateTaskCommentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "commentId",
+			In:   "path",
+		}
+		params.CommentId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUpdateTaskCommentParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateTaskCommentParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: commentId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "commentId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CommentId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "commentId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UpdateTaskTemplateParams is parameters of updateTaskTemplate operation.
+type UpdateTaskTemplateParams struct {
+	ID uuid.UUID
+}
+
+func unpackUpdateTaskTemplateParams(packed middleware.Parameters) (params UpdateTaskTemplateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUpdateTaskTemplateParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateTaskTemplateParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UploadTaskAttachmentParams is parameters of uploadTaskAttachment operation.
+type UploadTaskAttachmentParams struct {
+	ID uuid.UUID
+}
+
+func unpackUploadTaskAttachmentParams(packed middleware.Parameters) (params UploadTaskAttachmentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUploadTaskAttachmentParams(args [1]string, argsEscaped bool, r *http.Request) (params UploadTaskAttachmentParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
@@ -251,6 +251,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
+			case 'b': // Prefix: "barcodes/resolve"
+
+				if l := len("barcodes/resolve"); len(elem) >= l && elem[0:l] == "barcodes/resolve" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleResolveBarcodeRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
 			case 'c': // Prefix: "cells"
 
 				if l := len("cells"); len(elem) >= l && elem[0:l] == "cells" {
@@ -447,16 +467,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						elem = origElem
 					}
 					// Param: "id"
-					// Leaf parameter, slashes are prohibited
+					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
+					if idx < 0 {
+						idx = len(elem)
 					}
-					args[0] = elem
-					elem = ""
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "DELETE":
 							s.handleDeleteEmployeeByIdRequest([1]string{
@@ -476,6 +495,34 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/work-profile"
+
+						if l := len("/work-profile"); len(elem) >= l && elem[0:l] == "/work-profile" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetWorkerProfileRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleSetWorkerProfileRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+
+					}
 
 				}
 
@@ -734,7 +781,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "GET":
 						s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
@@ -744,27 +790,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					return
 				}
-
-			case 'o': // Prefix: "orgs"
-
-				if l := len("orgs"); len(elem) >= l && elem[0:l] == "orgs" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleGetOrganizationsRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handleCreateOrganizationRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,POST")
-					}
-
-					return
-				}
 				switch elem[0] {
 				case '/': // Prefix: "/"
 
@@ -774,42 +799,57 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 
-					// Param: "id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+					if len(elem) == 0 {
 						break
 					}
-					args[0] = elem
-					elem = ""
+					switch elem[0] {
+					case 's': // Prefix: "shift"
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "DELETE":
-							s.handleDeleteOrganizationRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "GET":
-							s.handleGetOrganizationByIdRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PUT":
-							s.handleUpdateOrganizationRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "DELETE,GET,PUT")
+						if l := len("shift"); len(elem) >= l && elem[0:l] == "shift" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleSetMyShiftRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 't': // Prefix: "tasks"
+
+						if l := len("tasks"); len(elem) >= l && elem[0:l] == "tasks" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetMyTasksRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
 						}
 
-						return
 					}
 
 				}
 
-			case 's': // Prefix: "storage-groups"
+			case 'o': // Prefix: "orgs"
 
-				if l := len("storage-groups"); len(elem) >= l && elem[0:l] == "storage-groups" {
+				if l := len("orgs"); len(elem) >= l && elem[0:l] == "orgs" {
 					elem = elem[l:]
 				} else {
 					break
@@ -818,9 +858,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				if len(elem) == 0 {
 					switch r.Method {
 					case "GET":
-						s.handleGetStorageGroupsRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleGetOrganizationsRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
-						s.handleCreateStorageGroupRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleCreateOrganizationRequest([0]string{}, elemIsEscaped, w, r)
 					default:
 						s.notAllowed(w, r, "GET,POST")
 					}
@@ -849,15 +889,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "DELETE":
-							s.handleDeleteStorageGroupRequest([1]string{
+							s.handleDeleteOrganizationRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleGetStorageGroupByIdRequest([1]string{
+							s.handleGetOrganizationByIdRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						case "PUT":
-							s.handleUpdateStorageGroupRequest([1]string{
+							s.handleUpdateOrganizationRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
@@ -869,9 +909,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
-			case 't': // Prefix: "t"
+			case 's': // Prefix: "sto"
 
-				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+				if l := len("sto"); len(elem) >= l && elem[0:l] == "sto" {
 					elem = elem[l:]
 				} else {
 					break
@@ -881,9 +921,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'a': // Prefix: "asks"
+				case 'c': // Prefix: "ck"
 
-					if l := len("asks"); len(elem) >= l && elem[0:l] == "asks" {
+					if l := len("ck"); len(elem) >= l && elem[0:l] == "ck" {
 						elem = elem[l:]
 					} else {
 						break
@@ -892,41 +932,30 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					if len(elem) == 0 {
 						switch r.Method {
 						case "GET":
-							s.handleGetTasksRequest([0]string{}, elemIsEscaped, w, r)
-						case "POST":
-							s.handleCreateTaskRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleGetStockLevelsRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET,POST")
+							s.notAllowed(w, r, "GET")
 						}
 
 						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case '-': // Prefix: "-lines"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("-lines"); len(elem) >= l && elem[0:l] == "-lines" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
 							switch r.Method {
 							case "GET":
-								s.handleGetTaskByIdRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
+								s.handleGetStockLinesRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleCreateStockLineRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, "GET,POST")
 							}
 
 							return
@@ -944,9 +973,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'c': // Prefix: "completed"
-
-								if l := len("completed"); len(elem) >= l && elem[0:l] == "completed" {
+							case 'm': // Prefix: "merge"
+								origElem := elem
+								if l := len("merge"); len(elem) >= l && elem[0:l] == "merge" {
 									elem = elem[l:]
 								} else {
 									break
@@ -956,9 +985,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "POST":
-										s.handleMarkTaskAsCompletedRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+										s.handleMergeStockLinesRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "POST")
 									}
@@ -966,9 +993,33 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'p': // Prefix: "pick-instance"
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetStockLineByIdRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/split"
 
-								if l := len("pick-instance"); len(elem) >= l && elem[0:l] == "pick-instance" {
+								if l := len("/split"); len(elem) >= l && elem[0:l] == "/split" {
 									elem = elem[l:]
 								} else {
 									break
@@ -978,7 +1029,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "POST":
-										s.handlePickInstanceFromCellRequest([1]string{
+										s.handleSplitStockLineRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
@@ -988,37 +1039,35 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'r': // Prefix: "ready"
+							}
 
-								if l := len("ready"); len(elem) >= l && elem[0:l] == "ready" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+						}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleMarkTaskAsAwaitingRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
-									}
+					case '/': // Prefix: "/expiring"
 
-									return
-								}
+						if l := len("/expiring"); len(elem) >= l && elem[0:l] == "/expiring" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetExpiringInstancesRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
 							}
 
+							return
 						}
 
 					}
 
-				case 'v': // Prefix: "v-boards"
+				case 'r': // Prefix: "rage-groups"
 
-					if l := len("v-boards"); len(elem) >= l && elem[0:l] == "v-boards" {
+					if l := len("rage-groups"); len(elem) >= l && elem[0:l] == "rage-groups" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1027,9 +1076,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					if len(elem) == 0 {
 						switch r.Method {
 						case "GET":
-							s.handleGetTvBoardsRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleGetStorageGroupsRequest([0]string{}, elemIsEscaped, w, r)
 						case "POST":
-							s.handleCreateTvBoardRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleCreateStorageGroupRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "GET,POST")
 						}
@@ -1046,264 +1095,202 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						// Param: "id"
-						// Match until "/"
+						// Leaf parameter, slashes are prohibited
 						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
+						if idx >= 0 {
+							break
 						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+						args[0] = elem
+						elem = ""
 
 						if len(elem) == 0 {
+							// Leaf node.
 							switch r.Method {
 							case "DELETE":
-								s.handleDeleteTvBoardRequest([1]string{
+								s.handleDeleteStorageGroupRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetStorageGroupByIdRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdateStorageGroupRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "DELETE")
+								s.notAllowed(w, r, "DELETE,GET,PUT")
 							}
 
 							return
 						}
-						switch elem[0] {
-						case '/': // Prefix: "/data"
-
-							if l := len("/data"); len(elem) >= l && elem[0:l] == "/data" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetTvBoardsDataRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-
-						}
 
 					}
 
 				}
 
-			case 'u': // Prefix: "units"
+			case 't': // Prefix: "t"
 
-				if l := len("units"); len(elem) >= l && elem[0:l] == "units" {
+				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleGetOrganizationUnitsRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handleCreateUnitRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,POST")
-					}
-
-					return
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'a': // Prefix: "ask"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("ask"); len(elem) >= l && elem[0:l] == "ask" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+					if len(elem) == 0 {
 						break
 					}
-					args[0] = elem
-					elem = ""
+					switch elem[0] {
+					case '-': // Prefix: "-"
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "DELETE":
-							s.handleDeleteOrganizationUnitRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "GET":
-							s.handleGetOrganizationUnitByIdRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PUT":
-							s.handleUpdateOrganizationUnitRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "DELETE,GET,PUT")
+						if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
-					}
-
-				}
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "exceptions"
 
-			}
+							if l := len("exceptions"); len(elem) >= l && elem[0:l] == "exceptions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-		}
-	}
-	s.notFound(w, r)
-}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetTaskExceptionsReportRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
 
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [2]string
-}
+								return
+							}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+						case 't': // Prefix: "templates"
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+							if l := len("templates"); len(elem) >= l && elem[0:l] == "templates" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetTaskTemplatesRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleCreateTaskTemplateRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
+								if len(elem) == 0 {
+									switch r.Method {
+									case "DELETE":
+										s.handleDeleteTaskTemplateRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleGetTaskTemplateByIdRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleUpdateTaskTemplateRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET,PUT")
+									}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/run"
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
+									if l := len("/run"); len(elem) >= l && elem[0:l] == "/run" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRunTaskTemplateRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "a"
+										return
+									}
 
-				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+								}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'p': // Prefix: "p"
+							}
 
-					if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+						}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'i': // Prefix: "i-tokens"
+					case 's': // Prefix: "s"
 
-						if l := len("i-tokens"); len(elem) >= l && elem[0:l] == "i-tokens" {
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
+							switch r.Method {
 							case "GET":
-								r.name = GetApiTokensOperation
-								r.summary = "Get list of Service API Tokens"
-								r.operationID = "getApiTokens"
-								r.pathPattern = "/api-tokens"
-								r.args = args
-								r.count = 0
-								return r, true
+								s.handleGetTasksRequest([0]string{}, elemIsEscaped, w, r)
 							case "POST":
-								r.name = CreateApiTokenOperation
-								r.summary = "Create Service API Token"
-								r.operationID = "createApiToken"
-								r.pathPattern = "/api-tokens"
-								r.args = args
-								r.count = 0
-								return r, true
+								s.handleCreateTaskRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								return
+								s.notAllowed(w, r, "GET,POST")
 							}
+
+							return
 						}
 						switch elem[0] {
 						case '/': // Prefix: "/"
@@ -1314,36 +1301,1780 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 
-							// Param: "id"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
+							if len(elem) == 0 {
 								break
 							}
-							args[0] = elem
-							elem = ""
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "DELETE":
-									r.name = RevokeApiTokenOperation
-									r.summary = "Revoke Service API Token"
-									r.operationID = "revokeApiToken"
-									r.pathPattern = "/api-tokens/{id}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+							switch elem[0] {
+							case 'i': // Prefix: "import"
+								origElem := elem
+								if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleImportTasksRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetTaskByIdRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "a"
+
+									if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 's': // Prefix: "ssign"
+
+										if l := len("ssign"); len(elem) >= l && elem[0:l] == "ssign" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleAssignTaskRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									case 't': // Prefix: "ttachments"
+
+										if l := len("ttachments"); len(elem) >= l && elem[0:l] == "ttachments" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleGetTaskAttachmentsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "POST":
+												s.handleUploadTaskAttachmentRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET,POST")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "attachmentId"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "DELETE":
+													s.handleDeleteTaskAttachmentRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												case "GET":
+													s.handleDownloadTaskAttachmentRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "DELETE,GET")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								case 'c': // Prefix: "c"
+
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "ancel"
+
+										if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleCancelTaskRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									case 'l': // Prefix: "laim"
+
+										if l := len("laim"); len(elem) >= l && elem[0:l] == "laim" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleClaimTaskRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									case 'o': // Prefix: "o"
+
+										if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'm': // Prefix: "m"
+
+											if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'm': // Prefix: "ments"
+
+												if l := len("ments"); len(elem) >= l && elem[0:l] == "ments" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													switch r.Method {
+													case "GET":
+														s.handleGetTaskCommentsRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													case "POST":
+														s.handleCreateTaskCommentRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET,POST")
+													}
+
+													return
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
+
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													// Param: "commentId"
+													// Leaf parameter, slashes are prohibited
+													idx := strings.IndexByte(elem, '/')
+													if idx >= 0 {
+														break
+													}
+													args[1] = elem
+													elem = ""
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch r.Method {
+														case "DELETE":
+															s.handleDeleteTaskCommentRequest([2]string{
+																args[0],
+																args[1],
+															}, elemIsEscaped, w, r)
+														case "PUT":
+															s.handleUpdateTaskCommentRequest([2]string{
+																args[0],
+																args[1],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, "DELETE,PUT")
+														}
+
+														return
+													}
+
+												}
+
+											case 'p': // Prefix: "pleted"
+
+												if l := len("pleted"); len(elem) >= l && elem[0:l] == "pleted" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleMarkTaskAsCompletedRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+											}
+
+										case 'u': // Prefix: "unt"
+
+											if l := len("unt"); len(elem) >= l && elem[0:l] == "unt" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "POST":
+													s.handleSubmitInventoryCountRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '-': // Prefix: "-"
+
+												if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case 'a': // Prefix: "adjustments"
+
+													if l := len("adjustments"); len(elem) >= l && elem[0:l] == "adjustments" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch r.Method {
+														case "POST":
+															s.handleApplyInventoryCountAdjustmentsRequest([1]string{
+																args[0],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, "POST")
+														}
+
+														return
+													}
+
+												case 'r': // Prefix: "report"
+
+													if l := len("report"); len(elem) >= l && elem[0:l] == "report" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch r.Method {
+														case "GET":
+															s.handleGetInventoryCountReportRequest([1]string{
+																args[0],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, "GET")
+														}
+
+														return
+													}
+
+												}
+
+											}
+
+										}
+
+									}
+
+								case 'e': // Prefix: "exceptions"
+
+									if l := len("exceptions"); len(elem) >= l && elem[0:l] == "exceptions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleReportTaskItemExceptionRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'h': // Prefix: "history"
+
+									if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTaskStatusHistoryRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'i': // Prefix: "i"
+
+									if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'n': // Prefix: "nspect-return"
+
+										if l := len("nspect-return"); len(elem) >= l && elem[0:l] == "nspect-return" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleInspectReturnedInstanceRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									case 't': // Prefix: "tems/"
+
+										if l := len("tems/"); len(elem) >= l && elem[0:l] == "tems/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "instanceId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/substitutes"
+
+											if l := len("/substitutes"); len(elem) >= l && elem[0:l] == "/substitutes" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetTaskItemSubstitutesRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								case 'p': // Prefix: "p"
+
+									if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'i': // Prefix: "ick"
+
+										if l := len("ick"); len(elem) >= l && elem[0:l] == "ick" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '-': // Prefix: "-"
+
+											if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'i': // Prefix: "instance"
+
+												if l := len("instance"); len(elem) >= l && elem[0:l] == "instance" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handlePickInstanceFromCellRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+											case 's': // Prefix: "stock-line"
+
+												if l := len("stock-line"); len(elem) >= l && elem[0:l] == "stock-line" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handlePickStockLineFromCellRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+											}
+
+										case 'u': // Prefix: "up-code"
+
+											if l := len("up-code"); len(elem) >= l && elem[0:l] == "up-code" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetTaskPickupCodeRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												case "POST":
+													s.handleRegenerateTaskPickupCodeRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET,POST")
+												}
+
+												return
+											}
+
+										}
+
+									case 'u': // Prefix: "ut-"
+
+										if l := len("ut-"); len(elem) >= l && elem[0:l] == "ut-" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'i': // Prefix: "instance"
+
+											if l := len("instance"); len(elem) >= l && elem[0:l] == "instance" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handlePutItemInTargetCellRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										case 's': // Prefix: "stock-line"
+
+											if l := len("stock-line"); len(elem) >= l && elem[0:l] == "stock-line" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handlePutStockLineInTargetCellRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								case 'r': // Prefix: "re"
+
+									if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "a"
+
+										if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'd': // Prefix: "dy"
+
+											if l := len("dy"); len(elem) >= l && elem[0:l] == "dy" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleMarkTaskAsAwaitingRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										case 's': // Prefix: "ssign"
+
+											if l := len("ssign"); len(elem) >= l && elem[0:l] == "ssign" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleReassignTaskRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										}
+
+									case 'c': // Prefix: "ceive"
+
+										if l := len("ceive"); len(elem) >= l && elem[0:l] == "ceive" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleReceiveItemsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								case 'u': // Prefix: "unassign"
+
+									if l := len("unassign"); len(elem) >= l && elem[0:l] == "unassign" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleUnassignTaskRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'r': // Prefix: "ransfer-orders"
+
+					if l := len("ransfer-orders"); len(elem) >= l && elem[0:l] == "ransfer-orders" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetTransferOrdersRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateTransferOrderRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetTransferOrderByIdRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/cancel"
+
+							if l := len("/cancel"); len(elem) >= l && elem[0:l] == "/cancel" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCancelTransferOrderRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'v': // Prefix: "v-boards"
+
+					if l := len("v-boards"); len(elem) >= l && elem[0:l] == "v-boards" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetTvBoardsRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateTvBoardRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteTvBoardRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/data"
+
+							if l := len("/data"); len(elem) >= l && elem[0:l] == "/data" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetTvBoardsDataRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'u': // Prefix: "units"
+
+				if l := len("units"); len(elem) >= l && elem[0:l] == "units" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleGetOrganizationUnitsRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreateUnitRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteOrganizationUnitRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleGetOrganizationUnitByIdRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleUpdateOrganizationUnitRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PUT")
+						}
+
+						return
+					}
+
+				}
+
+			case 'w': // Prefix: "waves"
+
+				if l := len("waves"); len(elem) >= l && elem[0:l] == "waves" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleGetPickWavesRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreatePickWaveRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetPickWaveByIdRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ancel"
+
+								if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleCancelPickWaveRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'o': // Prefix: "omplete"
+
+								if l := len("omplete"); len(elem) >= l && elem[0:l] == "omplete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleCompletePickWaveRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						case 'p': // Prefix: "pick-instance"
+
+							if l := len("pick-instance"); len(elem) >= l && elem[0:l] == "pick-instance" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handlePickWaveInstanceRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "sort-instance"
+
+							if l := len("sort-instance"); len(elem) >= l && elem[0:l] == "sort-instance" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleSortWaveInstanceRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "a"
+
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'p': // Prefix: "p"
+
+					if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "i-tokens"
+
+						if l := len("i-tokens"); len(elem) >= l && elem[0:l] == "i-tokens" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetApiTokensOperation
+								r.summary = "Get list of Service API Tokens"
+								r.operationID = "getApiTokens"
+								r.pathPattern = "/api-tokens"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = CreateApiTokenOperation
+								r.summary = "Create Service API Token"
+								r.operationID = "createApiToken"
+								r.pathPattern = "/api-tokens"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = RevokeApiTokenOperation
+									r.summary = "Revoke Service API Token"
+									r.operationID = "revokeApiToken"
+									r.pathPattern = "/api-tokens/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'p': // Prefix: "p/roles"
+
+						if l := len("p/roles"); len(elem) >= l && elem[0:l] == "p/roles" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetRolesOperation
+								r.summary = "Get all roles in system"
+								r.operationID = "getRoles"
+								r.pathPattern = "/app/roles"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'u': // Prefix: "u"
+
+					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'd': // Prefix: "dit-logs"
+
+						if l := len("dit-logs"); len(elem) >= l && elem[0:l] == "dit-logs" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetAuditLogsOperation
+								r.summary = "Get audit logs"
+								r.operationID = "getAuditLogs"
+								r.pathPattern = "/audit-logs"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 't': // Prefix: "th/"
+
+						if l := len("th/"); len(elem) >= l && elem[0:l] == "th/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'l': // Prefix: "logout"
+
+							if l := len("logout"); len(elem) >= l && elem[0:l] == "logout" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = LogoutOperation
+									r.summary = "Logout user"
+									r.operationID = "logout"
+									r.pathPattern = "/auth/logout"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'o': // Prefix: "oauth2/yandex"
+
+							if l := len("oauth2/yandex"); len(elem) >= l && elem[0:l] == "oauth2/yandex" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ExchangeYandexAccessTokenOperation
+									r.summary = "Exchange Yandex Access token for Session token"
+									r.operationID = "exchangeYandexAccessToken"
+									r.pathPattern = "/auth/oauth2/yandex"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'b': // Prefix: "barcodes/resolve"
+
+				if l := len("barcodes/resolve"); len(elem) >= l && elem[0:l] == "barcodes/resolve" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = ResolveBarcodeOperation
+						r.summary = "Resolve a scanned barcode"
+						r.operationID = "resolveBarcode"
+						r.pathPattern = "/barcodes/resolve"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'c': // Prefix: "cells"
+
+				if l := len("cells"); len(elem) >= l && elem[0:l] == "cells" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '-': // Prefix: "-groups"
+
+					if l := len("-groups"); len(elem) >= l && elem[0:l] == "-groups" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetCellsGroupsOperation
+							r.summary = "Get list of Cells Groups"
+							r.operationID = "getCellsGroups"
+							r.pathPattern = "/cells-groups"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateCellsGroupOperation
+							r.summary = "Create Cells Group"
+							r.operationID = "createCellsGroup"
+							r.pathPattern = "/cells-groups"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "groupId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteCellsGroupOperation
+								r.summary = "Delete Cells Group"
+								r.operationID = "deleteCellsGroup"
+								r.pathPattern = "/cells-groups/{groupId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetCellsGroupByIdOperation
+								r.summary = "Get Cells Group by ID"
+								r.operationID = "getCellsGroupById"
+								r.pathPattern = "/cells-groups/{groupId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdateCellsGroupOperation
+								r.summary = "Update Cells Group"
+								r.operationID = "updateCellsGroup"
+								r.pathPattern = "/cells-groups/{groupId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/cells"
+
+							if l := len("/cells"); len(elem) >= l && elem[0:l] == "/cells" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetCellsOperation
+									r.summary = "Get list of Cells"
+									r.operationID = "getCells"
+									r.pathPattern = "/cells-groups/{groupId}/cells"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "POST":
+									r.name = CreateCellOperation
+									r.summary = "Create Cells"
+									r.operationID = "createCell"
+									r.pathPattern = "/cells-groups/{groupId}/cells"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
 								}
 							}
 
 						}
 
-					case 'p': // Prefix: "p/roles"
+					}
 
-						if l := len("p/roles"); len(elem) >= l && elem[0:l] == "p/roles" {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = DeleteCellOperation
+							r.summary = "Delete Cell"
+							r.operationID = "deleteCell"
+							r.pathPattern = "/cells/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = GetCellByIdOperation
+							r.summary = "Get Cell by ID"
+							r.operationID = "getCellById"
+							r.pathPattern = "/cells/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PUT":
+							r.name = UpdateCellOperation
+							r.summary = "Update Cell"
+							r.operationID = "updateCell"
+							r.pathPattern = "/cells/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'e': // Prefix: "employees"
+
+				if l := len("employees"); len(elem) >= l && elem[0:l] == "employees" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = GetEmployeesOperation
+						r.summary = "Get employees of the organization"
+						r.operationID = "getEmployees"
+						r.pathPattern = "/employees"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "invite"
+						origElem := elem
+						if l := len("invite"); len(elem) >= l && elem[0:l] == "invite" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1352,11 +3083,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "GET":
-								r.name = GetRolesOperation
-								r.summary = "Get all roles in system"
-								r.operationID = "getRoles"
-								r.pathPattern = "/app/roles"
+							case "POST":
+								r.name = InviteEmployeeOperation
+								r.summary = "Invite employee to the organization"
+								r.operationID = "inviteEmployee"
+								r.pathPattern = "/employees/invite"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -1365,23 +3096,51 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+						elem = origElem
 					}
-
-				case 'u': // Prefix: "u"
-
-					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
-						elem = elem[l:]
-					} else {
-						break
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
 					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						break
+						switch method {
+						case "DELETE":
+							r.name = DeleteEmployeeByIdOperation
+							r.summary = "Delete employee by id"
+							r.operationID = "deleteEmployeeById"
+							r.pathPattern = "/employees/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = GetEmployeeByIdOperation
+							r.summary = "Get employee by id"
+							r.operationID = "getEmployeeById"
+							r.pathPattern = "/employees/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PATCH":
+							r.name = PatchEmployeeByIdOperation
+							r.summary = "Update employee by id"
+							r.operationID = "patchEmployeeById"
+							r.pathPattern = "/employees/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
 					}
 					switch elem[0] {
-					case 'd': // Prefix: "dit-logs"
+					case '/': // Prefix: "/work-profile"
 
-						if l := len("dit-logs"); len(elem) >= l && elem[0:l] == "dit-logs" {
+						if l := len("/work-profile"); len(elem) >= l && elem[0:l] == "/work-profile" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1391,99 +3150,119 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							// Leaf node.
 							switch method {
 							case "GET":
-								r.name = GetAuditLogsOperation
-								r.summary = "Get audit logs"
-								r.operationID = "getAuditLogs"
-								r.pathPattern = "/audit-logs"
+								r.name = GetWorkerProfileOperation
+								r.summary = "Get worker profile of the employee"
+								r.operationID = "getWorkerProfile"
+								r.pathPattern = "/employees/{id}/work-profile"
 								r.args = args
-								r.count = 0
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = SetWorkerProfileOperation
+								r.summary = "Set worker profile of the employee"
+								r.operationID = "setWorkerProfile"
+								r.pathPattern = "/employees/{id}/work-profile"
+								r.args = args
+								r.count = 1
 								return r, true
 							default:
 								return
 							}
 						}
 
-					case 't': // Prefix: "th/"
-
-						if l := len("th/"); len(elem) >= l && elem[0:l] == "th/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+					}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'l': // Prefix: "logout"
+				}
 
-							if l := len("logout"); len(elem) >= l && elem[0:l] == "logout" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+			case 'i': // Prefix: "i"
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = LogoutOperation
-									r.summary = "Logout user"
-									r.operationID = "logout"
-									r.pathPattern = "/auth/logout"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
+				if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-						case 'o': // Prefix: "oauth2/yandex"
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'n': // Prefix: "nstances"
 
-							if l := len("oauth2/yandex"); len(elem) >= l && elem[0:l] == "oauth2/yandex" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+					if l := len("nstances"); len(elem) >= l && elem[0:l] == "nstances" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = ExchangeYandexAccessTokenOperation
-									r.summary = "Exchange Yandex Access token for Session token"
-									r.operationID = "exchangeYandexAccessToken"
-									r.pathPattern = "/auth/oauth2/yandex"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetInstancesOperation
+							r.summary = "Get list of Instances"
+							r.operationID = "getInstances"
+							r.pathPattern = "/instances"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-					}
-
-				}
+						// Param: "instanceId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
 
-			case 'c': // Prefix: "cells"
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteInstanceByIdOperation
+								r.summary = "Delete Instance by ID"
+								r.operationID = "deleteInstanceById"
+								r.pathPattern = "/instances/{instanceId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetInstanceByIdOperation
+								r.summary = "Get Instance by ID"
+								r.operationID = "getInstanceById"
+								r.pathPattern = "/instances/{instanceId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdateInstanceByIdOperation
+								r.summary = "Update Instance by ID"
+								r.operationID = "updateInstanceById"
+								r.pathPattern = "/instances/{instanceId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
 
-				if l := len("cells"); len(elem) >= l && elem[0:l] == "cells" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+					}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case '-': // Prefix: "-groups"
+				case 't': // Prefix: "tems"
 
-					if l := len("-groups"); len(elem) >= l && elem[0:l] == "-groups" {
+					if l := len("tems"); len(elem) >= l && elem[0:l] == "tems" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1492,18 +3271,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "GET":
-							r.name = GetCellsGroupsOperation
-							r.summary = "Get list of Cells Groups"
-							r.operationID = "getCellsGroups"
-							r.pathPattern = "/cells-groups"
+							r.name = GetItemsOperation
+							r.summary = "Get list of Items"
+							r.operationID = "getItems"
+							r.pathPattern = "/items"
 							r.args = args
 							r.count = 0
 							return r, true
 						case "POST":
-							r.name = CreateCellsGroupOperation
-							r.summary = "Create Cells Group"
-							r.operationID = "createCellsGroup"
-							r.pathPattern = "/cells-groups"
+							r.name = CreateItemOperation
+							r.summary = "Create Item"
+							r.operationID = "createItem"
+							r.pathPattern = "/items"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -1520,7 +3299,7 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 
-						// Param: "groupId"
+						// Param: "itemId"
 						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
 						if idx < 0 {
@@ -1532,26 +3311,26 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "DELETE":
-								r.name = DeleteCellsGroupOperation
-								r.summary = "Delete Cells Group"
-								r.operationID = "deleteCellsGroup"
-								r.pathPattern = "/cells-groups/{groupId}"
+								r.name = DeleteItemOperation
+								r.summary = "Delete Item"
+								r.operationID = "deleteItem"
+								r.pathPattern = "/items/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
 							case "GET":
-								r.name = GetCellsGroupByIdOperation
-								r.summary = "Get Cells Group by ID"
-								r.operationID = "getCellsGroupById"
-								r.pathPattern = "/cells-groups/{groupId}"
+								r.name = GetItemByIdOperation
+								r.summary = "Get Item by ID"
+								r.operationID = "getItemById"
+								r.pathPattern = "/items/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
 							case "PUT":
-								r.name = UpdateCellsGroupOperation
-								r.summary = "Update Cells Group"
-								r.operationID = "updateCellsGroup"
-								r.pathPattern = "/cells-groups/{groupId}"
+								r.name = UpdateItemOperation
+								r.summary = "Update Item"
+								r.operationID = "updateItem"
+								r.pathPattern = "/items/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -1560,96 +3339,143 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/cells"
+						case '/': // Prefix: "/"
 
-							if l := len("/cells"); len(elem) >= l && elem[0:l] == "/cells" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = GetCellsOperation
-									r.summary = "Get list of Cells"
-									r.operationID = "getCells"
-									r.pathPattern = "/cells-groups/{groupId}/cells"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "POST":
-									r.name = CreateCellOperation
-									r.summary = "Create Cells"
-									r.operationID = "createCell"
-									r.pathPattern = "/cells-groups/{groupId}/cells"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
+								break
 							}
+							switch elem[0] {
+							case 'i': // Prefix: "instances"
 
-						}
+								if l := len("instances"); len(elem) >= l && elem[0:l] == "instances" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetInstancesByItemIdOperation
+										r.summary = "Get list of Instances For Item"
+										r.operationID = "getInstancesByItemId"
+										r.pathPattern = "/items/{itemId}/instances"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = CreateInstanceForItemOperation
+										r.summary = "Create Instance For Item"
+										r.operationID = "createInstanceForItem"
+										r.pathPattern = "/items/{itemId}/instances"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
 
-				case '/': // Prefix: "/"
+							case 'v': // Prefix: "variants"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								if l := len("variants"); len(elem) >= l && elem[0:l] == "variants" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					// Param: "id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetItemVariantsOperation
+										r.summary = "Get Item Variants"
+										r.operationID = "getItemVariants"
+										r.pathPattern = "/items/{id}/variants"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = CreateItemVariantOperation
+										r.summary = "Create Item Variant"
+										r.operationID = "createItemVariant"
+										r.pathPattern = "/items/{id}/variants"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "variantId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteItemVariantOperation
+											r.summary = "Delete Item Variant By ID"
+											r.operationID = "deleteItemVariant"
+											r.pathPattern = "/items/{id}/variants/{variantId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "GET":
+											r.name = GetItemVariantByIdOperation
+											r.summary = "Get Item Variant By ID"
+											r.operationID = "getItemVariantById"
+											r.pathPattern = "/items/{id}/variants/{variantId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "PUT":
+											r.name = UpdateItemVariantOperation
+											r.summary = "Update Item Variant By ID"
+											r.operationID = "updateItemVariant"
+											r.pathPattern = "/items/{id}/variants/{variantId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "DELETE":
-							r.name = DeleteCellOperation
-							r.summary = "Delete Cell"
-							r.operationID = "deleteCell"
-							r.pathPattern = "/cells/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "GET":
-							r.name = GetCellByIdOperation
-							r.summary = "Get Cell by ID"
-							r.operationID = "getCellById"
-							r.pathPattern = "/cells/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PUT":
-							r.name = UpdateCellOperation
-							r.summary = "Update Cell"
-							r.operationID = "updateCell"
-							r.pathPattern = "/cells/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
 						}
+
 					}
 
 				}
 
-			case 'e': // Prefix: "employees"
+			case 'm': // Prefix: "me"
 
-				if l := len("employees"); len(elem) >= l && elem[0:l] == "employees" {
+				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
 					elem = elem[l:]
 				} else {
 					break
@@ -1658,10 +3484,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						r.name = GetEmployeesOperation
-						r.summary = "Get employees of the organization"
-						r.operationID = "getEmployees"
-						r.pathPattern = "/employees"
+						r.name = GetCurrentUserOperation
+						r.summary = "Get Current User"
+						r.operationID = "getCurrentUser"
+						r.pathPattern = "/me"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -1682,9 +3508,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'i': // Prefix: "invite"
-						origElem := elem
-						if l := len("invite"); len(elem) >= l && elem[0:l] == "invite" {
+					case 's': // Prefix: "shift"
+
+						if l := len("shift"); len(elem) >= l && elem[0:l] == "shift" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1694,10 +3520,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							// Leaf node.
 							switch method {
 							case "POST":
-								r.name = InviteEmployeeOperation
-								r.summary = "Invite employee to the organization"
-								r.operationID = "inviteEmployee"
-								r.pathPattern = "/employees/invite"
+								r.name = SetMyShiftOperation
+								r.summary = "Start or end the shift of current user"
+								r.operationID = "setMyShift"
+								r.pathPattern = "/me/shift"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -1706,8 +3532,73 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
-						elem = origElem
+					case 't': // Prefix: "tasks"
+
+						if l := len("tasks"); len(elem) >= l && elem[0:l] == "tasks" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetMyTasksOperation
+								r.summary = "Get open tasks assigned to current user"
+								r.operationID = "getMyTasks"
+								r.pathPattern = "/me/tasks"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'o': // Prefix: "orgs"
+
+				if l := len("orgs"); len(elem) >= l && elem[0:l] == "orgs" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = GetOrganizationsOperation
+						r.summary = "Get list of user Organizations"
+						r.operationID = "getOrganizations"
+						r.pathPattern = "/orgs"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = CreateOrganizationOperation
+						r.summary = "Create new Organization"
+						r.operationID = "createOrganization"
+						r.pathPattern = "/orgs"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
 					}
+
 					// Param: "id"
 					// Leaf parameter, slashes are prohibited
 					idx := strings.IndexByte(elem, '/')
@@ -1721,26 +3612,26 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "DELETE":
-							r.name = DeleteEmployeeByIdOperation
-							r.summary = "Delete employee by id"
-							r.operationID = "deleteEmployeeById"
-							r.pathPattern = "/employees/{id}"
+							r.name = DeleteOrganizationOperation
+							r.summary = "Delete Organization"
+							r.operationID = "deleteOrganization"
+							r.pathPattern = "/orgs/{id}"
 							r.args = args
 							r.count = 1
 							return r, true
 						case "GET":
-							r.name = GetEmployeeByIdOperation
-							r.summary = "Get employee by id"
-							r.operationID = "getEmployeeById"
-							r.pathPattern = "/employees/{id}"
+							r.name = GetOrganizationByIdOperation
+							r.summary = "Get Organization by ID"
+							r.operationID = "getOrganizationById"
+							r.pathPattern = "/orgs/{id}"
 							r.args = args
 							r.count = 1
 							return r, true
-						case "PATCH":
-							r.name = PatchEmployeeByIdOperation
-							r.summary = "Update employee by id"
-							r.operationID = "patchEmployeeById"
-							r.pathPattern = "/employees/{id}"
+						case "PUT":
+							r.name = UpdateOrganizationOperation
+							r.summary = "Update Organization"
+							r.operationID = "updateOrganization"
+							r.pathPattern = "/orgs/{id}"
 							r.args = args
 							r.count = 1
 							return r, true
@@ -1751,9 +3642,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
-			case 'i': // Prefix: "i"
+			case 's': // Prefix: "sto"
 
-				if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+				if l := len("sto"); len(elem) >= l && elem[0:l] == "sto" {
 					elem = elem[l:]
 				} else {
 					break
@@ -1763,9 +3654,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'n': // Prefix: "nstances"
+				case 'c': // Prefix: "ck"
 
-					if l := len("nstances"); len(elem) >= l && elem[0:l] == "nstances" {
+					if l := len("ck"); len(elem) >= l && elem[0:l] == "ck" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1774,10 +3665,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "GET":
-							r.name = GetInstancesOperation
-							r.summary = "Get list of Instances"
-							r.operationID = "getInstances"
-							r.pathPattern = "/instances"
+							r.name = GetStockLevelsOperation
+							r.summary = "Get stock levels per item variant"
+							r.operationID = "getStockLevels"
+							r.pathPattern = "/stock"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -1786,49 +3677,145 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case '-': // Prefix: "-lines"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("-lines"); len(elem) >= l && elem[0:l] == "-lines" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "instanceId"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
-							case "DELETE":
-								r.name = DeleteInstanceByIdOperation
-								r.summary = "Delete Instance by ID"
-								r.operationID = "deleteInstanceById"
-								r.pathPattern = "/instances/{instanceId}"
+							case "GET":
+								r.name = GetStockLinesOperation
+								r.summary = "Get stock lines of quantity tracked variants"
+								r.operationID = "getStockLines"
+								r.pathPattern = "/stock-lines"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
-							case "GET":
-								r.name = GetInstanceByIdOperation
-								r.summary = "Get Instance by ID"
-								r.operationID = "getInstanceById"
-								r.pathPattern = "/instances/{instanceId}"
+							case "POST":
+								r.name = CreateStockLineOperation
+								r.summary = "Put a quantity of a quantity tracked variant into a cell"
+								r.operationID = "createStockLine"
+								r.pathPattern = "/stock-lines"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
-							case "PUT":
-								r.name = UpdateInstanceByIdOperation
-								r.summary = "Update Instance by ID"
-								r.operationID = "updateInstanceById"
-								r.pathPattern = "/instances/{instanceId}"
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'm': // Prefix: "merge"
+								origElem := elem
+								if l := len("merge"); len(elem) >= l && elem[0:l] == "merge" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = MergeStockLinesOperation
+										r.summary = "Merge available stock lines of one variant in one cell"
+										r.operationID = "mergeStockLines"
+										r.pathPattern = "/stock-lines/merge"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetStockLineByIdOperation
+									r.summary = "Get stock line by ID"
+									r.operationID = "getStockLineById"
+									r.pathPattern = "/stock-lines/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/split"
+
+								if l := len("/split"); len(elem) >= l && elem[0:l] == "/split" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = SplitStockLineOperation
+										r.summary = "Split a quantity off an available stock line into a new line in the same cell"
+										r.operationID = "splitStockLine"
+										r.pathPattern = "/stock-lines/{id}/split"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case '/': // Prefix: "/expiring"
+
+						if l := len("/expiring"); len(elem) >= l && elem[0:l] == "/expiring" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetExpiringInstancesOperation
+								r.summary = "Get instances expiring soon"
+								r.operationID = "getExpiringInstances"
+								r.pathPattern = "/stock/expiring"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
@@ -1837,9 +3824,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
-				case 't': // Prefix: "tems"
+				case 'r': // Prefix: "rage-groups"
 
-					if l := len("tems"); len(elem) >= l && elem[0:l] == "tems" {
+					if l := len("rage-groups"); len(elem) >= l && elem[0:l] == "rage-groups" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1848,18 +3835,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "GET":
-							r.name = GetItemsOperation
-							r.summary = "Get list of Items"
-							r.operationID = "getItems"
-							r.pathPattern = "/items"
+							r.name = GetStorageGroupsOperation
+							r.summary = "Get list of Storage Groups"
+							r.operationID = "getStorageGroups"
+							r.pathPattern = "/storage-groups"
 							r.args = args
 							r.count = 0
 							return r, true
 						case "POST":
-							r.name = CreateItemOperation
-							r.summary = "Create Item"
-							r.operationID = "createItem"
-							r.pathPattern = "/items"
+							r.name = CreateStorageGroupOperation
+							r.summary = "Create Storage Group"
+							r.operationID = "createStorageGroup"
+							r.pathPattern = "/storage-groups"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -1876,38 +3863,39 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 
-						// Param: "itemId"
-						// Match until "/"
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
 						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
+						if idx >= 0 {
+							break
 						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+						args[0] = elem
+						elem = ""
 
 						if len(elem) == 0 {
+							// Leaf node.
 							switch method {
 							case "DELETE":
-								r.name = DeleteItemOperation
-								r.summary = "Delete Item"
-								r.operationID = "deleteItem"
-								r.pathPattern = "/items/{id}"
+								r.name = DeleteStorageGroupOperation
+								r.summary = "Delete Storage Group"
+								r.operationID = "deleteStorageGroup"
+								r.pathPattern = "/storage-groups/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
 							case "GET":
-								r.name = GetItemByIdOperation
-								r.summary = "Get Item by ID"
-								r.operationID = "getItemById"
-								r.pathPattern = "/items/{id}"
+								r.name = GetStorageGroupByIdOperation
+								r.summary = "Get Storage Group by ID"
+								r.operationID = "getStorageGroupById"
+								r.pathPattern = "/storage-groups/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
 							case "PUT":
-								r.name = UpdateItemOperation
-								r.summary = "Update Item"
-								r.operationID = "updateItem"
-								r.pathPattern = "/items/{id}"
+								r.name = UpdateStorageGroupOperation
+								r.summary = "Update Storage Group"
+								r.operationID = "updateStorageGroup"
+								r.pathPattern = "/storage-groups/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -1915,43 +3903,142 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
+
+					}
+
+				}
+
+			case 't': // Prefix: "t"
+
+				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "ask"
+
+					if l := len("ask"); len(elem) >= l && elem[0:l] == "ask" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '-': // Prefix: "-"
+
+						if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'e': // Prefix: "exceptions"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("exceptions"); len(elem) >= l && elem[0:l] == "exceptions" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetTaskExceptionsReportOperation
+									r.summary = "Report of task item exceptions, newest first"
+									r.operationID = "getTaskExceptionsReport"
+									r.pathPattern = "/task-exceptions"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 't': // Prefix: "templates"
+
+							if l := len("templates"); len(elem) >= l && elem[0:l] == "templates" {
+								elem = elem[l:]
+							} else {
 								break
 							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetTaskTemplatesOperation
+									r.summary = "Get task templates of the organization"
+									r.operationID = "getTaskTemplates"
+									r.pathPattern = "/task-templates"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "POST":
+									r.name = CreateTaskTemplateOperation
+									r.summary = "Create task template, optionally run on a cron schedule"
+									r.operationID = "createTaskTemplate"
+									r.pathPattern = "/task-templates"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
 							switch elem[0] {
-							case 'i': // Prefix: "instances"
+							case '/': // Prefix: "/"
 
-								if l := len("instances"); len(elem) >= l && elem[0:l] == "instances" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
 								if len(elem) == 0 {
-									// Leaf node.
 									switch method {
+									case "DELETE":
+										r.name = DeleteTaskTemplateOperation
+										r.summary = "Delete task template"
+										r.operationID = "deleteTaskTemplate"
+										r.pathPattern = "/task-templates/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
 									case "GET":
-										r.name = GetInstancesByItemIdOperation
-										r.summary = "Get list of Instances For Item"
-										r.operationID = "getInstancesByItemId"
-										r.pathPattern = "/items/{itemId}/instances"
+										r.name = GetTaskTemplateByIdOperation
+										r.summary = "Get task template by ID"
+										r.operationID = "getTaskTemplateById"
+										r.pathPattern = "/task-templates/{id}"
 										r.args = args
 										r.count = 1
 										return r, true
-									case "POST":
-										r.name = CreateInstanceForItemOperation
-										r.summary = "Create Instance For Item"
-										r.operationID = "createInstanceForItem"
-										r.pathPattern = "/items/{itemId}/instances"
+									case "PUT":
+										r.name = UpdateTaskTemplateOperation
+										r.summary = "Update task template and its rules"
+										r.operationID = "updateTaskTemplate"
+										r.pathPattern = "/task-templates/{id}"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -1959,300 +4046,981 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										return
 									}
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/run"
 
-							case 'v': // Prefix: "variants"
+									if l := len("/run"); len(elem) >= l && elem[0:l] == "/run" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								if l := len("variants"); len(elem) >= l && elem[0:l] == "variants" {
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RunTaskTemplateOperation
+											r.summary = "Create a task from the template right away"
+											r.operationID = "runTaskTemplate"
+											r.pathPattern = "/task-templates/{id}/run"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetTasksOperation
+								r.summary = "Get all tasks for organization"
+								r.operationID = "getTasks"
+								r.pathPattern = "/tasks"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = CreateTaskOperation
+								r.summary = "Create a task"
+								r.operationID = "createTask"
+								r.pathPattern = "/tasks"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'i': // Prefix: "import"
+								origElem := elem
+								if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
+									// Leaf node.
 									switch method {
-									case "GET":
-										r.name = GetItemVariantsOperation
-										r.summary = "Get Item Variants"
-										r.operationID = "getItemVariants"
-										r.pathPattern = "/items/{id}/variants"
-										r.args = args
-										r.count = 1
-										return r, true
 									case "POST":
-										r.name = CreateItemVariantOperation
-										r.summary = "Create Item Variant"
-										r.operationID = "createItemVariant"
-										r.pathPattern = "/items/{id}/variants"
+										r.name = ImportTasksOperation
+										r.summary = "Create tasks in bulk from CSV or JSON lines"
+										r.operationID = "importTasks"
+										r.pathPattern = "/tasks/import"
 										r.args = args
-										r.count = 1
+										r.count = 0
 										return r, true
 									default:
 										return
 									}
-								}
-								switch elem[0] {
-								case '/': // Prefix: "/"
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetTaskByIdOperation
+									r.summary = "Get Task by ID"
+									r.operationID = "getTaskById"
+									r.pathPattern = "/tasks/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "a"
+
+									if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 's': // Prefix: "ssign"
+
+										if l := len("ssign"); len(elem) >= l && elem[0:l] == "ssign" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = AssignTaskOperation
+												r.summary = "Assign task to employee"
+												r.operationID = "assignTask"
+												r.pathPattern = "/tasks/{id}/assign"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 't': // Prefix: "ttachments"
+
+										if l := len("ttachments"); len(elem) >= l && elem[0:l] == "ttachments" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = GetTaskAttachmentsOperation
+												r.summary = "Get files attached to a task"
+												r.operationID = "getTaskAttachments"
+												r.pathPattern = "/tasks/{id}/attachments"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "POST":
+												r.name = UploadTaskAttachmentOperation
+												r.summary = "Attach a photo to a task or one of its items"
+												r.operationID = "uploadTaskAttachment"
+												r.pathPattern = "/tasks/{id}/attachments"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "attachmentId"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "DELETE":
+													r.name = DeleteTaskAttachmentOperation
+													r.summary = "Delete an attachment, allowed to its uploader and managers"
+													r.operationID = "deleteTaskAttachment"
+													r.pathPattern = "/tasks/{id}/attachments/{attachmentId}"
+													r.args = args
+													r.count = 2
+													return r, true
+												case "GET":
+													r.name = DownloadTaskAttachmentOperation
+													r.summary = "Download the content of an attachment"
+													r.operationID = "downloadTaskAttachment"
+													r.pathPattern = "/tasks/{id}/attachments/{attachmentId}"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								case 'c': // Prefix: "c"
+
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "ancel"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = CancelTaskOperation
+												r.summary = "Cancel task"
+												r.operationID = "cancelTask"
+												r.pathPattern = "/tasks/{id}/cancel"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'l': // Prefix: "laim"
+
+										if l := len("laim"); len(elem) >= l && elem[0:l] == "laim" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = ClaimTaskOperation
+												r.summary = "Assign task to current user"
+												r.operationID = "claimTask"
+												r.pathPattern = "/tasks/{id}/claim"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'o': // Prefix: "o"
+
+										if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'm': // Prefix: "m"
+
+											if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'm': // Prefix: "ments"
+
+												if l := len("ments"); len(elem) >= l && elem[0:l] == "ments" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													switch method {
+													case "GET":
+														r.name = GetTaskCommentsOperation
+														r.summary = "Get comments of a task"
+														r.operationID = "getTaskComments"
+														r.pathPattern = "/tasks/{id}/comments"
+														r.args = args
+														r.count = 1
+														return r, true
+													case "POST":
+														r.name = CreateTaskCommentOperation
+														r.summary = "Leave a comment on a task or one of its items"
+														r.operationID = "createTaskComment"
+														r.pathPattern = "/tasks/{id}/comments"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
+
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													// Param: "commentId"
+													// Leaf parameter, slashes are prohibited
+													idx := strings.IndexByte(elem, '/')
+													if idx >= 0 {
+														break
+													}
+													args[1] = elem
+													elem = ""
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch method {
+														case "DELETE":
+															r.name = DeleteTaskCommentOperation
+															r.summary = "Delete a comment, allowed to its author and managers"
+															r.operationID = "deleteTaskComment"
+															r.pathPattern = "/tasks/{id}/comments/{commentId}"
+															r.args = args
+															r.count = 2
+															return r, true
+														case "PUT":
+															r.name = UpdateTaskCommentOperation
+															r.summary = "Edit a comment, only its author can do it"
+															r.operationID = "updateTaskComment"
+															r.pathPattern = "/tasks/{id}/comments/{commentId}"
+															r.args = args
+															r.count = 2
+															return r, true
+														default:
+															return
+														}
+													}
+
+												}
+
+											case 'p': // Prefix: "pleted"
+
+												if l := len("pleted"); len(elem) >= l && elem[0:l] == "pleted" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = MarkTaskAsCompletedOperation
+														r.summary = "Mark task as completed"
+														r.operationID = "markTaskAsCompleted"
+														r.pathPattern = "/tasks/{id}/completed"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										case 'u': // Prefix: "unt"
+
+											if l := len("unt"); len(elem) >= l && elem[0:l] == "unt" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "POST":
+													r.name = SubmitInventoryCountOperation
+													r.summary = "Submit counted goods of a cell"
+													r.operationID = "submitInventoryCount"
+													r.pathPattern = "/tasks/{id}/count"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '-': // Prefix: "-"
+
+												if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case 'a': // Prefix: "adjustments"
+
+													if l := len("adjustments"); len(elem) >= l && elem[0:l] == "adjustments" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch method {
+														case "POST":
+															r.name = ApplyInventoryCountAdjustmentsOperation
+															r.summary = "Apply inventory count adjustments and complete the task"
+															r.operationID = "applyInventoryCountAdjustments"
+															r.pathPattern = "/tasks/{id}/count-adjustments"
+															r.args = args
+															r.count = 1
+															return r, true
+														default:
+															return
+														}
+													}
+
+												case 'r': // Prefix: "report"
+
+													if l := len("report"); len(elem) >= l && elem[0:l] == "report" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch method {
+														case "GET":
+															r.name = GetInventoryCountReportOperation
+															r.summary = "Get inventory count reconciliation report"
+															r.operationID = "getInventoryCountReport"
+															r.pathPattern = "/tasks/{id}/count-report"
+															r.args = args
+															r.count = 1
+															return r, true
+														default:
+															return
+														}
+													}
+
+												}
+
+											}
+
+										}
+
+									}
+
+								case 'e': // Prefix: "exceptions"
+
+									if l := len("exceptions"); len(elem) >= l && elem[0:l] == "exceptions" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "variantId"
-									// Leaf parameter, slashes are prohibited
-									idx := strings.IndexByte(elem, '/')
-									if idx >= 0 {
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = ReportTaskItemExceptionOperation
+											r.summary = "Report a task item as not found, damaged or wrong and optionally substitute it"
+											r.operationID = "reportTaskItemException"
+											r.pathPattern = "/tasks/{id}/exceptions"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'h': // Prefix: "history"
+
+									if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+										elem = elem[l:]
+									} else {
 										break
 									}
-									args[1] = elem
-									elem = ""
 
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
-										case "DELETE":
-											r.name = DeleteItemVariantOperation
-											r.summary = "Delete Item Variant By ID"
-											r.operationID = "deleteItemVariant"
-											r.pathPattern = "/items/{id}/variants/{variantId}"
-											r.args = args
-											r.count = 2
-											return r, true
 										case "GET":
-											r.name = GetItemVariantByIdOperation
-											r.summary = "Get Item Variant By ID"
-											r.operationID = "getItemVariantById"
-											r.pathPattern = "/items/{id}/variants/{variantId}"
-											r.args = args
-											r.count = 2
-											return r, true
-										case "PUT":
-											r.name = UpdateItemVariantOperation
-											r.summary = "Update Item Variant By ID"
-											r.operationID = "updateItemVariant"
-											r.pathPattern = "/items/{id}/variants/{variantId}"
+											r.name = GetTaskStatusHistoryOperation
+											r.summary = "Get task status history"
+											r.operationID = "getTaskStatusHistory"
+											r.pathPattern = "/tasks/{id}/history"
 											r.args = args
-											r.count = 2
+											r.count = 1
 											return r, true
 										default:
 											return
 										}
 									}
 
-								}
+								case 'i': // Prefix: "i"
 
-							}
+									if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'n': // Prefix: "nspect-return"
 
-					}
+										if l := len("nspect-return"); len(elem) >= l && elem[0:l] == "nspect-return" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-				}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = InspectReturnedInstanceOperation
+												r.summary = "Inspect a returned instance and restock, quarantine or dispose it"
+												r.operationID = "inspectReturnedInstance"
+												r.pathPattern = "/tasks/{id}/inspect-return"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
 
-			case 'm': // Prefix: "me"
+									case 't': // Prefix: "tems/"
 
-				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+										if l := len("tems/"); len(elem) >= l && elem[0:l] == "tems/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = GetCurrentUserOperation
-						r.summary = "Get Current User"
-						r.operationID = "getCurrentUser"
-						r.pathPattern = "/me"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
+										// Param: "instanceId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
 
-			case 'o': // Prefix: "orgs"
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/substitutes"
+
+											if l := len("/substitutes"); len(elem) >= l && elem[0:l] == "/substitutes" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = GetTaskItemSubstitutesOperation
+													r.summary = "Suggest available instances of the same variant to substitute a task item"
+													r.operationID = "getTaskItemSubstitutes"
+													r.pathPattern = "/tasks/{id}/items/{instanceId}/substitutes"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
 
-				if l := len("orgs"); len(elem) >= l && elem[0:l] == "orgs" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+										}
 
-				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = GetOrganizationsOperation
-						r.summary = "Get list of user Organizations"
-						r.operationID = "getOrganizations"
-						r.pathPattern = "/orgs"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "POST":
-						r.name = CreateOrganizationOperation
-						r.summary = "Create new Organization"
-						r.operationID = "createOrganization"
-						r.pathPattern = "/orgs"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+									}
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								case 'p': // Prefix: "p"
 
-					// Param: "id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
+									if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "DELETE":
-							r.name = DeleteOrganizationOperation
-							r.summary = "Delete Organization"
-							r.operationID = "deleteOrganization"
-							r.pathPattern = "/orgs/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "GET":
-							r.name = GetOrganizationByIdOperation
-							r.summary = "Get Organization by ID"
-							r.operationID = "getOrganizationById"
-							r.pathPattern = "/orgs/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PUT":
-							r.name = UpdateOrganizationOperation
-							r.summary = "Update Organization"
-							r.operationID = "updateOrganization"
-							r.pathPattern = "/orgs/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
-					}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'i': // Prefix: "ick"
 
-				}
+										if l := len("ick"); len(elem) >= l && elem[0:l] == "ick" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-			case 's': // Prefix: "storage-groups"
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '-': // Prefix: "-"
+
+											if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'i': // Prefix: "instance"
+
+												if l := len("instance"); len(elem) >= l && elem[0:l] == "instance" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = PickInstanceFromCellOperation
+														r.summary = "Pick an item from cell"
+														r.operationID = "pickInstanceFromCell"
+														r.pathPattern = "/tasks/{id}/pick-instance"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
+											case 's': // Prefix: "stock-line"
+
+												if l := len("stock-line"); len(elem) >= l && elem[0:l] == "stock-line" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = PickStockLineFromCellOperation
+														r.summary = "Pick the quantity of a task item off its stock line"
+														r.operationID = "pickStockLineFromCell"
+														r.pathPattern = "/tasks/{id}/pick-stock-line"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										case 'u': // Prefix: "up-code"
+
+											if l := len("up-code"); len(elem) >= l && elem[0:l] == "up-code" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = GetTaskPickupCodeOperation
+													r.summary = "Get pickup code of a ready pickment task"
+													r.operationID = "getTaskPickupCode"
+													r.pathPattern = "/tasks/{id}/pickup-code"
+													r.args = args
+													r.count = 1
+													return r, true
+												case "POST":
+													r.name = RegenerateTaskPickupCodeOperation
+													r.summary = "Issue a new pickup code"
+													r.operationID = "regenerateTaskPickupCode"
+													r.pathPattern = "/tasks/{id}/pickup-code"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
 
-				if l := len("storage-groups"); len(elem) >= l && elem[0:l] == "storage-groups" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+										}
 
-				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = GetStorageGroupsOperation
-						r.summary = "Get list of Storage Groups"
-						r.operationID = "getStorageGroups"
-						r.pathPattern = "/storage-groups"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "POST":
-						r.name = CreateStorageGroupOperation
-						r.summary = "Create Storage Group"
-						r.operationID = "createStorageGroup"
-						r.pathPattern = "/storage-groups"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+									case 'u': // Prefix: "ut-"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										if l := len("ut-"); len(elem) >= l && elem[0:l] == "ut-" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					// Param: "id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'i': // Prefix: "instance"
+
+											if l := len("instance"); len(elem) >= l && elem[0:l] == "instance" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = PutItemInTargetCellOperation
+													r.summary = "Put an item in target cell"
+													r.operationID = "putItemInTargetCell"
+													r.pathPattern = "/tasks/{id}/put-instance"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										case 's': // Prefix: "stock-line"
+
+											if l := len("stock-line"); len(elem) >= l && elem[0:l] == "stock-line" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = PutStockLineInTargetCellOperation
+													r.summary = "Put a picked stock line into the target cell"
+													r.operationID = "putStockLineInTargetCell"
+													r.pathPattern = "/tasks/{id}/put-stock-line"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								case 'r': // Prefix: "re"
+
+									if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "a"
+
+										if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'd': // Prefix: "dy"
+
+											if l := len("dy"); len(elem) >= l && elem[0:l] == "dy" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = MarkTaskAsAwaitingOperation
+													r.summary = "Mark task as awaiting to collect"
+													r.operationID = "markTaskAsAwaiting"
+													r.pathPattern = "/tasks/{id}/ready"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										case 's': // Prefix: "ssign"
+
+											if l := len("ssign"); len(elem) >= l && elem[0:l] == "ssign" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = ReassignTaskOperation
+													r.summary = "Reassign task to another employee"
+													r.operationID = "reassignTask"
+													r.pathPattern = "/tasks/{id}/reassign"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									case 'c': // Prefix: "ceive"
+
+										if l := len("ceive"); len(elem) >= l && elem[0:l] == "ceive" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = ReceiveItemsOperation
+												r.summary = "Receive items of a variant into the receiving cell"
+												r.operationID = "receiveItems"
+												r.pathPattern = "/tasks/{id}/receive"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'u': // Prefix: "unassign"
+
+									if l := len("unassign"); len(elem) >= l && elem[0:l] == "unassign" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "DELETE":
-							r.name = DeleteStorageGroupOperation
-							r.summary = "Delete Storage Group"
-							r.operationID = "deleteStorageGroup"
-							r.pathPattern = "/storage-groups/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "GET":
-							r.name = GetStorageGroupByIdOperation
-							r.summary = "Get Storage Group by ID"
-							r.operationID = "getStorageGroupById"
-							r.pathPattern = "/storage-groups/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PUT":
-							r.name = UpdateStorageGroupOperation
-							r.summary = "Update Storage Group"
-							r.operationID = "updateStorageGroup"
-							r.pathPattern = "/storage-groups/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
-					}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = UnassignTaskOperation
+											r.summary = "Unassign task"
+											r.operationID = "unassignTask"
+											r.pathPattern = "/tasks/{id}/unassign"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
 
-				}
+								}
 
-			case 't': // Prefix: "t"
+							}
 
-				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'a': // Prefix: "asks"
+					}
+
+				case 'r': // Prefix: "ransfer-orders"
 
-					if l := len("asks"); len(elem) >= l && elem[0:l] == "asks" {
+					if l := len("ransfer-orders"); len(elem) >= l && elem[0:l] == "ransfer-orders" {
 						elem = elem[l:]
 					} else {
 						break
@@ -2261,18 +5029,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "GET":
-							r.name = GetTasksOperation
-							r.summary = "Get all tasks for organization"
-							r.operationID = "getTasks"
-							r.pathPattern = "/tasks"
+							r.name = GetTransferOrdersOperation
+							r.summary = "Get transfer orders of the organization, newest first"
+							r.operationID = "getTransferOrders"
+							r.pathPattern = "/transfer-orders"
 							r.args = args
 							r.count = 0
 							return r, true
 						case "POST":
-							r.name = CreateTaskOperation
-							r.summary = "Create a task"
-							r.operationID = "createTask"
-							r.pathPattern = "/tasks"
+							r.name = CreateTransferOrderOperation
+							r.summary = "Transfer instances from one unit to another"
+							r.operationID = "createTransferOrder"
+							r.pathPattern = "/transfer-orders"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -2301,10 +5069,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "GET":
-								r.name = GetTaskByIdOperation
-								r.summary = "Get Task by ID"
-								r.operationID = "getTaskById"
-								r.pathPattern = "/tasks/{id}"
+								r.name = GetTransferOrderByIdOperation
+								r.summary = "Get transfer order with its instances"
+								r.operationID = "getTransferOrderById"
+								r.pathPattern = "/transfer-orders/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -2313,90 +5081,28 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case '/': // Prefix: "/cancel"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("/cancel"); len(elem) >= l && elem[0:l] == "/cancel" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'c': // Prefix: "completed"
-
-								if l := len("completed"); len(elem) >= l && elem[0:l] == "completed" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = MarkTaskAsCompletedOperation
-										r.summary = "Mark task as completed"
-										r.operationID = "markTaskAsCompleted"
-										r.pathPattern = "/tasks/{id}/completed"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
-
-							case 'p': // Prefix: "pick-instance"
-
-								if l := len("pick-instance"); len(elem) >= l && elem[0:l] == "pick-instance" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = PickInstanceFromCellOperation
-										r.summary = "Pick an item from cell"
-										r.operationID = "pickInstanceFromCell"
-										r.pathPattern = "/tasks/{id}/pick-instance"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
-
-							case 'r': // Prefix: "ready"
-
-								if l := len("ready"); len(elem) >= l && elem[0:l] == "ready" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = MarkTaskAsAwaitingOperation
-										r.summary = "Mark task as awaiting to collect"
-										r.operationID = "markTaskAsAwaiting"
-										r.pathPattern = "/tasks/{id}/ready"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = CancelTransferOrderOperation
+									r.summary = "Cancel a pending transfer order and its outbound leg"
+									r.operationID = "cancelTransferOrder"
+									r.pathPattern = "/transfer-orders/{id}/cancel"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
 								}
-
 							}
 
 						}
@@ -2578,6 +5284,197 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
+			case 'w': // Prefix: "waves"
+
+				if l := len("waves"); len(elem) >= l && elem[0:l] == "waves" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = GetPickWavesOperation
+						r.summary = "Get pick waves of the organization"
+						r.operationID = "getPickWaves"
+						r.pathPattern = "/waves"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = CreatePickWaveOperation
+						r.summary = "Group pickment tasks of a unit into a pick wave"
+						r.operationID = "createPickWave"
+						r.pathPattern = "/waves"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetPickWaveByIdOperation
+							r.summary = "Get pick wave with its tasks and combined pick list"
+							r.operationID = "getPickWaveById"
+							r.pathPattern = "/waves/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ancel"
+
+								if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = CancelPickWaveOperation
+										r.summary = "Cancel a pick wave and release its tasks"
+										r.operationID = "cancelPickWave"
+										r.pathPattern = "/waves/{id}/cancel"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'o': // Prefix: "omplete"
+
+								if l := len("omplete"); len(elem) >= l && elem[0:l] == "omplete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = CompletePickWaveOperation
+										r.summary = "Complete a sorted pick wave and mark its tasks as ready"
+										r.operationID = "completePickWave"
+										r.pathPattern = "/waves/{id}/complete"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 'p': // Prefix: "pick-instance"
+
+							if l := len("pick-instance"); len(elem) >= l && elem[0:l] == "pick-instance" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = PickWaveInstanceOperation
+									r.summary = "Pick an instance of the pick wave from its source cell"
+									r.operationID = "pickWaveInstance"
+									r.pathPattern = "/waves/{id}/pick-instance"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "sort-instance"
+
+							if l := len("sort-instance"); len(elem) >= l && elem[0:l] == "sort-instance" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = SortWaveInstanceOperation
+									r.summary = "Sort a picked instance to its task at the consolidation cell"
+									r.operationID = "sortWaveInstance"
+									r.pathPattern = "/waves/{id}/sort-instance"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				}
+
 			}
 
 		}
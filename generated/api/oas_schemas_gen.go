@@ -4,17 +4,64 @@ package api
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
 	"github.com/google/uuid"
+
+	ht "github.com/ogen-go/ogen/http"
 )
 
 func (s *DefaultErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// Order in which available instances are allocated to tasks. fifo takes the oldest instances first,
+// fefo the ones expiring first.
+// Ref: #/components/schemas/AllocationPolicy
+type AllocationPolicy string
+
+const (
+	AllocationPolicyFifo AllocationPolicy = "fifo"
+	AllocationPolicyFefo AllocationPolicy = "fefo"
+)
+
+// AllValues returns all AllocationPolicy values.
+func (AllocationPolicy) AllValues() []AllocationPolicy {
+	return []AllocationPolicy{
+		AllocationPolicyFifo,
+		AllocationPolicyFefo,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AllocationPolicy) MarshalText() ([]byte, error) {
+	switch s {
+	case AllocationPolicyFifo:
+		return []byte(s), nil
+	case AllocationPolicyFefo:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AllocationPolicy) UnmarshalText(data []byte) error {
+	switch AllocationPolicy(data) {
+	case AllocationPolicyFifo:
+		*s = AllocationPolicyFifo
+		return nil
+	case AllocationPolicyFefo:
+		*s = AllocationPolicyFefo
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type ApiToken struct {
 	APIKey string
 }
@@ -29,6 +76,50 @@ func (s *ApiToken) SetAPIKey(val string) {
 	s.APIKey = val
 }
 
+type ApplyInventoryCountAdjustmentsBadRequest ErrorContent
+
+func (*ApplyInventoryCountAdjustmentsBadRequest) applyInventoryCountAdjustmentsRes() {}
+
+type ApplyInventoryCountAdjustmentsForbidden ErrorContent
+
+func (*ApplyInventoryCountAdjustmentsForbidden) applyInventoryCountAdjustmentsRes() {}
+
+type ApplyInventoryCountAdjustmentsUnauthorized ErrorContent
+
+func (*ApplyInventoryCountAdjustmentsUnauthorized) applyInventoryCountAdjustmentsRes() {}
+
+type AssignTaskBadRequest ErrorContent
+
+func (*AssignTaskBadRequest) assignTaskRes() {}
+
+type AssignTaskForbidden ErrorContent
+
+func (*AssignTaskForbidden) assignTaskRes() {}
+
+// AssignTaskNoContent is response for AssignTask operation.
+type AssignTaskNoContent struct{}
+
+func (*AssignTaskNoContent) assignTaskRes() {}
+
+// Ref: #/components/schemas/AssignTaskRequest
+type AssignTaskRequest struct {
+	UserId uuid.UUID `json:"userId"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *AssignTaskRequest) GetUserId() uuid.UUID {
+	return s.UserId
+}
+
+// SetUserId sets the value of UserId.
+func (s *AssignTaskRequest) SetUserId(val uuid.UUID) {
+	s.UserId = val
+}
+
+type AssignTaskUnauthorized ErrorContent
+
+func (*AssignTaskUnauthorized) assignTaskRes() {}
+
 // Ref: #/components/schemas/AuditLog
 type AuditLog struct {
 	ID               uuid.UUID                  `json:"id"`
@@ -39,6 +130,8 @@ type AuditLog struct {
 	TargetObjectId   uuid.UUID                  `json:"targetObjectId"`
 	PrechangeState   NilAuditLogPrechangeState  `json:"prechangeState"`
 	PostchangeState  NilAuditLogPostchangeState `json:"postchangeState"`
+	// Reason code of the change, e.g. count_missing.
+	Reason OptNilString `json:"reason"`
 }
 
 // GetID returns the value of ID.
@@ -81,6 +174,11 @@ func (s *AuditLog) GetPostchangeState() NilAuditLogPostchangeState {
 	return s.PostchangeState
 }
 
+// GetReason returns the value of Reason.
+func (s *AuditLog) GetReason() OptNilString {
+	return s.Reason
+}
+
 // SetID sets the value of ID.
 func (s *AuditLog) SetID(val uuid.UUID) {
 	s.ID = val
@@ -121,6 +219,11 @@ func (s *AuditLog) SetPostchangeState(val NilAuditLogPostchangeState) {
 	s.PostchangeState = val
 }
 
+// SetReason sets the value of Reason.
+func (s *AuditLog) SetReason(val OptNilString) {
+	s.Reason = val
+}
+
 type AuditLogAction string
 
 const (
@@ -244,6 +347,256 @@ func (s *AuthResponse) SetSetCookie(val string) {
 
 func (*AuthResponse) exchangeYandexAccessTokenRes() {}
 
+// An object matching the code. Only the entity of the object type is set.
+// Ref: #/components/schemas/BarcodeMatch
+type BarcodeMatch struct {
+	ObjectType BarcodeMatchObjectType `json:"objectType"`
+	// The field of the object the code matched.
+	MatchedBy BarcodeMatchMatchedBy `json:"matchedBy"`
+	Variant   OptItemVariant        `json:"variant"`
+	// Stock of the variant per unit.
+	Stock    []StockLevel       `json:"stock"`
+	Cell     OptCellForInstance `json:"cell"`
+	Instance OptInstanceFull    `json:"instance"`
+	TvBoard  OptTvBoard         `json:"tvBoard"`
+}
+
+// GetObjectType returns the value of ObjectType.
+func (s *BarcodeMatch) GetObjectType() BarcodeMatchObjectType {
+	return s.ObjectType
+}
+
+// GetMatchedBy returns the value of MatchedBy.
+func (s *BarcodeMatch) GetMatchedBy() BarcodeMatchMatchedBy {
+	return s.MatchedBy
+}
+
+// GetVariant returns the value of Variant.
+func (s *BarcodeMatch) GetVariant() OptItemVariant {
+	return s.Variant
+}
+
+// GetStock returns the value of Stock.
+func (s *BarcodeMatch) GetStock() []StockLevel {
+	return s.Stock
+}
+
+// GetCell returns the value of Cell.
+func (s *BarcodeMatch) GetCell() OptCellForInstance {
+	return s.Cell
+}
+
+// GetInstance returns the value of Instance.
+func (s *BarcodeMatch) GetInstance() OptInstanceFull {
+	return s.Instance
+}
+
+// GetTvBoard returns the value of TvBoard.
+func (s *BarcodeMatch) GetTvBoard() OptTvBoard {
+	return s.TvBoard
+}
+
+// SetObjectType sets the value of ObjectType.
+func (s *BarcodeMatch) SetObjectType(val BarcodeMatchObjectType) {
+	s.ObjectType = val
+}
+
+// SetMatchedBy sets the value of MatchedBy.
+func (s *BarcodeMatch) SetMatchedBy(val BarcodeMatchMatchedBy) {
+	s.MatchedBy = val
+}
+
+// SetVariant sets the value of Variant.
+func (s *BarcodeMatch) SetVariant(val OptItemVariant) {
+	s.Variant = val
+}
+
+// SetStock sets the value of Stock.
+func (s *BarcodeMatch) SetStock(val []StockLevel) {
+	s.Stock = val
+}
+
+// SetCell sets the value of Cell.
+func (s *BarcodeMatch) SetCell(val OptCellForInstance) {
+	s.Cell = val
+}
+
+// SetInstance sets the value of Instance.
+func (s *BarcodeMatch) SetInstance(val OptInstanceFull) {
+	s.Instance = val
+}
+
+// SetTvBoard sets the value of TvBoard.
+func (s *BarcodeMatch) SetTvBoard(val OptTvBoard) {
+	s.TvBoard = val
+}
+
+// The field of the object the code matched.
+type BarcodeMatchMatchedBy string
+
+const (
+	BarcodeMatchMatchedByEan13        BarcodeMatchMatchedBy = "ean13"
+	BarcodeMatchMatchedByArticle      BarcodeMatchMatchedBy = "article"
+	BarcodeMatchMatchedByAlias        BarcodeMatchMatchedBy = "alias"
+	BarcodeMatchMatchedByID           BarcodeMatchMatchedBy = "id"
+	BarcodeMatchMatchedByToken        BarcodeMatchMatchedBy = "token"
+	BarcodeMatchMatchedBySerialNumber BarcodeMatchMatchedBy = "serial_number"
+)
+
+// AllValues returns all BarcodeMatchMatchedBy values.
+func (BarcodeMatchMatchedBy) AllValues() []BarcodeMatchMatchedBy {
+	return []BarcodeMatchMatchedBy{
+		BarcodeMatchMatchedByEan13,
+		BarcodeMatchMatchedByArticle,
+		BarcodeMatchMatchedByAlias,
+		BarcodeMatchMatchedByID,
+		BarcodeMatchMatchedByToken,
+		BarcodeMatchMatchedBySerialNumber,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BarcodeMatchMatchedBy) MarshalText() ([]byte, error) {
+	switch s {
+	case BarcodeMatchMatchedByEan13:
+		return []byte(s), nil
+	case BarcodeMatchMatchedByArticle:
+		return []byte(s), nil
+	case BarcodeMatchMatchedByAlias:
+		return []byte(s), nil
+	case BarcodeMatchMatchedByID:
+		return []byte(s), nil
+	case BarcodeMatchMatchedByToken:
+		return []byte(s), nil
+	case BarcodeMatchMatchedBySerialNumber:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BarcodeMatchMatchedBy) UnmarshalText(data []byte) error {
+	switch BarcodeMatchMatchedBy(data) {
+	case BarcodeMatchMatchedByEan13:
+		*s = BarcodeMatchMatchedByEan13
+		return nil
+	case BarcodeMatchMatchedByArticle:
+		*s = BarcodeMatchMatchedByArticle
+		return nil
+	case BarcodeMatchMatchedByAlias:
+		*s = BarcodeMatchMatchedByAlias
+		return nil
+	case BarcodeMatchMatchedByID:
+		*s = BarcodeMatchMatchedByID
+		return nil
+	case BarcodeMatchMatchedByToken:
+		*s = BarcodeMatchMatchedByToken
+		return nil
+	case BarcodeMatchMatchedBySerialNumber:
+		*s = BarcodeMatchMatchedBySerialNumber
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type BarcodeMatchObjectType string
+
+const (
+	BarcodeMatchObjectTypeVariant  BarcodeMatchObjectType = "variant"
+	BarcodeMatchObjectTypeCell     BarcodeMatchObjectType = "cell"
+	BarcodeMatchObjectTypeInstance BarcodeMatchObjectType = "instance"
+	BarcodeMatchObjectTypeTvBoard  BarcodeMatchObjectType = "tv-board"
+)
+
+// AllValues returns all BarcodeMatchObjectType values.
+func (BarcodeMatchObjectType) AllValues() []BarcodeMatchObjectType {
+	return []BarcodeMatchObjectType{
+		BarcodeMatchObjectTypeVariant,
+		BarcodeMatchObjectTypeCell,
+		BarcodeMatchObjectTypeInstance,
+		BarcodeMatchObjectTypeTvBoard,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BarcodeMatchObjectType) MarshalText() ([]byte, error) {
+	switch s {
+	case BarcodeMatchObjectTypeVariant:
+		return []byte(s), nil
+	case BarcodeMatchObjectTypeCell:
+		return []byte(s), nil
+	case BarcodeMatchObjectTypeInstance:
+		return []byte(s), nil
+	case BarcodeMatchObjectTypeTvBoard:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BarcodeMatchObjectType) UnmarshalText(data []byte) error {
+	switch BarcodeMatchObjectType(data) {
+	case BarcodeMatchObjectTypeVariant:
+		*s = BarcodeMatchObjectTypeVariant
+		return nil
+	case BarcodeMatchObjectTypeCell:
+		*s = BarcodeMatchObjectTypeCell
+		return nil
+	case BarcodeMatchObjectTypeInstance:
+		*s = BarcodeMatchObjectTypeInstance
+		return nil
+	case BarcodeMatchObjectTypeTvBoard:
+		*s = BarcodeMatchObjectTypeTvBoard
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CancelPickWaveBadRequest ErrorContent
+
+func (*CancelPickWaveBadRequest) cancelPickWaveRes() {}
+
+type CancelPickWaveForbidden ErrorContent
+
+func (*CancelPickWaveForbidden) cancelPickWaveRes() {}
+
+type CancelPickWaveUnauthorized ErrorContent
+
+func (*CancelPickWaveUnauthorized) cancelPickWaveRes() {}
+
+type CancelTaskBadRequest ErrorContent
+
+func (*CancelTaskBadRequest) cancelTaskRes() {}
+
+// CancelTaskCreated is response for CancelTask operation.
+type CancelTaskCreated struct{}
+
+func (*CancelTaskCreated) cancelTaskRes() {}
+
+type CancelTaskForbidden ErrorContent
+
+func (*CancelTaskForbidden) cancelTaskRes() {}
+
+type CancelTaskUnauthorized ErrorContent
+
+func (*CancelTaskUnauthorized) cancelTaskRes() {}
+
+type CancelTransferOrderBadRequest ErrorContent
+
+func (*CancelTransferOrderBadRequest) cancelTransferOrderRes() {}
+
+type CancelTransferOrderForbidden ErrorContent
+
+func (*CancelTransferOrderForbidden) cancelTransferOrderRes() {}
+
+type CancelTransferOrderUnauthorized ErrorContent
+
+func (*CancelTransferOrderUnauthorized) cancelTransferOrderRes() {}
+
 // Merged schema.
 // Ref: #/components/schemas/Cell
 type Cell struct {
@@ -744,6 +1097,63 @@ func (s *CellGroup) SetUnitId(val uuid.UUID) {
 	s.UnitId = val
 }
 
+type ClaimTaskBadRequest ErrorContent
+
+func (*ClaimTaskBadRequest) claimTaskRes() {}
+
+type ClaimTaskForbidden ErrorContent
+
+func (*ClaimTaskForbidden) claimTaskRes() {}
+
+// ClaimTaskNoContent is response for ClaimTask operation.
+type ClaimTaskNoContent struct{}
+
+func (*ClaimTaskNoContent) claimTaskRes() {}
+
+type ClaimTaskUnauthorized ErrorContent
+
+func (*ClaimTaskUnauthorized) claimTaskRes() {}
+
+type CompletePickWaveBadRequest ErrorContent
+
+func (*CompletePickWaveBadRequest) completePickWaveRes() {}
+
+type CompletePickWaveForbidden ErrorContent
+
+func (*CompletePickWaveForbidden) completePickWaveRes() {}
+
+type CompletePickWaveUnauthorized ErrorContent
+
+func (*CompletePickWaveUnauthorized) completePickWaveRes() {}
+
+// Ref: #/components/schemas/CompleteTaskRequest
+type CompleteTaskRequest struct {
+	// Saved in the task status history.
+	Comment OptNilString `json:"comment"`
+	// Digits or scanned QR payload of the pickup code. Required for tasks holding one.
+	PickupCode OptString `json:"pickupCode"`
+}
+
+// GetComment returns the value of Comment.
+func (s *CompleteTaskRequest) GetComment() OptNilString {
+	return s.Comment
+}
+
+// GetPickupCode returns the value of PickupCode.
+func (s *CompleteTaskRequest) GetPickupCode() OptString {
+	return s.PickupCode
+}
+
+// SetComment sets the value of Comment.
+func (s *CompleteTaskRequest) SetComment(val OptNilString) {
+	s.Comment = val
+}
+
+// SetPickupCode sets the value of PickupCode.
+func (s *CompleteTaskRequest) SetPickupCode(val OptString) {
+	s.PickupCode = val
+}
+
 type Cookie struct {
 	APIKey string
 }
@@ -969,6 +1379,12 @@ func (*CreateInstanceForItemForbidden) createInstanceForItemRes() {}
 type CreateInstanceForItemRequest struct {
 	VariantId uuid.UUID  `json:"variantId"`
 	CellId    OptNilUUID `json:"cellId"`
+	// Manufacturer serial number, unique per variant. Required when the variant requires serials, kept
+	// on update when not given.
+	SerialNumber   OptNilString `json:"serialNumber"`
+	LotNumber      OptNilString `json:"lotNumber"`
+	ManufacturedAt OptNilDate   `json:"manufacturedAt"`
+	ExpiresAt      OptNilDate   `json:"expiresAt"`
 }
 
 // GetVariantId returns the value of VariantId.
@@ -981,6 +1397,26 @@ func (s *CreateInstanceForItemRequest) GetCellId() OptNilUUID {
 	return s.CellId
 }
 
+// GetSerialNumber returns the value of SerialNumber.
+func (s *CreateInstanceForItemRequest) GetSerialNumber() OptNilString {
+	return s.SerialNumber
+}
+
+// GetLotNumber returns the value of LotNumber.
+func (s *CreateInstanceForItemRequest) GetLotNumber() OptNilString {
+	return s.LotNumber
+}
+
+// GetManufacturedAt returns the value of ManufacturedAt.
+func (s *CreateInstanceForItemRequest) GetManufacturedAt() OptNilDate {
+	return s.ManufacturedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *CreateInstanceForItemRequest) GetExpiresAt() OptNilDate {
+	return s.ExpiresAt
+}
+
 // SetVariantId sets the value of VariantId.
 func (s *CreateInstanceForItemRequest) SetVariantId(val uuid.UUID) {
 	s.VariantId = val
@@ -991,6 +1427,26 @@ func (s *CreateInstanceForItemRequest) SetCellId(val OptNilUUID) {
 	s.CellId = val
 }
 
+// SetSerialNumber sets the value of SerialNumber.
+func (s *CreateInstanceForItemRequest) SetSerialNumber(val OptNilString) {
+	s.SerialNumber = val
+}
+
+// SetLotNumber sets the value of LotNumber.
+func (s *CreateInstanceForItemRequest) SetLotNumber(val OptNilString) {
+	s.LotNumber = val
+}
+
+// SetManufacturedAt sets the value of ManufacturedAt.
+func (s *CreateInstanceForItemRequest) SetManufacturedAt(val OptNilDate) {
+	s.ManufacturedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *CreateInstanceForItemRequest) SetExpiresAt(val OptNilDate) {
+	s.ExpiresAt = val
+}
+
 // Ref: #/components/schemas/CreateInstanceForItemResponse
 type CreateInstanceForItemResponse struct {
 	Data InstanceForItem `json:"data"`
@@ -1091,6 +1547,14 @@ type CreateItemVariantRequest struct {
 	Name    string       `json:"name"`
 	Article OptNilString `json:"article"`
 	Ean13   OptNilInt64  `json:"ean13"`
+	// Serialized variants are stocked as instances, quantity tracked ones as stock lines holding a
+	// quantity. Cannot change while the variant has stock.
+	TrackingMode OptCreateItemVariantRequestTrackingMode `json:"trackingMode"`
+	// Overrides the allocation policy of the organization, null inherits it.
+	AllocationPolicy OptNilCreateItemVariantRequestAllocationPolicy `json:"allocationPolicy"`
+	// Instances must get a serial number when created or received. Only serialized variants can require
+	// serials.
+	RequiresSerial OptBool `json:"requiresSerial"`
 }
 
 // GetName returns the value of Name.
@@ -1108,6 +1572,21 @@ func (s *CreateItemVariantRequest) GetEan13() OptNilInt64 {
 	return s.Ean13
 }
 
+// GetTrackingMode returns the value of TrackingMode.
+func (s *CreateItemVariantRequest) GetTrackingMode() OptCreateItemVariantRequestTrackingMode {
+	return s.TrackingMode
+}
+
+// GetAllocationPolicy returns the value of AllocationPolicy.
+func (s *CreateItemVariantRequest) GetAllocationPolicy() OptNilCreateItemVariantRequestAllocationPolicy {
+	return s.AllocationPolicy
+}
+
+// GetRequiresSerial returns the value of RequiresSerial.
+func (s *CreateItemVariantRequest) GetRequiresSerial() OptBool {
+	return s.RequiresSerial
+}
+
 // SetName sets the value of Name.
 func (s *CreateItemVariantRequest) SetName(val string) {
 	s.Name = val
@@ -1123,30 +1602,130 @@ func (s *CreateItemVariantRequest) SetEan13(val OptNilInt64) {
 	s.Ean13 = val
 }
 
-// Ref: #/components/schemas/CreateItemVariantResponse
-type CreateItemVariantResponse struct {
-	Data ItemVariant `json:"data"`
+// SetTrackingMode sets the value of TrackingMode.
+func (s *CreateItemVariantRequest) SetTrackingMode(val OptCreateItemVariantRequestTrackingMode) {
+	s.TrackingMode = val
 }
 
-// GetData returns the value of Data.
-func (s *CreateItemVariantResponse) GetData() ItemVariant {
-	return s.Data
+// SetAllocationPolicy sets the value of AllocationPolicy.
+func (s *CreateItemVariantRequest) SetAllocationPolicy(val OptNilCreateItemVariantRequestAllocationPolicy) {
+	s.AllocationPolicy = val
 }
 
-// SetData sets the value of Data.
-func (s *CreateItemVariantResponse) SetData(val ItemVariant) {
-	s.Data = val
+// SetRequiresSerial sets the value of RequiresSerial.
+func (s *CreateItemVariantRequest) SetRequiresSerial(val OptBool) {
+	s.RequiresSerial = val
 }
 
-func (*CreateItemVariantResponse) createItemVariantRes() {}
+// Overrides the allocation policy of the organization, null inherits it.
+type CreateItemVariantRequestAllocationPolicy string
 
-type CreateItemVariantUnauthorized ErrorContent
+const (
+	CreateItemVariantRequestAllocationPolicyFifo CreateItemVariantRequestAllocationPolicy = "fifo"
+	CreateItemVariantRequestAllocationPolicyFefo CreateItemVariantRequestAllocationPolicy = "fefo"
+)
 
-func (*CreateItemVariantUnauthorized) createItemVariantRes() {}
+// AllValues returns all CreateItemVariantRequestAllocationPolicy values.
+func (CreateItemVariantRequestAllocationPolicy) AllValues() []CreateItemVariantRequestAllocationPolicy {
+	return []CreateItemVariantRequestAllocationPolicy{
+		CreateItemVariantRequestAllocationPolicyFifo,
+		CreateItemVariantRequestAllocationPolicyFefo,
+	}
+}
 
-type CreateOrganizationBadRequest ErrorContent
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateItemVariantRequestAllocationPolicy) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateItemVariantRequestAllocationPolicyFifo:
+		return []byte(s), nil
+	case CreateItemVariantRequestAllocationPolicyFefo:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-func (*CreateOrganizationBadRequest) createOrganizationRes() {}
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateItemVariantRequestAllocationPolicy) UnmarshalText(data []byte) error {
+	switch CreateItemVariantRequestAllocationPolicy(data) {
+	case CreateItemVariantRequestAllocationPolicyFifo:
+		*s = CreateItemVariantRequestAllocationPolicyFifo
+		return nil
+	case CreateItemVariantRequestAllocationPolicyFefo:
+		*s = CreateItemVariantRequestAllocationPolicyFefo
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Serialized variants are stocked as instances, quantity tracked ones as stock lines holding a
+// quantity. Cannot change while the variant has stock.
+type CreateItemVariantRequestTrackingMode string
+
+const (
+	CreateItemVariantRequestTrackingModeSerialized CreateItemVariantRequestTrackingMode = "serialized"
+	CreateItemVariantRequestTrackingModeQuantity   CreateItemVariantRequestTrackingMode = "quantity"
+)
+
+// AllValues returns all CreateItemVariantRequestTrackingMode values.
+func (CreateItemVariantRequestTrackingMode) AllValues() []CreateItemVariantRequestTrackingMode {
+	return []CreateItemVariantRequestTrackingMode{
+		CreateItemVariantRequestTrackingModeSerialized,
+		CreateItemVariantRequestTrackingModeQuantity,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateItemVariantRequestTrackingMode) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateItemVariantRequestTrackingModeSerialized:
+		return []byte(s), nil
+	case CreateItemVariantRequestTrackingModeQuantity:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateItemVariantRequestTrackingMode) UnmarshalText(data []byte) error {
+	switch CreateItemVariantRequestTrackingMode(data) {
+	case CreateItemVariantRequestTrackingModeSerialized:
+		*s = CreateItemVariantRequestTrackingModeSerialized
+		return nil
+	case CreateItemVariantRequestTrackingModeQuantity:
+		*s = CreateItemVariantRequestTrackingModeQuantity
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CreateItemVariantResponse
+type CreateItemVariantResponse struct {
+	Data ItemVariant `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *CreateItemVariantResponse) GetData() ItemVariant {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *CreateItemVariantResponse) SetData(val ItemVariant) {
+	s.Data = val
+}
+
+func (*CreateItemVariantResponse) createItemVariantRes() {}
+
+type CreateItemVariantUnauthorized ErrorContent
+
+func (*CreateItemVariantUnauthorized) createItemVariantRes() {}
+
+type CreateOrganizationBadRequest ErrorContent
+
+func (*CreateOrganizationBadRequest) createOrganizationRes() {}
 
 type CreateOrganizationConflict ErrorContent
 
@@ -1216,6 +1795,134 @@ func (s *CreateOrganizationUnitResponse) SetData(val Unit) {
 
 func (*CreateOrganizationUnitResponse) createUnitRes() {}
 
+type CreatePickWaveBadRequest ErrorContent
+
+func (*CreatePickWaveBadRequest) createPickWaveRes() {}
+
+type CreatePickWaveForbidden ErrorContent
+
+func (*CreatePickWaveForbidden) createPickWaveRes() {}
+
+// Ref: #/components/schemas/CreatePickWaveRequest
+type CreatePickWaveRequest struct {
+	UnitId uuid.UUID `json:"unitId"`
+	Name   string    `json:"name"`
+	// Cell in the unit where picked instances are sorted back to their tasks.
+	ConsolidationCellId uuid.UUID `json:"consolidationCellId"`
+	// Pending pickment tasks of the unit. When omitted the planner takes open pickment tasks by priority
+	// and due date.
+	TaskIds []uuid.UUID `json:"taskIds"`
+	// Maximum number of tasks taken by the planner.
+	MaxTasks OptInt `json:"maxTasks"`
+}
+
+// GetUnitId returns the value of UnitId.
+func (s *CreatePickWaveRequest) GetUnitId() uuid.UUID {
+	return s.UnitId
+}
+
+// GetName returns the value of Name.
+func (s *CreatePickWaveRequest) GetName() string {
+	return s.Name
+}
+
+// GetConsolidationCellId returns the value of ConsolidationCellId.
+func (s *CreatePickWaveRequest) GetConsolidationCellId() uuid.UUID {
+	return s.ConsolidationCellId
+}
+
+// GetTaskIds returns the value of TaskIds.
+func (s *CreatePickWaveRequest) GetTaskIds() []uuid.UUID {
+	return s.TaskIds
+}
+
+// GetMaxTasks returns the value of MaxTasks.
+func (s *CreatePickWaveRequest) GetMaxTasks() OptInt {
+	return s.MaxTasks
+}
+
+// SetUnitId sets the value of UnitId.
+func (s *CreatePickWaveRequest) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
+
+// SetName sets the value of Name.
+func (s *CreatePickWaveRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetConsolidationCellId sets the value of ConsolidationCellId.
+func (s *CreatePickWaveRequest) SetConsolidationCellId(val uuid.UUID) {
+	s.ConsolidationCellId = val
+}
+
+// SetTaskIds sets the value of TaskIds.
+func (s *CreatePickWaveRequest) SetTaskIds(val []uuid.UUID) {
+	s.TaskIds = val
+}
+
+// SetMaxTasks sets the value of MaxTasks.
+func (s *CreatePickWaveRequest) SetMaxTasks(val OptInt) {
+	s.MaxTasks = val
+}
+
+type CreatePickWaveUnauthorized ErrorContent
+
+func (*CreatePickWaveUnauthorized) createPickWaveRes() {}
+
+type CreateStockLineBadRequest ErrorContent
+
+func (*CreateStockLineBadRequest) createStockLineRes() {}
+
+type CreateStockLineForbidden ErrorContent
+
+func (*CreateStockLineForbidden) createStockLineRes() {}
+
+type CreateStockLineNotFound ErrorContent
+
+func (*CreateStockLineNotFound) createStockLineRes() {}
+
+// Ref: #/components/schemas/CreateStockLineRequest
+type CreateStockLineRequest struct {
+	VariantId uuid.UUID `json:"variantId"`
+	CellId    uuid.UUID `json:"cellId"`
+	Quantity  int       `json:"quantity"`
+}
+
+// GetVariantId returns the value of VariantId.
+func (s *CreateStockLineRequest) GetVariantId() uuid.UUID {
+	return s.VariantId
+}
+
+// GetCellId returns the value of CellId.
+func (s *CreateStockLineRequest) GetCellId() uuid.UUID {
+	return s.CellId
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *CreateStockLineRequest) GetQuantity() int {
+	return s.Quantity
+}
+
+// SetVariantId sets the value of VariantId.
+func (s *CreateStockLineRequest) SetVariantId(val uuid.UUID) {
+	s.VariantId = val
+}
+
+// SetCellId sets the value of CellId.
+func (s *CreateStockLineRequest) SetCellId(val uuid.UUID) {
+	s.CellId = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CreateStockLineRequest) SetQuantity(val int) {
+	s.Quantity = val
+}
+
+type CreateStockLineUnauthorized ErrorContent
+
+func (*CreateStockLineUnauthorized) createStockLineRes() {}
+
 type CreateStorageGroupBadRequest ErrorContent
 
 func (*CreateStorageGroupBadRequest) createStorageGroupRes() {}
@@ -1249,6 +1956,49 @@ type CreateTaskBadRequest ErrorContent
 
 func (*CreateTaskBadRequest) createTaskRes() {}
 
+type CreateTaskCommentBadRequest ErrorContent
+
+func (*CreateTaskCommentBadRequest) createTaskCommentRes() {}
+
+type CreateTaskCommentForbidden ErrorContent
+
+func (*CreateTaskCommentForbidden) createTaskCommentRes() {}
+
+type CreateTaskCommentNotFound ErrorContent
+
+func (*CreateTaskCommentNotFound) createTaskCommentRes() {}
+
+// Ref: #/components/schemas/CreateTaskCommentRequest
+type CreateTaskCommentRequest struct {
+	Body string `json:"body"`
+	// Instance of the task item to comment on, the whole task when omitted.
+	InstanceId OptUUID `json:"instanceId"`
+}
+
+// GetBody returns the value of Body.
+func (s *CreateTaskCommentRequest) GetBody() string {
+	return s.Body
+}
+
+// GetInstanceId returns the value of InstanceId.
+func (s *CreateTaskCommentRequest) GetInstanceId() OptUUID {
+	return s.InstanceId
+}
+
+// SetBody sets the value of Body.
+func (s *CreateTaskCommentRequest) SetBody(val string) {
+	s.Body = val
+}
+
+// SetInstanceId sets the value of InstanceId.
+func (s *CreateTaskCommentRequest) SetInstanceId(val OptUUID) {
+	s.InstanceId = val
+}
+
+type CreateTaskCommentUnauthorized ErrorContent
+
+func (*CreateTaskCommentUnauthorized) createTaskCommentRes() {}
+
 type CreateTaskForbidden ErrorContent
 
 func (*CreateTaskForbidden) createTaskRes() {}
@@ -1259,8 +2009,18 @@ type CreateTaskRequest struct {
 	Description OptNilString                 `json:"description"`
 	Type        CreateTaskRequestType        `json:"type"`
 	UnitId      uuid.UUID                    `json:"unitId"`
+	Priority    OptCreateTaskRequestPriority `json:"priority"`
+	DueAt       OptNilDateTime               `json:"dueAt"`
 	AssignedTo  OptNilUUID                   `json:"assignedTo"`
 	Items       []CreateTaskRequestItemsItem `json:"items"`
+	// Cell where received goods are put. Required for receiving tasks.
+	ReceivingCellId OptNilUUID `json:"receivingCellId"`
+	// Manifest of expected goods. Required for receiving tasks.
+	ExpectedItems []CreateTaskRequestExpectedItemsItem `json:"expectedItems"`
+	// Completed pickment task the goods are returned from. Required for return tasks.
+	OriginalTaskId OptNilUUID `json:"originalTaskId"`
+	// Cells to count. Required for inventory count tasks, exactly one field must be set.
+	CountTarget OptNilCreateTaskRequestCountTarget `json:"countTarget"`
 }
 
 // GetName returns the value of Name.
@@ -1283,6 +2043,16 @@ func (s *CreateTaskRequest) GetUnitId() uuid.UUID {
 	return s.UnitId
 }
 
+// GetPriority returns the value of Priority.
+func (s *CreateTaskRequest) GetPriority() OptCreateTaskRequestPriority {
+	return s.Priority
+}
+
+// GetDueAt returns the value of DueAt.
+func (s *CreateTaskRequest) GetDueAt() OptNilDateTime {
+	return s.DueAt
+}
+
 // GetAssignedTo returns the value of AssignedTo.
 func (s *CreateTaskRequest) GetAssignedTo() OptNilUUID {
 	return s.AssignedTo
@@ -1293,6 +2063,26 @@ func (s *CreateTaskRequest) GetItems() []CreateTaskRequestItemsItem {
 	return s.Items
 }
 
+// GetReceivingCellId returns the value of ReceivingCellId.
+func (s *CreateTaskRequest) GetReceivingCellId() OptNilUUID {
+	return s.ReceivingCellId
+}
+
+// GetExpectedItems returns the value of ExpectedItems.
+func (s *CreateTaskRequest) GetExpectedItems() []CreateTaskRequestExpectedItemsItem {
+	return s.ExpectedItems
+}
+
+// GetOriginalTaskId returns the value of OriginalTaskId.
+func (s *CreateTaskRequest) GetOriginalTaskId() OptNilUUID {
+	return s.OriginalTaskId
+}
+
+// GetCountTarget returns the value of CountTarget.
+func (s *CreateTaskRequest) GetCountTarget() OptNilCreateTaskRequestCountTarget {
+	return s.CountTarget
+}
+
 // SetName sets the value of Name.
 func (s *CreateTaskRequest) SetName(val string) {
 	s.Name = val
@@ -1313,6 +2103,16 @@ func (s *CreateTaskRequest) SetUnitId(val uuid.UUID) {
 	s.UnitId = val
 }
 
+// SetPriority sets the value of Priority.
+func (s *CreateTaskRequest) SetPriority(val OptCreateTaskRequestPriority) {
+	s.Priority = val
+}
+
+// SetDueAt sets the value of DueAt.
+func (s *CreateTaskRequest) SetDueAt(val OptNilDateTime) {
+	s.DueAt = val
+}
+
 // SetAssignedTo sets the value of AssignedTo.
 func (s *CreateTaskRequest) SetAssignedTo(val OptNilUUID) {
 	s.AssignedTo = val
@@ -1323,36 +2123,201 @@ func (s *CreateTaskRequest) SetItems(val []CreateTaskRequestItemsItem) {
 	s.Items = val
 }
 
+// SetReceivingCellId sets the value of ReceivingCellId.
+func (s *CreateTaskRequest) SetReceivingCellId(val OptNilUUID) {
+	s.ReceivingCellId = val
+}
+
+// SetExpectedItems sets the value of ExpectedItems.
+func (s *CreateTaskRequest) SetExpectedItems(val []CreateTaskRequestExpectedItemsItem) {
+	s.ExpectedItems = val
+}
+
+// SetOriginalTaskId sets the value of OriginalTaskId.
+func (s *CreateTaskRequest) SetOriginalTaskId(val OptNilUUID) {
+	s.OriginalTaskId = val
+}
+
+// SetCountTarget sets the value of CountTarget.
+func (s *CreateTaskRequest) SetCountTarget(val OptNilCreateTaskRequestCountTarget) {
+	s.CountTarget = val
+}
+
+// Cells to count. Required for inventory count tasks, exactly one field must be set.
+type CreateTaskRequestCountTarget struct {
+	CellsGroupId   OptUUID     `json:"cellsGroupId"`
+	StorageGroupId OptUUID     `json:"storageGroupId"`
+	CellIds        []uuid.UUID `json:"cellIds"`
+}
+
+// GetCellsGroupId returns the value of CellsGroupId.
+func (s *CreateTaskRequestCountTarget) GetCellsGroupId() OptUUID {
+	return s.CellsGroupId
+}
+
+// GetStorageGroupId returns the value of StorageGroupId.
+func (s *CreateTaskRequestCountTarget) GetStorageGroupId() OptUUID {
+	return s.StorageGroupId
+}
+
+// GetCellIds returns the value of CellIds.
+func (s *CreateTaskRequestCountTarget) GetCellIds() []uuid.UUID {
+	return s.CellIds
+}
+
+// SetCellsGroupId sets the value of CellsGroupId.
+func (s *CreateTaskRequestCountTarget) SetCellsGroupId(val OptUUID) {
+	s.CellsGroupId = val
+}
+
+// SetStorageGroupId sets the value of StorageGroupId.
+func (s *CreateTaskRequestCountTarget) SetStorageGroupId(val OptUUID) {
+	s.StorageGroupId = val
+}
+
+// SetCellIds sets the value of CellIds.
+func (s *CreateTaskRequestCountTarget) SetCellIds(val []uuid.UUID) {
+	s.CellIds = val
+}
+
+type CreateTaskRequestExpectedItemsItem struct {
+	VariantId uuid.UUID `json:"variantId"`
+	Quantity  int       `json:"quantity"`
+}
+
+// GetVariantId returns the value of VariantId.
+func (s *CreateTaskRequestExpectedItemsItem) GetVariantId() uuid.UUID {
+	return s.VariantId
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *CreateTaskRequestExpectedItemsItem) GetQuantity() int {
+	return s.Quantity
+}
+
+// SetVariantId sets the value of VariantId.
+func (s *CreateTaskRequestExpectedItemsItem) SetVariantId(val uuid.UUID) {
+	s.VariantId = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CreateTaskRequestExpectedItemsItem) SetQuantity(val int) {
+	s.Quantity = val
+}
+
 type CreateTaskRequestItemsItem struct {
-	InstanceId   uuid.UUID `json:"instanceId"`
-	TargetCellId OptUUID   `json:"targetCellId"`
+	InstanceId OptUUID `json:"instanceId"`
+	// Stock line to take the quantity off, only for pickment and movement tasks. Exactly one of
+	// instanceId and stockLineId must be set.
+	StockLineId OptUUID `json:"stockLineId"`
+	// Quantity taken off the stock line.
+	Quantity     OptInt  `json:"quantity"`
+	TargetCellId OptUUID `json:"targetCellId"`
 }
 
 // GetInstanceId returns the value of InstanceId.
-func (s *CreateTaskRequestItemsItem) GetInstanceId() uuid.UUID {
+func (s *CreateTaskRequestItemsItem) GetInstanceId() OptUUID {
 	return s.InstanceId
 }
 
+// GetStockLineId returns the value of StockLineId.
+func (s *CreateTaskRequestItemsItem) GetStockLineId() OptUUID {
+	return s.StockLineId
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *CreateTaskRequestItemsItem) GetQuantity() OptInt {
+	return s.Quantity
+}
+
 // GetTargetCellId returns the value of TargetCellId.
 func (s *CreateTaskRequestItemsItem) GetTargetCellId() OptUUID {
 	return s.TargetCellId
 }
 
 // SetInstanceId sets the value of InstanceId.
-func (s *CreateTaskRequestItemsItem) SetInstanceId(val uuid.UUID) {
+func (s *CreateTaskRequestItemsItem) SetInstanceId(val OptUUID) {
 	s.InstanceId = val
 }
 
+// SetStockLineId sets the value of StockLineId.
+func (s *CreateTaskRequestItemsItem) SetStockLineId(val OptUUID) {
+	s.StockLineId = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CreateTaskRequestItemsItem) SetQuantity(val OptInt) {
+	s.Quantity = val
+}
+
 // SetTargetCellId sets the value of TargetCellId.
 func (s *CreateTaskRequestItemsItem) SetTargetCellId(val OptUUID) {
 	s.TargetCellId = val
 }
 
+type CreateTaskRequestPriority string
+
+const (
+	CreateTaskRequestPriorityLow    CreateTaskRequestPriority = "low"
+	CreateTaskRequestPriorityNormal CreateTaskRequestPriority = "normal"
+	CreateTaskRequestPriorityHigh   CreateTaskRequestPriority = "high"
+	CreateTaskRequestPriorityUrgent CreateTaskRequestPriority = "urgent"
+)
+
+// AllValues returns all CreateTaskRequestPriority values.
+func (CreateTaskRequestPriority) AllValues() []CreateTaskRequestPriority {
+	return []CreateTaskRequestPriority{
+		CreateTaskRequestPriorityLow,
+		CreateTaskRequestPriorityNormal,
+		CreateTaskRequestPriorityHigh,
+		CreateTaskRequestPriorityUrgent,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateTaskRequestPriority) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateTaskRequestPriorityLow:
+		return []byte(s), nil
+	case CreateTaskRequestPriorityNormal:
+		return []byte(s), nil
+	case CreateTaskRequestPriorityHigh:
+		return []byte(s), nil
+	case CreateTaskRequestPriorityUrgent:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateTaskRequestPriority) UnmarshalText(data []byte) error {
+	switch CreateTaskRequestPriority(data) {
+	case CreateTaskRequestPriorityLow:
+		*s = CreateTaskRequestPriorityLow
+		return nil
+	case CreateTaskRequestPriorityNormal:
+		*s = CreateTaskRequestPriorityNormal
+		return nil
+	case CreateTaskRequestPriorityHigh:
+		*s = CreateTaskRequestPriorityHigh
+		return nil
+	case CreateTaskRequestPriorityUrgent:
+		*s = CreateTaskRequestPriorityUrgent
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type CreateTaskRequestType string
 
 const (
-	CreateTaskRequestTypePickment CreateTaskRequestType = "pickment"
-	CreateTaskRequestTypeMovement CreateTaskRequestType = "movement"
+	CreateTaskRequestTypePickment       CreateTaskRequestType = "pickment"
+	CreateTaskRequestTypeMovement       CreateTaskRequestType = "movement"
+	CreateTaskRequestTypeReceiving      CreateTaskRequestType = "receiving"
+	CreateTaskRequestTypeInventoryCount CreateTaskRequestType = "inventory_count"
+	CreateTaskRequestTypeReturn         CreateTaskRequestType = "return"
 )
 
 // AllValues returns all CreateTaskRequestType values.
@@ -1360,6 +2325,9 @@ func (CreateTaskRequestType) AllValues() []CreateTaskRequestType {
 	return []CreateTaskRequestType{
 		CreateTaskRequestTypePickment,
 		CreateTaskRequestTypeMovement,
+		CreateTaskRequestTypeReceiving,
+		CreateTaskRequestTypeInventoryCount,
+		CreateTaskRequestTypeReturn,
 	}
 }
 
@@ -1370,6 +2338,12 @@ func (s CreateTaskRequestType) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case CreateTaskRequestTypeMovement:
 		return []byte(s), nil
+	case CreateTaskRequestTypeReceiving:
+		return []byte(s), nil
+	case CreateTaskRequestTypeInventoryCount:
+		return []byte(s), nil
+	case CreateTaskRequestTypeReturn:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -1384,6 +2358,15 @@ func (s *CreateTaskRequestType) UnmarshalText(data []byte) error {
 	case CreateTaskRequestTypeMovement:
 		*s = CreateTaskRequestTypeMovement
 		return nil
+	case CreateTaskRequestTypeReceiving:
+		*s = CreateTaskRequestTypeReceiving
+		return nil
+	case CreateTaskRequestTypeInventoryCount:
+		*s = CreateTaskRequestTypeInventoryCount
+		return nil
+	case CreateTaskRequestTypeReturn:
+		*s = CreateTaskRequestTypeReturn
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -1406,19 +2389,318 @@ func (s *CreateTaskResponse) SetData(val TaskFull) {
 
 func (*CreateTaskResponse) createTaskRes() {}
 
-type CreateTaskUnauthorized ErrorContent
-
-func (*CreateTaskUnauthorized) createTaskRes() {}
+type CreateTaskTemplateBadRequest ErrorContent
 
-type CreateTvBoardBadRequest ErrorContent
+func (*CreateTaskTemplateBadRequest) createTaskTemplateRes() {}
 
-func (*CreateTvBoardBadRequest) createTvBoardRes() {}
+type CreateTaskTemplateForbidden ErrorContent
 
-type CreateTvBoardForbidden ErrorContent
+func (*CreateTaskTemplateForbidden) createTaskTemplateRes() {}
 
-func (*CreateTvBoardForbidden) createTvBoardRes() {}
+// Ref: #/components/schemas/CreateTaskTemplateRequest
+type CreateTaskTemplateRequest struct {
+	UnitId      uuid.UUID                            `json:"unitId"`
+	Name        string                               `json:"name"`
+	Description OptNilString                         `json:"description"`
+	Type        CreateTaskTemplateRequestType        `json:"type"`
+	Priority    OptCreateTaskTemplateRequestPriority `json:"priority"`
+	// Assignee of created tasks.
+	AssignedToUserId OptNilUUID `json:"assignedToUserId"`
+	// Due date of created tasks relative to their creation.
+	DueInMinutes OptNilInt `json:"dueInMinutes"`
+	// Five field cron expression (minute, hour, day of month, month, day of week) evaluated in UTC.
+	// Tasks are created only manually when omitted.
+	Schedule OptNilString `json:"schedule"`
+	// Inactive templates are skipped by the scheduler.
+	IsActive OptBool            `json:"isActive"`
+	Rules    []TaskTemplateRule `json:"rules"`
+}
 
-// Ref: #/components/schemas/CreateTvBoardRequest
+// GetUnitId returns the value of UnitId.
+func (s *CreateTaskTemplateRequest) GetUnitId() uuid.UUID {
+	return s.UnitId
+}
+
+// GetName returns the value of Name.
+func (s *CreateTaskTemplateRequest) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateTaskTemplateRequest) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetType returns the value of Type.
+func (s *CreateTaskTemplateRequest) GetType() CreateTaskTemplateRequestType {
+	return s.Type
+}
+
+// GetPriority returns the value of Priority.
+func (s *CreateTaskTemplateRequest) GetPriority() OptCreateTaskTemplateRequestPriority {
+	return s.Priority
+}
+
+// GetAssignedToUserId returns the value of AssignedToUserId.
+func (s *CreateTaskTemplateRequest) GetAssignedToUserId() OptNilUUID {
+	return s.AssignedToUserId
+}
+
+// GetDueInMinutes returns the value of DueInMinutes.
+func (s *CreateTaskTemplateRequest) GetDueInMinutes() OptNilInt {
+	return s.DueInMinutes
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *CreateTaskTemplateRequest) GetSchedule() OptNilString {
+	return s.Schedule
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *CreateTaskTemplateRequest) GetIsActive() OptBool {
+	return s.IsActive
+}
+
+// GetRules returns the value of Rules.
+func (s *CreateTaskTemplateRequest) GetRules() []TaskTemplateRule {
+	return s.Rules
+}
+
+// SetUnitId sets the value of UnitId.
+func (s *CreateTaskTemplateRequest) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
+
+// SetName sets the value of Name.
+func (s *CreateTaskTemplateRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateTaskTemplateRequest) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetType sets the value of Type.
+func (s *CreateTaskTemplateRequest) SetType(val CreateTaskTemplateRequestType) {
+	s.Type = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *CreateTaskTemplateRequest) SetPriority(val OptCreateTaskTemplateRequestPriority) {
+	s.Priority = val
+}
+
+// SetAssignedToUserId sets the value of AssignedToUserId.
+func (s *CreateTaskTemplateRequest) SetAssignedToUserId(val OptNilUUID) {
+	s.AssignedToUserId = val
+}
+
+// SetDueInMinutes sets the value of DueInMinutes.
+func (s *CreateTaskTemplateRequest) SetDueInMinutes(val OptNilInt) {
+	s.DueInMinutes = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *CreateTaskTemplateRequest) SetSchedule(val OptNilString) {
+	s.Schedule = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *CreateTaskTemplateRequest) SetIsActive(val OptBool) {
+	s.IsActive = val
+}
+
+// SetRules sets the value of Rules.
+func (s *CreateTaskTemplateRequest) SetRules(val []TaskTemplateRule) {
+	s.Rules = val
+}
+
+type CreateTaskTemplateRequestPriority string
+
+const (
+	CreateTaskTemplateRequestPriorityLow    CreateTaskTemplateRequestPriority = "low"
+	CreateTaskTemplateRequestPriorityNormal CreateTaskTemplateRequestPriority = "normal"
+	CreateTaskTemplateRequestPriorityHigh   CreateTaskTemplateRequestPriority = "high"
+	CreateTaskTemplateRequestPriorityUrgent CreateTaskTemplateRequestPriority = "urgent"
+)
+
+// AllValues returns all CreateTaskTemplateRequestPriority values.
+func (CreateTaskTemplateRequestPriority) AllValues() []CreateTaskTemplateRequestPriority {
+	return []CreateTaskTemplateRequestPriority{
+		CreateTaskTemplateRequestPriorityLow,
+		CreateTaskTemplateRequestPriorityNormal,
+		CreateTaskTemplateRequestPriorityHigh,
+		CreateTaskTemplateRequestPriorityUrgent,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateTaskTemplateRequestPriority) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateTaskTemplateRequestPriorityLow:
+		return []byte(s), nil
+	case CreateTaskTemplateRequestPriorityNormal:
+		return []byte(s), nil
+	case CreateTaskTemplateRequestPriorityHigh:
+		return []byte(s), nil
+	case CreateTaskTemplateRequestPriorityUrgent:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateTaskTemplateRequestPriority) UnmarshalText(data []byte) error {
+	switch CreateTaskTemplateRequestPriority(data) {
+	case CreateTaskTemplateRequestPriorityLow:
+		*s = CreateTaskTemplateRequestPriorityLow
+		return nil
+	case CreateTaskTemplateRequestPriorityNormal:
+		*s = CreateTaskTemplateRequestPriorityNormal
+		return nil
+	case CreateTaskTemplateRequestPriorityHigh:
+		*s = CreateTaskTemplateRequestPriorityHigh
+		return nil
+	case CreateTaskTemplateRequestPriorityUrgent:
+		*s = CreateTaskTemplateRequestPriorityUrgent
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CreateTaskTemplateRequestType string
+
+const (
+	CreateTaskTemplateRequestTypePickment CreateTaskTemplateRequestType = "pickment"
+	CreateTaskTemplateRequestTypeMovement CreateTaskTemplateRequestType = "movement"
+)
+
+// AllValues returns all CreateTaskTemplateRequestType values.
+func (CreateTaskTemplateRequestType) AllValues() []CreateTaskTemplateRequestType {
+	return []CreateTaskTemplateRequestType{
+		CreateTaskTemplateRequestTypePickment,
+		CreateTaskTemplateRequestTypeMovement,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateTaskTemplateRequestType) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateTaskTemplateRequestTypePickment:
+		return []byte(s), nil
+	case CreateTaskTemplateRequestTypeMovement:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateTaskTemplateRequestType) UnmarshalText(data []byte) error {
+	switch CreateTaskTemplateRequestType(data) {
+	case CreateTaskTemplateRequestTypePickment:
+		*s = CreateTaskTemplateRequestTypePickment
+		return nil
+	case CreateTaskTemplateRequestTypeMovement:
+		*s = CreateTaskTemplateRequestTypeMovement
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CreateTaskTemplateUnauthorized ErrorContent
+
+func (*CreateTaskTemplateUnauthorized) createTaskTemplateRes() {}
+
+type CreateTaskUnauthorized ErrorContent
+
+func (*CreateTaskUnauthorized) createTaskRes() {}
+
+type CreateTransferOrderBadRequest ErrorContent
+
+func (*CreateTransferOrderBadRequest) createTransferOrderRes() {}
+
+type CreateTransferOrderForbidden ErrorContent
+
+func (*CreateTransferOrderForbidden) createTransferOrderRes() {}
+
+// Ref: #/components/schemas/CreateTransferOrderRequest
+type CreateTransferOrderRequest struct {
+	Name              string    `json:"name"`
+	SourceUnitId      uuid.UUID `json:"sourceUnitId"`
+	DestinationUnitId uuid.UUID `json:"destinationUnitId"`
+	// Cell in the destination unit the instances are received into.
+	ReceivingCellId uuid.UUID   `json:"receivingCellId"`
+	InstanceIds     []uuid.UUID `json:"instanceIds"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateTransferOrderRequest) GetName() string {
+	return s.Name
+}
+
+// GetSourceUnitId returns the value of SourceUnitId.
+func (s *CreateTransferOrderRequest) GetSourceUnitId() uuid.UUID {
+	return s.SourceUnitId
+}
+
+// GetDestinationUnitId returns the value of DestinationUnitId.
+func (s *CreateTransferOrderRequest) GetDestinationUnitId() uuid.UUID {
+	return s.DestinationUnitId
+}
+
+// GetReceivingCellId returns the value of ReceivingCellId.
+func (s *CreateTransferOrderRequest) GetReceivingCellId() uuid.UUID {
+	return s.ReceivingCellId
+}
+
+// GetInstanceIds returns the value of InstanceIds.
+func (s *CreateTransferOrderRequest) GetInstanceIds() []uuid.UUID {
+	return s.InstanceIds
+}
+
+// SetName sets the value of Name.
+func (s *CreateTransferOrderRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetSourceUnitId sets the value of SourceUnitId.
+func (s *CreateTransferOrderRequest) SetSourceUnitId(val uuid.UUID) {
+	s.SourceUnitId = val
+}
+
+// SetDestinationUnitId sets the value of DestinationUnitId.
+func (s *CreateTransferOrderRequest) SetDestinationUnitId(val uuid.UUID) {
+	s.DestinationUnitId = val
+}
+
+// SetReceivingCellId sets the value of ReceivingCellId.
+func (s *CreateTransferOrderRequest) SetReceivingCellId(val uuid.UUID) {
+	s.ReceivingCellId = val
+}
+
+// SetInstanceIds sets the value of InstanceIds.
+func (s *CreateTransferOrderRequest) SetInstanceIds(val []uuid.UUID) {
+	s.InstanceIds = val
+}
+
+type CreateTransferOrderUnauthorized ErrorContent
+
+func (*CreateTransferOrderUnauthorized) createTransferOrderRes() {}
+
+type CreateTvBoardBadRequest ErrorContent
+
+func (*CreateTvBoardBadRequest) createTvBoardRes() {}
+
+type CreateTvBoardForbidden ErrorContent
+
+func (*CreateTvBoardForbidden) createTvBoardRes() {}
+
+// Ref: #/components/schemas/CreateTvBoardRequest
 type CreateTvBoardRequest struct {
 	// The name of the TV Board.
 	Name string `json:"name"`
@@ -1650,6 +2932,57 @@ type DeleteStorageGroupUnauthorized ErrorContent
 
 func (*DeleteStorageGroupUnauthorized) deleteStorageGroupRes() {}
 
+type DeleteTaskAttachmentForbidden ErrorContent
+
+func (*DeleteTaskAttachmentForbidden) deleteTaskAttachmentRes() {}
+
+// DeleteTaskAttachmentNoContent is response for DeleteTaskAttachment operation.
+type DeleteTaskAttachmentNoContent struct{}
+
+func (*DeleteTaskAttachmentNoContent) deleteTaskAttachmentRes() {}
+
+type DeleteTaskAttachmentNotFound ErrorContent
+
+func (*DeleteTaskAttachmentNotFound) deleteTaskAttachmentRes() {}
+
+type DeleteTaskAttachmentUnauthorized ErrorContent
+
+func (*DeleteTaskAttachmentUnauthorized) deleteTaskAttachmentRes() {}
+
+type DeleteTaskCommentForbidden ErrorContent
+
+func (*DeleteTaskCommentForbidden) deleteTaskCommentRes() {}
+
+// DeleteTaskCommentNoContent is response for DeleteTaskComment operation.
+type DeleteTaskCommentNoContent struct{}
+
+func (*DeleteTaskCommentNoContent) deleteTaskCommentRes() {}
+
+type DeleteTaskCommentNotFound ErrorContent
+
+func (*DeleteTaskCommentNotFound) deleteTaskCommentRes() {}
+
+type DeleteTaskCommentUnauthorized ErrorContent
+
+func (*DeleteTaskCommentUnauthorized) deleteTaskCommentRes() {}
+
+type DeleteTaskTemplateForbidden ErrorContent
+
+func (*DeleteTaskTemplateForbidden) deleteTaskTemplateRes() {}
+
+// DeleteTaskTemplateNoContent is response for DeleteTaskTemplate operation.
+type DeleteTaskTemplateNoContent struct{}
+
+func (*DeleteTaskTemplateNoContent) deleteTaskTemplateRes() {}
+
+type DeleteTaskTemplateNotFound ErrorContent
+
+func (*DeleteTaskTemplateNotFound) deleteTaskTemplateRes() {}
+
+type DeleteTaskTemplateUnauthorized ErrorContent
+
+func (*DeleteTaskTemplateUnauthorized) deleteTaskTemplateRes() {}
+
 type DeleteTvBoardForbidden ErrorContent
 
 func (*DeleteTvBoardForbidden) deleteTvBoardRes() {}
@@ -1667,6 +3000,34 @@ type DeleteTvBoardUnauthorized ErrorContent
 
 func (*DeleteTvBoardUnauthorized) deleteTvBoardRes() {}
 
+type DownloadTaskAttachmentForbidden ErrorContent
+
+func (*DownloadTaskAttachmentForbidden) downloadTaskAttachmentRes() {}
+
+type DownloadTaskAttachmentNotFound ErrorContent
+
+func (*DownloadTaskAttachmentNotFound) downloadTaskAttachmentRes() {}
+
+type DownloadTaskAttachmentOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DownloadTaskAttachmentOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*DownloadTaskAttachmentOK) downloadTaskAttachmentRes() {}
+
+type DownloadTaskAttachmentUnauthorized ErrorContent
+
+func (*DownloadTaskAttachmentUnauthorized) downloadTaskAttachmentRes() {}
+
 // Ref: #/components/schemas/Employee
 type Employee struct {
 	UserId     uuid.UUID `json:"userId"`
@@ -1866,6 +3227,71 @@ func (s *ExchangeYandexAccessTokenReq) SetAccessToken(val string) {
 	s.AccessToken = val
 }
 
+// Data of the GS1-128 application identifiers of the code, null for other codes.
+// Ref: #/components/schemas/GS1Data
+type GS1Data struct {
+	// GTIN-14, application identifier (01).
+	Gtin NilString `json:"gtin"`
+	// Batch or lot number, application identifier (10).
+	LotNumber NilString `json:"lotNumber"`
+	// Production date, application identifier (11).
+	ManufacturedAt NilDate `json:"manufacturedAt"`
+	// Expiration date (17), or the best before date (15) when absent.
+	ExpiresAt NilDate `json:"expiresAt"`
+	// Serial number, application identifier (21).
+	SerialNumber NilString `json:"serialNumber"`
+}
+
+// GetGtin returns the value of Gtin.
+func (s *GS1Data) GetGtin() NilString {
+	return s.Gtin
+}
+
+// GetLotNumber returns the value of LotNumber.
+func (s *GS1Data) GetLotNumber() NilString {
+	return s.LotNumber
+}
+
+// GetManufacturedAt returns the value of ManufacturedAt.
+func (s *GS1Data) GetManufacturedAt() NilDate {
+	return s.ManufacturedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *GS1Data) GetExpiresAt() NilDate {
+	return s.ExpiresAt
+}
+
+// GetSerialNumber returns the value of SerialNumber.
+func (s *GS1Data) GetSerialNumber() NilString {
+	return s.SerialNumber
+}
+
+// SetGtin sets the value of Gtin.
+func (s *GS1Data) SetGtin(val NilString) {
+	s.Gtin = val
+}
+
+// SetLotNumber sets the value of LotNumber.
+func (s *GS1Data) SetLotNumber(val NilString) {
+	s.LotNumber = val
+}
+
+// SetManufacturedAt sets the value of ManufacturedAt.
+func (s *GS1Data) SetManufacturedAt(val NilDate) {
+	s.ManufacturedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *GS1Data) SetExpiresAt(val NilDate) {
+	s.ExpiresAt = val
+}
+
+// SetSerialNumber sets the value of SerialNumber.
+func (s *GS1Data) SetSerialNumber(val NilString) {
+	s.SerialNumber = val
+}
+
 type GetApiTokensForbidden ErrorContent
 
 func (*GetApiTokensForbidden) getApiTokensRes() {}
@@ -2161,6 +3587,18 @@ type GetEmployeesUnauthorized ErrorContent
 
 func (*GetEmployeesUnauthorized) getEmployeesRes() {}
 
+type GetExpiringInstancesBadRequest ErrorContent
+
+func (*GetExpiringInstancesBadRequest) getExpiringInstancesRes() {}
+
+type GetExpiringInstancesForbidden ErrorContent
+
+func (*GetExpiringInstancesForbidden) getExpiringInstancesRes() {}
+
+type GetExpiringInstancesUnauthorized ErrorContent
+
+func (*GetExpiringInstancesUnauthorized) getExpiringInstancesRes() {}
+
 type GetInstanceByIdForbidden ErrorContent
 
 func (*GetInstanceByIdForbidden) getInstanceByIdRes() {}
@@ -2184,12 +3622,17 @@ func (s *GetInstanceByIdResponse) SetData(val InstanceFull) {
 	s.Data = val
 }
 
-func (*GetInstanceByIdResponse) getInstanceByIdRes() {}
+func (*GetInstanceByIdResponse) getInstanceByIdRes()         {}
+func (*GetInstanceByIdResponse) inspectReturnedInstanceRes() {}
 
 type GetInstanceByIdUnauthorized ErrorContent
 
 func (*GetInstanceByIdUnauthorized) getInstanceByIdRes() {}
 
+type GetInstancesBadRequest ErrorContent
+
+func (*GetInstancesBadRequest) getInstancesRes() {}
+
 type GetInstancesByItemIdForbidden ErrorContent
 
 func (*GetInstancesByItemIdForbidden) getInstancesByItemIdRes() {}
@@ -2219,8 +3662,14 @@ type GetInstancesByItemIdResponseDataItem struct {
 	ID               uuid.UUID                                  `json:"id"`
 	Status           GetInstancesByItemIdResponseDataItemStatus `json:"status"`
 	AffectedByTaskId OptNilUUID                                 `json:"affectedByTaskId"`
-	Variant          ItemVariant                                `json:"variant"`
-	Cell             NilCellForInstanceOptional                 `json:"cell"`
+	// Manufacturer serial number, unique per variant.
+	SerialNumber   NilString `json:"serialNumber"`
+	LotNumber      NilString `json:"lotNumber"`
+	ManufacturedAt NilDate   `json:"manufacturedAt"`
+	// Expired instances are not allocated to pickment tasks.
+	ExpiresAt NilDate                    `json:"expiresAt"`
+	Variant   ItemVariant                `json:"variant"`
+	Cell      NilCellForInstanceOptional `json:"cell"`
 }
 
 // GetID returns the value of ID.
@@ -2238,6 +3687,26 @@ func (s *GetInstancesByItemIdResponseDataItem) GetAffectedByTaskId() OptNilUUID
 	return s.AffectedByTaskId
 }
 
+// GetSerialNumber returns the value of SerialNumber.
+func (s *GetInstancesByItemIdResponseDataItem) GetSerialNumber() NilString {
+	return s.SerialNumber
+}
+
+// GetLotNumber returns the value of LotNumber.
+func (s *GetInstancesByItemIdResponseDataItem) GetLotNumber() NilString {
+	return s.LotNumber
+}
+
+// GetManufacturedAt returns the value of ManufacturedAt.
+func (s *GetInstancesByItemIdResponseDataItem) GetManufacturedAt() NilDate {
+	return s.ManufacturedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *GetInstancesByItemIdResponseDataItem) GetExpiresAt() NilDate {
+	return s.ExpiresAt
+}
+
 // GetVariant returns the value of Variant.
 func (s *GetInstancesByItemIdResponseDataItem) GetVariant() ItemVariant {
 	return s.Variant
@@ -2263,6 +3732,26 @@ func (s *GetInstancesByItemIdResponseDataItem) SetAffectedByTaskId(val OptNilUUI
 	s.AffectedByTaskId = val
 }
 
+// SetSerialNumber sets the value of SerialNumber.
+func (s *GetInstancesByItemIdResponseDataItem) SetSerialNumber(val NilString) {
+	s.SerialNumber = val
+}
+
+// SetLotNumber sets the value of LotNumber.
+func (s *GetInstancesByItemIdResponseDataItem) SetLotNumber(val NilString) {
+	s.LotNumber = val
+}
+
+// SetManufacturedAt sets the value of ManufacturedAt.
+func (s *GetInstancesByItemIdResponseDataItem) SetManufacturedAt(val NilDate) {
+	s.ManufacturedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *GetInstancesByItemIdResponseDataItem) SetExpiresAt(val NilDate) {
+	s.ExpiresAt = val
+}
+
 // SetVariant sets the value of Variant.
 func (s *GetInstancesByItemIdResponseDataItem) SetVariant(val ItemVariant) {
 	s.Variant = val
@@ -2276,9 +3765,13 @@ func (s *GetInstancesByItemIdResponseDataItem) SetCell(val NilCellForInstanceOpt
 type GetInstancesByItemIdResponseDataItemStatus string
 
 const (
-	GetInstancesByItemIdResponseDataItemStatusAvailable GetInstancesByItemIdResponseDataItemStatus = "available"
-	GetInstancesByItemIdResponseDataItemStatusReserved  GetInstancesByItemIdResponseDataItemStatus = "reserved"
-	GetInstancesByItemIdResponseDataItemStatusConsumed  GetInstancesByItemIdResponseDataItemStatus = "consumed"
+	GetInstancesByItemIdResponseDataItemStatusAvailable   GetInstancesByItemIdResponseDataItemStatus = "available"
+	GetInstancesByItemIdResponseDataItemStatusReserved    GetInstancesByItemIdResponseDataItemStatus = "reserved"
+	GetInstancesByItemIdResponseDataItemStatusConsumed    GetInstancesByItemIdResponseDataItemStatus = "consumed"
+	GetInstancesByItemIdResponseDataItemStatusQuarantined GetInstancesByItemIdResponseDataItemStatus = "quarantined"
+	GetInstancesByItemIdResponseDataItemStatusDisposed    GetInstancesByItemIdResponseDataItemStatus = "disposed"
+	GetInstancesByItemIdResponseDataItemStatusShipped     GetInstancesByItemIdResponseDataItemStatus = "shipped"
+	GetInstancesByItemIdResponseDataItemStatusInTransit   GetInstancesByItemIdResponseDataItemStatus = "in_transit"
 )
 
 // AllValues returns all GetInstancesByItemIdResponseDataItemStatus values.
@@ -2287,6 +3780,10 @@ func (GetInstancesByItemIdResponseDataItemStatus) AllValues() []GetInstancesByIt
 		GetInstancesByItemIdResponseDataItemStatusAvailable,
 		GetInstancesByItemIdResponseDataItemStatusReserved,
 		GetInstancesByItemIdResponseDataItemStatusConsumed,
+		GetInstancesByItemIdResponseDataItemStatusQuarantined,
+		GetInstancesByItemIdResponseDataItemStatusDisposed,
+		GetInstancesByItemIdResponseDataItemStatusShipped,
+		GetInstancesByItemIdResponseDataItemStatusInTransit,
 	}
 }
 
@@ -2299,6 +3796,14 @@ func (s GetInstancesByItemIdResponseDataItemStatus) MarshalText() ([]byte, error
 		return []byte(s), nil
 	case GetInstancesByItemIdResponseDataItemStatusConsumed:
 		return []byte(s), nil
+	case GetInstancesByItemIdResponseDataItemStatusQuarantined:
+		return []byte(s), nil
+	case GetInstancesByItemIdResponseDataItemStatusDisposed:
+		return []byte(s), nil
+	case GetInstancesByItemIdResponseDataItemStatusShipped:
+		return []byte(s), nil
+	case GetInstancesByItemIdResponseDataItemStatusInTransit:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -2316,6 +3821,18 @@ func (s *GetInstancesByItemIdResponseDataItemStatus) UnmarshalText(data []byte)
 	case GetInstancesByItemIdResponseDataItemStatusConsumed:
 		*s = GetInstancesByItemIdResponseDataItemStatusConsumed
 		return nil
+	case GetInstancesByItemIdResponseDataItemStatusQuarantined:
+		*s = GetInstancesByItemIdResponseDataItemStatusQuarantined
+		return nil
+	case GetInstancesByItemIdResponseDataItemStatusDisposed:
+		*s = GetInstancesByItemIdResponseDataItemStatusDisposed
+		return nil
+	case GetInstancesByItemIdResponseDataItemStatusShipped:
+		*s = GetInstancesByItemIdResponseDataItemStatusShipped
+		return nil
+	case GetInstancesByItemIdResponseDataItemStatusInTransit:
+		*s = GetInstancesByItemIdResponseDataItemStatusInTransit
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -2348,12 +3865,26 @@ func (s *GetInstancesResponse) SetData(val []InstanceFull) {
 	s.Data = val
 }
 
-func (*GetInstancesResponse) getInstancesRes() {}
+func (*GetInstancesResponse) getExpiringInstancesRes()   {}
+func (*GetInstancesResponse) getInstancesRes()           {}
+func (*GetInstancesResponse) getTaskItemSubstitutesRes() {}
 
 type GetInstancesUnauthorized ErrorContent
 
 func (*GetInstancesUnauthorized) getInstancesRes() {}
 
+type GetInventoryCountReportBadRequest ErrorContent
+
+func (*GetInventoryCountReportBadRequest) getInventoryCountReportRes() {}
+
+type GetInventoryCountReportForbidden ErrorContent
+
+func (*GetInventoryCountReportForbidden) getInventoryCountReportRes() {}
+
+type GetInventoryCountReportUnauthorized ErrorContent
+
+func (*GetInventoryCountReportUnauthorized) getInventoryCountReportRes() {}
+
 type GetItemByIdForbidden ErrorContent
 
 func (*GetItemByIdForbidden) getItemByIdRes() {}
@@ -2470,6 +4001,14 @@ type GetItemsUnauthorized ErrorContent
 
 func (*GetItemsUnauthorized) getItemsRes() {}
 
+type GetMyTasksForbidden ErrorContent
+
+func (*GetMyTasksForbidden) getMyTasksRes() {}
+
+type GetMyTasksUnauthorized ErrorContent
+
+func (*GetMyTasksUnauthorized) getMyTasksRes() {}
+
 type GetOrganizationByIdForbidden ErrorContent
 
 func (*GetOrganizationByIdForbidden) getOrganizationByIdRes() {}
@@ -2582,7 +4121,72 @@ type GetOrganizationsUnauthorized ErrorContent
 
 func (*GetOrganizationsUnauthorized) getOrganizationsRes() {}
 
-type GetRolesNotFound ErrorContent
+type GetPickWaveByIdBadRequest ErrorContent
+
+func (*GetPickWaveByIdBadRequest) getPickWaveByIdRes() {}
+
+type GetPickWaveByIdForbidden ErrorContent
+
+func (*GetPickWaveByIdForbidden) getPickWaveByIdRes() {}
+
+type GetPickWaveByIdNotFound ErrorContent
+
+func (*GetPickWaveByIdNotFound) getPickWaveByIdRes() {}
+
+type GetPickWaveByIdUnauthorized ErrorContent
+
+func (*GetPickWaveByIdUnauthorized) getPickWaveByIdRes() {}
+
+// Ref: #/components/schemas/GetPickWaveResponse
+type GetPickWaveResponse struct {
+	Data PickWaveFull `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *GetPickWaveResponse) GetData() PickWaveFull {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *GetPickWaveResponse) SetData(val PickWaveFull) {
+	s.Data = val
+}
+
+func (*GetPickWaveResponse) cancelPickWaveRes()   {}
+func (*GetPickWaveResponse) completePickWaveRes() {}
+func (*GetPickWaveResponse) createPickWaveRes()   {}
+func (*GetPickWaveResponse) getPickWaveByIdRes()  {}
+
+type GetPickWavesBadRequest ErrorContent
+
+func (*GetPickWavesBadRequest) getPickWavesRes() {}
+
+type GetPickWavesForbidden ErrorContent
+
+func (*GetPickWavesForbidden) getPickWavesRes() {}
+
+// Ref: #/components/schemas/GetPickWavesResponse
+type GetPickWavesResponse struct {
+	Data []PickWave `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *GetPickWavesResponse) GetData() []PickWave {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *GetPickWavesResponse) SetData(val []PickWave) {
+	s.Data = val
+}
+
+func (*GetPickWavesResponse) getPickWavesRes() {}
+
+type GetPickWavesUnauthorized ErrorContent
+
+func (*GetPickWavesUnauthorized) getPickWavesRes() {}
+
+type GetRolesNotFound ErrorContent
 
 func (*GetRolesNotFound) getRolesRes() {}
 
@@ -2606,6 +4210,282 @@ type GetRolesUnauthorized ErrorContent
 
 func (*GetRolesUnauthorized) getRolesRes() {}
 
+type GetStockLevelsBadRequest ErrorContent
+
+func (*GetStockLevelsBadRequest) getStockLevelsRes() {}
+
+type GetStockLevelsForbidden ErrorContent
+
+func (*GetStockLevelsForbidden) getStockLevelsRes() {}
+
+type GetStockLevelsGroupBy string
+
+const (
+	GetStockLevelsGroupByCell         GetStockLevelsGroupBy = "cell"
+	GetStockLevelsGroupByCellsGroup   GetStockLevelsGroupBy = "cells_group"
+	GetStockLevelsGroupByStorageGroup GetStockLevelsGroupBy = "storage_group"
+	GetStockLevelsGroupByUnit         GetStockLevelsGroupBy = "unit"
+)
+
+// AllValues returns all GetStockLevelsGroupBy values.
+func (GetStockLevelsGroupBy) AllValues() []GetStockLevelsGroupBy {
+	return []GetStockLevelsGroupBy{
+		GetStockLevelsGroupByCell,
+		GetStockLevelsGroupByCellsGroup,
+		GetStockLevelsGroupByStorageGroup,
+		GetStockLevelsGroupByUnit,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetStockLevelsGroupBy) MarshalText() ([]byte, error) {
+	switch s {
+	case GetStockLevelsGroupByCell:
+		return []byte(s), nil
+	case GetStockLevelsGroupByCellsGroup:
+		return []byte(s), nil
+	case GetStockLevelsGroupByStorageGroup:
+		return []byte(s), nil
+	case GetStockLevelsGroupByUnit:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetStockLevelsGroupBy) UnmarshalText(data []byte) error {
+	switch GetStockLevelsGroupBy(data) {
+	case GetStockLevelsGroupByCell:
+		*s = GetStockLevelsGroupByCell
+		return nil
+	case GetStockLevelsGroupByCellsGroup:
+		*s = GetStockLevelsGroupByCellsGroup
+		return nil
+	case GetStockLevelsGroupByStorageGroup:
+		*s = GetStockLevelsGroupByStorageGroup
+		return nil
+	case GetStockLevelsGroupByUnit:
+		*s = GetStockLevelsGroupByUnit
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/GetStockLevelsResponse
+type GetStockLevelsResponse struct {
+	Data []StockLevel `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *GetStockLevelsResponse) GetData() []StockLevel {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *GetStockLevelsResponse) SetData(val []StockLevel) {
+	s.Data = val
+}
+
+func (*GetStockLevelsResponse) getStockLevelsRes() {}
+
+type GetStockLevelsStatusItem string
+
+const (
+	GetStockLevelsStatusItemAvailable GetStockLevelsStatusItem = "available"
+	GetStockLevelsStatusItemReserved  GetStockLevelsStatusItem = "reserved"
+	GetStockLevelsStatusItemConsumed  GetStockLevelsStatusItem = "consumed"
+	GetStockLevelsStatusItemInTransit GetStockLevelsStatusItem = "in_transit"
+)
+
+// AllValues returns all GetStockLevelsStatusItem values.
+func (GetStockLevelsStatusItem) AllValues() []GetStockLevelsStatusItem {
+	return []GetStockLevelsStatusItem{
+		GetStockLevelsStatusItemAvailable,
+		GetStockLevelsStatusItemReserved,
+		GetStockLevelsStatusItemConsumed,
+		GetStockLevelsStatusItemInTransit,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetStockLevelsStatusItem) MarshalText() ([]byte, error) {
+	switch s {
+	case GetStockLevelsStatusItemAvailable:
+		return []byte(s), nil
+	case GetStockLevelsStatusItemReserved:
+		return []byte(s), nil
+	case GetStockLevelsStatusItemConsumed:
+		return []byte(s), nil
+	case GetStockLevelsStatusItemInTransit:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetStockLevelsStatusItem) UnmarshalText(data []byte) error {
+	switch GetStockLevelsStatusItem(data) {
+	case GetStockLevelsStatusItemAvailable:
+		*s = GetStockLevelsStatusItemAvailable
+		return nil
+	case GetStockLevelsStatusItemReserved:
+		*s = GetStockLevelsStatusItemReserved
+		return nil
+	case GetStockLevelsStatusItemConsumed:
+		*s = GetStockLevelsStatusItemConsumed
+		return nil
+	case GetStockLevelsStatusItemInTransit:
+		*s = GetStockLevelsStatusItemInTransit
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetStockLevelsUnauthorized ErrorContent
+
+func (*GetStockLevelsUnauthorized) getStockLevelsRes() {}
+
+type GetStockLineByIdForbidden ErrorContent
+
+func (*GetStockLineByIdForbidden) getStockLineByIdRes() {}
+
+type GetStockLineByIdNotFound ErrorContent
+
+func (*GetStockLineByIdNotFound) getStockLineByIdRes() {}
+
+type GetStockLineByIdUnauthorized ErrorContent
+
+func (*GetStockLineByIdUnauthorized) getStockLineByIdRes() {}
+
+// Ref: #/components/schemas/GetStockLineResponse
+type GetStockLineResponse struct {
+	Data StockLine `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *GetStockLineResponse) GetData() StockLine {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *GetStockLineResponse) SetData(val StockLine) {
+	s.Data = val
+}
+
+func (*GetStockLineResponse) createStockLineRes()  {}
+func (*GetStockLineResponse) getStockLineByIdRes() {}
+func (*GetStockLineResponse) mergeStockLinesRes()  {}
+func (*GetStockLineResponse) splitStockLineRes()   {}
+
+type GetStockLinesBadRequest ErrorContent
+
+func (*GetStockLinesBadRequest) getStockLinesRes() {}
+
+type GetStockLinesForbidden ErrorContent
+
+func (*GetStockLinesForbidden) getStockLinesRes() {}
+
+// Ref: #/components/schemas/GetStockLinesResponse
+type GetStockLinesResponse struct {
+	Data []StockLine `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *GetStockLinesResponse) GetData() []StockLine {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *GetStockLinesResponse) SetData(val []StockLine) {
+	s.Data = val
+}
+
+func (*GetStockLinesResponse) getStockLinesRes() {}
+
+type GetStockLinesStatus string
+
+const (
+	GetStockLinesStatusAvailable   GetStockLinesStatus = "available"
+	GetStockLinesStatusReserved    GetStockLinesStatus = "reserved"
+	GetStockLinesStatusConsumed    GetStockLinesStatus = "consumed"
+	GetStockLinesStatusQuarantined GetStockLinesStatus = "quarantined"
+	GetStockLinesStatusDisposed    GetStockLinesStatus = "disposed"
+	GetStockLinesStatusShipped     GetStockLinesStatus = "shipped"
+	GetStockLinesStatusInTransit   GetStockLinesStatus = "in_transit"
+)
+
+// AllValues returns all GetStockLinesStatus values.
+func (GetStockLinesStatus) AllValues() []GetStockLinesStatus {
+	return []GetStockLinesStatus{
+		GetStockLinesStatusAvailable,
+		GetStockLinesStatusReserved,
+		GetStockLinesStatusConsumed,
+		GetStockLinesStatusQuarantined,
+		GetStockLinesStatusDisposed,
+		GetStockLinesStatusShipped,
+		GetStockLinesStatusInTransit,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetStockLinesStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetStockLinesStatusAvailable:
+		return []byte(s), nil
+	case GetStockLinesStatusReserved:
+		return []byte(s), nil
+	case GetStockLinesStatusConsumed:
+		return []byte(s), nil
+	case GetStockLinesStatusQuarantined:
+		return []byte(s), nil
+	case GetStockLinesStatusDisposed:
+		return []byte(s), nil
+	case GetStockLinesStatusShipped:
+		return []byte(s), nil
+	case GetStockLinesStatusInTransit:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetStockLinesStatus) UnmarshalText(data []byte) error {
+	switch GetStockLinesStatus(data) {
+	case GetStockLinesStatusAvailable:
+		*s = GetStockLinesStatusAvailable
+		return nil
+	case GetStockLinesStatusReserved:
+		*s = GetStockLinesStatusReserved
+		return nil
+	case GetStockLinesStatusConsumed:
+		*s = GetStockLinesStatusConsumed
+		return nil
+	case GetStockLinesStatusQuarantined:
+		*s = GetStockLinesStatusQuarantined
+		return nil
+	case GetStockLinesStatusDisposed:
+		*s = GetStockLinesStatusDisposed
+		return nil
+	case GetStockLinesStatusShipped:
+		*s = GetStockLinesStatusShipped
+		return nil
+	case GetStockLinesStatusInTransit:
+		*s = GetStockLinesStatusInTransit
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetStockLinesUnauthorized ErrorContent
+
+func (*GetStockLinesUnauthorized) getStockLinesRes() {}
+
 type GetStorageGroupByIdForbidden ErrorContent
 
 func (*GetStorageGroupByIdForbidden) getStorageGroupByIdRes() {}
@@ -2664,346 +4544,382 @@ type GetStorageGroupsUnauthorized ErrorContent
 
 func (*GetStorageGroupsUnauthorized) getStorageGroupsRes() {}
 
-type GetTaskByIdForbidden ErrorContent
-
-func (*GetTaskByIdForbidden) getTaskByIdRes() {}
-
-type GetTaskByIdNotFound ErrorContent
-
-func (*GetTaskByIdNotFound) getTaskByIdRes() {}
-
-type GetTaskByIdUnauthorized ErrorContent
-
-func (*GetTaskByIdUnauthorized) getTaskByIdRes() {}
-
-// Ref: #/components/schemas/GetTaskResponse
-type GetTaskResponse struct {
-	Data TaskFull `json:"data"`
+// Ref: #/components/schemas/GetTaskAttachmentResponse
+type GetTaskAttachmentResponse struct {
+	Data TaskAttachment `json:"data"`
 }
 
 // GetData returns the value of Data.
-func (s *GetTaskResponse) GetData() TaskFull {
+func (s *GetTaskAttachmentResponse) GetData() TaskAttachment {
 	return s.Data
 }
 
 // SetData sets the value of Data.
-func (s *GetTaskResponse) SetData(val TaskFull) {
+func (s *GetTaskAttachmentResponse) SetData(val TaskAttachment) {
 	s.Data = val
 }
 
-func (*GetTaskResponse) getTaskByIdRes() {}
+func (*GetTaskAttachmentResponse) uploadTaskAttachmentRes() {}
 
-type GetTasksForbidden ErrorContent
+type GetTaskAttachmentsForbidden ErrorContent
 
-func (*GetTasksForbidden) getTasksRes() {}
+func (*GetTaskAttachmentsForbidden) getTaskAttachmentsRes() {}
 
-type GetTasksNotFound ErrorContent
+type GetTaskAttachmentsNotFound ErrorContent
 
-func (*GetTasksNotFound) getTasksRes() {}
+func (*GetTaskAttachmentsNotFound) getTaskAttachmentsRes() {}
 
-// Ref: #/components/schemas/GetTasksResponse
-type GetTasksResponse struct {
-	Data []TaskBase `json:"data"`
+// Ref: #/components/schemas/GetTaskAttachmentsResponse
+type GetTaskAttachmentsResponse struct {
+	Data []TaskAttachment `json:"data"`
 }
 
 // GetData returns the value of Data.
-func (s *GetTasksResponse) GetData() []TaskBase {
+func (s *GetTaskAttachmentsResponse) GetData() []TaskAttachment {
 	return s.Data
 }
 
 // SetData sets the value of Data.
-func (s *GetTasksResponse) SetData(val []TaskBase) {
+func (s *GetTaskAttachmentsResponse) SetData(val []TaskAttachment) {
 	s.Data = val
 }
 
-func (*GetTasksResponse) getTasksRes() {}
+func (*GetTaskAttachmentsResponse) getTaskAttachmentsRes() {}
 
-type GetTasksUnauthorized ErrorContent
+type GetTaskAttachmentsUnauthorized ErrorContent
 
-func (*GetTasksUnauthorized) getTasksRes() {}
+func (*GetTaskAttachmentsUnauthorized) getTaskAttachmentsRes() {}
 
-// Ref: #/components/schemas/GetTvBoardDataResponse
-type GetTvBoardDataResponse struct {
-	Data GetTvBoardDataResponseData `json:"data"`
+type GetTaskByIdForbidden ErrorContent
+
+func (*GetTaskByIdForbidden) getTaskByIdRes() {}
+
+type GetTaskByIdNotFound ErrorContent
+
+func (*GetTaskByIdNotFound) getTaskByIdRes() {}
+
+type GetTaskByIdUnauthorized ErrorContent
+
+func (*GetTaskByIdUnauthorized) getTaskByIdRes() {}
+
+// Ref: #/components/schemas/GetTaskCommentResponse
+type GetTaskCommentResponse struct {
+	Data TaskComment `json:"data"`
 }
 
 // GetData returns the value of Data.
-func (s *GetTvBoardDataResponse) GetData() GetTvBoardDataResponseData {
+func (s *GetTaskCommentResponse) GetData() TaskComment {
 	return s.Data
 }
 
 // SetData sets the value of Data.
-func (s *GetTvBoardDataResponse) SetData(val GetTvBoardDataResponseData) {
+func (s *GetTaskCommentResponse) SetData(val TaskComment) {
 	s.Data = val
 }
 
-func (*GetTvBoardDataResponse) getTvBoardsDataRes() {}
+func (*GetTaskCommentResponse) createTaskCommentRes() {}
+func (*GetTaskCommentResponse) updateTaskCommentRes() {}
 
-type GetTvBoardDataResponseData struct {
-	TvBoard TvBoard    `json:"tvBoard"`
-	Tasks   []TaskBase `json:"tasks"`
-}
+type GetTaskCommentsForbidden ErrorContent
 
-// GetTvBoard returns the value of TvBoard.
-func (s *GetTvBoardDataResponseData) GetTvBoard() TvBoard {
-	return s.TvBoard
-}
+func (*GetTaskCommentsForbidden) getTaskCommentsRes() {}
 
-// GetTasks returns the value of Tasks.
-func (s *GetTvBoardDataResponseData) GetTasks() []TaskBase {
-	return s.Tasks
+type GetTaskCommentsNotFound ErrorContent
+
+func (*GetTaskCommentsNotFound) getTaskCommentsRes() {}
+
+// Ref: #/components/schemas/GetTaskCommentsResponse
+type GetTaskCommentsResponse struct {
+	Data []TaskComment `json:"data"`
 }
 
-// SetTvBoard sets the value of TvBoard.
-func (s *GetTvBoardDataResponseData) SetTvBoard(val TvBoard) {
-	s.TvBoard = val
+// GetData returns the value of Data.
+func (s *GetTaskCommentsResponse) GetData() []TaskComment {
+	return s.Data
 }
 
-// SetTasks sets the value of Tasks.
-func (s *GetTvBoardDataResponseData) SetTasks(val []TaskBase) {
-	s.Tasks = val
+// SetData sets the value of Data.
+func (s *GetTaskCommentsResponse) SetData(val []TaskComment) {
+	s.Data = val
 }
 
-type GetTvBoardsDataNotFound ErrorContent
+func (*GetTaskCommentsResponse) getTaskCommentsRes() {}
 
-func (*GetTvBoardsDataNotFound) getTvBoardsDataRes() {}
+type GetTaskCommentsUnauthorized ErrorContent
 
-type GetTvBoardsDataUnauthorized ErrorContent
+func (*GetTaskCommentsUnauthorized) getTaskCommentsRes() {}
 
-func (*GetTvBoardsDataUnauthorized) getTvBoardsDataRes() {}
+type GetTaskExceptionsReportBadRequest ErrorContent
 
-type GetTvBoardsForbidden ErrorContent
+func (*GetTaskExceptionsReportBadRequest) getTaskExceptionsReportRes() {}
 
-func (*GetTvBoardsForbidden) getTvBoardsRes() {}
+type GetTaskExceptionsReportForbidden ErrorContent
 
-type GetTvBoardsNotFound ErrorContent
+func (*GetTaskExceptionsReportForbidden) getTaskExceptionsReportRes() {}
 
-func (*GetTvBoardsNotFound) getTvBoardsRes() {}
+type GetTaskExceptionsReportReason string
 
-// Ref: #/components/schemas/GetTvBoardsResponse
-type GetTvBoardsResponse struct {
-	Data []TvBoard `json:"data"`
+const (
+	GetTaskExceptionsReportReasonNotFound  GetTaskExceptionsReportReason = "not_found"
+	GetTaskExceptionsReportReasonDamaged   GetTaskExceptionsReportReason = "damaged"
+	GetTaskExceptionsReportReasonWrongItem GetTaskExceptionsReportReason = "wrong_item"
+)
+
+// AllValues returns all GetTaskExceptionsReportReason values.
+func (GetTaskExceptionsReportReason) AllValues() []GetTaskExceptionsReportReason {
+	return []GetTaskExceptionsReportReason{
+		GetTaskExceptionsReportReasonNotFound,
+		GetTaskExceptionsReportReasonDamaged,
+		GetTaskExceptionsReportReasonWrongItem,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetTaskExceptionsReportReason) MarshalText() ([]byte, error) {
+	switch s {
+	case GetTaskExceptionsReportReasonNotFound:
+		return []byte(s), nil
+	case GetTaskExceptionsReportReasonDamaged:
+		return []byte(s), nil
+	case GetTaskExceptionsReportReasonWrongItem:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetTaskExceptionsReportReason) UnmarshalText(data []byte) error {
+	switch GetTaskExceptionsReportReason(data) {
+	case GetTaskExceptionsReportReasonNotFound:
+		*s = GetTaskExceptionsReportReasonNotFound
+		return nil
+	case GetTaskExceptionsReportReasonDamaged:
+		*s = GetTaskExceptionsReportReasonDamaged
+		return nil
+	case GetTaskExceptionsReportReasonWrongItem:
+		*s = GetTaskExceptionsReportReasonWrongItem
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetTaskExceptionsReportUnauthorized ErrorContent
+
+func (*GetTaskExceptionsReportUnauthorized) getTaskExceptionsReportRes() {}
+
+type GetTaskItemSubstitutesBadRequest ErrorContent
+
+func (*GetTaskItemSubstitutesBadRequest) getTaskItemSubstitutesRes() {}
+
+type GetTaskItemSubstitutesForbidden ErrorContent
+
+func (*GetTaskItemSubstitutesForbidden) getTaskItemSubstitutesRes() {}
+
+type GetTaskItemSubstitutesUnauthorized ErrorContent
+
+func (*GetTaskItemSubstitutesUnauthorized) getTaskItemSubstitutesRes() {}
+
+type GetTaskPickupCodeForbidden ErrorContent
+
+func (*GetTaskPickupCodeForbidden) getTaskPickupCodeRes() {}
+
+type GetTaskPickupCodeNotFound ErrorContent
+
+func (*GetTaskPickupCodeNotFound) getTaskPickupCodeRes() {}
+
+// Ref: #/components/schemas/GetTaskPickupCodeResponse
+type GetTaskPickupCodeResponse struct {
+	Data TaskPickupCode `json:"data"`
 }
 
 // GetData returns the value of Data.
-func (s *GetTvBoardsResponse) GetData() []TvBoard {
+func (s *GetTaskPickupCodeResponse) GetData() TaskPickupCode {
 	return s.Data
 }
 
 // SetData sets the value of Data.
-func (s *GetTvBoardsResponse) SetData(val []TvBoard) {
+func (s *GetTaskPickupCodeResponse) SetData(val TaskPickupCode) {
 	s.Data = val
 }
 
-func (*GetTvBoardsResponse) getTvBoardsRes() {}
+func (*GetTaskPickupCodeResponse) getTaskPickupCodeRes()        {}
+func (*GetTaskPickupCodeResponse) regenerateTaskPickupCodeRes() {}
 
-type GetTvBoardsUnauthorized ErrorContent
+type GetTaskPickupCodeUnauthorized ErrorContent
 
-func (*GetTvBoardsUnauthorized) getTvBoardsRes() {}
+func (*GetTaskPickupCodeUnauthorized) getTaskPickupCodeRes() {}
 
-// Ref: #/components/schemas/InstanceForItem
-type InstanceForItem struct {
-	ID               uuid.UUID                  `json:"id"`
-	Status           InstanceForItemStatus      `json:"status"`
-	AffectedByTaskId OptNilUUID                 `json:"affectedByTaskId"`
-	Variant          ItemVariant                `json:"variant"`
-	Cell             NilCellForInstanceOptional `json:"cell"`
+// Ref: #/components/schemas/GetTaskResponse
+type GetTaskResponse struct {
+	Data TaskFull `json:"data"`
 }
 
-// GetID returns the value of ID.
-func (s *InstanceForItem) GetID() uuid.UUID {
-	return s.ID
+// GetData returns the value of Data.
+func (s *GetTaskResponse) GetData() TaskFull {
+	return s.Data
 }
 
-// GetStatus returns the value of Status.
-func (s *InstanceForItem) GetStatus() InstanceForItemStatus {
-	return s.Status
+// SetData sets the value of Data.
+func (s *GetTaskResponse) SetData(val TaskFull) {
+	s.Data = val
 }
 
-// GetAffectedByTaskId returns the value of AffectedByTaskId.
-func (s *InstanceForItem) GetAffectedByTaskId() OptNilUUID {
-	return s.AffectedByTaskId
-}
+func (*GetTaskResponse) getTaskByIdRes()             {}
+func (*GetTaskResponse) pickWaveInstanceRes()        {}
+func (*GetTaskResponse) reportTaskItemExceptionRes() {}
+func (*GetTaskResponse) runTaskTemplateRes()         {}
+func (*GetTaskResponse) sortWaveInstanceRes()        {}
 
-// GetVariant returns the value of Variant.
-func (s *InstanceForItem) GetVariant() ItemVariant {
-	return s.Variant
-}
+type GetTaskStatusHistoryForbidden ErrorContent
 
-// GetCell returns the value of Cell.
-func (s *InstanceForItem) GetCell() NilCellForInstanceOptional {
-	return s.Cell
-}
+func (*GetTaskStatusHistoryForbidden) getTaskStatusHistoryRes() {}
 
-// SetID sets the value of ID.
-func (s *InstanceForItem) SetID(val uuid.UUID) {
-	s.ID = val
-}
+type GetTaskStatusHistoryNotFound ErrorContent
 
-// SetStatus sets the value of Status.
-func (s *InstanceForItem) SetStatus(val InstanceForItemStatus) {
-	s.Status = val
-}
+func (*GetTaskStatusHistoryNotFound) getTaskStatusHistoryRes() {}
 
-// SetAffectedByTaskId sets the value of AffectedByTaskId.
-func (s *InstanceForItem) SetAffectedByTaskId(val OptNilUUID) {
-	s.AffectedByTaskId = val
+// Ref: #/components/schemas/GetTaskStatusHistoryResponse
+type GetTaskStatusHistoryResponse struct {
+	Data []TaskStatusChange `json:"data"`
 }
 
-// SetVariant sets the value of Variant.
-func (s *InstanceForItem) SetVariant(val ItemVariant) {
-	s.Variant = val
+// GetData returns the value of Data.
+func (s *GetTaskStatusHistoryResponse) GetData() []TaskStatusChange {
+	return s.Data
 }
 
-// SetCell sets the value of Cell.
-func (s *InstanceForItem) SetCell(val NilCellForInstanceOptional) {
-	s.Cell = val
+// SetData sets the value of Data.
+func (s *GetTaskStatusHistoryResponse) SetData(val []TaskStatusChange) {
+	s.Data = val
 }
 
-type InstanceForItemStatus string
+func (*GetTaskStatusHistoryResponse) getTaskStatusHistoryRes() {}
 
-const (
-	InstanceForItemStatusAvailable InstanceForItemStatus = "available"
-	InstanceForItemStatusReserved  InstanceForItemStatus = "reserved"
-	InstanceForItemStatusConsumed  InstanceForItemStatus = "consumed"
-)
+type GetTaskStatusHistoryUnauthorized ErrorContent
 
-// AllValues returns all InstanceForItemStatus values.
-func (InstanceForItemStatus) AllValues() []InstanceForItemStatus {
-	return []InstanceForItemStatus{
-		InstanceForItemStatusAvailable,
-		InstanceForItemStatusReserved,
-		InstanceForItemStatusConsumed,
-	}
-}
+func (*GetTaskStatusHistoryUnauthorized) getTaskStatusHistoryRes() {}
 
-// MarshalText implements encoding.TextMarshaler.
-func (s InstanceForItemStatus) MarshalText() ([]byte, error) {
-	switch s {
-	case InstanceForItemStatusAvailable:
-		return []byte(s), nil
-	case InstanceForItemStatusReserved:
-		return []byte(s), nil
-	case InstanceForItemStatusConsumed:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
-}
+type GetTaskTemplateByIdForbidden ErrorContent
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *InstanceForItemStatus) UnmarshalText(data []byte) error {
-	switch InstanceForItemStatus(data) {
-	case InstanceForItemStatusAvailable:
-		*s = InstanceForItemStatusAvailable
-		return nil
-	case InstanceForItemStatusReserved:
-		*s = InstanceForItemStatusReserved
-		return nil
-	case InstanceForItemStatusConsumed:
-		*s = InstanceForItemStatusConsumed
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
-}
+func (*GetTaskTemplateByIdForbidden) getTaskTemplateByIdRes() {}
 
-// Ref: #/components/schemas/InstanceFull
-type InstanceFull struct {
-	// Instance ID.
-	ID               uuid.UUID                  `json:"id"`
-	Status           InstanceFullStatus         `json:"status"`
-	Item             ItemForList                `json:"item"`
-	AffectedByTaskId NilUUID                    `json:"affectedByTaskId"`
-	Variant          ItemVariant                `json:"variant"`
-	Cell             NilCellForInstanceOptional `json:"cell"`
-}
+type GetTaskTemplateByIdNotFound ErrorContent
 
-// GetID returns the value of ID.
-func (s *InstanceFull) GetID() uuid.UUID {
-	return s.ID
-}
+func (*GetTaskTemplateByIdNotFound) getTaskTemplateByIdRes() {}
 
-// GetStatus returns the value of Status.
-func (s *InstanceFull) GetStatus() InstanceFullStatus {
-	return s.Status
+type GetTaskTemplateByIdUnauthorized ErrorContent
+
+func (*GetTaskTemplateByIdUnauthorized) getTaskTemplateByIdRes() {}
+
+// Ref: #/components/schemas/GetTaskTemplateResponse
+type GetTaskTemplateResponse struct {
+	Data TaskTemplate `json:"data"`
 }
 
-// GetItem returns the value of Item.
-func (s *InstanceFull) GetItem() ItemForList {
-	return s.Item
+// GetData returns the value of Data.
+func (s *GetTaskTemplateResponse) GetData() TaskTemplate {
+	return s.Data
 }
 
-// GetAffectedByTaskId returns the value of AffectedByTaskId.
-func (s *InstanceFull) GetAffectedByTaskId() NilUUID {
-	return s.AffectedByTaskId
+// SetData sets the value of Data.
+func (s *GetTaskTemplateResponse) SetData(val TaskTemplate) {
+	s.Data = val
 }
 
-// GetVariant returns the value of Variant.
-func (s *InstanceFull) GetVariant() ItemVariant {
-	return s.Variant
+func (*GetTaskTemplateResponse) createTaskTemplateRes()  {}
+func (*GetTaskTemplateResponse) getTaskTemplateByIdRes() {}
+func (*GetTaskTemplateResponse) updateTaskTemplateRes()  {}
+
+type GetTaskTemplatesForbidden ErrorContent
+
+func (*GetTaskTemplatesForbidden) getTaskTemplatesRes() {}
+
+// Ref: #/components/schemas/GetTaskTemplatesResponse
+type GetTaskTemplatesResponse struct {
+	Data []TaskTemplate `json:"data"`
 }
 
-// GetCell returns the value of Cell.
-func (s *InstanceFull) GetCell() NilCellForInstanceOptional {
-	return s.Cell
+// GetData returns the value of Data.
+func (s *GetTaskTemplatesResponse) GetData() []TaskTemplate {
+	return s.Data
 }
 
-// SetID sets the value of ID.
-func (s *InstanceFull) SetID(val uuid.UUID) {
-	s.ID = val
+// SetData sets the value of Data.
+func (s *GetTaskTemplatesResponse) SetData(val []TaskTemplate) {
+	s.Data = val
 }
 
-// SetStatus sets the value of Status.
-func (s *InstanceFull) SetStatus(val InstanceFullStatus) {
-	s.Status = val
+func (*GetTaskTemplatesResponse) getTaskTemplatesRes() {}
+
+type GetTaskTemplatesUnauthorized ErrorContent
+
+func (*GetTaskTemplatesUnauthorized) getTaskTemplatesRes() {}
+
+type GetTasksForbidden ErrorContent
+
+func (*GetTasksForbidden) getTasksRes() {}
+
+type GetTasksNotFound ErrorContent
+
+func (*GetTasksNotFound) getTasksRes() {}
+
+// Ref: #/components/schemas/GetTasksResponse
+type GetTasksResponse struct {
+	Data []TaskBase `json:"data"`
+	// Cursor of the next page, null on the last page.
+	NextCursor OptNilString `json:"nextCursor"`
 }
 
-// SetItem sets the value of Item.
-func (s *InstanceFull) SetItem(val ItemForList) {
-	s.Item = val
+// GetData returns the value of Data.
+func (s *GetTasksResponse) GetData() []TaskBase {
+	return s.Data
 }
 
-// SetAffectedByTaskId sets the value of AffectedByTaskId.
-func (s *InstanceFull) SetAffectedByTaskId(val NilUUID) {
-	s.AffectedByTaskId = val
+// GetNextCursor returns the value of NextCursor.
+func (s *GetTasksResponse) GetNextCursor() OptNilString {
+	return s.NextCursor
 }
 
-// SetVariant sets the value of Variant.
-func (s *InstanceFull) SetVariant(val ItemVariant) {
-	s.Variant = val
+// SetData sets the value of Data.
+func (s *GetTasksResponse) SetData(val []TaskBase) {
+	s.Data = val
 }
 
-// SetCell sets the value of Cell.
-func (s *InstanceFull) SetCell(val NilCellForInstanceOptional) {
-	s.Cell = val
+// SetNextCursor sets the value of NextCursor.
+func (s *GetTasksResponse) SetNextCursor(val OptNilString) {
+	s.NextCursor = val
 }
 
-type InstanceFullStatus string
+func (*GetTasksResponse) getMyTasksRes() {}
+func (*GetTasksResponse) getTasksRes()   {}
+
+type GetTasksSortBy string
 
 const (
-	InstanceFullStatusAvailable InstanceFullStatus = "available"
-	InstanceFullStatusReserved  InstanceFullStatus = "reserved"
-	InstanceFullStatusConsumed  InstanceFullStatus = "consumed"
+	GetTasksSortByCreatedAt GetTasksSortBy = "created_at"
+	GetTasksSortByName      GetTasksSortBy = "name"
+	GetTasksSortByPriority  GetTasksSortBy = "priority"
 )
 
-// AllValues returns all InstanceFullStatus values.
-func (InstanceFullStatus) AllValues() []InstanceFullStatus {
-	return []InstanceFullStatus{
-		InstanceFullStatusAvailable,
-		InstanceFullStatusReserved,
-		InstanceFullStatusConsumed,
+// AllValues returns all GetTasksSortBy values.
+func (GetTasksSortBy) AllValues() []GetTasksSortBy {
+	return []GetTasksSortBy{
+		GetTasksSortByCreatedAt,
+		GetTasksSortByName,
+		GetTasksSortByPriority,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s InstanceFullStatus) MarshalText() ([]byte, error) {
+func (s GetTasksSortBy) MarshalText() ([]byte, error) {
 	switch s {
-	case InstanceFullStatusAvailable:
+	case GetTasksSortByCreatedAt:
 		return []byte(s), nil
-	case InstanceFullStatusReserved:
+	case GetTasksSortByName:
 		return []byte(s), nil
-	case InstanceFullStatusConsumed:
+	case GetTasksSortByPriority:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -3011,1366 +4927,1355 @@ func (s InstanceFullStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *InstanceFullStatus) UnmarshalText(data []byte) error {
-	switch InstanceFullStatus(data) {
-	case InstanceFullStatusAvailable:
-		*s = InstanceFullStatusAvailable
+func (s *GetTasksSortBy) UnmarshalText(data []byte) error {
+	switch GetTasksSortBy(data) {
+	case GetTasksSortByCreatedAt:
+		*s = GetTasksSortByCreatedAt
 		return nil
-	case InstanceFullStatusReserved:
-		*s = InstanceFullStatusReserved
+	case GetTasksSortByName:
+		*s = GetTasksSortByName
 		return nil
-	case InstanceFullStatusConsumed:
-		*s = InstanceFullStatusConsumed
+	case GetTasksSortByPriority:
+		*s = GetTasksSortByPriority
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type InviteEmployeeBadRequest ErrorContent
-
-func (*InviteEmployeeBadRequest) inviteEmployeeRes() {}
+type GetTasksSortOrder string
 
-type InviteEmployeeForbidden ErrorContent
+const (
+	GetTasksSortOrderAsc  GetTasksSortOrder = "asc"
+	GetTasksSortOrderDesc GetTasksSortOrder = "desc"
+)
 
-func (*InviteEmployeeForbidden) inviteEmployeeRes() {}
+// AllValues returns all GetTasksSortOrder values.
+func (GetTasksSortOrder) AllValues() []GetTasksSortOrder {
+	return []GetTasksSortOrder{
+		GetTasksSortOrderAsc,
+		GetTasksSortOrderDesc,
+	}
+}
 
-// Ref: #/components/schemas/InviteEmployeeRequest
-type InviteEmployeeRequest struct {
-	Email  string `json:"email"`
-	RoleId int    `json:"roleId"`
+// MarshalText implements encoding.TextMarshaler.
+func (s GetTasksSortOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case GetTasksSortOrderAsc:
+		return []byte(s), nil
+	case GetTasksSortOrderDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetEmail returns the value of Email.
-func (s *InviteEmployeeRequest) GetEmail() string {
-	return s.Email
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetTasksSortOrder) UnmarshalText(data []byte) error {
+	switch GetTasksSortOrder(data) {
+	case GetTasksSortOrderAsc:
+		*s = GetTasksSortOrderAsc
+		return nil
+	case GetTasksSortOrderDesc:
+		*s = GetTasksSortOrderDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetRoleId returns the value of RoleId.
-func (s *InviteEmployeeRequest) GetRoleId() int {
-	return s.RoleId
+type GetTasksStatusItem string
+
+const (
+	GetTasksStatusItemPending    GetTasksStatusItem = "pending"
+	GetTasksStatusItemInProgress GetTasksStatusItem = "in_progress"
+	GetTasksStatusItemReady      GetTasksStatusItem = "ready"
+	GetTasksStatusItemCompleted  GetTasksStatusItem = "completed"
+	GetTasksStatusItemCancelled  GetTasksStatusItem = "cancelled"
+)
+
+// AllValues returns all GetTasksStatusItem values.
+func (GetTasksStatusItem) AllValues() []GetTasksStatusItem {
+	return []GetTasksStatusItem{
+		GetTasksStatusItemPending,
+		GetTasksStatusItemInProgress,
+		GetTasksStatusItemReady,
+		GetTasksStatusItemCompleted,
+		GetTasksStatusItemCancelled,
+	}
 }
 
-// SetEmail sets the value of Email.
-func (s *InviteEmployeeRequest) SetEmail(val string) {
-	s.Email = val
+// MarshalText implements encoding.TextMarshaler.
+func (s GetTasksStatusItem) MarshalText() ([]byte, error) {
+	switch s {
+	case GetTasksStatusItemPending:
+		return []byte(s), nil
+	case GetTasksStatusItemInProgress:
+		return []byte(s), nil
+	case GetTasksStatusItemReady:
+		return []byte(s), nil
+	case GetTasksStatusItemCompleted:
+		return []byte(s), nil
+	case GetTasksStatusItemCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetRoleId sets the value of RoleId.
-func (s *InviteEmployeeRequest) SetRoleId(val int) {
-	s.RoleId = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetTasksStatusItem) UnmarshalText(data []byte) error {
+	switch GetTasksStatusItem(data) {
+	case GetTasksStatusItemPending:
+		*s = GetTasksStatusItemPending
+		return nil
+	case GetTasksStatusItemInProgress:
+		*s = GetTasksStatusItemInProgress
+		return nil
+	case GetTasksStatusItemReady:
+		*s = GetTasksStatusItemReady
+		return nil
+	case GetTasksStatusItemCompleted:
+		*s = GetTasksStatusItemCompleted
+		return nil
+	case GetTasksStatusItemCancelled:
+		*s = GetTasksStatusItemCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-type InviteEmployeeUnauthorized ErrorContent
+type GetTasksType string
 
-func (*InviteEmployeeUnauthorized) inviteEmployeeRes() {}
+const (
+	GetTasksTypePickment       GetTasksType = "pickment"
+	GetTasksTypeMovement       GetTasksType = "movement"
+	GetTasksTypeReceiving      GetTasksType = "receiving"
+	GetTasksTypeInventoryCount GetTasksType = "inventory_count"
+	GetTasksTypeReturn         GetTasksType = "return"
+)
 
-// Merged schema.
-// Ref: #/components/schemas/ItemForList
-type ItemForList struct {
-	// Merged property.
-	ID   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
-	// Merged property.
-	Description NilString     `json:"description"`
-	Variants    []ItemVariant `json:"variants"`
+// AllValues returns all GetTasksType values.
+func (GetTasksType) AllValues() []GetTasksType {
+	return []GetTasksType{
+		GetTasksTypePickment,
+		GetTasksTypeMovement,
+		GetTasksTypeReceiving,
+		GetTasksTypeInventoryCount,
+		GetTasksTypeReturn,
+	}
 }
 
-// GetID returns the value of ID.
-func (s *ItemForList) GetID() uuid.UUID {
-	return s.ID
+// MarshalText implements encoding.TextMarshaler.
+func (s GetTasksType) MarshalText() ([]byte, error) {
+	switch s {
+	case GetTasksTypePickment:
+		return []byte(s), nil
+	case GetTasksTypeMovement:
+		return []byte(s), nil
+	case GetTasksTypeReceiving:
+		return []byte(s), nil
+	case GetTasksTypeInventoryCount:
+		return []byte(s), nil
+	case GetTasksTypeReturn:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetName returns the value of Name.
-func (s *ItemForList) GetName() string {
-	return s.Name
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetTasksType) UnmarshalText(data []byte) error {
+	switch GetTasksType(data) {
+	case GetTasksTypePickment:
+		*s = GetTasksTypePickment
+		return nil
+	case GetTasksTypeMovement:
+		*s = GetTasksTypeMovement
+		return nil
+	case GetTasksTypeReceiving:
+		*s = GetTasksTypeReceiving
+		return nil
+	case GetTasksTypeInventoryCount:
+		*s = GetTasksTypeInventoryCount
+		return nil
+	case GetTasksTypeReturn:
+		*s = GetTasksTypeReturn
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetDescription returns the value of Description.
-func (s *ItemForList) GetDescription() NilString {
-	return s.Description
-}
+type GetTasksUnauthorized ErrorContent
 
-// GetVariants returns the value of Variants.
-func (s *ItemForList) GetVariants() []ItemVariant {
-	return s.Variants
-}
+func (*GetTasksUnauthorized) getTasksRes() {}
 
-// SetID sets the value of ID.
-func (s *ItemForList) SetID(val uuid.UUID) {
-	s.ID = val
-}
+type GetTransferOrderByIdBadRequest ErrorContent
 
-// SetName sets the value of Name.
-func (s *ItemForList) SetName(val string) {
-	s.Name = val
-}
+func (*GetTransferOrderByIdBadRequest) getTransferOrderByIdRes() {}
 
-// SetDescription sets the value of Description.
-func (s *ItemForList) SetDescription(val NilString) {
-	s.Description = val
-}
+type GetTransferOrderByIdForbidden ErrorContent
 
-// SetVariants sets the value of Variants.
-func (s *ItemForList) SetVariants(val []ItemVariant) {
-	s.Variants = val
-}
+func (*GetTransferOrderByIdForbidden) getTransferOrderByIdRes() {}
 
-// Merged schema.
-// Ref: #/components/schemas/ItemFull
-type ItemFull struct {
-	// Merged property.
-	ID   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
-	// Merged property.
-	Description NilString         `json:"description"`
-	Variants    []ItemVariant     `json:"variants"`
-	Items       []InstanceForItem `json:"items"`
-}
+type GetTransferOrderByIdNotFound ErrorContent
 
-// GetID returns the value of ID.
-func (s *ItemFull) GetID() uuid.UUID {
-	return s.ID
-}
+func (*GetTransferOrderByIdNotFound) getTransferOrderByIdRes() {}
 
-// GetName returns the value of Name.
-func (s *ItemFull) GetName() string {
-	return s.Name
-}
+type GetTransferOrderByIdUnauthorized ErrorContent
 
-// GetDescription returns the value of Description.
-func (s *ItemFull) GetDescription() NilString {
-	return s.Description
+func (*GetTransferOrderByIdUnauthorized) getTransferOrderByIdRes() {}
+
+// Ref: #/components/schemas/GetTransferOrderResponse
+type GetTransferOrderResponse struct {
+	Data TransferOrderFull `json:"data"`
 }
 
-// GetVariants returns the value of Variants.
-func (s *ItemFull) GetVariants() []ItemVariant {
-	return s.Variants
+// GetData returns the value of Data.
+func (s *GetTransferOrderResponse) GetData() TransferOrderFull {
+	return s.Data
 }
 
-// GetItems returns the value of Items.
-func (s *ItemFull) GetItems() []InstanceForItem {
-	return s.Items
+// SetData sets the value of Data.
+func (s *GetTransferOrderResponse) SetData(val TransferOrderFull) {
+	s.Data = val
 }
 
-// SetID sets the value of ID.
-func (s *ItemFull) SetID(val uuid.UUID) {
-	s.ID = val
+func (*GetTransferOrderResponse) cancelTransferOrderRes()  {}
+func (*GetTransferOrderResponse) createTransferOrderRes()  {}
+func (*GetTransferOrderResponse) getTransferOrderByIdRes() {}
+
+type GetTransferOrdersBadRequest ErrorContent
+
+func (*GetTransferOrdersBadRequest) getTransferOrdersRes() {}
+
+type GetTransferOrdersForbidden ErrorContent
+
+func (*GetTransferOrdersForbidden) getTransferOrdersRes() {}
+
+// Ref: #/components/schemas/GetTransferOrdersResponse
+type GetTransferOrdersResponse struct {
+	Data []TransferOrder `json:"data"`
 }
 
-// SetName sets the value of Name.
-func (s *ItemFull) SetName(val string) {
-	s.Name = val
+// GetData returns the value of Data.
+func (s *GetTransferOrdersResponse) GetData() []TransferOrder {
+	return s.Data
 }
 
-// SetDescription sets the value of Description.
-func (s *ItemFull) SetDescription(val NilString) {
-	s.Description = val
+// SetData sets the value of Data.
+func (s *GetTransferOrdersResponse) SetData(val []TransferOrder) {
+	s.Data = val
 }
 
-// SetVariants sets the value of Variants.
-func (s *ItemFull) SetVariants(val []ItemVariant) {
-	s.Variants = val
+func (*GetTransferOrdersResponse) getTransferOrdersRes() {}
+
+type GetTransferOrdersStatus string
+
+const (
+	GetTransferOrdersStatusPending   GetTransferOrdersStatus = "pending"
+	GetTransferOrdersStatusInTransit GetTransferOrdersStatus = "in_transit"
+	GetTransferOrdersStatusReceived  GetTransferOrdersStatus = "received"
+	GetTransferOrdersStatusCancelled GetTransferOrdersStatus = "cancelled"
+)
+
+// AllValues returns all GetTransferOrdersStatus values.
+func (GetTransferOrdersStatus) AllValues() []GetTransferOrdersStatus {
+	return []GetTransferOrdersStatus{
+		GetTransferOrdersStatusPending,
+		GetTransferOrdersStatusInTransit,
+		GetTransferOrdersStatusReceived,
+		GetTransferOrdersStatusCancelled,
+	}
 }
 
-// SetItems sets the value of Items.
-func (s *ItemFull) SetItems(val []InstanceForItem) {
-	s.Items = val
+// MarshalText implements encoding.TextMarshaler.
+func (s GetTransferOrdersStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetTransferOrdersStatusPending:
+		return []byte(s), nil
+	case GetTransferOrdersStatusInTransit:
+		return []byte(s), nil
+	case GetTransferOrdersStatusReceived:
+		return []byte(s), nil
+	case GetTransferOrdersStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// Merged schema.
-// Ref: #/components/schemas/ItemVariant
-type ItemVariant struct {
-	ID   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
-	// Merged property.
-	Article NilString `json:"article"`
-	// Merged property.
-	Ean13 NilInt64 `json:"ean13"`
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetTransferOrdersStatus) UnmarshalText(data []byte) error {
+	switch GetTransferOrdersStatus(data) {
+	case GetTransferOrdersStatusPending:
+		*s = GetTransferOrdersStatusPending
+		return nil
+	case GetTransferOrdersStatusInTransit:
+		*s = GetTransferOrdersStatusInTransit
+		return nil
+	case GetTransferOrdersStatusReceived:
+		*s = GetTransferOrdersStatusReceived
+		return nil
+	case GetTransferOrdersStatusCancelled:
+		*s = GetTransferOrdersStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetID returns the value of ID.
-func (s *ItemVariant) GetID() uuid.UUID {
-	return s.ID
+type GetTransferOrdersUnauthorized ErrorContent
+
+func (*GetTransferOrdersUnauthorized) getTransferOrdersRes() {}
+
+// Ref: #/components/schemas/GetTvBoardDataResponse
+type GetTvBoardDataResponse struct {
+	Data GetTvBoardDataResponseData `json:"data"`
 }
 
-// GetName returns the value of Name.
-func (s *ItemVariant) GetName() string {
-	return s.Name
+// GetData returns the value of Data.
+func (s *GetTvBoardDataResponse) GetData() GetTvBoardDataResponseData {
+	return s.Data
 }
 
-// GetArticle returns the value of Article.
-func (s *ItemVariant) GetArticle() NilString {
-	return s.Article
+// SetData sets the value of Data.
+func (s *GetTvBoardDataResponse) SetData(val GetTvBoardDataResponseData) {
+	s.Data = val
 }
 
-// GetEan13 returns the value of Ean13.
-func (s *ItemVariant) GetEan13() NilInt64 {
-	return s.Ean13
+func (*GetTvBoardDataResponse) getTvBoardsDataRes() {}
+
+type GetTvBoardDataResponseData struct {
+	TvBoard TvBoard    `json:"tvBoard"`
+	Tasks   []TaskBase `json:"tasks"`
+	// Codes of the unit's ready pickment tasks waiting for pickup.
+	PickupCodes []TvBoardPickupCode `json:"pickupCodes"`
 }
 
-// SetID sets the value of ID.
-func (s *ItemVariant) SetID(val uuid.UUID) {
-	s.ID = val
+// GetTvBoard returns the value of TvBoard.
+func (s *GetTvBoardDataResponseData) GetTvBoard() TvBoard {
+	return s.TvBoard
 }
 
-// SetName sets the value of Name.
-func (s *ItemVariant) SetName(val string) {
-	s.Name = val
+// GetTasks returns the value of Tasks.
+func (s *GetTvBoardDataResponseData) GetTasks() []TaskBase {
+	return s.Tasks
 }
 
-// SetArticle sets the value of Article.
-func (s *ItemVariant) SetArticle(val NilString) {
-	s.Article = val
+// GetPickupCodes returns the value of PickupCodes.
+func (s *GetTvBoardDataResponseData) GetPickupCodes() []TvBoardPickupCode {
+	return s.PickupCodes
 }
 
-// SetEan13 sets the value of Ean13.
-func (s *ItemVariant) SetEan13(val NilInt64) {
-	s.Ean13 = val
+// SetTvBoard sets the value of TvBoard.
+func (s *GetTvBoardDataResponseData) SetTvBoard(val TvBoard) {
+	s.TvBoard = val
 }
 
-// Ref: #/components/responses/LogoutResponse
-type LogoutResponse struct {
-	SetCookie string
+// SetTasks sets the value of Tasks.
+func (s *GetTvBoardDataResponseData) SetTasks(val []TaskBase) {
+	s.Tasks = val
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *LogoutResponse) GetSetCookie() string {
-	return s.SetCookie
+// SetPickupCodes sets the value of PickupCodes.
+func (s *GetTvBoardDataResponseData) SetPickupCodes(val []TvBoardPickupCode) {
+	s.PickupCodes = val
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *LogoutResponse) SetSetCookie(val string) {
-	s.SetCookie = val
-}
+type GetTvBoardsDataNotFound ErrorContent
 
-func (*LogoutResponse) logoutRes() {}
+func (*GetTvBoardsDataNotFound) getTvBoardsDataRes() {}
 
-type MarkTaskAsAwaitingBadRequest ErrorContent
+type GetTvBoardsDataUnauthorized ErrorContent
 
-func (*MarkTaskAsAwaitingBadRequest) markTaskAsAwaitingRes() {}
+func (*GetTvBoardsDataUnauthorized) getTvBoardsDataRes() {}
 
-type MarkTaskAsAwaitingForbidden ErrorContent
+type GetTvBoardsForbidden ErrorContent
 
-func (*MarkTaskAsAwaitingForbidden) markTaskAsAwaitingRes() {}
+func (*GetTvBoardsForbidden) getTvBoardsRes() {}
 
-// MarkTaskAsAwaitingNoContent is response for MarkTaskAsAwaiting operation.
-type MarkTaskAsAwaitingNoContent struct{}
+type GetTvBoardsNotFound ErrorContent
 
-func (*MarkTaskAsAwaitingNoContent) markTaskAsAwaitingRes() {}
+func (*GetTvBoardsNotFound) getTvBoardsRes() {}
 
-type MarkTaskAsAwaitingUnauthorized ErrorContent
+// Ref: #/components/schemas/GetTvBoardsResponse
+type GetTvBoardsResponse struct {
+	Data []TvBoard `json:"data"`
+}
 
-func (*MarkTaskAsAwaitingUnauthorized) markTaskAsAwaitingRes() {}
+// GetData returns the value of Data.
+func (s *GetTvBoardsResponse) GetData() []TvBoard {
+	return s.Data
+}
 
-type MarkTaskAsCompletedBadRequest ErrorContent
+// SetData sets the value of Data.
+func (s *GetTvBoardsResponse) SetData(val []TvBoard) {
+	s.Data = val
+}
 
-func (*MarkTaskAsCompletedBadRequest) markTaskAsCompletedRes() {}
+func (*GetTvBoardsResponse) getTvBoardsRes() {}
 
-type MarkTaskAsCompletedForbidden ErrorContent
+type GetTvBoardsUnauthorized ErrorContent
 
-func (*MarkTaskAsCompletedForbidden) markTaskAsCompletedRes() {}
+func (*GetTvBoardsUnauthorized) getTvBoardsRes() {}
 
-// MarkTaskAsCompletedNoContent is response for MarkTaskAsCompleted operation.
-type MarkTaskAsCompletedNoContent struct{}
+type GetWorkerProfileForbidden ErrorContent
 
-func (*MarkTaskAsCompletedNoContent) markTaskAsCompletedRes() {}
+func (*GetWorkerProfileForbidden) getWorkerProfileRes() {}
 
-type MarkTaskAsCompletedUnauthorized ErrorContent
+type GetWorkerProfileNotFound ErrorContent
 
-func (*MarkTaskAsCompletedUnauthorized) markTaskAsCompletedRes() {}
+func (*GetWorkerProfileNotFound) getWorkerProfileRes() {}
 
-// NewNilAuditLogPostchangeState returns new NilAuditLogPostchangeState with value set to v.
-func NewNilAuditLogPostchangeState(v AuditLogPostchangeState) NilAuditLogPostchangeState {
-	return NilAuditLogPostchangeState{
-		Value: v,
-	}
+// Ref: #/components/schemas/GetWorkerProfileResponse
+type GetWorkerProfileResponse struct {
+	Data WorkerProfile `json:"data"`
 }
 
-// NilAuditLogPostchangeState is nullable AuditLogPostchangeState.
-type NilAuditLogPostchangeState struct {
-	Value AuditLogPostchangeState
-	Null  bool
+// GetData returns the value of Data.
+func (s *GetWorkerProfileResponse) GetData() WorkerProfile {
+	return s.Data
 }
 
-// SetTo sets value to v.
-func (o *NilAuditLogPostchangeState) SetTo(v AuditLogPostchangeState) {
-	o.Null = false
-	o.Value = v
+// SetData sets the value of Data.
+func (s *GetWorkerProfileResponse) SetData(val WorkerProfile) {
+	s.Data = val
 }
 
-// IsNull returns true if value is Null.
-func (o NilAuditLogPostchangeState) IsNull() bool { return o.Null }
+func (*GetWorkerProfileResponse) getWorkerProfileRes() {}
+func (*GetWorkerProfileResponse) setMyShiftRes()       {}
+func (*GetWorkerProfileResponse) setWorkerProfileRes() {}
 
-// SetToNull sets value to null.
-func (o *NilAuditLogPostchangeState) SetToNull() {
-	o.Null = true
-	var v AuditLogPostchangeState
-	o.Value = v
+type GetWorkerProfileUnauthorized ErrorContent
+
+func (*GetWorkerProfileUnauthorized) getWorkerProfileRes() {}
+
+type ImportTasksBadRequest ErrorContent
+
+func (*ImportTasksBadRequest) importTasksRes() {}
+
+type ImportTasksForbidden ErrorContent
+
+func (*ImportTasksForbidden) importTasksRes() {}
+
+type ImportTasksReqApplicationXNdjson struct {
+	Data io.Reader
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilAuditLogPostchangeState) Get() (v AuditLogPostchangeState, ok bool) {
-	if o.Null {
-		return v, false
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImportTasksReqApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
-	return o.Value, true
+	return s.Data.Read(p)
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilAuditLogPostchangeState) Or(d AuditLogPostchangeState) AuditLogPostchangeState {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+func (*ImportTasksReqApplicationXNdjson) importTasksReq() {}
+
+type ImportTasksReqTextCsv struct {
+	Data io.Reader
 }
 
-// NewNilAuditLogPrechangeState returns new NilAuditLogPrechangeState with value set to v.
-func NewNilAuditLogPrechangeState(v AuditLogPrechangeState) NilAuditLogPrechangeState {
-	return NilAuditLogPrechangeState{
-		Value: v,
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImportTasksReqTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
+	return s.Data.Read(p)
 }
 
-// NilAuditLogPrechangeState is nullable AuditLogPrechangeState.
-type NilAuditLogPrechangeState struct {
-	Value AuditLogPrechangeState
-	Null  bool
-}
+func (*ImportTasksReqTextCsv) importTasksReq() {}
 
-// SetTo sets value to v.
-func (o *NilAuditLogPrechangeState) SetTo(v AuditLogPrechangeState) {
-	o.Null = false
-	o.Value = v
+// Ref: #/components/schemas/ImportTasksResponse
+type ImportTasksResponse struct {
+	Data TaskImportReport `json:"data"`
 }
 
-// IsNull returns true if value is Null.
-func (o NilAuditLogPrechangeState) IsNull() bool { return o.Null }
+// GetData returns the value of Data.
+func (s *ImportTasksResponse) GetData() TaskImportReport {
+	return s.Data
+}
 
-// SetToNull sets value to null.
-func (o *NilAuditLogPrechangeState) SetToNull() {
-	o.Null = true
-	var v AuditLogPrechangeState
-	o.Value = v
+// SetData sets the value of Data.
+func (s *ImportTasksResponse) SetData(val TaskImportReport) {
+	s.Data = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilAuditLogPrechangeState) Get() (v AuditLogPrechangeState, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+func (*ImportTasksResponse) importTasksRes() {}
+
+type ImportTasksUnauthorized ErrorContent
+
+func (*ImportTasksUnauthorized) importTasksRes() {}
+
+// Ref: #/components/schemas/InspectReturnRequest
+type InspectReturnRequest struct {
+	InstanceId  uuid.UUID                       `json:"instanceId"`
+	Disposition InspectReturnRequestDisposition `json:"disposition"`
+	// Cell to put the instance in. Required for restock, optional for quarantine.
+	CellId OptNilUUID `json:"cellId"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilAuditLogPrechangeState) Or(d AuditLogPrechangeState) AuditLogPrechangeState {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetInstanceId returns the value of InstanceId.
+func (s *InspectReturnRequest) GetInstanceId() uuid.UUID {
+	return s.InstanceId
 }
 
-// NewNilCellForInstanceOptional returns new NilCellForInstanceOptional with value set to v.
-func NewNilCellForInstanceOptional(v CellForInstanceOptional) NilCellForInstanceOptional {
-	return NilCellForInstanceOptional{
-		Value: v,
-	}
+// GetDisposition returns the value of Disposition.
+func (s *InspectReturnRequest) GetDisposition() InspectReturnRequestDisposition {
+	return s.Disposition
 }
 
-// NilCellForInstanceOptional is nullable CellForInstanceOptional.
-type NilCellForInstanceOptional struct {
-	Value CellForInstanceOptional
-	Null  bool
+// GetCellId returns the value of CellId.
+func (s *InspectReturnRequest) GetCellId() OptNilUUID {
+	return s.CellId
 }
 
-// SetTo sets value to v.
-func (o *NilCellForInstanceOptional) SetTo(v CellForInstanceOptional) {
-	o.Null = false
-	o.Value = v
+// SetInstanceId sets the value of InstanceId.
+func (s *InspectReturnRequest) SetInstanceId(val uuid.UUID) {
+	s.InstanceId = val
 }
 
-// IsNull returns true if value is Null.
-func (o NilCellForInstanceOptional) IsNull() bool { return o.Null }
+// SetDisposition sets the value of Disposition.
+func (s *InspectReturnRequest) SetDisposition(val InspectReturnRequestDisposition) {
+	s.Disposition = val
+}
 
-// SetToNull sets value to null.
-func (o *NilCellForInstanceOptional) SetToNull() {
-	o.Null = true
-	var v CellForInstanceOptional
-	o.Value = v
+// SetCellId sets the value of CellId.
+func (s *InspectReturnRequest) SetCellId(val OptNilUUID) {
+	s.CellId = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilCellForInstanceOptional) Get() (v CellForInstanceOptional, ok bool) {
-	if o.Null {
-		return v, false
+type InspectReturnRequestDisposition string
+
+const (
+	InspectReturnRequestDispositionRestock    InspectReturnRequestDisposition = "restock"
+	InspectReturnRequestDispositionQuarantine InspectReturnRequestDisposition = "quarantine"
+	InspectReturnRequestDispositionDispose    InspectReturnRequestDisposition = "dispose"
+)
+
+// AllValues returns all InspectReturnRequestDisposition values.
+func (InspectReturnRequestDisposition) AllValues() []InspectReturnRequestDisposition {
+	return []InspectReturnRequestDisposition{
+		InspectReturnRequestDispositionRestock,
+		InspectReturnRequestDispositionQuarantine,
+		InspectReturnRequestDispositionDispose,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilCellForInstanceOptional) Or(d CellForInstanceOptional) CellForInstanceOptional {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s InspectReturnRequestDisposition) MarshalText() ([]byte, error) {
+	switch s {
+	case InspectReturnRequestDispositionRestock:
+		return []byte(s), nil
+	case InspectReturnRequestDispositionQuarantine:
+		return []byte(s), nil
+	case InspectReturnRequestDispositionDispose:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewNilDateTime returns new NilDateTime with value set to v.
-func NewNilDateTime(v time.Time) NilDateTime {
-	return NilDateTime{
-		Value: v,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *InspectReturnRequestDisposition) UnmarshalText(data []byte) error {
+	switch InspectReturnRequestDisposition(data) {
+	case InspectReturnRequestDispositionRestock:
+		*s = InspectReturnRequestDispositionRestock
+		return nil
+	case InspectReturnRequestDispositionQuarantine:
+		*s = InspectReturnRequestDispositionQuarantine
+		return nil
+	case InspectReturnRequestDispositionDispose:
+		*s = InspectReturnRequestDispositionDispose
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// NilDateTime is nullable time.Time.
-type NilDateTime struct {
-	Value time.Time
-	Null  bool
-}
+type InspectReturnedInstanceBadRequest ErrorContent
 
-// SetTo sets value to v.
-func (o *NilDateTime) SetTo(v time.Time) {
-	o.Null = false
-	o.Value = v
-}
+func (*InspectReturnedInstanceBadRequest) inspectReturnedInstanceRes() {}
 
-// IsNull returns true if value is Null.
-func (o NilDateTime) IsNull() bool { return o.Null }
+type InspectReturnedInstanceForbidden ErrorContent
 
-// SetToNull sets value to null.
-func (o *NilDateTime) SetToNull() {
-	o.Null = true
-	var v time.Time
-	o.Value = v
-}
+func (*InspectReturnedInstanceForbidden) inspectReturnedInstanceRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilDateTime) Get() (v time.Time, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+type InspectReturnedInstanceUnauthorized ErrorContent
+
+func (*InspectReturnedInstanceUnauthorized) inspectReturnedInstanceRes() {}
+
+// Ref: #/components/schemas/InstanceForItem
+type InstanceForItem struct {
+	ID               uuid.UUID             `json:"id"`
+	Status           InstanceForItemStatus `json:"status"`
+	AffectedByTaskId OptNilUUID            `json:"affectedByTaskId"`
+	// Manufacturer serial number, unique per variant.
+	SerialNumber   NilString `json:"serialNumber"`
+	LotNumber      NilString `json:"lotNumber"`
+	ManufacturedAt NilDate   `json:"manufacturedAt"`
+	// Expired instances are not allocated to pickment tasks.
+	ExpiresAt NilDate                    `json:"expiresAt"`
+	Variant   ItemVariant                `json:"variant"`
+	Cell      NilCellForInstanceOptional `json:"cell"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetID returns the value of ID.
+func (s *InstanceForItem) GetID() uuid.UUID {
+	return s.ID
 }
 
-// NewNilEmployeeOptional returns new NilEmployeeOptional with value set to v.
-func NewNilEmployeeOptional(v EmployeeOptional) NilEmployeeOptional {
-	return NilEmployeeOptional{
-		Value: v,
-	}
+// GetStatus returns the value of Status.
+func (s *InstanceForItem) GetStatus() InstanceForItemStatus {
+	return s.Status
 }
 
-// NilEmployeeOptional is nullable EmployeeOptional.
-type NilEmployeeOptional struct {
-	Value EmployeeOptional
-	Null  bool
+// GetAffectedByTaskId returns the value of AffectedByTaskId.
+func (s *InstanceForItem) GetAffectedByTaskId() OptNilUUID {
+	return s.AffectedByTaskId
 }
 
-// SetTo sets value to v.
-func (o *NilEmployeeOptional) SetTo(v EmployeeOptional) {
-	o.Null = false
-	o.Value = v
+// GetSerialNumber returns the value of SerialNumber.
+func (s *InstanceForItem) GetSerialNumber() NilString {
+	return s.SerialNumber
 }
 
-// IsNull returns true if value is Null.
-func (o NilEmployeeOptional) IsNull() bool { return o.Null }
+// GetLotNumber returns the value of LotNumber.
+func (s *InstanceForItem) GetLotNumber() NilString {
+	return s.LotNumber
+}
 
-// SetToNull sets value to null.
-func (o *NilEmployeeOptional) SetToNull() {
-	o.Null = true
-	var v EmployeeOptional
-	o.Value = v
+// GetManufacturedAt returns the value of ManufacturedAt.
+func (s *InstanceForItem) GetManufacturedAt() NilDate {
+	return s.ManufacturedAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilEmployeeOptional) Get() (v EmployeeOptional, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *InstanceForItem) GetExpiresAt() NilDate {
+	return s.ExpiresAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilEmployeeOptional) Or(d EmployeeOptional) EmployeeOptional {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetVariant returns the value of Variant.
+func (s *InstanceForItem) GetVariant() ItemVariant {
+	return s.Variant
 }
 
-// NewNilInt64 returns new NilInt64 with value set to v.
-func NewNilInt64(v int64) NilInt64 {
-	return NilInt64{
-		Value: v,
-	}
+// GetCell returns the value of Cell.
+func (s *InstanceForItem) GetCell() NilCellForInstanceOptional {
+	return s.Cell
 }
 
-// NilInt64 is nullable int64.
-type NilInt64 struct {
-	Value int64
-	Null  bool
+// SetID sets the value of ID.
+func (s *InstanceForItem) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// SetTo sets value to v.
-func (o *NilInt64) SetTo(v int64) {
-	o.Null = false
-	o.Value = v
+// SetStatus sets the value of Status.
+func (s *InstanceForItem) SetStatus(val InstanceForItemStatus) {
+	s.Status = val
 }
 
-// IsNull returns true if value is Null.
-func (o NilInt64) IsNull() bool { return o.Null }
+// SetAffectedByTaskId sets the value of AffectedByTaskId.
+func (s *InstanceForItem) SetAffectedByTaskId(val OptNilUUID) {
+	s.AffectedByTaskId = val
+}
 
-// SetToNull sets value to null.
-func (o *NilInt64) SetToNull() {
-	o.Null = true
-	var v int64
-	o.Value = v
+// SetSerialNumber sets the value of SerialNumber.
+func (s *InstanceForItem) SetSerialNumber(val NilString) {
+	s.SerialNumber = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilInt64) Get() (v int64, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetLotNumber sets the value of LotNumber.
+func (s *InstanceForItem) SetLotNumber(val NilString) {
+	s.LotNumber = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilInt64) Or(d int64) int64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetManufacturedAt sets the value of ManufacturedAt.
+func (s *InstanceForItem) SetManufacturedAt(val NilDate) {
+	s.ManufacturedAt = val
 }
 
-// NewNilString returns new NilString with value set to v.
-func NewNilString(v string) NilString {
-	return NilString{
-		Value: v,
-	}
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *InstanceForItem) SetExpiresAt(val NilDate) {
+	s.ExpiresAt = val
 }
 
-// NilString is nullable string.
-type NilString struct {
-	Value string
-	Null  bool
+// SetVariant sets the value of Variant.
+func (s *InstanceForItem) SetVariant(val ItemVariant) {
+	s.Variant = val
 }
 
-// SetTo sets value to v.
-func (o *NilString) SetTo(v string) {
-	o.Null = false
-	o.Value = v
+// SetCell sets the value of Cell.
+func (s *InstanceForItem) SetCell(val NilCellForInstanceOptional) {
+	s.Cell = val
 }
 
-// IsNull returns true if value is Null.
-func (o NilString) IsNull() bool { return o.Null }
+type InstanceForItemStatus string
 
-// SetToNull sets value to null.
-func (o *NilString) SetToNull() {
-	o.Null = true
-	var v string
-	o.Value = v
-}
+const (
+	InstanceForItemStatusAvailable   InstanceForItemStatus = "available"
+	InstanceForItemStatusReserved    InstanceForItemStatus = "reserved"
+	InstanceForItemStatusConsumed    InstanceForItemStatus = "consumed"
+	InstanceForItemStatusQuarantined InstanceForItemStatus = "quarantined"
+	InstanceForItemStatusDisposed    InstanceForItemStatus = "disposed"
+	InstanceForItemStatusShipped     InstanceForItemStatus = "shipped"
+	InstanceForItemStatusInTransit   InstanceForItemStatus = "in_transit"
+)
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
+// AllValues returns all InstanceForItemStatus values.
+func (InstanceForItemStatus) AllValues() []InstanceForItemStatus {
+	return []InstanceForItemStatus{
+		InstanceForItemStatusAvailable,
+		InstanceForItemStatusReserved,
+		InstanceForItemStatusConsumed,
+		InstanceForItemStatusQuarantined,
+		InstanceForItemStatusDisposed,
+		InstanceForItemStatusShipped,
+		InstanceForItemStatusInTransit,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s InstanceForItemStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case InstanceForItemStatusAvailable:
+		return []byte(s), nil
+	case InstanceForItemStatusReserved:
+		return []byte(s), nil
+	case InstanceForItemStatusConsumed:
+		return []byte(s), nil
+	case InstanceForItemStatusQuarantined:
+		return []byte(s), nil
+	case InstanceForItemStatusDisposed:
+		return []byte(s), nil
+	case InstanceForItemStatusShipped:
+		return []byte(s), nil
+	case InstanceForItemStatusInTransit:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewNilUUID returns new NilUUID with value set to v.
-func NewNilUUID(v uuid.UUID) NilUUID {
-	return NilUUID{
-		Value: v,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *InstanceForItemStatus) UnmarshalText(data []byte) error {
+	switch InstanceForItemStatus(data) {
+	case InstanceForItemStatusAvailable:
+		*s = InstanceForItemStatusAvailable
+		return nil
+	case InstanceForItemStatusReserved:
+		*s = InstanceForItemStatusReserved
+		return nil
+	case InstanceForItemStatusConsumed:
+		*s = InstanceForItemStatusConsumed
+		return nil
+	case InstanceForItemStatusQuarantined:
+		*s = InstanceForItemStatusQuarantined
+		return nil
+	case InstanceForItemStatusDisposed:
+		*s = InstanceForItemStatusDisposed
+		return nil
+	case InstanceForItemStatusShipped:
+		*s = InstanceForItemStatusShipped
+		return nil
+	case InstanceForItemStatusInTransit:
+		*s = InstanceForItemStatusInTransit
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// NilUUID is nullable uuid.UUID.
-type NilUUID struct {
-	Value uuid.UUID
-	Null  bool
+// Ref: #/components/schemas/InstanceFull
+type InstanceFull struct {
+	// Instance ID.
+	ID               uuid.UUID          `json:"id"`
+	Status           InstanceFullStatus `json:"status"`
+	Item             ItemForList        `json:"item"`
+	AffectedByTaskId NilUUID            `json:"affectedByTaskId"`
+	// Manufacturer serial number, unique per variant.
+	SerialNumber   NilString `json:"serialNumber"`
+	LotNumber      NilString `json:"lotNumber"`
+	ManufacturedAt NilDate   `json:"manufacturedAt"`
+	// Expired instances are not allocated to pickment tasks.
+	ExpiresAt NilDate                    `json:"expiresAt"`
+	Variant   ItemVariant                `json:"variant"`
+	Cell      NilCellForInstanceOptional `json:"cell"`
 }
 
-// SetTo sets value to v.
-func (o *NilUUID) SetTo(v uuid.UUID) {
-	o.Null = false
-	o.Value = v
+// GetID returns the value of ID.
+func (s *InstanceFull) GetID() uuid.UUID {
+	return s.ID
 }
 
-// IsNull returns true if value is Null.
-func (o NilUUID) IsNull() bool { return o.Null }
+// GetStatus returns the value of Status.
+func (s *InstanceFull) GetStatus() InstanceFullStatus {
+	return s.Status
+}
 
-// SetToNull sets value to null.
-func (o *NilUUID) SetToNull() {
-	o.Null = true
-	var v uuid.UUID
-	o.Value = v
+// GetItem returns the value of Item.
+func (s *InstanceFull) GetItem() ItemForList {
+	return s.Item
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilUUID) Get() (v uuid.UUID, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetAffectedByTaskId returns the value of AffectedByTaskId.
+func (s *InstanceFull) GetAffectedByTaskId() NilUUID {
+	return s.AffectedByTaskId
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilUUID) Or(d uuid.UUID) uuid.UUID {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetSerialNumber returns the value of SerialNumber.
+func (s *InstanceFull) GetSerialNumber() NilString {
+	return s.SerialNumber
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// GetLotNumber returns the value of LotNumber.
+func (s *InstanceFull) GetLotNumber() NilString {
+	return s.LotNumber
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// GetManufacturedAt returns the value of ManufacturedAt.
+func (s *InstanceFull) GetManufacturedAt() NilDate {
+	return s.ManufacturedAt
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *InstanceFull) GetExpiresAt() NilDate {
+	return s.ExpiresAt
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// GetVariant returns the value of Variant.
+func (s *InstanceFull) GetVariant() ItemVariant {
+	return s.Variant
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// GetCell returns the value of Cell.
+func (s *InstanceFull) GetCell() NilCellForInstanceOptional {
+	return s.Cell
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetID sets the value of ID.
+func (s *InstanceFull) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetStatus sets the value of Status.
+func (s *InstanceFull) SetStatus(val InstanceFullStatus) {
+	s.Status = val
 }
 
-// NewOptNilInt64 returns new OptNilInt64 with value set to v.
-func NewOptNilInt64(v int64) OptNilInt64 {
-	return OptNilInt64{
-		Value: v,
-		Set:   true,
-	}
+// SetItem sets the value of Item.
+func (s *InstanceFull) SetItem(val ItemForList) {
+	s.Item = val
 }
 
-// OptNilInt64 is optional nullable int64.
-type OptNilInt64 struct {
-	Value int64
-	Set   bool
-	Null  bool
+// SetAffectedByTaskId sets the value of AffectedByTaskId.
+func (s *InstanceFull) SetAffectedByTaskId(val NilUUID) {
+	s.AffectedByTaskId = val
 }
 
-// IsSet returns true if OptNilInt64 was set.
-func (o OptNilInt64) IsSet() bool { return o.Set }
+// SetSerialNumber sets the value of SerialNumber.
+func (s *InstanceFull) SetSerialNumber(val NilString) {
+	s.SerialNumber = val
+}
 
-// Reset unsets value.
-func (o *OptNilInt64) Reset() {
-	var v int64
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetLotNumber sets the value of LotNumber.
+func (s *InstanceFull) SetLotNumber(val NilString) {
+	s.LotNumber = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilInt64) SetTo(v int64) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilInt64) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilInt64) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v int64
-	o.Value = v
-}
-
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilInt64) Get() (v int64, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
-
-// Or returns value if set, or given parameter if does not.
-func (o OptNilInt64) Or(d int64) int64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
-
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
-		Set:   true,
-	}
+// SetManufacturedAt sets the value of ManufacturedAt.
+func (s *InstanceFull) SetManufacturedAt(val NilDate) {
+	s.ManufacturedAt = val
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *InstanceFull) SetExpiresAt(val NilDate) {
+	s.ExpiresAt = val
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetVariant sets the value of Variant.
+func (s *InstanceFull) SetVariant(val ItemVariant) {
+	s.Variant = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetCell sets the value of Cell.
+func (s *InstanceFull) SetCell(val NilCellForInstanceOptional) {
+	s.Cell = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+type InstanceFullStatus string
 
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
-}
+const (
+	InstanceFullStatusAvailable   InstanceFullStatus = "available"
+	InstanceFullStatusReserved    InstanceFullStatus = "reserved"
+	InstanceFullStatusConsumed    InstanceFullStatus = "consumed"
+	InstanceFullStatusQuarantined InstanceFullStatus = "quarantined"
+	InstanceFullStatusDisposed    InstanceFullStatus = "disposed"
+	InstanceFullStatusShipped     InstanceFullStatus = "shipped"
+	InstanceFullStatusInTransit   InstanceFullStatus = "in_transit"
+)
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
+// AllValues returns all InstanceFullStatus values.
+func (InstanceFullStatus) AllValues() []InstanceFullStatus {
+	return []InstanceFullStatus{
+		InstanceFullStatusAvailable,
+		InstanceFullStatusReserved,
+		InstanceFullStatusConsumed,
+		InstanceFullStatusQuarantined,
+		InstanceFullStatusDisposed,
+		InstanceFullStatusShipped,
+		InstanceFullStatusInTransit,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s InstanceFullStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case InstanceFullStatusAvailable:
+		return []byte(s), nil
+	case InstanceFullStatusReserved:
+		return []byte(s), nil
+	case InstanceFullStatusConsumed:
+		return []byte(s), nil
+	case InstanceFullStatusQuarantined:
+		return []byte(s), nil
+	case InstanceFullStatusDisposed:
+		return []byte(s), nil
+	case InstanceFullStatusShipped:
+		return []byte(s), nil
+	case InstanceFullStatusInTransit:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptNilUUID returns new OptNilUUID with value set to v.
-func NewOptNilUUID(v uuid.UUID) OptNilUUID {
-	return OptNilUUID{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *InstanceFullStatus) UnmarshalText(data []byte) error {
+	switch InstanceFullStatus(data) {
+	case InstanceFullStatusAvailable:
+		*s = InstanceFullStatusAvailable
+		return nil
+	case InstanceFullStatusReserved:
+		*s = InstanceFullStatusReserved
+		return nil
+	case InstanceFullStatusConsumed:
+		*s = InstanceFullStatusConsumed
+		return nil
+	case InstanceFullStatusQuarantined:
+		*s = InstanceFullStatusQuarantined
+		return nil
+	case InstanceFullStatusDisposed:
+		*s = InstanceFullStatusDisposed
+		return nil
+	case InstanceFullStatusShipped:
+		*s = InstanceFullStatusShipped
+		return nil
+	case InstanceFullStatusInTransit:
+		*s = InstanceFullStatusInTransit
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptNilUUID is optional nullable uuid.UUID.
-type OptNilUUID struct {
-	Value uuid.UUID
-	Set   bool
-	Null  bool
+// Ref: #/components/schemas/InventoryCountDiscrepancy
+type InventoryCountDiscrepancy struct {
+	Instance     OptInstanceFull            `json:"instance"`
+	Variant      ItemVariant                `json:"variant"`
+	ExpectedCell NilCellForInstanceOptional `json:"expectedCell"`
+	FoundCell    NilCellForInstanceOptional `json:"foundCell"`
+	Quantity     int                        `json:"quantity"`
 }
 
-// IsSet returns true if OptNilUUID was set.
-func (o OptNilUUID) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilUUID) Reset() {
-	var v uuid.UUID
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetInstance returns the value of Instance.
+func (s *InventoryCountDiscrepancy) GetInstance() OptInstanceFull {
+	return s.Instance
 }
 
-// SetTo sets value to v.
-func (o *OptNilUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetVariant returns the value of Variant.
+func (s *InventoryCountDiscrepancy) GetVariant() ItemVariant {
+	return s.Variant
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilUUID) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilUUID) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v uuid.UUID
-	o.Value = v
+// GetExpectedCell returns the value of ExpectedCell.
+func (s *InventoryCountDiscrepancy) GetExpectedCell() NilCellForInstanceOptional {
+	return s.ExpectedCell
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetFoundCell returns the value of FoundCell.
+func (s *InventoryCountDiscrepancy) GetFoundCell() NilCellForInstanceOptional {
+	return s.FoundCell
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetQuantity returns the value of Quantity.
+func (s *InventoryCountDiscrepancy) GetQuantity() int {
+	return s.Quantity
 }
 
-// NewOptUUID returns new OptUUID with value set to v.
-func NewOptUUID(v uuid.UUID) OptUUID {
-	return OptUUID{
-		Value: v,
-		Set:   true,
-	}
+// SetInstance sets the value of Instance.
+func (s *InventoryCountDiscrepancy) SetInstance(val OptInstanceFull) {
+	s.Instance = val
 }
 
-// OptUUID is optional uuid.UUID.
-type OptUUID struct {
-	Value uuid.UUID
-	Set   bool
+// SetVariant sets the value of Variant.
+func (s *InventoryCountDiscrepancy) SetVariant(val ItemVariant) {
+	s.Variant = val
 }
 
-// IsSet returns true if OptUUID was set.
-func (o OptUUID) IsSet() bool { return o.Set }
+// SetExpectedCell sets the value of ExpectedCell.
+func (s *InventoryCountDiscrepancy) SetExpectedCell(val NilCellForInstanceOptional) {
+	s.ExpectedCell = val
+}
 
-// Reset unsets value.
-func (o *OptUUID) Reset() {
-	var v uuid.UUID
-	o.Value = v
-	o.Set = false
+// SetFoundCell sets the value of FoundCell.
+func (s *InventoryCountDiscrepancy) SetFoundCell(val NilCellForInstanceOptional) {
+	s.FoundCell = val
 }
 
-// SetTo sets value to v.
-func (o *OptUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Value = v
+// SetQuantity sets the value of Quantity.
+func (s *InventoryCountDiscrepancy) SetQuantity(val int) {
+	s.Quantity = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUUID) Get() (v uuid.UUID, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// Ref: #/components/schemas/InventoryCountReport
+type InventoryCountReport struct {
+	// Recorded in a counted cell but not found there.
+	Missing []InventoryCountDiscrepancy `json:"missing"`
+	// Found in a counted cell with no matching record.
+	Unexpected []InventoryCountDiscrepancy `json:"unexpected"`
+	// Found in a counted cell while recorded in another one.
+	Misplaced      []InventoryCountDiscrepancy `json:"misplaced"`
+	UncountedCells []CellForInstance           `json:"uncountedCells"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetMissing returns the value of Missing.
+func (s *InventoryCountReport) GetMissing() []InventoryCountDiscrepancy {
+	return s.Missing
 }
 
-// Merged schema.
-// Ref: #/components/schemas/Organization
-type Organization struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	Subdomain string    `json:"subdomain"`
+// GetUnexpected returns the value of Unexpected.
+func (s *InventoryCountReport) GetUnexpected() []InventoryCountDiscrepancy {
+	return s.Unexpected
 }
 
-// GetID returns the value of ID.
-func (s *Organization) GetID() uuid.UUID {
-	return s.ID
+// GetMisplaced returns the value of Misplaced.
+func (s *InventoryCountReport) GetMisplaced() []InventoryCountDiscrepancy {
+	return s.Misplaced
 }
 
-// GetName returns the value of Name.
-func (s *Organization) GetName() string {
-	return s.Name
+// GetUncountedCells returns the value of UncountedCells.
+func (s *InventoryCountReport) GetUncountedCells() []CellForInstance {
+	return s.UncountedCells
 }
 
-// GetSubdomain returns the value of Subdomain.
-func (s *Organization) GetSubdomain() string {
-	return s.Subdomain
+// SetMissing sets the value of Missing.
+func (s *InventoryCountReport) SetMissing(val []InventoryCountDiscrepancy) {
+	s.Missing = val
 }
 
-// SetID sets the value of ID.
-func (s *Organization) SetID(val uuid.UUID) {
-	s.ID = val
+// SetUnexpected sets the value of Unexpected.
+func (s *InventoryCountReport) SetUnexpected(val []InventoryCountDiscrepancy) {
+	s.Unexpected = val
 }
 
-// SetName sets the value of Name.
-func (s *Organization) SetName(val string) {
-	s.Name = val
+// SetMisplaced sets the value of Misplaced.
+func (s *InventoryCountReport) SetMisplaced(val []InventoryCountDiscrepancy) {
+	s.Misplaced = val
 }
 
-// SetSubdomain sets the value of Subdomain.
-func (s *Organization) SetSubdomain(val string) {
-	s.Subdomain = val
+// SetUncountedCells sets the value of UncountedCells.
+func (s *InventoryCountReport) SetUncountedCells(val []CellForInstance) {
+	s.UncountedCells = val
 }
 
-// Ref: #/components/schemas/OrganizationUpdate
-type OrganizationUpdate struct {
-	Name string `json:"name"`
+// Ref: #/components/schemas/InventoryCountReportResponse
+type InventoryCountReportResponse struct {
+	Data InventoryCountReport `json:"data"`
 }
 
-// GetName returns the value of Name.
-func (s *OrganizationUpdate) GetName() string {
-	return s.Name
+// GetData returns the value of Data.
+func (s *InventoryCountReportResponse) GetData() InventoryCountReport {
+	return s.Data
 }
 
-// SetName sets the value of Name.
-func (s *OrganizationUpdate) SetName(val string) {
-	s.Name = val
+// SetData sets the value of Data.
+func (s *InventoryCountReportResponse) SetData(val InventoryCountReport) {
+	s.Data = val
 }
 
-type PatchEmployeeByIdBadRequest ErrorContent
+func (*InventoryCountReportResponse) applyInventoryCountAdjustmentsRes() {}
+func (*InventoryCountReportResponse) getInventoryCountReportRes()        {}
 
-func (*PatchEmployeeByIdBadRequest) patchEmployeeByIdRes() {}
+type InviteEmployeeBadRequest ErrorContent
 
-type PatchEmployeeByIdForbidden ErrorContent
-
-func (*PatchEmployeeByIdForbidden) patchEmployeeByIdRes() {}
-
-type PatchEmployeeByIdNotFound ErrorContent
-
-func (*PatchEmployeeByIdNotFound) patchEmployeeByIdRes() {}
+func (*InviteEmployeeBadRequest) inviteEmployeeRes() {}
 
-type PatchEmployeeByIdUnauthorized ErrorContent
+type InviteEmployeeForbidden ErrorContent
 
-func (*PatchEmployeeByIdUnauthorized) patchEmployeeByIdRes() {}
+func (*InviteEmployeeForbidden) inviteEmployeeRes() {}
 
-// Ref: #/components/schemas/PatchEmployeeRequest
-type PatchEmployeeRequest struct {
-	UserId uuid.UUID `json:"userId"`
-	RoleId OptInt    `json:"roleId"`
+// Ref: #/components/schemas/InviteEmployeeRequest
+type InviteEmployeeRequest struct {
+	Email  string `json:"email"`
+	RoleId int    `json:"roleId"`
 }
 
-// GetUserId returns the value of UserId.
-func (s *PatchEmployeeRequest) GetUserId() uuid.UUID {
-	return s.UserId
+// GetEmail returns the value of Email.
+func (s *InviteEmployeeRequest) GetEmail() string {
+	return s.Email
 }
 
 // GetRoleId returns the value of RoleId.
-func (s *PatchEmployeeRequest) GetRoleId() OptInt {
+func (s *InviteEmployeeRequest) GetRoleId() int {
 	return s.RoleId
 }
 
-// SetUserId sets the value of UserId.
-func (s *PatchEmployeeRequest) SetUserId(val uuid.UUID) {
-	s.UserId = val
+// SetEmail sets the value of Email.
+func (s *InviteEmployeeRequest) SetEmail(val string) {
+	s.Email = val
 }
 
 // SetRoleId sets the value of RoleId.
-func (s *PatchEmployeeRequest) SetRoleId(val OptInt) {
+func (s *InviteEmployeeRequest) SetRoleId(val int) {
 	s.RoleId = val
 }
 
-type PickInstanceFromCellBadRequest ErrorContent
-
-func (*PickInstanceFromCellBadRequest) pickInstanceFromCellRes() {}
-
-type PickInstanceFromCellForbidden ErrorContent
-
-func (*PickInstanceFromCellForbidden) pickInstanceFromCellRes() {}
-
-// PickInstanceFromCellNoContent is response for PickInstanceFromCell operation.
-type PickInstanceFromCellNoContent struct{}
+type InviteEmployeeUnauthorized ErrorContent
 
-func (*PickInstanceFromCellNoContent) pickInstanceFromCellRes() {}
+func (*InviteEmployeeUnauthorized) inviteEmployeeRes() {}
 
-type PickInstanceFromCellReq struct {
-	InstanceId uuid.UUID `json:"instanceId"`
+// Merged schema.
+// Ref: #/components/schemas/ItemForList
+type ItemForList struct {
+	// Merged property.
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// Merged property.
+	Description NilString     `json:"description"`
+	Variants    []ItemVariant `json:"variants"`
 }
 
-// GetInstanceId returns the value of InstanceId.
-func (s *PickInstanceFromCellReq) GetInstanceId() uuid.UUID {
-	return s.InstanceId
+// GetID returns the value of ID.
+func (s *ItemForList) GetID() uuid.UUID {
+	return s.ID
 }
 
-// SetInstanceId sets the value of InstanceId.
-func (s *PickInstanceFromCellReq) SetInstanceId(val uuid.UUID) {
-	s.InstanceId = val
+// GetName returns the value of Name.
+func (s *ItemForList) GetName() string {
+	return s.Name
 }
 
-type PickInstanceFromCellUnauthorized ErrorContent
-
-func (*PickInstanceFromCellUnauthorized) pickInstanceFromCellRes() {}
-
-type RevokeApiTokenForbidden ErrorContent
-
-func (*RevokeApiTokenForbidden) revokeApiTokenRes() {}
-
-// RevokeApiTokenNoContent is response for RevokeApiToken operation.
-type RevokeApiTokenNoContent struct{}
+// GetDescription returns the value of Description.
+func (s *ItemForList) GetDescription() NilString {
+	return s.Description
+}
 
-func (*RevokeApiTokenNoContent) revokeApiTokenRes() {}
+// GetVariants returns the value of Variants.
+func (s *ItemForList) GetVariants() []ItemVariant {
+	return s.Variants
+}
 
-type RevokeApiTokenNotFound ErrorContent
+// SetID sets the value of ID.
+func (s *ItemForList) SetID(val uuid.UUID) {
+	s.ID = val
+}
 
-func (*RevokeApiTokenNotFound) revokeApiTokenRes() {}
+// SetName sets the value of Name.
+func (s *ItemForList) SetName(val string) {
+	s.Name = val
+}
 
-type RevokeApiTokenUnauthorized ErrorContent
+// SetDescription sets the value of Description.
+func (s *ItemForList) SetDescription(val NilString) {
+	s.Description = val
+}
 
-func (*RevokeApiTokenUnauthorized) revokeApiTokenRes() {}
+// SetVariants sets the value of Variants.
+func (s *ItemForList) SetVariants(val []ItemVariant) {
+	s.Variants = val
+}
 
-// Ref: #/components/schemas/Role
-type Role struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	DisplayName string `json:"displayName"`
-	Description string `json:"description"`
+// Merged schema.
+// Ref: #/components/schemas/ItemFull
+type ItemFull struct {
+	// Merged property.
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// Merged property.
+	Description NilString         `json:"description"`
+	Variants    []ItemVariant     `json:"variants"`
+	Items       []InstanceForItem `json:"items"`
 }
 
 // GetID returns the value of ID.
-func (s *Role) GetID() int {
+func (s *ItemFull) GetID() uuid.UUID {
 	return s.ID
 }
 
 // GetName returns the value of Name.
-func (s *Role) GetName() string {
+func (s *ItemFull) GetName() string {
 	return s.Name
 }
 
-// GetDisplayName returns the value of DisplayName.
-func (s *Role) GetDisplayName() string {
-	return s.DisplayName
-}
-
 // GetDescription returns the value of Description.
-func (s *Role) GetDescription() string {
+func (s *ItemFull) GetDescription() NilString {
 	return s.Description
 }
 
+// GetVariants returns the value of Variants.
+func (s *ItemFull) GetVariants() []ItemVariant {
+	return s.Variants
+}
+
+// GetItems returns the value of Items.
+func (s *ItemFull) GetItems() []InstanceForItem {
+	return s.Items
+}
+
 // SetID sets the value of ID.
-func (s *Role) SetID(val int) {
+func (s *ItemFull) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
 // SetName sets the value of Name.
-func (s *Role) SetName(val string) {
+func (s *ItemFull) SetName(val string) {
 	s.Name = val
 }
 
-// SetDisplayName sets the value of DisplayName.
-func (s *Role) SetDisplayName(val string) {
-	s.DisplayName = val
-}
-
 // SetDescription sets the value of Description.
-func (s *Role) SetDescription(val string) {
+func (s *ItemFull) SetDescription(val NilString) {
 	s.Description = val
 }
 
-type StorageAlias string
+// SetVariants sets the value of Variants.
+func (s *ItemFull) SetVariants(val []ItemVariant) {
+	s.Variants = val
+}
+
+// SetItems sets the value of Items.
+func (s *ItemFull) SetItems(val []InstanceForItem) {
+	s.Items = val
+}
 
 // Merged schema.
-// Ref: #/components/schemas/StorageGroup
-type StorageGroup struct {
-	ID uuid.UUID `json:"id"`
+// Ref: #/components/schemas/ItemVariant
+type ItemVariant struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
 	// Merged property.
-	ParentId NilUUID `json:"parentId"`
+	Article NilString `json:"article"`
 	// Merged property.
-	UnitId uuid.UUID    `json:"unitId"`
-	Name   string       `json:"name"`
-	Alias  StorageAlias `json:"alias"`
+	Ean13 NilInt64 `json:"ean13"`
+	// Serialized variants are stocked as instances, quantity tracked ones as stock lines holding a
+	// quantity. Cannot change while the variant has stock.
+	TrackingMode OptItemVariantTrackingMode `json:"trackingMode"`
+	// Overrides the allocation policy of the organization, null inherits it.
+	AllocationPolicy OptNilItemVariantAllocationPolicy `json:"allocationPolicy"`
+	// Instances must get a serial number when created or received. Only serialized variants can require
+	// serials.
+	RequiresSerial OptBool `json:"requiresSerial"`
 }
 
 // GetID returns the value of ID.
-func (s *StorageGroup) GetID() uuid.UUID {
+func (s *ItemVariant) GetID() uuid.UUID {
 	return s.ID
 }
 
-// GetParentId returns the value of ParentId.
-func (s *StorageGroup) GetParentId() NilUUID {
-	return s.ParentId
+// GetName returns the value of Name.
+func (s *ItemVariant) GetName() string {
+	return s.Name
 }
 
-// GetUnitId returns the value of UnitId.
-func (s *StorageGroup) GetUnitId() uuid.UUID {
-	return s.UnitId
+// GetArticle returns the value of Article.
+func (s *ItemVariant) GetArticle() NilString {
+	return s.Article
 }
 
-// GetName returns the value of Name.
-func (s *StorageGroup) GetName() string {
-	return s.Name
+// GetEan13 returns the value of Ean13.
+func (s *ItemVariant) GetEan13() NilInt64 {
+	return s.Ean13
 }
 
-// GetAlias returns the value of Alias.
-func (s *StorageGroup) GetAlias() StorageAlias {
-	return s.Alias
+// GetTrackingMode returns the value of TrackingMode.
+func (s *ItemVariant) GetTrackingMode() OptItemVariantTrackingMode {
+	return s.TrackingMode
 }
 
-// SetID sets the value of ID.
-func (s *StorageGroup) SetID(val uuid.UUID) {
-	s.ID = val
+// GetAllocationPolicy returns the value of AllocationPolicy.
+func (s *ItemVariant) GetAllocationPolicy() OptNilItemVariantAllocationPolicy {
+	return s.AllocationPolicy
 }
 
-// SetParentId sets the value of ParentId.
-func (s *StorageGroup) SetParentId(val NilUUID) {
-	s.ParentId = val
+// GetRequiresSerial returns the value of RequiresSerial.
+func (s *ItemVariant) GetRequiresSerial() OptBool {
+	return s.RequiresSerial
 }
 
-// SetUnitId sets the value of UnitId.
-func (s *StorageGroup) SetUnitId(val uuid.UUID) {
-	s.UnitId = val
+// SetID sets the value of ID.
+func (s *ItemVariant) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
 // SetName sets the value of Name.
-func (s *StorageGroup) SetName(val string) {
+func (s *ItemVariant) SetName(val string) {
 	s.Name = val
 }
 
-// SetAlias sets the value of Alias.
-func (s *StorageGroup) SetAlias(val StorageAlias) {
-	s.Alias = val
+// SetArticle sets the value of Article.
+func (s *ItemVariant) SetArticle(val NilString) {
+	s.Article = val
 }
 
-// Ref: #/components/schemas/StorageGroupBase
-type StorageGroupBase struct {
-	ParentId OptNilUUID   `json:"parentId"`
-	Name     string       `json:"name"`
-	Alias    StorageAlias `json:"alias"`
-	UnitId   uuid.UUID    `json:"unitId"`
+// SetEan13 sets the value of Ean13.
+func (s *ItemVariant) SetEan13(val NilInt64) {
+	s.Ean13 = val
 }
 
-// GetParentId returns the value of ParentId.
-func (s *StorageGroupBase) GetParentId() OptNilUUID {
-	return s.ParentId
+// SetTrackingMode sets the value of TrackingMode.
+func (s *ItemVariant) SetTrackingMode(val OptItemVariantTrackingMode) {
+	s.TrackingMode = val
 }
 
-// GetName returns the value of Name.
-func (s *StorageGroupBase) GetName() string {
-	return s.Name
+// SetAllocationPolicy sets the value of AllocationPolicy.
+func (s *ItemVariant) SetAllocationPolicy(val OptNilItemVariantAllocationPolicy) {
+	s.AllocationPolicy = val
 }
 
-// GetAlias returns the value of Alias.
-func (s *StorageGroupBase) GetAlias() StorageAlias {
-	return s.Alias
+// SetRequiresSerial sets the value of RequiresSerial.
+func (s *ItemVariant) SetRequiresSerial(val OptBool) {
+	s.RequiresSerial = val
 }
 
-// GetUnitId returns the value of UnitId.
-func (s *StorageGroupBase) GetUnitId() uuid.UUID {
-	return s.UnitId
-}
+// Overrides the allocation policy of the organization, null inherits it.
+type ItemVariantAllocationPolicy string
 
-// SetParentId sets the value of ParentId.
-func (s *StorageGroupBase) SetParentId(val OptNilUUID) {
-	s.ParentId = val
-}
+const (
+	ItemVariantAllocationPolicyFifo ItemVariantAllocationPolicy = "fifo"
+	ItemVariantAllocationPolicyFefo ItemVariantAllocationPolicy = "fefo"
+)
 
-// SetName sets the value of Name.
-func (s *StorageGroupBase) SetName(val string) {
-	s.Name = val
-}
-
-// SetAlias sets the value of Alias.
-func (s *StorageGroupBase) SetAlias(val StorageAlias) {
-	s.Alias = val
-}
-
-// SetUnitId sets the value of UnitId.
-func (s *StorageGroupBase) SetUnitId(val uuid.UUID) {
-	s.UnitId = val
-}
-
-// Ref: #/components/schemas/TaskBase
-type TaskBase struct {
-	ID          uuid.UUID           `json:"id"`
-	Name        string              `json:"name"`
-	Description NilString           `json:"description"`
-	Type        TaskBaseType        `json:"type"`
-	Status      TaskBaseStatus      `json:"status"`
-	CreatedAt   time.Time           `json:"createdAt"`
-	Unit        Unit                `json:"unit"`
-	AssignedTo  NilEmployeeOptional `json:"assignedTo"`
-	AssignedAt  NilDateTime         `json:"assignedAt"`
-	CompletedAt NilDateTime         `json:"completedAt"`
-}
-
-// GetID returns the value of ID.
-func (s *TaskBase) GetID() uuid.UUID {
-	return s.ID
-}
-
-// GetName returns the value of Name.
-func (s *TaskBase) GetName() string {
-	return s.Name
-}
-
-// GetDescription returns the value of Description.
-func (s *TaskBase) GetDescription() NilString {
-	return s.Description
-}
-
-// GetType returns the value of Type.
-func (s *TaskBase) GetType() TaskBaseType {
-	return s.Type
-}
-
-// GetStatus returns the value of Status.
-func (s *TaskBase) GetStatus() TaskBaseStatus {
-	return s.Status
-}
-
-// GetCreatedAt returns the value of CreatedAt.
-func (s *TaskBase) GetCreatedAt() time.Time {
-	return s.CreatedAt
-}
-
-// GetUnit returns the value of Unit.
-func (s *TaskBase) GetUnit() Unit {
-	return s.Unit
-}
-
-// GetAssignedTo returns the value of AssignedTo.
-func (s *TaskBase) GetAssignedTo() NilEmployeeOptional {
-	return s.AssignedTo
-}
-
-// GetAssignedAt returns the value of AssignedAt.
-func (s *TaskBase) GetAssignedAt() NilDateTime {
-	return s.AssignedAt
-}
-
-// GetCompletedAt returns the value of CompletedAt.
-func (s *TaskBase) GetCompletedAt() NilDateTime {
-	return s.CompletedAt
-}
-
-// SetID sets the value of ID.
-func (s *TaskBase) SetID(val uuid.UUID) {
-	s.ID = val
-}
-
-// SetName sets the value of Name.
-func (s *TaskBase) SetName(val string) {
-	s.Name = val
-}
-
-// SetDescription sets the value of Description.
-func (s *TaskBase) SetDescription(val NilString) {
-	s.Description = val
-}
-
-// SetType sets the value of Type.
-func (s *TaskBase) SetType(val TaskBaseType) {
-	s.Type = val
-}
-
-// SetStatus sets the value of Status.
-func (s *TaskBase) SetStatus(val TaskBaseStatus) {
-	s.Status = val
-}
-
-// SetCreatedAt sets the value of CreatedAt.
-func (s *TaskBase) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
-}
-
-// SetUnit sets the value of Unit.
-func (s *TaskBase) SetUnit(val Unit) {
-	s.Unit = val
-}
-
-// SetAssignedTo sets the value of AssignedTo.
-func (s *TaskBase) SetAssignedTo(val NilEmployeeOptional) {
-	s.AssignedTo = val
-}
-
-// SetAssignedAt sets the value of AssignedAt.
-func (s *TaskBase) SetAssignedAt(val NilDateTime) {
-	s.AssignedAt = val
-}
-
-// SetCompletedAt sets the value of CompletedAt.
-func (s *TaskBase) SetCompletedAt(val NilDateTime) {
-	s.CompletedAt = val
-}
-
-type TaskBaseStatus string
-
-const (
-	TaskBaseStatusPending    TaskBaseStatus = "pending"
-	TaskBaseStatusInProgress TaskBaseStatus = "in_progress"
-	TaskBaseStatusReady      TaskBaseStatus = "ready"
-	TaskBaseStatusCompleted  TaskBaseStatus = "completed"
-	TaskBaseStatusCancelled  TaskBaseStatus = "cancelled"
-)
-
-// AllValues returns all TaskBaseStatus values.
-func (TaskBaseStatus) AllValues() []TaskBaseStatus {
-	return []TaskBaseStatus{
-		TaskBaseStatusPending,
-		TaskBaseStatusInProgress,
-		TaskBaseStatusReady,
-		TaskBaseStatusCompleted,
-		TaskBaseStatusCancelled,
-	}
+// AllValues returns all ItemVariantAllocationPolicy values.
+func (ItemVariantAllocationPolicy) AllValues() []ItemVariantAllocationPolicy {
+	return []ItemVariantAllocationPolicy{
+		ItemVariantAllocationPolicyFifo,
+		ItemVariantAllocationPolicyFefo,
+	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s TaskBaseStatus) MarshalText() ([]byte, error) {
+func (s ItemVariantAllocationPolicy) MarshalText() ([]byte, error) {
 	switch s {
-	case TaskBaseStatusPending:
-		return []byte(s), nil
-	case TaskBaseStatusInProgress:
-		return []byte(s), nil
-	case TaskBaseStatusReady:
-		return []byte(s), nil
-	case TaskBaseStatusCompleted:
+	case ItemVariantAllocationPolicyFifo:
 		return []byte(s), nil
-	case TaskBaseStatusCancelled:
+	case ItemVariantAllocationPolicyFefo:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -4378,49 +6283,42 @@ func (s TaskBaseStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TaskBaseStatus) UnmarshalText(data []byte) error {
-	switch TaskBaseStatus(data) {
-	case TaskBaseStatusPending:
-		*s = TaskBaseStatusPending
-		return nil
-	case TaskBaseStatusInProgress:
-		*s = TaskBaseStatusInProgress
-		return nil
-	case TaskBaseStatusReady:
-		*s = TaskBaseStatusReady
-		return nil
-	case TaskBaseStatusCompleted:
-		*s = TaskBaseStatusCompleted
+func (s *ItemVariantAllocationPolicy) UnmarshalText(data []byte) error {
+	switch ItemVariantAllocationPolicy(data) {
+	case ItemVariantAllocationPolicyFifo:
+		*s = ItemVariantAllocationPolicyFifo
 		return nil
-	case TaskBaseStatusCancelled:
-		*s = TaskBaseStatusCancelled
+	case ItemVariantAllocationPolicyFefo:
+		*s = ItemVariantAllocationPolicyFefo
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type TaskBaseType string
+// Serialized variants are stocked as instances, quantity tracked ones as stock lines holding a
+// quantity. Cannot change while the variant has stock.
+type ItemVariantTrackingMode string
 
 const (
-	TaskBaseTypePickment TaskBaseType = "pickment"
-	TaskBaseTypeMovement TaskBaseType = "movement"
+	ItemVariantTrackingModeSerialized ItemVariantTrackingMode = "serialized"
+	ItemVariantTrackingModeQuantity   ItemVariantTrackingMode = "quantity"
 )
 
-// AllValues returns all TaskBaseType values.
-func (TaskBaseType) AllValues() []TaskBaseType {
-	return []TaskBaseType{
-		TaskBaseTypePickment,
-		TaskBaseTypeMovement,
+// AllValues returns all ItemVariantTrackingMode values.
+func (ItemVariantTrackingMode) AllValues() []ItemVariantTrackingMode {
+	return []ItemVariantTrackingMode{
+		ItemVariantTrackingModeSerialized,
+		ItemVariantTrackingModeQuantity,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s TaskBaseType) MarshalText() ([]byte, error) {
+func (s ItemVariantTrackingMode) MarshalText() ([]byte, error) {
 	switch s {
-	case TaskBaseTypePickment:
+	case ItemVariantTrackingModeSerialized:
 		return []byte(s), nil
-	case TaskBaseTypeMovement:
+	case ItemVariantTrackingModeQuantity:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -4428,178 +6326,7376 @@ func (s TaskBaseType) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TaskBaseType) UnmarshalText(data []byte) error {
-	switch TaskBaseType(data) {
-	case TaskBaseTypePickment:
-		*s = TaskBaseTypePickment
+func (s *ItemVariantTrackingMode) UnmarshalText(data []byte) error {
+	switch ItemVariantTrackingMode(data) {
+	case ItemVariantTrackingModeSerialized:
+		*s = ItemVariantTrackingModeSerialized
 		return nil
-	case TaskBaseTypeMovement:
-		*s = TaskBaseTypeMovement
+	case ItemVariantTrackingModeQuantity:
+		*s = ItemVariantTrackingModeQuantity
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Merged schema.
-// Ref: #/components/schemas/TaskFull
-type TaskFull struct {
-	ID          uuid.UUID           `json:"id"`
-	Name        string              `json:"name"`
-	Description NilString           `json:"description"`
-	Type        TaskFullType        `json:"type"`
-	Status      TaskFullStatus      `json:"status"`
-	CreatedAt   time.Time           `json:"createdAt"`
-	Unit        Unit                `json:"unit"`
-	AssignedTo  NilEmployeeOptional `json:"assignedTo"`
-	AssignedAt  NilDateTime         `json:"assignedAt"`
-	CompletedAt NilDateTime         `json:"completedAt"`
-	Items       []TaskItem          `json:"items"`
+// Ref: #/components/responses/LogoutResponse
+type LogoutResponse struct {
+	SetCookie string
 }
 
-// GetID returns the value of ID.
-func (s *TaskFull) GetID() uuid.UUID {
-	return s.ID
+// GetSetCookie returns the value of SetCookie.
+func (s *LogoutResponse) GetSetCookie() string {
+	return s.SetCookie
 }
 
-// GetName returns the value of Name.
-func (s *TaskFull) GetName() string {
-	return s.Name
+// SetSetCookie sets the value of SetCookie.
+func (s *LogoutResponse) SetSetCookie(val string) {
+	s.SetCookie = val
 }
 
-// GetDescription returns the value of Description.
-func (s *TaskFull) GetDescription() NilString {
-	return s.Description
-}
+func (*LogoutResponse) logoutRes() {}
 
-// GetType returns the value of Type.
-func (s *TaskFull) GetType() TaskFullType {
-	return s.Type
-}
+type MarkTaskAsAwaitingBadRequest ErrorContent
 
-// GetStatus returns the value of Status.
-func (s *TaskFull) GetStatus() TaskFullStatus {
-	return s.Status
-}
+func (*MarkTaskAsAwaitingBadRequest) markTaskAsAwaitingRes() {}
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *TaskFull) GetCreatedAt() time.Time {
-	return s.CreatedAt
-}
+type MarkTaskAsAwaitingForbidden ErrorContent
 
-// GetUnit returns the value of Unit.
-func (s *TaskFull) GetUnit() Unit {
-	return s.Unit
-}
+func (*MarkTaskAsAwaitingForbidden) markTaskAsAwaitingRes() {}
 
-// GetAssignedTo returns the value of AssignedTo.
-func (s *TaskFull) GetAssignedTo() NilEmployeeOptional {
-	return s.AssignedTo
-}
+// MarkTaskAsAwaitingNoContent is response for MarkTaskAsAwaiting operation.
+type MarkTaskAsAwaitingNoContent struct{}
 
-// GetAssignedAt returns the value of AssignedAt.
-func (s *TaskFull) GetAssignedAt() NilDateTime {
-	return s.AssignedAt
-}
+func (*MarkTaskAsAwaitingNoContent) markTaskAsAwaitingRes() {}
 
-// GetCompletedAt returns the value of CompletedAt.
-func (s *TaskFull) GetCompletedAt() NilDateTime {
-	return s.CompletedAt
-}
+type MarkTaskAsAwaitingUnauthorized ErrorContent
 
-// GetItems returns the value of Items.
-func (s *TaskFull) GetItems() []TaskItem {
-	return s.Items
-}
+func (*MarkTaskAsAwaitingUnauthorized) markTaskAsAwaitingRes() {}
 
-// SetID sets the value of ID.
-func (s *TaskFull) SetID(val uuid.UUID) {
-	s.ID = val
-}
+type MarkTaskAsCompletedBadRequest ErrorContent
 
-// SetName sets the value of Name.
-func (s *TaskFull) SetName(val string) {
-	s.Name = val
-}
+func (*MarkTaskAsCompletedBadRequest) markTaskAsCompletedRes() {}
 
-// SetDescription sets the value of Description.
-func (s *TaskFull) SetDescription(val NilString) {
-	s.Description = val
-}
+type MarkTaskAsCompletedConflict ErrorContent
 
-// SetType sets the value of Type.
-func (s *TaskFull) SetType(val TaskFullType) {
-	s.Type = val
-}
+func (*MarkTaskAsCompletedConflict) markTaskAsCompletedRes() {}
+
+type MarkTaskAsCompletedForbidden ErrorContent
+
+func (*MarkTaskAsCompletedForbidden) markTaskAsCompletedRes() {}
+
+// MarkTaskAsCompletedNoContent is response for MarkTaskAsCompleted operation.
+type MarkTaskAsCompletedNoContent struct{}
+
+func (*MarkTaskAsCompletedNoContent) markTaskAsCompletedRes() {}
+
+type MarkTaskAsCompletedUnauthorized ErrorContent
+
+func (*MarkTaskAsCompletedUnauthorized) markTaskAsCompletedRes() {}
+
+type MergeStockLinesBadRequest ErrorContent
+
+func (*MergeStockLinesBadRequest) mergeStockLinesRes() {}
+
+type MergeStockLinesForbidden ErrorContent
+
+func (*MergeStockLinesForbidden) mergeStockLinesRes() {}
+
+type MergeStockLinesNotFound ErrorContent
+
+func (*MergeStockLinesNotFound) mergeStockLinesRes() {}
+
+// Ref: #/components/schemas/MergeStockLinesRequest
+type MergeStockLinesRequest struct {
+	// Available lines of one variant in one cell. The quantity is summed into the first line.
+	StockLineIds []uuid.UUID `json:"stockLineIds"`
+}
+
+// GetStockLineIds returns the value of StockLineIds.
+func (s *MergeStockLinesRequest) GetStockLineIds() []uuid.UUID {
+	return s.StockLineIds
+}
+
+// SetStockLineIds sets the value of StockLineIds.
+func (s *MergeStockLinesRequest) SetStockLineIds(val []uuid.UUID) {
+	s.StockLineIds = val
+}
+
+type MergeStockLinesUnauthorized ErrorContent
+
+func (*MergeStockLinesUnauthorized) mergeStockLinesRes() {}
+
+// NewNilAuditLogPostchangeState returns new NilAuditLogPostchangeState with value set to v.
+func NewNilAuditLogPostchangeState(v AuditLogPostchangeState) NilAuditLogPostchangeState {
+	return NilAuditLogPostchangeState{
+		Value: v,
+	}
+}
+
+// NilAuditLogPostchangeState is nullable AuditLogPostchangeState.
+type NilAuditLogPostchangeState struct {
+	Value AuditLogPostchangeState
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilAuditLogPostchangeState) SetTo(v AuditLogPostchangeState) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilAuditLogPostchangeState) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilAuditLogPostchangeState) SetToNull() {
+	o.Null = true
+	var v AuditLogPostchangeState
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilAuditLogPostchangeState) Get() (v AuditLogPostchangeState, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilAuditLogPostchangeState) Or(d AuditLogPostchangeState) AuditLogPostchangeState {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilAuditLogPrechangeState returns new NilAuditLogPrechangeState with value set to v.
+func NewNilAuditLogPrechangeState(v AuditLogPrechangeState) NilAuditLogPrechangeState {
+	return NilAuditLogPrechangeState{
+		Value: v,
+	}
+}
+
+// NilAuditLogPrechangeState is nullable AuditLogPrechangeState.
+type NilAuditLogPrechangeState struct {
+	Value AuditLogPrechangeState
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilAuditLogPrechangeState) SetTo(v AuditLogPrechangeState) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilAuditLogPrechangeState) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilAuditLogPrechangeState) SetToNull() {
+	o.Null = true
+	var v AuditLogPrechangeState
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilAuditLogPrechangeState) Get() (v AuditLogPrechangeState, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilAuditLogPrechangeState) Or(d AuditLogPrechangeState) AuditLogPrechangeState {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilCellForInstanceOptional returns new NilCellForInstanceOptional with value set to v.
+func NewNilCellForInstanceOptional(v CellForInstanceOptional) NilCellForInstanceOptional {
+	return NilCellForInstanceOptional{
+		Value: v,
+	}
+}
+
+// NilCellForInstanceOptional is nullable CellForInstanceOptional.
+type NilCellForInstanceOptional struct {
+	Value CellForInstanceOptional
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilCellForInstanceOptional) SetTo(v CellForInstanceOptional) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilCellForInstanceOptional) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilCellForInstanceOptional) SetToNull() {
+	o.Null = true
+	var v CellForInstanceOptional
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilCellForInstanceOptional) Get() (v CellForInstanceOptional, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilCellForInstanceOptional) Or(d CellForInstanceOptional) CellForInstanceOptional {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDate returns new NilDate with value set to v.
+func NewNilDate(v time.Time) NilDate {
+	return NilDate{
+		Value: v,
+	}
+}
+
+// NilDate is nullable time.Time.
+type NilDate struct {
+	Value time.Time
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDate) SetTo(v time.Time) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilDate) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilDate) SetToNull() {
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDate) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDateTime returns new NilDateTime with value set to v.
+func NewNilDateTime(v time.Time) NilDateTime {
+	return NilDateTime{
+		Value: v,
+	}
+}
+
+// NilDateTime is nullable time.Time.
+type NilDateTime struct {
+	Value time.Time
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDateTime) SetTo(v time.Time) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilDateTime) SetToNull() {
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilEmployeeOptional returns new NilEmployeeOptional with value set to v.
+func NewNilEmployeeOptional(v EmployeeOptional) NilEmployeeOptional {
+	return NilEmployeeOptional{
+		Value: v,
+	}
+}
+
+// NilEmployeeOptional is nullable EmployeeOptional.
+type NilEmployeeOptional struct {
+	Value EmployeeOptional
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilEmployeeOptional) SetTo(v EmployeeOptional) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilEmployeeOptional) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilEmployeeOptional) SetToNull() {
+	o.Null = true
+	var v EmployeeOptional
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilEmployeeOptional) Get() (v EmployeeOptional, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilEmployeeOptional) Or(d EmployeeOptional) EmployeeOptional {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilGS1Data returns new NilGS1Data with value set to v.
+func NewNilGS1Data(v GS1Data) NilGS1Data {
+	return NilGS1Data{
+		Value: v,
+	}
+}
+
+// NilGS1Data is nullable GS1Data.
+type NilGS1Data struct {
+	Value GS1Data
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilGS1Data) SetTo(v GS1Data) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilGS1Data) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilGS1Data) SetToNull() {
+	o.Null = true
+	var v GS1Data
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilGS1Data) Get() (v GS1Data, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilGS1Data) Or(d GS1Data) GS1Data {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilInt returns new NilInt with value set to v.
+func NewNilInt(v int) NilInt {
+	return NilInt{
+		Value: v,
+	}
+}
+
+// NilInt is nullable int.
+type NilInt struct {
+	Value int
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt) SetTo(v int) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilInt) SetToNull() {
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilInt64 returns new NilInt64 with value set to v.
+func NewNilInt64(v int64) NilInt64 {
+	return NilInt64{
+		Value: v,
+	}
+}
+
+// NilInt64 is nullable int64.
+type NilInt64 struct {
+	Value int64
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt64) SetTo(v int64) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilInt64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilInt64) SetToNull() {
+	o.Null = true
+	var v int64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt64) Get() (v int64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilTaskExpectedItemDiscrepancy returns new NilTaskExpectedItemDiscrepancy with value set to v.
+func NewNilTaskExpectedItemDiscrepancy(v TaskExpectedItemDiscrepancy) NilTaskExpectedItemDiscrepancy {
+	return NilTaskExpectedItemDiscrepancy{
+		Value: v,
+	}
+}
+
+// NilTaskExpectedItemDiscrepancy is nullable TaskExpectedItemDiscrepancy.
+type NilTaskExpectedItemDiscrepancy struct {
+	Value TaskExpectedItemDiscrepancy
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilTaskExpectedItemDiscrepancy) SetTo(v TaskExpectedItemDiscrepancy) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilTaskExpectedItemDiscrepancy) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilTaskExpectedItemDiscrepancy) SetToNull() {
+	o.Null = true
+	var v TaskExpectedItemDiscrepancy
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilTaskExpectedItemDiscrepancy) Get() (v TaskExpectedItemDiscrepancy, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilTaskExpectedItemDiscrepancy) Or(d TaskExpectedItemDiscrepancy) TaskExpectedItemDiscrepancy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilTaskItemDisposition returns new NilTaskItemDisposition with value set to v.
+func NewNilTaskItemDisposition(v TaskItemDisposition) NilTaskItemDisposition {
+	return NilTaskItemDisposition{
+		Value: v,
+	}
+}
+
+// NilTaskItemDisposition is nullable TaskItemDisposition.
+type NilTaskItemDisposition struct {
+	Value TaskItemDisposition
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilTaskItemDisposition) SetTo(v TaskItemDisposition) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilTaskItemDisposition) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilTaskItemDisposition) SetToNull() {
+	o.Null = true
+	var v TaskItemDisposition
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilTaskItemDisposition) Get() (v TaskItemDisposition, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilTaskItemDisposition) Or(d TaskItemDisposition) TaskItemDisposition {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilTaskStatusChangeFromStatus returns new NilTaskStatusChangeFromStatus with value set to v.
+func NewNilTaskStatusChangeFromStatus(v TaskStatusChangeFromStatus) NilTaskStatusChangeFromStatus {
+	return NilTaskStatusChangeFromStatus{
+		Value: v,
+	}
+}
+
+// NilTaskStatusChangeFromStatus is nullable TaskStatusChangeFromStatus.
+type NilTaskStatusChangeFromStatus struct {
+	Value TaskStatusChangeFromStatus
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilTaskStatusChangeFromStatus) SetTo(v TaskStatusChangeFromStatus) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilTaskStatusChangeFromStatus) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilTaskStatusChangeFromStatus) SetToNull() {
+	o.Null = true
+	var v TaskStatusChangeFromStatus
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilTaskStatusChangeFromStatus) Get() (v TaskStatusChangeFromStatus, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilTaskStatusChangeFromStatus) Or(d TaskStatusChangeFromStatus) TaskStatusChangeFromStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilUUID returns new NilUUID with value set to v.
+func NewNilUUID(v uuid.UUID) NilUUID {
+	return NilUUID{
+		Value: v,
+	}
+}
+
+// NilUUID is nullable uuid.UUID.
+type NilUUID struct {
+	Value uuid.UUID
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilUUID) SetTo(v uuid.UUID) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilUUID) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilUUID) SetToNull() {
+	o.Null = true
+	var v uuid.UUID
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilUUID) Get() (v uuid.UUID, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAllocationPolicy returns new OptAllocationPolicy with value set to v.
+func NewOptAllocationPolicy(v AllocationPolicy) OptAllocationPolicy {
+	return OptAllocationPolicy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAllocationPolicy is optional AllocationPolicy.
+type OptAllocationPolicy struct {
+	Value AllocationPolicy
+	Set   bool
+}
+
+// IsSet returns true if OptAllocationPolicy was set.
+func (o OptAllocationPolicy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAllocationPolicy) Reset() {
+	var v AllocationPolicy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAllocationPolicy) SetTo(v AllocationPolicy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAllocationPolicy) Get() (v AllocationPolicy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAllocationPolicy) Or(d AllocationPolicy) AllocationPolicy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCellForInstance returns new OptCellForInstance with value set to v.
+func NewOptCellForInstance(v CellForInstance) OptCellForInstance {
+	return OptCellForInstance{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCellForInstance is optional CellForInstance.
+type OptCellForInstance struct {
+	Value CellForInstance
+	Set   bool
+}
+
+// IsSet returns true if OptCellForInstance was set.
+func (o OptCellForInstance) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCellForInstance) Reset() {
+	var v CellForInstance
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCellForInstance) SetTo(v CellForInstance) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCellForInstance) Get() (v CellForInstance, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCellForInstance) Or(d CellForInstance) CellForInstance {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCompleteTaskRequest returns new OptCompleteTaskRequest with value set to v.
+func NewOptCompleteTaskRequest(v CompleteTaskRequest) OptCompleteTaskRequest {
+	return OptCompleteTaskRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCompleteTaskRequest is optional CompleteTaskRequest.
+type OptCompleteTaskRequest struct {
+	Value CompleteTaskRequest
+	Set   bool
+}
+
+// IsSet returns true if OptCompleteTaskRequest was set.
+func (o OptCompleteTaskRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCompleteTaskRequest) Reset() {
+	var v CompleteTaskRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCompleteTaskRequest) SetTo(v CompleteTaskRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCompleteTaskRequest) Get() (v CompleteTaskRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCompleteTaskRequest) Or(d CompleteTaskRequest) CompleteTaskRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateItemVariantRequestTrackingMode returns new OptCreateItemVariantRequestTrackingMode with value set to v.
+func NewOptCreateItemVariantRequestTrackingMode(v CreateItemVariantRequestTrackingMode) OptCreateItemVariantRequestTrackingMode {
+	return OptCreateItemVariantRequestTrackingMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateItemVariantRequestTrackingMode is optional CreateItemVariantRequestTrackingMode.
+type OptCreateItemVariantRequestTrackingMode struct {
+	Value CreateItemVariantRequestTrackingMode
+	Set   bool
+}
+
+// IsSet returns true if OptCreateItemVariantRequestTrackingMode was set.
+func (o OptCreateItemVariantRequestTrackingMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateItemVariantRequestTrackingMode) Reset() {
+	var v CreateItemVariantRequestTrackingMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateItemVariantRequestTrackingMode) SetTo(v CreateItemVariantRequestTrackingMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateItemVariantRequestTrackingMode) Get() (v CreateItemVariantRequestTrackingMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateItemVariantRequestTrackingMode) Or(d CreateItemVariantRequestTrackingMode) CreateItemVariantRequestTrackingMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateTaskRequestPriority returns new OptCreateTaskRequestPriority with value set to v.
+func NewOptCreateTaskRequestPriority(v CreateTaskRequestPriority) OptCreateTaskRequestPriority {
+	return OptCreateTaskRequestPriority{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateTaskRequestPriority is optional CreateTaskRequestPriority.
+type OptCreateTaskRequestPriority struct {
+	Value CreateTaskRequestPriority
+	Set   bool
+}
+
+// IsSet returns true if OptCreateTaskRequestPriority was set.
+func (o OptCreateTaskRequestPriority) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateTaskRequestPriority) Reset() {
+	var v CreateTaskRequestPriority
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateTaskRequestPriority) SetTo(v CreateTaskRequestPriority) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateTaskRequestPriority) Get() (v CreateTaskRequestPriority, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateTaskRequestPriority) Or(d CreateTaskRequestPriority) CreateTaskRequestPriority {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateTaskTemplateRequestPriority returns new OptCreateTaskTemplateRequestPriority with value set to v.
+func NewOptCreateTaskTemplateRequestPriority(v CreateTaskTemplateRequestPriority) OptCreateTaskTemplateRequestPriority {
+	return OptCreateTaskTemplateRequestPriority{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateTaskTemplateRequestPriority is optional CreateTaskTemplateRequestPriority.
+type OptCreateTaskTemplateRequestPriority struct {
+	Value CreateTaskTemplateRequestPriority
+	Set   bool
+}
+
+// IsSet returns true if OptCreateTaskTemplateRequestPriority was set.
+func (o OptCreateTaskTemplateRequestPriority) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateTaskTemplateRequestPriority) Reset() {
+	var v CreateTaskTemplateRequestPriority
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateTaskTemplateRequestPriority) SetTo(v CreateTaskTemplateRequestPriority) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateTaskTemplateRequestPriority) Get() (v CreateTaskTemplateRequestPriority, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateTaskTemplateRequestPriority) Or(d CreateTaskTemplateRequestPriority) CreateTaskTemplateRequestPriority {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetStockLevelsGroupBy returns new OptGetStockLevelsGroupBy with value set to v.
+func NewOptGetStockLevelsGroupBy(v GetStockLevelsGroupBy) OptGetStockLevelsGroupBy {
+	return OptGetStockLevelsGroupBy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetStockLevelsGroupBy is optional GetStockLevelsGroupBy.
+type OptGetStockLevelsGroupBy struct {
+	Value GetStockLevelsGroupBy
+	Set   bool
+}
+
+// IsSet returns true if OptGetStockLevelsGroupBy was set.
+func (o OptGetStockLevelsGroupBy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetStockLevelsGroupBy) Reset() {
+	var v GetStockLevelsGroupBy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetStockLevelsGroupBy) SetTo(v GetStockLevelsGroupBy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetStockLevelsGroupBy) Get() (v GetStockLevelsGroupBy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetStockLevelsGroupBy) Or(d GetStockLevelsGroupBy) GetStockLevelsGroupBy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetStockLinesStatus returns new OptGetStockLinesStatus with value set to v.
+func NewOptGetStockLinesStatus(v GetStockLinesStatus) OptGetStockLinesStatus {
+	return OptGetStockLinesStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetStockLinesStatus is optional GetStockLinesStatus.
+type OptGetStockLinesStatus struct {
+	Value GetStockLinesStatus
+	Set   bool
+}
+
+// IsSet returns true if OptGetStockLinesStatus was set.
+func (o OptGetStockLinesStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetStockLinesStatus) Reset() {
+	var v GetStockLinesStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetStockLinesStatus) SetTo(v GetStockLinesStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetStockLinesStatus) Get() (v GetStockLinesStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetStockLinesStatus) Or(d GetStockLinesStatus) GetStockLinesStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetTaskExceptionsReportReason returns new OptGetTaskExceptionsReportReason with value set to v.
+func NewOptGetTaskExceptionsReportReason(v GetTaskExceptionsReportReason) OptGetTaskExceptionsReportReason {
+	return OptGetTaskExceptionsReportReason{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetTaskExceptionsReportReason is optional GetTaskExceptionsReportReason.
+type OptGetTaskExceptionsReportReason struct {
+	Value GetTaskExceptionsReportReason
+	Set   bool
+}
+
+// IsSet returns true if OptGetTaskExceptionsReportReason was set.
+func (o OptGetTaskExceptionsReportReason) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetTaskExceptionsReportReason) Reset() {
+	var v GetTaskExceptionsReportReason
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetTaskExceptionsReportReason) SetTo(v GetTaskExceptionsReportReason) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetTaskExceptionsReportReason) Get() (v GetTaskExceptionsReportReason, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetTaskExceptionsReportReason) Or(d GetTaskExceptionsReportReason) GetTaskExceptionsReportReason {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetTasksSortBy returns new OptGetTasksSortBy with value set to v.
+func NewOptGetTasksSortBy(v GetTasksSortBy) OptGetTasksSortBy {
+	return OptGetTasksSortBy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetTasksSortBy is optional GetTasksSortBy.
+type OptGetTasksSortBy struct {
+	Value GetTasksSortBy
+	Set   bool
+}
+
+// IsSet returns true if OptGetTasksSortBy was set.
+func (o OptGetTasksSortBy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetTasksSortBy) Reset() {
+	var v GetTasksSortBy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetTasksSortBy) SetTo(v GetTasksSortBy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetTasksSortBy) Get() (v GetTasksSortBy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetTasksSortBy) Or(d GetTasksSortBy) GetTasksSortBy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetTasksSortOrder returns new OptGetTasksSortOrder with value set to v.
+func NewOptGetTasksSortOrder(v GetTasksSortOrder) OptGetTasksSortOrder {
+	return OptGetTasksSortOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetTasksSortOrder is optional GetTasksSortOrder.
+type OptGetTasksSortOrder struct {
+	Value GetTasksSortOrder
+	Set   bool
+}
+
+// IsSet returns true if OptGetTasksSortOrder was set.
+func (o OptGetTasksSortOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetTasksSortOrder) Reset() {
+	var v GetTasksSortOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetTasksSortOrder) SetTo(v GetTasksSortOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetTasksSortOrder) Get() (v GetTasksSortOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetTasksSortOrder) Or(d GetTasksSortOrder) GetTasksSortOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetTasksType returns new OptGetTasksType with value set to v.
+func NewOptGetTasksType(v GetTasksType) OptGetTasksType {
+	return OptGetTasksType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetTasksType is optional GetTasksType.
+type OptGetTasksType struct {
+	Value GetTasksType
+	Set   bool
+}
+
+// IsSet returns true if OptGetTasksType was set.
+func (o OptGetTasksType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetTasksType) Reset() {
+	var v GetTasksType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetTasksType) SetTo(v GetTasksType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetTasksType) Get() (v GetTasksType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetTasksType) Or(d GetTasksType) GetTasksType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetTransferOrdersStatus returns new OptGetTransferOrdersStatus with value set to v.
+func NewOptGetTransferOrdersStatus(v GetTransferOrdersStatus) OptGetTransferOrdersStatus {
+	return OptGetTransferOrdersStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetTransferOrdersStatus is optional GetTransferOrdersStatus.
+type OptGetTransferOrdersStatus struct {
+	Value GetTransferOrdersStatus
+	Set   bool
+}
+
+// IsSet returns true if OptGetTransferOrdersStatus was set.
+func (o OptGetTransferOrdersStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetTransferOrdersStatus) Reset() {
+	var v GetTransferOrdersStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetTransferOrdersStatus) SetTo(v GetTransferOrdersStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetTransferOrdersStatus) Get() (v GetTransferOrdersStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetTransferOrdersStatus) Or(d GetTransferOrdersStatus) GetTransferOrdersStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInstanceFull returns new OptInstanceFull with value set to v.
+func NewOptInstanceFull(v InstanceFull) OptInstanceFull {
+	return OptInstanceFull{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInstanceFull is optional InstanceFull.
+type OptInstanceFull struct {
+	Value InstanceFull
+	Set   bool
+}
+
+// IsSet returns true if OptInstanceFull was set.
+func (o OptInstanceFull) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInstanceFull) Reset() {
+	var v InstanceFull
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInstanceFull) SetTo(v InstanceFull) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInstanceFull) Get() (v InstanceFull, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInstanceFull) Or(d InstanceFull) InstanceFull {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptItemVariant returns new OptItemVariant with value set to v.
+func NewOptItemVariant(v ItemVariant) OptItemVariant {
+	return OptItemVariant{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptItemVariant is optional ItemVariant.
+type OptItemVariant struct {
+	Value ItemVariant
+	Set   bool
+}
+
+// IsSet returns true if OptItemVariant was set.
+func (o OptItemVariant) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptItemVariant) Reset() {
+	var v ItemVariant
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptItemVariant) SetTo(v ItemVariant) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptItemVariant) Get() (v ItemVariant, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptItemVariant) Or(d ItemVariant) ItemVariant {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptItemVariantTrackingMode returns new OptItemVariantTrackingMode with value set to v.
+func NewOptItemVariantTrackingMode(v ItemVariantTrackingMode) OptItemVariantTrackingMode {
+	return OptItemVariantTrackingMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptItemVariantTrackingMode is optional ItemVariantTrackingMode.
+type OptItemVariantTrackingMode struct {
+	Value ItemVariantTrackingMode
+	Set   bool
+}
+
+// IsSet returns true if OptItemVariantTrackingMode was set.
+func (o OptItemVariantTrackingMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptItemVariantTrackingMode) Reset() {
+	var v ItemVariantTrackingMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptItemVariantTrackingMode) SetTo(v ItemVariantTrackingMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptItemVariantTrackingMode) Get() (v ItemVariantTrackingMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptItemVariantTrackingMode) Or(d ItemVariantTrackingMode) ItemVariantTrackingMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilCreateItemVariantRequestAllocationPolicy returns new OptNilCreateItemVariantRequestAllocationPolicy with value set to v.
+func NewOptNilCreateItemVariantRequestAllocationPolicy(v CreateItemVariantRequestAllocationPolicy) OptNilCreateItemVariantRequestAllocationPolicy {
+	return OptNilCreateItemVariantRequestAllocationPolicy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilCreateItemVariantRequestAllocationPolicy is optional nullable CreateItemVariantRequestAllocationPolicy.
+type OptNilCreateItemVariantRequestAllocationPolicy struct {
+	Value CreateItemVariantRequestAllocationPolicy
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilCreateItemVariantRequestAllocationPolicy was set.
+func (o OptNilCreateItemVariantRequestAllocationPolicy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilCreateItemVariantRequestAllocationPolicy) Reset() {
+	var v CreateItemVariantRequestAllocationPolicy
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilCreateItemVariantRequestAllocationPolicy) SetTo(v CreateItemVariantRequestAllocationPolicy) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilCreateItemVariantRequestAllocationPolicy) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilCreateItemVariantRequestAllocationPolicy) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v CreateItemVariantRequestAllocationPolicy
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilCreateItemVariantRequestAllocationPolicy) Get() (v CreateItemVariantRequestAllocationPolicy, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilCreateItemVariantRequestAllocationPolicy) Or(d CreateItemVariantRequestAllocationPolicy) CreateItemVariantRequestAllocationPolicy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilCreateTaskRequestCountTarget returns new OptNilCreateTaskRequestCountTarget with value set to v.
+func NewOptNilCreateTaskRequestCountTarget(v CreateTaskRequestCountTarget) OptNilCreateTaskRequestCountTarget {
+	return OptNilCreateTaskRequestCountTarget{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilCreateTaskRequestCountTarget is optional nullable CreateTaskRequestCountTarget.
+type OptNilCreateTaskRequestCountTarget struct {
+	Value CreateTaskRequestCountTarget
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilCreateTaskRequestCountTarget was set.
+func (o OptNilCreateTaskRequestCountTarget) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilCreateTaskRequestCountTarget) Reset() {
+	var v CreateTaskRequestCountTarget
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilCreateTaskRequestCountTarget) SetTo(v CreateTaskRequestCountTarget) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilCreateTaskRequestCountTarget) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilCreateTaskRequestCountTarget) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v CreateTaskRequestCountTarget
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilCreateTaskRequestCountTarget) Get() (v CreateTaskRequestCountTarget, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilCreateTaskRequestCountTarget) Or(d CreateTaskRequestCountTarget) CreateTaskRequestCountTarget {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDate returns new OptNilDate with value set to v.
+func NewOptNilDate(v time.Time) OptNilDate {
+	return OptNilDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDate is optional nullable time.Time.
+type OptNilDate struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDate was set.
+func (o OptNilDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDate) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDate) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDate) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt64 returns new OptNilInt64 with value set to v.
+func NewOptNilInt64(v int64) OptNilInt64 {
+	return OptNilInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt64 is optional nullable int64.
+type OptNilInt64 struct {
+	Value int64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt64 was set.
+func (o OptNilInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt64) SetTo(v int64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt64) Get() (v int64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilItemVariantAllocationPolicy returns new OptNilItemVariantAllocationPolicy with value set to v.
+func NewOptNilItemVariantAllocationPolicy(v ItemVariantAllocationPolicy) OptNilItemVariantAllocationPolicy {
+	return OptNilItemVariantAllocationPolicy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilItemVariantAllocationPolicy is optional nullable ItemVariantAllocationPolicy.
+type OptNilItemVariantAllocationPolicy struct {
+	Value ItemVariantAllocationPolicy
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilItemVariantAllocationPolicy was set.
+func (o OptNilItemVariantAllocationPolicy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilItemVariantAllocationPolicy) Reset() {
+	var v ItemVariantAllocationPolicy
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilItemVariantAllocationPolicy) SetTo(v ItemVariantAllocationPolicy) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilItemVariantAllocationPolicy) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilItemVariantAllocationPolicy) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v ItemVariantAllocationPolicy
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilItemVariantAllocationPolicy) Get() (v ItemVariantAllocationPolicy, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilItemVariantAllocationPolicy) Or(d ItemVariantAllocationPolicy) ItemVariantAllocationPolicy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUUID returns new OptNilUUID with value set to v.
+func NewOptNilUUID(v uuid.UUID) OptNilUUID {
+	return OptNilUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUUID is optional nullable uuid.UUID.
+type OptNilUUID struct {
+	Value uuid.UUID
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUUID was set.
+func (o OptNilUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUUID) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUUID) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v uuid.UUID
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUpdateItemVariantRequestAllocationPolicy returns new OptNilUpdateItemVariantRequestAllocationPolicy with value set to v.
+func NewOptNilUpdateItemVariantRequestAllocationPolicy(v UpdateItemVariantRequestAllocationPolicy) OptNilUpdateItemVariantRequestAllocationPolicy {
+	return OptNilUpdateItemVariantRequestAllocationPolicy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUpdateItemVariantRequestAllocationPolicy is optional nullable UpdateItemVariantRequestAllocationPolicy.
+type OptNilUpdateItemVariantRequestAllocationPolicy struct {
+	Value UpdateItemVariantRequestAllocationPolicy
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUpdateItemVariantRequestAllocationPolicy was set.
+func (o OptNilUpdateItemVariantRequestAllocationPolicy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUpdateItemVariantRequestAllocationPolicy) Reset() {
+	var v UpdateItemVariantRequestAllocationPolicy
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUpdateItemVariantRequestAllocationPolicy) SetTo(v UpdateItemVariantRequestAllocationPolicy) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUpdateItemVariantRequestAllocationPolicy) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUpdateItemVariantRequestAllocationPolicy) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v UpdateItemVariantRequestAllocationPolicy
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUpdateItemVariantRequestAllocationPolicy) Get() (v UpdateItemVariantRequestAllocationPolicy, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUpdateItemVariantRequestAllocationPolicy) Or(d UpdateItemVariantRequestAllocationPolicy) UpdateItemVariantRequestAllocationPolicy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStockLine returns new OptStockLine with value set to v.
+func NewOptStockLine(v StockLine) OptStockLine {
+	return OptStockLine{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStockLine is optional StockLine.
+type OptStockLine struct {
+	Value StockLine
+	Set   bool
+}
+
+// IsSet returns true if OptStockLine was set.
+func (o OptStockLine) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStockLine) Reset() {
+	var v StockLine
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStockLine) SetTo(v StockLine) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStockLine) Get() (v StockLine, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStockLine) Or(d StockLine) StockLine {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTaskAssignmentStrategy returns new OptTaskAssignmentStrategy with value set to v.
+func NewOptTaskAssignmentStrategy(v TaskAssignmentStrategy) OptTaskAssignmentStrategy {
+	return OptTaskAssignmentStrategy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTaskAssignmentStrategy is optional TaskAssignmentStrategy.
+type OptTaskAssignmentStrategy struct {
+	Value TaskAssignmentStrategy
+	Set   bool
+}
+
+// IsSet returns true if OptTaskAssignmentStrategy was set.
+func (o OptTaskAssignmentStrategy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTaskAssignmentStrategy) Reset() {
+	var v TaskAssignmentStrategy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTaskAssignmentStrategy) SetTo(v TaskAssignmentStrategy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTaskAssignmentStrategy) Get() (v TaskAssignmentStrategy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTaskAssignmentStrategy) Or(d TaskAssignmentStrategy) TaskAssignmentStrategy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTaskStatusChangeRequest returns new OptTaskStatusChangeRequest with value set to v.
+func NewOptTaskStatusChangeRequest(v TaskStatusChangeRequest) OptTaskStatusChangeRequest {
+	return OptTaskStatusChangeRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTaskStatusChangeRequest is optional TaskStatusChangeRequest.
+type OptTaskStatusChangeRequest struct {
+	Value TaskStatusChangeRequest
+	Set   bool
+}
+
+// IsSet returns true if OptTaskStatusChangeRequest was set.
+func (o OptTaskStatusChangeRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTaskStatusChangeRequest) Reset() {
+	var v TaskStatusChangeRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTaskStatusChangeRequest) SetTo(v TaskStatusChangeRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTaskStatusChangeRequest) Get() (v TaskStatusChangeRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTaskStatusChangeRequest) Or(d TaskStatusChangeRequest) TaskStatusChangeRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTvBoard returns new OptTvBoard with value set to v.
+func NewOptTvBoard(v TvBoard) OptTvBoard {
+	return OptTvBoard{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTvBoard is optional TvBoard.
+type OptTvBoard struct {
+	Value TvBoard
+	Set   bool
+}
+
+// IsSet returns true if OptTvBoard was set.
+func (o OptTvBoard) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTvBoard) Reset() {
+	var v TvBoard
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTvBoard) SetTo(v TvBoard) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTvBoard) Get() (v TvBoard, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTvBoard) Or(d TvBoard) TvBoard {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUnitBasePickPathStrategy returns new OptUnitBasePickPathStrategy with value set to v.
+func NewOptUnitBasePickPathStrategy(v UnitBasePickPathStrategy) OptUnitBasePickPathStrategy {
+	return OptUnitBasePickPathStrategy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUnitBasePickPathStrategy is optional UnitBasePickPathStrategy.
+type OptUnitBasePickPathStrategy struct {
+	Value UnitBasePickPathStrategy
+	Set   bool
+}
+
+// IsSet returns true if OptUnitBasePickPathStrategy was set.
+func (o OptUnitBasePickPathStrategy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUnitBasePickPathStrategy) Reset() {
+	var v UnitBasePickPathStrategy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUnitBasePickPathStrategy) SetTo(v UnitBasePickPathStrategy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUnitBasePickPathStrategy) Get() (v UnitBasePickPathStrategy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUnitBasePickPathStrategy) Or(d UnitBasePickPathStrategy) UnitBasePickPathStrategy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateItemVariantRequestTrackingMode returns new OptUpdateItemVariantRequestTrackingMode with value set to v.
+func NewOptUpdateItemVariantRequestTrackingMode(v UpdateItemVariantRequestTrackingMode) OptUpdateItemVariantRequestTrackingMode {
+	return OptUpdateItemVariantRequestTrackingMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateItemVariantRequestTrackingMode is optional UpdateItemVariantRequestTrackingMode.
+type OptUpdateItemVariantRequestTrackingMode struct {
+	Value UpdateItemVariantRequestTrackingMode
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateItemVariantRequestTrackingMode was set.
+func (o OptUpdateItemVariantRequestTrackingMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateItemVariantRequestTrackingMode) Reset() {
+	var v UpdateItemVariantRequestTrackingMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateItemVariantRequestTrackingMode) SetTo(v UpdateItemVariantRequestTrackingMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateItemVariantRequestTrackingMode) Get() (v UpdateItemVariantRequestTrackingMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateItemVariantRequestTrackingMode) Or(d UpdateItemVariantRequestTrackingMode) UpdateItemVariantRequestTrackingMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateTaskTemplateRequestPriority returns new OptUpdateTaskTemplateRequestPriority with value set to v.
+func NewOptUpdateTaskTemplateRequestPriority(v UpdateTaskTemplateRequestPriority) OptUpdateTaskTemplateRequestPriority {
+	return OptUpdateTaskTemplateRequestPriority{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateTaskTemplateRequestPriority is optional UpdateTaskTemplateRequestPriority.
+type OptUpdateTaskTemplateRequestPriority struct {
+	Value UpdateTaskTemplateRequestPriority
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateTaskTemplateRequestPriority was set.
+func (o OptUpdateTaskTemplateRequestPriority) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateTaskTemplateRequestPriority) Reset() {
+	var v UpdateTaskTemplateRequestPriority
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateTaskTemplateRequestPriority) SetTo(v UpdateTaskTemplateRequestPriority) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateTaskTemplateRequestPriority) Get() (v UpdateTaskTemplateRequestPriority, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateTaskTemplateRequestPriority) Or(d UpdateTaskTemplateRequestPriority) UpdateTaskTemplateRequestPriority {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Merged schema.
+// Ref: #/components/schemas/Organization
+type Organization struct {
+	ID                     uuid.UUID              `json:"id"`
+	TaskAssignmentStrategy TaskAssignmentStrategy `json:"taskAssignmentStrategy"`
+	AllocationPolicy       AllocationPolicy       `json:"allocationPolicy"`
+	Name                   string                 `json:"name"`
+	Subdomain              string                 `json:"subdomain"`
+}
+
+// GetID returns the value of ID.
+func (s *Organization) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetTaskAssignmentStrategy returns the value of TaskAssignmentStrategy.
+func (s *Organization) GetTaskAssignmentStrategy() TaskAssignmentStrategy {
+	return s.TaskAssignmentStrategy
+}
+
+// GetAllocationPolicy returns the value of AllocationPolicy.
+func (s *Organization) GetAllocationPolicy() AllocationPolicy {
+	return s.AllocationPolicy
+}
+
+// GetName returns the value of Name.
+func (s *Organization) GetName() string {
+	return s.Name
+}
+
+// GetSubdomain returns the value of Subdomain.
+func (s *Organization) GetSubdomain() string {
+	return s.Subdomain
+}
+
+// SetID sets the value of ID.
+func (s *Organization) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetTaskAssignmentStrategy sets the value of TaskAssignmentStrategy.
+func (s *Organization) SetTaskAssignmentStrategy(val TaskAssignmentStrategy) {
+	s.TaskAssignmentStrategy = val
+}
+
+// SetAllocationPolicy sets the value of AllocationPolicy.
+func (s *Organization) SetAllocationPolicy(val AllocationPolicy) {
+	s.AllocationPolicy = val
+}
+
+// SetName sets the value of Name.
+func (s *Organization) SetName(val string) {
+	s.Name = val
+}
+
+// SetSubdomain sets the value of Subdomain.
+func (s *Organization) SetSubdomain(val string) {
+	s.Subdomain = val
+}
+
+// Ref: #/components/schemas/OrganizationUpdate
+type OrganizationUpdate struct {
+	Name                   string                    `json:"name"`
+	TaskAssignmentStrategy OptTaskAssignmentStrategy `json:"taskAssignmentStrategy"`
+	AllocationPolicy       OptAllocationPolicy       `json:"allocationPolicy"`
+}
+
+// GetName returns the value of Name.
+func (s *OrganizationUpdate) GetName() string {
+	return s.Name
+}
+
+// GetTaskAssignmentStrategy returns the value of TaskAssignmentStrategy.
+func (s *OrganizationUpdate) GetTaskAssignmentStrategy() OptTaskAssignmentStrategy {
+	return s.TaskAssignmentStrategy
+}
+
+// GetAllocationPolicy returns the value of AllocationPolicy.
+func (s *OrganizationUpdate) GetAllocationPolicy() OptAllocationPolicy {
+	return s.AllocationPolicy
+}
+
+// SetName sets the value of Name.
+func (s *OrganizationUpdate) SetName(val string) {
+	s.Name = val
+}
+
+// SetTaskAssignmentStrategy sets the value of TaskAssignmentStrategy.
+func (s *OrganizationUpdate) SetTaskAssignmentStrategy(val OptTaskAssignmentStrategy) {
+	s.TaskAssignmentStrategy = val
+}
+
+// SetAllocationPolicy sets the value of AllocationPolicy.
+func (s *OrganizationUpdate) SetAllocationPolicy(val OptAllocationPolicy) {
+	s.AllocationPolicy = val
+}
+
+type PatchEmployeeByIdBadRequest ErrorContent
+
+func (*PatchEmployeeByIdBadRequest) patchEmployeeByIdRes() {}
+
+type PatchEmployeeByIdForbidden ErrorContent
+
+func (*PatchEmployeeByIdForbidden) patchEmployeeByIdRes() {}
+
+type PatchEmployeeByIdNotFound ErrorContent
+
+func (*PatchEmployeeByIdNotFound) patchEmployeeByIdRes() {}
+
+type PatchEmployeeByIdUnauthorized ErrorContent
+
+func (*PatchEmployeeByIdUnauthorized) patchEmployeeByIdRes() {}
+
+// Ref: #/components/schemas/PatchEmployeeRequest
+type PatchEmployeeRequest struct {
+	UserId uuid.UUID `json:"userId"`
+	RoleId OptInt    `json:"roleId"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *PatchEmployeeRequest) GetUserId() uuid.UUID {
+	return s.UserId
+}
+
+// GetRoleId returns the value of RoleId.
+func (s *PatchEmployeeRequest) GetRoleId() OptInt {
+	return s.RoleId
+}
+
+// SetUserId sets the value of UserId.
+func (s *PatchEmployeeRequest) SetUserId(val uuid.UUID) {
+	s.UserId = val
+}
+
+// SetRoleId sets the value of RoleId.
+func (s *PatchEmployeeRequest) SetRoleId(val OptInt) {
+	s.RoleId = val
+}
+
+type PickInstanceFromCellBadRequest ErrorContent
+
+func (*PickInstanceFromCellBadRequest) pickInstanceFromCellRes() {}
+
+type PickInstanceFromCellForbidden ErrorContent
+
+func (*PickInstanceFromCellForbidden) pickInstanceFromCellRes() {}
+
+// PickInstanceFromCellNoContent is response for PickInstanceFromCell operation.
+type PickInstanceFromCellNoContent struct{}
+
+func (*PickInstanceFromCellNoContent) pickInstanceFromCellRes() {}
+
+type PickInstanceFromCellReq struct {
+	InstanceId uuid.UUID `json:"instanceId"`
+}
+
+// GetInstanceId returns the value of InstanceId.
+func (s *PickInstanceFromCellReq) GetInstanceId() uuid.UUID {
+	return s.InstanceId
+}
+
+// SetInstanceId sets the value of InstanceId.
+func (s *PickInstanceFromCellReq) SetInstanceId(val uuid.UUID) {
+	s.InstanceId = val
+}
+
+type PickInstanceFromCellUnauthorized ErrorContent
+
+func (*PickInstanceFromCellUnauthorized) pickInstanceFromCellRes() {}
+
+type PickStockLineFromCellBadRequest ErrorContent
+
+func (*PickStockLineFromCellBadRequest) pickStockLineFromCellRes() {}
+
+type PickStockLineFromCellForbidden ErrorContent
+
+func (*PickStockLineFromCellForbidden) pickStockLineFromCellRes() {}
+
+// PickStockLineFromCellNoContent is response for PickStockLineFromCell operation.
+type PickStockLineFromCellNoContent struct{}
+
+func (*PickStockLineFromCellNoContent) pickStockLineFromCellRes() {}
+
+type PickStockLineFromCellUnauthorized ErrorContent
+
+func (*PickStockLineFromCellUnauthorized) pickStockLineFromCellRes() {}
+
+// Ref: #/components/schemas/PickWave
+type PickWave struct {
+	ID                  uuid.UUID      `json:"id"`
+	UnitId              uuid.UUID      `json:"unitId"`
+	Name                string         `json:"name"`
+	Status              PickWaveStatus `json:"status"`
+	ConsolidationCellId uuid.UUID      `json:"consolidationCellId"`
+	CreatedAt           time.Time      `json:"createdAt"`
+	CompletedAt         NilDateTime    `json:"completedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *PickWave) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUnitId returns the value of UnitId.
+func (s *PickWave) GetUnitId() uuid.UUID {
+	return s.UnitId
+}
+
+// GetName returns the value of Name.
+func (s *PickWave) GetName() string {
+	return s.Name
+}
+
+// GetStatus returns the value of Status.
+func (s *PickWave) GetStatus() PickWaveStatus {
+	return s.Status
+}
+
+// GetConsolidationCellId returns the value of ConsolidationCellId.
+func (s *PickWave) GetConsolidationCellId() uuid.UUID {
+	return s.ConsolidationCellId
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PickWave) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetCompletedAt returns the value of CompletedAt.
+func (s *PickWave) GetCompletedAt() NilDateTime {
+	return s.CompletedAt
+}
+
+// SetID sets the value of ID.
+func (s *PickWave) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUnitId sets the value of UnitId.
+func (s *PickWave) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
+
+// SetName sets the value of Name.
+func (s *PickWave) SetName(val string) {
+	s.Name = val
+}
+
+// SetStatus sets the value of Status.
+func (s *PickWave) SetStatus(val PickWaveStatus) {
+	s.Status = val
+}
+
+// SetConsolidationCellId sets the value of ConsolidationCellId.
+func (s *PickWave) SetConsolidationCellId(val uuid.UUID) {
+	s.ConsolidationCellId = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PickWave) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetCompletedAt sets the value of CompletedAt.
+func (s *PickWave) SetCompletedAt(val NilDateTime) {
+	s.CompletedAt = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/PickWaveFull
+type PickWaveFull struct {
+	ID                  uuid.UUID                  `json:"id"`
+	UnitId              uuid.UUID                  `json:"unitId"`
+	Name                string                     `json:"name"`
+	Status              PickWaveFullStatus         `json:"status"`
+	ConsolidationCellId uuid.UUID                  `json:"consolidationCellId"`
+	CreatedAt           time.Time                  `json:"createdAt"`
+	CompletedAt         NilDateTime                `json:"completedAt"`
+	ConsolidationCell   NilCellForInstanceOptional `json:"consolidationCell"`
+	Tasks               []TaskBase                 `json:"tasks"`
+	// Combined items of all wave tasks.
+	Items []TaskItem `json:"items"`
+}
+
+// GetID returns the value of ID.
+func (s *PickWaveFull) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUnitId returns the value of UnitId.
+func (s *PickWaveFull) GetUnitId() uuid.UUID {
+	return s.UnitId
+}
+
+// GetName returns the value of Name.
+func (s *PickWaveFull) GetName() string {
+	return s.Name
+}
+
+// GetStatus returns the value of Status.
+func (s *PickWaveFull) GetStatus() PickWaveFullStatus {
+	return s.Status
+}
+
+// GetConsolidationCellId returns the value of ConsolidationCellId.
+func (s *PickWaveFull) GetConsolidationCellId() uuid.UUID {
+	return s.ConsolidationCellId
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PickWaveFull) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetCompletedAt returns the value of CompletedAt.
+func (s *PickWaveFull) GetCompletedAt() NilDateTime {
+	return s.CompletedAt
+}
+
+// GetConsolidationCell returns the value of ConsolidationCell.
+func (s *PickWaveFull) GetConsolidationCell() NilCellForInstanceOptional {
+	return s.ConsolidationCell
+}
+
+// GetTasks returns the value of Tasks.
+func (s *PickWaveFull) GetTasks() []TaskBase {
+	return s.Tasks
+}
+
+// GetItems returns the value of Items.
+func (s *PickWaveFull) GetItems() []TaskItem {
+	return s.Items
+}
+
+// SetID sets the value of ID.
+func (s *PickWaveFull) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUnitId sets the value of UnitId.
+func (s *PickWaveFull) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
+
+// SetName sets the value of Name.
+func (s *PickWaveFull) SetName(val string) {
+	s.Name = val
+}
+
+// SetStatus sets the value of Status.
+func (s *PickWaveFull) SetStatus(val PickWaveFullStatus) {
+	s.Status = val
+}
+
+// SetConsolidationCellId sets the value of ConsolidationCellId.
+func (s *PickWaveFull) SetConsolidationCellId(val uuid.UUID) {
+	s.ConsolidationCellId = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PickWaveFull) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetCompletedAt sets the value of CompletedAt.
+func (s *PickWaveFull) SetCompletedAt(val NilDateTime) {
+	s.CompletedAt = val
+}
+
+// SetConsolidationCell sets the value of ConsolidationCell.
+func (s *PickWaveFull) SetConsolidationCell(val NilCellForInstanceOptional) {
+	s.ConsolidationCell = val
+}
+
+// SetTasks sets the value of Tasks.
+func (s *PickWaveFull) SetTasks(val []TaskBase) {
+	s.Tasks = val
+}
+
+// SetItems sets the value of Items.
+func (s *PickWaveFull) SetItems(val []TaskItem) {
+	s.Items = val
+}
+
+type PickWaveFullStatus string
+
+const (
+	PickWaveFullStatusPending   PickWaveFullStatus = "pending"
+	PickWaveFullStatusPicking   PickWaveFullStatus = "picking"
+	PickWaveFullStatusSorting   PickWaveFullStatus = "sorting"
+	PickWaveFullStatusCompleted PickWaveFullStatus = "completed"
+	PickWaveFullStatusCancelled PickWaveFullStatus = "cancelled"
+)
+
+// AllValues returns all PickWaveFullStatus values.
+func (PickWaveFullStatus) AllValues() []PickWaveFullStatus {
+	return []PickWaveFullStatus{
+		PickWaveFullStatusPending,
+		PickWaveFullStatusPicking,
+		PickWaveFullStatusSorting,
+		PickWaveFullStatusCompleted,
+		PickWaveFullStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PickWaveFullStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case PickWaveFullStatusPending:
+		return []byte(s), nil
+	case PickWaveFullStatusPicking:
+		return []byte(s), nil
+	case PickWaveFullStatusSorting:
+		return []byte(s), nil
+	case PickWaveFullStatusCompleted:
+		return []byte(s), nil
+	case PickWaveFullStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PickWaveFullStatus) UnmarshalText(data []byte) error {
+	switch PickWaveFullStatus(data) {
+	case PickWaveFullStatusPending:
+		*s = PickWaveFullStatusPending
+		return nil
+	case PickWaveFullStatusPicking:
+		*s = PickWaveFullStatusPicking
+		return nil
+	case PickWaveFullStatusSorting:
+		*s = PickWaveFullStatusSorting
+		return nil
+	case PickWaveFullStatusCompleted:
+		*s = PickWaveFullStatusCompleted
+		return nil
+	case PickWaveFullStatusCancelled:
+		*s = PickWaveFullStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PickWaveInstanceBadRequest ErrorContent
+
+func (*PickWaveInstanceBadRequest) pickWaveInstanceRes() {}
+
+type PickWaveInstanceForbidden ErrorContent
+
+func (*PickWaveInstanceForbidden) pickWaveInstanceRes() {}
+
+type PickWaveInstanceUnauthorized ErrorContent
+
+func (*PickWaveInstanceUnauthorized) pickWaveInstanceRes() {}
+
+type PickWaveStatus string
+
+const (
+	PickWaveStatusPending   PickWaveStatus = "pending"
+	PickWaveStatusPicking   PickWaveStatus = "picking"
+	PickWaveStatusSorting   PickWaveStatus = "sorting"
+	PickWaveStatusCompleted PickWaveStatus = "completed"
+	PickWaveStatusCancelled PickWaveStatus = "cancelled"
+)
+
+// AllValues returns all PickWaveStatus values.
+func (PickWaveStatus) AllValues() []PickWaveStatus {
+	return []PickWaveStatus{
+		PickWaveStatusPending,
+		PickWaveStatusPicking,
+		PickWaveStatusSorting,
+		PickWaveStatusCompleted,
+		PickWaveStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PickWaveStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case PickWaveStatusPending:
+		return []byte(s), nil
+	case PickWaveStatusPicking:
+		return []byte(s), nil
+	case PickWaveStatusSorting:
+		return []byte(s), nil
+	case PickWaveStatusCompleted:
+		return []byte(s), nil
+	case PickWaveStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PickWaveStatus) UnmarshalText(data []byte) error {
+	switch PickWaveStatus(data) {
+	case PickWaveStatusPending:
+		*s = PickWaveStatusPending
+		return nil
+	case PickWaveStatusPicking:
+		*s = PickWaveStatusPicking
+		return nil
+	case PickWaveStatusSorting:
+		*s = PickWaveStatusSorting
+		return nil
+	case PickWaveStatusCompleted:
+		*s = PickWaveStatusCompleted
+		return nil
+	case PickWaveStatusCancelled:
+		*s = PickWaveStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/PutItemInCellRequest
+type PutItemInCellRequest struct {
+	InstanceId uuid.UUID `json:"instanceId"`
+}
+
+// GetInstanceId returns the value of InstanceId.
+func (s *PutItemInCellRequest) GetInstanceId() uuid.UUID {
+	return s.InstanceId
+}
+
+// SetInstanceId sets the value of InstanceId.
+func (s *PutItemInCellRequest) SetInstanceId(val uuid.UUID) {
+	s.InstanceId = val
+}
+
+type PutItemInTargetCellBadRequest ErrorContent
+
+func (*PutItemInTargetCellBadRequest) putItemInTargetCellRes() {}
+
+// PutItemInTargetCellCreated is response for PutItemInTargetCell operation.
+type PutItemInTargetCellCreated struct{}
+
+func (*PutItemInTargetCellCreated) putItemInTargetCellRes() {}
+
+type PutItemInTargetCellForbidden ErrorContent
+
+func (*PutItemInTargetCellForbidden) putItemInTargetCellRes() {}
+
+type PutItemInTargetCellUnauthorized ErrorContent
+
+func (*PutItemInTargetCellUnauthorized) putItemInTargetCellRes() {}
+
+type PutStockLineInTargetCellBadRequest ErrorContent
+
+func (*PutStockLineInTargetCellBadRequest) putStockLineInTargetCellRes() {}
+
+type PutStockLineInTargetCellForbidden ErrorContent
+
+func (*PutStockLineInTargetCellForbidden) putStockLineInTargetCellRes() {}
+
+// PutStockLineInTargetCellNoContent is response for PutStockLineInTargetCell operation.
+type PutStockLineInTargetCellNoContent struct{}
+
+func (*PutStockLineInTargetCellNoContent) putStockLineInTargetCellRes() {}
+
+type PutStockLineInTargetCellUnauthorized ErrorContent
+
+func (*PutStockLineInTargetCellUnauthorized) putStockLineInTargetCellRes() {}
+
+type ReassignTaskBadRequest ErrorContent
+
+func (*ReassignTaskBadRequest) reassignTaskRes() {}
+
+type ReassignTaskForbidden ErrorContent
+
+func (*ReassignTaskForbidden) reassignTaskRes() {}
+
+// ReassignTaskNoContent is response for ReassignTask operation.
+type ReassignTaskNoContent struct{}
+
+func (*ReassignTaskNoContent) reassignTaskRes() {}
+
+type ReassignTaskUnauthorized ErrorContent
+
+func (*ReassignTaskUnauthorized) reassignTaskRes() {}
+
+type ReceiveItemsBadRequest ErrorContent
+
+func (*ReceiveItemsBadRequest) receiveItemsRes() {}
+
+type ReceiveItemsForbidden ErrorContent
+
+func (*ReceiveItemsForbidden) receiveItemsRes() {}
+
+// Ref: #/components/schemas/ReceiveItemsRequest
+type ReceiveItemsRequest struct {
+	VariantId uuid.UUID `json:"variantId"`
+	Quantity  OptInt    `json:"quantity"`
+	// Serial numbers of the received instances, one per instance. Quantity defaults to their count.
+	// Required when the variant requires serials.
+	SerialNumbers []string `json:"serialNumbers"`
+	// Recorded on every received instance, not accepted for quantity tracked variants.
+	LotNumber      OptString `json:"lotNumber"`
+	ManufacturedAt OptDate   `json:"manufacturedAt"`
+	ExpiresAt      OptDate   `json:"expiresAt"`
+}
+
+// GetVariantId returns the value of VariantId.
+func (s *ReceiveItemsRequest) GetVariantId() uuid.UUID {
+	return s.VariantId
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *ReceiveItemsRequest) GetQuantity() OptInt {
+	return s.Quantity
+}
+
+// GetSerialNumbers returns the value of SerialNumbers.
+func (s *ReceiveItemsRequest) GetSerialNumbers() []string {
+	return s.SerialNumbers
+}
+
+// GetLotNumber returns the value of LotNumber.
+func (s *ReceiveItemsRequest) GetLotNumber() OptString {
+	return s.LotNumber
+}
+
+// GetManufacturedAt returns the value of ManufacturedAt.
+func (s *ReceiveItemsRequest) GetManufacturedAt() OptDate {
+	return s.ManufacturedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *ReceiveItemsRequest) GetExpiresAt() OptDate {
+	return s.ExpiresAt
+}
+
+// SetVariantId sets the value of VariantId.
+func (s *ReceiveItemsRequest) SetVariantId(val uuid.UUID) {
+	s.VariantId = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *ReceiveItemsRequest) SetQuantity(val OptInt) {
+	s.Quantity = val
+}
+
+// SetSerialNumbers sets the value of SerialNumbers.
+func (s *ReceiveItemsRequest) SetSerialNumbers(val []string) {
+	s.SerialNumbers = val
+}
+
+// SetLotNumber sets the value of LotNumber.
+func (s *ReceiveItemsRequest) SetLotNumber(val OptString) {
+	s.LotNumber = val
+}
+
+// SetManufacturedAt sets the value of ManufacturedAt.
+func (s *ReceiveItemsRequest) SetManufacturedAt(val OptDate) {
+	s.ManufacturedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *ReceiveItemsRequest) SetExpiresAt(val OptDate) {
+	s.ExpiresAt = val
+}
+
+// Ref: #/components/schemas/ReceiveItemsResponse
+type ReceiveItemsResponse struct {
+	// Received instances of a serialized variant.
+	Data      []InstanceFull `json:"data"`
+	StockLine OptStockLine   `json:"stockLine"`
+}
+
+// GetData returns the value of Data.
+func (s *ReceiveItemsResponse) GetData() []InstanceFull {
+	return s.Data
+}
+
+// GetStockLine returns the value of StockLine.
+func (s *ReceiveItemsResponse) GetStockLine() OptStockLine {
+	return s.StockLine
+}
+
+// SetData sets the value of Data.
+func (s *ReceiveItemsResponse) SetData(val []InstanceFull) {
+	s.Data = val
+}
+
+// SetStockLine sets the value of StockLine.
+func (s *ReceiveItemsResponse) SetStockLine(val OptStockLine) {
+	s.StockLine = val
+}
+
+func (*ReceiveItemsResponse) receiveItemsRes() {}
+
+type ReceiveItemsUnauthorized ErrorContent
+
+func (*ReceiveItemsUnauthorized) receiveItemsRes() {}
+
+type RegenerateTaskPickupCodeConflict ErrorContent
+
+func (*RegenerateTaskPickupCodeConflict) regenerateTaskPickupCodeRes() {}
+
+type RegenerateTaskPickupCodeForbidden ErrorContent
+
+func (*RegenerateTaskPickupCodeForbidden) regenerateTaskPickupCodeRes() {}
+
+type RegenerateTaskPickupCodeNotFound ErrorContent
+
+func (*RegenerateTaskPickupCodeNotFound) regenerateTaskPickupCodeRes() {}
+
+type RegenerateTaskPickupCodeUnauthorized ErrorContent
+
+func (*RegenerateTaskPickupCodeUnauthorized) regenerateTaskPickupCodeRes() {}
+
+type ReportTaskItemExceptionBadRequest ErrorContent
+
+func (*ReportTaskItemExceptionBadRequest) reportTaskItemExceptionRes() {}
+
+type ReportTaskItemExceptionForbidden ErrorContent
+
+func (*ReportTaskItemExceptionForbidden) reportTaskItemExceptionRes() {}
+
+// Ref: #/components/schemas/ReportTaskItemExceptionRequest
+type ReportTaskItemExceptionRequest struct {
+	InstanceId uuid.UUID                            `json:"instanceId"`
+	Reason     ReportTaskItemExceptionRequestReason `json:"reason"`
+	Note       OptString                            `json:"note"`
+	// Available instance of the same variant to pick instead.
+	SubstituteInstanceId OptUUID `json:"substituteInstanceId"`
+	// Let the service choose a substitute when substituteInstanceId is not set.
+	AutoSubstitute OptBool `json:"autoSubstitute"`
+}
+
+// GetInstanceId returns the value of InstanceId.
+func (s *ReportTaskItemExceptionRequest) GetInstanceId() uuid.UUID {
+	return s.InstanceId
+}
+
+// GetReason returns the value of Reason.
+func (s *ReportTaskItemExceptionRequest) GetReason() ReportTaskItemExceptionRequestReason {
+	return s.Reason
+}
+
+// GetNote returns the value of Note.
+func (s *ReportTaskItemExceptionRequest) GetNote() OptString {
+	return s.Note
+}
+
+// GetSubstituteInstanceId returns the value of SubstituteInstanceId.
+func (s *ReportTaskItemExceptionRequest) GetSubstituteInstanceId() OptUUID {
+	return s.SubstituteInstanceId
+}
+
+// GetAutoSubstitute returns the value of AutoSubstitute.
+func (s *ReportTaskItemExceptionRequest) GetAutoSubstitute() OptBool {
+	return s.AutoSubstitute
+}
+
+// SetInstanceId sets the value of InstanceId.
+func (s *ReportTaskItemExceptionRequest) SetInstanceId(val uuid.UUID) {
+	s.InstanceId = val
+}
+
+// SetReason sets the value of Reason.
+func (s *ReportTaskItemExceptionRequest) SetReason(val ReportTaskItemExceptionRequestReason) {
+	s.Reason = val
+}
+
+// SetNote sets the value of Note.
+func (s *ReportTaskItemExceptionRequest) SetNote(val OptString) {
+	s.Note = val
+}
+
+// SetSubstituteInstanceId sets the value of SubstituteInstanceId.
+func (s *ReportTaskItemExceptionRequest) SetSubstituteInstanceId(val OptUUID) {
+	s.SubstituteInstanceId = val
+}
+
+// SetAutoSubstitute sets the value of AutoSubstitute.
+func (s *ReportTaskItemExceptionRequest) SetAutoSubstitute(val OptBool) {
+	s.AutoSubstitute = val
+}
+
+type ReportTaskItemExceptionRequestReason string
+
+const (
+	ReportTaskItemExceptionRequestReasonNotFound  ReportTaskItemExceptionRequestReason = "not_found"
+	ReportTaskItemExceptionRequestReasonDamaged   ReportTaskItemExceptionRequestReason = "damaged"
+	ReportTaskItemExceptionRequestReasonWrongItem ReportTaskItemExceptionRequestReason = "wrong_item"
+)
+
+// AllValues returns all ReportTaskItemExceptionRequestReason values.
+func (ReportTaskItemExceptionRequestReason) AllValues() []ReportTaskItemExceptionRequestReason {
+	return []ReportTaskItemExceptionRequestReason{
+		ReportTaskItemExceptionRequestReasonNotFound,
+		ReportTaskItemExceptionRequestReasonDamaged,
+		ReportTaskItemExceptionRequestReasonWrongItem,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportTaskItemExceptionRequestReason) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportTaskItemExceptionRequestReasonNotFound:
+		return []byte(s), nil
+	case ReportTaskItemExceptionRequestReasonDamaged:
+		return []byte(s), nil
+	case ReportTaskItemExceptionRequestReasonWrongItem:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportTaskItemExceptionRequestReason) UnmarshalText(data []byte) error {
+	switch ReportTaskItemExceptionRequestReason(data) {
+	case ReportTaskItemExceptionRequestReasonNotFound:
+		*s = ReportTaskItemExceptionRequestReasonNotFound
+		return nil
+	case ReportTaskItemExceptionRequestReasonDamaged:
+		*s = ReportTaskItemExceptionRequestReasonDamaged
+		return nil
+	case ReportTaskItemExceptionRequestReasonWrongItem:
+		*s = ReportTaskItemExceptionRequestReasonWrongItem
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ReportTaskItemExceptionUnauthorized ErrorContent
+
+func (*ReportTaskItemExceptionUnauthorized) reportTaskItemExceptionRes() {}
+
+type ResolveBarcodeBadRequest ErrorContent
+
+func (*ResolveBarcodeBadRequest) resolveBarcodeRes() {}
+
+type ResolveBarcodeForbidden ErrorContent
+
+func (*ResolveBarcodeForbidden) resolveBarcodeRes() {}
+
+// Ref: #/components/schemas/ResolveBarcodeResponse
+type ResolveBarcodeResponse struct {
+	Data ResolveBarcodeResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ResolveBarcodeResponse) GetData() ResolveBarcodeResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ResolveBarcodeResponse) SetData(val ResolveBarcodeResponseData) {
+	s.Data = val
+}
+
+func (*ResolveBarcodeResponse) resolveBarcodeRes() {}
+
+type ResolveBarcodeResponseData struct {
+	// The resolved code with surrounding whitespace removed.
+	Code string     `json:"code"`
+	Gs1  NilGS1Data `json:"gs1"`
+	// Every object the code may stand for, empty when nothing matched.
+	Matches []BarcodeMatch `json:"matches"`
+}
+
+// GetCode returns the value of Code.
+func (s *ResolveBarcodeResponseData) GetCode() string {
+	return s.Code
+}
+
+// GetGs1 returns the value of Gs1.
+func (s *ResolveBarcodeResponseData) GetGs1() NilGS1Data {
+	return s.Gs1
+}
+
+// GetMatches returns the value of Matches.
+func (s *ResolveBarcodeResponseData) GetMatches() []BarcodeMatch {
+	return s.Matches
+}
+
+// SetCode sets the value of Code.
+func (s *ResolveBarcodeResponseData) SetCode(val string) {
+	s.Code = val
+}
+
+// SetGs1 sets the value of Gs1.
+func (s *ResolveBarcodeResponseData) SetGs1(val NilGS1Data) {
+	s.Gs1 = val
+}
+
+// SetMatches sets the value of Matches.
+func (s *ResolveBarcodeResponseData) SetMatches(val []BarcodeMatch) {
+	s.Matches = val
+}
+
+type ResolveBarcodeUnauthorized ErrorContent
+
+func (*ResolveBarcodeUnauthorized) resolveBarcodeRes() {}
+
+type RevokeApiTokenForbidden ErrorContent
+
+func (*RevokeApiTokenForbidden) revokeApiTokenRes() {}
+
+// RevokeApiTokenNoContent is response for RevokeApiToken operation.
+type RevokeApiTokenNoContent struct{}
+
+func (*RevokeApiTokenNoContent) revokeApiTokenRes() {}
+
+type RevokeApiTokenNotFound ErrorContent
+
+func (*RevokeApiTokenNotFound) revokeApiTokenRes() {}
+
+type RevokeApiTokenUnauthorized ErrorContent
+
+func (*RevokeApiTokenUnauthorized) revokeApiTokenRes() {}
+
+// Ref: #/components/schemas/Role
+type Role struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+}
+
+// GetID returns the value of ID.
+func (s *Role) GetID() int {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Role) GetName() string {
+	return s.Name
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *Role) GetDisplayName() string {
+	return s.DisplayName
+}
+
+// GetDescription returns the value of Description.
+func (s *Role) GetDescription() string {
+	return s.Description
+}
+
+// SetID sets the value of ID.
+func (s *Role) SetID(val int) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Role) SetName(val string) {
+	s.Name = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *Role) SetDisplayName(val string) {
+	s.DisplayName = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Role) SetDescription(val string) {
+	s.Description = val
+}
+
+type RunTaskTemplateConflict ErrorContent
+
+func (*RunTaskTemplateConflict) runTaskTemplateRes() {}
+
+type RunTaskTemplateForbidden ErrorContent
+
+func (*RunTaskTemplateForbidden) runTaskTemplateRes() {}
+
+type RunTaskTemplateNotFound ErrorContent
+
+func (*RunTaskTemplateNotFound) runTaskTemplateRes() {}
+
+type RunTaskTemplateUnauthorized ErrorContent
+
+func (*RunTaskTemplateUnauthorized) runTaskTemplateRes() {}
+
+type SetMyShiftForbidden ErrorContent
+
+func (*SetMyShiftForbidden) setMyShiftRes() {}
+
+type SetMyShiftNotFound ErrorContent
+
+func (*SetMyShiftNotFound) setMyShiftRes() {}
+
+// Ref: #/components/schemas/SetMyShiftRequest
+type SetMyShiftRequest struct {
+	// Whether the worker takes automatically assigned tasks.
+	OnShift bool `json:"onShift"`
+}
+
+// GetOnShift returns the value of OnShift.
+func (s *SetMyShiftRequest) GetOnShift() bool {
+	return s.OnShift
+}
+
+// SetOnShift sets the value of OnShift.
+func (s *SetMyShiftRequest) SetOnShift(val bool) {
+	s.OnShift = val
+}
+
+type SetMyShiftUnauthorized ErrorContent
+
+func (*SetMyShiftUnauthorized) setMyShiftRes() {}
+
+type SetWorkerProfileBadRequest ErrorContent
+
+func (*SetWorkerProfileBadRequest) setWorkerProfileRes() {}
+
+type SetWorkerProfileForbidden ErrorContent
+
+func (*SetWorkerProfileForbidden) setWorkerProfileRes() {}
+
+type SetWorkerProfileNotFound ErrorContent
+
+func (*SetWorkerProfileNotFound) setWorkerProfileRes() {}
+
+type SetWorkerProfileUnauthorized ErrorContent
+
+func (*SetWorkerProfileUnauthorized) setWorkerProfileRes() {}
+
+type SortWaveInstanceBadRequest ErrorContent
+
+func (*SortWaveInstanceBadRequest) sortWaveInstanceRes() {}
+
+type SortWaveInstanceForbidden ErrorContent
+
+func (*SortWaveInstanceForbidden) sortWaveInstanceRes() {}
+
+type SortWaveInstanceUnauthorized ErrorContent
+
+func (*SortWaveInstanceUnauthorized) sortWaveInstanceRes() {}
+
+type SplitStockLineBadRequest ErrorContent
+
+func (*SplitStockLineBadRequest) splitStockLineRes() {}
+
+type SplitStockLineForbidden ErrorContent
+
+func (*SplitStockLineForbidden) splitStockLineRes() {}
+
+type SplitStockLineNotFound ErrorContent
+
+func (*SplitStockLineNotFound) splitStockLineRes() {}
+
+// Ref: #/components/schemas/SplitStockLineRequest
+type SplitStockLineRequest struct {
+	// Quantity moved to the new line, must be less than the line quantity.
+	Quantity int `json:"quantity"`
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *SplitStockLineRequest) GetQuantity() int {
+	return s.Quantity
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *SplitStockLineRequest) SetQuantity(val int) {
+	s.Quantity = val
+}
+
+type SplitStockLineUnauthorized ErrorContent
+
+func (*SplitStockLineUnauthorized) splitStockLineRes() {}
+
+// Ref: #/components/schemas/StockLevel
+type StockLevel struct {
+	ItemId    uuid.UUID          `json:"itemId"`
+	Variant   ItemVariant        `json:"variant"`
+	Location  StockLevelLocation `json:"location"`
+	Available int                `json:"available"`
+	Reserved  int                `json:"reserved"`
+	Consumed  int                `json:"consumed"`
+	InTransit int                `json:"inTransit"`
+	Total     int                `json:"total"`
+}
+
+// GetItemId returns the value of ItemId.
+func (s *StockLevel) GetItemId() uuid.UUID {
+	return s.ItemId
+}
+
+// GetVariant returns the value of Variant.
+func (s *StockLevel) GetVariant() ItemVariant {
+	return s.Variant
+}
+
+// GetLocation returns the value of Location.
+func (s *StockLevel) GetLocation() StockLevelLocation {
+	return s.Location
+}
+
+// GetAvailable returns the value of Available.
+func (s *StockLevel) GetAvailable() int {
+	return s.Available
+}
+
+// GetReserved returns the value of Reserved.
+func (s *StockLevel) GetReserved() int {
+	return s.Reserved
+}
+
+// GetConsumed returns the value of Consumed.
+func (s *StockLevel) GetConsumed() int {
+	return s.Consumed
+}
+
+// GetInTransit returns the value of InTransit.
+func (s *StockLevel) GetInTransit() int {
+	return s.InTransit
+}
+
+// GetTotal returns the value of Total.
+func (s *StockLevel) GetTotal() int {
+	return s.Total
+}
+
+// SetItemId sets the value of ItemId.
+func (s *StockLevel) SetItemId(val uuid.UUID) {
+	s.ItemId = val
+}
+
+// SetVariant sets the value of Variant.
+func (s *StockLevel) SetVariant(val ItemVariant) {
+	s.Variant = val
+}
+
+// SetLocation sets the value of Location.
+func (s *StockLevel) SetLocation(val StockLevelLocation) {
+	s.Location = val
+}
+
+// SetAvailable sets the value of Available.
+func (s *StockLevel) SetAvailable(val int) {
+	s.Available = val
+}
+
+// SetReserved sets the value of Reserved.
+func (s *StockLevel) SetReserved(val int) {
+	s.Reserved = val
+}
+
+// SetConsumed sets the value of Consumed.
+func (s *StockLevel) SetConsumed(val int) {
+	s.Consumed = val
+}
+
+// SetInTransit sets the value of InTransit.
+func (s *StockLevel) SetInTransit(val int) {
+	s.InTransit = val
+}
+
+// SetTotal sets the value of Total.
+func (s *StockLevel) SetTotal(val int) {
+	s.Total = val
+}
+
+type StockLevelLocation struct {
+	ID         uuid.UUID                    `json:"id"`
+	Name       string                       `json:"name"`
+	Alias      string                       `json:"alias"`
+	ObjectType StockLevelLocationObjectType `json:"objectType"`
+}
+
+// GetID returns the value of ID.
+func (s *StockLevelLocation) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *StockLevelLocation) GetName() string {
+	return s.Name
+}
+
+// GetAlias returns the value of Alias.
+func (s *StockLevelLocation) GetAlias() string {
+	return s.Alias
+}
+
+// GetObjectType returns the value of ObjectType.
+func (s *StockLevelLocation) GetObjectType() StockLevelLocationObjectType {
+	return s.ObjectType
+}
+
+// SetID sets the value of ID.
+func (s *StockLevelLocation) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *StockLevelLocation) SetName(val string) {
+	s.Name = val
+}
+
+// SetAlias sets the value of Alias.
+func (s *StockLevelLocation) SetAlias(val string) {
+	s.Alias = val
+}
+
+// SetObjectType sets the value of ObjectType.
+func (s *StockLevelLocation) SetObjectType(val StockLevelLocationObjectType) {
+	s.ObjectType = val
+}
+
+type StockLevelLocationObjectType string
+
+const (
+	StockLevelLocationObjectTypeCell         StockLevelLocationObjectType = "cell"
+	StockLevelLocationObjectTypeCellsGroup   StockLevelLocationObjectType = "cells_group"
+	StockLevelLocationObjectTypeStorageGroup StockLevelLocationObjectType = "storage_group"
+	StockLevelLocationObjectTypeUnit         StockLevelLocationObjectType = "unit"
+)
+
+// AllValues returns all StockLevelLocationObjectType values.
+func (StockLevelLocationObjectType) AllValues() []StockLevelLocationObjectType {
+	return []StockLevelLocationObjectType{
+		StockLevelLocationObjectTypeCell,
+		StockLevelLocationObjectTypeCellsGroup,
+		StockLevelLocationObjectTypeStorageGroup,
+		StockLevelLocationObjectTypeUnit,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s StockLevelLocationObjectType) MarshalText() ([]byte, error) {
+	switch s {
+	case StockLevelLocationObjectTypeCell:
+		return []byte(s), nil
+	case StockLevelLocationObjectTypeCellsGroup:
+		return []byte(s), nil
+	case StockLevelLocationObjectTypeStorageGroup:
+		return []byte(s), nil
+	case StockLevelLocationObjectTypeUnit:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StockLevelLocationObjectType) UnmarshalText(data []byte) error {
+	switch StockLevelLocationObjectType(data) {
+	case StockLevelLocationObjectTypeCell:
+		*s = StockLevelLocationObjectTypeCell
+		return nil
+	case StockLevelLocationObjectTypeCellsGroup:
+		*s = StockLevelLocationObjectTypeCellsGroup
+		return nil
+	case StockLevelLocationObjectTypeStorageGroup:
+		*s = StockLevelLocationObjectTypeStorageGroup
+		return nil
+	case StockLevelLocationObjectTypeUnit:
+		*s = StockLevelLocationObjectTypeUnit
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/StockLine
+type StockLine struct {
+	ID               uuid.UUID                  `json:"id"`
+	Status           StockLineStatus            `json:"status"`
+	Quantity         int                        `json:"quantity"`
+	Item             ItemForList                `json:"item"`
+	Variant          ItemVariant                `json:"variant"`
+	Cell             NilCellForInstanceOptional `json:"cell"`
+	AffectedByTaskId NilUUID                    `json:"affectedByTaskId"`
+	CreatedAt        time.Time                  `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *StockLine) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetStatus returns the value of Status.
+func (s *StockLine) GetStatus() StockLineStatus {
+	return s.Status
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *StockLine) GetQuantity() int {
+	return s.Quantity
+}
+
+// GetItem returns the value of Item.
+func (s *StockLine) GetItem() ItemForList {
+	return s.Item
+}
+
+// GetVariant returns the value of Variant.
+func (s *StockLine) GetVariant() ItemVariant {
+	return s.Variant
+}
+
+// GetCell returns the value of Cell.
+func (s *StockLine) GetCell() NilCellForInstanceOptional {
+	return s.Cell
+}
+
+// GetAffectedByTaskId returns the value of AffectedByTaskId.
+func (s *StockLine) GetAffectedByTaskId() NilUUID {
+	return s.AffectedByTaskId
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *StockLine) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *StockLine) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *StockLine) SetStatus(val StockLineStatus) {
+	s.Status = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *StockLine) SetQuantity(val int) {
+	s.Quantity = val
+}
+
+// SetItem sets the value of Item.
+func (s *StockLine) SetItem(val ItemForList) {
+	s.Item = val
+}
+
+// SetVariant sets the value of Variant.
+func (s *StockLine) SetVariant(val ItemVariant) {
+	s.Variant = val
+}
+
+// SetCell sets the value of Cell.
+func (s *StockLine) SetCell(val NilCellForInstanceOptional) {
+	s.Cell = val
+}
+
+// SetAffectedByTaskId sets the value of AffectedByTaskId.
+func (s *StockLine) SetAffectedByTaskId(val NilUUID) {
+	s.AffectedByTaskId = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *StockLine) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type StockLineStatus string
+
+const (
+	StockLineStatusAvailable   StockLineStatus = "available"
+	StockLineStatusReserved    StockLineStatus = "reserved"
+	StockLineStatusConsumed    StockLineStatus = "consumed"
+	StockLineStatusQuarantined StockLineStatus = "quarantined"
+	StockLineStatusDisposed    StockLineStatus = "disposed"
+	StockLineStatusShipped     StockLineStatus = "shipped"
+	StockLineStatusInTransit   StockLineStatus = "in_transit"
+)
+
+// AllValues returns all StockLineStatus values.
+func (StockLineStatus) AllValues() []StockLineStatus {
+	return []StockLineStatus{
+		StockLineStatusAvailable,
+		StockLineStatusReserved,
+		StockLineStatusConsumed,
+		StockLineStatusQuarantined,
+		StockLineStatusDisposed,
+		StockLineStatusShipped,
+		StockLineStatusInTransit,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s StockLineStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case StockLineStatusAvailable:
+		return []byte(s), nil
+	case StockLineStatusReserved:
+		return []byte(s), nil
+	case StockLineStatusConsumed:
+		return []byte(s), nil
+	case StockLineStatusQuarantined:
+		return []byte(s), nil
+	case StockLineStatusDisposed:
+		return []byte(s), nil
+	case StockLineStatusShipped:
+		return []byte(s), nil
+	case StockLineStatusInTransit:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StockLineStatus) UnmarshalText(data []byte) error {
+	switch StockLineStatus(data) {
+	case StockLineStatusAvailable:
+		*s = StockLineStatusAvailable
+		return nil
+	case StockLineStatusReserved:
+		*s = StockLineStatusReserved
+		return nil
+	case StockLineStatusConsumed:
+		*s = StockLineStatusConsumed
+		return nil
+	case StockLineStatusQuarantined:
+		*s = StockLineStatusQuarantined
+		return nil
+	case StockLineStatusDisposed:
+		*s = StockLineStatusDisposed
+		return nil
+	case StockLineStatusShipped:
+		*s = StockLineStatusShipped
+		return nil
+	case StockLineStatusInTransit:
+		*s = StockLineStatusInTransit
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type StorageAlias string
+
+// Merged schema.
+// Ref: #/components/schemas/StorageGroup
+type StorageGroup struct {
+	ID uuid.UUID `json:"id"`
+	// Merged property.
+	ParentId NilUUID `json:"parentId"`
+	// Merged property.
+	UnitId uuid.UUID    `json:"unitId"`
+	Name   string       `json:"name"`
+	Alias  StorageAlias `json:"alias"`
+}
+
+// GetID returns the value of ID.
+func (s *StorageGroup) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetParentId returns the value of ParentId.
+func (s *StorageGroup) GetParentId() NilUUID {
+	return s.ParentId
+}
+
+// GetUnitId returns the value of UnitId.
+func (s *StorageGroup) GetUnitId() uuid.UUID {
+	return s.UnitId
+}
+
+// GetName returns the value of Name.
+func (s *StorageGroup) GetName() string {
+	return s.Name
+}
+
+// GetAlias returns the value of Alias.
+func (s *StorageGroup) GetAlias() StorageAlias {
+	return s.Alias
+}
+
+// SetID sets the value of ID.
+func (s *StorageGroup) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetParentId sets the value of ParentId.
+func (s *StorageGroup) SetParentId(val NilUUID) {
+	s.ParentId = val
+}
+
+// SetUnitId sets the value of UnitId.
+func (s *StorageGroup) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
+
+// SetName sets the value of Name.
+func (s *StorageGroup) SetName(val string) {
+	s.Name = val
+}
+
+// SetAlias sets the value of Alias.
+func (s *StorageGroup) SetAlias(val StorageAlias) {
+	s.Alias = val
+}
+
+// Ref: #/components/schemas/StorageGroupBase
+type StorageGroupBase struct {
+	ParentId OptNilUUID   `json:"parentId"`
+	Name     string       `json:"name"`
+	Alias    StorageAlias `json:"alias"`
+	UnitId   uuid.UUID    `json:"unitId"`
+}
+
+// GetParentId returns the value of ParentId.
+func (s *StorageGroupBase) GetParentId() OptNilUUID {
+	return s.ParentId
+}
+
+// GetName returns the value of Name.
+func (s *StorageGroupBase) GetName() string {
+	return s.Name
+}
+
+// GetAlias returns the value of Alias.
+func (s *StorageGroupBase) GetAlias() StorageAlias {
+	return s.Alias
+}
+
+// GetUnitId returns the value of UnitId.
+func (s *StorageGroupBase) GetUnitId() uuid.UUID {
+	return s.UnitId
+}
+
+// SetParentId sets the value of ParentId.
+func (s *StorageGroupBase) SetParentId(val OptNilUUID) {
+	s.ParentId = val
+}
+
+// SetName sets the value of Name.
+func (s *StorageGroupBase) SetName(val string) {
+	s.Name = val
+}
+
+// SetAlias sets the value of Alias.
+func (s *StorageGroupBase) SetAlias(val StorageAlias) {
+	s.Alias = val
+}
+
+// SetUnitId sets the value of UnitId.
+func (s *StorageGroupBase) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
+
+// Ref: #/components/schemas/SubmitCountRequest
+type SubmitCountRequest struct {
+	CellId uuid.UUID `json:"cellId"`
+	// Scanned item instances.
+	InstanceIds []uuid.UUID `json:"instanceIds"`
+	// Scanned variant barcodes (EAN-13 or article), one entry per unit.
+	Barcodes []string `json:"barcodes"`
+}
+
+// GetCellId returns the value of CellId.
+func (s *SubmitCountRequest) GetCellId() uuid.UUID {
+	return s.CellId
+}
+
+// GetInstanceIds returns the value of InstanceIds.
+func (s *SubmitCountRequest) GetInstanceIds() []uuid.UUID {
+	return s.InstanceIds
+}
+
+// GetBarcodes returns the value of Barcodes.
+func (s *SubmitCountRequest) GetBarcodes() []string {
+	return s.Barcodes
+}
+
+// SetCellId sets the value of CellId.
+func (s *SubmitCountRequest) SetCellId(val uuid.UUID) {
+	s.CellId = val
+}
+
+// SetInstanceIds sets the value of InstanceIds.
+func (s *SubmitCountRequest) SetInstanceIds(val []uuid.UUID) {
+	s.InstanceIds = val
+}
+
+// SetBarcodes sets the value of Barcodes.
+func (s *SubmitCountRequest) SetBarcodes(val []string) {
+	s.Barcodes = val
+}
+
+type SubmitInventoryCountBadRequest ErrorContent
+
+func (*SubmitInventoryCountBadRequest) submitInventoryCountRes() {}
+
+type SubmitInventoryCountForbidden ErrorContent
+
+func (*SubmitInventoryCountForbidden) submitInventoryCountRes() {}
+
+// SubmitInventoryCountNoContent is response for SubmitInventoryCount operation.
+type SubmitInventoryCountNoContent struct{}
+
+func (*SubmitInventoryCountNoContent) submitInventoryCountRes() {}
+
+type SubmitInventoryCountUnauthorized ErrorContent
+
+func (*SubmitInventoryCountUnauthorized) submitInventoryCountRes() {}
+
+// How a worker is picked for tasks created without an assignee.
+// Ref: #/components/schemas/TaskAssignmentStrategy
+type TaskAssignmentStrategy string
+
+const (
+	TaskAssignmentStrategyManual         TaskAssignmentStrategy = "manual"
+	TaskAssignmentStrategyLeastOpenTasks TaskAssignmentStrategy = "least_open_tasks"
+	TaskAssignmentStrategyRoundRobin     TaskAssignmentStrategy = "round_robin"
+	TaskAssignmentStrategySkills         TaskAssignmentStrategy = "skills"
+)
+
+// AllValues returns all TaskAssignmentStrategy values.
+func (TaskAssignmentStrategy) AllValues() []TaskAssignmentStrategy {
+	return []TaskAssignmentStrategy{
+		TaskAssignmentStrategyManual,
+		TaskAssignmentStrategyLeastOpenTasks,
+		TaskAssignmentStrategyRoundRobin,
+		TaskAssignmentStrategySkills,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskAssignmentStrategy) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskAssignmentStrategyManual:
+		return []byte(s), nil
+	case TaskAssignmentStrategyLeastOpenTasks:
+		return []byte(s), nil
+	case TaskAssignmentStrategyRoundRobin:
+		return []byte(s), nil
+	case TaskAssignmentStrategySkills:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskAssignmentStrategy) UnmarshalText(data []byte) error {
+	switch TaskAssignmentStrategy(data) {
+	case TaskAssignmentStrategyManual:
+		*s = TaskAssignmentStrategyManual
+		return nil
+	case TaskAssignmentStrategyLeastOpenTasks:
+		*s = TaskAssignmentStrategyLeastOpenTasks
+		return nil
+	case TaskAssignmentStrategyRoundRobin:
+		*s = TaskAssignmentStrategyRoundRobin
+		return nil
+	case TaskAssignmentStrategySkills:
+		*s = TaskAssignmentStrategySkills
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TaskAttachment
+type TaskAttachment struct {
+	ID     uuid.UUID `json:"id"`
+	TaskId uuid.UUID `json:"taskId"`
+	// Set when the file is attached to a task item.
+	InstanceId NilUUID `json:"instanceId"`
+	// Comment the file illustrates.
+	CommentId   NilUUID `json:"commentId"`
+	FileName    string  `json:"fileName"`
+	ContentType string  `json:"contentType"`
+	// Size in bytes.
+	Size       int64               `json:"size"`
+	UploadedBy NilEmployeeOptional `json:"uploadedBy"`
+	CreatedAt  time.Time           `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *TaskAttachment) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetTaskId returns the value of TaskId.
+func (s *TaskAttachment) GetTaskId() uuid.UUID {
+	return s.TaskId
+}
+
+// GetInstanceId returns the value of InstanceId.
+func (s *TaskAttachment) GetInstanceId() NilUUID {
+	return s.InstanceId
+}
+
+// GetCommentId returns the value of CommentId.
+func (s *TaskAttachment) GetCommentId() NilUUID {
+	return s.CommentId
+}
+
+// GetFileName returns the value of FileName.
+func (s *TaskAttachment) GetFileName() string {
+	return s.FileName
+}
+
+// GetContentType returns the value of ContentType.
+func (s *TaskAttachment) GetContentType() string {
+	return s.ContentType
+}
+
+// GetSize returns the value of Size.
+func (s *TaskAttachment) GetSize() int64 {
+	return s.Size
+}
+
+// GetUploadedBy returns the value of UploadedBy.
+func (s *TaskAttachment) GetUploadedBy() NilEmployeeOptional {
+	return s.UploadedBy
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TaskAttachment) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *TaskAttachment) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetTaskId sets the value of TaskId.
+func (s *TaskAttachment) SetTaskId(val uuid.UUID) {
+	s.TaskId = val
+}
+
+// SetInstanceId sets the value of InstanceId.
+func (s *TaskAttachment) SetInstanceId(val NilUUID) {
+	s.InstanceId = val
+}
+
+// SetCommentId sets the value of CommentId.
+func (s *TaskAttachment) SetCommentId(val NilUUID) {
+	s.CommentId = val
+}
+
+// SetFileName sets the value of FileName.
+func (s *TaskAttachment) SetFileName(val string) {
+	s.FileName = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *TaskAttachment) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetSize sets the value of Size.
+func (s *TaskAttachment) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetUploadedBy sets the value of UploadedBy.
+func (s *TaskAttachment) SetUploadedBy(val NilEmployeeOptional) {
+	s.UploadedBy = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TaskAttachment) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/TaskBase
+type TaskBase struct {
+	ID          uuid.UUID        `json:"id"`
+	Name        string           `json:"name"`
+	Description NilString        `json:"description"`
+	Type        TaskBaseType     `json:"type"`
+	Status      TaskBaseStatus   `json:"status"`
+	Priority    TaskBasePriority `json:"priority"`
+	DueAt       NilDateTime      `json:"dueAt"`
+	// When the task was found open past its due date.
+	SlaBreachedAt NilDateTime         `json:"slaBreachedAt"`
+	CreatedAt     time.Time           `json:"createdAt"`
+	Unit          Unit                `json:"unit"`
+	AssignedTo    NilEmployeeOptional `json:"assignedTo"`
+	AssignedAt    NilDateTime         `json:"assignedAt"`
+	CompletedAt   NilDateTime         `json:"completedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *TaskBase) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TaskBase) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *TaskBase) GetDescription() NilString {
+	return s.Description
+}
+
+// GetType returns the value of Type.
+func (s *TaskBase) GetType() TaskBaseType {
+	return s.Type
+}
+
+// GetStatus returns the value of Status.
+func (s *TaskBase) GetStatus() TaskBaseStatus {
+	return s.Status
+}
+
+// GetPriority returns the value of Priority.
+func (s *TaskBase) GetPriority() TaskBasePriority {
+	return s.Priority
+}
+
+// GetDueAt returns the value of DueAt.
+func (s *TaskBase) GetDueAt() NilDateTime {
+	return s.DueAt
+}
+
+// GetSlaBreachedAt returns the value of SlaBreachedAt.
+func (s *TaskBase) GetSlaBreachedAt() NilDateTime {
+	return s.SlaBreachedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TaskBase) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUnit returns the value of Unit.
+func (s *TaskBase) GetUnit() Unit {
+	return s.Unit
+}
+
+// GetAssignedTo returns the value of AssignedTo.
+func (s *TaskBase) GetAssignedTo() NilEmployeeOptional {
+	return s.AssignedTo
+}
+
+// GetAssignedAt returns the value of AssignedAt.
+func (s *TaskBase) GetAssignedAt() NilDateTime {
+	return s.AssignedAt
+}
+
+// GetCompletedAt returns the value of CompletedAt.
+func (s *TaskBase) GetCompletedAt() NilDateTime {
+	return s.CompletedAt
+}
+
+// SetID sets the value of ID.
+func (s *TaskBase) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TaskBase) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *TaskBase) SetDescription(val NilString) {
+	s.Description = val
+}
+
+// SetType sets the value of Type.
+func (s *TaskBase) SetType(val TaskBaseType) {
+	s.Type = val
+}
+
+// SetStatus sets the value of Status.
+func (s *TaskBase) SetStatus(val TaskBaseStatus) {
+	s.Status = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *TaskBase) SetPriority(val TaskBasePriority) {
+	s.Priority = val
+}
+
+// SetDueAt sets the value of DueAt.
+func (s *TaskBase) SetDueAt(val NilDateTime) {
+	s.DueAt = val
+}
+
+// SetSlaBreachedAt sets the value of SlaBreachedAt.
+func (s *TaskBase) SetSlaBreachedAt(val NilDateTime) {
+	s.SlaBreachedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TaskBase) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUnit sets the value of Unit.
+func (s *TaskBase) SetUnit(val Unit) {
+	s.Unit = val
+}
+
+// SetAssignedTo sets the value of AssignedTo.
+func (s *TaskBase) SetAssignedTo(val NilEmployeeOptional) {
+	s.AssignedTo = val
+}
+
+// SetAssignedAt sets the value of AssignedAt.
+func (s *TaskBase) SetAssignedAt(val NilDateTime) {
+	s.AssignedAt = val
+}
+
+// SetCompletedAt sets the value of CompletedAt.
+func (s *TaskBase) SetCompletedAt(val NilDateTime) {
+	s.CompletedAt = val
+}
+
+type TaskBasePriority string
+
+const (
+	TaskBasePriorityLow    TaskBasePriority = "low"
+	TaskBasePriorityNormal TaskBasePriority = "normal"
+	TaskBasePriorityHigh   TaskBasePriority = "high"
+	TaskBasePriorityUrgent TaskBasePriority = "urgent"
+)
+
+// AllValues returns all TaskBasePriority values.
+func (TaskBasePriority) AllValues() []TaskBasePriority {
+	return []TaskBasePriority{
+		TaskBasePriorityLow,
+		TaskBasePriorityNormal,
+		TaskBasePriorityHigh,
+		TaskBasePriorityUrgent,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskBasePriority) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskBasePriorityLow:
+		return []byte(s), nil
+	case TaskBasePriorityNormal:
+		return []byte(s), nil
+	case TaskBasePriorityHigh:
+		return []byte(s), nil
+	case TaskBasePriorityUrgent:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskBasePriority) UnmarshalText(data []byte) error {
+	switch TaskBasePriority(data) {
+	case TaskBasePriorityLow:
+		*s = TaskBasePriorityLow
+		return nil
+	case TaskBasePriorityNormal:
+		*s = TaskBasePriorityNormal
+		return nil
+	case TaskBasePriorityHigh:
+		*s = TaskBasePriorityHigh
+		return nil
+	case TaskBasePriorityUrgent:
+		*s = TaskBasePriorityUrgent
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type TaskBaseStatus string
+
+const (
+	TaskBaseStatusPending    TaskBaseStatus = "pending"
+	TaskBaseStatusInProgress TaskBaseStatus = "in_progress"
+	TaskBaseStatusReady      TaskBaseStatus = "ready"
+	TaskBaseStatusCompleted  TaskBaseStatus = "completed"
+	TaskBaseStatusCancelled  TaskBaseStatus = "cancelled"
+)
+
+// AllValues returns all TaskBaseStatus values.
+func (TaskBaseStatus) AllValues() []TaskBaseStatus {
+	return []TaskBaseStatus{
+		TaskBaseStatusPending,
+		TaskBaseStatusInProgress,
+		TaskBaseStatusReady,
+		TaskBaseStatusCompleted,
+		TaskBaseStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskBaseStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskBaseStatusPending:
+		return []byte(s), nil
+	case TaskBaseStatusInProgress:
+		return []byte(s), nil
+	case TaskBaseStatusReady:
+		return []byte(s), nil
+	case TaskBaseStatusCompleted:
+		return []byte(s), nil
+	case TaskBaseStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskBaseStatus) UnmarshalText(data []byte) error {
+	switch TaskBaseStatus(data) {
+	case TaskBaseStatusPending:
+		*s = TaskBaseStatusPending
+		return nil
+	case TaskBaseStatusInProgress:
+		*s = TaskBaseStatusInProgress
+		return nil
+	case TaskBaseStatusReady:
+		*s = TaskBaseStatusReady
+		return nil
+	case TaskBaseStatusCompleted:
+		*s = TaskBaseStatusCompleted
+		return nil
+	case TaskBaseStatusCancelled:
+		*s = TaskBaseStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type TaskBaseType string
+
+const (
+	TaskBaseTypePickment       TaskBaseType = "pickment"
+	TaskBaseTypeMovement       TaskBaseType = "movement"
+	TaskBaseTypeReceiving      TaskBaseType = "receiving"
+	TaskBaseTypeInventoryCount TaskBaseType = "inventory_count"
+	TaskBaseTypeReturn         TaskBaseType = "return"
+)
+
+// AllValues returns all TaskBaseType values.
+func (TaskBaseType) AllValues() []TaskBaseType {
+	return []TaskBaseType{
+		TaskBaseTypePickment,
+		TaskBaseTypeMovement,
+		TaskBaseTypeReceiving,
+		TaskBaseTypeInventoryCount,
+		TaskBaseTypeReturn,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskBaseType) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskBaseTypePickment:
+		return []byte(s), nil
+	case TaskBaseTypeMovement:
+		return []byte(s), nil
+	case TaskBaseTypeReceiving:
+		return []byte(s), nil
+	case TaskBaseTypeInventoryCount:
+		return []byte(s), nil
+	case TaskBaseTypeReturn:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskBaseType) UnmarshalText(data []byte) error {
+	switch TaskBaseType(data) {
+	case TaskBaseTypePickment:
+		*s = TaskBaseTypePickment
+		return nil
+	case TaskBaseTypeMovement:
+		*s = TaskBaseTypeMovement
+		return nil
+	case TaskBaseTypeReceiving:
+		*s = TaskBaseTypeReceiving
+		return nil
+	case TaskBaseTypeInventoryCount:
+		*s = TaskBaseTypeInventoryCount
+		return nil
+	case TaskBaseTypeReturn:
+		*s = TaskBaseTypeReturn
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TaskComment
+type TaskComment struct {
+	ID     uuid.UUID `json:"id"`
+	TaskId uuid.UUID `json:"taskId"`
+	// Set when the comment is left on a task item.
+	InstanceId NilUUID             `json:"instanceId"`
+	Body       string              `json:"body"`
+	Author     NilEmployeeOptional `json:"author"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	// Time of the last edit, previous versions are kept in the audit log.
+	EditedAt NilDateTime `json:"editedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *TaskComment) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetTaskId returns the value of TaskId.
+func (s *TaskComment) GetTaskId() uuid.UUID {
+	return s.TaskId
+}
+
+// GetInstanceId returns the value of InstanceId.
+func (s *TaskComment) GetInstanceId() NilUUID {
+	return s.InstanceId
+}
+
+// GetBody returns the value of Body.
+func (s *TaskComment) GetBody() string {
+	return s.Body
+}
+
+// GetAuthor returns the value of Author.
+func (s *TaskComment) GetAuthor() NilEmployeeOptional {
+	return s.Author
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TaskComment) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetEditedAt returns the value of EditedAt.
+func (s *TaskComment) GetEditedAt() NilDateTime {
+	return s.EditedAt
+}
+
+// SetID sets the value of ID.
+func (s *TaskComment) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetTaskId sets the value of TaskId.
+func (s *TaskComment) SetTaskId(val uuid.UUID) {
+	s.TaskId = val
+}
+
+// SetInstanceId sets the value of InstanceId.
+func (s *TaskComment) SetInstanceId(val NilUUID) {
+	s.InstanceId = val
+}
+
+// SetBody sets the value of Body.
+func (s *TaskComment) SetBody(val string) {
+	s.Body = val
+}
+
+// SetAuthor sets the value of Author.
+func (s *TaskComment) SetAuthor(val NilEmployeeOptional) {
+	s.Author = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TaskComment) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetEditedAt sets the value of EditedAt.
+func (s *TaskComment) SetEditedAt(val NilDateTime) {
+	s.EditedAt = val
+}
+
+// Ref: #/components/schemas/TaskCountCell
+type TaskCountCell struct {
+	Cell      CellForInstance `json:"cell"`
+	CountedAt NilDateTime     `json:"countedAt"`
+}
+
+// GetCell returns the value of Cell.
+func (s *TaskCountCell) GetCell() CellForInstance {
+	return s.Cell
+}
+
+// GetCountedAt returns the value of CountedAt.
+func (s *TaskCountCell) GetCountedAt() NilDateTime {
+	return s.CountedAt
+}
+
+// SetCell sets the value of Cell.
+func (s *TaskCountCell) SetCell(val CellForInstance) {
+	s.Cell = val
+}
+
+// SetCountedAt sets the value of CountedAt.
+func (s *TaskCountCell) SetCountedAt(val NilDateTime) {
+	s.CountedAt = val
+}
+
+// Ref: #/components/schemas/TaskExceptionsReportResponse
+type TaskExceptionsReportResponse struct {
+	Data []TaskItemException `json:"data"`
+	// Number of listed exceptions per reason.
+	CountsByReason TaskExceptionsReportResponseCountsByReason `json:"countsByReason"`
+}
+
+// GetData returns the value of Data.
+func (s *TaskExceptionsReportResponse) GetData() []TaskItemException {
+	return s.Data
+}
+
+// GetCountsByReason returns the value of CountsByReason.
+func (s *TaskExceptionsReportResponse) GetCountsByReason() TaskExceptionsReportResponseCountsByReason {
+	return s.CountsByReason
+}
+
+// SetData sets the value of Data.
+func (s *TaskExceptionsReportResponse) SetData(val []TaskItemException) {
+	s.Data = val
+}
+
+// SetCountsByReason sets the value of CountsByReason.
+func (s *TaskExceptionsReportResponse) SetCountsByReason(val TaskExceptionsReportResponseCountsByReason) {
+	s.CountsByReason = val
+}
+
+func (*TaskExceptionsReportResponse) getTaskExceptionsReportRes() {}
+
+// Number of listed exceptions per reason.
+type TaskExceptionsReportResponseCountsByReason struct {
+	NotFound  int `json:"notFound"`
+	Damaged   int `json:"damaged"`
+	WrongItem int `json:"wrongItem"`
+}
+
+// GetNotFound returns the value of NotFound.
+func (s *TaskExceptionsReportResponseCountsByReason) GetNotFound() int {
+	return s.NotFound
+}
+
+// GetDamaged returns the value of Damaged.
+func (s *TaskExceptionsReportResponseCountsByReason) GetDamaged() int {
+	return s.Damaged
+}
+
+// GetWrongItem returns the value of WrongItem.
+func (s *TaskExceptionsReportResponseCountsByReason) GetWrongItem() int {
+	return s.WrongItem
+}
+
+// SetNotFound sets the value of NotFound.
+func (s *TaskExceptionsReportResponseCountsByReason) SetNotFound(val int) {
+	s.NotFound = val
+}
+
+// SetDamaged sets the value of Damaged.
+func (s *TaskExceptionsReportResponseCountsByReason) SetDamaged(val int) {
+	s.Damaged = val
+}
+
+// SetWrongItem sets the value of WrongItem.
+func (s *TaskExceptionsReportResponseCountsByReason) SetWrongItem(val int) {
+	s.WrongItem = val
+}
+
+// Ref: #/components/schemas/TaskExpectedItem
+type TaskExpectedItem struct {
+	Variant          ItemVariant `json:"variant"`
+	ExpectedQuantity int         `json:"expectedQuantity"`
+	ReceivedQuantity int         `json:"receivedQuantity"`
+	// Set when receiving is finished and received quantity differs from expected.
+	Discrepancy NilTaskExpectedItemDiscrepancy `json:"discrepancy"`
+}
+
+// GetVariant returns the value of Variant.
+func (s *TaskExpectedItem) GetVariant() ItemVariant {
+	return s.Variant
+}
+
+// GetExpectedQuantity returns the value of ExpectedQuantity.
+func (s *TaskExpectedItem) GetExpectedQuantity() int {
+	return s.ExpectedQuantity
+}
+
+// GetReceivedQuantity returns the value of ReceivedQuantity.
+func (s *TaskExpectedItem) GetReceivedQuantity() int {
+	return s.ReceivedQuantity
+}
+
+// GetDiscrepancy returns the value of Discrepancy.
+func (s *TaskExpectedItem) GetDiscrepancy() NilTaskExpectedItemDiscrepancy {
+	return s.Discrepancy
+}
+
+// SetVariant sets the value of Variant.
+func (s *TaskExpectedItem) SetVariant(val ItemVariant) {
+	s.Variant = val
+}
+
+// SetExpectedQuantity sets the value of ExpectedQuantity.
+func (s *TaskExpectedItem) SetExpectedQuantity(val int) {
+	s.ExpectedQuantity = val
+}
+
+// SetReceivedQuantity sets the value of ReceivedQuantity.
+func (s *TaskExpectedItem) SetReceivedQuantity(val int) {
+	s.ReceivedQuantity = val
+}
+
+// SetDiscrepancy sets the value of Discrepancy.
+func (s *TaskExpectedItem) SetDiscrepancy(val NilTaskExpectedItemDiscrepancy) {
+	s.Discrepancy = val
+}
+
+// Set when receiving is finished and received quantity differs from expected.
+type TaskExpectedItemDiscrepancy string
+
+const (
+	TaskExpectedItemDiscrepancyOver  TaskExpectedItemDiscrepancy = "over"
+	TaskExpectedItemDiscrepancyUnder TaskExpectedItemDiscrepancy = "under"
+)
+
+// AllValues returns all TaskExpectedItemDiscrepancy values.
+func (TaskExpectedItemDiscrepancy) AllValues() []TaskExpectedItemDiscrepancy {
+	return []TaskExpectedItemDiscrepancy{
+		TaskExpectedItemDiscrepancyOver,
+		TaskExpectedItemDiscrepancyUnder,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskExpectedItemDiscrepancy) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskExpectedItemDiscrepancyOver:
+		return []byte(s), nil
+	case TaskExpectedItemDiscrepancyUnder:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskExpectedItemDiscrepancy) UnmarshalText(data []byte) error {
+	switch TaskExpectedItemDiscrepancy(data) {
+	case TaskExpectedItemDiscrepancyOver:
+		*s = TaskExpectedItemDiscrepancyOver
+		return nil
+	case TaskExpectedItemDiscrepancyUnder:
+		*s = TaskExpectedItemDiscrepancyUnder
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Merged schema.
+// Ref: #/components/schemas/TaskFull
+type TaskFull struct {
+	ID          uuid.UUID        `json:"id"`
+	Name        string           `json:"name"`
+	Description NilString        `json:"description"`
+	Type        TaskFullType     `json:"type"`
+	Status      TaskFullStatus   `json:"status"`
+	Priority    TaskFullPriority `json:"priority"`
+	DueAt       NilDateTime      `json:"dueAt"`
+	// When the task was found open past its due date.
+	SlaBreachedAt  NilDateTime                `json:"slaBreachedAt"`
+	CreatedAt      time.Time                  `json:"createdAt"`
+	Unit           Unit                       `json:"unit"`
+	AssignedTo     NilEmployeeOptional        `json:"assignedTo"`
+	AssignedAt     NilDateTime                `json:"assignedAt"`
+	CompletedAt    NilDateTime                `json:"completedAt"`
+	Items          []TaskItem                 `json:"items"`
+	ReceivingCell  NilCellForInstanceOptional `json:"receivingCell"`
+	ExpectedItems  []TaskExpectedItem         `json:"expectedItems"`
+	CountCells     []TaskCountCell            `json:"countCells"`
+	Exceptions     []TaskItemException        `json:"exceptions"`
+	OriginalTaskId NilUUID                    `json:"originalTaskId"`
+	// Pick wave the pickment task is picked in.
+	WaveId NilUUID `json:"waveId"`
+	// Task template the task was created from.
+	TemplateId NilUUID `json:"templateId"`
+	// Transfer order the task is the outbound or inbound leg of.
+	TransferOrderId NilUUID `json:"transferOrderId"`
+}
+
+// GetID returns the value of ID.
+func (s *TaskFull) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TaskFull) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *TaskFull) GetDescription() NilString {
+	return s.Description
+}
+
+// GetType returns the value of Type.
+func (s *TaskFull) GetType() TaskFullType {
+	return s.Type
+}
+
+// GetStatus returns the value of Status.
+func (s *TaskFull) GetStatus() TaskFullStatus {
+	return s.Status
+}
+
+// GetPriority returns the value of Priority.
+func (s *TaskFull) GetPriority() TaskFullPriority {
+	return s.Priority
+}
+
+// GetDueAt returns the value of DueAt.
+func (s *TaskFull) GetDueAt() NilDateTime {
+	return s.DueAt
+}
+
+// GetSlaBreachedAt returns the value of SlaBreachedAt.
+func (s *TaskFull) GetSlaBreachedAt() NilDateTime {
+	return s.SlaBreachedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TaskFull) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUnit returns the value of Unit.
+func (s *TaskFull) GetUnit() Unit {
+	return s.Unit
+}
+
+// GetAssignedTo returns the value of AssignedTo.
+func (s *TaskFull) GetAssignedTo() NilEmployeeOptional {
+	return s.AssignedTo
+}
+
+// GetAssignedAt returns the value of AssignedAt.
+func (s *TaskFull) GetAssignedAt() NilDateTime {
+	return s.AssignedAt
+}
+
+// GetCompletedAt returns the value of CompletedAt.
+func (s *TaskFull) GetCompletedAt() NilDateTime {
+	return s.CompletedAt
+}
+
+// GetItems returns the value of Items.
+func (s *TaskFull) GetItems() []TaskItem {
+	return s.Items
+}
+
+// GetReceivingCell returns the value of ReceivingCell.
+func (s *TaskFull) GetReceivingCell() NilCellForInstanceOptional {
+	return s.ReceivingCell
+}
+
+// GetExpectedItems returns the value of ExpectedItems.
+func (s *TaskFull) GetExpectedItems() []TaskExpectedItem {
+	return s.ExpectedItems
+}
+
+// GetCountCells returns the value of CountCells.
+func (s *TaskFull) GetCountCells() []TaskCountCell {
+	return s.CountCells
+}
+
+// GetExceptions returns the value of Exceptions.
+func (s *TaskFull) GetExceptions() []TaskItemException {
+	return s.Exceptions
+}
+
+// GetOriginalTaskId returns the value of OriginalTaskId.
+func (s *TaskFull) GetOriginalTaskId() NilUUID {
+	return s.OriginalTaskId
+}
+
+// GetWaveId returns the value of WaveId.
+func (s *TaskFull) GetWaveId() NilUUID {
+	return s.WaveId
+}
+
+// GetTemplateId returns the value of TemplateId.
+func (s *TaskFull) GetTemplateId() NilUUID {
+	return s.TemplateId
+}
+
+// GetTransferOrderId returns the value of TransferOrderId.
+func (s *TaskFull) GetTransferOrderId() NilUUID {
+	return s.TransferOrderId
+}
+
+// SetID sets the value of ID.
+func (s *TaskFull) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TaskFull) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *TaskFull) SetDescription(val NilString) {
+	s.Description = val
+}
+
+// SetType sets the value of Type.
+func (s *TaskFull) SetType(val TaskFullType) {
+	s.Type = val
+}
+
+// SetStatus sets the value of Status.
+func (s *TaskFull) SetStatus(val TaskFullStatus) {
+	s.Status = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *TaskFull) SetPriority(val TaskFullPriority) {
+	s.Priority = val
+}
+
+// SetDueAt sets the value of DueAt.
+func (s *TaskFull) SetDueAt(val NilDateTime) {
+	s.DueAt = val
+}
+
+// SetSlaBreachedAt sets the value of SlaBreachedAt.
+func (s *TaskFull) SetSlaBreachedAt(val NilDateTime) {
+	s.SlaBreachedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TaskFull) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUnit sets the value of Unit.
+func (s *TaskFull) SetUnit(val Unit) {
+	s.Unit = val
+}
+
+// SetAssignedTo sets the value of AssignedTo.
+func (s *TaskFull) SetAssignedTo(val NilEmployeeOptional) {
+	s.AssignedTo = val
+}
+
+// SetAssignedAt sets the value of AssignedAt.
+func (s *TaskFull) SetAssignedAt(val NilDateTime) {
+	s.AssignedAt = val
+}
+
+// SetCompletedAt sets the value of CompletedAt.
+func (s *TaskFull) SetCompletedAt(val NilDateTime) {
+	s.CompletedAt = val
+}
+
+// SetItems sets the value of Items.
+func (s *TaskFull) SetItems(val []TaskItem) {
+	s.Items = val
+}
+
+// SetReceivingCell sets the value of ReceivingCell.
+func (s *TaskFull) SetReceivingCell(val NilCellForInstanceOptional) {
+	s.ReceivingCell = val
+}
+
+// SetExpectedItems sets the value of ExpectedItems.
+func (s *TaskFull) SetExpectedItems(val []TaskExpectedItem) {
+	s.ExpectedItems = val
+}
+
+// SetCountCells sets the value of CountCells.
+func (s *TaskFull) SetCountCells(val []TaskCountCell) {
+	s.CountCells = val
+}
+
+// SetExceptions sets the value of Exceptions.
+func (s *TaskFull) SetExceptions(val []TaskItemException) {
+	s.Exceptions = val
+}
+
+// SetOriginalTaskId sets the value of OriginalTaskId.
+func (s *TaskFull) SetOriginalTaskId(val NilUUID) {
+	s.OriginalTaskId = val
+}
+
+// SetWaveId sets the value of WaveId.
+func (s *TaskFull) SetWaveId(val NilUUID) {
+	s.WaveId = val
+}
+
+// SetTemplateId sets the value of TemplateId.
+func (s *TaskFull) SetTemplateId(val NilUUID) {
+	s.TemplateId = val
+}
+
+// SetTransferOrderId sets the value of TransferOrderId.
+func (s *TaskFull) SetTransferOrderId(val NilUUID) {
+	s.TransferOrderId = val
+}
+
+type TaskFullPriority string
+
+const (
+	TaskFullPriorityLow    TaskFullPriority = "low"
+	TaskFullPriorityNormal TaskFullPriority = "normal"
+	TaskFullPriorityHigh   TaskFullPriority = "high"
+	TaskFullPriorityUrgent TaskFullPriority = "urgent"
+)
+
+// AllValues returns all TaskFullPriority values.
+func (TaskFullPriority) AllValues() []TaskFullPriority {
+	return []TaskFullPriority{
+		TaskFullPriorityLow,
+		TaskFullPriorityNormal,
+		TaskFullPriorityHigh,
+		TaskFullPriorityUrgent,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskFullPriority) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskFullPriorityLow:
+		return []byte(s), nil
+	case TaskFullPriorityNormal:
+		return []byte(s), nil
+	case TaskFullPriorityHigh:
+		return []byte(s), nil
+	case TaskFullPriorityUrgent:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskFullPriority) UnmarshalText(data []byte) error {
+	switch TaskFullPriority(data) {
+	case TaskFullPriorityLow:
+		*s = TaskFullPriorityLow
+		return nil
+	case TaskFullPriorityNormal:
+		*s = TaskFullPriorityNormal
+		return nil
+	case TaskFullPriorityHigh:
+		*s = TaskFullPriorityHigh
+		return nil
+	case TaskFullPriorityUrgent:
+		*s = TaskFullPriorityUrgent
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type TaskFullStatus string
+
+const (
+	TaskFullStatusPending    TaskFullStatus = "pending"
+	TaskFullStatusInProgress TaskFullStatus = "in_progress"
+	TaskFullStatusReady      TaskFullStatus = "ready"
+	TaskFullStatusCompleted  TaskFullStatus = "completed"
+	TaskFullStatusCancelled  TaskFullStatus = "cancelled"
+)
+
+// AllValues returns all TaskFullStatus values.
+func (TaskFullStatus) AllValues() []TaskFullStatus {
+	return []TaskFullStatus{
+		TaskFullStatusPending,
+		TaskFullStatusInProgress,
+		TaskFullStatusReady,
+		TaskFullStatusCompleted,
+		TaskFullStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskFullStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskFullStatusPending:
+		return []byte(s), nil
+	case TaskFullStatusInProgress:
+		return []byte(s), nil
+	case TaskFullStatusReady:
+		return []byte(s), nil
+	case TaskFullStatusCompleted:
+		return []byte(s), nil
+	case TaskFullStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskFullStatus) UnmarshalText(data []byte) error {
+	switch TaskFullStatus(data) {
+	case TaskFullStatusPending:
+		*s = TaskFullStatusPending
+		return nil
+	case TaskFullStatusInProgress:
+		*s = TaskFullStatusInProgress
+		return nil
+	case TaskFullStatusReady:
+		*s = TaskFullStatusReady
+		return nil
+	case TaskFullStatusCompleted:
+		*s = TaskFullStatusCompleted
+		return nil
+	case TaskFullStatusCancelled:
+		*s = TaskFullStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type TaskFullType string
+
+const (
+	TaskFullTypePickment       TaskFullType = "pickment"
+	TaskFullTypeMovement       TaskFullType = "movement"
+	TaskFullTypeReceiving      TaskFullType = "receiving"
+	TaskFullTypeInventoryCount TaskFullType = "inventory_count"
+	TaskFullTypeReturn         TaskFullType = "return"
+)
+
+// AllValues returns all TaskFullType values.
+func (TaskFullType) AllValues() []TaskFullType {
+	return []TaskFullType{
+		TaskFullTypePickment,
+		TaskFullTypeMovement,
+		TaskFullTypeReceiving,
+		TaskFullTypeInventoryCount,
+		TaskFullTypeReturn,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskFullType) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskFullTypePickment:
+		return []byte(s), nil
+	case TaskFullTypeMovement:
+		return []byte(s), nil
+	case TaskFullTypeReceiving:
+		return []byte(s), nil
+	case TaskFullTypeInventoryCount:
+		return []byte(s), nil
+	case TaskFullTypeReturn:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskFullType) UnmarshalText(data []byte) error {
+	switch TaskFullType(data) {
+	case TaskFullTypePickment:
+		*s = TaskFullTypePickment
+		return nil
+	case TaskFullTypeMovement:
+		*s = TaskFullTypeMovement
+		return nil
+	case TaskFullTypeReceiving:
+		*s = TaskFullTypeReceiving
+		return nil
+	case TaskFullTypeInventoryCount:
+		*s = TaskFullTypeInventoryCount
+		return nil
+	case TaskFullTypeReturn:
+		*s = TaskFullTypeReturn
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TaskImportReport
+type TaskImportReport struct {
+	DryRun bool `json:"dryRun"`
+	// Number of rows read from the file.
+	RowsCount int `json:"rowsCount"`
+	// Tasks made up from the rows, empty when any row is invalid.
+	Tasks  []TaskImportReportTasksItem  `json:"tasks"`
+	Errors []TaskImportReportErrorsItem `json:"errors"`
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *TaskImportReport) GetDryRun() bool {
+	return s.DryRun
+}
+
+// GetRowsCount returns the value of RowsCount.
+func (s *TaskImportReport) GetRowsCount() int {
+	return s.RowsCount
+}
+
+// GetTasks returns the value of Tasks.
+func (s *TaskImportReport) GetTasks() []TaskImportReportTasksItem {
+	return s.Tasks
+}
+
+// GetErrors returns the value of Errors.
+func (s *TaskImportReport) GetErrors() []TaskImportReportErrorsItem {
+	return s.Errors
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *TaskImportReport) SetDryRun(val bool) {
+	s.DryRun = val
+}
+
+// SetRowsCount sets the value of RowsCount.
+func (s *TaskImportReport) SetRowsCount(val int) {
+	s.RowsCount = val
+}
+
+// SetTasks sets the value of Tasks.
+func (s *TaskImportReport) SetTasks(val []TaskImportReportTasksItem) {
+	s.Tasks = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *TaskImportReport) SetErrors(val []TaskImportReportErrorsItem) {
+	s.Errors = val
+}
+
+type TaskImportReportErrorsItem struct {
+	// Line of the file, the CSV header is line 1.
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// GetLine returns the value of Line.
+func (s *TaskImportReportErrorsItem) GetLine() int {
+	return s.Line
+}
+
+// GetMessage returns the value of Message.
+func (s *TaskImportReportErrorsItem) GetMessage() string {
+	return s.Message
+}
+
+// SetLine sets the value of Line.
+func (s *TaskImportReportErrorsItem) SetLine(val int) {
+	s.Line = val
+}
+
+// SetMessage sets the value of Message.
+func (s *TaskImportReportErrorsItem) SetMessage(val string) {
+	s.Message = val
+}
+
+type TaskImportReportTasksItem struct {
+	// Id of the created task, null in dry run.
+	TaskId NilUUID                       `json:"taskId"`
+	Name   string                        `json:"name"`
+	Type   TaskImportReportTasksItemType `json:"type"`
+	UnitId uuid.UUID                     `json:"unitId"`
+	// Lines of the file the task is made up from.
+	Lines       []int       `json:"lines"`
+	InstanceIds []uuid.UUID `json:"instanceIds"`
+}
+
+// GetTaskId returns the value of TaskId.
+func (s *TaskImportReportTasksItem) GetTaskId() NilUUID {
+	return s.TaskId
+}
+
+// GetName returns the value of Name.
+func (s *TaskImportReportTasksItem) GetName() string {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *TaskImportReportTasksItem) GetType() TaskImportReportTasksItemType {
+	return s.Type
+}
+
+// GetUnitId returns the value of UnitId.
+func (s *TaskImportReportTasksItem) GetUnitId() uuid.UUID {
+	return s.UnitId
+}
+
+// GetLines returns the value of Lines.
+func (s *TaskImportReportTasksItem) GetLines() []int {
+	return s.Lines
+}
+
+// GetInstanceIds returns the value of InstanceIds.
+func (s *TaskImportReportTasksItem) GetInstanceIds() []uuid.UUID {
+	return s.InstanceIds
+}
+
+// SetTaskId sets the value of TaskId.
+func (s *TaskImportReportTasksItem) SetTaskId(val NilUUID) {
+	s.TaskId = val
+}
+
+// SetName sets the value of Name.
+func (s *TaskImportReportTasksItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetType sets the value of Type.
+func (s *TaskImportReportTasksItem) SetType(val TaskImportReportTasksItemType) {
+	s.Type = val
+}
+
+// SetUnitId sets the value of UnitId.
+func (s *TaskImportReportTasksItem) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
+
+// SetLines sets the value of Lines.
+func (s *TaskImportReportTasksItem) SetLines(val []int) {
+	s.Lines = val
+}
+
+// SetInstanceIds sets the value of InstanceIds.
+func (s *TaskImportReportTasksItem) SetInstanceIds(val []uuid.UUID) {
+	s.InstanceIds = val
+}
+
+type TaskImportReportTasksItemType string
+
+const (
+	TaskImportReportTasksItemTypePickment TaskImportReportTasksItemType = "pickment"
+	TaskImportReportTasksItemTypeMovement TaskImportReportTasksItemType = "movement"
+)
+
+// AllValues returns all TaskImportReportTasksItemType values.
+func (TaskImportReportTasksItemType) AllValues() []TaskImportReportTasksItemType {
+	return []TaskImportReportTasksItemType{
+		TaskImportReportTasksItemTypePickment,
+		TaskImportReportTasksItemTypeMovement,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskImportReportTasksItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskImportReportTasksItemTypePickment:
+		return []byte(s), nil
+	case TaskImportReportTasksItemTypeMovement:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskImportReportTasksItemType) UnmarshalText(data []byte) error {
+	switch TaskImportReportTasksItemType(data) {
+	case TaskImportReportTasksItemTypePickment:
+		*s = TaskImportReportTasksItemTypePickment
+		return nil
+	case TaskImportReportTasksItemTypeMovement:
+		*s = TaskImportReportTasksItemTypeMovement
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Item of a task, either an instance or a quantity of a stock line.
+// Ref: #/components/schemas/TaskItem
+type TaskItem struct {
+	ID        uuid.UUID       `json:"id"`
+	TaskId    uuid.UUID       `json:"taskId"`
+	Instance  OptInstanceFull `json:"instance"`
+	StockLine OptStockLine    `json:"stockLine"`
+	// Quantity taken off the stock line, 1 for instance items.
+	Quantity   int                        `json:"quantity"`
+	SourceCell NilCellForInstanceOptional `json:"sourceCell"`
+	TargetCell NilCellForInstanceOptional `json:"targetCell"`
+	Status     TaskItemStatus             `json:"status"`
+	// Decision made on inspection, only for return tasks.
+	Disposition NilTaskItemDisposition `json:"disposition"`
+	// Position of the item in the walking route, items are returned in this order.
+	Sequence int `json:"sequence"`
+}
+
+// GetID returns the value of ID.
+func (s *TaskItem) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetTaskId returns the value of TaskId.
+func (s *TaskItem) GetTaskId() uuid.UUID {
+	return s.TaskId
+}
+
+// GetInstance returns the value of Instance.
+func (s *TaskItem) GetInstance() OptInstanceFull {
+	return s.Instance
+}
+
+// GetStockLine returns the value of StockLine.
+func (s *TaskItem) GetStockLine() OptStockLine {
+	return s.StockLine
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *TaskItem) GetQuantity() int {
+	return s.Quantity
+}
+
+// GetSourceCell returns the value of SourceCell.
+func (s *TaskItem) GetSourceCell() NilCellForInstanceOptional {
+	return s.SourceCell
+}
+
+// GetTargetCell returns the value of TargetCell.
+func (s *TaskItem) GetTargetCell() NilCellForInstanceOptional {
+	return s.TargetCell
+}
+
+// GetStatus returns the value of Status.
+func (s *TaskItem) GetStatus() TaskItemStatus {
+	return s.Status
+}
+
+// GetDisposition returns the value of Disposition.
+func (s *TaskItem) GetDisposition() NilTaskItemDisposition {
+	return s.Disposition
+}
+
+// GetSequence returns the value of Sequence.
+func (s *TaskItem) GetSequence() int {
+	return s.Sequence
+}
+
+// SetID sets the value of ID.
+func (s *TaskItem) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetTaskId sets the value of TaskId.
+func (s *TaskItem) SetTaskId(val uuid.UUID) {
+	s.TaskId = val
+}
+
+// SetInstance sets the value of Instance.
+func (s *TaskItem) SetInstance(val OptInstanceFull) {
+	s.Instance = val
+}
+
+// SetStockLine sets the value of StockLine.
+func (s *TaskItem) SetStockLine(val OptStockLine) {
+	s.StockLine = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *TaskItem) SetQuantity(val int) {
+	s.Quantity = val
+}
+
+// SetSourceCell sets the value of SourceCell.
+func (s *TaskItem) SetSourceCell(val NilCellForInstanceOptional) {
+	s.SourceCell = val
+}
+
+// SetTargetCell sets the value of TargetCell.
+func (s *TaskItem) SetTargetCell(val NilCellForInstanceOptional) {
+	s.TargetCell = val
+}
+
+// SetStatus sets the value of Status.
+func (s *TaskItem) SetStatus(val TaskItemStatus) {
+	s.Status = val
+}
+
+// SetDisposition sets the value of Disposition.
+func (s *TaskItem) SetDisposition(val NilTaskItemDisposition) {
+	s.Disposition = val
+}
+
+// SetSequence sets the value of Sequence.
+func (s *TaskItem) SetSequence(val int) {
+	s.Sequence = val
+}
+
+// Decision made on inspection, only for return tasks.
+type TaskItemDisposition string
+
+const (
+	TaskItemDispositionRestock    TaskItemDisposition = "restock"
+	TaskItemDispositionQuarantine TaskItemDisposition = "quarantine"
+	TaskItemDispositionDispose    TaskItemDisposition = "dispose"
+)
+
+// AllValues returns all TaskItemDisposition values.
+func (TaskItemDisposition) AllValues() []TaskItemDisposition {
+	return []TaskItemDisposition{
+		TaskItemDispositionRestock,
+		TaskItemDispositionQuarantine,
+		TaskItemDispositionDispose,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskItemDisposition) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskItemDispositionRestock:
+		return []byte(s), nil
+	case TaskItemDispositionQuarantine:
+		return []byte(s), nil
+	case TaskItemDispositionDispose:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskItemDisposition) UnmarshalText(data []byte) error {
+	switch TaskItemDisposition(data) {
+	case TaskItemDispositionRestock:
+		*s = TaskItemDispositionRestock
+		return nil
+	case TaskItemDispositionQuarantine:
+		*s = TaskItemDispositionQuarantine
+		return nil
+	case TaskItemDispositionDispose:
+		*s = TaskItemDispositionDispose
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TaskItemException
+type TaskItemException struct {
+	ID       uuid.UUID               `json:"id"`
+	TaskId   uuid.UUID               `json:"taskId"`
+	Instance InstanceFull            `json:"instance"`
+	Reason   TaskItemExceptionReason `json:"reason"`
+	Note     NilString               `json:"note"`
+	// Instance added to the task instead of the short one.
+	SubstituteInstanceId NilUUID             `json:"substituteInstanceId"`
+	ReportedBy           NilEmployeeOptional `json:"reportedBy"`
+	CreatedAt            time.Time           `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *TaskItemException) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetTaskId returns the value of TaskId.
+func (s *TaskItemException) GetTaskId() uuid.UUID {
+	return s.TaskId
+}
+
+// GetInstance returns the value of Instance.
+func (s *TaskItemException) GetInstance() InstanceFull {
+	return s.Instance
+}
+
+// GetReason returns the value of Reason.
+func (s *TaskItemException) GetReason() TaskItemExceptionReason {
+	return s.Reason
+}
+
+// GetNote returns the value of Note.
+func (s *TaskItemException) GetNote() NilString {
+	return s.Note
+}
+
+// GetSubstituteInstanceId returns the value of SubstituteInstanceId.
+func (s *TaskItemException) GetSubstituteInstanceId() NilUUID {
+	return s.SubstituteInstanceId
+}
+
+// GetReportedBy returns the value of ReportedBy.
+func (s *TaskItemException) GetReportedBy() NilEmployeeOptional {
+	return s.ReportedBy
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TaskItemException) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *TaskItemException) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetTaskId sets the value of TaskId.
+func (s *TaskItemException) SetTaskId(val uuid.UUID) {
+	s.TaskId = val
+}
+
+// SetInstance sets the value of Instance.
+func (s *TaskItemException) SetInstance(val InstanceFull) {
+	s.Instance = val
+}
+
+// SetReason sets the value of Reason.
+func (s *TaskItemException) SetReason(val TaskItemExceptionReason) {
+	s.Reason = val
+}
+
+// SetNote sets the value of Note.
+func (s *TaskItemException) SetNote(val NilString) {
+	s.Note = val
+}
+
+// SetSubstituteInstanceId sets the value of SubstituteInstanceId.
+func (s *TaskItemException) SetSubstituteInstanceId(val NilUUID) {
+	s.SubstituteInstanceId = val
+}
+
+// SetReportedBy sets the value of ReportedBy.
+func (s *TaskItemException) SetReportedBy(val NilEmployeeOptional) {
+	s.ReportedBy = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TaskItemException) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type TaskItemExceptionReason string
+
+const (
+	TaskItemExceptionReasonNotFound  TaskItemExceptionReason = "not_found"
+	TaskItemExceptionReasonDamaged   TaskItemExceptionReason = "damaged"
+	TaskItemExceptionReasonWrongItem TaskItemExceptionReason = "wrong_item"
+)
+
+// AllValues returns all TaskItemExceptionReason values.
+func (TaskItemExceptionReason) AllValues() []TaskItemExceptionReason {
+	return []TaskItemExceptionReason{
+		TaskItemExceptionReasonNotFound,
+		TaskItemExceptionReasonDamaged,
+		TaskItemExceptionReasonWrongItem,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskItemExceptionReason) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskItemExceptionReasonNotFound:
+		return []byte(s), nil
+	case TaskItemExceptionReasonDamaged:
+		return []byte(s), nil
+	case TaskItemExceptionReasonWrongItem:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskItemExceptionReason) UnmarshalText(data []byte) error {
+	switch TaskItemExceptionReason(data) {
+	case TaskItemExceptionReasonNotFound:
+		*s = TaskItemExceptionReasonNotFound
+		return nil
+	case TaskItemExceptionReasonDamaged:
+		*s = TaskItemExceptionReasonDamaged
+		return nil
+	case TaskItemExceptionReasonWrongItem:
+		*s = TaskItemExceptionReasonWrongItem
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type TaskItemStatus string
+
+const (
+	TaskItemStatusPending  TaskItemStatus = "pending"
+	TaskItemStatusPicked   TaskItemStatus = "picked"
+	TaskItemStatusDone     TaskItemStatus = "done"
+	TaskItemStatusReturned TaskItemStatus = "returned"
+	TaskItemStatusCanceled TaskItemStatus = "canceled"
+	TaskItemStatusShort    TaskItemStatus = "short"
+)
+
+// AllValues returns all TaskItemStatus values.
+func (TaskItemStatus) AllValues() []TaskItemStatus {
+	return []TaskItemStatus{
+		TaskItemStatusPending,
+		TaskItemStatusPicked,
+		TaskItemStatusDone,
+		TaskItemStatusReturned,
+		TaskItemStatusCanceled,
+		TaskItemStatusShort,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskItemStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskItemStatusPending:
+		return []byte(s), nil
+	case TaskItemStatusPicked:
+		return []byte(s), nil
+	case TaskItemStatusDone:
+		return []byte(s), nil
+	case TaskItemStatusReturned:
+		return []byte(s), nil
+	case TaskItemStatusCanceled:
+		return []byte(s), nil
+	case TaskItemStatusShort:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskItemStatus) UnmarshalText(data []byte) error {
+	switch TaskItemStatus(data) {
+	case TaskItemStatusPending:
+		*s = TaskItemStatusPending
+		return nil
+	case TaskItemStatusPicked:
+		*s = TaskItemStatusPicked
+		return nil
+	case TaskItemStatusDone:
+		*s = TaskItemStatusDone
+		return nil
+	case TaskItemStatusReturned:
+		*s = TaskItemStatusReturned
+		return nil
+	case TaskItemStatusCanceled:
+		*s = TaskItemStatusCanceled
+		return nil
+	case TaskItemStatusShort:
+		*s = TaskItemStatusShort
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// One-time code a customer or courier presents to collect a ready pickment task.
+// Ref: #/components/schemas/TaskPickupCode
+type TaskPickupCode struct {
+	TaskId uuid.UUID `json:"taskId"`
+	Code   string    `json:"code"`
+	// Content to encode into a QR code, accepted in place of the digits.
+	QrPayload      string `json:"qrPayload"`
+	FailedAttempts int    `json:"failedAttempts"`
+	// Failed attempts left before the code is locked.
+	AttemptsLeft int         `json:"attemptsLeft"`
+	CreatedAt    time.Time   `json:"createdAt"`
+	LockedAt     NilDateTime `json:"lockedAt"`
+	UsedAt       NilDateTime `json:"usedAt"`
+}
+
+// GetTaskId returns the value of TaskId.
+func (s *TaskPickupCode) GetTaskId() uuid.UUID {
+	return s.TaskId
+}
+
+// GetCode returns the value of Code.
+func (s *TaskPickupCode) GetCode() string {
+	return s.Code
+}
+
+// GetQrPayload returns the value of QrPayload.
+func (s *TaskPickupCode) GetQrPayload() string {
+	return s.QrPayload
+}
+
+// GetFailedAttempts returns the value of FailedAttempts.
+func (s *TaskPickupCode) GetFailedAttempts() int {
+	return s.FailedAttempts
+}
+
+// GetAttemptsLeft returns the value of AttemptsLeft.
+func (s *TaskPickupCode) GetAttemptsLeft() int {
+	return s.AttemptsLeft
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TaskPickupCode) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLockedAt returns the value of LockedAt.
+func (s *TaskPickupCode) GetLockedAt() NilDateTime {
+	return s.LockedAt
+}
+
+// GetUsedAt returns the value of UsedAt.
+func (s *TaskPickupCode) GetUsedAt() NilDateTime {
+	return s.UsedAt
+}
+
+// SetTaskId sets the value of TaskId.
+func (s *TaskPickupCode) SetTaskId(val uuid.UUID) {
+	s.TaskId = val
+}
+
+// SetCode sets the value of Code.
+func (s *TaskPickupCode) SetCode(val string) {
+	s.Code = val
+}
+
+// SetQrPayload sets the value of QrPayload.
+func (s *TaskPickupCode) SetQrPayload(val string) {
+	s.QrPayload = val
+}
+
+// SetFailedAttempts sets the value of FailedAttempts.
+func (s *TaskPickupCode) SetFailedAttempts(val int) {
+	s.FailedAttempts = val
+}
+
+// SetAttemptsLeft sets the value of AttemptsLeft.
+func (s *TaskPickupCode) SetAttemptsLeft(val int) {
+	s.AttemptsLeft = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TaskPickupCode) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLockedAt sets the value of LockedAt.
+func (s *TaskPickupCode) SetLockedAt(val NilDateTime) {
+	s.LockedAt = val
+}
+
+// SetUsedAt sets the value of UsedAt.
+func (s *TaskPickupCode) SetUsedAt(val NilDateTime) {
+	s.UsedAt = val
+}
+
+// Ref: #/components/schemas/TaskStatusChange
+type TaskStatusChange struct {
+	ID uuid.UUID `json:"id"`
+	// Empty for the task creation.
+	FromStatus NilTaskStatusChangeFromStatus `json:"fromStatus"`
+	ToStatus   TaskStatusChangeToStatus      `json:"toStatus"`
+	ChangedAt  time.Time                     `json:"changedAt"`
+	ChangedBy  NilEmployeeOptional           `json:"changedBy"`
+	Comment    NilString                     `json:"comment"`
+	// Time spent in toStatus, empty while the task is still in it.
+	DurationSeconds NilInt `json:"durationSeconds"`
+}
+
+// GetID returns the value of ID.
+func (s *TaskStatusChange) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetFromStatus returns the value of FromStatus.
+func (s *TaskStatusChange) GetFromStatus() NilTaskStatusChangeFromStatus {
+	return s.FromStatus
+}
+
+// GetToStatus returns the value of ToStatus.
+func (s *TaskStatusChange) GetToStatus() TaskStatusChangeToStatus {
+	return s.ToStatus
+}
+
+// GetChangedAt returns the value of ChangedAt.
+func (s *TaskStatusChange) GetChangedAt() time.Time {
+	return s.ChangedAt
+}
+
+// GetChangedBy returns the value of ChangedBy.
+func (s *TaskStatusChange) GetChangedBy() NilEmployeeOptional {
+	return s.ChangedBy
+}
+
+// GetComment returns the value of Comment.
+func (s *TaskStatusChange) GetComment() NilString {
+	return s.Comment
+}
+
+// GetDurationSeconds returns the value of DurationSeconds.
+func (s *TaskStatusChange) GetDurationSeconds() NilInt {
+	return s.DurationSeconds
+}
+
+// SetID sets the value of ID.
+func (s *TaskStatusChange) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetFromStatus sets the value of FromStatus.
+func (s *TaskStatusChange) SetFromStatus(val NilTaskStatusChangeFromStatus) {
+	s.FromStatus = val
+}
+
+// SetToStatus sets the value of ToStatus.
+func (s *TaskStatusChange) SetToStatus(val TaskStatusChangeToStatus) {
+	s.ToStatus = val
+}
+
+// SetChangedAt sets the value of ChangedAt.
+func (s *TaskStatusChange) SetChangedAt(val time.Time) {
+	s.ChangedAt = val
+}
+
+// SetChangedBy sets the value of ChangedBy.
+func (s *TaskStatusChange) SetChangedBy(val NilEmployeeOptional) {
+	s.ChangedBy = val
+}
+
+// SetComment sets the value of Comment.
+func (s *TaskStatusChange) SetComment(val NilString) {
+	s.Comment = val
+}
+
+// SetDurationSeconds sets the value of DurationSeconds.
+func (s *TaskStatusChange) SetDurationSeconds(val NilInt) {
+	s.DurationSeconds = val
+}
+
+// Empty for the task creation.
+type TaskStatusChangeFromStatus string
+
+const (
+	TaskStatusChangeFromStatusPending    TaskStatusChangeFromStatus = "pending"
+	TaskStatusChangeFromStatusInProgress TaskStatusChangeFromStatus = "in_progress"
+	TaskStatusChangeFromStatusReady      TaskStatusChangeFromStatus = "ready"
+	TaskStatusChangeFromStatusCompleted  TaskStatusChangeFromStatus = "completed"
+	TaskStatusChangeFromStatusCancelled  TaskStatusChangeFromStatus = "cancelled"
+)
+
+// AllValues returns all TaskStatusChangeFromStatus values.
+func (TaskStatusChangeFromStatus) AllValues() []TaskStatusChangeFromStatus {
+	return []TaskStatusChangeFromStatus{
+		TaskStatusChangeFromStatusPending,
+		TaskStatusChangeFromStatusInProgress,
+		TaskStatusChangeFromStatusReady,
+		TaskStatusChangeFromStatusCompleted,
+		TaskStatusChangeFromStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskStatusChangeFromStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskStatusChangeFromStatusPending:
+		return []byte(s), nil
+	case TaskStatusChangeFromStatusInProgress:
+		return []byte(s), nil
+	case TaskStatusChangeFromStatusReady:
+		return []byte(s), nil
+	case TaskStatusChangeFromStatusCompleted:
+		return []byte(s), nil
+	case TaskStatusChangeFromStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskStatusChangeFromStatus) UnmarshalText(data []byte) error {
+	switch TaskStatusChangeFromStatus(data) {
+	case TaskStatusChangeFromStatusPending:
+		*s = TaskStatusChangeFromStatusPending
+		return nil
+	case TaskStatusChangeFromStatusInProgress:
+		*s = TaskStatusChangeFromStatusInProgress
+		return nil
+	case TaskStatusChangeFromStatusReady:
+		*s = TaskStatusChangeFromStatusReady
+		return nil
+	case TaskStatusChangeFromStatusCompleted:
+		*s = TaskStatusChangeFromStatusCompleted
+		return nil
+	case TaskStatusChangeFromStatusCancelled:
+		*s = TaskStatusChangeFromStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TaskStatusChangeRequest
+type TaskStatusChangeRequest struct {
+	// Saved in the task status history.
+	Comment OptNilString `json:"comment"`
+}
+
+// GetComment returns the value of Comment.
+func (s *TaskStatusChangeRequest) GetComment() OptNilString {
+	return s.Comment
+}
+
+// SetComment sets the value of Comment.
+func (s *TaskStatusChangeRequest) SetComment(val OptNilString) {
+	s.Comment = val
+}
+
+type TaskStatusChangeToStatus string
+
+const (
+	TaskStatusChangeToStatusPending    TaskStatusChangeToStatus = "pending"
+	TaskStatusChangeToStatusInProgress TaskStatusChangeToStatus = "in_progress"
+	TaskStatusChangeToStatusReady      TaskStatusChangeToStatus = "ready"
+	TaskStatusChangeToStatusCompleted  TaskStatusChangeToStatus = "completed"
+	TaskStatusChangeToStatusCancelled  TaskStatusChangeToStatus = "cancelled"
+)
+
+// AllValues returns all TaskStatusChangeToStatus values.
+func (TaskStatusChangeToStatus) AllValues() []TaskStatusChangeToStatus {
+	return []TaskStatusChangeToStatus{
+		TaskStatusChangeToStatusPending,
+		TaskStatusChangeToStatusInProgress,
+		TaskStatusChangeToStatusReady,
+		TaskStatusChangeToStatusCompleted,
+		TaskStatusChangeToStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskStatusChangeToStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskStatusChangeToStatusPending:
+		return []byte(s), nil
+	case TaskStatusChangeToStatusInProgress:
+		return []byte(s), nil
+	case TaskStatusChangeToStatusReady:
+		return []byte(s), nil
+	case TaskStatusChangeToStatusCompleted:
+		return []byte(s), nil
+	case TaskStatusChangeToStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskStatusChangeToStatus) UnmarshalText(data []byte) error {
+	switch TaskStatusChangeToStatus(data) {
+	case TaskStatusChangeToStatusPending:
+		*s = TaskStatusChangeToStatusPending
+		return nil
+	case TaskStatusChangeToStatusInProgress:
+		*s = TaskStatusChangeToStatusInProgress
+		return nil
+	case TaskStatusChangeToStatusReady:
+		*s = TaskStatusChangeToStatusReady
+		return nil
+	case TaskStatusChangeToStatusCompleted:
+		*s = TaskStatusChangeToStatusCompleted
+		return nil
+	case TaskStatusChangeToStatusCancelled:
+		*s = TaskStatusChangeToStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TaskStockLineRequest
+type TaskStockLineRequest struct {
+	// Line of the task item, the reserved line once it is picked.
+	StockLineId uuid.UUID `json:"stockLineId"`
+}
+
+// GetStockLineId returns the value of StockLineId.
+func (s *TaskStockLineRequest) GetStockLineId() uuid.UUID {
+	return s.StockLineId
+}
+
+// SetStockLineId sets the value of StockLineId.
+func (s *TaskStockLineRequest) SetStockLineId(val uuid.UUID) {
+	s.StockLineId = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/TaskTemplate
+type TaskTemplate struct {
+	UnitId      uuid.UUID            `json:"unitId"`
+	Name        string               `json:"name"`
+	Description NilString            `json:"description"`
+	Type        TaskTemplateType     `json:"type"`
+	Priority    TaskTemplatePriority `json:"priority"`
+	// Assignee of created tasks.
+	AssignedToUserId NilUUID `json:"assignedToUserId"`
+	// Due date of created tasks relative to their creation.
+	DueInMinutes NilInt `json:"dueInMinutes"`
+	// Five field cron expression (minute, hour, day of month, month, day of week) evaluated in UTC.
+	// Tasks are created only manually when omitted.
+	Schedule NilString `json:"schedule"`
+	// Inactive templates are skipped by the scheduler.
+	IsActive  bool               `json:"isActive"`
+	Rules     []TaskTemplateRule `json:"rules"`
+	ID        uuid.UUID          `json:"id"`
+	NextRunAt NilDateTime        `json:"nextRunAt"`
+	LastRunAt NilDateTime        `json:"lastRunAt"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
+
+// GetUnitId returns the value of UnitId.
+func (s *TaskTemplate) GetUnitId() uuid.UUID {
+	return s.UnitId
+}
+
+// GetName returns the value of Name.
+func (s *TaskTemplate) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *TaskTemplate) GetDescription() NilString {
+	return s.Description
+}
+
+// GetType returns the value of Type.
+func (s *TaskTemplate) GetType() TaskTemplateType {
+	return s.Type
+}
+
+// GetPriority returns the value of Priority.
+func (s *TaskTemplate) GetPriority() TaskTemplatePriority {
+	return s.Priority
+}
+
+// GetAssignedToUserId returns the value of AssignedToUserId.
+func (s *TaskTemplate) GetAssignedToUserId() NilUUID {
+	return s.AssignedToUserId
+}
+
+// GetDueInMinutes returns the value of DueInMinutes.
+func (s *TaskTemplate) GetDueInMinutes() NilInt {
+	return s.DueInMinutes
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *TaskTemplate) GetSchedule() NilString {
+	return s.Schedule
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *TaskTemplate) GetIsActive() bool {
+	return s.IsActive
+}
+
+// GetRules returns the value of Rules.
+func (s *TaskTemplate) GetRules() []TaskTemplateRule {
+	return s.Rules
+}
+
+// GetID returns the value of ID.
+func (s *TaskTemplate) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetNextRunAt returns the value of NextRunAt.
+func (s *TaskTemplate) GetNextRunAt() NilDateTime {
+	return s.NextRunAt
+}
+
+// GetLastRunAt returns the value of LastRunAt.
+func (s *TaskTemplate) GetLastRunAt() NilDateTime {
+	return s.LastRunAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TaskTemplate) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetUnitId sets the value of UnitId.
+func (s *TaskTemplate) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
+
+// SetName sets the value of Name.
+func (s *TaskTemplate) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *TaskTemplate) SetDescription(val NilString) {
+	s.Description = val
+}
+
+// SetType sets the value of Type.
+func (s *TaskTemplate) SetType(val TaskTemplateType) {
+	s.Type = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *TaskTemplate) SetPriority(val TaskTemplatePriority) {
+	s.Priority = val
+}
+
+// SetAssignedToUserId sets the value of AssignedToUserId.
+func (s *TaskTemplate) SetAssignedToUserId(val NilUUID) {
+	s.AssignedToUserId = val
+}
+
+// SetDueInMinutes sets the value of DueInMinutes.
+func (s *TaskTemplate) SetDueInMinutes(val NilInt) {
+	s.DueInMinutes = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *TaskTemplate) SetSchedule(val NilString) {
+	s.Schedule = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *TaskTemplate) SetIsActive(val bool) {
+	s.IsActive = val
+}
+
+// SetRules sets the value of Rules.
+func (s *TaskTemplate) SetRules(val []TaskTemplateRule) {
+	s.Rules = val
+}
+
+// SetID sets the value of ID.
+func (s *TaskTemplate) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetNextRunAt sets the value of NextRunAt.
+func (s *TaskTemplate) SetNextRunAt(val NilDateTime) {
+	s.NextRunAt = val
+}
+
+// SetLastRunAt sets the value of LastRunAt.
+func (s *TaskTemplate) SetLastRunAt(val NilDateTime) {
+	s.LastRunAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TaskTemplate) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type TaskTemplatePriority string
+
+const (
+	TaskTemplatePriorityLow    TaskTemplatePriority = "low"
+	TaskTemplatePriorityNormal TaskTemplatePriority = "normal"
+	TaskTemplatePriorityHigh   TaskTemplatePriority = "high"
+	TaskTemplatePriorityUrgent TaskTemplatePriority = "urgent"
+)
+
+// AllValues returns all TaskTemplatePriority values.
+func (TaskTemplatePriority) AllValues() []TaskTemplatePriority {
+	return []TaskTemplatePriority{
+		TaskTemplatePriorityLow,
+		TaskTemplatePriorityNormal,
+		TaskTemplatePriorityHigh,
+		TaskTemplatePriorityUrgent,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskTemplatePriority) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskTemplatePriorityLow:
+		return []byte(s), nil
+	case TaskTemplatePriorityNormal:
+		return []byte(s), nil
+	case TaskTemplatePriorityHigh:
+		return []byte(s), nil
+	case TaskTemplatePriorityUrgent:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskTemplatePriority) UnmarshalText(data []byte) error {
+	switch TaskTemplatePriority(data) {
+	case TaskTemplatePriorityLow:
+		*s = TaskTemplatePriorityLow
+		return nil
+	case TaskTemplatePriorityNormal:
+		*s = TaskTemplatePriorityNormal
+		return nil
+	case TaskTemplatePriorityHigh:
+		*s = TaskTemplatePriorityHigh
+		return nil
+	case TaskTemplatePriorityUrgent:
+		*s = TaskTemplatePriorityUrgent
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Selects available instances of the template unit. At least one of itemId, variantId and
+// sourceCellsGroupId is required.
+// Ref: #/components/schemas/TaskTemplateRule
+type TaskTemplateRule struct {
+	ItemId    OptUUID `json:"itemId"`
+	VariantId OptUUID `json:"variantId"`
+	// Take only instances stored in the cells group.
+	SourceCellsGroupId OptUUID `json:"sourceCellsGroupId"`
+	// Cell the instances are moved to. Required for movement templates.
+	TargetCellId OptUUID `json:"targetCellId"`
+	// Maximum number of instances taken by the rule. All matching instances are taken when omitted.
+	MaxInstances OptInt `json:"maxInstances"`
+}
+
+// GetItemId returns the value of ItemId.
+func (s *TaskTemplateRule) GetItemId() OptUUID {
+	return s.ItemId
+}
+
+// GetVariantId returns the value of VariantId.
+func (s *TaskTemplateRule) GetVariantId() OptUUID {
+	return s.VariantId
+}
+
+// GetSourceCellsGroupId returns the value of SourceCellsGroupId.
+func (s *TaskTemplateRule) GetSourceCellsGroupId() OptUUID {
+	return s.SourceCellsGroupId
+}
+
+// GetTargetCellId returns the value of TargetCellId.
+func (s *TaskTemplateRule) GetTargetCellId() OptUUID {
+	return s.TargetCellId
+}
+
+// GetMaxInstances returns the value of MaxInstances.
+func (s *TaskTemplateRule) GetMaxInstances() OptInt {
+	return s.MaxInstances
+}
+
+// SetItemId sets the value of ItemId.
+func (s *TaskTemplateRule) SetItemId(val OptUUID) {
+	s.ItemId = val
+}
+
+// SetVariantId sets the value of VariantId.
+func (s *TaskTemplateRule) SetVariantId(val OptUUID) {
+	s.VariantId = val
+}
+
+// SetSourceCellsGroupId sets the value of SourceCellsGroupId.
+func (s *TaskTemplateRule) SetSourceCellsGroupId(val OptUUID) {
+	s.SourceCellsGroupId = val
+}
+
+// SetTargetCellId sets the value of TargetCellId.
+func (s *TaskTemplateRule) SetTargetCellId(val OptUUID) {
+	s.TargetCellId = val
+}
+
+// SetMaxInstances sets the value of MaxInstances.
+func (s *TaskTemplateRule) SetMaxInstances(val OptInt) {
+	s.MaxInstances = val
+}
+
+type TaskTemplateType string
+
+const (
+	TaskTemplateTypePickment TaskTemplateType = "pickment"
+	TaskTemplateTypeMovement TaskTemplateType = "movement"
+)
+
+// AllValues returns all TaskTemplateType values.
+func (TaskTemplateType) AllValues() []TaskTemplateType {
+	return []TaskTemplateType{
+		TaskTemplateTypePickment,
+		TaskTemplateTypeMovement,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TaskTemplateType) MarshalText() ([]byte, error) {
+	switch s {
+	case TaskTemplateTypePickment:
+		return []byte(s), nil
+	case TaskTemplateTypeMovement:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TaskTemplateType) UnmarshalText(data []byte) error {
+	switch TaskTemplateType(data) {
+	case TaskTemplateTypePickment:
+		*s = TaskTemplateTypePickment
+		return nil
+	case TaskTemplateTypeMovement:
+		*s = TaskTemplateTypeMovement
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Token
+type Token struct {
+	ID uuid.UUID `json:"id"`
+	// The name of the token.
+	Name string `json:"name"`
+	// The token secret.
+	Token string `json:"token"`
+}
+
+// GetID returns the value of ID.
+func (s *Token) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Token) GetName() string {
+	return s.Name
+}
+
+// GetToken returns the value of Token.
+func (s *Token) GetToken() string {
+	return s.Token
+}
+
+// SetID sets the value of ID.
+func (s *Token) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Token) SetName(val string) {
+	s.Name = val
+}
+
+// SetToken sets the value of Token.
+func (s *Token) SetToken(val string) {
+	s.Token = val
+}
+
+// Ref: #/components/schemas/TransferOrder
+type TransferOrder struct {
+	ID                uuid.UUID           `json:"id"`
+	Name              string              `json:"name"`
+	Status            TransferOrderStatus `json:"status"`
+	SourceUnitId      uuid.UUID           `json:"sourceUnitId"`
+	DestinationUnitId uuid.UUID           `json:"destinationUnitId"`
+	ReceivingCellId   uuid.UUID           `json:"receivingCellId"`
+	// Pickment task of the source unit picking the instances.
+	OutboundTaskId NilUUID `json:"outboundTaskId"`
+	// Receiving task of the destination unit, created once the instances are dispatched.
+	InboundTaskId NilUUID     `json:"inboundTaskId"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	DispatchedAt  NilDateTime `json:"dispatchedAt"`
+	ReceivedAt    NilDateTime `json:"receivedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *TransferOrder) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TransferOrder) GetName() string {
+	return s.Name
+}
+
+// GetStatus returns the value of Status.
+func (s *TransferOrder) GetStatus() TransferOrderStatus {
+	return s.Status
+}
+
+// GetSourceUnitId returns the value of SourceUnitId.
+func (s *TransferOrder) GetSourceUnitId() uuid.UUID {
+	return s.SourceUnitId
+}
+
+// GetDestinationUnitId returns the value of DestinationUnitId.
+func (s *TransferOrder) GetDestinationUnitId() uuid.UUID {
+	return s.DestinationUnitId
+}
+
+// GetReceivingCellId returns the value of ReceivingCellId.
+func (s *TransferOrder) GetReceivingCellId() uuid.UUID {
+	return s.ReceivingCellId
+}
+
+// GetOutboundTaskId returns the value of OutboundTaskId.
+func (s *TransferOrder) GetOutboundTaskId() NilUUID {
+	return s.OutboundTaskId
+}
+
+// GetInboundTaskId returns the value of InboundTaskId.
+func (s *TransferOrder) GetInboundTaskId() NilUUID {
+	return s.InboundTaskId
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TransferOrder) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetDispatchedAt returns the value of DispatchedAt.
+func (s *TransferOrder) GetDispatchedAt() NilDateTime {
+	return s.DispatchedAt
+}
+
+// GetReceivedAt returns the value of ReceivedAt.
+func (s *TransferOrder) GetReceivedAt() NilDateTime {
+	return s.ReceivedAt
+}
+
+// SetID sets the value of ID.
+func (s *TransferOrder) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TransferOrder) SetName(val string) {
+	s.Name = val
+}
+
+// SetStatus sets the value of Status.
+func (s *TransferOrder) SetStatus(val TransferOrderStatus) {
+	s.Status = val
+}
+
+// SetSourceUnitId sets the value of SourceUnitId.
+func (s *TransferOrder) SetSourceUnitId(val uuid.UUID) {
+	s.SourceUnitId = val
+}
+
+// SetDestinationUnitId sets the value of DestinationUnitId.
+func (s *TransferOrder) SetDestinationUnitId(val uuid.UUID) {
+	s.DestinationUnitId = val
+}
+
+// SetReceivingCellId sets the value of ReceivingCellId.
+func (s *TransferOrder) SetReceivingCellId(val uuid.UUID) {
+	s.ReceivingCellId = val
+}
+
+// SetOutboundTaskId sets the value of OutboundTaskId.
+func (s *TransferOrder) SetOutboundTaskId(val NilUUID) {
+	s.OutboundTaskId = val
+}
+
+// SetInboundTaskId sets the value of InboundTaskId.
+func (s *TransferOrder) SetInboundTaskId(val NilUUID) {
+	s.InboundTaskId = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TransferOrder) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetDispatchedAt sets the value of DispatchedAt.
+func (s *TransferOrder) SetDispatchedAt(val NilDateTime) {
+	s.DispatchedAt = val
+}
+
+// SetReceivedAt sets the value of ReceivedAt.
+func (s *TransferOrder) SetReceivedAt(val NilDateTime) {
+	s.ReceivedAt = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/TransferOrderFull
+type TransferOrderFull struct {
+	ID                uuid.UUID               `json:"id"`
+	Name              string                  `json:"name"`
+	Status            TransferOrderFullStatus `json:"status"`
+	SourceUnitId      uuid.UUID               `json:"sourceUnitId"`
+	DestinationUnitId uuid.UUID               `json:"destinationUnitId"`
+	ReceivingCellId   uuid.UUID               `json:"receivingCellId"`
+	// Pickment task of the source unit picking the instances.
+	OutboundTaskId NilUUID `json:"outboundTaskId"`
+	// Receiving task of the destination unit, created once the instances are dispatched.
+	InboundTaskId NilUUID     `json:"inboundTaskId"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	DispatchedAt  NilDateTime `json:"dispatchedAt"`
+	ReceivedAt    NilDateTime `json:"receivedAt"`
+	// Instances of the outbound leg, except canceled and short items.
+	Instances []InstanceFull `json:"instances"`
+}
+
+// GetID returns the value of ID.
+func (s *TransferOrderFull) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TransferOrderFull) GetName() string {
+	return s.Name
+}
+
+// GetStatus returns the value of Status.
+func (s *TransferOrderFull) GetStatus() TransferOrderFullStatus {
+	return s.Status
+}
+
+// GetSourceUnitId returns the value of SourceUnitId.
+func (s *TransferOrderFull) GetSourceUnitId() uuid.UUID {
+	return s.SourceUnitId
+}
+
+// GetDestinationUnitId returns the value of DestinationUnitId.
+func (s *TransferOrderFull) GetDestinationUnitId() uuid.UUID {
+	return s.DestinationUnitId
+}
+
+// GetReceivingCellId returns the value of ReceivingCellId.
+func (s *TransferOrderFull) GetReceivingCellId() uuid.UUID {
+	return s.ReceivingCellId
+}
+
+// GetOutboundTaskId returns the value of OutboundTaskId.
+func (s *TransferOrderFull) GetOutboundTaskId() NilUUID {
+	return s.OutboundTaskId
+}
+
+// GetInboundTaskId returns the value of InboundTaskId.
+func (s *TransferOrderFull) GetInboundTaskId() NilUUID {
+	return s.InboundTaskId
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TransferOrderFull) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetDispatchedAt returns the value of DispatchedAt.
+func (s *TransferOrderFull) GetDispatchedAt() NilDateTime {
+	return s.DispatchedAt
+}
+
+// GetReceivedAt returns the value of ReceivedAt.
+func (s *TransferOrderFull) GetReceivedAt() NilDateTime {
+	return s.ReceivedAt
+}
+
+// GetInstances returns the value of Instances.
+func (s *TransferOrderFull) GetInstances() []InstanceFull {
+	return s.Instances
+}
+
+// SetID sets the value of ID.
+func (s *TransferOrderFull) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TransferOrderFull) SetName(val string) {
+	s.Name = val
+}
 
 // SetStatus sets the value of Status.
-func (s *TaskFull) SetStatus(val TaskFullStatus) {
+func (s *TransferOrderFull) SetStatus(val TransferOrderFullStatus) {
 	s.Status = val
 }
 
+// SetSourceUnitId sets the value of SourceUnitId.
+func (s *TransferOrderFull) SetSourceUnitId(val uuid.UUID) {
+	s.SourceUnitId = val
+}
+
+// SetDestinationUnitId sets the value of DestinationUnitId.
+func (s *TransferOrderFull) SetDestinationUnitId(val uuid.UUID) {
+	s.DestinationUnitId = val
+}
+
+// SetReceivingCellId sets the value of ReceivingCellId.
+func (s *TransferOrderFull) SetReceivingCellId(val uuid.UUID) {
+	s.ReceivingCellId = val
+}
+
+// SetOutboundTaskId sets the value of OutboundTaskId.
+func (s *TransferOrderFull) SetOutboundTaskId(val NilUUID) {
+	s.OutboundTaskId = val
+}
+
+// SetInboundTaskId sets the value of InboundTaskId.
+func (s *TransferOrderFull) SetInboundTaskId(val NilUUID) {
+	s.InboundTaskId = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
-func (s *TaskFull) SetCreatedAt(val time.Time) {
+func (s *TransferOrderFull) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
+// SetDispatchedAt sets the value of DispatchedAt.
+func (s *TransferOrderFull) SetDispatchedAt(val NilDateTime) {
+	s.DispatchedAt = val
+}
+
+// SetReceivedAt sets the value of ReceivedAt.
+func (s *TransferOrderFull) SetReceivedAt(val NilDateTime) {
+	s.ReceivedAt = val
+}
+
+// SetInstances sets the value of Instances.
+func (s *TransferOrderFull) SetInstances(val []InstanceFull) {
+	s.Instances = val
+}
+
+type TransferOrderFullStatus string
+
+const (
+	TransferOrderFullStatusPending   TransferOrderFullStatus = "pending"
+	TransferOrderFullStatusInTransit TransferOrderFullStatus = "in_transit"
+	TransferOrderFullStatusReceived  TransferOrderFullStatus = "received"
+	TransferOrderFullStatusCancelled TransferOrderFullStatus = "cancelled"
+)
+
+// AllValues returns all TransferOrderFullStatus values.
+func (TransferOrderFullStatus) AllValues() []TransferOrderFullStatus {
+	return []TransferOrderFullStatus{
+		TransferOrderFullStatusPending,
+		TransferOrderFullStatusInTransit,
+		TransferOrderFullStatusReceived,
+		TransferOrderFullStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TransferOrderFullStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case TransferOrderFullStatusPending:
+		return []byte(s), nil
+	case TransferOrderFullStatusInTransit:
+		return []byte(s), nil
+	case TransferOrderFullStatusReceived:
+		return []byte(s), nil
+	case TransferOrderFullStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TransferOrderFullStatus) UnmarshalText(data []byte) error {
+	switch TransferOrderFullStatus(data) {
+	case TransferOrderFullStatusPending:
+		*s = TransferOrderFullStatusPending
+		return nil
+	case TransferOrderFullStatusInTransit:
+		*s = TransferOrderFullStatusInTransit
+		return nil
+	case TransferOrderFullStatusReceived:
+		*s = TransferOrderFullStatusReceived
+		return nil
+	case TransferOrderFullStatusCancelled:
+		*s = TransferOrderFullStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type TransferOrderStatus string
+
+const (
+	TransferOrderStatusPending   TransferOrderStatus = "pending"
+	TransferOrderStatusInTransit TransferOrderStatus = "in_transit"
+	TransferOrderStatusReceived  TransferOrderStatus = "received"
+	TransferOrderStatusCancelled TransferOrderStatus = "cancelled"
+)
+
+// AllValues returns all TransferOrderStatus values.
+func (TransferOrderStatus) AllValues() []TransferOrderStatus {
+	return []TransferOrderStatus{
+		TransferOrderStatusPending,
+		TransferOrderStatusInTransit,
+		TransferOrderStatusReceived,
+		TransferOrderStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TransferOrderStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case TransferOrderStatusPending:
+		return []byte(s), nil
+	case TransferOrderStatusInTransit:
+		return []byte(s), nil
+	case TransferOrderStatusReceived:
+		return []byte(s), nil
+	case TransferOrderStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TransferOrderStatus) UnmarshalText(data []byte) error {
+	switch TransferOrderStatus(data) {
+	case TransferOrderStatusPending:
+		*s = TransferOrderStatusPending
+		return nil
+	case TransferOrderStatusInTransit:
+		*s = TransferOrderStatusInTransit
+		return nil
+	case TransferOrderStatusReceived:
+		*s = TransferOrderStatusReceived
+		return nil
+	case TransferOrderStatusCancelled:
+		*s = TransferOrderStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TvBoard
+type TvBoard struct {
+	ID uuid.UUID `json:"id"`
+	// The name of the TV Board.
+	Name  string `json:"name"`
+	Token string `json:"token"`
+	Unit  Unit   `json:"unit"`
+}
+
+// GetID returns the value of ID.
+func (s *TvBoard) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TvBoard) GetName() string {
+	return s.Name
+}
+
+// GetToken returns the value of Token.
+func (s *TvBoard) GetToken() string {
+	return s.Token
+}
+
+// GetUnit returns the value of Unit.
+func (s *TvBoard) GetUnit() Unit {
+	return s.Unit
+}
+
+// SetID sets the value of ID.
+func (s *TvBoard) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TvBoard) SetName(val string) {
+	s.Name = val
+}
+
+// SetToken sets the value of Token.
+func (s *TvBoard) SetToken(val string) {
+	s.Token = val
+}
+
 // SetUnit sets the value of Unit.
-func (s *TaskFull) SetUnit(val Unit) {
+func (s *TvBoard) SetUnit(val Unit) {
 	s.Unit = val
 }
 
-// SetAssignedTo sets the value of AssignedTo.
-func (s *TaskFull) SetAssignedTo(val NilEmployeeOptional) {
-	s.AssignedTo = val
+// Ref: #/components/schemas/TvBoardPickupCode
+type TvBoardPickupCode struct {
+	TaskId    uuid.UUID `json:"taskId"`
+	TaskName  string    `json:"taskName"`
+	Code      string    `json:"code"`
+	QrPayload string    `json:"qrPayload"`
+}
+
+// GetTaskId returns the value of TaskId.
+func (s *TvBoardPickupCode) GetTaskId() uuid.UUID {
+	return s.TaskId
+}
+
+// GetTaskName returns the value of TaskName.
+func (s *TvBoardPickupCode) GetTaskName() string {
+	return s.TaskName
+}
+
+// GetCode returns the value of Code.
+func (s *TvBoardPickupCode) GetCode() string {
+	return s.Code
+}
+
+// GetQrPayload returns the value of QrPayload.
+func (s *TvBoardPickupCode) GetQrPayload() string {
+	return s.QrPayload
+}
+
+// SetTaskId sets the value of TaskId.
+func (s *TvBoardPickupCode) SetTaskId(val uuid.UUID) {
+	s.TaskId = val
+}
+
+// SetTaskName sets the value of TaskName.
+func (s *TvBoardPickupCode) SetTaskName(val string) {
+	s.TaskName = val
+}
+
+// SetCode sets the value of Code.
+func (s *TvBoardPickupCode) SetCode(val string) {
+	s.Code = val
+}
+
+// SetQrPayload sets the value of QrPayload.
+func (s *TvBoardPickupCode) SetQrPayload(val string) {
+	s.QrPayload = val
+}
+
+type UnassignTaskBadRequest ErrorContent
+
+func (*UnassignTaskBadRequest) unassignTaskRes() {}
+
+type UnassignTaskForbidden ErrorContent
+
+func (*UnassignTaskForbidden) unassignTaskRes() {}
+
+// UnassignTaskNoContent is response for UnassignTask operation.
+type UnassignTaskNoContent struct{}
+
+func (*UnassignTaskNoContent) unassignTaskRes() {}
+
+type UnassignTaskUnauthorized ErrorContent
+
+func (*UnassignTaskUnauthorized) unassignTaskRes() {}
+
+// Merged schema.
+// Ref: #/components/schemas/Unit
+type Unit struct {
+	ID    uuid.UUID    `json:"id"`
+	Name  string       `json:"name"`
+	Alias StorageAlias `json:"alias"`
+	// Merged property.
+	Address NilString `json:"address"`
+	// How task items are ordered into a walking route inside a cells group.
+	PickPathStrategy UnitPickPathStrategy `json:"pickPathStrategy"`
+}
+
+// GetID returns the value of ID.
+func (s *Unit) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Unit) GetName() string {
+	return s.Name
+}
+
+// GetAlias returns the value of Alias.
+func (s *Unit) GetAlias() StorageAlias {
+	return s.Alias
+}
+
+// GetAddress returns the value of Address.
+func (s *Unit) GetAddress() NilString {
+	return s.Address
+}
+
+// GetPickPathStrategy returns the value of PickPathStrategy.
+func (s *Unit) GetPickPathStrategy() UnitPickPathStrategy {
+	return s.PickPathStrategy
+}
+
+// SetID sets the value of ID.
+func (s *Unit) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Unit) SetName(val string) {
+	s.Name = val
+}
+
+// SetAlias sets the value of Alias.
+func (s *Unit) SetAlias(val StorageAlias) {
+	s.Alias = val
+}
+
+// SetAddress sets the value of Address.
+func (s *Unit) SetAddress(val NilString) {
+	s.Address = val
+}
+
+// SetPickPathStrategy sets the value of PickPathStrategy.
+func (s *Unit) SetPickPathStrategy(val UnitPickPathStrategy) {
+	s.PickPathStrategy = val
+}
+
+// Ref: #/components/schemas/UnitBase
+type UnitBase struct {
+	Name    string       `json:"name"`
+	Alias   StorageAlias `json:"alias"`
+	Address OptNilString `json:"address"`
+	// How task items are ordered into a walking route inside a cells group.
+	PickPathStrategy OptUnitBasePickPathStrategy `json:"pickPathStrategy"`
+}
+
+// GetName returns the value of Name.
+func (s *UnitBase) GetName() string {
+	return s.Name
+}
+
+// GetAlias returns the value of Alias.
+func (s *UnitBase) GetAlias() StorageAlias {
+	return s.Alias
+}
+
+// GetAddress returns the value of Address.
+func (s *UnitBase) GetAddress() OptNilString {
+	return s.Address
+}
+
+// GetPickPathStrategy returns the value of PickPathStrategy.
+func (s *UnitBase) GetPickPathStrategy() OptUnitBasePickPathStrategy {
+	return s.PickPathStrategy
+}
+
+// SetName sets the value of Name.
+func (s *UnitBase) SetName(val string) {
+	s.Name = val
 }
 
-// SetAssignedAt sets the value of AssignedAt.
-func (s *TaskFull) SetAssignedAt(val NilDateTime) {
-	s.AssignedAt = val
+// SetAlias sets the value of Alias.
+func (s *UnitBase) SetAlias(val StorageAlias) {
+	s.Alias = val
 }
 
-// SetCompletedAt sets the value of CompletedAt.
-func (s *TaskFull) SetCompletedAt(val NilDateTime) {
-	s.CompletedAt = val
+// SetAddress sets the value of Address.
+func (s *UnitBase) SetAddress(val OptNilString) {
+	s.Address = val
 }
 
-// SetItems sets the value of Items.
-func (s *TaskFull) SetItems(val []TaskItem) {
-	s.Items = val
+// SetPickPathStrategy sets the value of PickPathStrategy.
+func (s *UnitBase) SetPickPathStrategy(val OptUnitBasePickPathStrategy) {
+	s.PickPathStrategy = val
 }
 
-type TaskFullStatus string
+// How task items are ordered into a walking route inside a cells group.
+type UnitBasePickPathStrategy string
 
 const (
-	TaskFullStatusPending    TaskFullStatus = "pending"
-	TaskFullStatusInProgress TaskFullStatus = "in_progress"
-	TaskFullStatusReady      TaskFullStatus = "ready"
-	TaskFullStatusCompleted  TaskFullStatus = "completed"
-	TaskFullStatusCancelled  TaskFullStatus = "cancelled"
+	UnitBasePickPathStrategySerpentine UnitBasePickPathStrategy = "serpentine"
+	UnitBasePickPathStrategySequential UnitBasePickPathStrategy = "sequential"
 )
 
-// AllValues returns all TaskFullStatus values.
-func (TaskFullStatus) AllValues() []TaskFullStatus {
-	return []TaskFullStatus{
-		TaskFullStatusPending,
-		TaskFullStatusInProgress,
-		TaskFullStatusReady,
-		TaskFullStatusCompleted,
-		TaskFullStatusCancelled,
+// AllValues returns all UnitBasePickPathStrategy values.
+func (UnitBasePickPathStrategy) AllValues() []UnitBasePickPathStrategy {
+	return []UnitBasePickPathStrategy{
+		UnitBasePickPathStrategySerpentine,
+		UnitBasePickPathStrategySequential,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s TaskFullStatus) MarshalText() ([]byte, error) {
+func (s UnitBasePickPathStrategy) MarshalText() ([]byte, error) {
 	switch s {
-	case TaskFullStatusPending:
-		return []byte(s), nil
-	case TaskFullStatusInProgress:
+	case UnitBasePickPathStrategySerpentine:
 		return []byte(s), nil
-	case TaskFullStatusReady:
-		return []byte(s), nil
-	case TaskFullStatusCompleted:
-		return []byte(s), nil
-	case TaskFullStatusCancelled:
+	case UnitBasePickPathStrategySequential:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -4607,49 +13703,41 @@ func (s TaskFullStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TaskFullStatus) UnmarshalText(data []byte) error {
-	switch TaskFullStatus(data) {
-	case TaskFullStatusPending:
-		*s = TaskFullStatusPending
-		return nil
-	case TaskFullStatusInProgress:
-		*s = TaskFullStatusInProgress
-		return nil
-	case TaskFullStatusReady:
-		*s = TaskFullStatusReady
-		return nil
-	case TaskFullStatusCompleted:
-		*s = TaskFullStatusCompleted
+func (s *UnitBasePickPathStrategy) UnmarshalText(data []byte) error {
+	switch UnitBasePickPathStrategy(data) {
+	case UnitBasePickPathStrategySerpentine:
+		*s = UnitBasePickPathStrategySerpentine
 		return nil
-	case TaskFullStatusCancelled:
-		*s = TaskFullStatusCancelled
+	case UnitBasePickPathStrategySequential:
+		*s = UnitBasePickPathStrategySequential
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type TaskFullType string
+// How task items are ordered into a walking route inside a cells group.
+type UnitPickPathStrategy string
 
 const (
-	TaskFullTypePickment TaskFullType = "pickment"
-	TaskFullTypeMovement TaskFullType = "movement"
+	UnitPickPathStrategySerpentine UnitPickPathStrategy = "serpentine"
+	UnitPickPathStrategySequential UnitPickPathStrategy = "sequential"
 )
 
-// AllValues returns all TaskFullType values.
-func (TaskFullType) AllValues() []TaskFullType {
-	return []TaskFullType{
-		TaskFullTypePickment,
-		TaskFullTypeMovement,
+// AllValues returns all UnitPickPathStrategy values.
+func (UnitPickPathStrategy) AllValues() []UnitPickPathStrategy {
+	return []UnitPickPathStrategy{
+		UnitPickPathStrategySerpentine,
+		UnitPickPathStrategySequential,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s TaskFullType) MarshalText() ([]byte, error) {
+func (s UnitPickPathStrategy) MarshalText() ([]byte, error) {
 	switch s {
-	case TaskFullTypePickment:
+	case UnitPickPathStrategySerpentine:
 		return []byte(s), nil
-	case TaskFullTypeMovement:
+	case UnitPickPathStrategySequential:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -4657,735 +13745,1183 @@ func (s TaskFullType) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TaskFullType) UnmarshalText(data []byte) error {
-	switch TaskFullType(data) {
-	case TaskFullTypePickment:
-		*s = TaskFullTypePickment
+func (s *UnitPickPathStrategy) UnmarshalText(data []byte) error {
+	switch UnitPickPathStrategy(data) {
+	case UnitPickPathStrategySerpentine:
+		*s = UnitPickPathStrategySerpentine
 		return nil
-	case TaskFullTypeMovement:
-		*s = TaskFullTypeMovement
+	case UnitPickPathStrategySequential:
+		*s = UnitPickPathStrategySequential
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/TaskItem
-type TaskItem struct {
-	Instance   InstanceFull               `json:"instance"`
-	SourceCell CellForInstance            `json:"sourceCell"`
-	TargetCell NilCellForInstanceOptional `json:"targetCell"`
-	Status     TaskItemStatus             `json:"status"`
+type UpdateCellBadRequest ErrorContent
+
+func (*UpdateCellBadRequest) updateCellRes() {}
+
+type UpdateCellForbidden ErrorContent
+
+func (*UpdateCellForbidden) updateCellRes() {}
+
+type UpdateCellNotFound ErrorContent
+
+func (*UpdateCellNotFound) updateCellRes() {}
+
+// Ref: #/components/schemas/UpdateCellRequest
+type UpdateCellRequest struct {
+	Alias    string `json:"alias"`
+	Row      int    `json:"row"`
+	Level    int    `json:"level"`
+	Position int    `json:"position"`
+}
+
+// GetAlias returns the value of Alias.
+func (s *UpdateCellRequest) GetAlias() string {
+	return s.Alias
+}
+
+// GetRow returns the value of Row.
+func (s *UpdateCellRequest) GetRow() int {
+	return s.Row
+}
+
+// GetLevel returns the value of Level.
+func (s *UpdateCellRequest) GetLevel() int {
+	return s.Level
+}
+
+// GetPosition returns the value of Position.
+func (s *UpdateCellRequest) GetPosition() int {
+	return s.Position
+}
+
+// SetAlias sets the value of Alias.
+func (s *UpdateCellRequest) SetAlias(val string) {
+	s.Alias = val
+}
+
+// SetRow sets the value of Row.
+func (s *UpdateCellRequest) SetRow(val int) {
+	s.Row = val
+}
+
+// SetLevel sets the value of Level.
+func (s *UpdateCellRequest) SetLevel(val int) {
+	s.Level = val
+}
+
+// SetPosition sets the value of Position.
+func (s *UpdateCellRequest) SetPosition(val int) {
+	s.Position = val
+}
+
+// Ref: #/components/schemas/UpdateCellResponse
+type UpdateCellResponse struct {
+	Data Cell `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *UpdateCellResponse) GetData() Cell {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *UpdateCellResponse) SetData(val Cell) {
+	s.Data = val
+}
+
+func (*UpdateCellResponse) updateCellRes() {}
+
+type UpdateCellUnauthorized ErrorContent
+
+func (*UpdateCellUnauthorized) updateCellRes() {}
+
+type UpdateCellsGroupBadRequest ErrorContent
+
+func (*UpdateCellsGroupBadRequest) updateCellsGroupRes() {}
+
+type UpdateCellsGroupForbidden ErrorContent
+
+func (*UpdateCellsGroupForbidden) updateCellsGroupRes() {}
+
+type UpdateCellsGroupNotFound ErrorContent
+
+func (*UpdateCellsGroupNotFound) updateCellsGroupRes() {}
+
+// Ref: #/components/schemas/UpdateCellsGroupRequest
+type UpdateCellsGroupRequest struct {
+	Name           string       `json:"name"`
+	Alias          StorageAlias `json:"alias"`
+	StorageGroupId OptNilUUID   `json:"storageGroupId"`
+	UnitId         uuid.UUID    `json:"unitId"`
+}
+
+// GetName returns the value of Name.
+func (s *UpdateCellsGroupRequest) GetName() string {
+	return s.Name
+}
+
+// GetAlias returns the value of Alias.
+func (s *UpdateCellsGroupRequest) GetAlias() StorageAlias {
+	return s.Alias
+}
+
+// GetStorageGroupId returns the value of StorageGroupId.
+func (s *UpdateCellsGroupRequest) GetStorageGroupId() OptNilUUID {
+	return s.StorageGroupId
+}
+
+// GetUnitId returns the value of UnitId.
+func (s *UpdateCellsGroupRequest) GetUnitId() uuid.UUID {
+	return s.UnitId
+}
+
+// SetName sets the value of Name.
+func (s *UpdateCellsGroupRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetAlias sets the value of Alias.
+func (s *UpdateCellsGroupRequest) SetAlias(val StorageAlias) {
+	s.Alias = val
+}
+
+// SetStorageGroupId sets the value of StorageGroupId.
+func (s *UpdateCellsGroupRequest) SetStorageGroupId(val OptNilUUID) {
+	s.StorageGroupId = val
+}
+
+// SetUnitId sets the value of UnitId.
+func (s *UpdateCellsGroupRequest) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
+
+// Ref: #/components/schemas/UpdateCellsGroupResponse
+type UpdateCellsGroupResponse struct {
+	Data CellGroup `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *UpdateCellsGroupResponse) GetData() CellGroup {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *UpdateCellsGroupResponse) SetData(val CellGroup) {
+	s.Data = val
+}
+
+func (*UpdateCellsGroupResponse) updateCellsGroupRes() {}
+
+type UpdateCellsGroupUnauthorized ErrorContent
+
+func (*UpdateCellsGroupUnauthorized) updateCellsGroupRes() {}
+
+type UpdateInstanceByIdBadRequest ErrorContent
+
+func (*UpdateInstanceByIdBadRequest) updateInstanceByIdRes() {}
+
+type UpdateInstanceByIdForbidden ErrorContent
+
+func (*UpdateInstanceByIdForbidden) updateInstanceByIdRes() {}
+
+type UpdateInstanceByIdNotFound ErrorContent
+
+func (*UpdateInstanceByIdNotFound) updateInstanceByIdRes() {}
+
+type UpdateInstanceByIdUnauthorized ErrorContent
+
+func (*UpdateInstanceByIdUnauthorized) updateInstanceByIdRes() {}
+
+// Ref: #/components/schemas/UpdateInstanceRequest
+type UpdateInstanceRequest struct {
+	VariantId uuid.UUID  `json:"variantId"`
+	CellId    OptNilUUID `json:"cellId"`
+	// Manufacturer serial number, unique per variant. Required when the variant requires serials, kept
+	// on update when not given.
+	SerialNumber   OptNilString `json:"serialNumber"`
+	LotNumber      OptNilString `json:"lotNumber"`
+	ManufacturedAt OptNilDate   `json:"manufacturedAt"`
+	ExpiresAt      OptNilDate   `json:"expiresAt"`
+}
+
+// GetVariantId returns the value of VariantId.
+func (s *UpdateInstanceRequest) GetVariantId() uuid.UUID {
+	return s.VariantId
 }
 
-// GetInstance returns the value of Instance.
-func (s *TaskItem) GetInstance() InstanceFull {
-	return s.Instance
+// GetCellId returns the value of CellId.
+func (s *UpdateInstanceRequest) GetCellId() OptNilUUID {
+	return s.CellId
 }
 
-// GetSourceCell returns the value of SourceCell.
-func (s *TaskItem) GetSourceCell() CellForInstance {
-	return s.SourceCell
+// GetSerialNumber returns the value of SerialNumber.
+func (s *UpdateInstanceRequest) GetSerialNumber() OptNilString {
+	return s.SerialNumber
 }
 
-// GetTargetCell returns the value of TargetCell.
-func (s *TaskItem) GetTargetCell() NilCellForInstanceOptional {
-	return s.TargetCell
+// GetLotNumber returns the value of LotNumber.
+func (s *UpdateInstanceRequest) GetLotNumber() OptNilString {
+	return s.LotNumber
 }
 
-// GetStatus returns the value of Status.
-func (s *TaskItem) GetStatus() TaskItemStatus {
-	return s.Status
+// GetManufacturedAt returns the value of ManufacturedAt.
+func (s *UpdateInstanceRequest) GetManufacturedAt() OptNilDate {
+	return s.ManufacturedAt
 }
 
-// SetInstance sets the value of Instance.
-func (s *TaskItem) SetInstance(val InstanceFull) {
-	s.Instance = val
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *UpdateInstanceRequest) GetExpiresAt() OptNilDate {
+	return s.ExpiresAt
 }
 
-// SetSourceCell sets the value of SourceCell.
-func (s *TaskItem) SetSourceCell(val CellForInstance) {
-	s.SourceCell = val
+// SetVariantId sets the value of VariantId.
+func (s *UpdateInstanceRequest) SetVariantId(val uuid.UUID) {
+	s.VariantId = val
 }
 
-// SetTargetCell sets the value of TargetCell.
-func (s *TaskItem) SetTargetCell(val NilCellForInstanceOptional) {
-	s.TargetCell = val
+// SetCellId sets the value of CellId.
+func (s *UpdateInstanceRequest) SetCellId(val OptNilUUID) {
+	s.CellId = val
 }
 
-// SetStatus sets the value of Status.
-func (s *TaskItem) SetStatus(val TaskItemStatus) {
-	s.Status = val
+// SetSerialNumber sets the value of SerialNumber.
+func (s *UpdateInstanceRequest) SetSerialNumber(val OptNilString) {
+	s.SerialNumber = val
 }
 
-type TaskItemStatus string
-
-const (
-	TaskItemStatusPending TaskItemStatus = "pending"
-	TaskItemStatusPicked  TaskItemStatus = "picked"
-)
-
-// AllValues returns all TaskItemStatus values.
-func (TaskItemStatus) AllValues() []TaskItemStatus {
-	return []TaskItemStatus{
-		TaskItemStatusPending,
-		TaskItemStatusPicked,
-	}
+// SetLotNumber sets the value of LotNumber.
+func (s *UpdateInstanceRequest) SetLotNumber(val OptNilString) {
+	s.LotNumber = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s TaskItemStatus) MarshalText() ([]byte, error) {
-	switch s {
-	case TaskItemStatusPending:
-		return []byte(s), nil
-	case TaskItemStatusPicked:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetManufacturedAt sets the value of ManufacturedAt.
+func (s *UpdateInstanceRequest) SetManufacturedAt(val OptNilDate) {
+	s.ManufacturedAt = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TaskItemStatus) UnmarshalText(data []byte) error {
-	switch TaskItemStatus(data) {
-	case TaskItemStatusPending:
-		*s = TaskItemStatusPending
-		return nil
-	case TaskItemStatusPicked:
-		*s = TaskItemStatusPicked
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *UpdateInstanceRequest) SetExpiresAt(val OptNilDate) {
+	s.ExpiresAt = val
 }
 
-// Ref: #/components/schemas/Token
-type Token struct {
-	ID uuid.UUID `json:"id"`
-	// The name of the token.
-	Name string `json:"name"`
-	// The token secret.
-	Token string `json:"token"`
+// Ref: #/components/schemas/UpdateInstanceResponse
+type UpdateInstanceResponse struct {
+	Data InstanceFull `json:"data"`
 }
 
-// GetID returns the value of ID.
-func (s *Token) GetID() uuid.UUID {
-	return s.ID
+// GetData returns the value of Data.
+func (s *UpdateInstanceResponse) GetData() InstanceFull {
+	return s.Data
 }
 
-// GetName returns the value of Name.
-func (s *Token) GetName() string {
-	return s.Name
+// SetData sets the value of Data.
+func (s *UpdateInstanceResponse) SetData(val InstanceFull) {
+	s.Data = val
 }
 
-// GetToken returns the value of Token.
-func (s *Token) GetToken() string {
-	return s.Token
-}
+func (*UpdateInstanceResponse) updateInstanceByIdRes() {}
 
-// SetID sets the value of ID.
-func (s *Token) SetID(val uuid.UUID) {
-	s.ID = val
-}
+type UpdateItemBadRequest ErrorContent
 
-// SetName sets the value of Name.
-func (s *Token) SetName(val string) {
-	s.Name = val
-}
+func (*UpdateItemBadRequest) updateItemRes() {}
 
-// SetToken sets the value of Token.
-func (s *Token) SetToken(val string) {
-	s.Token = val
-}
+type UpdateItemForbidden ErrorContent
 
-// Ref: #/components/schemas/TvBoard
-type TvBoard struct {
-	ID uuid.UUID `json:"id"`
-	// The name of the TV Board.
-	Name  string `json:"name"`
-	Token string `json:"token"`
-	Unit  Unit   `json:"unit"`
+func (*UpdateItemForbidden) updateItemRes() {}
+
+type UpdateItemNotFound ErrorContent
+
+func (*UpdateItemNotFound) updateItemRes() {}
+
+// Ref: #/components/schemas/UpdateItemRequest
+type UpdateItemRequest struct {
+	ID          OptUUID      `json:"id"`
+	Name        string       `json:"name"`
+	Description OptNilString `json:"description"`
 }
 
 // GetID returns the value of ID.
-func (s *TvBoard) GetID() uuid.UUID {
+func (s *UpdateItemRequest) GetID() OptUUID {
 	return s.ID
 }
 
 // GetName returns the value of Name.
-func (s *TvBoard) GetName() string {
+func (s *UpdateItemRequest) GetName() string {
 	return s.Name
 }
 
-// GetToken returns the value of Token.
-func (s *TvBoard) GetToken() string {
-	return s.Token
-}
-
-// GetUnit returns the value of Unit.
-func (s *TvBoard) GetUnit() Unit {
-	return s.Unit
+// GetDescription returns the value of Description.
+func (s *UpdateItemRequest) GetDescription() OptNilString {
+	return s.Description
 }
 
 // SetID sets the value of ID.
-func (s *TvBoard) SetID(val uuid.UUID) {
+func (s *UpdateItemRequest) SetID(val OptUUID) {
 	s.ID = val
 }
 
 // SetName sets the value of Name.
-func (s *TvBoard) SetName(val string) {
+func (s *UpdateItemRequest) SetName(val string) {
 	s.Name = val
 }
 
-// SetToken sets the value of Token.
-func (s *TvBoard) SetToken(val string) {
-	s.Token = val
+// SetDescription sets the value of Description.
+func (s *UpdateItemRequest) SetDescription(val OptNilString) {
+	s.Description = val
 }
 
-// SetUnit sets the value of Unit.
-func (s *TvBoard) SetUnit(val Unit) {
-	s.Unit = val
+// Ref: #/components/schemas/UpdateItemResponse
+type UpdateItemResponse struct {
+	Data ItemFull `json:"data"`
 }
 
-// Merged schema.
-// Ref: #/components/schemas/Unit
-type Unit struct {
-	ID    uuid.UUID    `json:"id"`
-	Name  string       `json:"name"`
-	Alias StorageAlias `json:"alias"`
-	// Merged property.
-	Address NilString `json:"address"`
+// GetData returns the value of Data.
+func (s *UpdateItemResponse) GetData() ItemFull {
+	return s.Data
 }
 
-// GetID returns the value of ID.
-func (s *Unit) GetID() uuid.UUID {
-	return s.ID
+// SetData sets the value of Data.
+func (s *UpdateItemResponse) SetData(val ItemFull) {
+	s.Data = val
 }
 
-// GetName returns the value of Name.
-func (s *Unit) GetName() string {
-	return s.Name
-}
+func (*UpdateItemResponse) updateItemRes() {}
 
-// GetAlias returns the value of Alias.
-func (s *Unit) GetAlias() StorageAlias {
-	return s.Alias
-}
+type UpdateItemVariantBadRequest ErrorContent
 
-// GetAddress returns the value of Address.
-func (s *Unit) GetAddress() NilString {
-	return s.Address
-}
+func (*UpdateItemVariantBadRequest) updateItemVariantRes() {}
 
-// SetID sets the value of ID.
-func (s *Unit) SetID(val uuid.UUID) {
-	s.ID = val
-}
+type UpdateItemVariantForbidden ErrorContent
 
-// SetName sets the value of Name.
-func (s *Unit) SetName(val string) {
-	s.Name = val
-}
+func (*UpdateItemVariantForbidden) updateItemVariantRes() {}
 
-// SetAlias sets the value of Alias.
-func (s *Unit) SetAlias(val StorageAlias) {
-	s.Alias = val
-}
+type UpdateItemVariantNotFound ErrorContent
 
-// SetAddress sets the value of Address.
-func (s *Unit) SetAddress(val NilString) {
-	s.Address = val
-}
+func (*UpdateItemVariantNotFound) updateItemVariantRes() {}
 
-// Ref: #/components/schemas/UnitBase
-type UnitBase struct {
+// Ref: #/components/schemas/UpdateItemVariantRequest
+type UpdateItemVariantRequest struct {
 	Name    string       `json:"name"`
-	Alias   StorageAlias `json:"alias"`
-	Address OptNilString `json:"address"`
+	Article OptNilString `json:"article"`
+	Ean13   OptNilInt64  `json:"ean13"`
+	// Serialized variants are stocked as instances, quantity tracked ones as stock lines holding a
+	// quantity. Cannot change while the variant has stock.
+	TrackingMode OptUpdateItemVariantRequestTrackingMode `json:"trackingMode"`
+	// Overrides the allocation policy of the organization, null inherits it.
+	AllocationPolicy OptNilUpdateItemVariantRequestAllocationPolicy `json:"allocationPolicy"`
+	// Instances must get a serial number when created or received. Only serialized variants can require
+	// serials.
+	RequiresSerial OptBool `json:"requiresSerial"`
 }
 
 // GetName returns the value of Name.
-func (s *UnitBase) GetName() string {
+func (s *UpdateItemVariantRequest) GetName() string {
 	return s.Name
 }
 
-// GetAlias returns the value of Alias.
-func (s *UnitBase) GetAlias() StorageAlias {
-	return s.Alias
+// GetArticle returns the value of Article.
+func (s *UpdateItemVariantRequest) GetArticle() OptNilString {
+	return s.Article
 }
 
-// GetAddress returns the value of Address.
-func (s *UnitBase) GetAddress() OptNilString {
-	return s.Address
+// GetEan13 returns the value of Ean13.
+func (s *UpdateItemVariantRequest) GetEan13() OptNilInt64 {
+	return s.Ean13
 }
 
-// SetName sets the value of Name.
-func (s *UnitBase) SetName(val string) {
-	s.Name = val
+// GetTrackingMode returns the value of TrackingMode.
+func (s *UpdateItemVariantRequest) GetTrackingMode() OptUpdateItemVariantRequestTrackingMode {
+	return s.TrackingMode
 }
 
-// SetAlias sets the value of Alias.
-func (s *UnitBase) SetAlias(val StorageAlias) {
-	s.Alias = val
+// GetAllocationPolicy returns the value of AllocationPolicy.
+func (s *UpdateItemVariantRequest) GetAllocationPolicy() OptNilUpdateItemVariantRequestAllocationPolicy {
+	return s.AllocationPolicy
 }
 
-// SetAddress sets the value of Address.
-func (s *UnitBase) SetAddress(val OptNilString) {
-	s.Address = val
+// GetRequiresSerial returns the value of RequiresSerial.
+func (s *UpdateItemVariantRequest) GetRequiresSerial() OptBool {
+	return s.RequiresSerial
 }
 
-type UpdateCellBadRequest ErrorContent
-
-func (*UpdateCellBadRequest) updateCellRes() {}
-
-type UpdateCellForbidden ErrorContent
-
-func (*UpdateCellForbidden) updateCellRes() {}
+// SetName sets the value of Name.
+func (s *UpdateItemVariantRequest) SetName(val string) {
+	s.Name = val
+}
 
-type UpdateCellNotFound ErrorContent
+// SetArticle sets the value of Article.
+func (s *UpdateItemVariantRequest) SetArticle(val OptNilString) {
+	s.Article = val
+}
 
-func (*UpdateCellNotFound) updateCellRes() {}
+// SetEan13 sets the value of Ean13.
+func (s *UpdateItemVariantRequest) SetEan13(val OptNilInt64) {
+	s.Ean13 = val
+}
 
-// Ref: #/components/schemas/UpdateCellRequest
-type UpdateCellRequest struct {
-	Alias    string `json:"alias"`
-	Row      int    `json:"row"`
-	Level    int    `json:"level"`
-	Position int    `json:"position"`
+// SetTrackingMode sets the value of TrackingMode.
+func (s *UpdateItemVariantRequest) SetTrackingMode(val OptUpdateItemVariantRequestTrackingMode) {
+	s.TrackingMode = val
 }
 
-// GetAlias returns the value of Alias.
-func (s *UpdateCellRequest) GetAlias() string {
-	return s.Alias
+// SetAllocationPolicy sets the value of AllocationPolicy.
+func (s *UpdateItemVariantRequest) SetAllocationPolicy(val OptNilUpdateItemVariantRequestAllocationPolicy) {
+	s.AllocationPolicy = val
 }
 
-// GetRow returns the value of Row.
-func (s *UpdateCellRequest) GetRow() int {
-	return s.Row
+// SetRequiresSerial sets the value of RequiresSerial.
+func (s *UpdateItemVariantRequest) SetRequiresSerial(val OptBool) {
+	s.RequiresSerial = val
 }
 
-// GetLevel returns the value of Level.
-func (s *UpdateCellRequest) GetLevel() int {
-	return s.Level
+// Overrides the allocation policy of the organization, null inherits it.
+type UpdateItemVariantRequestAllocationPolicy string
+
+const (
+	UpdateItemVariantRequestAllocationPolicyFifo UpdateItemVariantRequestAllocationPolicy = "fifo"
+	UpdateItemVariantRequestAllocationPolicyFefo UpdateItemVariantRequestAllocationPolicy = "fefo"
+)
+
+// AllValues returns all UpdateItemVariantRequestAllocationPolicy values.
+func (UpdateItemVariantRequestAllocationPolicy) AllValues() []UpdateItemVariantRequestAllocationPolicy {
+	return []UpdateItemVariantRequestAllocationPolicy{
+		UpdateItemVariantRequestAllocationPolicyFifo,
+		UpdateItemVariantRequestAllocationPolicyFefo,
+	}
 }
 
-// GetPosition returns the value of Position.
-func (s *UpdateCellRequest) GetPosition() int {
-	return s.Position
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateItemVariantRequestAllocationPolicy) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateItemVariantRequestAllocationPolicyFifo:
+		return []byte(s), nil
+	case UpdateItemVariantRequestAllocationPolicyFefo:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetAlias sets the value of Alias.
-func (s *UpdateCellRequest) SetAlias(val string) {
-	s.Alias = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateItemVariantRequestAllocationPolicy) UnmarshalText(data []byte) error {
+	switch UpdateItemVariantRequestAllocationPolicy(data) {
+	case UpdateItemVariantRequestAllocationPolicyFifo:
+		*s = UpdateItemVariantRequestAllocationPolicyFifo
+		return nil
+	case UpdateItemVariantRequestAllocationPolicyFefo:
+		*s = UpdateItemVariantRequestAllocationPolicyFefo
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetRow sets the value of Row.
-func (s *UpdateCellRequest) SetRow(val int) {
-	s.Row = val
+// Serialized variants are stocked as instances, quantity tracked ones as stock lines holding a
+// quantity. Cannot change while the variant has stock.
+type UpdateItemVariantRequestTrackingMode string
+
+const (
+	UpdateItemVariantRequestTrackingModeSerialized UpdateItemVariantRequestTrackingMode = "serialized"
+	UpdateItemVariantRequestTrackingModeQuantity   UpdateItemVariantRequestTrackingMode = "quantity"
+)
+
+// AllValues returns all UpdateItemVariantRequestTrackingMode values.
+func (UpdateItemVariantRequestTrackingMode) AllValues() []UpdateItemVariantRequestTrackingMode {
+	return []UpdateItemVariantRequestTrackingMode{
+		UpdateItemVariantRequestTrackingModeSerialized,
+		UpdateItemVariantRequestTrackingModeQuantity,
+	}
 }
 
-// SetLevel sets the value of Level.
-func (s *UpdateCellRequest) SetLevel(val int) {
-	s.Level = val
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateItemVariantRequestTrackingMode) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateItemVariantRequestTrackingModeSerialized:
+		return []byte(s), nil
+	case UpdateItemVariantRequestTrackingModeQuantity:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetPosition sets the value of Position.
-func (s *UpdateCellRequest) SetPosition(val int) {
-	s.Position = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateItemVariantRequestTrackingMode) UnmarshalText(data []byte) error {
+	switch UpdateItemVariantRequestTrackingMode(data) {
+	case UpdateItemVariantRequestTrackingModeSerialized:
+		*s = UpdateItemVariantRequestTrackingModeSerialized
+		return nil
+	case UpdateItemVariantRequestTrackingModeQuantity:
+		*s = UpdateItemVariantRequestTrackingModeQuantity
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// Ref: #/components/schemas/UpdateCellResponse
-type UpdateCellResponse struct {
-	Data Cell `json:"data"`
+// Ref: #/components/schemas/UpdateItemVariantResponse
+type UpdateItemVariantResponse struct {
+	Data ItemVariant `json:"data"`
 }
 
 // GetData returns the value of Data.
-func (s *UpdateCellResponse) GetData() Cell {
+func (s *UpdateItemVariantResponse) GetData() ItemVariant {
 	return s.Data
 }
 
 // SetData sets the value of Data.
-func (s *UpdateCellResponse) SetData(val Cell) {
+func (s *UpdateItemVariantResponse) SetData(val ItemVariant) {
 	s.Data = val
 }
 
-func (*UpdateCellResponse) updateCellRes() {}
+func (*UpdateItemVariantResponse) updateItemVariantRes() {}
 
-type UpdateCellUnauthorized ErrorContent
+type UpdateItemVariantUnauthorized ErrorContent
 
-func (*UpdateCellUnauthorized) updateCellRes() {}
+func (*UpdateItemVariantUnauthorized) updateItemVariantRes() {}
 
-type UpdateCellsGroupBadRequest ErrorContent
+type UpdateOrganizationBadRequest ErrorContent
 
-func (*UpdateCellsGroupBadRequest) updateCellsGroupRes() {}
+func (*UpdateOrganizationBadRequest) updateOrganizationRes() {}
 
-type UpdateCellsGroupForbidden ErrorContent
+type UpdateOrganizationForbidden ErrorContent
 
-func (*UpdateCellsGroupForbidden) updateCellsGroupRes() {}
+func (*UpdateOrganizationForbidden) updateOrganizationRes() {}
 
-type UpdateCellsGroupNotFound ErrorContent
+type UpdateOrganizationNotFound ErrorContent
 
-func (*UpdateCellsGroupNotFound) updateCellsGroupRes() {}
+func (*UpdateOrganizationNotFound) updateOrganizationRes() {}
 
-// Ref: #/components/schemas/UpdateCellsGroupRequest
-type UpdateCellsGroupRequest struct {
-	Name           string       `json:"name"`
-	Alias          StorageAlias `json:"alias"`
-	StorageGroupId OptNilUUID   `json:"storageGroupId"`
-	UnitId         uuid.UUID    `json:"unitId"`
+// Ref: #/components/schemas/UpdateOrganizationResponse
+type UpdateOrganizationResponse struct {
+	Data Organization `json:"data"`
 }
 
-// GetName returns the value of Name.
-func (s *UpdateCellsGroupRequest) GetName() string {
-	return s.Name
+// GetData returns the value of Data.
+func (s *UpdateOrganizationResponse) GetData() Organization {
+	return s.Data
 }
 
-// GetAlias returns the value of Alias.
-func (s *UpdateCellsGroupRequest) GetAlias() StorageAlias {
-	return s.Alias
+// SetData sets the value of Data.
+func (s *UpdateOrganizationResponse) SetData(val Organization) {
+	s.Data = val
 }
 
-// GetStorageGroupId returns the value of StorageGroupId.
-func (s *UpdateCellsGroupRequest) GetStorageGroupId() OptNilUUID {
-	return s.StorageGroupId
-}
+func (*UpdateOrganizationResponse) updateOrganizationRes() {}
 
-// GetUnitId returns the value of UnitId.
-func (s *UpdateCellsGroupRequest) GetUnitId() uuid.UUID {
-	return s.UnitId
-}
+type UpdateOrganizationUnauthorized ErrorContent
 
-// SetName sets the value of Name.
-func (s *UpdateCellsGroupRequest) SetName(val string) {
-	s.Name = val
-}
+func (*UpdateOrganizationUnauthorized) updateOrganizationRes() {}
 
-// SetAlias sets the value of Alias.
-func (s *UpdateCellsGroupRequest) SetAlias(val StorageAlias) {
-	s.Alias = val
-}
+type UpdateOrganizationUnitBadRequest ErrorContent
 
-// SetStorageGroupId sets the value of StorageGroupId.
-func (s *UpdateCellsGroupRequest) SetStorageGroupId(val OptNilUUID) {
-	s.StorageGroupId = val
-}
+func (*UpdateOrganizationUnitBadRequest) updateOrganizationUnitRes() {}
 
-// SetUnitId sets the value of UnitId.
-func (s *UpdateCellsGroupRequest) SetUnitId(val uuid.UUID) {
-	s.UnitId = val
-}
+type UpdateOrganizationUnitForbidden ErrorContent
 
-// Ref: #/components/schemas/UpdateCellsGroupResponse
-type UpdateCellsGroupResponse struct {
-	Data CellGroup `json:"data"`
+func (*UpdateOrganizationUnitForbidden) updateOrganizationUnitRes() {}
+
+type UpdateOrganizationUnitNotFound ErrorContent
+
+func (*UpdateOrganizationUnitNotFound) updateOrganizationUnitRes() {}
+
+// Ref: #/components/schemas/UpdateOrganizationUnitResponse
+type UpdateOrganizationUnitResponse struct {
+	Data Unit `json:"data"`
 }
 
 // GetData returns the value of Data.
-func (s *UpdateCellsGroupResponse) GetData() CellGroup {
+func (s *UpdateOrganizationUnitResponse) GetData() Unit {
 	return s.Data
 }
 
 // SetData sets the value of Data.
-func (s *UpdateCellsGroupResponse) SetData(val CellGroup) {
+func (s *UpdateOrganizationUnitResponse) SetData(val Unit) {
 	s.Data = val
 }
 
-func (*UpdateCellsGroupResponse) updateCellsGroupRes() {}
-
-type UpdateCellsGroupUnauthorized ErrorContent
-
-func (*UpdateCellsGroupUnauthorized) updateCellsGroupRes() {}
+func (*UpdateOrganizationUnitResponse) updateOrganizationUnitRes() {}
 
-type UpdateInstanceByIdBadRequest ErrorContent
+type UpdateOrganizationUnitUnauthorized ErrorContent
 
-func (*UpdateInstanceByIdBadRequest) updateInstanceByIdRes() {}
+func (*UpdateOrganizationUnitUnauthorized) updateOrganizationUnitRes() {}
 
-type UpdateInstanceByIdForbidden ErrorContent
+type UpdateStorageGroupBadRequest ErrorContent
 
-func (*UpdateInstanceByIdForbidden) updateInstanceByIdRes() {}
+func (*UpdateStorageGroupBadRequest) updateStorageGroupRes() {}
 
-type UpdateInstanceByIdNotFound ErrorContent
+type UpdateStorageGroupForbidden ErrorContent
 
-func (*UpdateInstanceByIdNotFound) updateInstanceByIdRes() {}
+func (*UpdateStorageGroupForbidden) updateStorageGroupRes() {}
 
-type UpdateInstanceByIdUnauthorized ErrorContent
+type UpdateStorageGroupNotFound ErrorContent
 
-func (*UpdateInstanceByIdUnauthorized) updateInstanceByIdRes() {}
+func (*UpdateStorageGroupNotFound) updateStorageGroupRes() {}
 
-// Ref: #/components/schemas/UpdateInstanceRequest
-type UpdateInstanceRequest struct {
-	VariantId uuid.UUID  `json:"variantId"`
-	CellId    OptNilUUID `json:"cellId"`
+// Ref: #/components/schemas/UpdateStorageGroupResponse
+type UpdateStorageGroupResponse struct {
+	Data StorageGroup `json:"data"`
 }
 
-// GetVariantId returns the value of VariantId.
-func (s *UpdateInstanceRequest) GetVariantId() uuid.UUID {
-	return s.VariantId
+// GetData returns the value of Data.
+func (s *UpdateStorageGroupResponse) GetData() StorageGroup {
+	return s.Data
 }
 
-// GetCellId returns the value of CellId.
-func (s *UpdateInstanceRequest) GetCellId() OptNilUUID {
-	return s.CellId
+// SetData sets the value of Data.
+func (s *UpdateStorageGroupResponse) SetData(val StorageGroup) {
+	s.Data = val
 }
 
-// SetVariantId sets the value of VariantId.
-func (s *UpdateInstanceRequest) SetVariantId(val uuid.UUID) {
-	s.VariantId = val
-}
+func (*UpdateStorageGroupResponse) updateStorageGroupRes() {}
 
-// SetCellId sets the value of CellId.
-func (s *UpdateInstanceRequest) SetCellId(val OptNilUUID) {
-	s.CellId = val
-}
+type UpdateStorageGroupUnauthorized ErrorContent
 
-// Ref: #/components/schemas/UpdateInstanceResponse
-type UpdateInstanceResponse struct {
-	Data InstanceFull `json:"data"`
+func (*UpdateStorageGroupUnauthorized) updateStorageGroupRes() {}
+
+type UpdateTaskCommentBadRequest ErrorContent
+
+func (*UpdateTaskCommentBadRequest) updateTaskCommentRes() {}
+
+type UpdateTaskCommentForbidden ErrorContent
+
+func (*UpdateTaskCommentForbidden) updateTaskCommentRes() {}
+
+type UpdateTaskCommentNotFound ErrorContent
+
+func (*UpdateTaskCommentNotFound) updateTaskCommentRes() {}
+
+// Ref: #/components/schemas/UpdateTaskCommentRequest
+type UpdateTaskCommentRequest struct {
+	Body string `json:"body"`
 }
 
-// GetData returns the value of Data.
-func (s *UpdateInstanceResponse) GetData() InstanceFull {
-	return s.Data
+// GetBody returns the value of Body.
+func (s *UpdateTaskCommentRequest) GetBody() string {
+	return s.Body
 }
 
-// SetData sets the value of Data.
-func (s *UpdateInstanceResponse) SetData(val InstanceFull) {
-	s.Data = val
+// SetBody sets the value of Body.
+func (s *UpdateTaskCommentRequest) SetBody(val string) {
+	s.Body = val
 }
 
-func (*UpdateInstanceResponse) updateInstanceByIdRes() {}
+type UpdateTaskCommentUnauthorized ErrorContent
 
-type UpdateItemBadRequest ErrorContent
+func (*UpdateTaskCommentUnauthorized) updateTaskCommentRes() {}
 
-func (*UpdateItemBadRequest) updateItemRes() {}
+type UpdateTaskTemplateBadRequest ErrorContent
 
-type UpdateItemForbidden ErrorContent
+func (*UpdateTaskTemplateBadRequest) updateTaskTemplateRes() {}
 
-func (*UpdateItemForbidden) updateItemRes() {}
+type UpdateTaskTemplateForbidden ErrorContent
 
-type UpdateItemNotFound ErrorContent
+func (*UpdateTaskTemplateForbidden) updateTaskTemplateRes() {}
 
-func (*UpdateItemNotFound) updateItemRes() {}
+type UpdateTaskTemplateNotFound ErrorContent
 
-// Ref: #/components/schemas/UpdateItemRequest
-type UpdateItemRequest struct {
-	ID          OptUUID      `json:"id"`
-	Name        string       `json:"name"`
-	Description OptNilString `json:"description"`
+func (*UpdateTaskTemplateNotFound) updateTaskTemplateRes() {}
+
+// Ref: #/components/schemas/UpdateTaskTemplateRequest
+type UpdateTaskTemplateRequest struct {
+	UnitId      uuid.UUID                            `json:"unitId"`
+	Name        string                               `json:"name"`
+	Description OptNilString                         `json:"description"`
+	Type        UpdateTaskTemplateRequestType        `json:"type"`
+	Priority    OptUpdateTaskTemplateRequestPriority `json:"priority"`
+	// Assignee of created tasks.
+	AssignedToUserId OptNilUUID `json:"assignedToUserId"`
+	// Due date of created tasks relative to their creation.
+	DueInMinutes OptNilInt `json:"dueInMinutes"`
+	// Five field cron expression (minute, hour, day of month, month, day of week) evaluated in UTC.
+	// Tasks are created only manually when omitted.
+	Schedule OptNilString `json:"schedule"`
+	// Inactive templates are skipped by the scheduler.
+	IsActive OptBool            `json:"isActive"`
+	Rules    []TaskTemplateRule `json:"rules"`
 }
 
-// GetID returns the value of ID.
-func (s *UpdateItemRequest) GetID() OptUUID {
-	return s.ID
+// GetUnitId returns the value of UnitId.
+func (s *UpdateTaskTemplateRequest) GetUnitId() uuid.UUID {
+	return s.UnitId
 }
 
 // GetName returns the value of Name.
-func (s *UpdateItemRequest) GetName() string {
+func (s *UpdateTaskTemplateRequest) GetName() string {
 	return s.Name
 }
 
 // GetDescription returns the value of Description.
-func (s *UpdateItemRequest) GetDescription() OptNilString {
+func (s *UpdateTaskTemplateRequest) GetDescription() OptNilString {
 	return s.Description
 }
 
-// SetID sets the value of ID.
-func (s *UpdateItemRequest) SetID(val OptUUID) {
-	s.ID = val
+// GetType returns the value of Type.
+func (s *UpdateTaskTemplateRequest) GetType() UpdateTaskTemplateRequestType {
+	return s.Type
 }
 
-// SetName sets the value of Name.
-func (s *UpdateItemRequest) SetName(val string) {
-	s.Name = val
+// GetPriority returns the value of Priority.
+func (s *UpdateTaskTemplateRequest) GetPriority() OptUpdateTaskTemplateRequestPriority {
+	return s.Priority
 }
 
-// SetDescription sets the value of Description.
-func (s *UpdateItemRequest) SetDescription(val OptNilString) {
-	s.Description = val
+// GetAssignedToUserId returns the value of AssignedToUserId.
+func (s *UpdateTaskTemplateRequest) GetAssignedToUserId() OptNilUUID {
+	return s.AssignedToUserId
 }
 
-// Ref: #/components/schemas/UpdateItemResponse
-type UpdateItemResponse struct {
-	Data ItemFull `json:"data"`
+// GetDueInMinutes returns the value of DueInMinutes.
+func (s *UpdateTaskTemplateRequest) GetDueInMinutes() OptNilInt {
+	return s.DueInMinutes
 }
 
-// GetData returns the value of Data.
-func (s *UpdateItemResponse) GetData() ItemFull {
-	return s.Data
+// GetSchedule returns the value of Schedule.
+func (s *UpdateTaskTemplateRequest) GetSchedule() OptNilString {
+	return s.Schedule
 }
 
-// SetData sets the value of Data.
-func (s *UpdateItemResponse) SetData(val ItemFull) {
-	s.Data = val
+// GetIsActive returns the value of IsActive.
+func (s *UpdateTaskTemplateRequest) GetIsActive() OptBool {
+	return s.IsActive
 }
 
-func (*UpdateItemResponse) updateItemRes() {}
+// GetRules returns the value of Rules.
+func (s *UpdateTaskTemplateRequest) GetRules() []TaskTemplateRule {
+	return s.Rules
+}
 
-type UpdateItemVariantBadRequest ErrorContent
+// SetUnitId sets the value of UnitId.
+func (s *UpdateTaskTemplateRequest) SetUnitId(val uuid.UUID) {
+	s.UnitId = val
+}
 
-func (*UpdateItemVariantBadRequest) updateItemVariantRes() {}
+// SetName sets the value of Name.
+func (s *UpdateTaskTemplateRequest) SetName(val string) {
+	s.Name = val
+}
 
-type UpdateItemVariantForbidden ErrorContent
+// SetDescription sets the value of Description.
+func (s *UpdateTaskTemplateRequest) SetDescription(val OptNilString) {
+	s.Description = val
+}
 
-func (*UpdateItemVariantForbidden) updateItemVariantRes() {}
+// SetType sets the value of Type.
+func (s *UpdateTaskTemplateRequest) SetType(val UpdateTaskTemplateRequestType) {
+	s.Type = val
+}
 
-type UpdateItemVariantNotFound ErrorContent
+// SetPriority sets the value of Priority.
+func (s *UpdateTaskTemplateRequest) SetPriority(val OptUpdateTaskTemplateRequestPriority) {
+	s.Priority = val
+}
 
-func (*UpdateItemVariantNotFound) updateItemVariantRes() {}
+// SetAssignedToUserId sets the value of AssignedToUserId.
+func (s *UpdateTaskTemplateRequest) SetAssignedToUserId(val OptNilUUID) {
+	s.AssignedToUserId = val
+}
 
-// Ref: #/components/schemas/UpdateItemVariantRequest
-type UpdateItemVariantRequest struct {
-	Name    string       `json:"name"`
-	Article OptNilString `json:"article"`
-	Ean13   OptNilInt64  `json:"ean13"`
+// SetDueInMinutes sets the value of DueInMinutes.
+func (s *UpdateTaskTemplateRequest) SetDueInMinutes(val OptNilInt) {
+	s.DueInMinutes = val
 }
 
-// GetName returns the value of Name.
-func (s *UpdateItemVariantRequest) GetName() string {
-	return s.Name
+// SetSchedule sets the value of Schedule.
+func (s *UpdateTaskTemplateRequest) SetSchedule(val OptNilString) {
+	s.Schedule = val
 }
 
-// GetArticle returns the value of Article.
-func (s *UpdateItemVariantRequest) GetArticle() OptNilString {
-	return s.Article
+// SetIsActive sets the value of IsActive.
+func (s *UpdateTaskTemplateRequest) SetIsActive(val OptBool) {
+	s.IsActive = val
 }
 
-// GetEan13 returns the value of Ean13.
-func (s *UpdateItemVariantRequest) GetEan13() OptNilInt64 {
-	return s.Ean13
+// SetRules sets the value of Rules.
+func (s *UpdateTaskTemplateRequest) SetRules(val []TaskTemplateRule) {
+	s.Rules = val
 }
 
-// SetName sets the value of Name.
-func (s *UpdateItemVariantRequest) SetName(val string) {
-	s.Name = val
+type UpdateTaskTemplateRequestPriority string
+
+const (
+	UpdateTaskTemplateRequestPriorityLow    UpdateTaskTemplateRequestPriority = "low"
+	UpdateTaskTemplateRequestPriorityNormal UpdateTaskTemplateRequestPriority = "normal"
+	UpdateTaskTemplateRequestPriorityHigh   UpdateTaskTemplateRequestPriority = "high"
+	UpdateTaskTemplateRequestPriorityUrgent UpdateTaskTemplateRequestPriority = "urgent"
+)
+
+// AllValues returns all UpdateTaskTemplateRequestPriority values.
+func (UpdateTaskTemplateRequestPriority) AllValues() []UpdateTaskTemplateRequestPriority {
+	return []UpdateTaskTemplateRequestPriority{
+		UpdateTaskTemplateRequestPriorityLow,
+		UpdateTaskTemplateRequestPriorityNormal,
+		UpdateTaskTemplateRequestPriorityHigh,
+		UpdateTaskTemplateRequestPriorityUrgent,
+	}
 }
 
-// SetArticle sets the value of Article.
-func (s *UpdateItemVariantRequest) SetArticle(val OptNilString) {
-	s.Article = val
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateTaskTemplateRequestPriority) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateTaskTemplateRequestPriorityLow:
+		return []byte(s), nil
+	case UpdateTaskTemplateRequestPriorityNormal:
+		return []byte(s), nil
+	case UpdateTaskTemplateRequestPriorityHigh:
+		return []byte(s), nil
+	case UpdateTaskTemplateRequestPriorityUrgent:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetEan13 sets the value of Ean13.
-func (s *UpdateItemVariantRequest) SetEan13(val OptNilInt64) {
-	s.Ean13 = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateTaskTemplateRequestPriority) UnmarshalText(data []byte) error {
+	switch UpdateTaskTemplateRequestPriority(data) {
+	case UpdateTaskTemplateRequestPriorityLow:
+		*s = UpdateTaskTemplateRequestPriorityLow
+		return nil
+	case UpdateTaskTemplateRequestPriorityNormal:
+		*s = UpdateTaskTemplateRequestPriorityNormal
+		return nil
+	case UpdateTaskTemplateRequestPriorityHigh:
+		*s = UpdateTaskTemplateRequestPriorityHigh
+		return nil
+	case UpdateTaskTemplateRequestPriorityUrgent:
+		*s = UpdateTaskTemplateRequestPriorityUrgent
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// Ref: #/components/schemas/UpdateItemVariantResponse
-type UpdateItemVariantResponse struct {
-	Data ItemVariant `json:"data"`
+type UpdateTaskTemplateRequestType string
+
+const (
+	UpdateTaskTemplateRequestTypePickment UpdateTaskTemplateRequestType = "pickment"
+	UpdateTaskTemplateRequestTypeMovement UpdateTaskTemplateRequestType = "movement"
+)
+
+// AllValues returns all UpdateTaskTemplateRequestType values.
+func (UpdateTaskTemplateRequestType) AllValues() []UpdateTaskTemplateRequestType {
+	return []UpdateTaskTemplateRequestType{
+		UpdateTaskTemplateRequestTypePickment,
+		UpdateTaskTemplateRequestTypeMovement,
+	}
 }
 
-// GetData returns the value of Data.
-func (s *UpdateItemVariantResponse) GetData() ItemVariant {
-	return s.Data
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateTaskTemplateRequestType) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateTaskTemplateRequestTypePickment:
+		return []byte(s), nil
+	case UpdateTaskTemplateRequestTypeMovement:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetData sets the value of Data.
-func (s *UpdateItemVariantResponse) SetData(val ItemVariant) {
-	s.Data = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateTaskTemplateRequestType) UnmarshalText(data []byte) error {
+	switch UpdateTaskTemplateRequestType(data) {
+	case UpdateTaskTemplateRequestTypePickment:
+		*s = UpdateTaskTemplateRequestTypePickment
+		return nil
+	case UpdateTaskTemplateRequestTypeMovement:
+		*s = UpdateTaskTemplateRequestTypeMovement
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-func (*UpdateItemVariantResponse) updateItemVariantRes() {}
+type UpdateTaskTemplateUnauthorized ErrorContent
 
-type UpdateItemVariantUnauthorized ErrorContent
+func (*UpdateTaskTemplateUnauthorized) updateTaskTemplateRes() {}
 
-func (*UpdateItemVariantUnauthorized) updateItemVariantRes() {}
+type UploadTaskAttachmentBadRequest ErrorContent
 
-type UpdateOrganizationBadRequest ErrorContent
+func (*UploadTaskAttachmentBadRequest) uploadTaskAttachmentRes() {}
 
-func (*UpdateOrganizationBadRequest) updateOrganizationRes() {}
+type UploadTaskAttachmentForbidden ErrorContent
 
-type UpdateOrganizationForbidden ErrorContent
+func (*UploadTaskAttachmentForbidden) uploadTaskAttachmentRes() {}
 
-func (*UpdateOrganizationForbidden) updateOrganizationRes() {}
+type UploadTaskAttachmentNotFound ErrorContent
 
-type UpdateOrganizationNotFound ErrorContent
+func (*UploadTaskAttachmentNotFound) uploadTaskAttachmentRes() {}
 
-func (*UpdateOrganizationNotFound) updateOrganizationRes() {}
+// Ref: #/components/schemas/UploadTaskAttachmentRequest
+type UploadTaskAttachmentRequestMultipart struct {
+	// Photo in JPEG, PNG, GIF or WebP format.
+	File ht.MultipartFile `json:"file"`
+	// Instance of the task item to attach the file to.
+	InstanceId OptUUID `json:"instanceId"`
+	// Comment of the task the file illustrates.
+	CommentId OptUUID `json:"commentId"`
+}
 
-// Ref: #/components/schemas/UpdateOrganizationResponse
-type UpdateOrganizationResponse struct {
-	Data Organization `json:"data"`
+// GetFile returns the value of File.
+func (s *UploadTaskAttachmentRequestMultipart) GetFile() ht.MultipartFile {
+	return s.File
 }
 
-// GetData returns the value of Data.
-func (s *UpdateOrganizationResponse) GetData() Organization {
-	return s.Data
+// GetInstanceId returns the value of InstanceId.
+func (s *UploadTaskAttachmentRequestMultipart) GetInstanceId() OptUUID {
+	return s.InstanceId
 }
 
-// SetData sets the value of Data.
-func (s *UpdateOrganizationResponse) SetData(val Organization) {
-	s.Data = val
+// GetCommentId returns the value of CommentId.
+func (s *UploadTaskAttachmentRequestMultipart) GetCommentId() OptUUID {
+	return s.CommentId
 }
 
-func (*UpdateOrganizationResponse) updateOrganizationRes() {}
+// SetFile sets the value of File.
+func (s *UploadTaskAttachmentRequestMultipart) SetFile(val ht.MultipartFile) {
+	s.File = val
+}
 
-type UpdateOrganizationUnauthorized ErrorContent
+// SetInstanceId sets the value of InstanceId.
+func (s *UploadTaskAttachmentRequestMultipart) SetInstanceId(val OptUUID) {
+	s.InstanceId = val
+}
 
-func (*UpdateOrganizationUnauthorized) updateOrganizationRes() {}
+// SetCommentId sets the value of CommentId.
+func (s *UploadTaskAttachmentRequestMultipart) SetCommentId(val OptUUID) {
+	s.CommentId = val
+}
 
-type UpdateOrganizationUnitBadRequest ErrorContent
+type UploadTaskAttachmentUnauthorized ErrorContent
 
-func (*UpdateOrganizationUnitBadRequest) updateOrganizationUnitRes() {}
+func (*UploadTaskAttachmentUnauthorized) uploadTaskAttachmentRes() {}
 
-type UpdateOrganizationUnitForbidden ErrorContent
+// Merged schema.
+// Ref: #/components/schemas/WorkerProfile
+type WorkerProfile struct {
+	UserId    uuid.UUID `json:"userId"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Units the worker takes tasks in.
+	UnitIds []uuid.UUID `json:"unitIds"`
+	// Task types the worker is qualified for, used by the skills assignment strategy.
+	Skills  []WorkerProfileSkillsItem `json:"skills"`
+	OnShift bool                      `json:"onShift"`
+}
 
-func (*UpdateOrganizationUnitForbidden) updateOrganizationUnitRes() {}
+// GetUserId returns the value of UserId.
+func (s *WorkerProfile) GetUserId() uuid.UUID {
+	return s.UserId
+}
 
-type UpdateOrganizationUnitNotFound ErrorContent
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *WorkerProfile) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
 
-func (*UpdateOrganizationUnitNotFound) updateOrganizationUnitRes() {}
+// GetUnitIds returns the value of UnitIds.
+func (s *WorkerProfile) GetUnitIds() []uuid.UUID {
+	return s.UnitIds
+}
 
-// Ref: #/components/schemas/UpdateOrganizationUnitResponse
-type UpdateOrganizationUnitResponse struct {
-	Data Unit `json:"data"`
+// GetSkills returns the value of Skills.
+func (s *WorkerProfile) GetSkills() []WorkerProfileSkillsItem {
+	return s.Skills
 }
 
-// GetData returns the value of Data.
-func (s *UpdateOrganizationUnitResponse) GetData() Unit {
-	return s.Data
+// GetOnShift returns the value of OnShift.
+func (s *WorkerProfile) GetOnShift() bool {
+	return s.OnShift
 }
 
-// SetData sets the value of Data.
-func (s *UpdateOrganizationUnitResponse) SetData(val Unit) {
-	s.Data = val
+// SetUserId sets the value of UserId.
+func (s *WorkerProfile) SetUserId(val uuid.UUID) {
+	s.UserId = val
 }
 
-func (*UpdateOrganizationUnitResponse) updateOrganizationUnitRes() {}
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *WorkerProfile) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
 
-type UpdateOrganizationUnitUnauthorized ErrorContent
+// SetUnitIds sets the value of UnitIds.
+func (s *WorkerProfile) SetUnitIds(val []uuid.UUID) {
+	s.UnitIds = val
+}
 
-func (*UpdateOrganizationUnitUnauthorized) updateOrganizationUnitRes() {}
+// SetSkills sets the value of Skills.
+func (s *WorkerProfile) SetSkills(val []WorkerProfileSkillsItem) {
+	s.Skills = val
+}
 
-type UpdateStorageGroupBadRequest ErrorContent
+// SetOnShift sets the value of OnShift.
+func (s *WorkerProfile) SetOnShift(val bool) {
+	s.OnShift = val
+}
 
-func (*UpdateStorageGroupBadRequest) updateStorageGroupRes() {}
+// Ref: #/components/schemas/WorkerProfileBase
+type WorkerProfileBase struct {
+	// Units the worker takes tasks in.
+	UnitIds []uuid.UUID `json:"unitIds"`
+	// Task types the worker is qualified for, used by the skills assignment strategy.
+	Skills  []WorkerProfileBaseSkillsItem `json:"skills"`
+	OnShift bool                          `json:"onShift"`
+}
 
-type UpdateStorageGroupForbidden ErrorContent
+// GetUnitIds returns the value of UnitIds.
+func (s *WorkerProfileBase) GetUnitIds() []uuid.UUID {
+	return s.UnitIds
+}
 
-func (*UpdateStorageGroupForbidden) updateStorageGroupRes() {}
+// GetSkills returns the value of Skills.
+func (s *WorkerProfileBase) GetSkills() []WorkerProfileBaseSkillsItem {
+	return s.Skills
+}
 
-type UpdateStorageGroupNotFound ErrorContent
+// GetOnShift returns the value of OnShift.
+func (s *WorkerProfileBase) GetOnShift() bool {
+	return s.OnShift
+}
 
-func (*UpdateStorageGroupNotFound) updateStorageGroupRes() {}
+// SetUnitIds sets the value of UnitIds.
+func (s *WorkerProfileBase) SetUnitIds(val []uuid.UUID) {
+	s.UnitIds = val
+}
 
-// Ref: #/components/schemas/UpdateStorageGroupResponse
-type UpdateStorageGroupResponse struct {
-	Data StorageGroup `json:"data"`
+// SetSkills sets the value of Skills.
+func (s *WorkerProfileBase) SetSkills(val []WorkerProfileBaseSkillsItem) {
+	s.Skills = val
 }
 
-// GetData returns the value of Data.
-func (s *UpdateStorageGroupResponse) GetData() StorageGroup {
-	return s.Data
+// SetOnShift sets the value of OnShift.
+func (s *WorkerProfileBase) SetOnShift(val bool) {
+	s.OnShift = val
 }
 
-// SetData sets the value of Data.
-func (s *UpdateStorageGroupResponse) SetData(val StorageGroup) {
-	s.Data = val
+type WorkerProfileBaseSkillsItem string
+
+const (
+	WorkerProfileBaseSkillsItemPickment       WorkerProfileBaseSkillsItem = "pickment"
+	WorkerProfileBaseSkillsItemMovement       WorkerProfileBaseSkillsItem = "movement"
+	WorkerProfileBaseSkillsItemReceiving      WorkerProfileBaseSkillsItem = "receiving"
+	WorkerProfileBaseSkillsItemInventoryCount WorkerProfileBaseSkillsItem = "inventory_count"
+	WorkerProfileBaseSkillsItemReturn         WorkerProfileBaseSkillsItem = "return"
+)
+
+// AllValues returns all WorkerProfileBaseSkillsItem values.
+func (WorkerProfileBaseSkillsItem) AllValues() []WorkerProfileBaseSkillsItem {
+	return []WorkerProfileBaseSkillsItem{
+		WorkerProfileBaseSkillsItemPickment,
+		WorkerProfileBaseSkillsItemMovement,
+		WorkerProfileBaseSkillsItemReceiving,
+		WorkerProfileBaseSkillsItemInventoryCount,
+		WorkerProfileBaseSkillsItemReturn,
+	}
 }
 
-func (*UpdateStorageGroupResponse) updateStorageGroupRes() {}
+// MarshalText implements encoding.TextMarshaler.
+func (s WorkerProfileBaseSkillsItem) MarshalText() ([]byte, error) {
+	switch s {
+	case WorkerProfileBaseSkillsItemPickment:
+		return []byte(s), nil
+	case WorkerProfileBaseSkillsItemMovement:
+		return []byte(s), nil
+	case WorkerProfileBaseSkillsItemReceiving:
+		return []byte(s), nil
+	case WorkerProfileBaseSkillsItemInventoryCount:
+		return []byte(s), nil
+	case WorkerProfileBaseSkillsItemReturn:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-type UpdateStorageGroupUnauthorized ErrorContent
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WorkerProfileBaseSkillsItem) UnmarshalText(data []byte) error {
+	switch WorkerProfileBaseSkillsItem(data) {
+	case WorkerProfileBaseSkillsItemPickment:
+		*s = WorkerProfileBaseSkillsItemPickment
+		return nil
+	case WorkerProfileBaseSkillsItemMovement:
+		*s = WorkerProfileBaseSkillsItemMovement
+		return nil
+	case WorkerProfileBaseSkillsItemReceiving:
+		*s = WorkerProfileBaseSkillsItemReceiving
+		return nil
+	case WorkerProfileBaseSkillsItemInventoryCount:
+		*s = WorkerProfileBaseSkillsItemInventoryCount
+		return nil
+	case WorkerProfileBaseSkillsItemReturn:
+		*s = WorkerProfileBaseSkillsItemReturn
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-func (*UpdateStorageGroupUnauthorized) updateStorageGroupRes() {}
+type WorkerProfileSkillsItem string
+
+const (
+	WorkerProfileSkillsItemPickment       WorkerProfileSkillsItem = "pickment"
+	WorkerProfileSkillsItemMovement       WorkerProfileSkillsItem = "movement"
+	WorkerProfileSkillsItemReceiving      WorkerProfileSkillsItem = "receiving"
+	WorkerProfileSkillsItemInventoryCount WorkerProfileSkillsItem = "inventory_count"
+	WorkerProfileSkillsItemReturn         WorkerProfileSkillsItem = "return"
+)
+
+// AllValues returns all WorkerProfileSkillsItem values.
+func (WorkerProfileSkillsItem) AllValues() []WorkerProfileSkillsItem {
+	return []WorkerProfileSkillsItem{
+		WorkerProfileSkillsItemPickment,
+		WorkerProfileSkillsItemMovement,
+		WorkerProfileSkillsItemReceiving,
+		WorkerProfileSkillsItemInventoryCount,
+		WorkerProfileSkillsItemReturn,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WorkerProfileSkillsItem) MarshalText() ([]byte, error) {
+	switch s {
+	case WorkerProfileSkillsItemPickment:
+		return []byte(s), nil
+	case WorkerProfileSkillsItemMovement:
+		return []byte(s), nil
+	case WorkerProfileSkillsItemReceiving:
+		return []byte(s), nil
+	case WorkerProfileSkillsItemInventoryCount:
+		return []byte(s), nil
+	case WorkerProfileSkillsItemReturn:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WorkerProfileSkillsItem) UnmarshalText(data []byte) error {
+	switch WorkerProfileSkillsItem(data) {
+	case WorkerProfileSkillsItemPickment:
+		*s = WorkerProfileSkillsItemPickment
+		return nil
+	case WorkerProfileSkillsItemMovement:
+		*s = WorkerProfileSkillsItemMovement
+		return nil
+	case WorkerProfileSkillsItemReceiving:
+		*s = WorkerProfileSkillsItemReceiving
+		return nil
+	case WorkerProfileSkillsItemInventoryCount:
+		*s = WorkerProfileSkillsItemInventoryCount
+		return nil
+	case WorkerProfileSkillsItemReturn:
+		*s = WorkerProfileSkillsItemReturn
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
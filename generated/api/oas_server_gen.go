@@ -8,6 +8,48 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// ApplyInventoryCountAdjustments implements applyInventoryCountAdjustments operation.
+	//
+	// Apply inventory count adjustments and complete the task.
+	//
+	// POST /tasks/{id}/count-adjustments
+	ApplyInventoryCountAdjustments(ctx context.Context, params ApplyInventoryCountAdjustmentsParams) (ApplyInventoryCountAdjustmentsRes, error)
+	// AssignTask implements assignTask operation.
+	//
+	// Assign task to employee.
+	//
+	// POST /tasks/{id}/assign
+	AssignTask(ctx context.Context, req *AssignTaskRequest, params AssignTaskParams) (AssignTaskRes, error)
+	// CancelPickWave implements cancelPickWave operation.
+	//
+	// Cancel a pick wave and release its tasks.
+	//
+	// POST /waves/{id}/cancel
+	CancelPickWave(ctx context.Context, params CancelPickWaveParams) (CancelPickWaveRes, error)
+	// CancelTask implements cancelTask operation.
+	//
+	// Cancel task.
+	//
+	// POST /tasks/{id}/cancel
+	CancelTask(ctx context.Context, req OptTaskStatusChangeRequest, params CancelTaskParams) (CancelTaskRes, error)
+	// CancelTransferOrder implements cancelTransferOrder operation.
+	//
+	// Cancel a pending transfer order and its outbound leg.
+	//
+	// POST /transfer-orders/{id}/cancel
+	CancelTransferOrder(ctx context.Context, req OptTaskStatusChangeRequest, params CancelTransferOrderParams) (CancelTransferOrderRes, error)
+	// ClaimTask implements claimTask operation.
+	//
+	// Assign task to current user.
+	//
+	// POST /tasks/{id}/claim
+	ClaimTask(ctx context.Context, params ClaimTaskParams) (ClaimTaskRes, error)
+	// CompletePickWave implements completePickWave operation.
+	//
+	// Complete a sorted pick wave and mark its tasks as ready.
+	//
+	// POST /waves/{id}/complete
+	CompletePickWave(ctx context.Context, params CompletePickWaveParams) (CompletePickWaveRes, error)
 	// CreateApiToken implements createApiToken operation.
 	//
 	// Create Service API Token.
@@ -50,6 +92,18 @@ type Handler interface {
 	//
 	// POST /orgs
 	CreateOrganization(ctx context.Context, req *CreateOrganizationRequest) (CreateOrganizationRes, error)
+	// CreatePickWave implements createPickWave operation.
+	//
+	// Group pickment tasks of a unit into a pick wave.
+	//
+	// POST /waves
+	CreatePickWave(ctx context.Context, req *CreatePickWaveRequest) (CreatePickWaveRes, error)
+	// CreateStockLine implements createStockLine operation.
+	//
+	// Put a quantity of a quantity tracked variant into a cell.
+	//
+	// POST /stock-lines
+	CreateStockLine(ctx context.Context, req *CreateStockLineRequest) (CreateStockLineRes, error)
 	// CreateStorageGroup implements createStorageGroup operation.
 	//
 	// Create Storage Group.
@@ -62,6 +116,26 @@ type Handler interface {
 	//
 	// POST /tasks
 	CreateTask(ctx context.Context, req *CreateTaskRequest) (CreateTaskRes, error)
+	// CreateTaskComment implements createTaskComment operation.
+	//
+	// Leave a comment on a task or one of its items.
+	//
+	// POST /tasks/{id}/comments
+	CreateTaskComment(ctx context.Context, req *CreateTaskCommentRequest, params CreateTaskCommentParams) (CreateTaskCommentRes, error)
+	// CreateTaskTemplate implements createTaskTemplate operation.
+	//
+	// Create task template, optionally run on a cron schedule.
+	//
+	// POST /task-templates
+	CreateTaskTemplate(ctx context.Context, req *CreateTaskTemplateRequest) (CreateTaskTemplateRes, error)
+	// CreateTransferOrder implements createTransferOrder operation.
+	//
+	// Creates the order with its outbound leg, a pickment task of the source unit. Completing the
+	// outbound leg puts the picked instances in transit and creates the inbound leg, a receiving task of
+	// the destination unit.
+	//
+	// POST /transfer-orders
+	CreateTransferOrder(ctx context.Context, req *CreateTransferOrderRequest) (CreateTransferOrderRes, error)
 	// CreateTvBoard implements createTvBoard operation.
 	//
 	// Create TV Board.
@@ -128,12 +202,36 @@ type Handler interface {
 	//
 	// DELETE /storage-groups/{id}
 	DeleteStorageGroup(ctx context.Context, params DeleteStorageGroupParams) (DeleteStorageGroupRes, error)
+	// DeleteTaskAttachment implements deleteTaskAttachment operation.
+	//
+	// Delete an attachment, allowed to its uploader and managers.
+	//
+	// DELETE /tasks/{id}/attachments/{attachmentId}
+	DeleteTaskAttachment(ctx context.Context, params DeleteTaskAttachmentParams) (DeleteTaskAttachmentRes, error)
+	// DeleteTaskComment implements deleteTaskComment operation.
+	//
+	// Delete a comment, allowed to its author and managers.
+	//
+	// DELETE /tasks/{id}/comments/{commentId}
+	DeleteTaskComment(ctx context.Context, params DeleteTaskCommentParams) (DeleteTaskCommentRes, error)
+	// DeleteTaskTemplate implements deleteTaskTemplate operation.
+	//
+	// Delete task template.
+	//
+	// DELETE /task-templates/{id}
+	DeleteTaskTemplate(ctx context.Context, params DeleteTaskTemplateParams) (DeleteTaskTemplateRes, error)
 	// DeleteTvBoard implements deleteTvBoard operation.
 	//
 	// Delete TV Board.
 	//
 	// DELETE /tv-boards/{id}
 	DeleteTvBoard(ctx context.Context, params DeleteTvBoardParams) (DeleteTvBoardRes, error)
+	// DownloadTaskAttachment implements downloadTaskAttachment operation.
+	//
+	// Download the content of an attachment.
+	//
+	// GET /tasks/{id}/attachments/{attachmentId}
+	DownloadTaskAttachment(ctx context.Context, params DownloadTaskAttachmentParams) (DownloadTaskAttachmentRes, error)
 	// ExchangeYandexAccessToken implements exchangeYandexAccessToken operation.
 	//
 	// Exchange Yandex Access token for Session token.
@@ -194,6 +292,13 @@ type Handler interface {
 	//
 	// GET /employees
 	GetEmployees(ctx context.Context) (GetEmployeesRes, error)
+	// GetExpiringInstances implements getExpiringInstances operation.
+	//
+	// Lists available and reserved instances expiring within the given number of days, already expired
+	// ones included, soonest first.
+	//
+	// GET /stock/expiring
+	GetExpiringInstances(ctx context.Context, params GetExpiringInstancesParams) (GetExpiringInstancesRes, error)
 	// GetInstanceById implements getInstanceById operation.
 	//
 	// Get Instance by ID.
@@ -202,16 +307,23 @@ type Handler interface {
 	GetInstanceById(ctx context.Context, params GetInstanceByIdParams) (GetInstanceByIdRes, error)
 	// GetInstances implements getInstances operation.
 	//
-	// Get list of Instances.
+	// Lists every instance of the organization, or the instances matching a serial number when
+	// serial_number or search is given.
 	//
 	// GET /instances
-	GetInstances(ctx context.Context) (GetInstancesRes, error)
+	GetInstances(ctx context.Context, params GetInstancesParams) (GetInstancesRes, error)
 	// GetInstancesByItemId implements getInstancesByItemId operation.
 	//
 	// Get list of Instances For Item.
 	//
 	// GET /items/{itemId}/instances
 	GetInstancesByItemId(ctx context.Context, params GetInstancesByItemIdParams) (GetInstancesByItemIdRes, error)
+	// GetInventoryCountReport implements getInventoryCountReport operation.
+	//
+	// Get inventory count reconciliation report.
+	//
+	// GET /tasks/{id}/count-report
+	GetInventoryCountReport(ctx context.Context, params GetInventoryCountReportParams) (GetInventoryCountReportRes, error)
 	// GetItemById implements getItemById operation.
 	//
 	// Get Item by ID.
@@ -236,6 +348,12 @@ type Handler interface {
 	//
 	// GET /items
 	GetItems(ctx context.Context) (GetItemsRes, error)
+	// GetMyTasks implements getMyTasks operation.
+	//
+	// Get open tasks assigned to current user.
+	//
+	// GET /me/tasks
+	GetMyTasks(ctx context.Context) (GetMyTasksRes, error)
 	// GetOrganizationById implements getOrganizationById operation.
 	//
 	// Get Organization by ID.
@@ -260,12 +378,44 @@ type Handler interface {
 	//
 	// GET /orgs
 	GetOrganizations(ctx context.Context) (GetOrganizationsRes, error)
+	// GetPickWaveById implements getPickWaveById operation.
+	//
+	// Get pick wave with its tasks and combined pick list.
+	//
+	// GET /waves/{id}
+	GetPickWaveById(ctx context.Context, params GetPickWaveByIdParams) (GetPickWaveByIdRes, error)
+	// GetPickWaves implements getPickWaves operation.
+	//
+	// Get pick waves of the organization.
+	//
+	// GET /waves
+	GetPickWaves(ctx context.Context) (GetPickWavesRes, error)
 	// GetRoles implements getRoles operation.
 	//
 	// Get all roles in system.
 	//
 	// GET /app/roles
 	GetRoles(ctx context.Context) (GetRolesRes, error)
+	// GetStockLevels implements getStockLevels operation.
+	//
+	// Counts instances per variant and rolls them up through the storage hierarchy to the requested
+	// level. Nested storage groups include the stock of their subgroups. Instances outside of cells are
+	// only counted per unit.
+	//
+	// GET /stock
+	GetStockLevels(ctx context.Context, params GetStockLevelsParams) (GetStockLevelsRes, error)
+	// GetStockLineById implements getStockLineById operation.
+	//
+	// Get stock line by ID.
+	//
+	// GET /stock-lines/{id}
+	GetStockLineById(ctx context.Context, params GetStockLineByIdParams) (GetStockLineByIdRes, error)
+	// GetStockLines implements getStockLines operation.
+	//
+	// Get stock lines of quantity tracked variants.
+	//
+	// GET /stock-lines
+	GetStockLines(ctx context.Context, params GetStockLinesParams) (GetStockLinesRes, error)
 	// GetStorageGroupById implements getStorageGroupById operation.
 	//
 	// Get Storage Group by ID.
@@ -278,18 +428,78 @@ type Handler interface {
 	//
 	// GET /storage-groups
 	GetStorageGroups(ctx context.Context) (GetStorageGroupsRes, error)
+	// GetTaskAttachments implements getTaskAttachments operation.
+	//
+	// Get files attached to a task.
+	//
+	// GET /tasks/{id}/attachments
+	GetTaskAttachments(ctx context.Context, params GetTaskAttachmentsParams) (GetTaskAttachmentsRes, error)
 	// GetTaskById implements getTaskById operation.
 	//
 	// Get Task by ID.
 	//
 	// GET /tasks/{id}
 	GetTaskById(ctx context.Context, params GetTaskByIdParams) (GetTaskByIdRes, error)
+	// GetTaskComments implements getTaskComments operation.
+	//
+	// Get comments of a task.
+	//
+	// GET /tasks/{id}/comments
+	GetTaskComments(ctx context.Context, params GetTaskCommentsParams) (GetTaskCommentsRes, error)
+	// GetTaskExceptionsReport implements getTaskExceptionsReport operation.
+	//
+	// Report of task item exceptions, newest first.
+	//
+	// GET /task-exceptions
+	GetTaskExceptionsReport(ctx context.Context, params GetTaskExceptionsReportParams) (GetTaskExceptionsReportRes, error)
+	// GetTaskItemSubstitutes implements getTaskItemSubstitutes operation.
+	//
+	// Suggest available instances of the same variant to substitute a task item.
+	//
+	// GET /tasks/{id}/items/{instanceId}/substitutes
+	GetTaskItemSubstitutes(ctx context.Context, params GetTaskItemSubstitutesParams) (GetTaskItemSubstitutesRes, error)
+	// GetTaskPickupCode implements getTaskPickupCode operation.
+	//
+	// Get pickup code of a ready pickment task.
+	//
+	// GET /tasks/{id}/pickup-code
+	GetTaskPickupCode(ctx context.Context, params GetTaskPickupCodeParams) (GetTaskPickupCodeRes, error)
+	// GetTaskStatusHistory implements getTaskStatusHistory operation.
+	//
+	// Get task status history.
+	//
+	// GET /tasks/{id}/history
+	GetTaskStatusHistory(ctx context.Context, params GetTaskStatusHistoryParams) (GetTaskStatusHistoryRes, error)
+	// GetTaskTemplateById implements getTaskTemplateById operation.
+	//
+	// Get task template by ID.
+	//
+	// GET /task-templates/{id}
+	GetTaskTemplateById(ctx context.Context, params GetTaskTemplateByIdParams) (GetTaskTemplateByIdRes, error)
+	// GetTaskTemplates implements getTaskTemplates operation.
+	//
+	// Get task templates of the organization.
+	//
+	// GET /task-templates
+	GetTaskTemplates(ctx context.Context) (GetTaskTemplatesRes, error)
 	// GetTasks implements getTasks operation.
 	//
 	// Get all tasks for organization.
 	//
 	// GET /tasks
-	GetTasks(ctx context.Context) (GetTasksRes, error)
+	GetTasks(ctx context.Context, params GetTasksParams) (GetTasksRes, error)
+	// GetTransferOrderById implements getTransferOrderById operation.
+	//
+	// Get transfer order with its instances.
+	//
+	// GET /transfer-orders/{id}
+	GetTransferOrderById(ctx context.Context, params GetTransferOrderByIdParams) (GetTransferOrderByIdRes, error)
+	// GetTransferOrders implements getTransferOrders operation.
+	//
+	// Get transfer orders of the organization, newest first.
+	//
+	// GET /transfer-orders
+	GetTransferOrders(ctx context.Context, params GetTransferOrdersParams) (GetTransferOrdersRes, error)
 	// GetTvBoards implements getTvBoards operation.
 	//
 	// Get list of TV Boards.
@@ -302,6 +512,31 @@ type Handler interface {
 	//
 	// GET /tv-boards/{tvToken}/data
 	GetTvBoardsData(ctx context.Context, params GetTvBoardsDataParams) (GetTvBoardsDataRes, error)
+	// GetWorkerProfile implements getWorkerProfile operation.
+	//
+	// Get worker profile of the employee.
+	//
+	// GET /employees/{id}/work-profile
+	GetWorkerProfile(ctx context.Context, params GetWorkerProfileParams) (GetWorkerProfileRes, error)
+	// ImportTasks implements importTasks operation.
+	//
+	// Every row is a line of a task, rows with the same name make up one task.
+	// A row takes either an instance id or a variant article with a quantity of
+	// available instances to pick. CSV files start with the header
+	// `name,type,unit,instance_id,article,quantity,target_cell`, JSON lines use
+	// the keys `name`, `type`, `unit`, `instanceId`, `article`, `quantity` and
+	// `targetCell`. Units and cells are referenced by alias, the type defaults to
+	// pickment. All rows are validated first. Nothing is created when any row is
+	// invalid, otherwise all tasks are created in one transaction.
+	//
+	// POST /tasks/import
+	ImportTasks(ctx context.Context, req ImportTasksReq, params ImportTasksParams) (ImportTasksRes, error)
+	// InspectReturnedInstance implements inspectReturnedInstance operation.
+	//
+	// Inspect a returned instance and restock, quarantine or dispose it.
+	//
+	// POST /tasks/{id}/inspect-return
+	InspectReturnedInstance(ctx context.Context, req *InspectReturnRequest, params InspectReturnedInstanceParams) (InspectReturnedInstanceRes, error)
 	// InviteEmployee implements inviteEmployee operation.
 	//
 	// Invite employee to the organization.
@@ -319,13 +554,20 @@ type Handler interface {
 	// Mark task as awaiting to collect.
 	//
 	// POST /tasks/{id}/ready
-	MarkTaskAsAwaiting(ctx context.Context, params MarkTaskAsAwaitingParams) (MarkTaskAsAwaitingRes, error)
+	MarkTaskAsAwaiting(ctx context.Context, req OptTaskStatusChangeRequest, params MarkTaskAsAwaitingParams) (MarkTaskAsAwaitingRes, error)
 	// MarkTaskAsCompleted implements markTaskAsCompleted operation.
 	//
-	// Mark task as completed.
+	// Tasks holding a pickup code are completed only when the code is presented. Failed attempts are
+	// limited, the code is locked once the limit is reached.
 	//
 	// POST /tasks/{id}/completed
-	MarkTaskAsCompleted(ctx context.Context, params MarkTaskAsCompletedParams) (MarkTaskAsCompletedRes, error)
+	MarkTaskAsCompleted(ctx context.Context, req OptCompleteTaskRequest, params MarkTaskAsCompletedParams) (MarkTaskAsCompletedRes, error)
+	// MergeStockLines implements mergeStockLines operation.
+	//
+	// Merge available stock lines of one variant in one cell.
+	//
+	// POST /stock-lines/merge
+	MergeStockLines(ctx context.Context, req *MergeStockLinesRequest) (MergeStockLinesRes, error)
 	// PatchEmployeeById implements patchEmployeeById operation.
 	//
 	// Update employee by id.
@@ -338,12 +580,112 @@ type Handler interface {
 	//
 	// POST /tasks/{id}/pick-instance
 	PickInstanceFromCell(ctx context.Context, req *PickInstanceFromCellReq, params PickInstanceFromCellParams) (PickInstanceFromCellRes, error)
+	// PickStockLineFromCell implements pickStockLineFromCell operation.
+	//
+	// Pick the quantity of a task item off its stock line.
+	//
+	// POST /tasks/{id}/pick-stock-line
+	PickStockLineFromCell(ctx context.Context, req *TaskStockLineRequest, params PickStockLineFromCellParams) (PickStockLineFromCellRes, error)
+	// PickWaveInstance implements pickWaveInstance operation.
+	//
+	// Pick an instance of the pick wave from its source cell.
+	//
+	// POST /waves/{id}/pick-instance
+	PickWaveInstance(ctx context.Context, req *PutItemInCellRequest, params PickWaveInstanceParams) (PickWaveInstanceRes, error)
+	// PutItemInTargetCell implements putItemInTargetCell operation.
+	//
+	// Put an item in target cell.
+	//
+	// POST /tasks/{id}/put-instance
+	PutItemInTargetCell(ctx context.Context, req *PutItemInCellRequest, params PutItemInTargetCellParams) (PutItemInTargetCellRes, error)
+	// PutStockLineInTargetCell implements putStockLineInTargetCell operation.
+	//
+	// Put a picked stock line into the target cell.
+	//
+	// POST /tasks/{id}/put-stock-line
+	PutStockLineInTargetCell(ctx context.Context, req *TaskStockLineRequest, params PutStockLineInTargetCellParams) (PutStockLineInTargetCellRes, error)
+	// ReassignTask implements reassignTask operation.
+	//
+	// Reassign task to another employee.
+	//
+	// POST /tasks/{id}/reassign
+	ReassignTask(ctx context.Context, req *AssignTaskRequest, params ReassignTaskParams) (ReassignTaskRes, error)
+	// ReceiveItems implements receiveItems operation.
+	//
+	// Receive items of a variant into the receiving cell.
+	//
+	// POST /tasks/{id}/receive
+	ReceiveItems(ctx context.Context, req *ReceiveItemsRequest, params ReceiveItemsParams) (ReceiveItemsRes, error)
+	// RegenerateTaskPickupCode implements regenerateTaskPickupCode operation.
+	//
+	// Replaces the current code of a ready pickment task and resets failed attempts.
+	//
+	// POST /tasks/{id}/pickup-code
+	RegenerateTaskPickupCode(ctx context.Context, params RegenerateTaskPickupCodeParams) (RegenerateTaskPickupCodeRes, error)
+	// ReportTaskItemException implements reportTaskItemException operation.
+	//
+	// Report a task item as not found, damaged or wrong and optionally substitute it.
+	//
+	// POST /tasks/{id}/exceptions
+	ReportTaskItemException(ctx context.Context, req *ReportTaskItemExceptionRequest, params ReportTaskItemExceptionParams) (ReportTaskItemExceptionRes, error)
+	// ResolveBarcode implements resolveBarcode operation.
+	//
+	// Finds the objects of the organization a scanned code stands for. GS1-128 codes, raw with the group
+	// separator for FNC1 or with parenthesized application identifiers, are resolved by their GTIN to
+	// variants and by the serial number to instances, and their lot and expiry data is returned. Other
+	// codes are matched against instance ids, variant EAN-13s and articles, cell aliases and TV board
+	// tokens.
+	//
+	// GET /barcodes/resolve
+	ResolveBarcode(ctx context.Context, params ResolveBarcodeParams) (ResolveBarcodeRes, error)
 	// RevokeApiToken implements revokeApiToken operation.
 	//
 	// Revoke Service API Token.
 	//
 	// DELETE /api-tokens/{id}
 	RevokeApiToken(ctx context.Context, params RevokeApiTokenParams) (RevokeApiTokenRes, error)
+	// RunTaskTemplate implements runTaskTemplate operation.
+	//
+	// Instances are selected by the template rules. The template schedule is not affected.
+	//
+	// POST /task-templates/{id}/run
+	RunTaskTemplate(ctx context.Context, params RunTaskTemplateParams) (RunTaskTemplateRes, error)
+	// SetMyShift implements setMyShift operation.
+	//
+	// Pending tasks of a worker going off shift are handed over to other workers.
+	//
+	// POST /me/shift
+	SetMyShift(ctx context.Context, req *SetMyShiftRequest) (SetMyShiftRes, error)
+	// SetWorkerProfile implements setWorkerProfile operation.
+	//
+	// Pending tasks of a worker going off shift are handed over to other workers.
+	//
+	// PUT /employees/{id}/work-profile
+	SetWorkerProfile(ctx context.Context, req *WorkerProfileBase, params SetWorkerProfileParams) (SetWorkerProfileRes, error)
+	// SortWaveInstance implements sortWaveInstance operation.
+	//
+	// Sort a picked instance to its task at the consolidation cell.
+	//
+	// POST /waves/{id}/sort-instance
+	SortWaveInstance(ctx context.Context, req *PutItemInCellRequest, params SortWaveInstanceParams) (SortWaveInstanceRes, error)
+	// SplitStockLine implements splitStockLine operation.
+	//
+	// Split a quantity off an available stock line into a new line in the same cell.
+	//
+	// POST /stock-lines/{id}/split
+	SplitStockLine(ctx context.Context, req *SplitStockLineRequest, params SplitStockLineParams) (SplitStockLineRes, error)
+	// SubmitInventoryCount implements submitInventoryCount operation.
+	//
+	// Replaces the previous submission for the same cell.
+	//
+	// POST /tasks/{id}/count
+	SubmitInventoryCount(ctx context.Context, req *SubmitCountRequest, params SubmitInventoryCountParams) (SubmitInventoryCountRes, error)
+	// UnassignTask implements unassignTask operation.
+	//
+	// Unassign task.
+	//
+	// POST /tasks/{id}/unassign
+	UnassignTask(ctx context.Context, params UnassignTaskParams) (UnassignTaskRes, error)
 	// UpdateCell implements updateCell operation.
 	//
 	// Update Cell.
@@ -392,6 +734,24 @@ type Handler interface {
 	//
 	// PUT /storage-groups/{id}
 	UpdateStorageGroup(ctx context.Context, req *StorageGroupBase, params UpdateStorageGroupParams) (UpdateStorageGroupRes, error)
+	// UpdateTaskComment implements updateTaskComment operation.
+	//
+	// Edit a comment, only its author can do it.
+	//
+	// PUT /tasks/{id}/comments/{commentId}
+	UpdateTaskComment(ctx context.Context, req *UpdateTaskCommentRequest, params UpdateTaskCommentParams) (UpdateTaskCommentRes, error)
+	// UpdateTaskTemplate implements updateTaskTemplate operation.
+	//
+	// Update task template and its rules.
+	//
+	// PUT /task-templates/{id}
+	UpdateTaskTemplate(ctx context.Context, req *UpdateTaskTemplateRequest, params UpdateTaskTemplateParams) (UpdateTaskTemplateRes, error)
+	// UploadTaskAttachment implements uploadTaskAttachment operation.
+	//
+	// Attach a photo to a task or one of its items.
+	//
+	// POST /tasks/{id}/attachments
+	UploadTaskAttachment(ctx context.Context, req *UploadTaskAttachmentRequestMultipart, params UploadTaskAttachmentParams) (UploadTaskAttachmentRes, error)
 	// NewError creates *DefaultErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
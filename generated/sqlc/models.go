@@ -11,12 +11,58 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AllocationPolicy string
+
+const (
+	AllocationPolicyFifo AllocationPolicy = "fifo"
+	AllocationPolicyFefo AllocationPolicy = "fefo"
+)
+
+func (e *AllocationPolicy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AllocationPolicy(s)
+	case string:
+		*e = AllocationPolicy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AllocationPolicy: %T", src)
+	}
+	return nil
+}
+
+type NullAllocationPolicy struct {
+	AllocationPolicy AllocationPolicy
+	Valid            bool // Valid is true if AllocationPolicy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAllocationPolicy) Scan(value interface{}) error {
+	if value == nil {
+		ns.AllocationPolicy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AllocationPolicy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAllocationPolicy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AllocationPolicy), nil
+}
+
 type ItemInstanceStatus string
 
 const (
-	ItemInstanceStatusAvailable ItemInstanceStatus = "available"
-	ItemInstanceStatusReserved  ItemInstanceStatus = "reserved"
-	ItemInstanceStatusConsumed  ItemInstanceStatus = "consumed"
+	ItemInstanceStatusAvailable   ItemInstanceStatus = "available"
+	ItemInstanceStatusReserved    ItemInstanceStatus = "reserved"
+	ItemInstanceStatusConsumed    ItemInstanceStatus = "consumed"
+	ItemInstanceStatusQuarantined ItemInstanceStatus = "quarantined"
+	ItemInstanceStatusDisposed    ItemInstanceStatus = "disposed"
+	ItemInstanceStatusShipped     ItemInstanceStatus = "shipped"
+	ItemInstanceStatusInTransit   ItemInstanceStatus = "in_transit"
 )
 
 func (e *ItemInstanceStatus) Scan(src interface{}) error {
@@ -54,6 +100,307 @@ func (ns NullItemInstanceStatus) Value() (driver.Value, error) {
 	return string(ns.ItemInstanceStatus), nil
 }
 
+type ItemTrackingMode string
+
+const (
+	ItemTrackingModeSerialized ItemTrackingMode = "serialized"
+	ItemTrackingModeQuantity   ItemTrackingMode = "quantity"
+)
+
+func (e *ItemTrackingMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ItemTrackingMode(s)
+	case string:
+		*e = ItemTrackingMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ItemTrackingMode: %T", src)
+	}
+	return nil
+}
+
+type NullItemTrackingMode struct {
+	ItemTrackingMode ItemTrackingMode
+	Valid            bool // Valid is true if ItemTrackingMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullItemTrackingMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.ItemTrackingMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ItemTrackingMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullItemTrackingMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ItemTrackingMode), nil
+}
+
+type PickPathStrategy string
+
+const (
+	PickPathStrategySerpentine PickPathStrategy = "serpentine"
+	PickPathStrategySequential PickPathStrategy = "sequential"
+)
+
+func (e *PickPathStrategy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PickPathStrategy(s)
+	case string:
+		*e = PickPathStrategy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PickPathStrategy: %T", src)
+	}
+	return nil
+}
+
+type NullPickPathStrategy struct {
+	PickPathStrategy PickPathStrategy
+	Valid            bool // Valid is true if PickPathStrategy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPickPathStrategy) Scan(value interface{}) error {
+	if value == nil {
+		ns.PickPathStrategy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PickPathStrategy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPickPathStrategy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PickPathStrategy), nil
+}
+
+type PickWaveStatus string
+
+const (
+	PickWaveStatusPending   PickWaveStatus = "pending"
+	PickWaveStatusPicking   PickWaveStatus = "picking"
+	PickWaveStatusSorting   PickWaveStatus = "sorting"
+	PickWaveStatusCompleted PickWaveStatus = "completed"
+	PickWaveStatusCancelled PickWaveStatus = "cancelled"
+)
+
+func (e *PickWaveStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PickWaveStatus(s)
+	case string:
+		*e = PickWaveStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PickWaveStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPickWaveStatus struct {
+	PickWaveStatus PickWaveStatus
+	Valid          bool // Valid is true if PickWaveStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPickWaveStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PickWaveStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PickWaveStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPickWaveStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PickWaveStatus), nil
+}
+
+type ReturnDisposition string
+
+const (
+	ReturnDispositionRestock    ReturnDisposition = "restock"
+	ReturnDispositionQuarantine ReturnDisposition = "quarantine"
+	ReturnDispositionDispose    ReturnDisposition = "dispose"
+)
+
+func (e *ReturnDisposition) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReturnDisposition(s)
+	case string:
+		*e = ReturnDisposition(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReturnDisposition: %T", src)
+	}
+	return nil
+}
+
+type NullReturnDisposition struct {
+	ReturnDisposition ReturnDisposition
+	Valid             bool // Valid is true if ReturnDisposition is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReturnDisposition) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReturnDisposition, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReturnDisposition.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReturnDisposition) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReturnDisposition), nil
+}
+
+type TaskAssignmentStrategy string
+
+const (
+	TaskAssignmentStrategyManual         TaskAssignmentStrategy = "manual"
+	TaskAssignmentStrategyLeastOpenTasks TaskAssignmentStrategy = "least_open_tasks"
+	TaskAssignmentStrategyRoundRobin     TaskAssignmentStrategy = "round_robin"
+	TaskAssignmentStrategySkills         TaskAssignmentStrategy = "skills"
+)
+
+func (e *TaskAssignmentStrategy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TaskAssignmentStrategy(s)
+	case string:
+		*e = TaskAssignmentStrategy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TaskAssignmentStrategy: %T", src)
+	}
+	return nil
+}
+
+type NullTaskAssignmentStrategy struct {
+	TaskAssignmentStrategy TaskAssignmentStrategy
+	Valid                  bool // Valid is true if TaskAssignmentStrategy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTaskAssignmentStrategy) Scan(value interface{}) error {
+	if value == nil {
+		ns.TaskAssignmentStrategy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TaskAssignmentStrategy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTaskAssignmentStrategy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TaskAssignmentStrategy), nil
+}
+
+type TaskDiscrepancyType string
+
+const (
+	TaskDiscrepancyTypeOver  TaskDiscrepancyType = "over"
+	TaskDiscrepancyTypeUnder TaskDiscrepancyType = "under"
+)
+
+func (e *TaskDiscrepancyType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TaskDiscrepancyType(s)
+	case string:
+		*e = TaskDiscrepancyType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TaskDiscrepancyType: %T", src)
+	}
+	return nil
+}
+
+type NullTaskDiscrepancyType struct {
+	TaskDiscrepancyType TaskDiscrepancyType
+	Valid               bool // Valid is true if TaskDiscrepancyType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTaskDiscrepancyType) Scan(value interface{}) error {
+	if value == nil {
+		ns.TaskDiscrepancyType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TaskDiscrepancyType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTaskDiscrepancyType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TaskDiscrepancyType), nil
+}
+
+type TaskItemExceptionReason string
+
+const (
+	TaskItemExceptionReasonNotFound  TaskItemExceptionReason = "not_found"
+	TaskItemExceptionReasonDamaged   TaskItemExceptionReason = "damaged"
+	TaskItemExceptionReasonWrongItem TaskItemExceptionReason = "wrong_item"
+)
+
+func (e *TaskItemExceptionReason) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TaskItemExceptionReason(s)
+	case string:
+		*e = TaskItemExceptionReason(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TaskItemExceptionReason: %T", src)
+	}
+	return nil
+}
+
+type NullTaskItemExceptionReason struct {
+	TaskItemExceptionReason TaskItemExceptionReason
+	Valid                   bool // Valid is true if TaskItemExceptionReason is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTaskItemExceptionReason) Scan(value interface{}) error {
+	if value == nil {
+		ns.TaskItemExceptionReason, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TaskItemExceptionReason.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTaskItemExceptionReason) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TaskItemExceptionReason), nil
+}
+
 type TaskItemStatus string
 
 const (
@@ -62,6 +409,7 @@ const (
 	TaskItemStatusDone     TaskItemStatus = "done"
 	TaskItemStatusReturned TaskItemStatus = "returned"
 	TaskItemStatusCanceled TaskItemStatus = "canceled"
+	TaskItemStatusShort    TaskItemStatus = "short"
 )
 
 func (e *TaskItemStatus) Scan(src interface{}) error {
@@ -99,6 +447,50 @@ func (ns NullTaskItemStatus) Value() (driver.Value, error) {
 	return string(ns.TaskItemStatus), nil
 }
 
+type TaskPriority string
+
+const (
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityNormal TaskPriority = "normal"
+	TaskPriorityHigh   TaskPriority = "high"
+	TaskPriorityUrgent TaskPriority = "urgent"
+)
+
+func (e *TaskPriority) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TaskPriority(s)
+	case string:
+		*e = TaskPriority(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TaskPriority: %T", src)
+	}
+	return nil
+}
+
+type NullTaskPriority struct {
+	TaskPriority TaskPriority
+	Valid        bool // Valid is true if TaskPriority is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTaskPriority) Scan(value interface{}) error {
+	if value == nil {
+		ns.TaskPriority, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TaskPriority.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTaskPriority) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TaskPriority), nil
+}
+
 type TaskStatus string
 
 const (
@@ -147,8 +539,11 @@ func (ns NullTaskStatus) Value() (driver.Value, error) {
 type TaskType string
 
 const (
-	TaskTypeMovement TaskType = "movement"
-	TaskTypePickment TaskType = "pickment"
+	TaskTypeMovement       TaskType = "movement"
+	TaskTypePickment       TaskType = "pickment"
+	TaskTypeReceiving      TaskType = "receiving"
+	TaskTypeInventoryCount TaskType = "inventory_count"
+	TaskTypeReturn         TaskType = "return"
 )
 
 func (e *TaskType) Scan(src interface{}) error {
@@ -186,6 +581,50 @@ func (ns NullTaskType) Value() (driver.Value, error) {
 	return string(ns.TaskType), nil
 }
 
+type TransferOrderStatus string
+
+const (
+	TransferOrderStatusPending   TransferOrderStatus = "pending"
+	TransferOrderStatusInTransit TransferOrderStatus = "in_transit"
+	TransferOrderStatusReceived  TransferOrderStatus = "received"
+	TransferOrderStatusCancelled TransferOrderStatus = "cancelled"
+)
+
+func (e *TransferOrderStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TransferOrderStatus(s)
+	case string:
+		*e = TransferOrderStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TransferOrderStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTransferOrderStatus struct {
+	TransferOrderStatus TransferOrderStatus
+	Valid               bool // Valid is true if TransferOrderStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTransferOrderStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TransferOrderStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TransferOrderStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTransferOrderStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TransferOrderStatus), nil
+}
+
 type AppApiToken struct {
 	ID        pgtype.UUID
 	OrgID     pgtype.UUID
@@ -205,6 +644,7 @@ type AppObjectChange struct {
 	TargetObjectID   pgtype.UUID
 	PrechangeState   []byte
 	PostchangeState  []byte
+	Reason           pgtype.Text
 }
 
 type AppRole struct {
@@ -240,6 +680,15 @@ type AppUserSession struct {
 	RevokedAt pgtype.Timestamp
 }
 
+type AppWorkerProfile struct {
+	OrgID     pgtype.UUID
+	UserID    pgtype.UUID
+	UnitIds   []pgtype.UUID
+	Skills    []string
+	OnShift   bool
+	UpdatedAt pgtype.Timestamp
+}
+
 type Cell struct {
 	ID           pgtype.UUID
 	OrgID        pgtype.UUID
@@ -284,19 +733,26 @@ type ItemInstance struct {
 	CellID           pgtype.UUID
 	Status           ItemInstanceStatus
 	AffectedByTaskID pgtype.UUID
+	SerialNumber     pgtype.Text
+	LotNumber        pgtype.Text
+	ManufacturedAt   pgtype.Date
+	ExpiresAt        pgtype.Date
 	CreatedAt        pgtype.Timestamp
 	DeletedAt        pgtype.Timestamp
 }
 
 type ItemVariant struct {
-	ID        pgtype.UUID
-	OrgID     pgtype.UUID
-	ItemID    pgtype.UUID
-	Name      string
-	Article   pgtype.Text
-	Ean13     pgtype.Int8
-	CreatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
+	ID               pgtype.UUID
+	OrgID            pgtype.UUID
+	ItemID           pgtype.UUID
+	Name             string
+	Article          pgtype.Text
+	Ean13            pgtype.Int8
+	TrackingMode     ItemTrackingMode
+	AllocationPolicy NullAllocationPolicy
+	RequiresSerial   bool
+	CreatedAt        pgtype.Timestamp
+	DeletedAt        pgtype.Timestamp
 }
 
 type ObjectType struct {
@@ -306,21 +762,50 @@ type ObjectType struct {
 }
 
 type Org struct {
-	ID        pgtype.UUID
-	Name      string
-	Subdomain string
-	CreatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
+	ID                     pgtype.UUID
+	Name                   string
+	Subdomain              string
+	TaskAssignmentStrategy TaskAssignmentStrategy
+	AllocationPolicy       AllocationPolicy
+	CreatedAt              pgtype.Timestamp
+	DeletedAt              pgtype.Timestamp
 }
 
 type OrgUnit struct {
-	ID        pgtype.UUID
-	OrgID     pgtype.UUID
-	Name      string
-	Alias     string
-	Address   pgtype.Text
-	CreatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
+	ID                 pgtype.UUID
+	OrgID              pgtype.UUID
+	Name               string
+	Alias              string
+	Address            pgtype.Text
+	PickPathStrategy   PickPathStrategy
+	LastAssignedUserID pgtype.UUID
+	CreatedAt          pgtype.Timestamp
+	DeletedAt          pgtype.Timestamp
+}
+
+type PickWave struct {
+	ID                  pgtype.UUID
+	OrgID               pgtype.UUID
+	UnitID              pgtype.UUID
+	Name                string
+	Status              PickWaveStatus
+	ConsolidationCellID pgtype.UUID
+	CreatedAt           pgtype.Timestamp
+	CompletedAt         pgtype.Timestamp
+	DeletedAt           pgtype.Timestamp
+}
+
+type StockLine struct {
+	ID               pgtype.UUID
+	OrgID            pgtype.UUID
+	ItemID           pgtype.UUID
+	VariantID        pgtype.UUID
+	CellID           pgtype.UUID
+	Status           ItemInstanceStatus
+	Quantity         int32
+	AffectedByTaskID pgtype.UUID
+	CreatedAt        pgtype.Timestamp
+	DeletedAt        pgtype.Timestamp
 }
 
 type StorageGroup struct {
@@ -341,22 +826,169 @@ type Task struct {
 	UnitID           pgtype.UUID
 	Type             TaskType
 	Status           TaskStatus
+	Priority         TaskPriority
 	Name             string
 	Description      pgtype.Text
 	AssignedToUserID pgtype.UUID
 	AssignedAt       pgtype.Timestamp
 	CompletedAt      pgtype.Timestamp
+	DueAt            pgtype.Timestamp
+	SlaBreachedAt    pgtype.Timestamp
+	ReceivingCellID  pgtype.UUID
+	OriginalTaskID   pgtype.UUID
+	WaveID           pgtype.UUID
+	TemplateID       pgtype.UUID
+	TransferOrderID  pgtype.UUID
+	CreatedAt        pgtype.Timestamp
+	DeletedAt        pgtype.Timestamp
+}
+
+type TaskAttachment struct {
+	ID               pgtype.UUID
+	OrgID            pgtype.UUID
+	TaskID           pgtype.UUID
+	ItemInstanceID   pgtype.UUID
+	CommentID        pgtype.UUID
+	FileName         string
+	ContentType      string
+	SizeBytes        int64
+	StorageKey       string
+	UploadedByUserID pgtype.UUID
 	CreatedAt        pgtype.Timestamp
 	DeletedAt        pgtype.Timestamp
 }
 
+type TaskComment struct {
+	ID             pgtype.UUID
+	OrgID          pgtype.UUID
+	TaskID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+	Body           string
+	AuthorUserID   pgtype.UUID
+	CreatedAt      pgtype.Timestamp
+	EditedAt       pgtype.Timestamp
+	DeletedAt      pgtype.Timestamp
+}
+
+type TaskCountCell struct {
+	OrgID           pgtype.UUID
+	TaskID          pgtype.UUID
+	CellID          pgtype.UUID
+	CountedAt       pgtype.Timestamp
+	CountedByUserID pgtype.UUID
+}
+
+type TaskCountEntry struct {
+	ID             pgtype.UUID
+	OrgID          pgtype.UUID
+	TaskID         pgtype.UUID
+	CellID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+	VariantID      pgtype.UUID
+	Quantity       int32
+	CreatedAt      pgtype.Timestamp
+}
+
+type TaskExpectedItem struct {
+	ID               pgtype.UUID
+	OrgID            pgtype.UUID
+	TaskID           pgtype.UUID
+	VariantID        pgtype.UUID
+	ExpectedQuantity int32
+	ReceivedQuantity int32
+	Discrepancy      NullTaskDiscrepancyType
+	CreatedAt        pgtype.Timestamp
+}
+
 type TaskItem struct {
+	ID                pgtype.UUID
 	OrgID             pgtype.UUID
 	TaskID            pgtype.UUID
 	ItemInstanceID    pgtype.UUID
+	StockLineID       pgtype.UUID
+	Quantity          int32
 	Status            TaskItemStatus
 	SourceCellID      pgtype.UUID
 	DestinationCellID pgtype.UUID
+	Disposition       NullReturnDisposition
+}
+
+type TaskItemException struct {
+	ID                   pgtype.UUID
+	OrgID                pgtype.UUID
+	TaskID               pgtype.UUID
+	ItemInstanceID       pgtype.UUID
+	Reason               TaskItemExceptionReason
+	Note                 pgtype.Text
+	SubstituteInstanceID pgtype.UUID
+	ReportedByUserID     pgtype.UUID
+	CreatedAt            pgtype.Timestamp
+}
+
+type TaskPickupCode struct {
+	TaskID         pgtype.UUID
+	OrgID          pgtype.UUID
+	UnitID         pgtype.UUID
+	Code           string
+	FailedAttempts int32
+	CreatedAt      pgtype.Timestamp
+	LockedAt       pgtype.Timestamp
+	UsedAt         pgtype.Timestamp
+}
+
+type TaskStatusHistory struct {
+	ID              pgtype.UUID
+	OrgID           pgtype.UUID
+	TaskID          pgtype.UUID
+	FromStatus      NullTaskStatus
+	ToStatus        TaskStatus
+	ChangedByUserID pgtype.UUID
+	Comment         pgtype.Text
+	ChangedAt       pgtype.Timestamp
+}
+
+type TaskTemplate struct {
+	ID               pgtype.UUID
+	OrgID            pgtype.UUID
+	UnitID           pgtype.UUID
+	Type             TaskType
+	Priority         TaskPriority
+	Name             string
+	Description      pgtype.Text
+	AssignedToUserID pgtype.UUID
+	DueInMinutes     pgtype.Int4
+	Schedule         pgtype.Text
+	IsActive         bool
+	NextRunAt        pgtype.Timestamp
+	LastRunAt        pgtype.Timestamp
+	CreatedAt        pgtype.Timestamp
+	DeletedAt        pgtype.Timestamp
+}
+
+type TaskTemplateRule struct {
+	ID                 pgtype.UUID
+	OrgID              pgtype.UUID
+	TemplateID         pgtype.UUID
+	Position           int32
+	ItemID             pgtype.UUID
+	VariantID          pgtype.UUID
+	SourceCellsGroupID pgtype.UUID
+	TargetCellID       pgtype.UUID
+	MaxInstances       pgtype.Int4
+}
+
+type TransferOrder struct {
+	ID                pgtype.UUID
+	OrgID             pgtype.UUID
+	SourceUnitID      pgtype.UUID
+	DestinationUnitID pgtype.UUID
+	ReceivingCellID   pgtype.UUID
+	Name              string
+	Status            TransferOrderStatus
+	CreatedAt         pgtype.Timestamp
+	DispatchedAt      pgtype.Timestamp
+	ReceivedAt        pgtype.Timestamp
+	DeletedAt         pgtype.Timestamp
 }
 
 type TvBoard struct {
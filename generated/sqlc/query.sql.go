@@ -11,6 +11,32 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addTaskExpectedItemReceived = `-- name: AddTaskExpectedItemReceived :one
+UPDATE task_expected_item SET received_quantity = received_quantity + $3::int WHERE org_id = $1 AND id = $2 RETURNING id, org_id, task_id, variant_id, expected_quantity, received_quantity, discrepancy, created_at
+`
+
+type AddTaskExpectedItemReceivedParams struct {
+	OrgID    pgtype.UUID
+	ID       pgtype.UUID
+	Quantity int32
+}
+
+func (q *Queries) AddTaskExpectedItemReceived(ctx context.Context, arg AddTaskExpectedItemReceivedParams) (TaskExpectedItem, error) {
+	row := q.db.QueryRow(ctx, addTaskExpectedItemReceived, arg.OrgID, arg.ID, arg.Quantity)
+	var i TaskExpectedItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.VariantID,
+		&i.ExpectedQuantity,
+		&i.ReceivedQuantity,
+		&i.Discrepancy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const assignRoleToUser = `-- name: AssignRoleToUser :exec
 INSERT INTO app_role_binding (role_id, user_id, org_id) 
 VALUES ($1, $2, $3)
@@ -30,6 +56,84 @@ func (q *Queries) AssignRoleToUser(ctx context.Context, arg AssignRoleToUserPara
 	return err
 }
 
+const claimTask = `-- name: ClaimTask :one
+UPDATE task SET assigned_to_user_id = $3, assigned_at = CURRENT_TIMESTAMP
+WHERE org_id = $1 AND id = $2 AND assigned_to_user_id IS NULL
+  AND status IN ('pending', 'in_progress', 'ready') AND deleted_at IS NULL
+RETURNING id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at
+`
+
+type ClaimTaskParams struct {
+	OrgID            pgtype.UUID
+	ID               pgtype.UUID
+	AssignedToUserID pgtype.UUID
+}
+
+func (q *Queries) ClaimTask(ctx context.Context, arg ClaimTaskParams) (Task, error) {
+	row := q.db.QueryRow(ctx, claimTask, arg.OrgID, arg.ID, arg.AssignedToUserID)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Type,
+		&i.Status,
+		&i.Priority,
+		&i.Name,
+		&i.Description,
+		&i.AssignedToUserID,
+		&i.AssignedAt,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.SlaBreachedAt,
+		&i.ReceivingCellID,
+		&i.OriginalTaskID,
+		&i.WaveID,
+		&i.TemplateID,
+		&i.TransferOrderID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const countOpenTasksByAssignee = `-- name: CountOpenTasksByAssignee :many
+SELECT assigned_to_user_id, COUNT(*)::int AS open_tasks FROM task
+WHERE org_id = $1 AND assigned_to_user_id = ANY($2::uuid[])
+  AND status IN ('pending', 'in_progress') AND deleted_at IS NULL
+GROUP BY assigned_to_user_id
+`
+
+type CountOpenTasksByAssigneeParams struct {
+	OrgID   pgtype.UUID
+	UserIds []pgtype.UUID
+}
+
+type CountOpenTasksByAssigneeRow struct {
+	AssignedToUserID pgtype.UUID
+	OpenTasks        int32
+}
+
+func (q *Queries) CountOpenTasksByAssignee(ctx context.Context, arg CountOpenTasksByAssigneeParams) ([]CountOpenTasksByAssigneeRow, error) {
+	rows, err := q.db.Query(ctx, countOpenTasksByAssignee, arg.OrgID, arg.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountOpenTasksByAssigneeRow
+	for rows.Next() {
+		var i CountOpenTasksByAssigneeRow
+		if err := rows.Scan(&i.AssignedToUserID, &i.OpenTasks); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createApiToken = `-- name: CreateApiToken :one
 INSERT INTO app_api_token (org_id, name, token) VALUES ($1, $2, $3) RETURNING id, org_id, name, token, created_at, revoked_at
 `
@@ -157,15 +261,20 @@ func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) (Item, e
 }
 
 const createItemInstance = `-- name: CreateItemInstance :one
-INSERT INTO item_instance (org_id, item_id, variant_id, cell_id, status) VALUES ($1, $2, $3, $4, $5) RETURNING id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, created_at, deleted_at
+INSERT INTO item_instance (org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at
 `
 
 type CreateItemInstanceParams struct {
-	OrgID     pgtype.UUID
-	ItemID    pgtype.UUID
-	VariantID pgtype.UUID
-	CellID    pgtype.UUID
-	Status    ItemInstanceStatus
+	OrgID            pgtype.UUID
+	ItemID           pgtype.UUID
+	VariantID        pgtype.UUID
+	CellID           pgtype.UUID
+	Status           ItemInstanceStatus
+	AffectedByTaskID pgtype.UUID
+	SerialNumber     pgtype.Text
+	LotNumber        pgtype.Text
+	ManufacturedAt   pgtype.Date
+	ExpiresAt        pgtype.Date
 }
 
 // Item Instances
@@ -176,6 +285,11 @@ func (q *Queries) CreateItemInstance(ctx context.Context, arg CreateItemInstance
 		arg.VariantID,
 		arg.CellID,
 		arg.Status,
+		arg.AffectedByTaskID,
+		arg.SerialNumber,
+		arg.LotNumber,
+		arg.ManufacturedAt,
+		arg.ExpiresAt,
 	)
 	var i ItemInstance
 	err := row.Scan(
@@ -186,6 +300,10 @@ func (q *Queries) CreateItemInstance(ctx context.Context, arg CreateItemInstance
 		&i.CellID,
 		&i.Status,
 		&i.AffectedByTaskID,
+		&i.SerialNumber,
+		&i.LotNumber,
+		&i.ManufacturedAt,
+		&i.ExpiresAt,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -193,15 +311,18 @@ func (q *Queries) CreateItemInstance(ctx context.Context, arg CreateItemInstance
 }
 
 const createItemVariant = `-- name: CreateItemVariant :one
-INSERT INTO item_variant (org_id, item_id, name, article, ean13) VALUES ($1, $2, $3, $4, $5) RETURNING id, org_id, item_id, name, article, ean13, created_at, deleted_at
+INSERT INTO item_variant (org_id, item_id, name, article, ean13, tracking_mode, allocation_policy, requires_serial) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, org_id, item_id, name, article, ean13, tracking_mode, allocation_policy, requires_serial, created_at, deleted_at
 `
 
 type CreateItemVariantParams struct {
-	OrgID   pgtype.UUID
-	ItemID  pgtype.UUID
-	Name    string
-	Article pgtype.Text
-	Ean13   pgtype.Int8
+	OrgID            pgtype.UUID
+	ItemID           pgtype.UUID
+	Name             string
+	Article          pgtype.Text
+	Ean13            pgtype.Int8
+	TrackingMode     ItemTrackingMode
+	AllocationPolicy NullAllocationPolicy
+	RequiresSerial   bool
 }
 
 // Item Variants
@@ -212,6 +333,9 @@ func (q *Queries) CreateItemVariant(ctx context.Context, arg CreateItemVariantPa
 		arg.Name,
 		arg.Article,
 		arg.Ean13,
+		arg.TrackingMode,
+		arg.AllocationPolicy,
+		arg.RequiresSerial,
 	)
 	var i ItemVariant
 	err := row.Scan(
@@ -221,6 +345,9 @@ func (q *Queries) CreateItemVariant(ctx context.Context, arg CreateItemVariantPa
 		&i.Name,
 		&i.Article,
 		&i.Ean13,
+		&i.TrackingMode,
+		&i.AllocationPolicy,
+		&i.RequiresSerial,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -228,7 +355,7 @@ func (q *Queries) CreateItemVariant(ctx context.Context, arg CreateItemVariantPa
 }
 
 const createObjectChange = `-- name: CreateObjectChange :one
-INSERT INTO app_object_change (org_id, user_id, action, target_object_type, target_object_id, prechange_state, postchange_state) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, org_id, user_id, action, time, target_object_type, target_object_id, prechange_state, postchange_state
+INSERT INTO app_object_change (org_id, user_id, action, target_object_type, target_object_id, prechange_state, postchange_state, reason) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, org_id, user_id, action, time, target_object_type, target_object_id, prechange_state, postchange_state, reason
 `
 
 type CreateObjectChangeParams struct {
@@ -239,6 +366,7 @@ type CreateObjectChangeParams struct {
 	TargetObjectID   pgtype.UUID
 	PrechangeState   []byte
 	PostchangeState  []byte
+	Reason           pgtype.Text
 }
 
 func (q *Queries) CreateObjectChange(ctx context.Context, arg CreateObjectChangeParams) (AppObjectChange, error) {
@@ -250,6 +378,7 @@ func (q *Queries) CreateObjectChange(ctx context.Context, arg CreateObjectChange
 		arg.TargetObjectID,
 		arg.PrechangeState,
 		arg.PostchangeState,
+		arg.Reason,
 	)
 	var i AppObjectChange
 	err := row.Scan(
@@ -262,19 +391,21 @@ func (q *Queries) CreateObjectChange(ctx context.Context, arg CreateObjectChange
 		&i.TargetObjectID,
 		&i.PrechangeState,
 		&i.PostchangeState,
+		&i.Reason,
 	)
 	return i, err
 }
 
 const createOrgUnit = `-- name: CreateOrgUnit :one
-INSERT INTO org_unit (org_id, name, alias, address) VALUES ($1, $2, $3, $4) RETURNING id, org_id, name, alias, address, created_at, deleted_at
+INSERT INTO org_unit (org_id, name, alias, address, pick_path_strategy) VALUES ($1, $2, $3, $4, $5) RETURNING id, org_id, name, alias, address, pick_path_strategy, last_assigned_user_id, created_at, deleted_at
 `
 
 type CreateOrgUnitParams struct {
-	OrgID   pgtype.UUID
-	Name    string
-	Alias   string
-	Address pgtype.Text
+	OrgID            pgtype.UUID
+	Name             string
+	Alias            string
+	Address          pgtype.Text
+	PickPathStrategy PickPathStrategy
 }
 
 // Units
@@ -284,6 +415,7 @@ func (q *Queries) CreateOrgUnit(ctx context.Context, arg CreateOrgUnitParams) (O
 		arg.Name,
 		arg.Alias,
 		arg.Address,
+		arg.PickPathStrategy,
 	)
 	var i OrgUnit
 	err := row.Scan(
@@ -292,6 +424,8 @@ func (q *Queries) CreateOrgUnit(ctx context.Context, arg CreateOrgUnitParams) (O
 		&i.Name,
 		&i.Alias,
 		&i.Address,
+		&i.PickPathStrategy,
+		&i.LastAssignedUserID,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -299,7 +433,7 @@ func (q *Queries) CreateOrgUnit(ctx context.Context, arg CreateOrgUnitParams) (O
 }
 
 const createOrganization = `-- name: CreateOrganization :one
-INSERT INTO org (name, subdomain) VALUES ($1, $2) RETURNING id, name, subdomain, created_at, deleted_at
+INSERT INTO org (name, subdomain) VALUES ($1, $2) RETURNING id, name, subdomain, task_assignment_strategy, allocation_policy, created_at, deleted_at
 `
 
 type CreateOrganizationParams struct {
@@ -314,6 +448,83 @@ func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganization
 		&i.ID,
 		&i.Name,
 		&i.Subdomain,
+		&i.TaskAssignmentStrategy,
+		&i.AllocationPolicy,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createPickWave = `-- name: CreatePickWave :one
+INSERT INTO pick_wave (org_id, unit_id, name, consolidation_cell_id) VALUES ($1, $2, $3, $4) RETURNING id, org_id, unit_id, name, status, consolidation_cell_id, created_at, completed_at, deleted_at
+`
+
+type CreatePickWaveParams struct {
+	OrgID               pgtype.UUID
+	UnitID              pgtype.UUID
+	Name                string
+	ConsolidationCellID pgtype.UUID
+}
+
+// Pick Waves
+func (q *Queries) CreatePickWave(ctx context.Context, arg CreatePickWaveParams) (PickWave, error) {
+	row := q.db.QueryRow(ctx, createPickWave,
+		arg.OrgID,
+		arg.UnitID,
+		arg.Name,
+		arg.ConsolidationCellID,
+	)
+	var i PickWave
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Name,
+		&i.Status,
+		&i.ConsolidationCellID,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createStockLine = `-- name: CreateStockLine :one
+INSERT INTO stock_line (org_id, item_id, variant_id, cell_id, status, quantity, affected_by_task_id) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, org_id, item_id, variant_id, cell_id, status, quantity, affected_by_task_id, created_at, deleted_at
+`
+
+type CreateStockLineParams struct {
+	OrgID            pgtype.UUID
+	ItemID           pgtype.UUID
+	VariantID        pgtype.UUID
+	CellID           pgtype.UUID
+	Status           ItemInstanceStatus
+	Quantity         int32
+	AffectedByTaskID pgtype.UUID
+}
+
+// Stock Lines
+func (q *Queries) CreateStockLine(ctx context.Context, arg CreateStockLineParams) (StockLine, error) {
+	row := q.db.QueryRow(ctx, createStockLine,
+		arg.OrgID,
+		arg.ItemID,
+		arg.VariantID,
+		arg.CellID,
+		arg.Status,
+		arg.Quantity,
+		arg.AffectedByTaskID,
+	)
+	var i StockLine
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.ItemID,
+		&i.VariantID,
+		&i.CellID,
+		&i.Status,
+		&i.Quantity,
+		&i.AffectedByTaskID,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -357,7 +568,7 @@ func (q *Queries) CreateStorageGroup(ctx context.Context, arg CreateStorageGroup
 }
 
 const createTask = `-- name: CreateTask :one
-INSERT INTO task (org_id, unit_id, type, name, description, assigned_to_user_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, org_id, unit_id, type, status, name, description, assigned_to_user_id, assigned_at, completed_at, created_at, deleted_at
+INSERT INTO task (org_id, unit_id, type, name, description, assigned_to_user_id, receiving_cell_id, original_task_id, priority, due_at, template_id, transfer_order_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at
 `
 
 type CreateTaskParams struct {
@@ -367,6 +578,12 @@ type CreateTaskParams struct {
 	Name             string
 	Description      pgtype.Text
 	AssignedToUserID pgtype.UUID
+	ReceivingCellID  pgtype.UUID
+	OriginalTaskID   pgtype.UUID
+	Priority         TaskPriority
+	DueAt            pgtype.Timestamp
+	TemplateID       pgtype.UUID
+	TransferOrderID  pgtype.UUID
 }
 
 // Tasks
@@ -378,6 +595,12 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, e
 		arg.Name,
 		arg.Description,
 		arg.AssignedToUserID,
+		arg.ReceivingCellID,
+		arg.OriginalTaskID,
+		arg.Priority,
+		arg.DueAt,
+		arg.TemplateID,
+		arg.TransferOrderID,
 	)
 	var i Task
 	err := row.Scan(
@@ -386,145 +609,556 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, e
 		&i.UnitID,
 		&i.Type,
 		&i.Status,
+		&i.Priority,
 		&i.Name,
 		&i.Description,
 		&i.AssignedToUserID,
 		&i.AssignedAt,
 		&i.CompletedAt,
+		&i.DueAt,
+		&i.SlaBreachedAt,
+		&i.ReceivingCellID,
+		&i.OriginalTaskID,
+		&i.WaveID,
+		&i.TemplateID,
+		&i.TransferOrderID,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const createTaskItem = `-- name: CreateTaskItem :one
-INSERT INTO task_item (org_id, task_id, item_instance_id, source_cell_id, destination_cell_id) VALUES ($1, $2, $3, $4, $5) RETURNING org_id, task_id, item_instance_id, status, source_cell_id, destination_cell_id
+const createTaskAttachment = `-- name: CreateTaskAttachment :one
+INSERT INTO task_attachment (id, org_id, task_id, item_instance_id, comment_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, org_id, task_id, item_instance_id, comment_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, deleted_at
 `
 
-type CreateTaskItemParams struct {
-	OrgID             pgtype.UUID
-	TaskID            pgtype.UUID
-	ItemInstanceID    pgtype.UUID
-	SourceCellID      pgtype.UUID
-	DestinationCellID pgtype.UUID
-}
-
-func (q *Queries) CreateTaskItem(ctx context.Context, arg CreateTaskItemParams) (TaskItem, error) {
-	row := q.db.QueryRow(ctx, createTaskItem,
+type CreateTaskAttachmentParams struct {
+	ID               pgtype.UUID
+	OrgID            pgtype.UUID
+	TaskID           pgtype.UUID
+	ItemInstanceID   pgtype.UUID
+	CommentID        pgtype.UUID
+	FileName         string
+	ContentType      string
+	SizeBytes        int64
+	StorageKey       string
+	UploadedByUserID pgtype.UUID
+}
+
+// Task Attachments
+func (q *Queries) CreateTaskAttachment(ctx context.Context, arg CreateTaskAttachmentParams) (TaskAttachment, error) {
+	row := q.db.QueryRow(ctx, createTaskAttachment,
+		arg.ID,
 		arg.OrgID,
 		arg.TaskID,
 		arg.ItemInstanceID,
-		arg.SourceCellID,
-		arg.DestinationCellID,
+		arg.CommentID,
+		arg.FileName,
+		arg.ContentType,
+		arg.SizeBytes,
+		arg.StorageKey,
+		arg.UploadedByUserID,
 	)
-	var i TaskItem
+	var i TaskAttachment
 	err := row.Scan(
+		&i.ID,
 		&i.OrgID,
 		&i.TaskID,
 		&i.ItemInstanceID,
-		&i.Status,
-		&i.SourceCellID,
-		&i.DestinationCellID,
+		&i.CommentID,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.StorageKey,
+		&i.UploadedByUserID,
+		&i.CreatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const createTvBoard = `-- name: CreateTvBoard :one
-INSERT INTO tv_board (org_id, unit_id, name) VALUES ($1, $2, $3) RETURNING id, org_id, unit_id, name, token, created_at, deleted_at
+const createTaskComment = `-- name: CreateTaskComment :one
+INSERT INTO task_comment (org_id, task_id, item_instance_id, body, author_user_id) VALUES ($1, $2, $3, $4, $5) RETURNING id, org_id, task_id, item_instance_id, body, author_user_id, created_at, edited_at, deleted_at
 `
 
-type CreateTvBoardParams struct {
-	OrgID  pgtype.UUID
-	UnitID pgtype.UUID
-	Name   string
+type CreateTaskCommentParams struct {
+	OrgID          pgtype.UUID
+	TaskID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+	Body           string
+	AuthorUserID   pgtype.UUID
 }
 
-// TV Boards
-func (q *Queries) CreateTvBoard(ctx context.Context, arg CreateTvBoardParams) (TvBoard, error) {
-	row := q.db.QueryRow(ctx, createTvBoard, arg.OrgID, arg.UnitID, arg.Name)
-	var i TvBoard
+// Task Comments
+func (q *Queries) CreateTaskComment(ctx context.Context, arg CreateTaskCommentParams) (TaskComment, error) {
+	row := q.db.QueryRow(ctx, createTaskComment,
+		arg.OrgID,
+		arg.TaskID,
+		arg.ItemInstanceID,
+		arg.Body,
+		arg.AuthorUserID,
+	)
+	var i TaskComment
 	err := row.Scan(
 		&i.ID,
 		&i.OrgID,
-		&i.UnitID,
-		&i.Name,
-		&i.Token,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.Body,
+		&i.AuthorUserID,
 		&i.CreatedAt,
+		&i.EditedAt,
 		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const createUser = `-- name: CreateUser :one
-INSERT INTO app_user (email, first_name, last_name, middle_name, yandex_id) VALUES ($1, $2, $3, $4, $5) RETURNING id, email, first_name, last_name, middle_name, yandex_id, created_at
+const createTaskCountCell = `-- name: CreateTaskCountCell :exec
+INSERT INTO task_count_cell (org_id, task_id, cell_id) VALUES ($1, $2, $3)
 `
 
-type CreateUserParams struct {
-	Email      string
-	FirstName  string
-	LastName   string
-	MiddleName pgtype.Text
-	YandexID   pgtype.Text
+type CreateTaskCountCellParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+	CellID pgtype.UUID
 }
 
-// User
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (AppUser, error) {
-	row := q.db.QueryRow(ctx, createUser,
-		arg.Email,
-		arg.FirstName,
-		arg.LastName,
-		arg.MiddleName,
-		arg.YandexID,
-	)
-	var i AppUser
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.FirstName,
-		&i.LastName,
-		&i.MiddleName,
-		&i.YandexID,
-		&i.CreatedAt,
+func (q *Queries) CreateTaskCountCell(ctx context.Context, arg CreateTaskCountCellParams) error {
+	_, err := q.db.Exec(ctx, createTaskCountCell, arg.OrgID, arg.TaskID, arg.CellID)
+	return err
+}
+
+const createTaskCountEntry = `-- name: CreateTaskCountEntry :exec
+INSERT INTO task_count_entry (org_id, task_id, cell_id, item_instance_id, variant_id, quantity) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateTaskCountEntryParams struct {
+	OrgID          pgtype.UUID
+	TaskID         pgtype.UUID
+	CellID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+	VariantID      pgtype.UUID
+	Quantity       int32
+}
+
+func (q *Queries) CreateTaskCountEntry(ctx context.Context, arg CreateTaskCountEntryParams) error {
+	_, err := q.db.Exec(ctx, createTaskCountEntry,
+		arg.OrgID,
+		arg.TaskID,
+		arg.CellID,
+		arg.ItemInstanceID,
+		arg.VariantID,
+		arg.Quantity,
 	)
-	return i, err
+	return err
 }
 
-const createUserSession = `-- name: CreateUserSession :one
-INSERT INTO app_user_session (user_id, token) VALUES ($1, $2) RETURNING id, user_id, token, created_at, expires_at, revoked_at
+const createTaskExpectedItem = `-- name: CreateTaskExpectedItem :one
+INSERT INTO task_expected_item (org_id, task_id, variant_id, expected_quantity) VALUES ($1, $2, $3, $4) RETURNING id, org_id, task_id, variant_id, expected_quantity, received_quantity, discrepancy, created_at
 `
 
-type CreateUserSessionParams struct {
-	UserID pgtype.UUID
-	Token  string
+type CreateTaskExpectedItemParams struct {
+	OrgID            pgtype.UUID
+	TaskID           pgtype.UUID
+	VariantID        pgtype.UUID
+	ExpectedQuantity int32
 }
 
-// User Auth
-func (q *Queries) CreateUserSession(ctx context.Context, arg CreateUserSessionParams) (AppUserSession, error) {
-	row := q.db.QueryRow(ctx, createUserSession, arg.UserID, arg.Token)
-	var i AppUserSession
+func (q *Queries) CreateTaskExpectedItem(ctx context.Context, arg CreateTaskExpectedItemParams) (TaskExpectedItem, error) {
+	row := q.db.QueryRow(ctx, createTaskExpectedItem,
+		arg.OrgID,
+		arg.TaskID,
+		arg.VariantID,
+		arg.ExpectedQuantity,
+	)
+	var i TaskExpectedItem
 	err := row.Scan(
 		&i.ID,
-		&i.UserID,
-		&i.Token,
+		&i.OrgID,
+		&i.TaskID,
+		&i.VariantID,
+		&i.ExpectedQuantity,
+		&i.ReceivedQuantity,
+		&i.Discrepancy,
 		&i.CreatedAt,
-		&i.ExpiresAt,
-		&i.RevokedAt,
 	)
 	return i, err
 }
 
-const deleteCell = `-- name: DeleteCell :exec
-UPDATE cell SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2
+const createTaskItem = `-- name: CreateTaskItem :one
+INSERT INTO task_item (org_id, task_id, item_instance_id, source_cell_id, destination_cell_id) VALUES ($1, $2, $3, $4, $5) RETURNING id, org_id, task_id, item_instance_id, stock_line_id, quantity, status, source_cell_id, destination_cell_id, disposition
 `
 
-type DeleteCellParams struct {
-	OrgID pgtype.UUID
-	ID    pgtype.UUID
-}
-
-func (q *Queries) DeleteCell(ctx context.Context, arg DeleteCellParams) error {
-	_, err := q.db.Exec(ctx, deleteCell, arg.OrgID, arg.ID)
-	return err
+type CreateTaskItemParams struct {
+	OrgID             pgtype.UUID
+	TaskID            pgtype.UUID
+	ItemInstanceID    pgtype.UUID
+	SourceCellID      pgtype.UUID
+	DestinationCellID pgtype.UUID
+}
+
+func (q *Queries) CreateTaskItem(ctx context.Context, arg CreateTaskItemParams) (TaskItem, error) {
+	row := q.db.QueryRow(ctx, createTaskItem,
+		arg.OrgID,
+		arg.TaskID,
+		arg.ItemInstanceID,
+		arg.SourceCellID,
+		arg.DestinationCellID,
+	)
+	var i TaskItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.StockLineID,
+		&i.Quantity,
+		&i.Status,
+		&i.SourceCellID,
+		&i.DestinationCellID,
+		&i.Disposition,
+	)
+	return i, err
+}
+
+const createTaskItemException = `-- name: CreateTaskItemException :one
+INSERT INTO task_item_exception (org_id, task_id, item_instance_id, reason, note, substitute_instance_id, reported_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, org_id, task_id, item_instance_id, reason, note, substitute_instance_id, reported_by_user_id, created_at
+`
+
+type CreateTaskItemExceptionParams struct {
+	OrgID                pgtype.UUID
+	TaskID               pgtype.UUID
+	ItemInstanceID       pgtype.UUID
+	Reason               TaskItemExceptionReason
+	Note                 pgtype.Text
+	SubstituteInstanceID pgtype.UUID
+	ReportedByUserID     pgtype.UUID
+}
+
+// Task Item Exceptions
+func (q *Queries) CreateTaskItemException(ctx context.Context, arg CreateTaskItemExceptionParams) (TaskItemException, error) {
+	row := q.db.QueryRow(ctx, createTaskItemException,
+		arg.OrgID,
+		arg.TaskID,
+		arg.ItemInstanceID,
+		arg.Reason,
+		arg.Note,
+		arg.SubstituteInstanceID,
+		arg.ReportedByUserID,
+	)
+	var i TaskItemException
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.Reason,
+		&i.Note,
+		&i.SubstituteInstanceID,
+		&i.ReportedByUserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createTaskStatusHistory = `-- name: CreateTaskStatusHistory :exec
+INSERT INTO task_status_history (org_id, task_id, from_status, to_status, changed_by_user_id, comment) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateTaskStatusHistoryParams struct {
+	OrgID           pgtype.UUID
+	TaskID          pgtype.UUID
+	FromStatus      NullTaskStatus
+	ToStatus        TaskStatus
+	ChangedByUserID pgtype.UUID
+	Comment         pgtype.Text
+}
+
+func (q *Queries) CreateTaskStatusHistory(ctx context.Context, arg CreateTaskStatusHistoryParams) error {
+	_, err := q.db.Exec(ctx, createTaskStatusHistory,
+		arg.OrgID,
+		arg.TaskID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.ChangedByUserID,
+		arg.Comment,
+	)
+	return err
+}
+
+const createTaskStockLineItem = `-- name: CreateTaskStockLineItem :one
+INSERT INTO task_item (org_id, task_id, stock_line_id, quantity, source_cell_id, destination_cell_id, status) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, org_id, task_id, item_instance_id, stock_line_id, quantity, status, source_cell_id, destination_cell_id, disposition
+`
+
+type CreateTaskStockLineItemParams struct {
+	OrgID             pgtype.UUID
+	TaskID            pgtype.UUID
+	StockLineID       pgtype.UUID
+	Quantity          int32
+	SourceCellID      pgtype.UUID
+	DestinationCellID pgtype.UUID
+	Status            TaskItemStatus
+}
+
+func (q *Queries) CreateTaskStockLineItem(ctx context.Context, arg CreateTaskStockLineItemParams) (TaskItem, error) {
+	row := q.db.QueryRow(ctx, createTaskStockLineItem,
+		arg.OrgID,
+		arg.TaskID,
+		arg.StockLineID,
+		arg.Quantity,
+		arg.SourceCellID,
+		arg.DestinationCellID,
+		arg.Status,
+	)
+	var i TaskItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.StockLineID,
+		&i.Quantity,
+		&i.Status,
+		&i.SourceCellID,
+		&i.DestinationCellID,
+		&i.Disposition,
+	)
+	return i, err
+}
+
+const createTaskTemplate = `-- name: CreateTaskTemplate :one
+INSERT INTO task_template (org_id, unit_id, type, priority, name, description, assigned_to_user_id, due_in_minutes, schedule, is_active, next_run_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, org_id, unit_id, type, priority, name, description, assigned_to_user_id, due_in_minutes, schedule, is_active, next_run_at, last_run_at, created_at, deleted_at
+`
+
+type CreateTaskTemplateParams struct {
+	OrgID            pgtype.UUID
+	UnitID           pgtype.UUID
+	Type             TaskType
+	Priority         TaskPriority
+	Name             string
+	Description      pgtype.Text
+	AssignedToUserID pgtype.UUID
+	DueInMinutes     pgtype.Int4
+	Schedule         pgtype.Text
+	IsActive         bool
+	NextRunAt        pgtype.Timestamp
+}
+
+// Task Templates
+func (q *Queries) CreateTaskTemplate(ctx context.Context, arg CreateTaskTemplateParams) (TaskTemplate, error) {
+	row := q.db.QueryRow(ctx, createTaskTemplate,
+		arg.OrgID,
+		arg.UnitID,
+		arg.Type,
+		arg.Priority,
+		arg.Name,
+		arg.Description,
+		arg.AssignedToUserID,
+		arg.DueInMinutes,
+		arg.Schedule,
+		arg.IsActive,
+		arg.NextRunAt,
+	)
+	var i TaskTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Type,
+		&i.Priority,
+		&i.Name,
+		&i.Description,
+		&i.AssignedToUserID,
+		&i.DueInMinutes,
+		&i.Schedule,
+		&i.IsActive,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createTaskTemplateRule = `-- name: CreateTaskTemplateRule :one
+INSERT INTO task_template_rule (org_id, template_id, position, item_id, variant_id, source_cells_group_id, target_cell_id, max_instances)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, org_id, template_id, position, item_id, variant_id, source_cells_group_id, target_cell_id, max_instances
+`
+
+type CreateTaskTemplateRuleParams struct {
+	OrgID              pgtype.UUID
+	TemplateID         pgtype.UUID
+	Position           int32
+	ItemID             pgtype.UUID
+	VariantID          pgtype.UUID
+	SourceCellsGroupID pgtype.UUID
+	TargetCellID       pgtype.UUID
+	MaxInstances       pgtype.Int4
+}
+
+func (q *Queries) CreateTaskTemplateRule(ctx context.Context, arg CreateTaskTemplateRuleParams) (TaskTemplateRule, error) {
+	row := q.db.QueryRow(ctx, createTaskTemplateRule,
+		arg.OrgID,
+		arg.TemplateID,
+		arg.Position,
+		arg.ItemID,
+		arg.VariantID,
+		arg.SourceCellsGroupID,
+		arg.TargetCellID,
+		arg.MaxInstances,
+	)
+	var i TaskTemplateRule
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TemplateID,
+		&i.Position,
+		&i.ItemID,
+		&i.VariantID,
+		&i.SourceCellsGroupID,
+		&i.TargetCellID,
+		&i.MaxInstances,
+	)
+	return i, err
+}
+
+const createTransferOrder = `-- name: CreateTransferOrder :one
+INSERT INTO transfer_order (org_id, source_unit_id, destination_unit_id, receiving_cell_id, name) VALUES ($1, $2, $3, $4, $5) RETURNING id, org_id, source_unit_id, destination_unit_id, receiving_cell_id, name, status, created_at, dispatched_at, received_at, deleted_at
+`
+
+type CreateTransferOrderParams struct {
+	OrgID             pgtype.UUID
+	SourceUnitID      pgtype.UUID
+	DestinationUnitID pgtype.UUID
+	ReceivingCellID   pgtype.UUID
+	Name              string
+}
+
+// Transfer Orders
+func (q *Queries) CreateTransferOrder(ctx context.Context, arg CreateTransferOrderParams) (TransferOrder, error) {
+	row := q.db.QueryRow(ctx, createTransferOrder,
+		arg.OrgID,
+		arg.SourceUnitID,
+		arg.DestinationUnitID,
+		arg.ReceivingCellID,
+		arg.Name,
+	)
+	var i TransferOrder
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.SourceUnitID,
+		&i.DestinationUnitID,
+		&i.ReceivingCellID,
+		&i.Name,
+		&i.Status,
+		&i.CreatedAt,
+		&i.DispatchedAt,
+		&i.ReceivedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createTvBoard = `-- name: CreateTvBoard :one
+INSERT INTO tv_board (org_id, unit_id, name) VALUES ($1, $2, $3) RETURNING id, org_id, unit_id, name, token, created_at, deleted_at
+`
+
+type CreateTvBoardParams struct {
+	OrgID  pgtype.UUID
+	UnitID pgtype.UUID
+	Name   string
+}
+
+// TV Boards
+func (q *Queries) CreateTvBoard(ctx context.Context, arg CreateTvBoardParams) (TvBoard, error) {
+	row := q.db.QueryRow(ctx, createTvBoard, arg.OrgID, arg.UnitID, arg.Name)
+	var i TvBoard
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Name,
+		&i.Token,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO app_user (email, first_name, last_name, middle_name, yandex_id) VALUES ($1, $2, $3, $4, $5) RETURNING id, email, first_name, last_name, middle_name, yandex_id, created_at
+`
+
+type CreateUserParams struct {
+	Email      string
+	FirstName  string
+	LastName   string
+	MiddleName pgtype.Text
+	YandexID   pgtype.Text
+}
+
+// User
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (AppUser, error) {
+	row := q.db.QueryRow(ctx, createUser,
+		arg.Email,
+		arg.FirstName,
+		arg.LastName,
+		arg.MiddleName,
+		arg.YandexID,
+	)
+	var i AppUser
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.MiddleName,
+		&i.YandexID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createUserSession = `-- name: CreateUserSession :one
+INSERT INTO app_user_session (user_id, token) VALUES ($1, $2) RETURNING id, user_id, token, created_at, expires_at, revoked_at
+`
+
+type CreateUserSessionParams struct {
+	UserID pgtype.UUID
+	Token  string
+}
+
+// User Auth
+func (q *Queries) CreateUserSession(ctx context.Context, arg CreateUserSessionParams) (AppUserSession, error) {
+	row := q.db.QueryRow(ctx, createUserSession, arg.UserID, arg.Token)
+	var i AppUserSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Token,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const deleteCell = `-- name: DeleteCell :exec
+UPDATE cell SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2
+`
+
+type DeleteCellParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) DeleteCell(ctx context.Context, arg DeleteCellParams) error {
+	_, err := q.db.Exec(ctx, deleteCell, arg.OrgID, arg.ID)
+	return err
 }
 
 const deleteCellsGroup = `-- name: DeleteCellsGroup :exec
@@ -607,6 +1241,20 @@ func (q *Queries) DeleteOrganization(ctx context.Context, id pgtype.UUID) error
 	return err
 }
 
+const deleteStockLines = `-- name: DeleteStockLines :exec
+UPDATE stock_line SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = ANY($2::uuid[]) AND deleted_at IS NULL
+`
+
+type DeleteStockLinesParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
+}
+
+func (q *Queries) DeleteStockLines(ctx context.Context, arg DeleteStockLinesParams) error {
+	_, err := q.db.Exec(ctx, deleteStockLines, arg.OrgID, arg.Ids)
+	return err
+}
+
 const deleteStorageGroup = `-- name: DeleteStorageGroup :exec
 UPDATE storage_group SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2
 `
@@ -621,13 +1269,86 @@ func (q *Queries) DeleteStorageGroup(ctx context.Context, arg DeleteStorageGroup
 	return err
 }
 
-const deleteTvBoard = `-- name: DeleteTvBoard :exec
-UPDATE tv_board SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2
+const deleteTaskAttachment = `-- name: DeleteTaskAttachment :exec
+UPDATE task_attachment SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL
 `
 
-type DeleteTvBoardParams struct {
-	OrgID pgtype.UUID
-	ID    pgtype.UUID
+type DeleteTaskAttachmentParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+	ID     pgtype.UUID
+}
+
+func (q *Queries) DeleteTaskAttachment(ctx context.Context, arg DeleteTaskAttachmentParams) error {
+	_, err := q.db.Exec(ctx, deleteTaskAttachment, arg.OrgID, arg.TaskID, arg.ID)
+	return err
+}
+
+const deleteTaskComment = `-- name: DeleteTaskComment :exec
+UPDATE task_comment SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL
+`
+
+type DeleteTaskCommentParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+	ID     pgtype.UUID
+}
+
+func (q *Queries) DeleteTaskComment(ctx context.Context, arg DeleteTaskCommentParams) error {
+	_, err := q.db.Exec(ctx, deleteTaskComment, arg.OrgID, arg.TaskID, arg.ID)
+	return err
+}
+
+const deleteTaskCountEntriesForCell = `-- name: DeleteTaskCountEntriesForCell :exec
+DELETE FROM task_count_entry WHERE org_id = $1 AND task_id = $2 AND cell_id = $3
+`
+
+type DeleteTaskCountEntriesForCellParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+	CellID pgtype.UUID
+}
+
+func (q *Queries) DeleteTaskCountEntriesForCell(ctx context.Context, arg DeleteTaskCountEntriesForCellParams) error {
+	_, err := q.db.Exec(ctx, deleteTaskCountEntriesForCell, arg.OrgID, arg.TaskID, arg.CellID)
+	return err
+}
+
+const deleteTaskTemplate = `-- name: DeleteTaskTemplate :exec
+UPDATE task_template SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2
+`
+
+type DeleteTaskTemplateParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) DeleteTaskTemplate(ctx context.Context, arg DeleteTaskTemplateParams) error {
+	_, err := q.db.Exec(ctx, deleteTaskTemplate, arg.OrgID, arg.ID)
+	return err
+}
+
+const deleteTaskTemplateRules = `-- name: DeleteTaskTemplateRules :exec
+DELETE FROM task_template_rule WHERE org_id = $1 AND template_id = $2
+`
+
+type DeleteTaskTemplateRulesParams struct {
+	OrgID      pgtype.UUID
+	TemplateID pgtype.UUID
+}
+
+func (q *Queries) DeleteTaskTemplateRules(ctx context.Context, arg DeleteTaskTemplateRulesParams) error {
+	_, err := q.db.Exec(ctx, deleteTaskTemplateRules, arg.OrgID, arg.TemplateID)
+	return err
+}
+
+const deleteTvBoard = `-- name: DeleteTvBoard :exec
+UPDATE tv_board SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2
+`
+
+type DeleteTvBoardParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
 }
 
 func (q *Queries) DeleteTvBoard(ctx context.Context, arg DeleteTvBoardParams) error {
@@ -635,6 +1356,71 @@ func (q *Queries) DeleteTvBoard(ctx context.Context, arg DeleteTvBoardParams) er
 	return err
 }
 
+const dispatchTaskInstances = `-- name: DispatchTaskInstances :exec
+UPDATE item_instance SET status = 'in_transit', cell_id = NULL
+WHERE org_id = $1 AND affected_by_task_id = $2 AND status = 'reserved' AND deleted_at IS NULL
+`
+
+type DispatchTaskInstancesParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+// Instances picked by the outbound leg of a transfer order leave the unit
+func (q *Queries) DispatchTaskInstances(ctx context.Context, arg DispatchTaskInstancesParams) error {
+	_, err := q.db.Exec(ctx, dispatchTaskInstances, arg.OrgID, arg.TaskID)
+	return err
+}
+
+const getActiveUnitPickupCodes = `-- name: GetActiveUnitPickupCodes :many
+SELECT task_pickup_code.task_id, task_pickup_code.org_id, task_pickup_code.unit_id, task_pickup_code.code, task_pickup_code.failed_attempts, task_pickup_code.created_at, task_pickup_code.locked_at, task_pickup_code.used_at, task.name AS task_name FROM task_pickup_code
+JOIN task ON task.id = task_pickup_code.task_id
+WHERE task_pickup_code.org_id = $1 AND task_pickup_code.unit_id = $2
+  AND task_pickup_code.used_at IS NULL AND task_pickup_code.locked_at IS NULL
+  AND task.status = 'ready' AND task.deleted_at IS NULL
+ORDER BY task_pickup_code.created_at
+`
+
+type GetActiveUnitPickupCodesParams struct {
+	OrgID  pgtype.UUID
+	UnitID pgtype.UUID
+}
+
+type GetActiveUnitPickupCodesRow struct {
+	TaskPickupCode TaskPickupCode
+	TaskName       string
+}
+
+func (q *Queries) GetActiveUnitPickupCodes(ctx context.Context, arg GetActiveUnitPickupCodesParams) ([]GetActiveUnitPickupCodesRow, error) {
+	rows, err := q.db.Query(ctx, getActiveUnitPickupCodes, arg.OrgID, arg.UnitID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActiveUnitPickupCodesRow
+	for rows.Next() {
+		var i GetActiveUnitPickupCodesRow
+		if err := rows.Scan(
+			&i.TaskPickupCode.TaskID,
+			&i.TaskPickupCode.OrgID,
+			&i.TaskPickupCode.UnitID,
+			&i.TaskPickupCode.Code,
+			&i.TaskPickupCode.FailedAttempts,
+			&i.TaskPickupCode.CreatedAt,
+			&i.TaskPickupCode.LockedAt,
+			&i.TaskPickupCode.UsedAt,
+			&i.TaskName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getApiToken = `-- name: GetApiToken :one
 SELECT id, org_id, name, token, created_at, revoked_at FROM app_api_token WHERE org_id = $1 AND id = $2 AND revoked_at IS NULL
 `
@@ -690,6 +1476,55 @@ func (q *Queries) GetApiTokens(ctx context.Context, orgID pgtype.UUID) ([]AppApi
 	return items, nil
 }
 
+const getAvailableUnitInstanceIds = `-- name: GetAvailableUnitInstanceIds :many
+SELECT item_instance.id FROM item_instance
+JOIN cell ON cell.id = item_instance.cell_id AND cell.deleted_at IS NULL
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+WHERE item_instance.org_id = $1 AND cells_group.unit_id = $2
+  AND item_instance.id = ANY($3::uuid[])
+  AND item_instance.status = 'available' AND item_instance.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM task_item
+    JOIN task ON task.id = task_item.task_id
+    WHERE task_item.item_instance_id = item_instance.id AND task_item.status IN ('pending', 'picked')
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM task_count_cell
+    JOIN task ON task.id = task_count_cell.task_id
+    WHERE task_count_cell.cell_id = item_instance.cell_id
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+`
+
+type GetAvailableUnitInstanceIdsParams struct {
+	OrgID  pgtype.UUID
+	UnitID pgtype.UUID
+	Ids    []pgtype.UUID
+}
+
+// Instances of the unit which can be put into a new task, same conditions as
+// for template rules
+func (q *Queries) GetAvailableUnitInstanceIds(ctx context.Context, arg GetAvailableUnitInstanceIdsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, getAvailableUnitInstanceIds, arg.OrgID, arg.UnitID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCellById = `-- name: GetCellById :one
 SELECT id, org_id, cells_group_id, alias, row, level, position, created_at, deleted_at FROM cell WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
 `
@@ -716,6 +1551,77 @@ func (q *Queries) GetCellById(ctx context.Context, arg GetCellByIdParams) (Cell,
 	return i, err
 }
 
+const getCellIdsByAlias = `-- name: GetCellIdsByAlias :many
+SELECT cell.id FROM cell
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+WHERE cell.org_id = $1 AND cell.alias = $2 AND cell.deleted_at IS NULL
+ORDER BY cell.id
+`
+
+type GetCellIdsByAliasParams struct {
+	OrgID pgtype.UUID
+	Alias string
+}
+
+// Aliases are unique within a cells group only, cells of different groups
+// may share one
+func (q *Queries) GetCellIdsByAlias(ctx context.Context, arg GetCellIdsByAliasParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, getCellIdsByAlias, arg.OrgID, arg.Alias)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCellIdsInStorageGroup = `-- name: GetCellIdsInStorageGroup :many
+WITH RECURSIVE groups AS (
+    SELECT id FROM storage_group WHERE storage_group.org_id = $1 AND storage_group.id = $2 AND deleted_at IS NULL
+    UNION ALL
+    SELECT sg.id FROM storage_group sg JOIN groups ON sg.parent_id = groups.id WHERE sg.deleted_at IS NULL
+)
+SELECT cell.id FROM cell
+JOIN cells_group ON cells_group.id = cell.cells_group_id
+WHERE cell.org_id = $1 AND cells_group.storage_group_id IN (SELECT id FROM groups)
+  AND cell.deleted_at IS NULL AND cells_group.deleted_at IS NULL
+`
+
+type GetCellIdsInStorageGroupParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetCellIdsInStorageGroup(ctx context.Context, arg GetCellIdsInStorageGroupParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, getCellIdsInStorageGroup, arg.OrgID, arg.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCellPath = `-- name: GetCellPath :many
 WITH RECURSIVE path AS (
   SELECT
@@ -724,7 +1630,7 @@ WITH RECURSIVE path AS (
     cg.alias,
     cg.name,
     cg.storage_group_id AS parent_group_id,
-    NULL::UUID         AS unit_id,
+    cg.unit_id         AS unit_id,
     1                  AS lvl
   FROM cell c
   JOIN cells_group cg
@@ -847,6 +1753,45 @@ func (q *Queries) GetCells(ctx context.Context, arg GetCellsParams) ([]Cell, err
 	return items, nil
 }
 
+const getCellsByIds = `-- name: GetCellsByIds :many
+SELECT id, org_id, cells_group_id, alias, row, level, position, created_at, deleted_at FROM cell WHERE org_id = $1 AND id = ANY($2::uuid[]) AND deleted_at IS NULL
+`
+
+type GetCellsByIdsParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
+}
+
+func (q *Queries) GetCellsByIds(ctx context.Context, arg GetCellsByIdsParams) ([]Cell, error) {
+	rows, err := q.db.Query(ctx, getCellsByIds, arg.OrgID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Cell
+	for rows.Next() {
+		var i Cell
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.CellsGroupID,
+			&i.Alias,
+			&i.Row,
+			&i.Level,
+			&i.Position,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCellsGroupById = `-- name: GetCellsGroupById :one
 SELECT id, org_id, unit_id, storage_group_id, name, alias, created_at, deleted_at FROM cells_group WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
 `
@@ -905,38 +1850,185 @@ func (q *Queries) GetCellsGroups(ctx context.Context, orgID pgtype.UUID) ([]Cell
 	return items, nil
 }
 
-const getEmployee = `-- name: GetEmployee :one
-SELECT app_user.id, app_user.email, app_user.first_name, app_user.last_name, app_user.middle_name, app_user.yandex_id, app_user.created_at, app_role.id, app_role.name, app_role.display_name, app_role.description FROM app_user
-JOIN app_role_binding ON app_user.id = app_role_binding.user_id
-JOIN app_role ON app_role_binding.role_id = app_role.id
-WHERE app_role_binding.org_id = $1 AND app_role_binding.user_id = $2
+const getCellsPaths = `-- name: GetCellsPaths :many
+WITH RECURSIVE path AS (
+  SELECT
+    c.id               AS cell_id,
+    cg.id,
+    'cells_group'      AS type,
+    cg.alias,
+    cg.name,
+    cg.storage_group_id AS parent_group_id,
+    cg.unit_id         AS unit_id,
+    1                  AS lvl
+  FROM cell c
+  JOIN cells_group cg
+    ON c.cells_group_id = cg.id
+   AND c.org_id         = cg.org_id
+  WHERE c.org_id = $1
+    AND c.id     = ANY($2::uuid[])
+
+  UNION ALL
+
+  SELECT
+    p.cell_id,
+    sg.id,
+    'storage_group'    AS type,
+    sg.alias,
+    sg.name,
+    sg.parent_id       AS parent_group_id,
+    sg.unit_id,
+    p.lvl + 1          AS lvl
+  FROM path p
+  JOIN storage_group sg
+    ON sg.id     = p.parent_group_id
+   AND sg.org_id = $1
+)
+
+SELECT cell_id, id, type, alias, name
+FROM (
+  SELECT cell_id, id, type, alias, name, lvl
+  FROM path
+
+  UNION ALL
+
+  SELECT
+    p.cell_id,
+    ou.id,
+    'unit'            AS type,
+    ou.alias,
+    ou.name,
+    MAX(p.lvl) + 1    AS lvl
+  FROM path p
+  JOIN org_unit ou
+    ON ou.id     = p.unit_id
+   AND ou.org_id = $1
+  GROUP BY p.cell_id, ou.id, ou.alias, ou.name
+) t
+ORDER BY cell_id, lvl DESC
 `
 
-type GetEmployeeParams struct {
-	OrgID  pgtype.UUID
-	UserID pgtype.UUID
+type GetCellsPathsParams struct {
+	OrgID   pgtype.UUID
+	CellIds []pgtype.UUID
 }
 
-type GetEmployeeRow struct {
-	AppUser AppUser
-	AppRole AppRole
+type GetCellsPathsRow struct {
+	CellID pgtype.UUID
+	ID     pgtype.UUID
+	Type   string
+	Alias  string
+	Name   string
 }
 
-func (q *Queries) GetEmployee(ctx context.Context, arg GetEmployeeParams) (GetEmployeeRow, error) {
-	row := q.db.QueryRow(ctx, getEmployee, arg.OrgID, arg.UserID)
-	var i GetEmployeeRow
-	err := row.Scan(
-		&i.AppUser.ID,
-		&i.AppUser.Email,
-		&i.AppUser.FirstName,
-		&i.AppUser.LastName,
-		&i.AppUser.MiddleName,
-		&i.AppUser.YandexID,
-		&i.AppUser.CreatedAt,
-		&i.AppRole.ID,
-		&i.AppRole.Name,
-		&i.AppRole.DisplayName,
-		&i.AppRole.Description,
+// Same as GetCellPath for several cells at once, segments go from unit down to cells group
+func (q *Queries) GetCellsPaths(ctx context.Context, arg GetCellsPathsParams) ([]GetCellsPathsRow, error) {
+	rows, err := q.db.Query(ctx, getCellsPaths, arg.OrgID, arg.CellIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCellsPathsRow
+	for rows.Next() {
+		var i GetCellsPathsRow
+		if err := rows.Scan(
+			&i.CellID,
+			&i.ID,
+			&i.Type,
+			&i.Alias,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueTaskTemplates = `-- name: GetDueTaskTemplates :many
+SELECT id, org_id, unit_id, type, priority, name, description, assigned_to_user_id, due_in_minutes, schedule, is_active, next_run_at, last_run_at, created_at, deleted_at FROM task_template
+WHERE is_active AND deleted_at IS NULL AND next_run_at <= $1
+ORDER BY next_run_at
+LIMIT $2::int
+`
+
+type GetDueTaskTemplatesParams struct {
+	Now     pgtype.Timestamp
+	MaxRows int32
+}
+
+// Active scheduled templates of all organizations whose next run has come
+func (q *Queries) GetDueTaskTemplates(ctx context.Context, arg GetDueTaskTemplatesParams) ([]TaskTemplate, error) {
+	rows, err := q.db.Query(ctx, getDueTaskTemplates, arg.Now, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskTemplate
+	for rows.Next() {
+		var i TaskTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.DueInMinutes,
+			&i.Schedule,
+			&i.IsActive,
+			&i.NextRunAt,
+			&i.LastRunAt,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEmployee = `-- name: GetEmployee :one
+SELECT app_user.id, app_user.email, app_user.first_name, app_user.last_name, app_user.middle_name, app_user.yandex_id, app_user.created_at, app_role.id, app_role.name, app_role.display_name, app_role.description FROM app_user
+JOIN app_role_binding ON app_user.id = app_role_binding.user_id
+JOIN app_role ON app_role_binding.role_id = app_role.id
+WHERE app_role_binding.org_id = $1 AND app_role_binding.user_id = $2
+`
+
+type GetEmployeeParams struct {
+	OrgID  pgtype.UUID
+	UserID pgtype.UUID
+}
+
+type GetEmployeeRow struct {
+	AppUser AppUser
+	AppRole AppRole
+}
+
+func (q *Queries) GetEmployee(ctx context.Context, arg GetEmployeeParams) (GetEmployeeRow, error) {
+	row := q.db.QueryRow(ctx, getEmployee, arg.OrgID, arg.UserID)
+	var i GetEmployeeRow
+	err := row.Scan(
+		&i.AppUser.ID,
+		&i.AppUser.Email,
+		&i.AppUser.FirstName,
+		&i.AppUser.LastName,
+		&i.AppUser.MiddleName,
+		&i.AppUser.YandexID,
+		&i.AppUser.CreatedAt,
+		&i.AppRole.ID,
+		&i.AppRole.Name,
+		&i.AppRole.DisplayName,
+		&i.AppRole.Description,
 	)
 	return i, err
 }
@@ -1026,6 +2118,151 @@ func (q *Queries) GetEmployees(ctx context.Context, orgID pgtype.UUID) ([]GetEmp
 	return items, nil
 }
 
+const getEmployeesByUserIds = `-- name: GetEmployeesByUserIds :many
+SELECT app_user.id, app_user.email, app_user.first_name, app_user.last_name, app_user.middle_name, app_user.yandex_id, app_user.created_at, app_role.id, app_role.name, app_role.display_name, app_role.description FROM app_user
+JOIN app_role_binding ON app_user.id = app_role_binding.user_id
+JOIN app_role ON app_role_binding.role_id = app_role.id
+WHERE app_role_binding.org_id = $1 AND app_role_binding.user_id = ANY($2::uuid[])
+`
+
+type GetEmployeesByUserIdsParams struct {
+	OrgID   pgtype.UUID
+	UserIds []pgtype.UUID
+}
+
+type GetEmployeesByUserIdsRow struct {
+	AppUser AppUser
+	AppRole AppRole
+}
+
+func (q *Queries) GetEmployeesByUserIds(ctx context.Context, arg GetEmployeesByUserIdsParams) ([]GetEmployeesByUserIdsRow, error) {
+	rows, err := q.db.Query(ctx, getEmployeesByUserIds, arg.OrgID, arg.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEmployeesByUserIdsRow
+	for rows.Next() {
+		var i GetEmployeesByUserIdsRow
+		if err := rows.Scan(
+			&i.AppUser.ID,
+			&i.AppUser.Email,
+			&i.AppUser.FirstName,
+			&i.AppUser.LastName,
+			&i.AppUser.MiddleName,
+			&i.AppUser.YandexID,
+			&i.AppUser.CreatedAt,
+			&i.AppRole.ID,
+			&i.AppRole.Name,
+			&i.AppRole.DisplayName,
+			&i.AppRole.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpiringInstances = `-- name: GetExpiringInstances :many
+SELECT item_instance.id, item_instance.org_id, item_instance.item_id, item_instance.variant_id, item_instance.cell_id, item_instance.status, item_instance.affected_by_task_id, item_instance.serial_number, item_instance.lot_number, item_instance.manufactured_at, item_instance.expires_at, item_instance.created_at, item_instance.deleted_at FROM item_instance
+WHERE item_instance.org_id = $1 AND item_instance.deleted_at IS NULL
+  AND item_instance.status IN ('available', 'reserved')
+  AND item_instance.expires_at <= $2::date
+  AND (
+    $3::uuid IS NULL
+    OR EXISTS (
+      SELECT 1 FROM cell
+      JOIN cells_group ON cells_group.id = cell.cells_group_id
+      WHERE cell.id = item_instance.cell_id AND cells_group.unit_id = $3::uuid
+    )
+    OR EXISTS (
+      SELECT 1 FROM task
+      WHERE item_instance.cell_id IS NULL AND task.id = item_instance.affected_by_task_id
+        AND task.unit_id = $3::uuid
+    )
+  )
+ORDER BY item_instance.expires_at, item_instance.id
+`
+
+type GetExpiringInstancesParams struct {
+	OrgID  pgtype.UUID
+	Until  pgtype.Date
+	UnitID pgtype.UUID
+}
+
+// Instances in stock expiring up to the given day, already expired ones
+// included. Reserved instances are out of cells and belong to the unit of
+// the task holding them.
+func (q *Queries) GetExpiringInstances(ctx context.Context, arg GetExpiringInstancesParams) ([]ItemInstance, error) {
+	rows, err := q.db.Query(ctx, getExpiringInstances, arg.OrgID, arg.Until, arg.UnitID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemInstance
+	for rows.Next() {
+		var i ItemInstance
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.VariantID,
+			&i.CellID,
+			&i.Status,
+			&i.AffectedByTaskID,
+			&i.SerialNumber,
+			&i.LotNumber,
+			&i.ManufacturedAt,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFrozenCellIds = `-- name: GetFrozenCellIds :many
+SELECT DISTINCT task_count_cell.cell_id FROM task_count_cell
+JOIN task ON task.id = task_count_cell.task_id
+WHERE task_count_cell.org_id = $1 AND task_count_cell.cell_id = ANY($2::uuid[])
+  AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+`
+
+type GetFrozenCellIdsParams struct {
+	OrgID   pgtype.UUID
+	CellIds []pgtype.UUID
+}
+
+func (q *Queries) GetFrozenCellIds(ctx context.Context, arg GetFrozenCellIdsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, getFrozenCellIds, arg.OrgID, arg.CellIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var cell_id pgtype.UUID
+		if err := rows.Scan(&cell_id); err != nil {
+			return nil, err
+		}
+		items = append(items, cell_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getItemById = `-- name: GetItemById :one
 SELECT id, org_id, name, description, width, depth, height, weight, created_at, deleted_at FROM item WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
 `
@@ -1054,7 +2291,7 @@ func (q *Queries) GetItemById(ctx context.Context, arg GetItemByIdParams) (Item,
 }
 
 const getItemInstance = `-- name: GetItemInstance :one
-SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
 `
 
 type GetItemInstanceParams struct {
@@ -1073,6 +2310,85 @@ func (q *Queries) GetItemInstance(ctx context.Context, arg GetItemInstanceParams
 		&i.CellID,
 		&i.Status,
 		&i.AffectedByTaskID,
+		&i.SerialNumber,
+		&i.LotNumber,
+		&i.ManufacturedAt,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getItemInstanceChangesBySerialNumber = `-- name: GetItemInstanceChangesBySerialNumber :many
+SELECT id, org_id, user_id, action, time, target_object_type, target_object_id, prechange_state, postchange_state, reason FROM app_object_change
+WHERE org_id = $1 AND target_object_type = 7
+  AND (postchange_state->>'serial_number' = $2::text OR prechange_state->>'serial_number' = $2::text)
+ORDER BY time, id
+`
+
+type GetItemInstanceChangesBySerialNumberParams struct {
+	OrgID        pgtype.UUID
+	SerialNumber string
+}
+
+// Changes of every instance which carried the serial number, including
+// deleted ones
+func (q *Queries) GetItemInstanceChangesBySerialNumber(ctx context.Context, arg GetItemInstanceChangesBySerialNumberParams) ([]AppObjectChange, error) {
+	rows, err := q.db.Query(ctx, getItemInstanceChangesBySerialNumber, arg.OrgID, arg.SerialNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AppObjectChange
+	for rows.Next() {
+		var i AppObjectChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UserID,
+			&i.Action,
+			&i.Time,
+			&i.TargetObjectType,
+			&i.TargetObjectID,
+			&i.PrechangeState,
+			&i.PostchangeState,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getItemInstanceForUpdate = `-- name: GetItemInstanceForUpdate :one
+SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE
+`
+
+type GetItemInstanceForUpdateParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetItemInstanceForUpdate(ctx context.Context, arg GetItemInstanceForUpdateParams) (ItemInstance, error) {
+	row := q.db.QueryRow(ctx, getItemInstanceForUpdate, arg.OrgID, arg.ID)
+	var i ItemInstance
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.ItemID,
+		&i.VariantID,
+		&i.CellID,
+		&i.Status,
+		&i.AffectedByTaskID,
+		&i.SerialNumber,
+		&i.LotNumber,
+		&i.ManufacturedAt,
+		&i.ExpiresAt,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -1080,7 +2396,7 @@ func (q *Queries) GetItemInstance(ctx context.Context, arg GetItemInstanceParams
 }
 
 const getItemInstancesAll = `-- name: GetItemInstancesAll :many
-SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND deleted_at IS NULL
+SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetItemInstancesAll(ctx context.Context, orgID pgtype.UUID) ([]ItemInstance, error) {
@@ -1100,6 +2416,10 @@ func (q *Queries) GetItemInstancesAll(ctx context.Context, orgID pgtype.UUID) ([
 			&i.CellID,
 			&i.Status,
 			&i.AffectedByTaskID,
+			&i.SerialNumber,
+			&i.LotNumber,
+			&i.ManufacturedAt,
+			&i.ExpiresAt,
 			&i.CreatedAt,
 			&i.DeletedAt,
 		); err != nil {
@@ -1114,7 +2434,7 @@ func (q *Queries) GetItemInstancesAll(ctx context.Context, orgID pgtype.UUID) ([
 }
 
 const getItemInstancesForCell = `-- name: GetItemInstancesForCell :many
-SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND cell_id = $2 AND deleted_at IS NULL
+SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND cell_id = $2 AND deleted_at IS NULL
 `
 
 type GetItemInstancesForCellParams struct {
@@ -1139,6 +2459,10 @@ func (q *Queries) GetItemInstancesForCell(ctx context.Context, arg GetItemInstan
 			&i.CellID,
 			&i.Status,
 			&i.AffectedByTaskID,
+			&i.SerialNumber,
+			&i.LotNumber,
+			&i.ManufacturedAt,
+			&i.ExpiresAt,
 			&i.CreatedAt,
 			&i.DeletedAt,
 		); err != nil {
@@ -1152,17 +2476,17 @@ func (q *Queries) GetItemInstancesForCell(ctx context.Context, arg GetItemInstan
 	return items, nil
 }
 
-const getItemInstancesForCellsGroup = `-- name: GetItemInstancesForCellsGroup :many
-SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, created_at, deleted_at FROM item_instance WHERE item_instance.org_id = $1 AND cell_id IN (SELECT id FROM cell WHERE cells_group_id = $2 AND deleted_at IS NULL) AND deleted_at IS NULL
+const getItemInstancesForCells = `-- name: GetItemInstancesForCells :many
+SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND cell_id = ANY($2::uuid[]) AND status NOT IN ('consumed', 'disposed', 'shipped') AND deleted_at IS NULL
 `
 
-type GetItemInstancesForCellsGroupParams struct {
-	OrgID        pgtype.UUID
-	CellsGroupID pgtype.UUID
+type GetItemInstancesForCellsParams struct {
+	OrgID   pgtype.UUID
+	CellIds []pgtype.UUID
 }
 
-func (q *Queries) GetItemInstancesForCellsGroup(ctx context.Context, arg GetItemInstancesForCellsGroupParams) ([]ItemInstance, error) {
-	rows, err := q.db.Query(ctx, getItemInstancesForCellsGroup, arg.OrgID, arg.CellsGroupID)
+func (q *Queries) GetItemInstancesForCells(ctx context.Context, arg GetItemInstancesForCellsParams) ([]ItemInstance, error) {
+	rows, err := q.db.Query(ctx, getItemInstancesForCells, arg.OrgID, arg.CellIds)
 	if err != nil {
 		return nil, err
 	}
@@ -1178,6 +2502,10 @@ func (q *Queries) GetItemInstancesForCellsGroup(ctx context.Context, arg GetItem
 			&i.CellID,
 			&i.Status,
 			&i.AffectedByTaskID,
+			&i.SerialNumber,
+			&i.LotNumber,
+			&i.ManufacturedAt,
+			&i.ExpiresAt,
 			&i.CreatedAt,
 			&i.DeletedAt,
 		); err != nil {
@@ -1191,17 +2519,17 @@ func (q *Queries) GetItemInstancesForCellsGroup(ctx context.Context, arg GetItem
 	return items, nil
 }
 
-const getItemInstancesForItem = `-- name: GetItemInstancesForItem :many
-SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND item_id = $2 AND deleted_at IS NULL
+const getItemInstancesForCellsGroup = `-- name: GetItemInstancesForCellsGroup :many
+SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at FROM item_instance WHERE item_instance.org_id = $1 AND cell_id IN (SELECT id FROM cell WHERE cells_group_id = $2 AND deleted_at IS NULL) AND deleted_at IS NULL
 `
 
-type GetItemInstancesForItemParams struct {
-	OrgID  pgtype.UUID
-	ItemID pgtype.UUID
+type GetItemInstancesForCellsGroupParams struct {
+	OrgID        pgtype.UUID
+	CellsGroupID pgtype.UUID
 }
 
-func (q *Queries) GetItemInstancesForItem(ctx context.Context, arg GetItemInstancesForItemParams) ([]ItemInstance, error) {
-	rows, err := q.db.Query(ctx, getItemInstancesForItem, arg.OrgID, arg.ItemID)
+func (q *Queries) GetItemInstancesForCellsGroup(ctx context.Context, arg GetItemInstancesForCellsGroupParams) ([]ItemInstance, error) {
+	rows, err := q.db.Query(ctx, getItemInstancesForCellsGroup, arg.OrgID, arg.CellsGroupID)
 	if err != nil {
 		return nil, err
 	}
@@ -1217,6 +2545,10 @@ func (q *Queries) GetItemInstancesForItem(ctx context.Context, arg GetItemInstan
 			&i.CellID,
 			&i.Status,
 			&i.AffectedByTaskID,
+			&i.SerialNumber,
+			&i.LotNumber,
+			&i.ManufacturedAt,
+			&i.ExpiresAt,
 			&i.CreatedAt,
 			&i.DeletedAt,
 		); err != nil {
@@ -1230,11 +2562,127 @@ func (q *Queries) GetItemInstancesForItem(ctx context.Context, arg GetItemInstan
 	return items, nil
 }
 
-const getItemVariantById = `-- name: GetItemVariantById :one
-SELECT id, org_id, item_id, name, article, ean13, created_at, deleted_at FROM item_variant WHERE org_id = $1 AND item_id = $2 AND id = $3 AND deleted_at IS NULL
+const getItemInstancesForItem = `-- name: GetItemInstancesForItem :many
+SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at FROM item_instance WHERE org_id = $1 AND item_id = $2 AND deleted_at IS NULL
 `
 
-type GetItemVariantByIdParams struct {
+type GetItemInstancesForItemParams struct {
+	OrgID  pgtype.UUID
+	ItemID pgtype.UUID
+}
+
+func (q *Queries) GetItemInstancesForItem(ctx context.Context, arg GetItemInstancesForItemParams) ([]ItemInstance, error) {
+	rows, err := q.db.Query(ctx, getItemInstancesForItem, arg.OrgID, arg.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemInstance
+	for rows.Next() {
+		var i ItemInstance
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.VariantID,
+			&i.CellID,
+			&i.Status,
+			&i.AffectedByTaskID,
+			&i.SerialNumber,
+			&i.LotNumber,
+			&i.ManufacturedAt,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getItemInstancesWithItemByIds = `-- name: GetItemInstancesWithItemByIds :many
+SELECT item_instance.id, item_instance.org_id, item_instance.item_id, item_instance.variant_id, item_instance.cell_id, item_instance.status, item_instance.affected_by_task_id, item_instance.serial_number, item_instance.lot_number, item_instance.manufactured_at, item_instance.expires_at, item_instance.created_at, item_instance.deleted_at, item_variant.id, item_variant.org_id, item_variant.item_id, item_variant.name, item_variant.article, item_variant.ean13, item_variant.tracking_mode, item_variant.allocation_policy, item_variant.requires_serial, item_variant.created_at, item_variant.deleted_at, item.id, item.org_id, item.name, item.description, item.width, item.depth, item.height, item.weight, item.created_at, item.deleted_at FROM item_instance
+JOIN item_variant ON item_variant.id = item_instance.variant_id
+JOIN item ON item.id = item_instance.item_id
+WHERE item_instance.org_id = $1 AND item_instance.id = ANY($2::uuid[]) AND item_instance.deleted_at IS NULL
+`
+
+type GetItemInstancesWithItemByIdsParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
+}
+
+type GetItemInstancesWithItemByIdsRow struct {
+	ItemInstance ItemInstance
+	ItemVariant  ItemVariant
+	Item         Item
+}
+
+func (q *Queries) GetItemInstancesWithItemByIds(ctx context.Context, arg GetItemInstancesWithItemByIdsParams) ([]GetItemInstancesWithItemByIdsRow, error) {
+	rows, err := q.db.Query(ctx, getItemInstancesWithItemByIds, arg.OrgID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetItemInstancesWithItemByIdsRow
+	for rows.Next() {
+		var i GetItemInstancesWithItemByIdsRow
+		if err := rows.Scan(
+			&i.ItemInstance.ID,
+			&i.ItemInstance.OrgID,
+			&i.ItemInstance.ItemID,
+			&i.ItemInstance.VariantID,
+			&i.ItemInstance.CellID,
+			&i.ItemInstance.Status,
+			&i.ItemInstance.AffectedByTaskID,
+			&i.ItemInstance.SerialNumber,
+			&i.ItemInstance.LotNumber,
+			&i.ItemInstance.ManufacturedAt,
+			&i.ItemInstance.ExpiresAt,
+			&i.ItemInstance.CreatedAt,
+			&i.ItemInstance.DeletedAt,
+			&i.ItemVariant.ID,
+			&i.ItemVariant.OrgID,
+			&i.ItemVariant.ItemID,
+			&i.ItemVariant.Name,
+			&i.ItemVariant.Article,
+			&i.ItemVariant.Ean13,
+			&i.ItemVariant.TrackingMode,
+			&i.ItemVariant.AllocationPolicy,
+			&i.ItemVariant.RequiresSerial,
+			&i.ItemVariant.CreatedAt,
+			&i.ItemVariant.DeletedAt,
+			&i.Item.ID,
+			&i.Item.OrgID,
+			&i.Item.Name,
+			&i.Item.Description,
+			&i.Item.Width,
+			&i.Item.Depth,
+			&i.Item.Height,
+			&i.Item.Weight,
+			&i.Item.CreatedAt,
+			&i.Item.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getItemVariantById = `-- name: GetItemVariantById :one
+SELECT id, org_id, item_id, name, article, ean13, tracking_mode, allocation_policy, requires_serial, created_at, deleted_at FROM item_variant WHERE org_id = $1 AND item_id = $2 AND id = $3 AND deleted_at IS NULL
+`
+
+type GetItemVariantByIdParams struct {
 	OrgID  pgtype.UUID
 	ItemID pgtype.UUID
 	ID     pgtype.UUID
@@ -1250,6 +2698,9 @@ func (q *Queries) GetItemVariantById(ctx context.Context, arg GetItemVariantById
 		&i.Name,
 		&i.Article,
 		&i.Ean13,
+		&i.TrackingMode,
+		&i.AllocationPolicy,
+		&i.RequiresSerial,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -1257,7 +2708,7 @@ func (q *Queries) GetItemVariantById(ctx context.Context, arg GetItemVariantById
 }
 
 const getItemVariants = `-- name: GetItemVariants :many
-SELECT id, org_id, item_id, name, article, ean13, created_at, deleted_at FROM item_variant WHERE org_id = $1 AND item_id = $2 AND deleted_at IS NULL
+SELECT id, org_id, item_id, name, article, ean13, tracking_mode, allocation_policy, requires_serial, created_at, deleted_at FROM item_variant WHERE org_id = $1 AND item_id = $2 AND deleted_at IS NULL
 `
 
 type GetItemVariantsParams struct {
@@ -1281,6 +2732,134 @@ func (q *Queries) GetItemVariants(ctx context.Context, arg GetItemVariantsParams
 			&i.Name,
 			&i.Article,
 			&i.Ean13,
+			&i.TrackingMode,
+			&i.AllocationPolicy,
+			&i.RequiresSerial,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getItemVariantsByBarcodes = `-- name: GetItemVariantsByBarcodes :many
+SELECT id, org_id, item_id, name, article, ean13, tracking_mode, allocation_policy, requires_serial, created_at, deleted_at FROM item_variant WHERE org_id = $1 AND deleted_at IS NULL
+  AND (ean13 = ANY($2::bigint[]) OR article = ANY($3::text[]))
+`
+
+type GetItemVariantsByBarcodesParams struct {
+	OrgID    pgtype.UUID
+	Ean13s   []int64
+	Articles []string
+}
+
+func (q *Queries) GetItemVariantsByBarcodes(ctx context.Context, arg GetItemVariantsByBarcodesParams) ([]ItemVariant, error) {
+	rows, err := q.db.Query(ctx, getItemVariantsByBarcodes, arg.OrgID, arg.Ean13s, arg.Articles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemVariant
+	for rows.Next() {
+		var i ItemVariant
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.Name,
+			&i.Article,
+			&i.Ean13,
+			&i.TrackingMode,
+			&i.AllocationPolicy,
+			&i.RequiresSerial,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getItemVariantsByIds = `-- name: GetItemVariantsByIds :many
+SELECT id, org_id, item_id, name, article, ean13, tracking_mode, allocation_policy, requires_serial, created_at, deleted_at FROM item_variant WHERE org_id = $1 AND id = ANY($2::uuid[]) AND deleted_at IS NULL
+`
+
+type GetItemVariantsByIdsParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
+}
+
+func (q *Queries) GetItemVariantsByIds(ctx context.Context, arg GetItemVariantsByIdsParams) ([]ItemVariant, error) {
+	rows, err := q.db.Query(ctx, getItemVariantsByIds, arg.OrgID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemVariant
+	for rows.Next() {
+		var i ItemVariant
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.Name,
+			&i.Article,
+			&i.Ean13,
+			&i.TrackingMode,
+			&i.AllocationPolicy,
+			&i.RequiresSerial,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getItemVariantsByItemIds = `-- name: GetItemVariantsByItemIds :many
+SELECT id, org_id, item_id, name, article, ean13, tracking_mode, allocation_policy, requires_serial, created_at, deleted_at FROM item_variant WHERE org_id = $1 AND item_id = ANY($2::uuid[]) AND deleted_at IS NULL
+`
+
+type GetItemVariantsByItemIdsParams struct {
+	OrgID   pgtype.UUID
+	ItemIds []pgtype.UUID
+}
+
+func (q *Queries) GetItemVariantsByItemIds(ctx context.Context, arg GetItemVariantsByItemIdsParams) ([]ItemVariant, error) {
+	rows, err := q.db.Query(ctx, getItemVariantsByItemIds, arg.OrgID, arg.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemVariant
+	for rows.Next() {
+		var i ItemVariant
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.Name,
+			&i.Article,
+			&i.Ean13,
+			&i.TrackingMode,
+			&i.AllocationPolicy,
+			&i.RequiresSerial,
 			&i.CreatedAt,
 			&i.DeletedAt,
 		); err != nil {
@@ -1330,7 +2909,7 @@ func (q *Queries) GetItems(ctx context.Context, orgID pgtype.UUID) ([]Item, erro
 }
 
 const getObjectChanges = `-- name: GetObjectChanges :many
-SELECT id, org_id, user_id, action, time, target_object_type, target_object_id, prechange_state, postchange_state FROM app_object_change WHERE org_id = $1 AND target_object_type = $2 AND target_object_id = $3
+SELECT id, org_id, user_id, action, time, target_object_type, target_object_id, prechange_state, postchange_state, reason FROM app_object_change WHERE org_id = $1 AND target_object_type = $2 AND target_object_id = $3
 `
 
 type GetObjectChangesParams struct {
@@ -1358,6 +2937,7 @@ func (q *Queries) GetObjectChanges(ctx context.Context, arg GetObjectChangesPara
 			&i.TargetObjectID,
 			&i.PrechangeState,
 			&i.PostchangeState,
+			&i.Reason,
 		); err != nil {
 			return nil, err
 		}
@@ -1381,6 +2961,50 @@ func (q *Queries) GetObjectTypeById(ctx context.Context, id int32) (ObjectType,
 	return i, err
 }
 
+const getOpenReturnTaskItemsForInstances = `-- name: GetOpenReturnTaskItemsForInstances :many
+SELECT task_item.id, task_item.org_id, task_item.task_id, task_item.item_instance_id, task_item.stock_line_id, task_item.quantity, task_item.status, task_item.source_cell_id, task_item.destination_cell_id, task_item.disposition FROM task_item
+JOIN task ON task.id = task_item.task_id
+WHERE task_item.org_id = $1 AND task_item.item_instance_id = ANY($2::uuid[])
+  AND task_item.status = 'pending' AND task.type = 'return'
+  AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+`
+
+type GetOpenReturnTaskItemsForInstancesParams struct {
+	OrgID       pgtype.UUID
+	InstanceIds []pgtype.UUID
+}
+
+func (q *Queries) GetOpenReturnTaskItemsForInstances(ctx context.Context, arg GetOpenReturnTaskItemsForInstancesParams) ([]TaskItem, error) {
+	rows, err := q.db.Query(ctx, getOpenReturnTaskItemsForInstances, arg.OrgID, arg.InstanceIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskItem
+	for rows.Next() {
+		var i TaskItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.ItemInstanceID,
+			&i.StockLineID,
+			&i.Quantity,
+			&i.Status,
+			&i.SourceCellID,
+			&i.DestinationCellID,
+			&i.Disposition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getOrgIdByApiToken = `-- name: GetOrgIdByApiToken :one
 SELECT org_id FROM app_api_token WHERE token = $1 AND revoked_at IS NULL
 `
@@ -1393,7 +3017,7 @@ func (q *Queries) GetOrgIdByApiToken(ctx context.Context, token string) (pgtype.
 }
 
 const getOrgUnitById = `-- name: GetOrgUnitById :one
-SELECT id, org_id, name, alias, address, created_at, deleted_at FROM org_unit WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+SELECT id, org_id, name, alias, address, pick_path_strategy, last_assigned_user_id, created_at, deleted_at FROM org_unit WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
 `
 
 type GetOrgUnitByIdParams struct {
@@ -1410,6 +3034,8 @@ func (q *Queries) GetOrgUnitById(ctx context.Context, arg GetOrgUnitByIdParams)
 		&i.Name,
 		&i.Alias,
 		&i.Address,
+		&i.PickPathStrategy,
+		&i.LastAssignedUserID,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -1417,7 +3043,7 @@ func (q *Queries) GetOrgUnitById(ctx context.Context, arg GetOrgUnitByIdParams)
 }
 
 const getOrgUnits = `-- name: GetOrgUnits :many
-SELECT id, org_id, name, alias, address, created_at, deleted_at FROM org_unit WHERE org_id = $1 AND deleted_at IS NULL
+SELECT id, org_id, name, alias, address, pick_path_strategy, last_assigned_user_id, created_at, deleted_at FROM org_unit WHERE org_id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetOrgUnits(ctx context.Context, orgID pgtype.UUID) ([]OrgUnit, error) {
@@ -1435,6 +3061,47 @@ func (q *Queries) GetOrgUnits(ctx context.Context, orgID pgtype.UUID) ([]OrgUnit
 			&i.Name,
 			&i.Alias,
 			&i.Address,
+			&i.PickPathStrategy,
+			&i.LastAssignedUserID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOrgUnitsByIds = `-- name: GetOrgUnitsByIds :many
+SELECT id, org_id, name, alias, address, pick_path_strategy, last_assigned_user_id, created_at, deleted_at FROM org_unit WHERE org_id = $1 AND id = ANY($2::uuid[]) AND deleted_at IS NULL
+`
+
+type GetOrgUnitsByIdsParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
+}
+
+func (q *Queries) GetOrgUnitsByIds(ctx context.Context, arg GetOrgUnitsByIdsParams) ([]OrgUnit, error) {
+	rows, err := q.db.Query(ctx, getOrgUnitsByIds, arg.OrgID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrgUnit
+	for rows.Next() {
+		var i OrgUnit
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.Name,
+			&i.Alias,
+			&i.Address,
+			&i.PickPathStrategy,
+			&i.LastAssignedUserID,
 			&i.CreatedAt,
 			&i.DeletedAt,
 		); err != nil {
@@ -1449,7 +3116,7 @@ func (q *Queries) GetOrgUnits(ctx context.Context, orgID pgtype.UUID) ([]OrgUnit
 }
 
 const getOrganization = `-- name: GetOrganization :one
-SELECT id, name, subdomain, created_at, deleted_at FROM org WHERE id = $1 AND deleted_at IS NULL
+SELECT id, name, subdomain, task_assignment_strategy, allocation_policy, created_at, deleted_at FROM org WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetOrganization(ctx context.Context, id pgtype.UUID) (Org, error) {
@@ -1459,46 +3126,52 @@ func (q *Queries) GetOrganization(ctx context.Context, id pgtype.UUID) (Org, err
 		&i.ID,
 		&i.Name,
 		&i.Subdomain,
+		&i.TaskAssignmentStrategy,
+		&i.AllocationPolicy,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const getRoleById = `-- name: GetRoleById :one
-SELECT id, name, display_name, description FROM app_role WHERE id = $1
-`
-
-func (q *Queries) GetRoleById(ctx context.Context, id int32) (AppRole, error) {
-	row := q.db.QueryRow(ctx, getRoleById, id)
-	var i AppRole
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.DisplayName,
-		&i.Description,
-	)
-	return i, err
-}
-
-const getRoles = `-- name: GetRoles :many
-SELECT id, name, display_name, description FROM app_role
+const getOverdueTasks = `-- name: GetOverdueTasks :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task
+WHERE due_at < (now() AT TIME ZONE 'UTC') AND sla_breached_at IS NULL
+  AND status IN ('pending', 'in_progress', 'ready') AND deleted_at IS NULL
+ORDER BY due_at
+LIMIT $1::int
 `
 
-func (q *Queries) GetRoles(ctx context.Context) ([]AppRole, error) {
-	rows, err := q.db.Query(ctx, getRoles)
+func (q *Queries) GetOverdueTasks(ctx context.Context, maxRows int32) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getOverdueTasks, maxRows)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AppRole
+	var items []Task
 	for rows.Next() {
-		var i AppRole
+		var i Task
 		if err := rows.Scan(
 			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
 			&i.Name,
-			&i.DisplayName,
 			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1510,72 +3183,133 @@ func (q *Queries) GetRoles(ctx context.Context) ([]AppRole, error) {
 	return items, nil
 }
 
-const getSessionBySecret = `-- name: GetSessionBySecret :one
-SELECT id, user_id, token, created_at, expires_at, revoked_at FROM app_user_session WHERE token = $1 LIMIT 1
+const getPendingTasksAssignedToUserForUpdate = `-- name: GetPendingTasksAssignedToUserForUpdate :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task
+WHERE org_id = $1 AND assigned_to_user_id = $2 AND status = 'pending' AND deleted_at IS NULL
+ORDER BY created_at
+FOR UPDATE
 `
 
-func (q *Queries) GetSessionBySecret(ctx context.Context, token string) (AppUserSession, error) {
-	row := q.db.QueryRow(ctx, getSessionBySecret, token)
-	var i AppUserSession
+type GetPendingTasksAssignedToUserForUpdateParams struct {
+	OrgID            pgtype.UUID
+	AssignedToUserID pgtype.UUID
+}
+
+func (q *Queries) GetPendingTasksAssignedToUserForUpdate(ctx context.Context, arg GetPendingTasksAssignedToUserForUpdateParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getPendingTasksAssignedToUserForUpdate, arg.OrgID, arg.AssignedToUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPickWaveById = `-- name: GetPickWaveById :one
+SELECT id, org_id, unit_id, name, status, consolidation_cell_id, created_at, completed_at, deleted_at FROM pick_wave WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+`
+
+type GetPickWaveByIdParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetPickWaveById(ctx context.Context, arg GetPickWaveByIdParams) (PickWave, error) {
+	row := q.db.QueryRow(ctx, getPickWaveById, arg.OrgID, arg.ID)
+	var i PickWave
 	err := row.Scan(
 		&i.ID,
-		&i.UserID,
-		&i.Token,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Name,
+		&i.Status,
+		&i.ConsolidationCellID,
 		&i.CreatedAt,
-		&i.ExpiresAt,
-		&i.RevokedAt,
+		&i.CompletedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const getStorageGroupById = `-- name: GetStorageGroupById :one
-SELECT id, org_id, unit_id, parent_id, name, alias, description, created_at, deleted_at FROM storage_group WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+const getPickWaveByIdForUpdate = `-- name: GetPickWaveByIdForUpdate :one
+SELECT id, org_id, unit_id, name, status, consolidation_cell_id, created_at, completed_at, deleted_at FROM pick_wave WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE
 `
 
-type GetStorageGroupByIdParams struct {
+type GetPickWaveByIdForUpdateParams struct {
 	OrgID pgtype.UUID
 	ID    pgtype.UUID
 }
 
-func (q *Queries) GetStorageGroupById(ctx context.Context, arg GetStorageGroupByIdParams) (StorageGroup, error) {
-	row := q.db.QueryRow(ctx, getStorageGroupById, arg.OrgID, arg.ID)
-	var i StorageGroup
+func (q *Queries) GetPickWaveByIdForUpdate(ctx context.Context, arg GetPickWaveByIdForUpdateParams) (PickWave, error) {
+	row := q.db.QueryRow(ctx, getPickWaveByIdForUpdate, arg.OrgID, arg.ID)
+	var i PickWave
 	err := row.Scan(
 		&i.ID,
 		&i.OrgID,
 		&i.UnitID,
-		&i.ParentID,
 		&i.Name,
-		&i.Alias,
-		&i.Description,
+		&i.Status,
+		&i.ConsolidationCellID,
 		&i.CreatedAt,
+		&i.CompletedAt,
 		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const getStorageGroups = `-- name: GetStorageGroups :many
-SELECT id, org_id, unit_id, parent_id, name, alias, description, created_at, deleted_at FROM storage_group WHERE org_id = $1 AND deleted_at IS NULL
+const getPickWaves = `-- name: GetPickWaves :many
+SELECT id, org_id, unit_id, name, status, consolidation_cell_id, created_at, completed_at, deleted_at FROM pick_wave WHERE org_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC
 `
 
-func (q *Queries) GetStorageGroups(ctx context.Context, orgID pgtype.UUID) ([]StorageGroup, error) {
-	rows, err := q.db.Query(ctx, getStorageGroups, orgID)
+func (q *Queries) GetPickWaves(ctx context.Context, orgID pgtype.UUID) ([]PickWave, error) {
+	rows, err := q.db.Query(ctx, getPickWaves, orgID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []StorageGroup
+	var items []PickWave
 	for rows.Next() {
-		var i StorageGroup
+		var i PickWave
 		if err := rows.Scan(
 			&i.ID,
 			&i.OrgID,
 			&i.UnitID,
-			&i.ParentID,
 			&i.Name,
-			&i.Alias,
-			&i.Description,
+			&i.Status,
+			&i.ConsolidationCellID,
 			&i.CreatedAt,
+			&i.CompletedAt,
 			&i.DeletedAt,
 		); err != nil {
 			return nil, err
@@ -1588,60 +3322,40 @@ func (q *Queries) GetStorageGroups(ctx context.Context, orgID pgtype.UUID) ([]St
 	return items, nil
 }
 
-const getTaskById = `-- name: GetTaskById :one
-SELECT id, org_id, unit_id, type, status, name, description, assigned_to_user_id, assigned_at, completed_at, created_at, deleted_at FROM task WHERE org_id = $1 AND id = $2
+const getRoleById = `-- name: GetRoleById :one
+SELECT id, name, display_name, description FROM app_role WHERE id = $1
 `
 
-type GetTaskByIdParams struct {
-	OrgID pgtype.UUID
-	ID    pgtype.UUID
-}
-
-func (q *Queries) GetTaskById(ctx context.Context, arg GetTaskByIdParams) (Task, error) {
-	row := q.db.QueryRow(ctx, getTaskById, arg.OrgID, arg.ID)
-	var i Task
+func (q *Queries) GetRoleById(ctx context.Context, id int32) (AppRole, error) {
+	row := q.db.QueryRow(ctx, getRoleById, id)
+	var i AppRole
 	err := row.Scan(
 		&i.ID,
-		&i.OrgID,
-		&i.UnitID,
-		&i.Type,
-		&i.Status,
 		&i.Name,
+		&i.DisplayName,
 		&i.Description,
-		&i.AssignedToUserID,
-		&i.AssignedAt,
-		&i.CompletedAt,
-		&i.CreatedAt,
-		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const getTaskItems = `-- name: GetTaskItems :many
-SELECT org_id, task_id, item_instance_id, status, source_cell_id, destination_cell_id FROM task_item WHERE org_id = $1 AND task_id = $2
+const getRoles = `-- name: GetRoles :many
+SELECT id, name, display_name, description FROM app_role
 `
 
-type GetTaskItemsParams struct {
-	OrgID  pgtype.UUID
-	TaskID pgtype.UUID
-}
-
-func (q *Queries) GetTaskItems(ctx context.Context, arg GetTaskItemsParams) ([]TaskItem, error) {
-	rows, err := q.db.Query(ctx, getTaskItems, arg.OrgID, arg.TaskID)
+func (q *Queries) GetRoles(ctx context.Context) ([]AppRole, error) {
+	rows, err := q.db.Query(ctx, getRoles)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []TaskItem
+	var items []AppRole
 	for rows.Next() {
-		var i TaskItem
+		var i AppRole
 		if err := rows.Scan(
-			&i.OrgID,
-			&i.TaskID,
-			&i.ItemInstanceID,
-			&i.Status,
-			&i.SourceCellID,
-			&i.DestinationCellID,
+			&i.ID,
+			&i.Name,
+			&i.DisplayName,
+			&i.Description,
 		); err != nil {
 			return nil, err
 		}
@@ -1653,32 +3367,93 @@ func (q *Queries) GetTaskItems(ctx context.Context, arg GetTaskItemsParams) ([]T
 	return items, nil
 }
 
-const getTasks = `-- name: GetTasks :many
-SELECT id, org_id, unit_id, type, status, name, description, assigned_to_user_id, assigned_at, completed_at, created_at, deleted_at FROM task WHERE org_id = $1
+const getSessionBySecret = `-- name: GetSessionBySecret :one
+SELECT id, user_id, token, created_at, expires_at, revoked_at FROM app_user_session WHERE token = $1 LIMIT 1
 `
 
-func (q *Queries) GetTasks(ctx context.Context, orgID pgtype.UUID) ([]Task, error) {
-	rows, err := q.db.Query(ctx, getTasks, orgID)
+func (q *Queries) GetSessionBySecret(ctx context.Context, token string) (AppUserSession, error) {
+	row := q.db.QueryRow(ctx, getSessionBySecret, token)
+	var i AppUserSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Token,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getStockCounts = `-- name: GetStockCounts :many
+WITH stock AS (
+  SELECT item_id, variant_id, cell_id, status, affected_by_task_id, 1 AS quantity
+  FROM item_instance WHERE item_instance.org_id = $1 AND item_instance.deleted_at IS NULL
+  UNION ALL
+  SELECT item_id, variant_id, cell_id, status, affected_by_task_id, quantity
+  FROM stock_line WHERE stock_line.org_id = $1 AND stock_line.deleted_at IS NULL
+)
+SELECT
+  stock.item_id::uuid AS item_id,
+  stock.variant_id::uuid AS variant_id,
+  stock.cell_id::uuid AS cell_id,
+  COALESCE(cells_group.unit_id, task.unit_id)::uuid AS unit_id,
+  stock.status::item_instance_status AS status,
+  SUM(stock.quantity)::int AS quantity
+FROM stock
+LEFT JOIN cell ON cell.id = stock.cell_id
+LEFT JOIN cells_group ON cells_group.id = cell.cells_group_id
+LEFT JOIN task ON task.id = stock.affected_by_task_id AND stock.cell_id IS NULL
+WHERE stock.status::text = ANY($2::text[])
+  AND ($3::uuid IS NULL OR stock.item_id = $3)
+  AND ($4::uuid IS NULL OR stock.variant_id = $4)
+  AND ($5::uuid IS NULL OR COALESCE(cells_group.unit_id, task.unit_id) = $5)
+GROUP BY stock.item_id, stock.variant_id, stock.cell_id, COALESCE(cells_group.unit_id, task.unit_id), stock.status
+`
+
+type GetStockCountsParams struct {
+	OrgID     pgtype.UUID
+	Statuses  []string
+	ItemID    pgtype.UUID
+	VariantID pgtype.UUID
+	UnitID    pgtype.UUID
+}
+
+type GetStockCountsRow struct {
+	ItemID    pgtype.UUID
+	VariantID pgtype.UUID
+	CellID    pgtype.UUID
+	UnitID    pgtype.UUID
+	Status    ItemInstanceStatus
+	Quantity  int32
+}
+
+// Stock
+// Quantities per variant, cell and status, counting instances one by one
+// and stock lines by their quantity. Stock out of any cell is attributed to
+// the unit of the task which last affected it.
+func (q *Queries) GetStockCounts(ctx context.Context, arg GetStockCountsParams) ([]GetStockCountsRow, error) {
+	rows, err := q.db.Query(ctx, getStockCounts,
+		arg.OrgID,
+		arg.Statuses,
+		arg.ItemID,
+		arg.VariantID,
+		arg.UnitID,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Task
+	var items []GetStockCountsRow
 	for rows.Next() {
-		var i Task
+		var i GetStockCountsRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.OrgID,
+			&i.ItemID,
+			&i.VariantID,
+			&i.CellID,
 			&i.UnitID,
-			&i.Type,
 			&i.Status,
-			&i.Name,
-			&i.Description,
-			&i.AssignedToUserID,
-			&i.AssignedAt,
-			&i.CompletedAt,
-			&i.CreatedAt,
-			&i.DeletedAt,
+			&i.Quantity,
 		); err != nil {
 			return nil, err
 		}
@@ -1690,68 +3465,102 @@ func (q *Queries) GetTasks(ctx context.Context, orgID pgtype.UUID) ([]Task, erro
 	return items, nil
 }
 
-const getTvBoardById = `-- name: GetTvBoardById :one
-SELECT id, org_id, unit_id, name, token, created_at, deleted_at FROM tv_board WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+const getStockLine = `-- name: GetStockLine :one
+SELECT id, org_id, item_id, variant_id, cell_id, status, quantity, affected_by_task_id, created_at, deleted_at FROM stock_line WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
 `
 
-type GetTvBoardByIdParams struct {
+type GetStockLineParams struct {
 	OrgID pgtype.UUID
 	ID    pgtype.UUID
 }
 
-func (q *Queries) GetTvBoardById(ctx context.Context, arg GetTvBoardByIdParams) (TvBoard, error) {
-	row := q.db.QueryRow(ctx, getTvBoardById, arg.OrgID, arg.ID)
-	var i TvBoard
+func (q *Queries) GetStockLine(ctx context.Context, arg GetStockLineParams) (StockLine, error) {
+	row := q.db.QueryRow(ctx, getStockLine, arg.OrgID, arg.ID)
+	var i StockLine
 	err := row.Scan(
 		&i.ID,
 		&i.OrgID,
-		&i.UnitID,
-		&i.Name,
-		&i.Token,
+		&i.ItemID,
+		&i.VariantID,
+		&i.CellID,
+		&i.Status,
+		&i.Quantity,
+		&i.AffectedByTaskID,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const getTvBoardByToken = `-- name: GetTvBoardByToken :one
-SELECT id, org_id, unit_id, name, token, created_at, deleted_at FROM tv_board WHERE token = $1 AND deleted_at IS NULL LIMIT 1
+const getStockLineForUpdate = `-- name: GetStockLineForUpdate :one
+SELECT id, org_id, item_id, variant_id, cell_id, status, quantity, affected_by_task_id, created_at, deleted_at FROM stock_line WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE
 `
 
-func (q *Queries) GetTvBoardByToken(ctx context.Context, token string) (TvBoard, error) {
-	row := q.db.QueryRow(ctx, getTvBoardByToken, token)
-	var i TvBoard
+type GetStockLineForUpdateParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetStockLineForUpdate(ctx context.Context, arg GetStockLineForUpdateParams) (StockLine, error) {
+	row := q.db.QueryRow(ctx, getStockLineForUpdate, arg.OrgID, arg.ID)
+	var i StockLine
 	err := row.Scan(
 		&i.ID,
 		&i.OrgID,
-		&i.UnitID,
-		&i.Name,
-		&i.Token,
+		&i.ItemID,
+		&i.VariantID,
+		&i.CellID,
+		&i.Status,
+		&i.Quantity,
+		&i.AffectedByTaskID,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const getTvBoards = `-- name: GetTvBoards :many
-SELECT id, org_id, unit_id, name, token, created_at, deleted_at FROM tv_board WHERE org_id = $1 AND deleted_at IS NULL
+const getStockLines = `-- name: GetStockLines :many
+SELECT id, org_id, item_id, variant_id, cell_id, status, quantity, affected_by_task_id, created_at, deleted_at FROM stock_line
+WHERE org_id = $1 AND deleted_at IS NULL
+  AND ($2::uuid IS NULL OR item_id = $2)
+  AND ($3::uuid IS NULL OR variant_id = $3)
+  AND ($4::uuid IS NULL OR cell_id = $4)
+  AND ($5::item_instance_status IS NULL OR status = $5)
+ORDER BY created_at, id
 `
 
-func (q *Queries) GetTvBoards(ctx context.Context, orgID pgtype.UUID) ([]TvBoard, error) {
-	rows, err := q.db.Query(ctx, getTvBoards, orgID)
+type GetStockLinesParams struct {
+	OrgID     pgtype.UUID
+	ItemID    pgtype.UUID
+	VariantID pgtype.UUID
+	CellID    pgtype.UUID
+	Status    NullItemInstanceStatus
+}
+
+func (q *Queries) GetStockLines(ctx context.Context, arg GetStockLinesParams) ([]StockLine, error) {
+	rows, err := q.db.Query(ctx, getStockLines,
+		arg.OrgID,
+		arg.ItemID,
+		arg.VariantID,
+		arg.CellID,
+		arg.Status,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []TvBoard
+	var items []StockLine
 	for rows.Next() {
-		var i TvBoard
+		var i StockLine
 		if err := rows.Scan(
 			&i.ID,
 			&i.OrgID,
-			&i.UnitID,
-			&i.Name,
-			&i.Token,
+			&i.ItemID,
+			&i.VariantID,
+			&i.CellID,
+			&i.Status,
+			&i.Quantity,
+			&i.AffectedByTaskID,
 			&i.CreatedAt,
 			&i.DeletedAt,
 		); err != nil {
@@ -1765,174 +3574,3335 @@ func (q *Queries) GetTvBoards(ctx context.Context, orgID pgtype.UUID) ([]TvBoard
 	return items, nil
 }
 
-const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, first_name, last_name, middle_name, yandex_id, created_at FROM app_user WHERE email = $1 LIMIT 1
+const getStockLinesForUpdate = `-- name: GetStockLinesForUpdate :many
+SELECT id, org_id, item_id, variant_id, cell_id, status, quantity, affected_by_task_id, created_at, deleted_at FROM stock_line WHERE org_id = $1 AND id = ANY($2::uuid[]) AND deleted_at IS NULL ORDER BY id FOR UPDATE
 `
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (AppUser, error) {
-	row := q.db.QueryRow(ctx, getUserByEmail, email)
-	var i AppUser
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.FirstName,
-		&i.LastName,
-		&i.MiddleName,
-		&i.YandexID,
-		&i.CreatedAt,
-	)
-	return i, err
+type GetStockLinesForUpdateParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
 }
 
-const getUserById = `-- name: GetUserById :one
-SELECT id, email, first_name, last_name, middle_name, yandex_id, created_at FROM app_user WHERE id = $1 LIMIT 1
-`
+func (q *Queries) GetStockLinesForUpdate(ctx context.Context, arg GetStockLinesForUpdateParams) ([]StockLine, error) {
+	rows, err := q.db.Query(ctx, getStockLinesForUpdate, arg.OrgID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []StockLine
+	for rows.Next() {
+		var i StockLine
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.VariantID,
+			&i.CellID,
+			&i.Status,
+			&i.Quantity,
+			&i.AffectedByTaskID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStockLinesWithItemByIds = `-- name: GetStockLinesWithItemByIds :many
+SELECT stock_line.id, stock_line.org_id, stock_line.item_id, stock_line.variant_id, stock_line.cell_id, stock_line.status, stock_line.quantity, stock_line.affected_by_task_id, stock_line.created_at, stock_line.deleted_at, item_variant.id, item_variant.org_id, item_variant.item_id, item_variant.name, item_variant.article, item_variant.ean13, item_variant.tracking_mode, item_variant.allocation_policy, item_variant.requires_serial, item_variant.created_at, item_variant.deleted_at, item.id, item.org_id, item.name, item.description, item.width, item.depth, item.height, item.weight, item.created_at, item.deleted_at FROM stock_line
+JOIN item_variant ON item_variant.id = stock_line.variant_id
+JOIN item ON item.id = stock_line.item_id
+WHERE stock_line.org_id = $1 AND stock_line.id = ANY($2::uuid[]) AND stock_line.deleted_at IS NULL
+`
+
+type GetStockLinesWithItemByIdsParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
+}
+
+type GetStockLinesWithItemByIdsRow struct {
+	StockLine   StockLine
+	ItemVariant ItemVariant
+	Item        Item
+}
+
+func (q *Queries) GetStockLinesWithItemByIds(ctx context.Context, arg GetStockLinesWithItemByIdsParams) ([]GetStockLinesWithItemByIdsRow, error) {
+	rows, err := q.db.Query(ctx, getStockLinesWithItemByIds, arg.OrgID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStockLinesWithItemByIdsRow
+	for rows.Next() {
+		var i GetStockLinesWithItemByIdsRow
+		if err := rows.Scan(
+			&i.StockLine.ID,
+			&i.StockLine.OrgID,
+			&i.StockLine.ItemID,
+			&i.StockLine.VariantID,
+			&i.StockLine.CellID,
+			&i.StockLine.Status,
+			&i.StockLine.Quantity,
+			&i.StockLine.AffectedByTaskID,
+			&i.StockLine.CreatedAt,
+			&i.StockLine.DeletedAt,
+			&i.ItemVariant.ID,
+			&i.ItemVariant.OrgID,
+			&i.ItemVariant.ItemID,
+			&i.ItemVariant.Name,
+			&i.ItemVariant.Article,
+			&i.ItemVariant.Ean13,
+			&i.ItemVariant.TrackingMode,
+			&i.ItemVariant.AllocationPolicy,
+			&i.ItemVariant.RequiresSerial,
+			&i.ItemVariant.CreatedAt,
+			&i.ItemVariant.DeletedAt,
+			&i.Item.ID,
+			&i.Item.OrgID,
+			&i.Item.Name,
+			&i.Item.Description,
+			&i.Item.Width,
+			&i.Item.Depth,
+			&i.Item.Height,
+			&i.Item.Weight,
+			&i.Item.CreatedAt,
+			&i.Item.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStorageGroupById = `-- name: GetStorageGroupById :one
+SELECT id, org_id, unit_id, parent_id, name, alias, description, created_at, deleted_at FROM storage_group WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+`
+
+type GetStorageGroupByIdParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetStorageGroupById(ctx context.Context, arg GetStorageGroupByIdParams) (StorageGroup, error) {
+	row := q.db.QueryRow(ctx, getStorageGroupById, arg.OrgID, arg.ID)
+	var i StorageGroup
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.ParentID,
+		&i.Name,
+		&i.Alias,
+		&i.Description,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getStorageGroups = `-- name: GetStorageGroups :many
+SELECT id, org_id, unit_id, parent_id, name, alias, description, created_at, deleted_at FROM storage_group WHERE org_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetStorageGroups(ctx context.Context, orgID pgtype.UUID) ([]StorageGroup, error) {
+	rows, err := q.db.Query(ctx, getStorageGroups, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []StorageGroup
+	for rows.Next() {
+		var i StorageGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.ParentID,
+			&i.Name,
+			&i.Alias,
+			&i.Description,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSubstituteInstances = `-- name: GetSubstituteInstances :many
+SELECT item_instance.id, item_instance.org_id, item_instance.item_id, item_instance.variant_id, item_instance.cell_id, item_instance.status, item_instance.affected_by_task_id, item_instance.serial_number, item_instance.lot_number, item_instance.manufactured_at, item_instance.expires_at, item_instance.created_at, item_instance.deleted_at FROM item_instance
+JOIN cell ON cell.id = item_instance.cell_id AND cell.deleted_at IS NULL
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+JOIN item_variant ON item_variant.id = item_instance.variant_id
+JOIN org ON org.id = item_instance.org_id
+WHERE item_instance.org_id = $1 AND item_instance.variant_id = $2
+  AND cells_group.unit_id = $3
+  AND item_instance.status = 'available' AND item_instance.deleted_at IS NULL
+  AND (item_instance.expires_at IS NULL OR item_instance.expires_at >= CURRENT_DATE)
+  AND ($4::uuid IS NULL OR item_instance.id = $4)
+  AND NOT EXISTS (
+    SELECT 1 FROM task_item
+    JOIN task ON task.id = task_item.task_id
+    WHERE task_item.item_instance_id = item_instance.id AND task_item.status IN ('pending', 'picked')
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM task_count_cell
+    JOIN task ON task.id = task_count_cell.task_id
+    WHERE task_count_cell.cell_id = item_instance.cell_id
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+ORDER BY
+  CASE WHEN COALESCE(item_variant.allocation_policy, org.allocation_policy) = 'fefo' THEN item_instance.expires_at END NULLS LAST,
+  cell.row, cell.position, cell.level, item_instance.created_at
+LIMIT $5::int
+`
+
+type GetSubstituteInstancesParams struct {
+	OrgID      pgtype.UUID
+	VariantID  pgtype.UUID
+	UnitID     pgtype.UUID
+	InstanceID pgtype.UUID
+	MaxRows    int32
+}
+
+// Available unexpired instances of the variant stored in the unit which are
+// not taken by an open task and not in a frozen cell. Instances expiring first
+// come first when the variant is allocated first expired first out.
+func (q *Queries) GetSubstituteInstances(ctx context.Context, arg GetSubstituteInstancesParams) ([]ItemInstance, error) {
+	rows, err := q.db.Query(ctx, getSubstituteInstances,
+		arg.OrgID,
+		arg.VariantID,
+		arg.UnitID,
+		arg.InstanceID,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemInstance
+	for rows.Next() {
+		var i ItemInstance
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.VariantID,
+			&i.CellID,
+			&i.Status,
+			&i.AffectedByTaskID,
+			&i.SerialNumber,
+			&i.LotNumber,
+			&i.ManufacturedAt,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskAttachment = `-- name: GetTaskAttachment :one
+SELECT id, org_id, task_id, item_instance_id, comment_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, deleted_at FROM task_attachment WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL
+`
+
+type GetTaskAttachmentParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+	ID     pgtype.UUID
+}
+
+func (q *Queries) GetTaskAttachment(ctx context.Context, arg GetTaskAttachmentParams) (TaskAttachment, error) {
+	row := q.db.QueryRow(ctx, getTaskAttachment, arg.OrgID, arg.TaskID, arg.ID)
+	var i TaskAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.CommentID,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.StorageKey,
+		&i.UploadedByUserID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTaskAttachments = `-- name: GetTaskAttachments :many
+SELECT id, org_id, task_id, item_instance_id, comment_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, deleted_at FROM task_attachment
+WHERE org_id = $1 AND task_id = $2 AND deleted_at IS NULL
+  AND ($3::uuid IS NULL OR item_instance_id = $3)
+ORDER BY created_at, id
+`
+
+type GetTaskAttachmentsParams struct {
+	OrgID          pgtype.UUID
+	TaskID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+}
+
+func (q *Queries) GetTaskAttachments(ctx context.Context, arg GetTaskAttachmentsParams) ([]TaskAttachment, error) {
+	rows, err := q.db.Query(ctx, getTaskAttachments, arg.OrgID, arg.TaskID, arg.ItemInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskAttachment
+	for rows.Next() {
+		var i TaskAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.ItemInstanceID,
+			&i.CommentID,
+			&i.FileName,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.StorageKey,
+			&i.UploadedByUserID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskById = `-- name: GetTaskById :one
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task WHERE org_id = $1 AND id = $2
+`
+
+type GetTaskByIdParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetTaskById(ctx context.Context, arg GetTaskByIdParams) (Task, error) {
+	row := q.db.QueryRow(ctx, getTaskById, arg.OrgID, arg.ID)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Type,
+		&i.Status,
+		&i.Priority,
+		&i.Name,
+		&i.Description,
+		&i.AssignedToUserID,
+		&i.AssignedAt,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.SlaBreachedAt,
+		&i.ReceivingCellID,
+		&i.OriginalTaskID,
+		&i.WaveID,
+		&i.TemplateID,
+		&i.TransferOrderID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTaskByIdForUpdate = `-- name: GetTaskByIdForUpdate :one
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE
+`
+
+type GetTaskByIdForUpdateParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetTaskByIdForUpdate(ctx context.Context, arg GetTaskByIdForUpdateParams) (Task, error) {
+	row := q.db.QueryRow(ctx, getTaskByIdForUpdate, arg.OrgID, arg.ID)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Type,
+		&i.Status,
+		&i.Priority,
+		&i.Name,
+		&i.Description,
+		&i.AssignedToUserID,
+		&i.AssignedAt,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.SlaBreachedAt,
+		&i.ReceivingCellID,
+		&i.OriginalTaskID,
+		&i.WaveID,
+		&i.TemplateID,
+		&i.TransferOrderID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTaskComment = `-- name: GetTaskComment :one
+SELECT id, org_id, task_id, item_instance_id, body, author_user_id, created_at, edited_at, deleted_at FROM task_comment WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL
+`
+
+type GetTaskCommentParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+	ID     pgtype.UUID
+}
+
+func (q *Queries) GetTaskComment(ctx context.Context, arg GetTaskCommentParams) (TaskComment, error) {
+	row := q.db.QueryRow(ctx, getTaskComment, arg.OrgID, arg.TaskID, arg.ID)
+	var i TaskComment
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.Body,
+		&i.AuthorUserID,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTaskComments = `-- name: GetTaskComments :many
+SELECT id, org_id, task_id, item_instance_id, body, author_user_id, created_at, edited_at, deleted_at FROM task_comment
+WHERE org_id = $1 AND task_id = $2 AND deleted_at IS NULL
+  AND ($3::uuid IS NULL OR item_instance_id = $3)
+ORDER BY created_at, id
+`
+
+type GetTaskCommentsParams struct {
+	OrgID          pgtype.UUID
+	TaskID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+}
+
+func (q *Queries) GetTaskComments(ctx context.Context, arg GetTaskCommentsParams) ([]TaskComment, error) {
+	rows, err := q.db.Query(ctx, getTaskComments, arg.OrgID, arg.TaskID, arg.ItemInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskComment
+	for rows.Next() {
+		var i TaskComment
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.ItemInstanceID,
+			&i.Body,
+			&i.AuthorUserID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskCountCellForUpdate = `-- name: GetTaskCountCellForUpdate :one
+SELECT org_id, task_id, cell_id, counted_at, counted_by_user_id FROM task_count_cell WHERE org_id = $1 AND task_id = $2 AND cell_id = $3 FOR UPDATE
+`
+
+type GetTaskCountCellForUpdateParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+	CellID pgtype.UUID
+}
+
+func (q *Queries) GetTaskCountCellForUpdate(ctx context.Context, arg GetTaskCountCellForUpdateParams) (TaskCountCell, error) {
+	row := q.db.QueryRow(ctx, getTaskCountCellForUpdate, arg.OrgID, arg.TaskID, arg.CellID)
+	var i TaskCountCell
+	err := row.Scan(
+		&i.OrgID,
+		&i.TaskID,
+		&i.CellID,
+		&i.CountedAt,
+		&i.CountedByUserID,
+	)
+	return i, err
+}
+
+const getTaskCountCells = `-- name: GetTaskCountCells :many
+SELECT org_id, task_id, cell_id, counted_at, counted_by_user_id FROM task_count_cell WHERE org_id = $1 AND task_id = $2
+`
+
+type GetTaskCountCellsParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskCountCells(ctx context.Context, arg GetTaskCountCellsParams) ([]TaskCountCell, error) {
+	rows, err := q.db.Query(ctx, getTaskCountCells, arg.OrgID, arg.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskCountCell
+	for rows.Next() {
+		var i TaskCountCell
+		if err := rows.Scan(
+			&i.OrgID,
+			&i.TaskID,
+			&i.CellID,
+			&i.CountedAt,
+			&i.CountedByUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskCountEntries = `-- name: GetTaskCountEntries :many
+SELECT id, org_id, task_id, cell_id, item_instance_id, variant_id, quantity, created_at FROM task_count_entry WHERE org_id = $1 AND task_id = $2
+`
+
+type GetTaskCountEntriesParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskCountEntries(ctx context.Context, arg GetTaskCountEntriesParams) ([]TaskCountEntry, error) {
+	rows, err := q.db.Query(ctx, getTaskCountEntries, arg.OrgID, arg.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskCountEntry
+	for rows.Next() {
+		var i TaskCountEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.CellID,
+			&i.ItemInstanceID,
+			&i.VariantID,
+			&i.Quantity,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskExpectedItemForUpdate = `-- name: GetTaskExpectedItemForUpdate :one
+SELECT id, org_id, task_id, variant_id, expected_quantity, received_quantity, discrepancy, created_at FROM task_expected_item WHERE org_id = $1 AND task_id = $2 AND variant_id = $3 FOR UPDATE
+`
+
+type GetTaskExpectedItemForUpdateParams struct {
+	OrgID     pgtype.UUID
+	TaskID    pgtype.UUID
+	VariantID pgtype.UUID
+}
+
+func (q *Queries) GetTaskExpectedItemForUpdate(ctx context.Context, arg GetTaskExpectedItemForUpdateParams) (TaskExpectedItem, error) {
+	row := q.db.QueryRow(ctx, getTaskExpectedItemForUpdate, arg.OrgID, arg.TaskID, arg.VariantID)
+	var i TaskExpectedItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.VariantID,
+		&i.ExpectedQuantity,
+		&i.ReceivedQuantity,
+		&i.Discrepancy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTaskExpectedItems = `-- name: GetTaskExpectedItems :many
+SELECT id, org_id, task_id, variant_id, expected_quantity, received_quantity, discrepancy, created_at FROM task_expected_item WHERE org_id = $1 AND task_id = $2 ORDER BY created_at, id
+`
+
+type GetTaskExpectedItemsParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskExpectedItems(ctx context.Context, arg GetTaskExpectedItemsParams) ([]TaskExpectedItem, error) {
+	rows, err := q.db.Query(ctx, getTaskExpectedItems, arg.OrgID, arg.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskExpectedItem
+	for rows.Next() {
+		var i TaskExpectedItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.VariantID,
+			&i.ExpectedQuantity,
+			&i.ReceivedQuantity,
+			&i.Discrepancy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskInTransitInstanceIds = `-- name: GetTaskInTransitInstanceIds :many
+SELECT id FROM item_instance
+WHERE org_id = $1 AND affected_by_task_id = $2 AND status = 'in_transit' AND deleted_at IS NULL
+  AND ($3::uuid IS NULL OR variant_id = $3)
+ORDER BY created_at, id
+`
+
+type GetTaskInTransitInstanceIdsParams struct {
+	OrgID     pgtype.UUID
+	TaskID    pgtype.UUID
+	VariantID pgtype.UUID
+}
+
+func (q *Queries) GetTaskInTransitInstanceIds(ctx context.Context, arg GetTaskInTransitInstanceIdsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, getTaskInTransitInstanceIds, arg.OrgID, arg.TaskID, arg.VariantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskInTransitInstancesForUpdate = `-- name: GetTaskInTransitInstancesForUpdate :many
+SELECT id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at FROM item_instance
+WHERE org_id = $1 AND affected_by_task_id = $2 AND variant_id = $3
+  AND status = 'in_transit' AND deleted_at IS NULL
+ORDER BY created_at, id
+LIMIT $4::int
+FOR UPDATE
+`
+
+type GetTaskInTransitInstancesForUpdateParams struct {
+	OrgID     pgtype.UUID
+	TaskID    pgtype.UUID
+	VariantID pgtype.UUID
+	MaxRows   int32
+}
+
+func (q *Queries) GetTaskInTransitInstancesForUpdate(ctx context.Context, arg GetTaskInTransitInstancesForUpdateParams) ([]ItemInstance, error) {
+	rows, err := q.db.Query(ctx, getTaskInTransitInstancesForUpdate,
+		arg.OrgID,
+		arg.TaskID,
+		arg.VariantID,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemInstance
+	for rows.Next() {
+		var i ItemInstance
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.VariantID,
+			&i.CellID,
+			&i.Status,
+			&i.AffectedByTaskID,
+			&i.SerialNumber,
+			&i.LotNumber,
+			&i.ManufacturedAt,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskInTransitVariantCounts = `-- name: GetTaskInTransitVariantCounts :many
+SELECT variant_id, COUNT(*)::int AS quantity FROM item_instance
+WHERE org_id = $1 AND affected_by_task_id = $2 AND status = 'in_transit' AND deleted_at IS NULL
+GROUP BY variant_id ORDER BY variant_id
+`
+
+type GetTaskInTransitVariantCountsParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+type GetTaskInTransitVariantCountsRow struct {
+	VariantID pgtype.UUID
+	Quantity  int32
+}
+
+func (q *Queries) GetTaskInTransitVariantCounts(ctx context.Context, arg GetTaskInTransitVariantCountsParams) ([]GetTaskInTransitVariantCountsRow, error) {
+	rows, err := q.db.Query(ctx, getTaskInTransitVariantCounts, arg.OrgID, arg.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTaskInTransitVariantCountsRow
+	for rows.Next() {
+		var i GetTaskInTransitVariantCountsRow
+		if err := rows.Scan(&i.VariantID, &i.Quantity); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskItem = `-- name: GetTaskItem :one
+SELECT id, org_id, task_id, item_instance_id, stock_line_id, quantity, status, source_cell_id, destination_cell_id, disposition FROM task_item WHERE org_id = $1 AND task_id = $2 AND item_instance_id = $3
+`
+
+type GetTaskItemParams struct {
+	OrgID          pgtype.UUID
+	TaskID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+}
+
+func (q *Queries) GetTaskItem(ctx context.Context, arg GetTaskItemParams) (TaskItem, error) {
+	row := q.db.QueryRow(ctx, getTaskItem, arg.OrgID, arg.TaskID, arg.ItemInstanceID)
+	var i TaskItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.StockLineID,
+		&i.Quantity,
+		&i.Status,
+		&i.SourceCellID,
+		&i.DestinationCellID,
+		&i.Disposition,
+	)
+	return i, err
+}
+
+const getTaskItemExceptions = `-- name: GetTaskItemExceptions :many
+SELECT id, org_id, task_id, item_instance_id, reason, note, substitute_instance_id, reported_by_user_id, created_at FROM task_item_exception WHERE org_id = $1 AND task_id = $2 ORDER BY created_at ASC
+`
+
+type GetTaskItemExceptionsParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskItemExceptions(ctx context.Context, arg GetTaskItemExceptionsParams) ([]TaskItemException, error) {
+	rows, err := q.db.Query(ctx, getTaskItemExceptions, arg.OrgID, arg.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskItemException
+	for rows.Next() {
+		var i TaskItemException
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.ItemInstanceID,
+			&i.Reason,
+			&i.Note,
+			&i.SubstituteInstanceID,
+			&i.ReportedByUserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskItemExceptionsReport = `-- name: GetTaskItemExceptionsReport :many
+SELECT task_item_exception.id, task_item_exception.org_id, task_item_exception.task_id, task_item_exception.item_instance_id, task_item_exception.reason, task_item_exception.note, task_item_exception.substitute_instance_id, task_item_exception.reported_by_user_id, task_item_exception.created_at FROM task_item_exception
+JOIN task ON task.id = task_item_exception.task_id
+WHERE task_item_exception.org_id = $1 AND task.deleted_at IS NULL
+  AND ($2::uuid IS NULL OR task.unit_id = $2)
+  AND ($3::task_item_exception_reason IS NULL OR task_item_exception.reason = $3)
+  AND ($4::timestamp IS NULL OR task_item_exception.created_at >= $4)
+  AND ($5::timestamp IS NULL OR task_item_exception.created_at < $5)
+ORDER BY task_item_exception.created_at DESC
+LIMIT $6::int
+`
+
+type GetTaskItemExceptionsReportParams struct {
+	OrgID       pgtype.UUID
+	UnitID      pgtype.UUID
+	Reason      NullTaskItemExceptionReason
+	CreatedFrom pgtype.Timestamp
+	CreatedTo   pgtype.Timestamp
+	MaxRows     int32
+}
+
+func (q *Queries) GetTaskItemExceptionsReport(ctx context.Context, arg GetTaskItemExceptionsReportParams) ([]TaskItemException, error) {
+	rows, err := q.db.Query(ctx, getTaskItemExceptionsReport,
+		arg.OrgID,
+		arg.UnitID,
+		arg.Reason,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskItemException
+	for rows.Next() {
+		var i TaskItemException
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.ItemInstanceID,
+			&i.Reason,
+			&i.Note,
+			&i.SubstituteInstanceID,
+			&i.ReportedByUserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskItemForUpdate = `-- name: GetTaskItemForUpdate :one
+SELECT id, org_id, task_id, item_instance_id, stock_line_id, quantity, status, source_cell_id, destination_cell_id, disposition FROM task_item WHERE org_id = $1 AND task_id = $2 AND item_instance_id = $3 FOR UPDATE
+`
+
+type GetTaskItemForUpdateParams struct {
+	OrgID          pgtype.UUID
+	TaskID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+}
+
+func (q *Queries) GetTaskItemForUpdate(ctx context.Context, arg GetTaskItemForUpdateParams) (TaskItem, error) {
+	row := q.db.QueryRow(ctx, getTaskItemForUpdate, arg.OrgID, arg.TaskID, arg.ItemInstanceID)
+	var i TaskItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.StockLineID,
+		&i.Quantity,
+		&i.Status,
+		&i.SourceCellID,
+		&i.DestinationCellID,
+		&i.Disposition,
+	)
+	return i, err
+}
+
+const getTaskItems = `-- name: GetTaskItems :many
+SELECT id, org_id, task_id, item_instance_id, stock_line_id, quantity, status, source_cell_id, destination_cell_id, disposition FROM task_item WHERE org_id = $1 AND task_id = $2
+`
+
+type GetTaskItemsParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskItems(ctx context.Context, arg GetTaskItemsParams) ([]TaskItem, error) {
+	rows, err := q.db.Query(ctx, getTaskItems, arg.OrgID, arg.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskItem
+	for rows.Next() {
+		var i TaskItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.ItemInstanceID,
+			&i.StockLineID,
+			&i.Quantity,
+			&i.Status,
+			&i.SourceCellID,
+			&i.DestinationCellID,
+			&i.Disposition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskPickupCode = `-- name: GetTaskPickupCode :one
+SELECT task_id, org_id, unit_id, code, failed_attempts, created_at, locked_at, used_at FROM task_pickup_code WHERE org_id = $1 AND task_id = $2
+`
+
+type GetTaskPickupCodeParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskPickupCode(ctx context.Context, arg GetTaskPickupCodeParams) (TaskPickupCode, error) {
+	row := q.db.QueryRow(ctx, getTaskPickupCode, arg.OrgID, arg.TaskID)
+	var i TaskPickupCode
+	err := row.Scan(
+		&i.TaskID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Code,
+		&i.FailedAttempts,
+		&i.CreatedAt,
+		&i.LockedAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const getTaskPickupCodeForUpdate = `-- name: GetTaskPickupCodeForUpdate :one
+SELECT task_id, org_id, unit_id, code, failed_attempts, created_at, locked_at, used_at FROM task_pickup_code WHERE org_id = $1 AND task_id = $2 FOR UPDATE
+`
+
+type GetTaskPickupCodeForUpdateParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskPickupCodeForUpdate(ctx context.Context, arg GetTaskPickupCodeForUpdateParams) (TaskPickupCode, error) {
+	row := q.db.QueryRow(ctx, getTaskPickupCodeForUpdate, arg.OrgID, arg.TaskID)
+	var i TaskPickupCode
+	err := row.Scan(
+		&i.TaskID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Code,
+		&i.FailedAttempts,
+		&i.CreatedAt,
+		&i.LockedAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const getTaskReservedInstanceIds = `-- name: GetTaskReservedInstanceIds :many
+SELECT id FROM item_instance WHERE org_id = $1 AND affected_by_task_id = $2 AND status = 'reserved' AND deleted_at IS NULL
+`
+
+type GetTaskReservedInstanceIdsParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskReservedInstanceIds(ctx context.Context, arg GetTaskReservedInstanceIdsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, getTaskReservedInstanceIds, arg.OrgID, arg.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskReservedStockLines = `-- name: GetTaskReservedStockLines :many
+SELECT id, org_id, item_id, variant_id, cell_id, status, quantity, affected_by_task_id, created_at, deleted_at FROM stock_line WHERE org_id = $1 AND affected_by_task_id = $2 AND status = 'reserved' AND deleted_at IS NULL
+`
+
+type GetTaskReservedStockLinesParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskReservedStockLines(ctx context.Context, arg GetTaskReservedStockLinesParams) ([]StockLine, error) {
+	rows, err := q.db.Query(ctx, getTaskReservedStockLines, arg.OrgID, arg.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []StockLine
+	for rows.Next() {
+		var i StockLine
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.VariantID,
+			&i.CellID,
+			&i.Status,
+			&i.Quantity,
+			&i.AffectedByTaskID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskStatusHistory = `-- name: GetTaskStatusHistory :many
+SELECT id, org_id, task_id, from_status, to_status, changed_by_user_id, comment, changed_at FROM task_status_history WHERE org_id = $1 AND task_id = $2 ORDER BY changed_at, id
+`
+
+type GetTaskStatusHistoryParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) GetTaskStatusHistory(ctx context.Context, arg GetTaskStatusHistoryParams) ([]TaskStatusHistory, error) {
+	rows, err := q.db.Query(ctx, getTaskStatusHistory, arg.OrgID, arg.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskStatusHistory
+	for rows.Next() {
+		var i TaskStatusHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.ChangedByUserID,
+			&i.Comment,
+			&i.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskStockLineItemForUpdate = `-- name: GetTaskStockLineItemForUpdate :one
+SELECT id, org_id, task_id, item_instance_id, stock_line_id, quantity, status, source_cell_id, destination_cell_id, disposition FROM task_item WHERE org_id = $1 AND task_id = $2 AND stock_line_id = $3 FOR UPDATE
+`
+
+type GetTaskStockLineItemForUpdateParams struct {
+	OrgID       pgtype.UUID
+	TaskID      pgtype.UUID
+	StockLineID pgtype.UUID
+}
+
+func (q *Queries) GetTaskStockLineItemForUpdate(ctx context.Context, arg GetTaskStockLineItemForUpdateParams) (TaskItem, error) {
+	row := q.db.QueryRow(ctx, getTaskStockLineItemForUpdate, arg.OrgID, arg.TaskID, arg.StockLineID)
+	var i TaskItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.StockLineID,
+		&i.Quantity,
+		&i.Status,
+		&i.SourceCellID,
+		&i.DestinationCellID,
+		&i.Disposition,
+	)
+	return i, err
+}
+
+const getTaskTemplateById = `-- name: GetTaskTemplateById :one
+SELECT id, org_id, unit_id, type, priority, name, description, assigned_to_user_id, due_in_minutes, schedule, is_active, next_run_at, last_run_at, created_at, deleted_at FROM task_template WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+`
+
+type GetTaskTemplateByIdParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetTaskTemplateById(ctx context.Context, arg GetTaskTemplateByIdParams) (TaskTemplate, error) {
+	row := q.db.QueryRow(ctx, getTaskTemplateById, arg.OrgID, arg.ID)
+	var i TaskTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Type,
+		&i.Priority,
+		&i.Name,
+		&i.Description,
+		&i.AssignedToUserID,
+		&i.DueInMinutes,
+		&i.Schedule,
+		&i.IsActive,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTaskTemplateRules = `-- name: GetTaskTemplateRules :many
+SELECT id, org_id, template_id, position, item_id, variant_id, source_cells_group_id, target_cell_id, max_instances FROM task_template_rule WHERE org_id = $1 AND template_id = ANY($2::uuid[]) ORDER BY template_id, position
+`
+
+type GetTaskTemplateRulesParams struct {
+	OrgID       pgtype.UUID
+	TemplateIds []pgtype.UUID
+}
+
+func (q *Queries) GetTaskTemplateRules(ctx context.Context, arg GetTaskTemplateRulesParams) ([]TaskTemplateRule, error) {
+	rows, err := q.db.Query(ctx, getTaskTemplateRules, arg.OrgID, arg.TemplateIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskTemplateRule
+	for rows.Next() {
+		var i TaskTemplateRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TemplateID,
+			&i.Position,
+			&i.ItemID,
+			&i.VariantID,
+			&i.SourceCellsGroupID,
+			&i.TargetCellID,
+			&i.MaxInstances,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskTemplates = `-- name: GetTaskTemplates :many
+SELECT id, org_id, unit_id, type, priority, name, description, assigned_to_user_id, due_in_minutes, schedule, is_active, next_run_at, last_run_at, created_at, deleted_at FROM task_template WHERE org_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC
+`
+
+func (q *Queries) GetTaskTemplates(ctx context.Context, orgID pgtype.UUID) ([]TaskTemplate, error) {
+	rows, err := q.db.Query(ctx, getTaskTemplates, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskTemplate
+	for rows.Next() {
+		var i TaskTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.DueInMinutes,
+			&i.Schedule,
+			&i.IsActive,
+			&i.NextRunAt,
+			&i.LastRunAt,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTasks = `-- name: GetTasks :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task WHERE org_id = $1 AND deleted_at IS NULL
+ORDER BY priority DESC, due_at ASC NULLS LAST, created_at ASC
+`
+
+func (q *Queries) GetTasks(ctx context.Context, orgID pgtype.UUID) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getTasks, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTasksAssignedToUser = `-- name: GetTasksAssignedToUser :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task WHERE org_id = $1 AND assigned_to_user_id = $2 AND status IN ('pending', 'in_progress', 'ready') AND deleted_at IS NULL
+ORDER BY priority DESC, due_at ASC NULLS LAST, created_at ASC
+`
+
+type GetTasksAssignedToUserParams struct {
+	OrgID            pgtype.UUID
+	AssignedToUserID pgtype.UUID
+}
+
+func (q *Queries) GetTasksAssignedToUser(ctx context.Context, arg GetTasksAssignedToUserParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getTasksAssignedToUser, arg.OrgID, arg.AssignedToUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTasksByIdsForUpdate = `-- name: GetTasksByIdsForUpdate :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task WHERE org_id = $1 AND id = ANY($2::uuid[]) AND deleted_at IS NULL ORDER BY id FOR UPDATE
+`
+
+type GetTasksByIdsForUpdateParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
+}
+
+func (q *Queries) GetTasksByIdsForUpdate(ctx context.Context, arg GetTasksByIdsForUpdateParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getTasksByIdsForUpdate, arg.OrgID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTemplateRuleInstances = `-- name: GetTemplateRuleInstances :many
+SELECT item_instance.id, item_instance.org_id, item_instance.item_id, item_instance.variant_id, item_instance.cell_id, item_instance.status, item_instance.affected_by_task_id, item_instance.serial_number, item_instance.lot_number, item_instance.manufactured_at, item_instance.expires_at, item_instance.created_at, item_instance.deleted_at FROM item_instance
+JOIN cell ON cell.id = item_instance.cell_id AND cell.deleted_at IS NULL
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+JOIN item_variant ON item_variant.id = item_instance.variant_id
+JOIN org ON org.id = item_instance.org_id
+WHERE item_instance.org_id = $1 AND cells_group.unit_id = $2
+  AND item_instance.status = 'available' AND item_instance.deleted_at IS NULL
+  AND (item_instance.expires_at IS NULL OR item_instance.expires_at >= CURRENT_DATE)
+  AND ($3::uuid IS NULL OR item_instance.item_id = $3)
+  AND ($4::uuid IS NULL OR item_instance.variant_id = $4)
+  AND ($5::uuid IS NULL OR cell.cells_group_id = $5)
+  AND ($6::uuid IS NULL OR item_instance.cell_id <> $6)
+  AND NOT EXISTS (
+    SELECT 1 FROM task_item
+    JOIN task ON task.id = task_item.task_id
+    WHERE task_item.item_instance_id = item_instance.id AND task_item.status IN ('pending', 'picked')
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM task_count_cell
+    JOIN task ON task.id = task_count_cell.task_id
+    WHERE task_count_cell.cell_id = item_instance.cell_id
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+ORDER BY
+  CASE WHEN COALESCE(item_variant.allocation_policy, org.allocation_policy) = 'fefo' THEN item_instance.expires_at END NULLS LAST,
+  item_instance.created_at, item_instance.id
+LIMIT $7::int
+`
+
+type GetTemplateRuleInstancesParams struct {
+	OrgID              pgtype.UUID
+	UnitID             pgtype.UUID
+	ItemID             pgtype.UUID
+	VariantID          pgtype.UUID
+	SourceCellsGroupID pgtype.UUID
+	TargetCellID       pgtype.UUID
+	MaxRows            int32
+}
+
+// Available unexpired instances of the unit matching a template rule which are
+// not taken by an open task, not in a frozen cell and not in the target cell
+// already, in allocation order of their variant
+func (q *Queries) GetTemplateRuleInstances(ctx context.Context, arg GetTemplateRuleInstancesParams) ([]ItemInstance, error) {
+	rows, err := q.db.Query(ctx, getTemplateRuleInstances,
+		arg.OrgID,
+		arg.UnitID,
+		arg.ItemID,
+		arg.VariantID,
+		arg.SourceCellsGroupID,
+		arg.TargetCellID,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ItemInstance
+	for rows.Next() {
+		var i ItemInstance
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.ItemID,
+			&i.VariantID,
+			&i.CellID,
+			&i.Status,
+			&i.AffectedByTaskID,
+			&i.SerialNumber,
+			&i.LotNumber,
+			&i.ManufacturedAt,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransferOrderById = `-- name: GetTransferOrderById :one
+SELECT id, org_id, source_unit_id, destination_unit_id, receiving_cell_id, name, status, created_at, dispatched_at, received_at, deleted_at FROM transfer_order WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+`
+
+type GetTransferOrderByIdParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetTransferOrderById(ctx context.Context, arg GetTransferOrderByIdParams) (TransferOrder, error) {
+	row := q.db.QueryRow(ctx, getTransferOrderById, arg.OrgID, arg.ID)
+	var i TransferOrder
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.SourceUnitID,
+		&i.DestinationUnitID,
+		&i.ReceivingCellID,
+		&i.Name,
+		&i.Status,
+		&i.CreatedAt,
+		&i.DispatchedAt,
+		&i.ReceivedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTransferOrderByIdForUpdate = `-- name: GetTransferOrderByIdForUpdate :one
+SELECT id, org_id, source_unit_id, destination_unit_id, receiving_cell_id, name, status, created_at, dispatched_at, received_at, deleted_at FROM transfer_order WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE
+`
+
+type GetTransferOrderByIdForUpdateParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetTransferOrderByIdForUpdate(ctx context.Context, arg GetTransferOrderByIdForUpdateParams) (TransferOrder, error) {
+	row := q.db.QueryRow(ctx, getTransferOrderByIdForUpdate, arg.OrgID, arg.ID)
+	var i TransferOrder
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.SourceUnitID,
+		&i.DestinationUnitID,
+		&i.ReceivingCellID,
+		&i.Name,
+		&i.Status,
+		&i.CreatedAt,
+		&i.DispatchedAt,
+		&i.ReceivedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTransferOrders = `-- name: GetTransferOrders :many
+SELECT id, org_id, source_unit_id, destination_unit_id, receiving_cell_id, name, status, created_at, dispatched_at, received_at, deleted_at FROM transfer_order WHERE org_id = $1 AND deleted_at IS NULL
+  AND ($2::transfer_order_status IS NULL OR status = $2)
+ORDER BY created_at DESC
+`
+
+type GetTransferOrdersParams struct {
+	OrgID  pgtype.UUID
+	Status NullTransferOrderStatus
+}
+
+func (q *Queries) GetTransferOrders(ctx context.Context, arg GetTransferOrdersParams) ([]TransferOrder, error) {
+	rows, err := q.db.Query(ctx, getTransferOrders, arg.OrgID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TransferOrder
+	for rows.Next() {
+		var i TransferOrder
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.SourceUnitID,
+			&i.DestinationUnitID,
+			&i.ReceivingCellID,
+			&i.Name,
+			&i.Status,
+			&i.CreatedAt,
+			&i.DispatchedAt,
+			&i.ReceivedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransferOrdersTasks = `-- name: GetTransferOrdersTasks :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task WHERE org_id = $1 AND transfer_order_id = ANY($2::uuid[]) AND deleted_at IS NULL
+`
+
+type GetTransferOrdersTasksParams struct {
+	OrgID            pgtype.UUID
+	TransferOrderIds []pgtype.UUID
+}
+
+func (q *Queries) GetTransferOrdersTasks(ctx context.Context, arg GetTransferOrdersTasksParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getTransferOrdersTasks, arg.OrgID, arg.TransferOrderIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTvBoardById = `-- name: GetTvBoardById :one
+SELECT id, org_id, unit_id, name, token, created_at, deleted_at FROM tv_board WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+`
+
+type GetTvBoardByIdParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) GetTvBoardById(ctx context.Context, arg GetTvBoardByIdParams) (TvBoard, error) {
+	row := q.db.QueryRow(ctx, getTvBoardById, arg.OrgID, arg.ID)
+	var i TvBoard
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Name,
+		&i.Token,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTvBoardByToken = `-- name: GetTvBoardByToken :one
+SELECT id, org_id, unit_id, name, token, created_at, deleted_at FROM tv_board WHERE token = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetTvBoardByToken(ctx context.Context, token string) (TvBoard, error) {
+	row := q.db.QueryRow(ctx, getTvBoardByToken, token)
+	var i TvBoard
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Name,
+		&i.Token,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTvBoards = `-- name: GetTvBoards :many
+SELECT id, org_id, unit_id, name, token, created_at, deleted_at FROM tv_board WHERE org_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetTvBoards(ctx context.Context, orgID pgtype.UUID) ([]TvBoard, error) {
+	rows, err := q.db.Query(ctx, getTvBoards, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TvBoard
+	for rows.Next() {
+		var i TvBoard
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Name,
+			&i.Token,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnitCellsByAliases = `-- name: GetUnitCellsByAliases :many
+SELECT cell.id, cell.org_id, cell.cells_group_id, cell.alias, cell.row, cell.level, cell.position, cell.created_at, cell.deleted_at FROM cell
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+WHERE cell.org_id = $1 AND cells_group.unit_id = $2
+  AND cell.alias = ANY($3::text[]) AND cell.deleted_at IS NULL
+`
+
+type GetUnitCellsByAliasesParams struct {
+	OrgID   pgtype.UUID
+	UnitID  pgtype.UUID
+	Aliases []string
+}
+
+// Task Import
+func (q *Queries) GetUnitCellsByAliases(ctx context.Context, arg GetUnitCellsByAliasesParams) ([]Cell, error) {
+	rows, err := q.db.Query(ctx, getUnitCellsByAliases, arg.OrgID, arg.UnitID, arg.Aliases)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Cell
+	for rows.Next() {
+		var i Cell
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.CellsGroupID,
+			&i.Alias,
+			&i.Row,
+			&i.Level,
+			&i.Position,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnitLastAssignedUserForUpdate = `-- name: GetUnitLastAssignedUserForUpdate :one
+SELECT last_assigned_user_id FROM org_unit WHERE org_id = $1 AND id = $2 FOR NO KEY UPDATE
+`
+
+type GetUnitLastAssignedUserForUpdateParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+// Serializes round robin assignment in the unit, does not block task inserts
+func (q *Queries) GetUnitLastAssignedUserForUpdate(ctx context.Context, arg GetUnitLastAssignedUserForUpdateParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, getUnitLastAssignedUserForUpdate, arg.OrgID, arg.ID)
+	var last_assigned_user_id pgtype.UUID
+	err := row.Scan(&last_assigned_user_id)
+	return last_assigned_user_id, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, email, first_name, last_name, middle_name, yandex_id, created_at FROM app_user WHERE email = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (AppUser, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i AppUser
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.MiddleName,
+		&i.YandexID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUserById = `-- name: GetUserById :one
+SELECT id, email, first_name, last_name, middle_name, yandex_id, created_at FROM app_user WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserById(ctx context.Context, id pgtype.UUID) (AppUser, error) {
+	row := q.db.QueryRow(ctx, getUserById, id)
+	var i AppUser
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.MiddleName,
+		&i.YandexID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUserOrgs = `-- name: GetUserOrgs :many
+SELECT id, name, subdomain, task_assignment_strategy, allocation_policy, created_at, deleted_at FROM org WHERE id IN (SELECT org_id FROM app_role_binding WHERE user_id = $1) AND deleted_at IS NULL
+`
+
+// Organizations
+func (q *Queries) GetUserOrgs(ctx context.Context, userID pgtype.UUID) ([]Org, error) {
+	rows, err := q.db.Query(ctx, getUserOrgs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Org
+	for rows.Next() {
+		var i Org
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Subdomain,
+			&i.TaskAssignmentStrategy,
+			&i.AllocationPolicy,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserRoleInOrg = `-- name: GetUserRoleInOrg :one
+SELECT app_role.id, app_role.name, app_role.display_name, app_role.description FROM app_role 
+JOIN app_role_binding ON app_role.id = app_role_binding.role_id
+WHERE app_role_binding.user_id = $2 AND app_role_binding.org_id = $1
+`
+
+type GetUserRoleInOrgParams struct {
+	OrgID  pgtype.UUID
+	UserID pgtype.UUID
+}
+
+type GetUserRoleInOrgRow struct {
+	AppRole AppRole
+}
+
+func (q *Queries) GetUserRoleInOrg(ctx context.Context, arg GetUserRoleInOrgParams) (GetUserRoleInOrgRow, error) {
+	row := q.db.QueryRow(ctx, getUserRoleInOrg, arg.OrgID, arg.UserID)
+	var i GetUserRoleInOrgRow
+	err := row.Scan(
+		&i.AppRole.ID,
+		&i.AppRole.Name,
+		&i.AppRole.DisplayName,
+		&i.AppRole.Description,
+	)
+	return i, err
+}
+
+const getWaveCandidateTasks = `-- name: GetWaveCandidateTasks :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task
+WHERE task.org_id = $1 AND task.unit_id = $2 AND task.type = 'pickment' AND task.status = 'pending'
+  AND task.wave_id IS NULL AND task.deleted_at IS NULL
+  AND EXISTS (SELECT 1 FROM task_item WHERE task_item.task_id = task.id)
+  AND NOT EXISTS (
+    SELECT 1 FROM task_item WHERE task_item.task_id = task.id
+      AND (task_item.destination_cell_id IS NOT NULL OR task_item.stock_line_id IS NOT NULL)
+  )
+ORDER BY priority DESC, due_at ASC NULLS LAST, created_at ASC
+LIMIT $3::int
+`
+
+type GetWaveCandidateTasksParams struct {
+	OrgID    pgtype.UUID
+	UnitID   pgtype.UUID
+	MaxTasks int32
+}
+
+// Pending pickment tasks of the unit which are not in a wave yet, most urgent
+// first. Must match isWaveable, so the limit counts only tasks the wave can take.
+func (q *Queries) GetWaveCandidateTasks(ctx context.Context, arg GetWaveCandidateTasksParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getWaveCandidateTasks, arg.OrgID, arg.UnitID, arg.MaxTasks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWaveTaskItemByInstance = `-- name: GetWaveTaskItemByInstance :one
+SELECT task_item.id, task_item.org_id, task_item.task_id, task_item.item_instance_id, task_item.stock_line_id, task_item.quantity, task_item.status, task_item.source_cell_id, task_item.destination_cell_id, task_item.disposition FROM task_item
+JOIN task ON task.id = task_item.task_id
+WHERE task_item.org_id = $1 AND task.wave_id = $2 AND task.deleted_at IS NULL
+  AND task_item.item_instance_id = $3 AND task_item.status = $4
+LIMIT 1
+`
+
+type GetWaveTaskItemByInstanceParams struct {
+	OrgID          pgtype.UUID
+	WaveID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+	Status         TaskItemStatus
+}
+
+func (q *Queries) GetWaveTaskItemByInstance(ctx context.Context, arg GetWaveTaskItemByInstanceParams) (TaskItem, error) {
+	row := q.db.QueryRow(ctx, getWaveTaskItemByInstance,
+		arg.OrgID,
+		arg.WaveID,
+		arg.ItemInstanceID,
+		arg.Status,
+	)
+	var i TaskItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.StockLineID,
+		&i.Quantity,
+		&i.Status,
+		&i.SourceCellID,
+		&i.DestinationCellID,
+		&i.Disposition,
+	)
+	return i, err
+}
+
+const getWaveTaskItems = `-- name: GetWaveTaskItems :many
+SELECT task_item.id, task_item.org_id, task_item.task_id, task_item.item_instance_id, task_item.stock_line_id, task_item.quantity, task_item.status, task_item.source_cell_id, task_item.destination_cell_id, task_item.disposition FROM task_item
+JOIN task ON task.id = task_item.task_id
+WHERE task_item.org_id = $1 AND task.wave_id = $2 AND task.deleted_at IS NULL
+`
+
+type GetWaveTaskItemsParams struct {
+	OrgID  pgtype.UUID
+	WaveID pgtype.UUID
+}
+
+func (q *Queries) GetWaveTaskItems(ctx context.Context, arg GetWaveTaskItemsParams) ([]TaskItem, error) {
+	rows, err := q.db.Query(ctx, getWaveTaskItems, arg.OrgID, arg.WaveID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskItem
+	for rows.Next() {
+		var i TaskItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.TaskID,
+			&i.ItemInstanceID,
+			&i.StockLineID,
+			&i.Quantity,
+			&i.Status,
+			&i.SourceCellID,
+			&i.DestinationCellID,
+			&i.Disposition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWaveTasks = `-- name: GetWaveTasks :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task WHERE org_id = $1 AND wave_id = $2 AND deleted_at IS NULL
+ORDER BY priority DESC, due_at ASC NULLS LAST, created_at ASC
+`
+
+type GetWaveTasksParams struct {
+	OrgID  pgtype.UUID
+	WaveID pgtype.UUID
+}
+
+func (q *Queries) GetWaveTasks(ctx context.Context, arg GetWaveTasksParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getWaveTasks, arg.OrgID, arg.WaveID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkerProfile = `-- name: GetWorkerProfile :one
+SELECT org_id, user_id, unit_ids, skills, on_shift, updated_at FROM app_worker_profile WHERE org_id = $1 AND user_id = $2
+`
+
+type GetWorkerProfileParams struct {
+	OrgID  pgtype.UUID
+	UserID pgtype.UUID
+}
+
+func (q *Queries) GetWorkerProfile(ctx context.Context, arg GetWorkerProfileParams) (AppWorkerProfile, error) {
+	row := q.db.QueryRow(ctx, getWorkerProfile, arg.OrgID, arg.UserID)
+	var i AppWorkerProfile
+	err := row.Scan(
+		&i.OrgID,
+		&i.UserID,
+		&i.UnitIds,
+		&i.Skills,
+		&i.OnShift,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWorkerProfiles = `-- name: GetWorkerProfiles :many
+SELECT org_id, user_id, unit_ids, skills, on_shift, updated_at FROM app_worker_profile WHERE org_id = $1
+`
+
+func (q *Queries) GetWorkerProfiles(ctx context.Context, orgID pgtype.UUID) ([]AppWorkerProfile, error) {
+	rows, err := q.db.Query(ctx, getWorkerProfiles, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AppWorkerProfile
+	for rows.Next() {
+		var i AppWorkerProfile
+		if err := rows.Scan(
+			&i.OrgID,
+			&i.UserID,
+			&i.UnitIds,
+			&i.Skills,
+			&i.OnShift,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const invalidateSession = `-- name: InvalidateSession :exec
+UPDATE app_user_session SET revoked_at = CURRENT_TIMESTAMP WHERE id = $1
+`
+
+func (q *Queries) InvalidateSession(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, invalidateSession, id)
+	return err
+}
+
+const itemVariantHasStock = `-- name: ItemVariantHasStock :one
+SELECT (EXISTS (SELECT 1 FROM item_instance WHERE item_instance.org_id = $1 AND item_instance.variant_id = $2 AND item_instance.deleted_at IS NULL)
+    OR EXISTS (SELECT 1 FROM stock_line WHERE stock_line.org_id = $1 AND stock_line.variant_id = $2 AND stock_line.deleted_at IS NULL))::boolean AS has_stock
+`
+
+type ItemVariantHasStockParams struct {
+	OrgID     pgtype.UUID
+	VariantID pgtype.UUID
+}
+
+func (q *Queries) ItemVariantHasStock(ctx context.Context, arg ItemVariantHasStockParams) (bool, error) {
+	row := q.db.QueryRow(ctx, itemVariantHasStock, arg.OrgID, arg.VariantID)
+	var has_stock bool
+	err := row.Scan(&has_stock)
+	return has_stock, err
+}
+
+const listTasksByCreatedAtAsc = `-- name: ListTasksByCreatedAtAsc :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task
+WHERE org_id = $1
+  AND deleted_at IS NULL
+  AND ($2::text[] IS NULL OR status::text = ANY($2::text[]))
+  AND ($3::task_type IS NULL OR type = $3::task_type)
+  AND ($4::uuid IS NULL OR unit_id = $4::uuid)
+  AND ($5::uuid IS NULL OR assigned_to_user_id = $5::uuid)
+  AND ($6::timestamp IS NULL OR created_at >= $6::timestamp)
+  AND ($7::timestamp IS NULL OR created_at < $7::timestamp)
+  AND ($8::timestamp IS NULL OR completed_at >= $8::timestamp)
+  AND ($9::timestamp IS NULL OR completed_at < $9::timestamp)
+  AND ($10::text IS NULL OR name ILIKE '%' || $10::text || '%')
+  AND ($11::uuid IS NULL OR (created_at, id) > ($12::timestamp, $11::uuid))
+ORDER BY created_at, id
+LIMIT $13::int
+`
+
+type ListTasksByCreatedAtAscParams struct {
+	OrgID            pgtype.UUID
+	Statuses         []string
+	Type             NullTaskType
+	UnitID           pgtype.UUID
+	AssignedToUserID pgtype.UUID
+	CreatedFrom      pgtype.Timestamp
+	CreatedTo        pgtype.Timestamp
+	CompletedFrom    pgtype.Timestamp
+	CompletedTo      pgtype.Timestamp
+	Search           pgtype.Text
+	CursorID         pgtype.UUID
+	CursorCreatedAt  pgtype.Timestamp
+	PageSize         int32
+}
+
+// Task listing uses keyset pagination with one query per sort field and
+// direction. The cursor compares raw columns in the order of the matching
+// task_org_*_idx index, so pages are read from the index instead of sorting
+// every task of the organization.
+func (q *Queries) ListTasksByCreatedAtAsc(ctx context.Context, arg ListTasksByCreatedAtAscParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listTasksByCreatedAtAsc,
+		arg.OrgID,
+		arg.Statuses,
+		arg.Type,
+		arg.UnitID,
+		arg.AssignedToUserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CompletedFrom,
+		arg.CompletedTo,
+		arg.Search,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByCreatedAtDesc = `-- name: ListTasksByCreatedAtDesc :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task
+WHERE org_id = $1
+  AND deleted_at IS NULL
+  AND ($2::text[] IS NULL OR status::text = ANY($2::text[]))
+  AND ($3::task_type IS NULL OR type = $3::task_type)
+  AND ($4::uuid IS NULL OR unit_id = $4::uuid)
+  AND ($5::uuid IS NULL OR assigned_to_user_id = $5::uuid)
+  AND ($6::timestamp IS NULL OR created_at >= $6::timestamp)
+  AND ($7::timestamp IS NULL OR created_at < $7::timestamp)
+  AND ($8::timestamp IS NULL OR completed_at >= $8::timestamp)
+  AND ($9::timestamp IS NULL OR completed_at < $9::timestamp)
+  AND ($10::text IS NULL OR name ILIKE '%' || $10::text || '%')
+  AND ($11::uuid IS NULL OR (created_at, id) < ($12::timestamp, $11::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $13::int
+`
+
+type ListTasksByCreatedAtDescParams struct {
+	OrgID            pgtype.UUID
+	Statuses         []string
+	Type             NullTaskType
+	UnitID           pgtype.UUID
+	AssignedToUserID pgtype.UUID
+	CreatedFrom      pgtype.Timestamp
+	CreatedTo        pgtype.Timestamp
+	CompletedFrom    pgtype.Timestamp
+	CompletedTo      pgtype.Timestamp
+	Search           pgtype.Text
+	CursorID         pgtype.UUID
+	CursorCreatedAt  pgtype.Timestamp
+	PageSize         int32
+}
+
+func (q *Queries) ListTasksByCreatedAtDesc(ctx context.Context, arg ListTasksByCreatedAtDescParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listTasksByCreatedAtDesc,
+		arg.OrgID,
+		arg.Statuses,
+		arg.Type,
+		arg.UnitID,
+		arg.AssignedToUserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CompletedFrom,
+		arg.CompletedTo,
+		arg.Search,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByNameAsc = `-- name: ListTasksByNameAsc :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task
+WHERE org_id = $1
+  AND deleted_at IS NULL
+  AND ($2::text[] IS NULL OR status::text = ANY($2::text[]))
+  AND ($3::task_type IS NULL OR type = $3::task_type)
+  AND ($4::uuid IS NULL OR unit_id = $4::uuid)
+  AND ($5::uuid IS NULL OR assigned_to_user_id = $5::uuid)
+  AND ($6::timestamp IS NULL OR created_at >= $6::timestamp)
+  AND ($7::timestamp IS NULL OR created_at < $7::timestamp)
+  AND ($8::timestamp IS NULL OR completed_at >= $8::timestamp)
+  AND ($9::timestamp IS NULL OR completed_at < $9::timestamp)
+  AND ($10::text IS NULL OR name ILIKE '%' || $10::text || '%')
+  AND ($11::uuid IS NULL OR (name, id) > ($12::text, $11::uuid))
+ORDER BY name, id
+LIMIT $13::int
+`
+
+type ListTasksByNameAscParams struct {
+	OrgID            pgtype.UUID
+	Statuses         []string
+	Type             NullTaskType
+	UnitID           pgtype.UUID
+	AssignedToUserID pgtype.UUID
+	CreatedFrom      pgtype.Timestamp
+	CreatedTo        pgtype.Timestamp
+	CompletedFrom    pgtype.Timestamp
+	CompletedTo      pgtype.Timestamp
+	Search           pgtype.Text
+	CursorID         pgtype.UUID
+	CursorName       pgtype.Text
+	PageSize         int32
+}
+
+func (q *Queries) ListTasksByNameAsc(ctx context.Context, arg ListTasksByNameAscParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listTasksByNameAsc,
+		arg.OrgID,
+		arg.Statuses,
+		arg.Type,
+		arg.UnitID,
+		arg.AssignedToUserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CompletedFrom,
+		arg.CompletedTo,
+		arg.Search,
+		arg.CursorID,
+		arg.CursorName,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByNameDesc = `-- name: ListTasksByNameDesc :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task
+WHERE org_id = $1
+  AND deleted_at IS NULL
+  AND ($2::text[] IS NULL OR status::text = ANY($2::text[]))
+  AND ($3::task_type IS NULL OR type = $3::task_type)
+  AND ($4::uuid IS NULL OR unit_id = $4::uuid)
+  AND ($5::uuid IS NULL OR assigned_to_user_id = $5::uuid)
+  AND ($6::timestamp IS NULL OR created_at >= $6::timestamp)
+  AND ($7::timestamp IS NULL OR created_at < $7::timestamp)
+  AND ($8::timestamp IS NULL OR completed_at >= $8::timestamp)
+  AND ($9::timestamp IS NULL OR completed_at < $9::timestamp)
+  AND ($10::text IS NULL OR name ILIKE '%' || $10::text || '%')
+  AND ($11::uuid IS NULL OR (name, id) < ($12::text, $11::uuid))
+ORDER BY name DESC, id DESC
+LIMIT $13::int
+`
+
+type ListTasksByNameDescParams struct {
+	OrgID            pgtype.UUID
+	Statuses         []string
+	Type             NullTaskType
+	UnitID           pgtype.UUID
+	AssignedToUserID pgtype.UUID
+	CreatedFrom      pgtype.Timestamp
+	CreatedTo        pgtype.Timestamp
+	CompletedFrom    pgtype.Timestamp
+	CompletedTo      pgtype.Timestamp
+	Search           pgtype.Text
+	CursorID         pgtype.UUID
+	CursorName       pgtype.Text
+	PageSize         int32
+}
+
+func (q *Queries) ListTasksByNameDesc(ctx context.Context, arg ListTasksByNameDescParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listTasksByNameDesc,
+		arg.OrgID,
+		arg.Statuses,
+		arg.Type,
+		arg.UnitID,
+		arg.AssignedToUserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CompletedFrom,
+		arg.CompletedTo,
+		arg.Search,
+		arg.CursorID,
+		arg.CursorName,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByPriorityAsc = `-- name: ListTasksByPriorityAsc :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task
+WHERE org_id = $1
+  AND deleted_at IS NULL
+  AND ($2::text[] IS NULL OR status::text = ANY($2::text[]))
+  AND ($3::task_type IS NULL OR type = $3::task_type)
+  AND ($4::uuid IS NULL OR unit_id = $4::uuid)
+  AND ($5::uuid IS NULL OR assigned_to_user_id = $5::uuid)
+  AND ($6::timestamp IS NULL OR created_at >= $6::timestamp)
+  AND ($7::timestamp IS NULL OR created_at < $7::timestamp)
+  AND ($8::timestamp IS NULL OR completed_at >= $8::timestamp)
+  AND ($9::timestamp IS NULL OR completed_at < $9::timestamp)
+  AND ($10::text IS NULL OR name ILIKE '%' || $10::text || '%')
+  AND ($11::uuid IS NULL OR (priority, created_at, id) > ($12::task_priority, $13::timestamp, $11::uuid))
+ORDER BY priority, created_at, id
+LIMIT $14::int
+`
+
+type ListTasksByPriorityAscParams struct {
+	OrgID            pgtype.UUID
+	Statuses         []string
+	Type             NullTaskType
+	UnitID           pgtype.UUID
+	AssignedToUserID pgtype.UUID
+	CreatedFrom      pgtype.Timestamp
+	CreatedTo        pgtype.Timestamp
+	CompletedFrom    pgtype.Timestamp
+	CompletedTo      pgtype.Timestamp
+	Search           pgtype.Text
+	CursorID         pgtype.UUID
+	CursorPriority   NullTaskPriority
+	CursorCreatedAt  pgtype.Timestamp
+	PageSize         int32
+}
+
+func (q *Queries) ListTasksByPriorityAsc(ctx context.Context, arg ListTasksByPriorityAscParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listTasksByPriorityAsc,
+		arg.OrgID,
+		arg.Statuses,
+		arg.Type,
+		arg.UnitID,
+		arg.AssignedToUserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CompletedFrom,
+		arg.CompletedTo,
+		arg.Search,
+		arg.CursorID,
+		arg.CursorPriority,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByPriorityDesc = `-- name: ListTasksByPriorityDesc :many
+SELECT id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at FROM task
+WHERE org_id = $1
+  AND deleted_at IS NULL
+  AND ($2::text[] IS NULL OR status::text = ANY($2::text[]))
+  AND ($3::task_type IS NULL OR type = $3::task_type)
+  AND ($4::uuid IS NULL OR unit_id = $4::uuid)
+  AND ($5::uuid IS NULL OR assigned_to_user_id = $5::uuid)
+  AND ($6::timestamp IS NULL OR created_at >= $6::timestamp)
+  AND ($7::timestamp IS NULL OR created_at < $7::timestamp)
+  AND ($8::timestamp IS NULL OR completed_at >= $8::timestamp)
+  AND ($9::timestamp IS NULL OR completed_at < $9::timestamp)
+  AND ($10::text IS NULL OR name ILIKE '%' || $10::text || '%')
+  AND ($11::uuid IS NULL OR (priority, created_at, id) < ($12::task_priority, $13::timestamp, $11::uuid))
+ORDER BY priority DESC, created_at DESC, id DESC
+LIMIT $14::int
+`
+
+type ListTasksByPriorityDescParams struct {
+	OrgID            pgtype.UUID
+	Statuses         []string
+	Type             NullTaskType
+	UnitID           pgtype.UUID
+	AssignedToUserID pgtype.UUID
+	CreatedFrom      pgtype.Timestamp
+	CreatedTo        pgtype.Timestamp
+	CompletedFrom    pgtype.Timestamp
+	CompletedTo      pgtype.Timestamp
+	Search           pgtype.Text
+	CursorID         pgtype.UUID
+	CursorPriority   NullTaskPriority
+	CursorCreatedAt  pgtype.Timestamp
+	PageSize         int32
+}
+
+func (q *Queries) ListTasksByPriorityDesc(ctx context.Context, arg ListTasksByPriorityDescParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listTasksByPriorityDesc,
+		arg.OrgID,
+		arg.Statuses,
+		arg.Type,
+		arg.UnitID,
+		arg.AssignedToUserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CompletedFrom,
+		arg.CompletedTo,
+		arg.Search,
+		arg.CursorID,
+		arg.CursorPriority,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UnitID,
+			&i.Type,
+			&i.Status,
+			&i.Priority,
+			&i.Name,
+			&i.Description,
+			&i.AssignedToUserID,
+			&i.AssignedAt,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.SlaBreachedAt,
+			&i.ReceivingCellID,
+			&i.OriginalTaskID,
+			&i.WaveID,
+			&i.TemplateID,
+			&i.TransferOrderID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockItemInstances = `-- name: LockItemInstances :many
+SELECT id FROM item_instance WHERE org_id = $1 AND id = ANY($2::uuid[]) AND deleted_at IS NULL
+ORDER BY id FOR UPDATE
+`
+
+type LockItemInstancesParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
+}
+
+// Rows are locked in id order, so concurrent callers do not deadlock
+func (q *Queries) LockItemInstances(ctx context.Context, arg LockItemInstancesParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, lockItemInstances, arg.OrgID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markTaskSlaBreached = `-- name: MarkTaskSlaBreached :one
+UPDATE task SET sla_breached_at = (now() AT TIME ZONE 'UTC')
+WHERE org_id = $1 AND id = $2
+  AND due_at < (now() AT TIME ZONE 'UTC') AND sla_breached_at IS NULL
+  AND status IN ('pending', 'in_progress', 'ready') AND deleted_at IS NULL
+RETURNING id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at
+`
+
+type MarkTaskSlaBreachedParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+// Every overdue open task is marked once, even with several server instances
+func (q *Queries) MarkTaskSlaBreached(ctx context.Context, arg MarkTaskSlaBreachedParams) (Task, error) {
+	row := q.db.QueryRow(ctx, markTaskSlaBreached, arg.OrgID, arg.ID)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Type,
+		&i.Status,
+		&i.Priority,
+		&i.Name,
+		&i.Description,
+		&i.AssignedToUserID,
+		&i.AssignedAt,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.SlaBreachedAt,
+		&i.ReceivingCellID,
+		&i.OriginalTaskID,
+		&i.WaveID,
+		&i.TemplateID,
+		&i.TransferOrderID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const moveItemInstance = `-- name: MoveItemInstance :exec
+UPDATE item_instance SET cell_id = $3, status = $4, affected_by_task_id = $5 WHERE org_id = $1 AND id = $2
+`
+
+type MoveItemInstanceParams struct {
+	OrgID            pgtype.UUID
+	ID               pgtype.UUID
+	CellID           pgtype.UUID
+	Status           ItemInstanceStatus
+	AffectedByTaskID pgtype.UUID
+}
 
-func (q *Queries) GetUserById(ctx context.Context, id pgtype.UUID) (AppUser, error) {
-	row := q.db.QueryRow(ctx, getUserById, id)
-	var i AppUser
+func (q *Queries) MoveItemInstance(ctx context.Context, arg MoveItemInstanceParams) error {
+	_, err := q.db.Exec(ctx, moveItemInstance,
+		arg.OrgID,
+		arg.ID,
+		arg.CellID,
+		arg.Status,
+		arg.AffectedByTaskID,
+	)
+	return err
+}
+
+const moveStockLine = `-- name: MoveStockLine :exec
+UPDATE stock_line SET cell_id = $3, status = $4, affected_by_task_id = $5 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+`
+
+type MoveStockLineParams struct {
+	OrgID            pgtype.UUID
+	ID               pgtype.UUID
+	CellID           pgtype.UUID
+	Status           ItemInstanceStatus
+	AffectedByTaskID pgtype.UUID
+}
+
+func (q *Queries) MoveStockLine(ctx context.Context, arg MoveStockLineParams) error {
+	_, err := q.db.Exec(ctx, moveStockLine,
+		arg.OrgID,
+		arg.ID,
+		arg.CellID,
+		arg.Status,
+		arg.AffectedByTaskID,
+	)
+	return err
+}
+
+const recordTaskPickupCodeFailure = `-- name: RecordTaskPickupCodeFailure :one
+UPDATE task_pickup_code SET
+    failed_attempts = failed_attempts + 1,
+    locked_at = CASE WHEN failed_attempts + 1 >= $3::int THEN CURRENT_TIMESTAMP ELSE locked_at END
+WHERE org_id = $1 AND task_id = $2
+RETURNING task_id, org_id, unit_id, code, failed_attempts, created_at, locked_at, used_at
+`
+
+type RecordTaskPickupCodeFailureParams struct {
+	OrgID       pgtype.UUID
+	TaskID      pgtype.UUID
+	MaxAttempts int32
+}
+
+func (q *Queries) RecordTaskPickupCodeFailure(ctx context.Context, arg RecordTaskPickupCodeFailureParams) (TaskPickupCode, error) {
+	row := q.db.QueryRow(ctx, recordTaskPickupCodeFailure, arg.OrgID, arg.TaskID, arg.MaxAttempts)
+	var i TaskPickupCode
+	err := row.Scan(
+		&i.TaskID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Code,
+		&i.FailedAttempts,
+		&i.CreatedAt,
+		&i.LockedAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const releaseWaveTasks = `-- name: ReleaseWaveTasks :exec
+UPDATE task SET wave_id = NULL WHERE org_id = $1 AND wave_id = $2
+`
+
+type ReleaseWaveTasksParams struct {
+	OrgID  pgtype.UUID
+	WaveID pgtype.UUID
+}
+
+func (q *Queries) ReleaseWaveTasks(ctx context.Context, arg ReleaseWaveTasksParams) error {
+	_, err := q.db.Exec(ctx, releaseWaveTasks, arg.OrgID, arg.WaveID)
+	return err
+}
+
+const revokeApiToken = `-- name: RevokeApiToken :exec
+UPDATE app_api_token SET revoked_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2
+`
+
+type RevokeApiTokenParams struct {
+	OrgID pgtype.UUID
+	ID    pgtype.UUID
+}
+
+func (q *Queries) RevokeApiToken(ctx context.Context, arg RevokeApiTokenParams) error {
+	_, err := q.db.Exec(ctx, revokeApiToken, arg.OrgID, arg.ID)
+	return err
+}
+
+const searchItemInstanceIdsBySerialNumber = `-- name: SearchItemInstanceIdsBySerialNumber :many
+SELECT id FROM item_instance
+WHERE org_id = $1 AND deleted_at IS NULL AND serial_number IS NOT NULL
+  AND ($2::text IS NULL OR serial_number = $2)
+  AND ($3::text IS NULL OR serial_number ILIKE $3)
+ORDER BY serial_number, id
+LIMIT $4::int
+`
+
+type SearchItemInstanceIdsBySerialNumberParams struct {
+	OrgID        pgtype.UUID
+	SerialNumber pgtype.Text
+	Pattern      pgtype.Text
+	MaxRows      int32
+}
+
+// Instances with the exact serial number or a serial number containing the
+// search pattern
+func (q *Queries) SearchItemInstanceIdsBySerialNumber(ctx context.Context, arg SearchItemInstanceIdsBySerialNumberParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, searchItemInstanceIdsBySerialNumber,
+		arg.OrgID,
+		arg.SerialNumber,
+		arg.Pattern,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setItemInstanceCell = `-- name: SetItemInstanceCell :exec
+UPDATE item_instance SET cell_id = $3 WHERE org_id = $1 AND id = $2
+`
+
+type SetItemInstanceCellParams struct {
+	OrgID  pgtype.UUID
+	ID     pgtype.UUID
+	CellID pgtype.UUID
+}
+
+func (q *Queries) SetItemInstanceCell(ctx context.Context, arg SetItemInstanceCellParams) error {
+	_, err := q.db.Exec(ctx, setItemInstanceCell, arg.OrgID, arg.ID, arg.CellID)
+	return err
+}
+
+const setItemInstanceTaskStatus = `-- name: SetItemInstanceTaskStatus :exec
+UPDATE item_instance SET status = $3, affected_by_task_id = $4 WHERE org_id = $1 AND id = $2
+`
+
+type SetItemInstanceTaskStatusParams struct {
+	OrgID            pgtype.UUID
+	ID               pgtype.UUID
+	Status           ItemInstanceStatus
+	AffectedByTaskID pgtype.UUID
+}
+
+func (q *Queries) SetItemInstanceTaskStatus(ctx context.Context, arg SetItemInstanceTaskStatusParams) error {
+	_, err := q.db.Exec(ctx, setItemInstanceTaskStatus,
+		arg.OrgID,
+		arg.ID,
+		arg.Status,
+		arg.AffectedByTaskID,
+	)
+	return err
+}
+
+const setPickWaveStatus = `-- name: SetPickWaveStatus :one
+UPDATE pick_wave SET status = $3, completed_at = $4 WHERE org_id = $1 AND id = $2 RETURNING id, org_id, unit_id, name, status, consolidation_cell_id, created_at, completed_at, deleted_at
+`
+
+type SetPickWaveStatusParams struct {
+	OrgID       pgtype.UUID
+	ID          pgtype.UUID
+	Status      PickWaveStatus
+	CompletedAt pgtype.Timestamp
+}
+
+func (q *Queries) SetPickWaveStatus(ctx context.Context, arg SetPickWaveStatusParams) (PickWave, error) {
+	row := q.db.QueryRow(ctx, setPickWaveStatus,
+		arg.OrgID,
+		arg.ID,
+		arg.Status,
+		arg.CompletedAt,
+	)
+	var i PickWave
 	err := row.Scan(
 		&i.ID,
-		&i.Email,
-		&i.FirstName,
-		&i.LastName,
-		&i.MiddleName,
-		&i.YandexID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Name,
+		&i.Status,
+		&i.ConsolidationCellID,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const setPickedTaskItemsDone = `-- name: SetPickedTaskItemsDone :exec
+UPDATE task_item SET status = 'done' WHERE org_id = $1 AND task_id = $2 AND status = 'picked'
+`
+
+type SetPickedTaskItemsDoneParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) SetPickedTaskItemsDone(ctx context.Context, arg SetPickedTaskItemsDoneParams) error {
+	_, err := q.db.Exec(ctx, setPickedTaskItemsDone, arg.OrgID, arg.TaskID)
+	return err
+}
+
+const setStockLineQuantity = `-- name: SetStockLineQuantity :exec
+UPDATE stock_line SET quantity = $3 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL
+`
+
+type SetStockLineQuantityParams struct {
+	OrgID    pgtype.UUID
+	ID       pgtype.UUID
+	Quantity int32
+}
+
+func (q *Queries) SetStockLineQuantity(ctx context.Context, arg SetStockLineQuantityParams) error {
+	_, err := q.db.Exec(ctx, setStockLineQuantity, arg.OrgID, arg.ID, arg.Quantity)
+	return err
+}
+
+const setTaskAssignee = `-- name: SetTaskAssignee :one
+UPDATE task SET assigned_to_user_id = $3, assigned_at = $4 WHERE org_id = $1 AND id = $2 RETURNING id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at
+`
+
+type SetTaskAssigneeParams struct {
+	OrgID            pgtype.UUID
+	ID               pgtype.UUID
+	AssignedToUserID pgtype.UUID
+	AssignedAt       pgtype.Timestamp
+}
+
+func (q *Queries) SetTaskAssignee(ctx context.Context, arg SetTaskAssigneeParams) (Task, error) {
+	row := q.db.QueryRow(ctx, setTaskAssignee,
+		arg.OrgID,
+		arg.ID,
+		arg.AssignedToUserID,
+		arg.AssignedAt,
+	)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Type,
+		&i.Status,
+		&i.Priority,
+		&i.Name,
+		&i.Description,
+		&i.AssignedToUserID,
+		&i.AssignedAt,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.SlaBreachedAt,
+		&i.ReceivingCellID,
+		&i.OriginalTaskID,
+		&i.WaveID,
+		&i.TemplateID,
+		&i.TransferOrderID,
 		&i.CreatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const getUserOrgs = `-- name: GetUserOrgs :many
-SELECT id, name, subdomain, created_at, deleted_at FROM org WHERE id IN (SELECT org_id FROM app_role_binding WHERE user_id = $1) AND deleted_at IS NULL
+const setTaskCountCellCounted = `-- name: SetTaskCountCellCounted :exec
+UPDATE task_count_cell SET counted_at = CURRENT_TIMESTAMP, counted_by_user_id = $4 WHERE org_id = $1 AND task_id = $2 AND cell_id = $3
+`
+
+type SetTaskCountCellCountedParams struct {
+	OrgID           pgtype.UUID
+	TaskID          pgtype.UUID
+	CellID          pgtype.UUID
+	CountedByUserID pgtype.UUID
+}
+
+func (q *Queries) SetTaskCountCellCounted(ctx context.Context, arg SetTaskCountCellCountedParams) error {
+	_, err := q.db.Exec(ctx, setTaskCountCellCounted,
+		arg.OrgID,
+		arg.TaskID,
+		arg.CellID,
+		arg.CountedByUserID,
+	)
+	return err
+}
+
+const setTaskExpectedItemsDiscrepancies = `-- name: SetTaskExpectedItemsDiscrepancies :exec
+UPDATE task_expected_item SET discrepancy = CASE
+    WHEN received_quantity > expected_quantity THEN 'over'::task_discrepancy_type
+    WHEN received_quantity < expected_quantity THEN 'under'::task_discrepancy_type
+END
+WHERE org_id = $1 AND task_id = $2
+`
+
+type SetTaskExpectedItemsDiscrepanciesParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) SetTaskExpectedItemsDiscrepancies(ctx context.Context, arg SetTaskExpectedItemsDiscrepanciesParams) error {
+	_, err := q.db.Exec(ctx, setTaskExpectedItemsDiscrepancies, arg.OrgID, arg.TaskID)
+	return err
+}
+
+const setTaskItemDisposition = `-- name: SetTaskItemDisposition :exec
+UPDATE task_item SET status = 'done', disposition = $4, destination_cell_id = $5
+WHERE org_id = $1 AND task_id = $2 AND item_instance_id = $3
+`
+
+type SetTaskItemDispositionParams struct {
+	OrgID             pgtype.UUID
+	TaskID            pgtype.UUID
+	ItemInstanceID    pgtype.UUID
+	Disposition       NullReturnDisposition
+	DestinationCellID pgtype.UUID
+}
+
+func (q *Queries) SetTaskItemDisposition(ctx context.Context, arg SetTaskItemDispositionParams) error {
+	_, err := q.db.Exec(ctx, setTaskItemDisposition,
+		arg.OrgID,
+		arg.TaskID,
+		arg.ItemInstanceID,
+		arg.Disposition,
+		arg.DestinationCellID,
+	)
+	return err
+}
+
+const setTaskItemStatus = `-- name: SetTaskItemStatus :exec
+UPDATE task_item SET status = $4 WHERE org_id = $1 AND task_id = $2 AND item_instance_id = $3
+`
+
+type SetTaskItemStatusParams struct {
+	OrgID          pgtype.UUID
+	TaskID         pgtype.UUID
+	ItemInstanceID pgtype.UUID
+	Status         TaskItemStatus
+}
+
+func (q *Queries) SetTaskItemStatus(ctx context.Context, arg SetTaskItemStatusParams) error {
+	_, err := q.db.Exec(ctx, setTaskItemStatus,
+		arg.OrgID,
+		arg.TaskID,
+		arg.ItemInstanceID,
+		arg.Status,
+	)
+	return err
+}
+
+const setTaskStockLineItem = `-- name: SetTaskStockLineItem :exec
+UPDATE task_item SET stock_line_id = $2, status = $3 WHERE org_id = $1 AND id = $4
+`
+
+type SetTaskStockLineItemParams struct {
+	OrgID       pgtype.UUID
+	StockLineID pgtype.UUID
+	Status      TaskItemStatus
+	ID          pgtype.UUID
+}
+
+// Picking a part of a stock line moves the task item to the split off line
+func (q *Queries) SetTaskStockLineItem(ctx context.Context, arg SetTaskStockLineItemParams) error {
+	_, err := q.db.Exec(ctx, setTaskStockLineItem,
+		arg.OrgID,
+		arg.StockLineID,
+		arg.Status,
+		arg.ID,
+	)
+	return err
+}
+
+const setTaskTemplateRun = `-- name: SetTaskTemplateRun :exec
+UPDATE task_template SET last_run_at = $1, next_run_at = $2 WHERE id = $3
 `
 
-// Organizations
-func (q *Queries) GetUserOrgs(ctx context.Context, userID pgtype.UUID) ([]Org, error) {
-	rows, err := q.db.Query(ctx, getUserOrgs, userID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Org
-	for rows.Next() {
-		var i Org
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Subdomain,
-			&i.CreatedAt,
-			&i.DeletedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+type SetTaskTemplateRunParams struct {
+	LastRunAt pgtype.Timestamp
+	NextRunAt pgtype.Timestamp
+	ID        pgtype.UUID
 }
 
-const getUserRoleInOrg = `-- name: GetUserRoleInOrg :one
-SELECT app_role.id, app_role.name, app_role.display_name, app_role.description FROM app_role 
-JOIN app_role_binding ON app_role.id = app_role_binding.role_id
-WHERE app_role_binding.user_id = $2 AND app_role_binding.org_id = $1
+func (q *Queries) SetTaskTemplateRun(ctx context.Context, arg SetTaskTemplateRunParams) error {
+	_, err := q.db.Exec(ctx, setTaskTemplateRun, arg.LastRunAt, arg.NextRunAt, arg.ID)
+	return err
+}
+
+const setTasksWave = `-- name: SetTasksWave :exec
+UPDATE task SET wave_id = $2 WHERE org_id = $1 AND id = ANY($3::uuid[])
 `
 
-type GetUserRoleInOrgParams struct {
+type SetTasksWaveParams struct {
 	OrgID  pgtype.UUID
-	UserID pgtype.UUID
+	WaveID pgtype.UUID
+	Ids    []pgtype.UUID
 }
 
-type GetUserRoleInOrgRow struct {
-	AppRole AppRole
+func (q *Queries) SetTasksWave(ctx context.Context, arg SetTasksWaveParams) error {
+	_, err := q.db.Exec(ctx, setTasksWave, arg.OrgID, arg.WaveID, arg.Ids)
+	return err
 }
 
-func (q *Queries) GetUserRoleInOrg(ctx context.Context, arg GetUserRoleInOrgParams) (GetUserRoleInOrgRow, error) {
-	row := q.db.QueryRow(ctx, getUserRoleInOrg, arg.OrgID, arg.UserID)
-	var i GetUserRoleInOrgRow
+const setTransferOrderStatus = `-- name: SetTransferOrderStatus :one
+UPDATE transfer_order SET status = $3, dispatched_at = $4, received_at = $5 WHERE org_id = $1 AND id = $2 RETURNING id, org_id, source_unit_id, destination_unit_id, receiving_cell_id, name, status, created_at, dispatched_at, received_at, deleted_at
+`
+
+type SetTransferOrderStatusParams struct {
+	OrgID        pgtype.UUID
+	ID           pgtype.UUID
+	Status       TransferOrderStatus
+	DispatchedAt pgtype.Timestamp
+	ReceivedAt   pgtype.Timestamp
+}
+
+func (q *Queries) SetTransferOrderStatus(ctx context.Context, arg SetTransferOrderStatusParams) (TransferOrder, error) {
+	row := q.db.QueryRow(ctx, setTransferOrderStatus,
+		arg.OrgID,
+		arg.ID,
+		arg.Status,
+		arg.DispatchedAt,
+		arg.ReceivedAt,
+	)
+	var i TransferOrder
 	err := row.Scan(
-		&i.AppRole.ID,
-		&i.AppRole.Name,
-		&i.AppRole.DisplayName,
-		&i.AppRole.Description,
+		&i.ID,
+		&i.OrgID,
+		&i.SourceUnitID,
+		&i.DestinationUnitID,
+		&i.ReceivingCellID,
+		&i.Name,
+		&i.Status,
+		&i.CreatedAt,
+		&i.DispatchedAt,
+		&i.ReceivedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const invalidateSession = `-- name: InvalidateSession :exec
-UPDATE app_user_session SET revoked_at = CURRENT_TIMESTAMP WHERE id = $1
+const setUnitLastAssignedUser = `-- name: SetUnitLastAssignedUser :exec
+UPDATE org_unit SET last_assigned_user_id = $3 WHERE org_id = $1 AND id = $2
 `
 
-func (q *Queries) InvalidateSession(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, invalidateSession, id)
+type SetUnitLastAssignedUserParams struct {
+	OrgID              pgtype.UUID
+	ID                 pgtype.UUID
+	LastAssignedUserID pgtype.UUID
+}
+
+func (q *Queries) SetUnitLastAssignedUser(ctx context.Context, arg SetUnitLastAssignedUserParams) error {
+	_, err := q.db.Exec(ctx, setUnitLastAssignedUser, arg.OrgID, arg.ID, arg.LastAssignedUserID)
 	return err
 }
 
-const revokeApiToken = `-- name: RevokeApiToken :exec
-UPDATE app_api_token SET revoked_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2
+const setWaveTaskItemsDestination = `-- name: SetWaveTaskItemsDestination :exec
+UPDATE task_item SET destination_cell_id = $2
+WHERE task_item.org_id = $1 AND task_item.status IN ('pending', 'picked')
+  AND task_item.task_id IN (SELECT id FROM task WHERE task.org_id = $1 AND task.wave_id = $3)
 `
 
-type RevokeApiTokenParams struct {
-	OrgID pgtype.UUID
-	ID    pgtype.UUID
+type SetWaveTaskItemsDestinationParams struct {
+	OrgID             pgtype.UUID
+	DestinationCellID pgtype.UUID
+	WaveID            pgtype.UUID
 }
 
-func (q *Queries) RevokeApiToken(ctx context.Context, arg RevokeApiTokenParams) error {
-	_, err := q.db.Exec(ctx, revokeApiToken, arg.OrgID, arg.ID)
+// Items still to be sorted are sent to the consolidation cell or released with NULL
+func (q *Queries) SetWaveTaskItemsDestination(ctx context.Context, arg SetWaveTaskItemsDestinationParams) error {
+	_, err := q.db.Exec(ctx, setWaveTaskItemsDestination, arg.OrgID, arg.DestinationCellID, arg.WaveID)
 	return err
 }
 
-const setItemInstanceCell = `-- name: SetItemInstanceCell :exec
-UPDATE item_instance SET cell_id = $3 WHERE org_id = $1 AND id = $2
+const setWorkerOnShift = `-- name: SetWorkerOnShift :one
+INSERT INTO app_worker_profile (org_id, user_id, on_shift) VALUES ($1, $2, $3)
+ON CONFLICT (org_id, user_id) DO UPDATE SET on_shift = EXCLUDED.on_shift, updated_at = CURRENT_TIMESTAMP
+RETURNING org_id, user_id, unit_ids, skills, on_shift, updated_at
 `
 
-type SetItemInstanceCellParams struct {
+type SetWorkerOnShiftParams struct {
+	OrgID   pgtype.UUID
+	UserID  pgtype.UUID
+	OnShift bool
+}
+
+func (q *Queries) SetWorkerOnShift(ctx context.Context, arg SetWorkerOnShiftParams) (AppWorkerProfile, error) {
+	row := q.db.QueryRow(ctx, setWorkerOnShift, arg.OrgID, arg.UserID, arg.OnShift)
+	var i AppWorkerProfile
+	err := row.Scan(
+		&i.OrgID,
+		&i.UserID,
+		&i.UnitIds,
+		&i.Skills,
+		&i.OnShift,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const shipTaskInstances = `-- name: ShipTaskInstances :exec
+UPDATE item_instance SET status = 'shipped', cell_id = NULL
+WHERE org_id = $1 AND affected_by_task_id = $2 AND status = 'reserved' AND deleted_at IS NULL
+`
+
+type ShipTaskInstancesParams struct {
 	OrgID  pgtype.UUID
-	ID     pgtype.UUID
-	CellID pgtype.UUID
+	TaskID pgtype.UUID
 }
 
-func (q *Queries) SetItemInstanceCell(ctx context.Context, arg SetItemInstanceCellParams) error {
-	_, err := q.db.Exec(ctx, setItemInstanceCell, arg.OrgID, arg.ID, arg.CellID)
+func (q *Queries) ShipTaskInstances(ctx context.Context, arg ShipTaskInstancesParams) error {
+	_, err := q.db.Exec(ctx, shipTaskInstances, arg.OrgID, arg.TaskID)
 	return err
 }
 
-const setItemInstanceTaskStatus = `-- name: SetItemInstanceTaskStatus :exec
-UPDATE item_instance SET status = $3, affected_by_task_id = $4 WHERE org_id = $1 AND id = $2
+const shipTaskStockLines = `-- name: ShipTaskStockLines :exec
+UPDATE stock_line SET status = 'shipped', cell_id = NULL
+WHERE org_id = $1 AND affected_by_task_id = $2 AND status = 'reserved' AND deleted_at IS NULL
 `
 
-type SetItemInstanceTaskStatusParams struct {
-	OrgID            pgtype.UUID
-	ID               pgtype.UUID
-	Status           ItemInstanceStatus
-	AffectedByTaskID pgtype.UUID
+type ShipTaskStockLinesParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
 }
 
-func (q *Queries) SetItemInstanceTaskStatus(ctx context.Context, arg SetItemInstanceTaskStatusParams) error {
-	_, err := q.db.Exec(ctx, setItemInstanceTaskStatus,
-		arg.OrgID,
-		arg.ID,
-		arg.Status,
-		arg.AffectedByTaskID,
-	)
+func (q *Queries) ShipTaskStockLines(ctx context.Context, arg ShipTaskStockLinesParams) error {
+	_, err := q.db.Exec(ctx, shipTaskStockLines, arg.OrgID, arg.TaskID)
 	return err
 }
 
-const setTaskItemStatus = `-- name: SetTaskItemStatus :exec
-UPDATE task_item SET status = $3 WHERE org_id = $1 AND item_instance_id = $2
+const stockLinesInOpenTasks = `-- name: StockLinesInOpenTasks :one
+SELECT EXISTS (
+    SELECT 1 FROM task_item
+    JOIN task ON task.id = task_item.task_id
+    WHERE task_item.org_id = $1 AND task_item.stock_line_id = ANY($2::uuid[])
+      AND task_item.status IN ('pending', 'picked') AND task.status IN ('pending', 'in_progress', 'ready')
+)
 `
 
-type SetTaskItemStatusParams struct {
-	OrgID          pgtype.UUID
-	ItemInstanceID pgtype.UUID
-	Status         TaskItemStatus
+type StockLinesInOpenTasksParams struct {
+	OrgID pgtype.UUID
+	Ids   []pgtype.UUID
 }
 
-func (q *Queries) SetTaskItemStatus(ctx context.Context, arg SetTaskItemStatusParams) error {
-	_, err := q.db.Exec(ctx, setTaskItemStatus, arg.OrgID, arg.ItemInstanceID, arg.Status)
-	return err
+func (q *Queries) StockLinesInOpenTasks(ctx context.Context, arg StockLinesInOpenTasksParams) (bool, error) {
+	row := q.db.QueryRow(ctx, stockLinesInOpenTasks, arg.OrgID, arg.Ids)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const tryLockTaskTemplate = `-- name: TryLockTaskTemplate :one
+SELECT pg_try_advisory_xact_lock(hashtext('task_template'), hashtext($1::text)) AS locked
+`
+
+// Transaction level advisory lock taken by the replica which runs the template
+func (q *Queries) TryLockTaskTemplate(ctx context.Context, templateID string) (bool, error) {
+	row := q.db.QueryRow(ctx, tryLockTaskTemplate, templateID)
+	var locked bool
+	err := row.Scan(&locked)
+	return locked, err
 }
 
 const unassignRoleFromUser = `-- name: UnassignRoleFromUser :exec
@@ -2055,14 +7025,18 @@ func (q *Queries) UpdateItem(ctx context.Context, arg UpdateItemParams) (Item, e
 }
 
 const updateItemInstance = `-- name: UpdateItemInstance :one
-UPDATE item_instance SET cell_id = $3, variant_id = $4 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, created_at, deleted_at
+UPDATE item_instance SET cell_id = $3, variant_id = $4, serial_number = $5, lot_number = $6, manufactured_at = $7, expires_at = $8 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING id, org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at, created_at, deleted_at
 `
 
 type UpdateItemInstanceParams struct {
-	OrgID     pgtype.UUID
-	ID        pgtype.UUID
-	CellID    pgtype.UUID
-	VariantID pgtype.UUID
+	OrgID          pgtype.UUID
+	ID             pgtype.UUID
+	CellID         pgtype.UUID
+	VariantID      pgtype.UUID
+	SerialNumber   pgtype.Text
+	LotNumber      pgtype.Text
+	ManufacturedAt pgtype.Date
+	ExpiresAt      pgtype.Date
 }
 
 func (q *Queries) UpdateItemInstance(ctx context.Context, arg UpdateItemInstanceParams) (ItemInstance, error) {
@@ -2071,6 +7045,10 @@ func (q *Queries) UpdateItemInstance(ctx context.Context, arg UpdateItemInstance
 		arg.ID,
 		arg.CellID,
 		arg.VariantID,
+		arg.SerialNumber,
+		arg.LotNumber,
+		arg.ManufacturedAt,
+		arg.ExpiresAt,
 	)
 	var i ItemInstance
 	err := row.Scan(
@@ -2081,6 +7059,10 @@ func (q *Queries) UpdateItemInstance(ctx context.Context, arg UpdateItemInstance
 		&i.CellID,
 		&i.Status,
 		&i.AffectedByTaskID,
+		&i.SerialNumber,
+		&i.LotNumber,
+		&i.ManufacturedAt,
+		&i.ExpiresAt,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -2088,16 +7070,19 @@ func (q *Queries) UpdateItemInstance(ctx context.Context, arg UpdateItemInstance
 }
 
 const updateItemVariant = `-- name: UpdateItemVariant :one
-UPDATE item_variant SET name = $4, article = $5, ean13 = $6 WHERE org_id = $1 AND item_id = $2 AND id = $3 AND deleted_at IS NULL RETURNING id, org_id, item_id, name, article, ean13, created_at, deleted_at
+UPDATE item_variant SET name = $4, article = $5, ean13 = $6, tracking_mode = $7, allocation_policy = $8, requires_serial = $9 WHERE org_id = $1 AND item_id = $2 AND id = $3 AND deleted_at IS NULL RETURNING id, org_id, item_id, name, article, ean13, tracking_mode, allocation_policy, requires_serial, created_at, deleted_at
 `
 
 type UpdateItemVariantParams struct {
-	OrgID   pgtype.UUID
-	ItemID  pgtype.UUID
-	ID      pgtype.UUID
-	Name    string
-	Article pgtype.Text
-	Ean13   pgtype.Int8
+	OrgID            pgtype.UUID
+	ItemID           pgtype.UUID
+	ID               pgtype.UUID
+	Name             string
+	Article          pgtype.Text
+	Ean13            pgtype.Int8
+	TrackingMode     ItemTrackingMode
+	AllocationPolicy NullAllocationPolicy
+	RequiresSerial   bool
 }
 
 func (q *Queries) UpdateItemVariant(ctx context.Context, arg UpdateItemVariantParams) (ItemVariant, error) {
@@ -2108,6 +7093,9 @@ func (q *Queries) UpdateItemVariant(ctx context.Context, arg UpdateItemVariantPa
 		arg.Name,
 		arg.Article,
 		arg.Ean13,
+		arg.TrackingMode,
+		arg.AllocationPolicy,
+		arg.RequiresSerial,
 	)
 	var i ItemVariant
 	err := row.Scan(
@@ -2117,6 +7105,9 @@ func (q *Queries) UpdateItemVariant(ctx context.Context, arg UpdateItemVariantPa
 		&i.Name,
 		&i.Article,
 		&i.Ean13,
+		&i.TrackingMode,
+		&i.AllocationPolicy,
+		&i.RequiresSerial,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -2124,15 +7115,16 @@ func (q *Queries) UpdateItemVariant(ctx context.Context, arg UpdateItemVariantPa
 }
 
 const updateOrgUnit = `-- name: UpdateOrgUnit :one
-UPDATE org_unit SET name = $3, alias = $4, address = $5 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING id, org_id, name, alias, address, created_at, deleted_at
+UPDATE org_unit SET name = $3, alias = $4, address = $5, pick_path_strategy = $6 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING id, org_id, name, alias, address, pick_path_strategy, last_assigned_user_id, created_at, deleted_at
 `
 
 type UpdateOrgUnitParams struct {
-	OrgID   pgtype.UUID
-	ID      pgtype.UUID
-	Name    string
-	Alias   string
-	Address pgtype.Text
+	OrgID            pgtype.UUID
+	ID               pgtype.UUID
+	Name             string
+	Alias            string
+	Address          pgtype.Text
+	PickPathStrategy PickPathStrategy
 }
 
 func (q *Queries) UpdateOrgUnit(ctx context.Context, arg UpdateOrgUnitParams) (OrgUnit, error) {
@@ -2142,6 +7134,7 @@ func (q *Queries) UpdateOrgUnit(ctx context.Context, arg UpdateOrgUnitParams) (O
 		arg.Name,
 		arg.Alias,
 		arg.Address,
+		arg.PickPathStrategy,
 	)
 	var i OrgUnit
 	err := row.Scan(
@@ -2150,6 +7143,8 @@ func (q *Queries) UpdateOrgUnit(ctx context.Context, arg UpdateOrgUnitParams) (O
 		&i.Name,
 		&i.Alias,
 		&i.Address,
+		&i.PickPathStrategy,
+		&i.LastAssignedUserID,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -2157,21 +7152,30 @@ func (q *Queries) UpdateOrgUnit(ctx context.Context, arg UpdateOrgUnitParams) (O
 }
 
 const updateOrganization = `-- name: UpdateOrganization :one
-UPDATE org SET name = $2 WHERE id = $1 RETURNING id, name, subdomain, created_at, deleted_at
+UPDATE org SET name = $2, task_assignment_strategy = $3, allocation_policy = $4 WHERE id = $1 RETURNING id, name, subdomain, task_assignment_strategy, allocation_policy, created_at, deleted_at
 `
 
 type UpdateOrganizationParams struct {
-	ID   pgtype.UUID
-	Name string
+	ID                     pgtype.UUID
+	Name                   string
+	TaskAssignmentStrategy TaskAssignmentStrategy
+	AllocationPolicy       AllocationPolicy
 }
 
 func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Org, error) {
-	row := q.db.QueryRow(ctx, updateOrganization, arg.ID, arg.Name)
+	row := q.db.QueryRow(ctx, updateOrganization,
+		arg.ID,
+		arg.Name,
+		arg.TaskAssignmentStrategy,
+		arg.AllocationPolicy,
+	)
 	var i Org
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Subdomain,
+		&i.TaskAssignmentStrategy,
+		&i.AllocationPolicy,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
@@ -2214,17 +7218,23 @@ func (q *Queries) UpdateStorageGroup(ctx context.Context, arg UpdateStorageGroup
 }
 
 const updateTask = `-- name: UpdateTask :one
-UPDATE task SET status = $3 WHERE org_id = $1 AND id = $2 RETURNING id, org_id, unit_id, type, status, name, description, assigned_to_user_id, assigned_at, completed_at, created_at, deleted_at
+UPDATE task SET status = $3, completed_at = $4 WHERE org_id = $1 AND id = $2 RETURNING id, org_id, unit_id, type, status, priority, name, description, assigned_to_user_id, assigned_at, completed_at, due_at, sla_breached_at, receiving_cell_id, original_task_id, wave_id, template_id, transfer_order_id, created_at, deleted_at
 `
 
 type UpdateTaskParams struct {
-	OrgID  pgtype.UUID
-	ID     pgtype.UUID
-	Status TaskStatus
+	OrgID       pgtype.UUID
+	ID          pgtype.UUID
+	Status      TaskStatus
+	CompletedAt pgtype.Timestamp
 }
 
 func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (Task, error) {
-	row := q.db.QueryRow(ctx, updateTask, arg.OrgID, arg.ID, arg.Status)
+	row := q.db.QueryRow(ctx, updateTask,
+		arg.OrgID,
+		arg.ID,
+		arg.Status,
+		arg.CompletedAt,
+	)
 	var i Task
 	err := row.Scan(
 		&i.ID,
@@ -2232,13 +7242,208 @@ func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (Task, e
 		&i.UnitID,
 		&i.Type,
 		&i.Status,
+		&i.Priority,
 		&i.Name,
 		&i.Description,
 		&i.AssignedToUserID,
 		&i.AssignedAt,
 		&i.CompletedAt,
+		&i.DueAt,
+		&i.SlaBreachedAt,
+		&i.ReceivingCellID,
+		&i.OriginalTaskID,
+		&i.WaveID,
+		&i.TemplateID,
+		&i.TransferOrderID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateTaskComment = `-- name: UpdateTaskComment :one
+UPDATE task_comment SET body = $4, edited_at = CURRENT_TIMESTAMP
+WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL RETURNING id, org_id, task_id, item_instance_id, body, author_user_id, created_at, edited_at, deleted_at
+`
+
+type UpdateTaskCommentParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+	ID     pgtype.UUID
+	Body   string
+}
+
+func (q *Queries) UpdateTaskComment(ctx context.Context, arg UpdateTaskCommentParams) (TaskComment, error) {
+	row := q.db.QueryRow(ctx, updateTaskComment,
+		arg.OrgID,
+		arg.TaskID,
+		arg.ID,
+		arg.Body,
+	)
+	var i TaskComment
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.TaskID,
+		&i.ItemInstanceID,
+		&i.Body,
+		&i.AuthorUserID,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateTaskTemplate = `-- name: UpdateTaskTemplate :one
+UPDATE task_template SET
+    unit_id = $3, type = $4, priority = $5, name = $6, description = $7, assigned_to_user_id = $8,
+    due_in_minutes = $9, schedule = $10, is_active = $11, next_run_at = $12
+WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING id, org_id, unit_id, type, priority, name, description, assigned_to_user_id, due_in_minutes, schedule, is_active, next_run_at, last_run_at, created_at, deleted_at
+`
+
+type UpdateTaskTemplateParams struct {
+	OrgID            pgtype.UUID
+	ID               pgtype.UUID
+	UnitID           pgtype.UUID
+	Type             TaskType
+	Priority         TaskPriority
+	Name             string
+	Description      pgtype.Text
+	AssignedToUserID pgtype.UUID
+	DueInMinutes     pgtype.Int4
+	Schedule         pgtype.Text
+	IsActive         bool
+	NextRunAt        pgtype.Timestamp
+}
+
+func (q *Queries) UpdateTaskTemplate(ctx context.Context, arg UpdateTaskTemplateParams) (TaskTemplate, error) {
+	row := q.db.QueryRow(ctx, updateTaskTemplate,
+		arg.OrgID,
+		arg.ID,
+		arg.UnitID,
+		arg.Type,
+		arg.Priority,
+		arg.Name,
+		arg.Description,
+		arg.AssignedToUserID,
+		arg.DueInMinutes,
+		arg.Schedule,
+		arg.IsActive,
+		arg.NextRunAt,
+	)
+	var i TaskTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Type,
+		&i.Priority,
+		&i.Name,
+		&i.Description,
+		&i.AssignedToUserID,
+		&i.DueInMinutes,
+		&i.Schedule,
+		&i.IsActive,
+		&i.NextRunAt,
+		&i.LastRunAt,
 		&i.CreatedAt,
 		&i.DeletedAt,
 	)
 	return i, err
 }
+
+const upsertTaskPickupCode = `-- name: UpsertTaskPickupCode :one
+INSERT INTO task_pickup_code (task_id, org_id, unit_id, code) VALUES ($1, $2, $3, $4)
+ON CONFLICT (task_id) DO UPDATE SET
+    code = EXCLUDED.code, failed_attempts = 0, created_at = CURRENT_TIMESTAMP, locked_at = NULL, used_at = NULL
+RETURNING task_id, org_id, unit_id, code, failed_attempts, created_at, locked_at, used_at
+`
+
+type UpsertTaskPickupCodeParams struct {
+	TaskID pgtype.UUID
+	OrgID  pgtype.UUID
+	UnitID pgtype.UUID
+	Code   string
+}
+
+func (q *Queries) UpsertTaskPickupCode(ctx context.Context, arg UpsertTaskPickupCodeParams) (TaskPickupCode, error) {
+	row := q.db.QueryRow(ctx, upsertTaskPickupCode,
+		arg.TaskID,
+		arg.OrgID,
+		arg.UnitID,
+		arg.Code,
+	)
+	var i TaskPickupCode
+	err := row.Scan(
+		&i.TaskID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Code,
+		&i.FailedAttempts,
+		&i.CreatedAt,
+		&i.LockedAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const upsertWorkerProfile = `-- name: UpsertWorkerProfile :one
+INSERT INTO app_worker_profile (org_id, user_id, unit_ids, skills, on_shift) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (org_id, user_id) DO UPDATE SET
+    unit_ids = EXCLUDED.unit_ids, skills = EXCLUDED.skills, on_shift = EXCLUDED.on_shift, updated_at = CURRENT_TIMESTAMP
+RETURNING org_id, user_id, unit_ids, skills, on_shift, updated_at
+`
+
+type UpsertWorkerProfileParams struct {
+	OrgID   pgtype.UUID
+	UserID  pgtype.UUID
+	UnitIds []pgtype.UUID
+	Skills  []string
+	OnShift bool
+}
+
+func (q *Queries) UpsertWorkerProfile(ctx context.Context, arg UpsertWorkerProfileParams) (AppWorkerProfile, error) {
+	row := q.db.QueryRow(ctx, upsertWorkerProfile,
+		arg.OrgID,
+		arg.UserID,
+		arg.UnitIds,
+		arg.Skills,
+		arg.OnShift,
+	)
+	var i AppWorkerProfile
+	err := row.Scan(
+		&i.OrgID,
+		&i.UserID,
+		&i.UnitIds,
+		&i.Skills,
+		&i.OnShift,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const useTaskPickupCode = `-- name: UseTaskPickupCode :one
+UPDATE task_pickup_code SET used_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND task_id = $2 RETURNING task_id, org_id, unit_id, code, failed_attempts, created_at, locked_at, used_at
+`
+
+type UseTaskPickupCodeParams struct {
+	OrgID  pgtype.UUID
+	TaskID pgtype.UUID
+}
+
+func (q *Queries) UseTaskPickupCode(ctx context.Context, arg UseTaskPickupCodeParams) (TaskPickupCode, error) {
+	row := q.db.QueryRow(ctx, useTaskPickupCode, arg.OrgID, arg.TaskID)
+	var i TaskPickupCode
+	err := row.Scan(
+		&i.TaskID,
+		&i.OrgID,
+		&i.UnitID,
+		&i.Code,
+		&i.FailedAttempts,
+		&i.CreatedAt,
+		&i.LockedAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
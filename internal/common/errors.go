@@ -20,6 +20,7 @@ var (
 	ErrValidationError  = &ErrDetailedValidationError{Message: "validation error"}
 	ErrNotFound         = errors.New("not found")
 	ErrDuplicationError = errors.New("duplication error")
+	ErrConflict         = errors.New("conflict")
 )
 
 var (
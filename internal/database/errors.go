@@ -17,6 +17,16 @@ func IsUniqueViolation(err error) bool {
 	return pgErr.Code == pgerrcode.UniqueViolation
 }
 
+// IsCheckViolation reports whether err was raised by a CHECK constraint
+func IsCheckViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == pgerrcode.CheckViolation
+}
+
 func IsNotFound(err error) bool {
 	return errors.Is(err, pgx.ErrNoRows)
 }
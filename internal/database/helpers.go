@@ -33,6 +33,22 @@ func PgUUIDPtr(id *uuid.UUID) pgtype.UUID {
 	return PgUUID(*id)
 }
 
+func PgUUIDs(ids []uuid.UUID) []pgtype.UUID {
+	result := make([]pgtype.UUID, len(ids))
+	for i, id := range ids {
+		result[i] = PgUUID(id)
+	}
+	return result
+}
+
+func UUIDsFromPgx(ids []pgtype.UUID) []uuid.UUID {
+	result := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		result[i] = UUIDFromPgx(id)
+	}
+	return result
+}
+
 // Text
 
 func PgTextPtrFromPgx(s pgtype.Text) *string {
@@ -80,6 +96,26 @@ func PgTimestampPtr(t *time.Time) pgtype.Timestamp {
 	return pgtype.Timestamp{Time: *t, Valid: !t.IsZero()}
 }
 
+// Date
+
+func PgDatePtrFromPgx(d pgtype.Date) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
+
+func PgDate(t time.Time) pgtype.Date {
+	return pgtype.Date{Time: t, Valid: !t.IsZero()}
+}
+
+func PgDatePtr(t *time.Time) pgtype.Date {
+	if t == nil {
+		return pgtype.Date{Valid: false}
+	}
+	return PgDate(*t)
+}
+
 // Int4
 
 func PgInt4(i int32) pgtype.Int4 {
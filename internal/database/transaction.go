@@ -14,11 +14,29 @@ import (
 type TxFn[T any] func(ctx context.Context, tx pgx.Tx) (T, error)
 type VoidTxFn func(ctx context.Context, tx pgx.Tx) error
 
+type txContextKey struct{}
+
+// ContextWithTx makes WithTransaction calls made with the returned context
+// run as savepoints of tx, so that several service calls are committed or
+// rolled back together
+func ContextWithTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
 func WithTransaction[T any](ctx context.Context, pool *pgxpool.Pool, tracer trace.Tracer, f TxFn[T]) (result T, err error) {
 	ctx, span := tracer.Start(ctx, "database.WithTransaction")
 	defer span.End()
 
-	tx, err := pool.Begin(ctx)
+	var tx pgx.Tx
+	if outer, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		span.SetAttributes(attribute.Bool("transaction.nested", true))
+		tx, err = outer.Begin(ctx)
+		if err == nil {
+			ctx = ContextWithTx(ctx, tx)
+		}
+	} else {
+		tx, err = pool.Begin(ctx)
+	}
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "failed to begin transaction")
@@ -70,6 +70,11 @@ func toAuditLog(objectChange *models.ObjectChange) (*api.AuditLog, error) {
 		postchangeState.Null = true
 	}
 
+	var reason api.OptNilString
+	if objectChange.Reason != nil {
+		reason.SetTo(string(*objectChange.Reason))
+	}
+
 	return &api.AuditLog{
 		ID:               objectChange.ID,
 		Employee:         toEmployeeDTO(objectChange.Employee),
@@ -78,15 +83,21 @@ func toAuditLog(objectChange *models.ObjectChange) (*api.AuditLog, error) {
 		TargetObjectType: toObjectTypeDTO(objectChange.ObjectType),
 		PrechangeState:   prechangeState,
 		PostchangeState:  postchangeState,
+		Reason:           reason,
 	}, nil
 }
 
 func (h *RestApiImplementation) GetAuditLogs(ctx context.Context, params api.GetAuditLogsParams) (api.GetAuditLogsRes, error) {
-	if !params.ObjectTypeID.Set || !params.ObjectID.Set {
-		return nil, h.NewError(ctx, errors.New("object type id and object id are required"))
+	var auditLogs []*models.ObjectChange
+	var err error
+	if serialNumber, ok := params.SerialNumber.Get(); ok {
+		auditLogs, err = h.auditUseCase.GetItemInstanceChangesBySerialNumber(ctx, serialNumber)
+	} else {
+		if !params.ObjectTypeID.Set || !params.ObjectID.Set {
+			return nil, h.NewError(ctx, errors.New("object type id and object id are required"))
+		}
+		auditLogs, err = h.auditUseCase.GetObjectChanges(ctx, models.ObjectTypeId(params.ObjectTypeID.Value), params.ObjectID.Value)
 	}
-
-	auditLogs, err := h.auditUseCase.GetObjectChanges(ctx, models.ObjectTypeId(params.ObjectTypeID.Value), params.ObjectID.Value)
 	if err != nil {
 		return nil, h.NewError(ctx, err)
 	}
@@ -95,6 +95,9 @@ func (h *RestApiImplementation) NewError(ctx context.Context, err error) *api.De
 	case errors.Is(err, common.ErrDuplicationError):
 		return h.NewConflictError(ctx, err.Error())
 
+	case errors.Is(err, common.ErrConflict):
+		return h.NewConflictError(ctx, err.Error())
+
 	case errors.Is(err, common.ErrNotFound):
 		return h.NewNotFoundError(ctx, err.Error())
 
@@ -7,6 +7,7 @@ import (
 	authUC "github.com/let-store-it/backend/internal/usecases/auth"
 	itemUC "github.com/let-store-it/backend/internal/usecases/item"
 	orgUC "github.com/let-store-it/backend/internal/usecases/organization"
+	stockUC "github.com/let-store-it/backend/internal/usecases/stock"
 	storageUC "github.com/let-store-it/backend/internal/usecases/storage"
 	taskUC "github.com/let-store-it/backend/internal/usecases/task"
 	tvboardUC "github.com/let-store-it/backend/internal/usecases/tv_board"
@@ -21,6 +22,7 @@ type RestApiImplementation struct {
 	auditUseCase        *auditUC.AuditUseCase
 	taskUseCase         *taskUC.TaskUseCase
 	tvBoardUseCase      *tvboardUC.TvBoardUseCase
+	stockUseCase        *stockUC.StockUseCase
 }
 
 // GetInstancesByItemId implements api.Handler.
@@ -37,6 +39,7 @@ func NewRestApiImplementation(
 	auditUseCase *auditUC.AuditUseCase,
 	taskUseCase *taskUC.TaskUseCase,
 	tvBoardUseCase *tvboardUC.TvBoardUseCase,
+	stockUseCase *stockUC.StockUseCase,
 ) *RestApiImplementation {
 	return &RestApiImplementation{
 		orgUseCase:          orgUseCase,
@@ -47,5 +50,6 @@ func NewRestApiImplementation(
 		auditUseCase:        auditUseCase,
 		taskUseCase:         taskUseCase,
 		tvBoardUseCase:      tvBoardUseCase,
+		stockUseCase:        stockUseCase,
 	}
 }
@@ -41,14 +41,15 @@ func convertCellPathToDTO(cellPath *[]models.CellPathSegment) []api.CellForInsta
 	return dtoCellPath
 }
 
-func convertCellToNilDTO(cell *models.Cell) api.CellForInstance {
-	res := api.CellForInstance{}
-	if cell == nil {
-		return res
+func convertCellToDTO(cell *models.Cell) api.CellForInstance {
+	return api.CellForInstance{
+		ID:       cell.ID,
+		Alias:    cell.Alias,
+		Row:      cell.Row,
+		Level:    cell.Level,
+		Position: cell.Position,
+		CellPath: convertCellPathToDTO(cell.Path),
 	}
-	modelCell := convertCellToDTO(cell)
-	res = modelCell
-	return res
 }
 
 func convertCellOptionalToNilDTO(cell *models.Cell) api.NilCellForInstanceOptional {
@@ -69,23 +70,26 @@ func convertCellOptionalToNilDTO(cell *models.Cell) api.NilCellForInstanceOption
 	return res
 }
 
-func convertCellToDTO(cell *models.Cell) api.CellForInstance {
-	return api.CellForInstance{
-		ID:       cell.ID,
-		Alias:    cell.Alias,
-		Row:      cell.Row,
-		Level:    cell.Level,
-		Position: cell.Position,
-		CellPath: convertCellPathToDTO(cell.Path),
-	}
+func convertItemLotToDTO(itemInstance *models.ItemInstance) (lotNumber api.NilString, manufacturedAt api.NilDate, expiresAt api.NilDate) {
+	PtrToApiNil(itemInstance.LotNumber, &lotNumber)
+	PtrToApiNil(itemInstance.ManufacturedAt, &manufacturedAt)
+	PtrToApiNil(itemInstance.ExpiresAt, &expiresAt)
+	return lotNumber, manufacturedAt, expiresAt
 }
 
 func convertItemInstanceToDTO(itemInstance *models.ItemInstance) api.InstanceForItem {
+	var serialNumber api.NilString
+	PtrToApiNil(itemInstance.SerialNumber, &serialNumber)
+	lotNumber, manufacturedAt, expiresAt := convertItemLotToDTO(itemInstance)
 	return api.InstanceForItem{
-		ID:      itemInstance.ID,
-		Status:  api.InstanceForItemStatus(itemInstance.Status),
-		Variant: convertItemVariantToDTO(itemInstance.Variant),
-		Cell:    convertCellOptionalToNilDTO(itemInstance.Cell),
+		ID:             itemInstance.ID,
+		Status:         api.InstanceForItemStatus(itemInstance.Status),
+		Variant:        convertItemVariantToDTO(itemInstance.Variant),
+		Cell:           convertCellOptionalToNilDTO(itemInstance.Cell),
+		SerialNumber:   serialNumber,
+		LotNumber:      lotNumber,
+		ManufacturedAt: manufacturedAt,
+		ExpiresAt:      expiresAt,
 	}
 }
 
@@ -107,6 +111,9 @@ func convertItemInstanceToTaskItemDTO(itemInstance *models.ItemInstance) api.Ins
 	}
 	var affectedByTaskId api.NilUUID
 	PtrToApiNil(itemInstance.AffectedByTaskID, &affectedByTaskId)
+	var serialNumber api.NilString
+	PtrToApiNil(itemInstance.SerialNumber, &serialNumber)
+	lotNumber, manufacturedAt, expiresAt := convertItemLotToDTO(itemInstance)
 
 	return api.InstanceFull{
 		ID:               itemInstance.ID,
@@ -115,6 +122,10 @@ func convertItemInstanceToTaskItemDTO(itemInstance *models.ItemInstance) api.Ins
 		Cell:             convertCellOptionalToNilDTO(itemInstance.Cell),
 		Item:             item,
 		AffectedByTaskId: affectedByTaskId,
+		SerialNumber:     serialNumber,
+		LotNumber:        lotNumber,
+		ManufacturedAt:   manufacturedAt,
+		ExpiresAt:        expiresAt,
 	}
 }
 
@@ -129,11 +140,21 @@ func convertItemVariantToDTO(variant *models.ItemVariant) api.ItemVariant {
 	var ean13 api.NilInt64
 	PtrToApiNil(variant.EAN13, &ean13)
 
+	var allocationPolicy api.OptNilItemVariantAllocationPolicy
+	if variant.AllocationPolicy != nil {
+		allocationPolicy.SetTo(api.ItemVariantAllocationPolicy(*variant.AllocationPolicy))
+	} else {
+		allocationPolicy.SetToNull()
+	}
+
 	return api.ItemVariant{
-		ID:      variant.ID,
-		Name:    variant.Name,
-		Article: article,
-		Ean13:   ean13,
+		ID:               variant.ID,
+		Name:             variant.Name,
+		Article:          article,
+		Ean13:            ean13,
+		TrackingMode:     api.NewOptItemVariantTrackingMode(api.ItemVariantTrackingMode(variant.TrackingMode)),
+		AllocationPolicy: allocationPolicy,
+		RequiresSerial:   api.NewOptBool(variant.RequiresSerial),
 	}
 }
 
@@ -162,19 +183,6 @@ func convertItemInstancesForItemToDTO(itemInstances []*models.ItemInstance) []ap
 			continue
 		}
 
-		var cellPath []api.CellForInstanceCellPathItem
-		if instance.Cell != nil && instance.Cell.Path != nil {
-			cellPath = make([]api.CellForInstanceCellPathItem, 0, len(*instance.Cell.Path))
-			for _, pathSegment := range *instance.Cell.Path {
-				cellPath = append(cellPath, api.CellForInstanceCellPathItem{
-					ID:         pathSegment.ID,
-					Alias:      pathSegment.Alias,
-					Name:       pathSegment.Name,
-					ObjectType: api.CellForInstanceCellPathItemObjectType(pathSegment.ObjectType),
-				})
-			}
-		}
-
 		var article api.NilString
 		if instance.Variant != nil {
 			PtrToApiNil(instance.Variant.Article, &article)
@@ -195,11 +203,18 @@ func convertItemInstancesForItemToDTO(itemInstances []*models.ItemInstance) []ap
 			}
 		}
 
+		var serialNumber api.NilString
+		PtrToApiNil(instance.SerialNumber, &serialNumber)
+		lotNumber, manufacturedAt, expiresAt := convertItemLotToDTO(instance)
 		dtoInstances = append(dtoInstances, api.InstanceForItem{
-			ID:      instance.ID,
-			Status:  api.InstanceForItemStatus(instance.Status),
-			Variant: variant,
-			Cell:    convertCellOptionalToNilDTO(instance.Cell),
+			ID:             instance.ID,
+			Status:         api.InstanceForItemStatus(instance.Status),
+			Variant:        variant,
+			Cell:           convertCellOptionalToNilDTO(instance.Cell),
+			SerialNumber:   serialNumber,
+			LotNumber:      lotNumber,
+			ManufacturedAt: manufacturedAt,
+			ExpiresAt:      expiresAt,
 		})
 	}
 	return dtoInstances
@@ -317,10 +332,17 @@ func (h *RestApiImplementation) UpdateItem(ctx context.Context, req *api.UpdateI
 
 func (h *RestApiImplementation) CreateItemVariant(ctx context.Context, req *api.CreateItemVariantRequest, params api.CreateItemVariantParams) (api.CreateItemVariantRes, error) {
 	variant := &models.ItemVariant{
-		Name:    req.Name,
-		ItemID:  params.ID,
-		Article: ApiValueToPtr(req.Article),
-		EAN13:   ApiValueToPtr(req.Ean13),
+		Name:         req.Name,
+		ItemID:       params.ID,
+		Article:      ApiValueToPtr(req.Article),
+		EAN13:        ApiValueToPtr(req.Ean13),
+		TrackingMode: models.ItemTrackingMode(req.TrackingMode.Or(api.CreateItemVariantRequestTrackingModeSerialized)),
+
+		RequiresSerial: req.RequiresSerial.Or(false),
+	}
+	if policy, ok := req.AllocationPolicy.Get(); ok {
+		allocationPolicy := models.AllocationPolicy(policy)
+		variant.AllocationPolicy = &allocationPolicy
 	}
 
 	res, err := h.itemUseCase.CreateItemVariant(ctx, variant)
@@ -356,12 +378,23 @@ func (h *RestApiImplementation) GetItemVariantById(ctx context.Context, params a
 }
 
 func (h *RestApiImplementation) UpdateItemVariant(ctx context.Context, req *api.UpdateItemVariantRequest, params api.UpdateItemVariantParams) (api.UpdateItemVariantRes, error) {
+	// Tracking mode is kept when not given, a missing allocation policy falls
+	// back to the one of the organization
 	variant := &models.ItemVariant{
 		ID:      params.VariantId,
 		Name:    req.Name,
 		ItemID:  params.ID,
 		Article: ApiValueToPtr(req.Article),
 		EAN13:   ApiValueToPtr(req.Ean13),
+
+		RequiresSerial: req.RequiresSerial.Or(false),
+	}
+	if mode, ok := req.TrackingMode.Get(); ok {
+		variant.TrackingMode = models.ItemTrackingMode(mode)
+	}
+	if policy, ok := req.AllocationPolicy.Get(); ok {
+		allocationPolicy := models.AllocationPolicy(policy)
+		variant.AllocationPolicy = &allocationPolicy
 	}
 
 	updatedVariant, err := h.itemUseCase.UpdateItemVariant(ctx, variant)
@@ -388,6 +421,11 @@ func (h *RestApiImplementation) CreateInstanceForItem(ctx context.Context, req *
 		ItemID:    params.ItemId,
 		VariantID: req.VariantId,
 		CellID:    ApiValueToPtr(req.CellId),
+
+		SerialNumber:   ApiValueToPtr(req.SerialNumber),
+		LotNumber:      ApiValueToPtr(req.LotNumber),
+		ManufacturedAt: ApiValueToPtr(req.ManufacturedAt),
+		ExpiresAt:      ApiValueToPtr(req.ExpiresAt),
 	}
 
 	itemInstance, err := h.itemUseCase.CreateItemInstance(ctx, itemInstance)
@@ -420,7 +458,11 @@ func (h *RestApiImplementation) GetInstanceById(ctx context.Context, params api.
 }
 
 func (h *RestApiImplementation) UpdateInstanceById(ctx context.Context, req *api.UpdateInstanceRequest, params api.UpdateInstanceByIdParams) (api.UpdateInstanceByIdRes, error) {
-	updatedInstance, err := h.itemUseCase.UpdateItemInstance(ctx, params.InstanceId, req.VariantId, ApiValueToPtr(req.CellId))
+	updatedInstance, err := h.itemUseCase.UpdateItemInstance(ctx, params.InstanceId, req.VariantId, ApiValueToPtr(req.CellId), ApiValueToPtr(req.SerialNumber), models.ItemLot{
+		LotNumber:      ApiValueToPtr(req.LotNumber),
+		ManufacturedAt: ApiValueToPtr(req.ManufacturedAt),
+		ExpiresAt:      ApiValueToPtr(req.ExpiresAt),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -435,8 +477,14 @@ func (h *RestApiImplementation) UpdateInstanceById(ctx context.Context, req *api
 	}, nil
 }
 
-func (h *RestApiImplementation) GetInstances(ctx context.Context) (api.GetInstancesRes, error) {
-	instances, err := h.itemUseCase.GetItemInstancesAll(ctx)
+func (h *RestApiImplementation) GetInstances(ctx context.Context, params api.GetInstancesParams) (api.GetInstancesRes, error) {
+	var instances []*models.ItemInstance
+	var err error
+	if params.SerialNumber.Set || params.Search.Set {
+		instances, err = h.itemUseCase.SearchItemInstancesBySerialNumber(ctx, ApiValueToPtr(params.SerialNumber), ApiValueToPtr(params.Search))
+	} else {
+		instances, err = h.itemUseCase.GetItemInstancesAll(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
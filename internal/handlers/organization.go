@@ -12,6 +12,9 @@ func convertToDTO(org *models.Organization) api.Organization {
 		ID:        org.ID,
 		Name:      org.Name,
 		Subdomain: org.Subdomain,
+
+		TaskAssignmentStrategy: api.TaskAssignmentStrategy(org.TaskAssignmentStrategy),
+		AllocationPolicy:       api.AllocationPolicy(org.AllocationPolicy),
 	}
 }
 
@@ -60,7 +63,9 @@ func (h *RestApiImplementation) UpdateOrganization(ctx context.Context, req *api
 	ctx = context.WithValue(ctx, models.OrganizationIDContextKey, params.ID)
 
 	org := &models.Organization{
-		Name: req.Name,
+		Name:                   req.Name,
+		TaskAssignmentStrategy: models.TaskAssignmentStrategy(req.TaskAssignmentStrategy.Or("")),
+		AllocationPolicy:       models.AllocationPolicy(req.AllocationPolicy.Or("")),
 	}
 
 	updatedOrg, err := h.orgUseCase.Update(ctx, org)
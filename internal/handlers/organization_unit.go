@@ -16,10 +16,11 @@ func convertUnitToDTO(unit *models.OrganizationUnit) api.Unit {
 	}
 
 	return api.Unit{
-		ID:      unit.ID,
-		Name:    unit.Name,
-		Alias:   api.StorageAlias(unit.Alias),
-		Address: address,
+		ID:               unit.ID,
+		Name:             unit.Name,
+		Alias:            api.StorageAlias(unit.Alias),
+		Address:          address,
+		PickPathStrategy: api.UnitPickPathStrategy(unit.PickPathStrategy),
 	}
 }
 
@@ -40,7 +41,7 @@ func (h *RestApiImplementation) GetOrganizationUnits(ctx context.Context) (api.G
 }
 
 func (h *RestApiImplementation) CreateUnit(ctx context.Context, req *api.UnitBase) (api.CreateUnitRes, error) {
-	unit, err := h.orgUnitUseCase.CreateUnit(ctx, req.Name, string(req.Alias), req.Address.Value)
+	unit, err := h.orgUnitUseCase.CreateUnit(ctx, req.Name, string(req.Alias), req.Address.Value, models.PickPathStrategy(req.PickPathStrategy.Or(api.UnitBasePickPathStrategySerpentine)))
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +82,8 @@ func (h *RestApiImplementation) UpdateOrganizationUnit(ctx context.Context, req
 		Name:    req.Name,
 		Alias:   string(req.Alias),
 		Address: address,
+
+		PickPathStrategy: models.PickPathStrategy(req.PickPathStrategy.Or(api.UnitBasePickPathStrategySerpentine)),
 	}
 
 	updatedUnit, err := h.orgUnitUseCase.UpdateUnit(ctx, unit)
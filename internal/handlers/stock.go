@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/let-store-it/backend/generated/api"
+	"github.com/let-store-it/backend/internal/models"
+)
+
+func stockLevelToDto(level *models.StockLevel) api.StockLevel {
+	return api.StockLevel{
+		ItemId:  level.ItemID,
+		Variant: convertItemVariantToDTO(level.Variant),
+		Location: api.StockLevelLocation{
+			ID:         level.Location.ID,
+			Name:       level.Location.Name,
+			Alias:      level.Location.Alias,
+			ObjectType: api.StockLevelLocationObjectType(level.Location.ObjectType),
+		},
+		Available: level.Available,
+		Reserved:  level.Reserved,
+		Consumed:  level.Consumed,
+		InTransit: level.InTransit,
+		Total:     level.Total(),
+	}
+}
+
+func (h *RestApiImplementation) GetStockLevels(ctx context.Context, params api.GetStockLevelsParams) (api.GetStockLevelsRes, error) {
+	filter := &models.StockFilter{
+		ItemID:    ApiValueToPtr(params.ItemID),
+		VariantID: ApiValueToPtr(params.VariantID),
+		UnitID:    ApiValueToPtr(params.UnitID),
+		GroupBy:   models.CellPathObjectType(params.GroupBy.Or(api.GetStockLevelsGroupByUnit)),
+	}
+	for _, status := range params.Status {
+		filter.Statuses = append(filter.Statuses, models.ItemInstanceStatus(status))
+	}
+
+	levels, err := h.stockUseCase.GetStockLevels(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.StockLevel, len(levels))
+	for i, level := range levels {
+		res[i] = stockLevelToDto(level)
+	}
+	return &api.GetStockLevelsResponse{
+		Data: res,
+	}, nil
+}
+
+func (h *RestApiImplementation) GetExpiringInstances(ctx context.Context, params api.GetExpiringInstancesParams) (api.GetExpiringInstancesRes, error) {
+	instances, err := h.stockUseCase.GetExpiringInstances(ctx, params.Days.Or(30), ApiValueToPtr(params.UnitID))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.InstanceFull, len(instances))
+	for i, instance := range instances {
+		res[i] = convertItemInstanceToTaskItemDTO(instance)
+	}
+	return &api.GetInstancesResponse{
+		Data: res,
+	}, nil
+}
+
+func gs1DataToNilDto(data *models.GS1Data) api.NilGS1Data {
+	res := api.NilGS1Data{}
+	if data == nil {
+		res.SetToNull()
+		return res
+	}
+	dto := api.GS1Data{}
+	PtrToApiNil(data.GTIN, &dto.Gtin)
+	PtrToApiNil(data.Lot.LotNumber, &dto.LotNumber)
+	PtrToApiNil(data.Lot.ManufacturedAt, &dto.ManufacturedAt)
+	PtrToApiNil(data.Lot.ExpiresAt, &dto.ExpiresAt)
+	PtrToApiNil(data.SerialNumber, &dto.SerialNumber)
+	res.SetTo(dto)
+	return res
+}
+
+func barcodeMatchToDto(match models.BarcodeMatch) api.BarcodeMatch {
+	res := api.BarcodeMatch{
+		ObjectType: api.BarcodeMatchObjectType(match.ObjectType),
+		MatchedBy:  api.BarcodeMatchMatchedBy(match.MatchedBy),
+	}
+	if match.Variant != nil {
+		res.Variant = api.NewOptItemVariant(convertItemVariantToDTO(match.Variant))
+		res.Stock = make([]api.StockLevel, len(match.Stock))
+		for i, level := range match.Stock {
+			res.Stock[i] = stockLevelToDto(level)
+		}
+	}
+	if match.Cell != nil {
+		res.Cell = api.NewOptCellForInstance(convertCellToDTO(match.Cell))
+	}
+	if match.Instance != nil {
+		res.Instance = api.NewOptInstanceFull(convertItemInstanceToTaskItemDTO(match.Instance))
+	}
+	if match.TvBoard != nil {
+		res.TvBoard = api.NewOptTvBoard(toTvBoard(match.TvBoard))
+	}
+	return res
+}
+
+func (h *RestApiImplementation) ResolveBarcode(ctx context.Context, params api.ResolveBarcodeParams) (api.ResolveBarcodeRes, error) {
+	resolution, err := h.stockUseCase.ResolveBarcode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]api.BarcodeMatch, len(resolution.Matches))
+	for i, match := range resolution.Matches {
+		matches[i] = barcodeMatchToDto(match)
+	}
+	return &api.ResolveBarcodeResponse{
+		Data: api.ResolveBarcodeResponseData{
+			Code:    resolution.Code,
+			Gs1:     gs1DataToNilDto(resolution.GS1),
+			Matches: matches,
+		},
+	}, nil
+}
+
+func stockLineToDto(line *models.StockLine) api.StockLine {
+	var item api.ItemForList
+	if line.Item != nil {
+		var description api.NilString
+		PtrToApiNil(line.Item.Description, &description)
+		item = api.ItemForList{
+			ID:          line.Item.ID,
+			Name:        line.Item.Name,
+			Description: description,
+			Variants:    convertItemVariantsToDTO(line.Item.Variants),
+		}
+	}
+
+	var affectedByTaskId api.NilUUID
+	PtrToApiNil(line.AffectedByTaskID, &affectedByTaskId)
+
+	return api.StockLine{
+		ID:               line.ID,
+		Status:           api.StockLineStatus(line.Status),
+		Quantity:         line.Quantity,
+		Item:             item,
+		Variant:          convertItemVariantToDTO(line.Variant),
+		Cell:             convertCellOptionalToNilDTO(line.Cell),
+		AffectedByTaskId: affectedByTaskId,
+		CreatedAt:        line.CreatedAt,
+	}
+}
+
+func (h *RestApiImplementation) GetStockLines(ctx context.Context, params api.GetStockLinesParams) (api.GetStockLinesRes, error) {
+	filter := &models.StockLineFilter{
+		ItemID:    ApiValueToPtr(params.ItemID),
+		VariantID: ApiValueToPtr(params.VariantID),
+		CellID:    ApiValueToPtr(params.CellID),
+	}
+	if status, ok := params.Status.Get(); ok {
+		s := models.ItemInstanceStatus(status)
+		filter.Status = &s
+	}
+
+	lines, err := h.itemUseCase.GetStockLines(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.StockLine, len(lines))
+	for i, line := range lines {
+		res[i] = stockLineToDto(line)
+	}
+	return &api.GetStockLinesResponse{
+		Data: res,
+	}, nil
+}
+
+func (h *RestApiImplementation) CreateStockLine(ctx context.Context, req *api.CreateStockLineRequest) (api.CreateStockLineRes, error) {
+	line, err := h.itemUseCase.CreateStockLine(ctx, &models.StockLine{
+		VariantID: req.VariantId,
+		CellID:    &req.CellId,
+		Quantity:  req.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetStockLineResponse{
+		Data: stockLineToDto(line),
+	}, nil
+}
+
+func (h *RestApiImplementation) GetStockLineById(ctx context.Context, params api.GetStockLineByIdParams) (api.GetStockLineByIdRes, error) {
+	line, err := h.itemUseCase.GetStockLineById(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetStockLineResponse{
+		Data: stockLineToDto(line),
+	}, nil
+}
+
+func (h *RestApiImplementation) SplitStockLine(ctx context.Context, req *api.SplitStockLineRequest, params api.SplitStockLineParams) (api.SplitStockLineRes, error) {
+	line, err := h.itemUseCase.SplitStockLine(ctx, params.ID, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetStockLineResponse{
+		Data: stockLineToDto(line),
+	}, nil
+}
+
+func (h *RestApiImplementation) MergeStockLines(ctx context.Context, req *api.MergeStockLinesRequest) (api.MergeStockLinesRes, error) {
+	line, err := h.itemUseCase.MergeStockLines(ctx, req.StockLineIds)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetStockLineResponse{
+		Data: stockLineToDto(line),
+	}, nil
+}
@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
+	"io"
 
+	"github.com/google/uuid"
 	"github.com/let-store-it/backend/generated/api"
 	"github.com/let-store-it/backend/internal/models"
 )
@@ -47,8 +50,12 @@ func taskToDto(task *models.Task) api.TaskBase {
 		AssignedAt:  assignedAt,
 		CompletedAt: completedAt,
 		Type:        api.TaskBaseType(task.Type),
+		Priority:    api.TaskBasePriority(task.Priority),
 	}
 
+	PtrToApiNil(task.DueAt, &res.DueAt)
+	PtrToApiNil(task.SlaBreachedAt, &res.SlaBreachedAt)
+
 	var assignedTo api.NilEmployeeOptional
 	if task.AssignedTo != nil {
 		assignedTo.SetTo(toAssignedToDTO(task.AssignedTo))
@@ -63,36 +70,64 @@ func taskToDto(task *models.Task) api.TaskBase {
 }
 
 func taskItemToDto(item *models.TaskItem) api.TaskItem {
-	var instance api.InstanceFull
+	var instance api.OptInstanceFull
 	if item.Instance != nil {
-		instance = convertItemInstanceToTaskItemDTO(item.Instance)
+		instance.SetTo(convertItemInstanceToTaskItemDTO(item.Instance))
 	}
 
-	var sourceCell api.CellForInstance
-	if item.SourceCell != nil {
-		sourceCell = convertCellToDTO(item.SourceCell)
+	var stockLine api.OptStockLine
+	if item.StockLine != nil {
+		stockLine.SetTo(stockLineToDto(item.StockLine))
 	}
 
-	var targetCell api.NilCellForInstanceOptional
-	if item.TargetCell != nil {
-		targetCell.SetTo(api.CellForInstanceOptional{
-			ID:       item.TargetCell.ID,
-			Alias:    item.TargetCell.Alias,
-			Row:      item.TargetCell.Row,
-			Level:    item.TargetCell.Level,
-			Position: item.TargetCell.Position,
-			CellPath: convertCellPathToOptionalDTO(item.TargetCell.Path),
-		})
+	var disposition api.NilTaskItemDisposition
+	if item.Disposition != nil {
+		disposition.SetTo(api.TaskItemDisposition(*item.Disposition))
 	} else {
-		targetCell.SetToNull()
+		disposition.SetToNull()
 	}
 
 	return api.TaskItem{
-		Instance:   instance,
-		SourceCell: sourceCell,
-		TargetCell: targetCell,
-		Status:     api.TaskItemStatus(item.Status),
+		ID:          item.ID,
+		TaskId:      item.TaskID,
+		Sequence:    item.Sequence,
+		Instance:    instance,
+		StockLine:   stockLine,
+		Quantity:    item.Quantity,
+		SourceCell:  convertCellOptionalToNilDTO(item.SourceCell),
+		TargetCell:  convertCellOptionalToNilDTO(item.TargetCell),
+		Status:      api.TaskItemStatus(item.Status),
+		Disposition: disposition,
+	}
+}
+
+func taskStatusChangeToDto(change *models.TaskStatusChange) api.TaskStatusChange {
+	res := api.TaskStatusChange{
+		ID:        change.ID,
+		ToStatus:  api.TaskStatusChangeToStatus(change.ToStatus),
+		ChangedAt: change.ChangedAt,
+	}
+
+	if change.FromStatus != nil {
+		res.FromStatus.SetTo(api.TaskStatusChangeFromStatus(*change.FromStatus))
+	} else {
+		res.FromStatus.SetToNull()
+	}
+
+	if change.ChangedBy != nil {
+		res.ChangedBy.SetTo(toAssignedToDTO(change.ChangedBy))
+	} else {
+		res.ChangedBy.SetToNull()
+	}
+
+	PtrToApiNil(change.Comment, &res.Comment)
+
+	if change.Duration != nil {
+		res.DurationSeconds.SetTo(int(change.Duration.Seconds()))
+	} else {
+		res.DurationSeconds.SetToNull()
 	}
+	return res
 }
 
 func taskToFullDto(task *models.Task) api.TaskFull {
@@ -114,8 +149,12 @@ func taskToFullDto(task *models.Task) api.TaskFull {
 		AssignedAt:  assignedAt,
 		CompletedAt: completedAt,
 		Type:        api.TaskFullType(task.Type),
+		Priority:    api.TaskFullPriority(task.Priority),
 	}
 
+	PtrToApiNil(task.DueAt, &res.DueAt)
+	PtrToApiNil(task.SlaBreachedAt, &res.SlaBreachedAt)
+
 	if task.Unit != nil {
 		res.Unit = convertUnitToDTO(task.Unit)
 	}
@@ -133,9 +172,86 @@ func taskToFullDto(task *models.Task) api.TaskFull {
 		taskItems[i] = taskItemToDto(item)
 	}
 	res.Items = taskItems
+
+	res.ReceivingCell = convertCellOptionalToNilDTO(task.ReceivingCell)
+	expectedItems := make([]api.TaskExpectedItem, len(task.ExpectedItems))
+	for i, expected := range task.ExpectedItems {
+		expectedItems[i] = taskExpectedItemToDto(expected)
+	}
+	res.ExpectedItems = expectedItems
+
+	countCells := make([]api.TaskCountCell, 0, len(task.CountCells))
+	for _, countCell := range task.CountCells {
+		if countCell.Cell == nil {
+			continue
+		}
+		var countedAt api.NilDateTime
+		PtrToApiNil(countCell.CountedAt, &countedAt)
+		countCells = append(countCells, api.TaskCountCell{
+			Cell:      convertCellToDTO(countCell.Cell),
+			CountedAt: countedAt,
+		})
+	}
+	res.CountCells = countCells
+
+	exceptions := make([]api.TaskItemException, len(task.Exceptions))
+	for i, exception := range task.Exceptions {
+		exceptions[i] = taskItemExceptionToDto(exception)
+	}
+	res.Exceptions = exceptions
+
+	PtrToApiNil(task.OriginalTaskID, &res.OriginalTaskId)
+	PtrToApiNil(task.WaveID, &res.WaveId)
+	PtrToApiNil(task.TemplateID, &res.TemplateId)
+	PtrToApiNil(task.TransferOrderID, &res.TransferOrderId)
+	return res
+}
+
+func taskItemExceptionToDto(exception *models.TaskItemException) api.TaskItemException {
+	var instance api.InstanceFull
+	if exception.Instance != nil {
+		instance = convertItemInstanceToTaskItemDTO(exception.Instance)
+	}
+
+	res := api.TaskItemException{
+		ID:        exception.ID,
+		TaskId:    exception.TaskID,
+		Instance:  instance,
+		Reason:    api.TaskItemExceptionReason(exception.Reason),
+		CreatedAt: exception.CreatedAt,
+	}
+	PtrToApiNil(exception.Note, &res.Note)
+	PtrToApiNil(exception.SubstituteInstanceID, &res.SubstituteInstanceId)
+
+	if exception.ReportedBy != nil {
+		res.ReportedBy.SetTo(toAssignedToDTO(exception.ReportedBy))
+	} else {
+		res.ReportedBy.SetToNull()
+	}
 	return res
 }
 
+func taskExpectedItemToDto(expected *models.TaskExpectedItem) api.TaskExpectedItem {
+	var discrepancy api.NilTaskExpectedItemDiscrepancy
+	if expected.Discrepancy != nil {
+		discrepancy.SetTo(api.TaskExpectedItemDiscrepancy(*expected.Discrepancy))
+	} else {
+		discrepancy.SetToNull()
+	}
+
+	var variant api.ItemVariant
+	if expected.Variant != nil {
+		variant = convertItemVariantToDTO(expected.Variant)
+	}
+
+	return api.TaskExpectedItem{
+		Variant:          variant,
+		ExpectedQuantity: expected.ExpectedQuantity,
+		ReceivedQuantity: expected.ReceivedQuantity,
+		Discrepancy:      discrepancy,
+	}
+}
+
 func (h *RestApiImplementation) CreateTask(ctx context.Context, req *api.CreateTaskRequest) (api.CreateTaskRes, error) {
 	task := &models.Task{
 		Name:             req.Name,
@@ -144,16 +260,39 @@ func (h *RestApiImplementation) CreateTask(ctx context.Context, req *api.CreateT
 		Type:             models.TaskType(req.Type),
 		UnitID:           req.UnitId,
 		AssignedToUserID: ApiValueToPtr(req.AssignedTo),
+		Priority:         models.TaskPriority(req.Priority.Or(api.CreateTaskRequestPriorityNormal)),
+		DueAt:            ApiValueToPtr(req.DueAt),
 	}
 
 	items := make([]*models.TaskItem, len(req.Items))
 	for i, item := range req.Items {
 		items[i] = &models.TaskItem{
-			InstanceID:   item.InstanceId,
+			InstanceID:   ApiValueToPtr(item.InstanceId),
+			StockLineID:  ApiValueToPtr(item.StockLineId),
+			Quantity:     item.Quantity.Or(0),
 			TargetCellID: ApiValueToPtr(item.TargetCellId),
 		}
 	}
 	task.Items = items
+	task.ReceivingCellID = ApiValueToPtr(req.ReceivingCellId)
+	task.OriginalTaskID = ApiValueToPtr(req.OriginalTaskId)
+
+	expectedItems := make([]*models.TaskExpectedItem, len(req.ExpectedItems))
+	for i, expected := range req.ExpectedItems {
+		expectedItems[i] = &models.TaskExpectedItem{
+			VariantID:        expected.VariantId,
+			ExpectedQuantity: expected.Quantity,
+		}
+	}
+	task.ExpectedItems = expectedItems
+
+	if countTarget, ok := req.CountTarget.Get(); ok {
+		task.CountTarget = &models.TaskCountTarget{
+			CellsGroupID:   ApiValueToPtr(countTarget.CellsGroupId),
+			StorageGroupID: ApiValueToPtr(countTarget.StorageGroupId),
+			CellIDs:        countTarget.CellIds,
+		}
+	}
 
 	createdTask, err := h.taskUseCase.CreateTask(ctx, task)
 	if err != nil {
@@ -164,6 +303,64 @@ func (h *RestApiImplementation) CreateTask(ctx context.Context, req *api.CreateT
 	}, nil
 }
 
+func taskImportReportToDto(report *models.TaskImportReport) api.TaskImportReport {
+	tasks := make([]api.TaskImportReportTasksItem, len(report.Tasks))
+	for i, task := range report.Tasks {
+		instanceIDs := make([]uuid.UUID, len(task.Items))
+		for j, item := range task.Items {
+			instanceIDs[j] = *item.InstanceID
+		}
+
+		var taskID api.NilUUID
+		PtrToApiNil(task.TaskID, &taskID)
+
+		tasks[i] = api.TaskImportReportTasksItem{
+			TaskId:      taskID,
+			Name:        task.Name,
+			Type:        api.TaskImportReportTasksItemType(task.Type),
+			UnitId:      task.UnitID,
+			Lines:       task.Lines,
+			InstanceIds: instanceIDs,
+		}
+	}
+
+	rowErrors := make([]api.TaskImportReportErrorsItem, len(report.Errors))
+	for i, err := range report.Errors {
+		rowErrors[i] = api.TaskImportReportErrorsItem{
+			Line:    err.Line,
+			Message: err.Message,
+		}
+	}
+
+	return api.TaskImportReport{
+		DryRun:    report.DryRun,
+		RowsCount: report.RowsCount,
+		Tasks:     tasks,
+		Errors:    rowErrors,
+	}
+}
+
+func (h *RestApiImplementation) ImportTasks(ctx context.Context, req api.ImportTasksReq, params api.ImportTasksParams) (api.ImportTasksRes, error) {
+	var (
+		format  models.TaskImportFormat
+		content io.Reader
+	)
+	switch req := req.(type) {
+	case *api.ImportTasksReqTextCsv:
+		format, content = models.TaskImportFormatCSV, req.Data
+	case *api.ImportTasksReqApplicationXNdjson:
+		format, content = models.TaskImportFormatNDJSON, req.Data
+	}
+
+	report, err := h.taskUseCase.ImportTasks(ctx, format, content, params.DryRun.Or(false))
+	if err != nil {
+		return nil, err
+	}
+	return &api.ImportTasksResponse{
+		Data: taskImportReportToDto(report),
+	}, nil
+}
+
 func (h *RestApiImplementation) GetTaskById(ctx context.Context, params api.GetTaskByIdParams) (api.GetTaskByIdRes, error) {
 	task, err := h.taskUseCase.GetTaskById(ctx, params.ID)
 	if err != nil {
@@ -174,17 +371,47 @@ func (h *RestApiImplementation) GetTaskById(ctx context.Context, params api.GetT
 	}, nil
 }
 
-func (h *RestApiImplementation) GetTasks(ctx context.Context) (api.GetTasksRes, error) {
-	tasks, err := h.taskUseCase.GetTasks(ctx)
+func taskFilterFromParams(params api.GetTasksParams) *models.TaskFilter {
+	filter := &models.TaskFilter{
+		UnitID:           ApiValueToPtr(params.UnitID),
+		AssignedToUserID: ApiValueToPtr(params.AssignedToUserID),
+		CreatedFrom:      ApiValueToPtr(params.CreatedFrom),
+		CreatedTo:        ApiValueToPtr(params.CreatedTo),
+		CompletedFrom:    ApiValueToPtr(params.CompletedFrom),
+		CompletedTo:      ApiValueToPtr(params.CompletedTo),
+		Search:           ApiValueToPtr(params.Search),
+		Cursor:           ApiValueToPtr(params.Cursor),
+		SortBy:           models.TaskSortField(params.SortBy.Or(api.GetTasksSortByCreatedAt)),
+		SortDesc:         params.SortOrder.Or(api.GetTasksSortOrderDesc) == api.GetTasksSortOrderDesc,
+		Limit:            params.Limit.Or(0),
+	}
+
+	for _, status := range params.Status {
+		filter.Statuses = append(filter.Statuses, models.TaskStatus(status))
+	}
+
+	if taskType, ok := params.Type.Get(); ok {
+		t := models.TaskType(taskType)
+		filter.Type = &t
+	}
+
+	return filter
+}
+
+func (h *RestApiImplementation) GetTasks(ctx context.Context, params api.GetTasksParams) (api.GetTasksRes, error) {
+	res, err := h.taskUseCase.ListTasks(ctx, taskFilterFromParams(params))
 	if err != nil {
 		return nil, err
 	}
-	dtos := make([]api.TaskBase, len(tasks))
-	for i, task := range tasks {
-		dtos[i] = taskToDto(task)
+
+	var nextCursor api.OptNilString
+	if res.NextCursor != nil {
+		nextCursor.SetTo(*res.NextCursor)
 	}
+
 	return &api.GetTasksResponse{
-		Data: dtos,
+		Data:       tasksToDto(res.Tasks),
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -196,18 +423,901 @@ func (h *RestApiImplementation) PickInstanceFromCell(ctx context.Context, req *a
 	return &api.PickInstanceFromCellNoContent{}, nil
 }
 
-func (h *RestApiImplementation) MarkTaskAsAwaiting(ctx context.Context, params api.MarkTaskAsAwaitingParams) (api.MarkTaskAsAwaitingRes, error) {
-	err := h.taskUseCase.MarkTaskAsAwaiting(ctx, params.ID)
+func (h *RestApiImplementation) PickStockLineFromCell(ctx context.Context, req *api.TaskStockLineRequest, params api.PickStockLineFromCellParams) (api.PickStockLineFromCellRes, error) {
+	err := h.taskUseCase.PickStockLineForTask(ctx, params.ID, req.StockLineId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PickStockLineFromCellNoContent{}, nil
+}
+
+func statusChangeComment(req api.OptTaskStatusChangeRequest) *string {
+	if body, ok := req.Get(); ok {
+		return ApiValueToPtr(body.Comment)
+	}
+	return nil
+}
+
+func (h *RestApiImplementation) GetTaskStatusHistory(ctx context.Context, params api.GetTaskStatusHistoryParams) (api.GetTaskStatusHistoryRes, error) {
+	history, err := h.taskUseCase.GetTaskStatusHistory(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.TaskStatusChange, len(history))
+	for i, change := range history {
+		res[i] = taskStatusChangeToDto(change)
+	}
+	return &api.GetTaskStatusHistoryResponse{
+		Data: res,
+	}, nil
+}
+
+func (h *RestApiImplementation) MarkTaskAsAwaiting(ctx context.Context, req api.OptTaskStatusChangeRequest, params api.MarkTaskAsAwaitingParams) (api.MarkTaskAsAwaitingRes, error) {
+	err := h.taskUseCase.MarkTaskAsAwaiting(ctx, params.ID, statusChangeComment(req))
 	if err != nil {
 		return nil, err
 	}
 	return &api.MarkTaskAsAwaitingNoContent{}, nil
 }
 
-func (h *RestApiImplementation) MarkTaskAsCompleted(ctx context.Context, params api.MarkTaskAsCompletedParams) (api.MarkTaskAsCompletedRes, error) {
-	err := h.taskUseCase.MarkTaskAsCompleted(ctx, params.ID)
+func (h *RestApiImplementation) MarkTaskAsCompleted(ctx context.Context, req api.OptCompleteTaskRequest, params api.MarkTaskAsCompletedParams) (api.MarkTaskAsCompletedRes, error) {
+	var comment, pickupCode *string
+	if body, ok := req.Get(); ok {
+		comment = ApiValueToPtr(body.Comment)
+		pickupCode = ApiValueToPtr(body.PickupCode)
+	}
+
+	err := h.taskUseCase.MarkTaskAsCompleted(ctx, params.ID, comment, pickupCode)
 	if err != nil {
 		return nil, err
 	}
 	return &api.MarkTaskAsCompletedNoContent{}, nil
 }
+
+func taskPickupCodeToDto(code *models.TaskPickupCode) api.TaskPickupCode {
+	var lockedAt, usedAt api.NilDateTime
+	PtrToApiNil(code.LockedAt, &lockedAt)
+	PtrToApiNil(code.UsedAt, &usedAt)
+
+	return api.TaskPickupCode{
+		TaskId:         code.TaskID,
+		Code:           code.Code,
+		QrPayload:      code.QRPayload,
+		FailedAttempts: code.FailedAttempts,
+		AttemptsLeft:   code.AttemptsLeft,
+		CreatedAt:      code.CreatedAt,
+		LockedAt:       lockedAt,
+		UsedAt:         usedAt,
+	}
+}
+
+func (h *RestApiImplementation) GetTaskPickupCode(ctx context.Context, params api.GetTaskPickupCodeParams) (api.GetTaskPickupCodeRes, error) {
+	code, err := h.taskUseCase.GetTaskPickupCode(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskPickupCodeResponse{
+		Data: taskPickupCodeToDto(code),
+	}, nil
+}
+
+func (h *RestApiImplementation) RegenerateTaskPickupCode(ctx context.Context, params api.RegenerateTaskPickupCodeParams) (api.RegenerateTaskPickupCodeRes, error) {
+	code, err := h.taskUseCase.RegeneratePickupCode(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskPickupCodeResponse{
+		Data: taskPickupCodeToDto(code),
+	}, nil
+}
+
+func (h *RestApiImplementation) PutItemInTargetCell(ctx context.Context, req *api.PutItemInCellRequest, params api.PutItemInTargetCellParams) (api.PutItemInTargetCellRes, error) {
+	err := h.taskUseCase.PutInstanceToTargetCellForTask(ctx, params.ID, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PutItemInTargetCellCreated{}, nil
+}
+
+func (h *RestApiImplementation) PutStockLineInTargetCell(ctx context.Context, req *api.TaskStockLineRequest, params api.PutStockLineInTargetCellParams) (api.PutStockLineInTargetCellRes, error) {
+	err := h.taskUseCase.PutStockLineToTargetCellForTask(ctx, params.ID, req.StockLineId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PutStockLineInTargetCellNoContent{}, nil
+}
+
+func (h *RestApiImplementation) ReceiveItems(ctx context.Context, req *api.ReceiveItemsRequest, params api.ReceiveItemsParams) (api.ReceiveItemsRes, error) {
+	// Serial numbers stand for the received instances when no quantity is given
+	quantity := req.Quantity.Or(1)
+	if !req.Quantity.Set && len(req.SerialNumbers) > 0 {
+		quantity = len(req.SerialNumbers)
+	}
+	receipt, err := h.taskUseCase.ReceiveItems(ctx, params.ID, req.VariantId, quantity, models.ItemLot{
+		LotNumber:      ApiValueToPtr(req.LotNumber),
+		ManufacturedAt: ApiValueToPtr(req.ManufacturedAt),
+		ExpiresAt:      ApiValueToPtr(req.ExpiresAt),
+	}, req.SerialNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	dtoInstances := make([]api.InstanceFull, 0, len(receipt.Instances))
+	for _, instance := range receipt.Instances {
+		dtoInstances = append(dtoInstances, convertItemInstanceToTaskItemDTO(instance))
+	}
+	res := &api.ReceiveItemsResponse{
+		Data: dtoInstances,
+	}
+	if receipt.StockLine != nil {
+		res.StockLine.SetTo(stockLineToDto(receipt.StockLine))
+	}
+	return res, nil
+}
+
+func (h *RestApiImplementation) InspectReturnedInstance(ctx context.Context, req *api.InspectReturnRequest, params api.InspectReturnedInstanceParams) (api.InspectReturnedInstanceRes, error) {
+	instance, err := h.taskUseCase.InspectReturnedInstance(ctx, params.ID, req.InstanceId, models.ReturnDisposition(req.Disposition), ApiValueToPtr(req.CellId))
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetInstanceByIdResponse{
+		Data: convertItemInstanceToTaskItemDTO(instance),
+	}, nil
+}
+
+func (h *RestApiImplementation) CancelTask(ctx context.Context, req api.OptTaskStatusChangeRequest, params api.CancelTaskParams) (api.CancelTaskRes, error) {
+	err := h.taskUseCase.CancelTask(ctx, params.ID, statusChangeComment(req))
+	if err != nil {
+		return nil, err
+	}
+	return &api.CancelTaskCreated{}, nil
+}
+
+func (h *RestApiImplementation) AssignTask(ctx context.Context, req *api.AssignTaskRequest, params api.AssignTaskParams) (api.AssignTaskRes, error) {
+	err := h.taskUseCase.AssignTask(ctx, params.ID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.AssignTaskNoContent{}, nil
+}
+
+func (h *RestApiImplementation) ReassignTask(ctx context.Context, req *api.AssignTaskRequest, params api.ReassignTaskParams) (api.ReassignTaskRes, error) {
+	err := h.taskUseCase.ReassignTask(ctx, params.ID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ReassignTaskNoContent{}, nil
+}
+
+func (h *RestApiImplementation) UnassignTask(ctx context.Context, params api.UnassignTaskParams) (api.UnassignTaskRes, error) {
+	err := h.taskUseCase.UnassignTask(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.UnassignTaskNoContent{}, nil
+}
+
+func (h *RestApiImplementation) ClaimTask(ctx context.Context, params api.ClaimTaskParams) (api.ClaimTaskRes, error) {
+	err := h.taskUseCase.ClaimTask(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ClaimTaskNoContent{}, nil
+}
+
+func (h *RestApiImplementation) GetMyTasks(ctx context.Context) (api.GetMyTasksRes, error) {
+	tasks, err := h.taskUseCase.GetMyTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTasksResponse{
+		Data: tasksToDto(tasks),
+	}, nil
+}
+
+func workerProfileToDto(profile *models.WorkerProfile) api.WorkerProfile {
+	skills := make([]api.WorkerProfileSkillsItem, len(profile.Skills))
+	for i, skill := range profile.Skills {
+		skills[i] = api.WorkerProfileSkillsItem(skill)
+	}
+	return api.WorkerProfile{
+		UserId:    profile.UserID,
+		UpdatedAt: profile.UpdatedAt,
+		UnitIds:   profile.UnitIDs,
+		Skills:    skills,
+		OnShift:   profile.OnShift,
+	}
+}
+
+func (h *RestApiImplementation) GetWorkerProfile(ctx context.Context, params api.GetWorkerProfileParams) (api.GetWorkerProfileRes, error) {
+	profile, err := h.taskUseCase.GetWorkerProfile(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetWorkerProfileResponse{
+		Data: workerProfileToDto(profile),
+	}, nil
+}
+
+func (h *RestApiImplementation) SetWorkerProfile(ctx context.Context, req *api.WorkerProfileBase, params api.SetWorkerProfileParams) (api.SetWorkerProfileRes, error) {
+	skills := make([]models.TaskType, len(req.Skills))
+	for i, skill := range req.Skills {
+		skills[i] = models.TaskType(skill)
+	}
+
+	profile, err := h.taskUseCase.SetWorkerProfile(ctx, &models.WorkerProfile{
+		UserID:  params.ID,
+		UnitIDs: req.UnitIds,
+		Skills:  skills,
+		OnShift: req.OnShift,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetWorkerProfileResponse{
+		Data: workerProfileToDto(profile),
+	}, nil
+}
+
+func (h *RestApiImplementation) SetMyShift(ctx context.Context, req *api.SetMyShiftRequest) (api.SetMyShiftRes, error) {
+	profile, err := h.taskUseCase.SetMyShift(ctx, req.OnShift)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetWorkerProfileResponse{
+		Data: workerProfileToDto(profile),
+	}, nil
+}
+
+func inventoryCountDiscrepanciesToDto(lines []*models.InventoryCountDiscrepancy) []api.InventoryCountDiscrepancy {
+	res := make([]api.InventoryCountDiscrepancy, len(lines))
+	for i, line := range lines {
+		res[i] = api.InventoryCountDiscrepancy{
+			ExpectedCell: convertCellOptionalToNilDTO(line.ExpectedCell),
+			FoundCell:    convertCellOptionalToNilDTO(line.FoundCell),
+			Quantity:     line.Quantity,
+		}
+		if line.Instance != nil {
+			res[i].Instance.SetTo(convertItemInstanceToTaskItemDTO(line.Instance))
+		}
+		if line.Variant != nil {
+			res[i].Variant = convertItemVariantToDTO(line.Variant)
+		}
+	}
+	return res
+}
+
+func inventoryCountReportToDto(report *models.InventoryCountReport) api.InventoryCountReport {
+	uncountedCells := make([]api.CellForInstance, len(report.UncountedCells))
+	for i, cell := range report.UncountedCells {
+		uncountedCells[i] = convertCellToDTO(cell)
+	}
+
+	return api.InventoryCountReport{
+		Missing:        inventoryCountDiscrepanciesToDto(report.Missing),
+		Unexpected:     inventoryCountDiscrepanciesToDto(report.Unexpected),
+		Misplaced:      inventoryCountDiscrepanciesToDto(report.Misplaced),
+		UncountedCells: uncountedCells,
+	}
+}
+
+func (h *RestApiImplementation) SubmitInventoryCount(ctx context.Context, req *api.SubmitCountRequest, params api.SubmitInventoryCountParams) (api.SubmitInventoryCountRes, error) {
+	err := h.taskUseCase.SubmitCount(ctx, params.ID, &models.TaskCountSubmission{
+		CellID:      req.CellId,
+		InstanceIDs: req.InstanceIds,
+		Barcodes:    req.Barcodes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.SubmitInventoryCountNoContent{}, nil
+}
+
+func (h *RestApiImplementation) GetInventoryCountReport(ctx context.Context, params api.GetInventoryCountReportParams) (api.GetInventoryCountReportRes, error) {
+	report, err := h.taskUseCase.GetCountReport(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.InventoryCountReportResponse{
+		Data: inventoryCountReportToDto(report),
+	}, nil
+}
+
+func (h *RestApiImplementation) ApplyInventoryCountAdjustments(ctx context.Context, params api.ApplyInventoryCountAdjustmentsParams) (api.ApplyInventoryCountAdjustmentsRes, error) {
+	report, err := h.taskUseCase.ApplyCountAdjustments(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.InventoryCountReportResponse{
+		Data: inventoryCountReportToDto(report),
+	}, nil
+}
+
+func pickWaveToDto(wave *models.PickWave) api.PickWave {
+	var completedAt api.NilDateTime
+	PtrToApiNil(wave.CompletedAt, &completedAt)
+
+	return api.PickWave{
+		ID:                  wave.ID,
+		UnitId:              wave.UnitID,
+		Name:                wave.Name,
+		Status:              api.PickWaveStatus(wave.Status),
+		ConsolidationCellId: wave.ConsolidationCellID,
+		CreatedAt:           wave.CreatedAt,
+		CompletedAt:         completedAt,
+	}
+}
+
+func pickWaveToFullDto(wave *models.PickWave) api.PickWaveFull {
+	var completedAt api.NilDateTime
+	PtrToApiNil(wave.CompletedAt, &completedAt)
+
+	items := make([]api.TaskItem, len(wave.Items))
+	for i, item := range wave.Items {
+		items[i] = taskItemToDto(item)
+	}
+
+	return api.PickWaveFull{
+		ID:                  wave.ID,
+		UnitId:              wave.UnitID,
+		Name:                wave.Name,
+		Status:              api.PickWaveFullStatus(wave.Status),
+		ConsolidationCellId: wave.ConsolidationCellID,
+		CreatedAt:           wave.CreatedAt,
+		CompletedAt:         completedAt,
+		ConsolidationCell:   convertCellOptionalToNilDTO(wave.ConsolidationCell),
+		Tasks:               tasksToDto(wave.Tasks),
+		Items:               items,
+	}
+}
+
+func (h *RestApiImplementation) CreatePickWave(ctx context.Context, req *api.CreatePickWaveRequest) (api.CreatePickWaveRes, error) {
+	wave, err := h.taskUseCase.CreatePickWave(ctx, &models.PickWaveCreate{
+		UnitID:              req.UnitId,
+		Name:                req.Name,
+		ConsolidationCellID: req.ConsolidationCellId,
+		TaskIDs:             req.TaskIds,
+		MaxTasks:            req.MaxTasks.Or(0),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetPickWaveResponse{
+		Data: pickWaveToFullDto(wave),
+	}, nil
+}
+
+func (h *RestApiImplementation) GetPickWaves(ctx context.Context) (api.GetPickWavesRes, error) {
+	waves, err := h.taskUseCase.GetPickWaves(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]api.PickWave, len(waves))
+	for i, wave := range waves {
+		res[i] = pickWaveToDto(wave)
+	}
+	return &api.GetPickWavesResponse{
+		Data: res,
+	}, nil
+}
+
+func (h *RestApiImplementation) GetPickWaveById(ctx context.Context, params api.GetPickWaveByIdParams) (api.GetPickWaveByIdRes, error) {
+	wave, err := h.taskUseCase.GetPickWaveById(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetPickWaveResponse{
+		Data: pickWaveToFullDto(wave),
+	}, nil
+}
+
+func (h *RestApiImplementation) PickWaveInstance(ctx context.Context, req *api.PutItemInCellRequest, params api.PickWaveInstanceParams) (api.PickWaveInstanceRes, error) {
+	task, err := h.taskUseCase.PickWaveInstance(ctx, params.ID, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskResponse{
+		Data: taskToFullDto(task),
+	}, nil
+}
+
+func (h *RestApiImplementation) SortWaveInstance(ctx context.Context, req *api.PutItemInCellRequest, params api.SortWaveInstanceParams) (api.SortWaveInstanceRes, error) {
+	task, err := h.taskUseCase.SortWaveInstance(ctx, params.ID, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskResponse{
+		Data: taskToFullDto(task),
+	}, nil
+}
+
+func (h *RestApiImplementation) CompletePickWave(ctx context.Context, params api.CompletePickWaveParams) (api.CompletePickWaveRes, error) {
+	wave, err := h.taskUseCase.CompletePickWave(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetPickWaveResponse{
+		Data: pickWaveToFullDto(wave),
+	}, nil
+}
+
+func (h *RestApiImplementation) CancelPickWave(ctx context.Context, params api.CancelPickWaveParams) (api.CancelPickWaveRes, error) {
+	wave, err := h.taskUseCase.CancelPickWave(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetPickWaveResponse{
+		Data: pickWaveToFullDto(wave),
+	}, nil
+}
+
+func transferOrderToDto(order *models.TransferOrder) api.TransferOrder {
+	res := api.TransferOrder{
+		ID:                order.ID,
+		Name:              order.Name,
+		Status:            api.TransferOrderStatus(order.Status),
+		SourceUnitId:      order.SourceUnitID,
+		DestinationUnitId: order.DestinationUnitID,
+		ReceivingCellId:   order.ReceivingCellID,
+		CreatedAt:         order.CreatedAt,
+	}
+	PtrToApiNil(order.OutboundTaskID, &res.OutboundTaskId)
+	PtrToApiNil(order.InboundTaskID, &res.InboundTaskId)
+	PtrToApiNil(order.DispatchedAt, &res.DispatchedAt)
+	PtrToApiNil(order.ReceivedAt, &res.ReceivedAt)
+	return res
+}
+
+func transferOrderToFullDto(order *models.TransferOrder) api.TransferOrderFull {
+	instances := make([]api.InstanceFull, len(order.Instances))
+	for i, instance := range order.Instances {
+		instances[i] = convertItemInstanceToTaskItemDTO(instance)
+	}
+
+	res := api.TransferOrderFull{
+		ID:                order.ID,
+		Name:              order.Name,
+		Status:            api.TransferOrderFullStatus(order.Status),
+		SourceUnitId:      order.SourceUnitID,
+		DestinationUnitId: order.DestinationUnitID,
+		ReceivingCellId:   order.ReceivingCellID,
+		CreatedAt:         order.CreatedAt,
+		Instances:         instances,
+	}
+	PtrToApiNil(order.OutboundTaskID, &res.OutboundTaskId)
+	PtrToApiNil(order.InboundTaskID, &res.InboundTaskId)
+	PtrToApiNil(order.DispatchedAt, &res.DispatchedAt)
+	PtrToApiNil(order.ReceivedAt, &res.ReceivedAt)
+	return res
+}
+
+func (h *RestApiImplementation) CreateTransferOrder(ctx context.Context, req *api.CreateTransferOrderRequest) (api.CreateTransferOrderRes, error) {
+	order, err := h.taskUseCase.CreateTransferOrder(ctx, &models.TransferOrderCreate{
+		Name:              req.Name,
+		SourceUnitID:      req.SourceUnitId,
+		DestinationUnitID: req.DestinationUnitId,
+		ReceivingCellID:   req.ReceivingCellId,
+		InstanceIDs:       req.InstanceIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTransferOrderResponse{
+		Data: transferOrderToFullDto(order),
+	}, nil
+}
+
+func (h *RestApiImplementation) GetTransferOrders(ctx context.Context, params api.GetTransferOrdersParams) (api.GetTransferOrdersRes, error) {
+	var status *models.TransferOrderStatus
+	if value, ok := params.Status.Get(); ok {
+		s := models.TransferOrderStatus(value)
+		status = &s
+	}
+
+	orders, err := h.taskUseCase.GetTransferOrders(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]api.TransferOrder, len(orders))
+	for i, order := range orders {
+		res[i] = transferOrderToDto(order)
+	}
+	return &api.GetTransferOrdersResponse{
+		Data: res,
+	}, nil
+}
+
+func (h *RestApiImplementation) GetTransferOrderById(ctx context.Context, params api.GetTransferOrderByIdParams) (api.GetTransferOrderByIdRes, error) {
+	order, err := h.taskUseCase.GetTransferOrderById(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTransferOrderResponse{
+		Data: transferOrderToFullDto(order),
+	}, nil
+}
+
+func (h *RestApiImplementation) CancelTransferOrder(ctx context.Context, req api.OptTaskStatusChangeRequest, params api.CancelTransferOrderParams) (api.CancelTransferOrderRes, error) {
+	order, err := h.taskUseCase.CancelTransferOrder(ctx, params.ID, statusChangeComment(req))
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTransferOrderResponse{
+		Data: transferOrderToFullDto(order),
+	}, nil
+}
+
+func (h *RestApiImplementation) ReportTaskItemException(ctx context.Context, req *api.ReportTaskItemExceptionRequest, params api.ReportTaskItemExceptionParams) (api.ReportTaskItemExceptionRes, error) {
+	task, err := h.taskUseCase.ReportTaskItemException(ctx, params.ID, &models.TaskItemExceptionCreate{
+		InstanceID:           req.InstanceId,
+		Reason:               models.TaskItemExceptionReason(req.Reason),
+		Note:                 ApiValueToPtr(req.Note),
+		SubstituteInstanceID: ApiValueToPtr(req.SubstituteInstanceId),
+		AutoSubstitute:       req.AutoSubstitute.Or(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskResponse{
+		Data: taskToFullDto(task),
+	}, nil
+}
+
+func (h *RestApiImplementation) GetTaskItemSubstitutes(ctx context.Context, params api.GetTaskItemSubstitutesParams) (api.GetTaskItemSubstitutesRes, error) {
+	instances, err := h.taskUseCase.GetSubstituteInstances(ctx, params.ID, params.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	dtoInstances := make([]api.InstanceFull, 0, len(instances))
+	for _, instance := range instances {
+		dtoInstances = append(dtoInstances, convertItemInstanceToTaskItemDTO(instance))
+	}
+
+	return &api.GetInstancesResponse{
+		Data: dtoInstances,
+	}, nil
+}
+
+func (h *RestApiImplementation) GetTaskExceptionsReport(ctx context.Context, params api.GetTaskExceptionsReportParams) (api.GetTaskExceptionsReportRes, error) {
+	filter := &models.TaskExceptionFilter{
+		UnitID:      ApiValueToPtr(params.UnitID),
+		CreatedFrom: ApiValueToPtr(params.CreatedFrom),
+		CreatedTo:   ApiValueToPtr(params.CreatedTo),
+		Limit:       params.Limit.Or(0),
+	}
+	if reason, ok := params.Reason.Get(); ok {
+		r := models.TaskItemExceptionReason(reason)
+		filter.Reason = &r
+	}
+
+	report, err := h.taskUseCase.GetTaskExceptionsReport(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions := make([]api.TaskItemException, len(report.Exceptions))
+	for i, exception := range report.Exceptions {
+		exceptions[i] = taskItemExceptionToDto(exception)
+	}
+
+	return &api.TaskExceptionsReportResponse{
+		Data: exceptions,
+		CountsByReason: api.TaskExceptionsReportResponseCountsByReason{
+			NotFound:  report.CountsByReason[models.TaskItemExceptionReasonNotFound],
+			Damaged:   report.CountsByReason[models.TaskItemExceptionReasonDamaged],
+			WrongItem: report.CountsByReason[models.TaskItemExceptionReasonWrongItem],
+		},
+	}, nil
+}
+
+func taskTemplateToDto(template *models.TaskTemplate) api.TaskTemplate {
+	res := api.TaskTemplate{
+		ID:        template.ID,
+		UnitId:    template.UnitID,
+		Name:      template.Name,
+		Type:      api.TaskTemplateType(template.Type),
+		Priority:  api.TaskTemplatePriority(template.Priority),
+		IsActive:  template.IsActive,
+		Rules:     make([]api.TaskTemplateRule, len(template.Rules)),
+		CreatedAt: template.CreatedAt,
+	}
+	PtrToApiNil(template.Description, &res.Description)
+	PtrToApiNil(template.AssignedToUserID, &res.AssignedToUserId)
+	PtrToApiNil(template.Schedule, &res.Schedule)
+	PtrToApiNil(template.NextRunAt, &res.NextRunAt)
+	PtrToApiNil(template.LastRunAt, &res.LastRunAt)
+	if template.DueInMinutes != nil {
+		res.DueInMinutes.SetTo(int(*template.DueInMinutes))
+	} else {
+		res.DueInMinutes.SetToNull()
+	}
+
+	for i, rule := range template.Rules {
+		dto := api.TaskTemplateRule{}
+		if rule.ItemID != nil {
+			dto.ItemId.SetTo(*rule.ItemID)
+		}
+		if rule.VariantID != nil {
+			dto.VariantId.SetTo(*rule.VariantID)
+		}
+		if rule.SourceCellsGroupID != nil {
+			dto.SourceCellsGroupId.SetTo(*rule.SourceCellsGroupID)
+		}
+		if rule.TargetCellID != nil {
+			dto.TargetCellId.SetTo(*rule.TargetCellID)
+		}
+		if rule.MaxInstances != nil {
+			dto.MaxInstances.SetTo(int(*rule.MaxInstances))
+		}
+		res.Rules[i] = dto
+	}
+	return res
+}
+
+// taskTemplateFromDto builds the template from the fields shared by the create
+// and update requests
+func taskTemplateFromDto(
+	unitID uuid.UUID,
+	name string,
+	description api.OptNilString,
+	taskType string,
+	priority string,
+	assignedToUserID api.OptNilUUID,
+	dueInMinutes api.OptNilInt,
+	schedule api.OptNilString,
+	isActive api.OptBool,
+	rules []api.TaskTemplateRule,
+) *models.TaskTemplate {
+	template := &models.TaskTemplate{
+		UnitID:           unitID,
+		Name:             name,
+		Description:      ApiValueToPtr(description),
+		Type:             models.TaskType(taskType),
+		Priority:         models.TaskPriority(priority),
+		AssignedToUserID: ApiValueToPtr(assignedToUserID),
+		Schedule:         ApiValueToPtr(schedule),
+		IsActive:         isActive.Or(true),
+		Rules:            make([]*models.TaskTemplateRule, len(rules)),
+	}
+	if minutes, ok := dueInMinutes.Get(); ok {
+		value := int32(minutes)
+		template.DueInMinutes = &value
+	}
+
+	for i, rule := range rules {
+		template.Rules[i] = &models.TaskTemplateRule{
+			ItemID:             ApiValueToPtr(rule.ItemId),
+			VariantID:          ApiValueToPtr(rule.VariantId),
+			SourceCellsGroupID: ApiValueToPtr(rule.SourceCellsGroupId),
+			TargetCellID:       ApiValueToPtr(rule.TargetCellId),
+		}
+		if maxInstances, ok := rule.MaxInstances.Get(); ok {
+			value := int32(maxInstances)
+			template.Rules[i].MaxInstances = &value
+		}
+	}
+	return template
+}
+
+func (h *RestApiImplementation) CreateTaskTemplate(ctx context.Context, req *api.CreateTaskTemplateRequest) (api.CreateTaskTemplateRes, error) {
+	template, err := h.taskUseCase.CreateTaskTemplate(ctx, taskTemplateFromDto(
+		req.UnitId,
+		req.Name,
+		req.Description,
+		string(req.Type),
+		string(req.Priority.Or(api.CreateTaskTemplateRequestPriorityNormal)),
+		req.AssignedToUserId,
+		req.DueInMinutes,
+		req.Schedule,
+		req.IsActive,
+		req.Rules,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskTemplateResponse{
+		Data: taskTemplateToDto(template),
+	}, nil
+}
+
+func (h *RestApiImplementation) GetTaskTemplates(ctx context.Context) (api.GetTaskTemplatesRes, error) {
+	templates, err := h.taskUseCase.GetTaskTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.TaskTemplate, len(templates))
+	for i, template := range templates {
+		res[i] = taskTemplateToDto(template)
+	}
+	return &api.GetTaskTemplatesResponse{
+		Data: res,
+	}, nil
+}
+
+func (h *RestApiImplementation) GetTaskTemplateById(ctx context.Context, params api.GetTaskTemplateByIdParams) (api.GetTaskTemplateByIdRes, error) {
+	template, err := h.taskUseCase.GetTaskTemplateById(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskTemplateResponse{
+		Data: taskTemplateToDto(template),
+	}, nil
+}
+
+func (h *RestApiImplementation) UpdateTaskTemplate(ctx context.Context, req *api.UpdateTaskTemplateRequest, params api.UpdateTaskTemplateParams) (api.UpdateTaskTemplateRes, error) {
+	template := taskTemplateFromDto(
+		req.UnitId,
+		req.Name,
+		req.Description,
+		string(req.Type),
+		string(req.Priority.Or(api.UpdateTaskTemplateRequestPriorityNormal)),
+		req.AssignedToUserId,
+		req.DueInMinutes,
+		req.Schedule,
+		req.IsActive,
+		req.Rules,
+	)
+	template.ID = params.ID
+
+	updated, err := h.taskUseCase.UpdateTaskTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskTemplateResponse{
+		Data: taskTemplateToDto(updated),
+	}, nil
+}
+
+func (h *RestApiImplementation) DeleteTaskTemplate(ctx context.Context, params api.DeleteTaskTemplateParams) (api.DeleteTaskTemplateRes, error) {
+	err := h.taskUseCase.DeleteTaskTemplate(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DeleteTaskTemplateNoContent{}, nil
+}
+
+func (h *RestApiImplementation) RunTaskTemplate(ctx context.Context, params api.RunTaskTemplateParams) (api.RunTaskTemplateRes, error) {
+	task, err := h.taskUseCase.RunTaskTemplate(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskResponse{
+		Data: taskToFullDto(task),
+	}, nil
+}
+
+func employeeOptionalToNilDto(employee *models.Employee) api.NilEmployeeOptional {
+	var res api.NilEmployeeOptional
+	if employee != nil {
+		res.SetTo(toAssignedToDTO(employee))
+	} else {
+		res.SetToNull()
+	}
+	return res
+}
+
+func taskCommentToDto(comment *models.TaskComment) api.TaskComment {
+	res := api.TaskComment{
+		ID:        comment.ID,
+		TaskId:    comment.TaskID,
+		Body:      comment.Body,
+		Author:    employeeOptionalToNilDto(comment.Author),
+		CreatedAt: comment.CreatedAt,
+	}
+	PtrToApiNil(comment.InstanceID, &res.InstanceId)
+	PtrToApiNil(comment.EditedAt, &res.EditedAt)
+	return res
+}
+
+func taskAttachmentToDto(attachment *models.TaskAttachment) api.TaskAttachment {
+	res := api.TaskAttachment{
+		ID:          attachment.ID,
+		TaskId:      attachment.TaskID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		UploadedBy:  employeeOptionalToNilDto(attachment.UploadedBy),
+		CreatedAt:   attachment.CreatedAt,
+	}
+	PtrToApiNil(attachment.InstanceID, &res.InstanceId)
+	PtrToApiNil(attachment.CommentID, &res.CommentId)
+	return res
+}
+
+func (h *RestApiImplementation) GetTaskComments(ctx context.Context, params api.GetTaskCommentsParams) (api.GetTaskCommentsRes, error) {
+	comments, err := h.taskUseCase.GetTaskComments(ctx, params.ID, ApiValueToPtr(params.InstanceId))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.TaskComment, len(comments))
+	for i, comment := range comments {
+		res[i] = taskCommentToDto(comment)
+	}
+	return &api.GetTaskCommentsResponse{
+		Data: res,
+	}, nil
+}
+
+func (h *RestApiImplementation) CreateTaskComment(ctx context.Context, req *api.CreateTaskCommentRequest, params api.CreateTaskCommentParams) (api.CreateTaskCommentRes, error) {
+	comment, err := h.taskUseCase.CreateTaskComment(ctx, params.ID, &models.TaskComment{
+		InstanceID: ApiValueToPtr(req.InstanceId),
+		Body:       req.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskCommentResponse{
+		Data: taskCommentToDto(comment),
+	}, nil
+}
+
+func (h *RestApiImplementation) UpdateTaskComment(ctx context.Context, req *api.UpdateTaskCommentRequest, params api.UpdateTaskCommentParams) (api.UpdateTaskCommentRes, error) {
+	comment, err := h.taskUseCase.UpdateTaskComment(ctx, params.ID, params.CommentId, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskCommentResponse{
+		Data: taskCommentToDto(comment),
+	}, nil
+}
+
+func (h *RestApiImplementation) DeleteTaskComment(ctx context.Context, params api.DeleteTaskCommentParams) (api.DeleteTaskCommentRes, error) {
+	err := h.taskUseCase.DeleteTaskComment(ctx, params.ID, params.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DeleteTaskCommentNoContent{}, nil
+}
+
+func (h *RestApiImplementation) GetTaskAttachments(ctx context.Context, params api.GetTaskAttachmentsParams) (api.GetTaskAttachmentsRes, error) {
+	attachments, err := h.taskUseCase.GetTaskAttachments(ctx, params.ID, ApiValueToPtr(params.InstanceId))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.TaskAttachment, len(attachments))
+	for i, attachment := range attachments {
+		res[i] = taskAttachmentToDto(attachment)
+	}
+	return &api.GetTaskAttachmentsResponse{
+		Data: res,
+	}, nil
+}
+
+func (h *RestApiImplementation) UploadTaskAttachment(ctx context.Context, req *api.UploadTaskAttachmentRequestMultipart, params api.UploadTaskAttachmentParams) (api.UploadTaskAttachmentRes, error) {
+	attachment, err := h.taskUseCase.UploadTaskAttachment(ctx, params.ID, &models.TaskAttachmentUpload{
+		InstanceID: ApiValueToPtr(req.InstanceId),
+		CommentID:  ApiValueToPtr(req.CommentId),
+		FileName:   req.File.Name,
+		Size:       req.File.Size,
+		Content:    req.File.File,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaskAttachmentResponse{
+		Data: taskAttachmentToDto(attachment),
+	}, nil
+}
+
+func (h *RestApiImplementation) DownloadTaskAttachment(ctx context.Context, params api.DownloadTaskAttachmentParams) (api.DownloadTaskAttachmentRes, error) {
+	_, content, err := h.taskUseCase.GetTaskAttachmentContent(ctx, params.ID, params.AttachmentId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DownloadTaskAttachmentOK{
+		Data: bytes.NewReader(content),
+	}, nil
+}
+
+func (h *RestApiImplementation) DeleteTaskAttachment(ctx context.Context, params api.DeleteTaskAttachmentParams) (api.DeleteTaskAttachmentRes, error) {
+	err := h.taskUseCase.DeleteTaskAttachment(ctx, params.ID, params.AttachmentId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DeleteTaskAttachmentNoContent{}, nil
+}
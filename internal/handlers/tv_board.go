@@ -86,10 +86,25 @@ func (h *RestApiImplementation) GetTvBoardsData(ctx context.Context, params api.
 		return nil, err
 	}
 
+	pickupCodes, err := h.taskUseCase.GetUnitPickupCodes(ctx, res.UnitID)
+	if err != nil {
+		return nil, err
+	}
+	pickupCodesDto := make([]api.TvBoardPickupCode, len(pickupCodes))
+	for i, code := range pickupCodes {
+		pickupCodesDto[i] = api.TvBoardPickupCode{
+			TaskId:    code.TaskID,
+			TaskName:  code.TaskName,
+			Code:      code.Code,
+			QrPayload: code.QRPayload,
+		}
+	}
+
 	return &api.GetTvBoardDataResponse{
 		Data: api.GetTvBoardDataResponseData{
-			TvBoard: toTvBoard(res),
-			Tasks:   tasksToDto(taskRes),
+			TvBoard:     toTvBoard(res),
+			Tasks:       tasksToDto(taskRes),
+			PickupCodes: pickupCodesDto,
 		},
 	}, nil
 }
@@ -15,20 +15,42 @@ const (
 	ObjectChangeActionDelete ObjectChangeAction = "delete"
 )
 
+// ObjectChangeReason is a reason code explaining why a change was made
+type ObjectChangeReason string
+
+const (
+	ObjectChangeReasonCountMissing    ObjectChangeReason = "count_missing"
+	ObjectChangeReasonCountMisplaced  ObjectChangeReason = "count_misplaced"
+	ObjectChangeReasonCountUnexpected ObjectChangeReason = "count_unexpected"
+	ObjectChangeReasonSlaBreached     ObjectChangeReason = "sla_breached"
+	ObjectChangeReasonItemDamaged     ObjectChangeReason = "item_damaged"
+
+	ObjectChangeReasonPickupCodeAccepted ObjectChangeReason = "pickup_code_accepted"
+	ObjectChangeReasonPickupCodeRejected ObjectChangeReason = "pickup_code_rejected"
+	ObjectChangeReasonPickupCodeLocked   ObjectChangeReason = "pickup_code_locked"
+)
+
 type ObjectTypeId int
 
 const (
-	ObjectTypeOrganization ObjectTypeId = 1
-	ObjectTypeUnit         ObjectTypeId = 2
-	ObjectTypeStorageGroup ObjectTypeId = 3
-	ObjectTypeCellsGroup   ObjectTypeId = 4
-	ObjectTypeCell         ObjectTypeId = 5
-	ObjectTypeItem         ObjectTypeId = 6
-	ObjectTypeItemInstance ObjectTypeId = 7
-	ObjectTypeEmployee     ObjectTypeId = 8
-	ObjectTypeTask         ObjectTypeId = 9
-	ObjectTypeItemVariant  ObjectTypeId = 10
-	ObjectTypeApiToken     ObjectTypeId = 11
+	ObjectTypeOrganization   ObjectTypeId = 1
+	ObjectTypeUnit           ObjectTypeId = 2
+	ObjectTypeStorageGroup   ObjectTypeId = 3
+	ObjectTypeCellsGroup     ObjectTypeId = 4
+	ObjectTypeCell           ObjectTypeId = 5
+	ObjectTypeItem           ObjectTypeId = 6
+	ObjectTypeItemInstance   ObjectTypeId = 7
+	ObjectTypeEmployee       ObjectTypeId = 8
+	ObjectTypeTask           ObjectTypeId = 9
+	ObjectTypeItemVariant    ObjectTypeId = 10
+	ObjectTypeApiToken       ObjectTypeId = 11
+	ObjectTypePickWave       ObjectTypeId = 12
+	ObjectTypeTaskTemplate   ObjectTypeId = 13
+	ObjectTypeTaskPickupCode ObjectTypeId = 14
+	ObjectTypeTaskComment    ObjectTypeId = 15
+	ObjectTypeTaskAttachment ObjectTypeId = 16
+	ObjectTypeTransferOrder  ObjectTypeId = 17
+	ObjectTypeStockLine      ObjectTypeId = 18
 )
 
 type ObjectType struct {
@@ -45,6 +67,8 @@ type ObjectChangeCreate struct {
 
 	PrechangeState  any `json:"prechange_state"`
 	PostchangeState any `json:"postchange_state"`
+
+	Reason *ObjectChangeReason `json:"reason"`
 }
 
 type ObjectChange struct {
@@ -60,6 +84,8 @@ type ObjectChange struct {
 	PrechangeState  json.RawMessage `json:"prechange_state"`
 	PostchangeState json.RawMessage `json:"postchange_state"`
 
+	Reason *ObjectChangeReason `json:"reason"`
+
 	Timestamp time.Time `json:"timestamp"`
 
 	Employee   *Employee   `json:"employee"`
@@ -0,0 +1,54 @@
+package models
+
+// BarcodeObjectType is the kind of object a scanned code was resolved to
+type BarcodeObjectType string
+
+const (
+	BarcodeObjectTypeVariant  BarcodeObjectType = "variant"
+	BarcodeObjectTypeCell     BarcodeObjectType = "cell"
+	BarcodeObjectTypeInstance BarcodeObjectType = "instance"
+	BarcodeObjectTypeTvBoard  BarcodeObjectType = "tv-board"
+)
+
+// BarcodeMatchField is the field of the object the code matched
+type BarcodeMatchField string
+
+const (
+	BarcodeMatchFieldEAN13        BarcodeMatchField = "ean13"
+	BarcodeMatchFieldArticle      BarcodeMatchField = "article"
+	BarcodeMatchFieldAlias        BarcodeMatchField = "alias"
+	BarcodeMatchFieldID           BarcodeMatchField = "id"
+	BarcodeMatchFieldToken        BarcodeMatchField = "token"
+	BarcodeMatchFieldSerialNumber BarcodeMatchField = "serial_number"
+)
+
+// GS1Data is the data of the GS1-128 application identifiers known to the
+// warehouse: GTIN (01), lot (10), production date (11), expiry date (17, or
+// best before 15) and serial number (21)
+type GS1Data struct {
+	GTIN         *string `json:"gtin"`
+	Lot          ItemLot `json:"lot"`
+	SerialNumber *string `json:"serial_number"`
+}
+
+// BarcodeMatch is one object of the organization matching a scanned code.
+// Only the entity of ObjectType is set.
+type BarcodeMatch struct {
+	ObjectType BarcodeObjectType `json:"object_type"`
+	MatchedBy  BarcodeMatchField `json:"matched_by"`
+
+	Variant *ItemVariant `json:"variant"`
+	// Stock of the variant per unit
+	Stock    []*StockLevel `json:"stock"`
+	Cell     *Cell         `json:"cell"`
+	Instance *ItemInstance `json:"instance"`
+	TvBoard  *TvBoard      `json:"tv_board"`
+}
+
+// BarcodeResolution lists every object a scanned code may stand for, the
+// client picks one when the code is ambiguous
+type BarcodeResolution struct {
+	Code    string         `json:"code"`
+	GS1     *GS1Data       `json:"gs1"`
+	Matches []BarcodeMatch `json:"matches"`
+}
@@ -29,16 +29,38 @@ type ItemVariant struct {
 	Article *string `json:"article"`
 	EAN13   *int64  `json:"ean13"`
 
+	TrackingMode ItemTrackingMode `json:"tracking_mode"`
+	// Overrides the allocation policy of the organization when set
+	AllocationPolicy *AllocationPolicy `json:"allocation_policy"`
+	// Instances must get a serial number when created or received
+	RequiresSerial bool `json:"requires_serial"`
+
 	CreatedAt time.Time  `json:"created_at"`
 	DeletedAt *time.Time `json:"deleted_at"`
 }
 
+// ItemTrackingMode tells how the stock of a variant is stored
+type ItemTrackingMode string
+
+const (
+	// Every piece is an item instance
+	ItemTrackingModeSerialized ItemTrackingMode = "serialized"
+	// Pieces are counted in stock lines
+	ItemTrackingModeQuantity ItemTrackingMode = "quantity"
+)
+
 type ItemInstanceStatus string
 
 const (
-	ItemInstanceStatusAvailable ItemInstanceStatus = "available"
-	ItemInstanceStatusReserved  ItemInstanceStatus = "reserved"
-	ItemInstanceStatusConsumed  ItemInstanceStatus = "consumed"
+	ItemInstanceStatusAvailable   ItemInstanceStatus = "available"
+	ItemInstanceStatusReserved    ItemInstanceStatus = "reserved"
+	ItemInstanceStatusConsumed    ItemInstanceStatus = "consumed"
+	ItemInstanceStatusQuarantined ItemInstanceStatus = "quarantined"
+	ItemInstanceStatusDisposed    ItemInstanceStatus = "disposed"
+	// Picked by a completed pickment task and handed over
+	ItemInstanceStatusShipped ItemInstanceStatus = "shipped"
+	// Dispatched by a transfer order and not received by the destination unit yet
+	ItemInstanceStatusInTransit ItemInstanceStatus = "in_transit"
 )
 
 type ItemInstance struct {
@@ -50,7 +72,60 @@ type ItemInstance struct {
 	Status           ItemInstanceStatus `json:"status"`
 	AffectedByTaskID *uuid.UUID         `json:"affected_by_task_id"`
 
+	// Manufacturer serial number, unique per variant
+	SerialNumber   *string    `json:"serial_number"`
+	LotNumber      *string    `json:"lot_number"`
+	ManufacturedAt *time.Time `json:"manufactured_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+
+	Item    *Item        `json:"item"`
+	Cell    *Cell        `json:"cell"`
+	Variant *ItemVariant `json:"variant"`
+}
+
+// ItemLot is the production batch data of received instances
+type ItemLot struct {
+	LotNumber      *string
+	ManufacturedAt *time.Time
+	ExpiresAt      *time.Time
+}
+
+func (l ItemLot) IsEmpty() bool {
+	return l.LotNumber == nil && l.ManufacturedAt == nil && l.ExpiresAt == nil
+}
+
+// IsExpired tells whether the expiry day has passed. Instances remain usable
+// through the day they expire.
+func IsExpired(expiresAt *time.Time, now time.Time) bool {
+	if expiresAt == nil {
+		return false
+	}
+	y, m, d := now.UTC().Date()
+	return expiresAt.Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+// StockLine is a quantity of a quantity tracked variant sharing a cell and
+// a status. Statuses are the ones of item instances.
+type StockLine struct {
+	ID               uuid.UUID          `json:"id"`
+	OrgID            uuid.UUID          `json:"org_id"`
+	ItemID           uuid.UUID          `json:"item_id"`
+	VariantID        uuid.UUID          `json:"variant_id"`
+	CellID           *uuid.UUID         `json:"cell_id"`
+	Status           ItemInstanceStatus `json:"status"`
+	Quantity         int                `json:"quantity"`
+	AffectedByTaskID *uuid.UUID         `json:"affected_by_task_id"`
+
+	CreatedAt time.Time `json:"created_at"`
+
 	Item    *Item        `json:"item"`
 	Cell    *Cell        `json:"cell"`
 	Variant *ItemVariant `json:"variant"`
 }
+
+type StockLineFilter struct {
+	ItemID    *uuid.UUID
+	VariantID *uuid.UUID
+	CellID    *uuid.UUID
+	Status    *ItemInstanceStatus
+}
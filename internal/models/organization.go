@@ -12,10 +12,50 @@ type Organization struct {
 	Name      string `json:"name"`
 	Subdomain string `json:"subdomain"`
 
+	TaskAssignmentStrategy TaskAssignmentStrategy `json:"task_assignment_strategy"`
+	AllocationPolicy       AllocationPolicy       `json:"allocation_policy"`
+
 	CreatedAt time.Time  `json:"created_at"`
 	DeletedAt *time.Time `json:"deleted_at"`
 }
 
+// TaskAssignmentStrategy selects how a worker is picked for tasks created
+// without an assignee
+type TaskAssignmentStrategy string
+
+const (
+	// Tasks stay unassigned until assigned or claimed
+	TaskAssignmentStrategyManual TaskAssignmentStrategy = "manual"
+	// The worker with the fewest open tasks gets the task
+	TaskAssignmentStrategyLeastOpenTasks TaskAssignmentStrategy = "least_open_tasks"
+	// Workers of the unit get tasks in turn
+	TaskAssignmentStrategyRoundRobin TaskAssignmentStrategy = "round_robin"
+	// The least loaded worker qualified for the task type gets the task
+	TaskAssignmentStrategySkills TaskAssignmentStrategy = "skills"
+)
+
+// AllocationPolicy selects which available instances are allocated to tasks
+// first
+type AllocationPolicy string
+
+const (
+	// First in first out, the oldest instances go first
+	AllocationPolicyFIFO AllocationPolicy = "fifo"
+	// First expired first out, the instances expiring first go first
+	AllocationPolicyFEFO AllocationPolicy = "fefo"
+)
+
+// PickPathStrategy selects how task items are ordered into a walking route
+// inside a cells group
+type PickPathStrategy string
+
+const (
+	// Rows are walked in alternating directions, S-shape
+	PickPathStrategySerpentine PickPathStrategy = "serpentine"
+	// Every row is walked from its first position
+	PickPathStrategySequential PickPathStrategy = "sequential"
+)
+
 type OrganizationUnit struct {
 	ID    uuid.UUID `json:"id"`
 	OrgID uuid.UUID `json:"org_id"`
@@ -24,6 +64,8 @@ type OrganizationUnit struct {
 	Alias   string  `json:"alias"`
 	Address *string `json:"address"`
 
+	PickPathStrategy PickPathStrategy `json:"pick_path_strategy"`
+
 	CreatedAt time.Time  `json:"created_at"`
 	DeletedAt *time.Time `json:"deleted_at"`
 }
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -39,3 +41,15 @@ type Employee struct {
 
 	Role *Role `json:"role"`
 }
+
+// WorkerProfile holds the settings of an employee used by automatic task
+// assignment
+type WorkerProfile struct {
+	UserID uuid.UUID `json:"user_id"`
+
+	UnitIDs []uuid.UUID `json:"unit_ids"`
+	Skills  []TaskType  `json:"skills"`
+	OnShift bool        `json:"on_shift"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// StockFilter selects the instances counted in stock levels. GroupBy is the
+// level of the storage hierarchy the counts are rolled up to.
+type StockFilter struct {
+	ItemID    *uuid.UUID
+	VariantID *uuid.UUID
+	UnitID    *uuid.UUID
+	// Counted statuses, every stock status when empty
+	Statuses []ItemInstanceStatus
+	GroupBy  CellPathObjectType
+}
+
+// StockLevel counts instances of a variant at one node of the storage
+// hierarchy. Nodes above cells include the stock of every cell below them.
+type StockLevel struct {
+	ItemID    uuid.UUID       `json:"item_id"`
+	VariantID uuid.UUID       `json:"variant_id"`
+	Location  CellPathSegment `json:"location"`
+
+	Available int `json:"available"`
+	Reserved  int `json:"reserved"`
+	Consumed  int `json:"consumed"`
+	InTransit int `json:"in_transit"`
+
+	Variant *ItemVariant `json:"variant"`
+}
+
+func (l *StockLevel) Total() int {
+	return l.Available + l.Reserved + l.Consumed + l.InTransit
+}
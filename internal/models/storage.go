@@ -35,6 +35,7 @@ type CellsGroup struct {
 type CellPathObjectType string
 
 const (
+	CellPathObjectTypeCell         CellPathObjectType = "cell"
 	CellPathObjectTypeCellsGroup   CellPathObjectType = "cells_group"
 	CellPathObjectTypeStorageGroup CellPathObjectType = "storage_group"
 	CellPathObjectTypeUnit         CellPathObjectType = "unit"
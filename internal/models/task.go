@@ -1,6 +1,7 @@
 package models
 
 import (
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,8 +10,11 @@ import (
 type TaskType string
 
 const (
-	TaskTypePickmentItem TaskType = "pickment"
-	TaskTypeMovement     TaskType = "movement"
+	TaskTypePickmentItem   TaskType = "pickment"
+	TaskTypeMovement       TaskType = "movement"
+	TaskTypeReceiving      TaskType = "receiving"
+	TaskTypeInventoryCount TaskType = "inventory_count"
+	TaskTypeReturn         TaskType = "return"
 )
 
 type TaskStatus string
@@ -23,6 +27,16 @@ const (
 	TaskStatusCancelled  TaskStatus = "cancelled"
 )
 
+// TaskPriority values are listed from the lowest to the highest
+type TaskPriority string
+
+const (
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityNormal TaskPriority = "normal"
+	TaskPriorityHigh   TaskPriority = "high"
+	TaskPriorityUrgent TaskPriority = "urgent"
+)
+
 type TaskItemStatus string
 
 const (
@@ -30,23 +44,176 @@ const (
 	TaskItemStatusPicked   TaskItemStatus = "picked"
 	TaskItemStatusDone     TaskItemStatus = "done"
 	TaskItemStatusReturned TaskItemStatus = "returned"
+	TaskItemStatusCanceled TaskItemStatus = "canceled"
+	// The item could not be picked, see TaskItemException
+	TaskItemStatusShort TaskItemStatus = "short"
 )
 
+// TaskItem moves either one instance of a serialized variant or a quantity
+// of a stock line of a quantity tracked variant
 type TaskItem struct {
-	OrgID      uuid.UUID `json:"org_id"`
-	TaskID     uuid.UUID `json:"task_id"`
-	InstanceID uuid.UUID `json:"instance_id"`
+	ID         uuid.UUID  `json:"id"`
+	OrgID      uuid.UUID  `json:"org_id"`
+	TaskID     uuid.UUID  `json:"task_id"`
+	InstanceID *uuid.UUID `json:"instance_id"`
+	// Line the quantity is taken from, the reserved part of it once picked
+	StockLineID *uuid.UUID `json:"stock_line_id"`
+	Quantity    int        `json:"quantity"`
 
 	SourceCellID *uuid.UUID `json:"source_cell_id"`
 	TargetCellID *uuid.UUID `json:"target_cell_id"`
 
-	Status TaskItemStatus `json:"status"`
+	Status      TaskItemStatus     `json:"status"`
+	Disposition *ReturnDisposition `json:"disposition"`
+	// Position of the item in the walking route of the task, from 1
+	Sequence int `json:"sequence"`
 
 	Instance   *ItemInstance `json:"instance"`
+	StockLine  *StockLine    `json:"stock_line"`
 	SourceCell *Cell         `json:"source_cell"`
 	TargetCell *Cell         `json:"target_cell"`
 }
 
+type TaskItemExceptionReason string
+
+const (
+	TaskItemExceptionReasonNotFound  TaskItemExceptionReason = "not_found"
+	TaskItemExceptionReasonDamaged   TaskItemExceptionReason = "damaged"
+	TaskItemExceptionReasonWrongItem TaskItemExceptionReason = "wrong_item"
+)
+
+// TaskItemException records a task item the worker could not pick. The item
+// is marked as short and may be replaced by another instance of the same
+// variant.
+type TaskItemException struct {
+	ID                   uuid.UUID               `json:"id"`
+	OrgID                uuid.UUID               `json:"org_id"`
+	TaskID               uuid.UUID               `json:"task_id"`
+	InstanceID           uuid.UUID               `json:"instance_id"`
+	Reason               TaskItemExceptionReason `json:"reason"`
+	Note                 *string                 `json:"note"`
+	SubstituteInstanceID *uuid.UUID              `json:"substitute_instance_id"`
+	ReportedByUserID     *uuid.UUID              `json:"reported_by_user_id"`
+	CreatedAt            time.Time               `json:"created_at"`
+
+	Instance   *ItemInstance `json:"instance"`
+	ReportedBy *Employee     `json:"reported_by"`
+}
+
+// TaskItemExceptionCreate is an exception reported by a worker. With
+// AutoSubstitute the service picks the substitute itself when
+// SubstituteInstanceID is not set.
+type TaskItemExceptionCreate struct {
+	InstanceID           uuid.UUID
+	Reason               TaskItemExceptionReason
+	Note                 *string
+	SubstituteInstanceID *uuid.UUID
+	AutoSubstitute       bool
+}
+
+type TaskExceptionFilter struct {
+	UnitID      *uuid.UUID
+	Reason      *TaskItemExceptionReason
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Limit       int
+}
+
+type TaskExceptionReport struct {
+	Exceptions     []*TaskItemException
+	CountsByReason map[TaskItemExceptionReason]int
+}
+
+// ReturnDisposition is what happens to an instance inspected by a return task
+type ReturnDisposition string
+
+const (
+	ReturnDispositionRestock    ReturnDisposition = "restock"
+	ReturnDispositionQuarantine ReturnDisposition = "quarantine"
+	ReturnDispositionDispose    ReturnDisposition = "dispose"
+)
+
+type TaskDiscrepancy string
+
+const (
+	TaskDiscrepancyOver  TaskDiscrepancy = "over"
+	TaskDiscrepancyUnder TaskDiscrepancy = "under"
+)
+
+// TaskExpectedItem is a line of a receiving task manifest
+type TaskExpectedItem struct {
+	ID        uuid.UUID `json:"id"`
+	OrgID     uuid.UUID `json:"org_id"`
+	TaskID    uuid.UUID `json:"task_id"`
+	VariantID uuid.UUID `json:"variant_id"`
+
+	ExpectedQuantity int              `json:"expected_quantity"`
+	ReceivedQuantity int              `json:"received_quantity"`
+	Discrepancy      *TaskDiscrepancy `json:"discrepancy"`
+
+	Variant *ItemVariant `json:"variant"`
+}
+
+// TaskReceipt is the stock registered by one receipt of a receiving task,
+// instances of a serialized variant or a stock line of a quantity tracked one
+type TaskReceipt struct {
+	Instances []*ItemInstance `json:"instances"`
+	StockLine *StockLine      `json:"stock_line"`
+}
+
+// TaskCountTarget selects cells for an inventory count task. Exactly one of
+// the fields is set.
+type TaskCountTarget struct {
+	CellsGroupID   *uuid.UUID  `json:"cells_group_id"`
+	StorageGroupID *uuid.UUID  `json:"storage_group_id"`
+	CellIDs        []uuid.UUID `json:"cell_ids"`
+}
+
+// TaskCountCell is a cell covered by an inventory count task
+type TaskCountCell struct {
+	CellID          uuid.UUID  `json:"cell_id"`
+	CountedAt       *time.Time `json:"counted_at"`
+	CountedByUserID *uuid.UUID `json:"counted_by_user_id"`
+
+	Cell *Cell `json:"cell"`
+}
+
+// TaskCountSubmission is what a worker found in a single cell
+type TaskCountSubmission struct {
+	CellID      uuid.UUID
+	InstanceIDs []uuid.UUID
+	Barcodes    []string
+}
+
+// InventoryCountDiscrepancy is a line of an inventory count reconciliation
+// report. Instance is nil for unexpected goods counted by variant barcode.
+type InventoryCountDiscrepancy struct {
+	InstanceID     *uuid.UUID `json:"instance_id"`
+	VariantID      uuid.UUID  `json:"variant_id"`
+	ExpectedCellID *uuid.UUID `json:"expected_cell_id"`
+	FoundCellID    *uuid.UUID `json:"found_cell_id"`
+	Quantity       int        `json:"quantity"`
+
+	Instance     *ItemInstance `json:"instance"`
+	Variant      *ItemVariant  `json:"variant"`
+	ExpectedCell *Cell         `json:"expected_cell"`
+	FoundCell    *Cell         `json:"found_cell"`
+}
+
+type InventoryCountReport struct {
+	TaskID uuid.UUID `json:"task_id"`
+
+	// Recorded in a counted cell but not found there
+	Missing []*InventoryCountDiscrepancy `json:"missing"`
+	// Found in a counted cell with no matching record
+	Unexpected []*InventoryCountDiscrepancy `json:"unexpected"`
+	// Found in a counted cell while recorded in another one
+	Misplaced []*InventoryCountDiscrepancy `json:"misplaced"`
+
+	UncountedCellIDs []uuid.UUID `json:"uncounted_cell_ids"`
+	UncountedCells   []*Cell     `json:"uncounted_cells"`
+}
+
 type Task struct {
 	ID uuid.UUID `json:"id"`
 
@@ -56,15 +223,302 @@ type Task struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
 
-	Status           TaskStatus `json:"status"`
-	AssignedToUserID *uuid.UUID `json:"assigned_to_user_id"`
-	Type             TaskType   `json:"type"`
+	Status           TaskStatus   `json:"status"`
+	Priority         TaskPriority `json:"priority"`
+	AssignedToUserID *uuid.UUID   `json:"assigned_to_user_id"`
+	Type             TaskType     `json:"type"`
+	ReceivingCellID  *uuid.UUID   `json:"receiving_cell_id"`
+	OriginalTaskID   *uuid.UUID   `json:"original_task_id"`
+	WaveID           *uuid.UUID   `json:"wave_id"`
+	TemplateID       *uuid.UUID   `json:"template_id"`
+	TransferOrderID  *uuid.UUID   `json:"transfer_order_id"`
 
-	Items      []*TaskItem       `json:"items"`
-	AssignedTo *Employee         `json:"assigned_to"`
-	Unit       *OrganizationUnit `json:"unit"`
+	Items         []*TaskItem          `json:"items"`
+	ExpectedItems []*TaskExpectedItem  `json:"expected_items"`
+	CountTarget   *TaskCountTarget     `json:"count_target"`
+	CountCells    []*TaskCountCell     `json:"count_cells"`
+	Exceptions    []*TaskItemException `json:"exceptions"`
+	ReceivingCell *Cell                `json:"receiving_cell"`
+	AssignedTo    *Employee            `json:"assigned_to"`
+	Unit          *OrganizationUnit    `json:"unit"`
 
 	CreatedAt   time.Time  `json:"created_at"`
 	AssignedAt  *time.Time `json:"assigned_at"`
 	CompletedAt *time.Time `json:"completed_at"`
+
+	DueAt *time.Time `json:"due_at"`
+	// Set once the task is found open after DueAt
+	SlaBreachedAt *time.Time `json:"sla_breached_at"`
+}
+
+// TaskPickupCode is the one-time code a customer or courier presents to
+// collect a ready pickment task, typed in as digits or scanned as a QR code
+type TaskPickupCode struct {
+	TaskID   uuid.UUID `json:"task_id"`
+	UnitID   uuid.UUID `json:"unit_id"`
+	TaskName string    `json:"-"`
+
+	// The code is kept out of audit records
+	Code      string `json:"-"`
+	QRPayload string `json:"-"`
+
+	FailedAttempts int `json:"failed_attempts"`
+	AttemptsLeft   int `json:"attempts_left"`
+
+	CreatedAt time.Time  `json:"created_at"`
+	LockedAt  *time.Time `json:"locked_at"`
+	UsedAt    *time.Time `json:"used_at"`
+}
+
+// TaskStatusChange is an entry of the task status history
+type TaskStatusChange struct {
+	ID              uuid.UUID   `json:"id"`
+	TaskID          uuid.UUID   `json:"task_id"`
+	FromStatus      *TaskStatus `json:"from_status"`
+	ToStatus        TaskStatus  `json:"to_status"`
+	ChangedByUserID *uuid.UUID  `json:"changed_by_user_id"`
+	Comment         *string     `json:"comment"`
+	ChangedAt       time.Time   `json:"changed_at"`
+	// Time spent in ToStatus, nil while the task is still in it
+	Duration *time.Duration `json:"duration"`
+
+	ChangedBy *Employee `json:"changed_by"`
+}
+
+type PickWaveStatus string
+
+const (
+	PickWaveStatusPending   PickWaveStatus = "pending"
+	PickWaveStatusPicking   PickWaveStatus = "picking"
+	PickWaveStatusSorting   PickWaveStatus = "sorting"
+	PickWaveStatusCompleted PickWaveStatus = "completed"
+	PickWaveStatusCancelled PickWaveStatus = "cancelled"
+)
+
+// PickWave groups pickment tasks of a unit into a single pick run. Picked
+// instances are sorted back to their tasks at the consolidation cell.
+type PickWave struct {
+	ID     uuid.UUID `json:"id"`
+	OrgID  uuid.UUID `json:"org_id"`
+	UnitID uuid.UUID `json:"unit_id"`
+
+	Name                string         `json:"name"`
+	Status              PickWaveStatus `json:"status"`
+	ConsolidationCellID uuid.UUID      `json:"consolidation_cell_id"`
+
+	// Combined items of all wave tasks
+	Items             []*TaskItem `json:"items"`
+	Tasks             []*Task     `json:"tasks"`
+	ConsolidationCell *Cell       `json:"consolidation_cell"`
+
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// PickWaveCreate either lists the wave tasks or lets the planner take up to
+// MaxTasks open pickment tasks of the unit, most urgent first
+type PickWaveCreate struct {
+	UnitID              uuid.UUID
+	Name                string
+	ConsolidationCellID uuid.UUID
+	TaskIDs             []uuid.UUID
+	MaxTasks            int
+}
+
+type TransferOrderStatus string
+
+const (
+	TransferOrderStatusPending   TransferOrderStatus = "pending"
+	TransferOrderStatusInTransit TransferOrderStatus = "in_transit"
+	TransferOrderStatusReceived  TransferOrderStatus = "received"
+	TransferOrderStatusCancelled TransferOrderStatus = "cancelled"
+)
+
+// TransferOrder moves instances between two units of the organization. The
+// outbound leg is a pickment task of the source unit. Instances it picks are
+// in transit once it is completed, until the inbound leg, a receiving task of
+// the destination unit, receives them into ReceivingCellID.
+type TransferOrder struct {
+	ID                uuid.UUID           `json:"id"`
+	OrgID             uuid.UUID           `json:"org_id"`
+	SourceUnitID      uuid.UUID           `json:"source_unit_id"`
+	DestinationUnitID uuid.UUID           `json:"destination_unit_id"`
+	ReceivingCellID   uuid.UUID           `json:"receiving_cell_id"`
+	Name              string              `json:"name"`
+	Status            TransferOrderStatus `json:"status"`
+
+	OutboundTaskID *uuid.UUID `json:"outbound_task_id"`
+	InboundTaskID  *uuid.UUID `json:"inbound_task_id"`
+	// Instances of the outbound leg, except canceled and short items
+	Instances []*ItemInstance `json:"-"`
+
+	CreatedAt    time.Time  `json:"created_at"`
+	DispatchedAt *time.Time `json:"dispatched_at"`
+	ReceivedAt   *time.Time `json:"received_at"`
+}
+
+type TransferOrderCreate struct {
+	Name              string
+	SourceUnitID      uuid.UUID
+	DestinationUnitID uuid.UUID
+	ReceivingCellID   uuid.UUID
+	InstanceIDs       []uuid.UUID
+}
+
+// TaskTemplate describes a task created repeatedly, manually or by the
+// scheduler when Schedule is set. Items of created tasks are selected by the
+// template rules at creation time.
+type TaskTemplate struct {
+	ID     uuid.UUID `json:"id"`
+	OrgID  uuid.UUID `json:"org_id"`
+	UnitID uuid.UUID `json:"unit_id"`
+
+	Type        TaskType     `json:"type"`
+	Priority    TaskPriority `json:"priority"`
+	Name        string       `json:"name"`
+	Description *string      `json:"description"`
+
+	AssignedToUserID *uuid.UUID `json:"assigned_to_user_id"`
+	// Due date of created tasks relative to their creation
+	DueInMinutes *int32 `json:"due_in_minutes"`
+
+	// Five field cron expression evaluated in UTC
+	Schedule  *string    `json:"schedule"`
+	IsActive  bool       `json:"is_active"`
+	NextRunAt *time.Time `json:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at"`
+
+	Rules []*TaskTemplateRule `json:"rules"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TaskTemplateRule selects available instances of the template unit. At least
+// one of ItemID, VariantID and SourceCellsGroupID is set. Every matching
+// instance is taken when MaxInstances is nil.
+type TaskTemplateRule struct {
+	ItemID             *uuid.UUID `json:"item_id"`
+	VariantID          *uuid.UUID `json:"variant_id"`
+	SourceCellsGroupID *uuid.UUID `json:"source_cells_group_id"`
+	TargetCellID       *uuid.UUID `json:"target_cell_id"`
+	MaxInstances       *int32     `json:"max_instances"`
+}
+
+type TaskSortField string
+
+const (
+	TaskSortFieldCreatedAt TaskSortField = "created_at"
+	TaskSortFieldName      TaskSortField = "name"
+	TaskSortFieldPriority  TaskSortField = "priority"
+)
+
+type TaskFilter struct {
+	Statuses         []TaskStatus
+	Type             *TaskType
+	UnitID           *uuid.UUID
+	AssignedToUserID *uuid.UUID
+
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	CompletedFrom *time.Time
+	CompletedTo   *time.Time
+
+	Search *string
+
+	SortBy   TaskSortField
+	SortDesc bool
+
+	Limit  int
+	Cursor *string
+}
+
+type TaskList struct {
+	Tasks      []*Task
+	NextCursor *string
+}
+
+// TaskComment is a note left on a task, or on one of its items when
+// InstanceID is set
+type TaskComment struct {
+	ID           uuid.UUID  `json:"id"`
+	OrgID        uuid.UUID  `json:"org_id"`
+	TaskID       uuid.UUID  `json:"task_id"`
+	InstanceID   *uuid.UUID `json:"instance_id"`
+	Body         string     `json:"body"`
+	AuthorUserID *uuid.UUID `json:"author_user_id"`
+	CreatedAt    time.Time  `json:"created_at"`
+	EditedAt     *time.Time `json:"edited_at"`
+
+	Author *Employee `json:"author"`
+}
+
+// TaskAttachment is the metadata of a file attached to a task, the content
+// itself is kept in the blob storage
+type TaskAttachment struct {
+	ID               uuid.UUID  `json:"id"`
+	OrgID            uuid.UUID  `json:"org_id"`
+	TaskID           uuid.UUID  `json:"task_id"`
+	InstanceID       *uuid.UUID `json:"instance_id"`
+	CommentID        *uuid.UUID `json:"comment_id"`
+	FileName         string     `json:"file_name"`
+	ContentType      string     `json:"content_type"`
+	Size             int64      `json:"size"`
+	StorageKey       string     `json:"-"`
+	UploadedByUserID *uuid.UUID `json:"uploaded_by_user_id"`
+	CreatedAt        time.Time  `json:"created_at"`
+
+	UploadedBy *Employee `json:"uploaded_by"`
+}
+
+type TaskAttachmentUpload struct {
+	InstanceID *uuid.UUID
+	CommentID  *uuid.UUID
+	FileName   string
+	Size       int64
+	Content    io.Reader
+}
+
+type TaskImportFormat string
+
+const (
+	TaskImportFormatCSV    TaskImportFormat = "csv"
+	TaskImportFormatNDJSON TaskImportFormat = "ndjson"
+)
+
+// TaskImportRow is a line of a bulk task import. Rows with the same name make
+// up one task. Either InstanceID or Article with Quantity is set.
+type TaskImportRow struct {
+	Line            int
+	Name            string
+	Type            TaskType
+	UnitAlias       string
+	InstanceID      *uuid.UUID
+	Article         *string
+	Quantity        *int
+	TargetCellAlias *string
+}
+
+type TaskImportError struct {
+	Line    int
+	Message string
+}
+
+// TaskImportTask is a task made up from the import rows. TaskID is set once
+// the task is created.
+type TaskImportTask struct {
+	TaskID *uuid.UUID
+	Name   string
+	Type   TaskType
+	UnitID uuid.UUID
+	Lines  []int
+	Items  []*TaskItem
+}
+
+// TaskImportReport lists the tasks of the import, or the errors found in its
+// rows, in which case nothing is created
+type TaskImportReport struct {
+	DryRun    bool
+	RowsCount int
+	Tasks     []*TaskImportTask
+	Errors    []TaskImportError
 }
@@ -12,9 +12,11 @@ import (
 	"github.com/let-store-it/backend/internal/handlers"
 	"github.com/let-store-it/backend/internal/services/audit"
 	"github.com/let-store-it/backend/internal/services/auth"
+	"github.com/let-store-it/backend/internal/services/blob"
 	"github.com/let-store-it/backend/internal/services/employee"
 	"github.com/let-store-it/backend/internal/services/item"
 	"github.com/let-store-it/backend/internal/services/organization"
+	"github.com/let-store-it/backend/internal/services/stock"
 	"github.com/let-store-it/backend/internal/services/storage"
 	"github.com/let-store-it/backend/internal/services/tasks"
 	"github.com/let-store-it/backend/internal/services/tvboard"
@@ -26,6 +28,7 @@ import (
 	authUC "github.com/let-store-it/backend/internal/usecases/auth"
 	itemUC "github.com/let-store-it/backend/internal/usecases/item"
 	organizationUC "github.com/let-store-it/backend/internal/usecases/organization"
+	stockUC "github.com/let-store-it/backend/internal/usecases/stock"
 	storageUC "github.com/let-store-it/backend/internal/usecases/storage"
 	taskUC "github.com/let-store-it/backend/internal/usecases/task"
 	tvboardUC "github.com/let-store-it/backend/internal/usecases/tv_board"
@@ -35,6 +38,10 @@ type Server struct {
 	echo         *echo.Echo
 	config       *config.Config
 	auditService *audit.AuditService
+	taskService  *tasks.TaskService
+
+	backgroundCtx  context.Context
+	stopBackground context.CancelFunc
 }
 
 func New(cfg *config.Config, queries *sqlc.Queries, pool *pgxpool.Pool) (*Server, error) {
@@ -111,6 +118,22 @@ func New(cfg *config.Config, queries *sqlc.Queries, pool *pgxpool.Pool) (*Server
 		AuditService: auditService,
 	})
 
+	blobStorage, err := blob.New(blob.StorageConfig{
+		Backend:   cfg.BlobStorage.Backend,
+		LocalPath: cfg.BlobStorage.LocalPath,
+		S3: blob.S3Config{
+			Endpoint:        cfg.BlobStorage.S3Endpoint,
+			Region:          cfg.BlobStorage.S3Region,
+			Bucket:          cfg.BlobStorage.S3Bucket,
+			AccessKeyID:     cfg.BlobStorage.S3AccessKeyID,
+			SecretAccessKey: cfg.BlobStorage.S3SecretAccessKey,
+			UsePathStyle:    cfg.BlobStorage.S3UsePathStyle,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	taskService := tasks.New(tasks.TaskServiceConfig{
 		Queries:         queries,
 		PGXPool:         pool,
@@ -120,11 +143,21 @@ func New(cfg *config.Config, queries *sqlc.Queries, pool *pgxpool.Pool) (*Server
 		StorageService:  storageGroupService,
 		EmployeeService: employeeService,
 		AuditService:    auditService,
+
+		BlobStorage:       blobStorage,
+		AttachmentMaxSize: cfg.Tasks.AttachmentMaxSize,
 	})
 	tvBoardService := tvboard.New(tvboard.TvBoardServiceConfig{
 		Queries: queries,
 		PGXPool: pool,
 	})
+	stockService := stock.New(stock.StockServiceConfig{
+		Queries:        queries,
+		StorageService: storageGroupService,
+		ItemService:    itemService,
+		OrgService:     orgService,
+		TvBoardService: tvBoardService,
+	})
 
 	// Initialize use cases
 	itemUseCase := itemUC.New(itemUC.ItemUseCaseConfig{
@@ -160,6 +193,10 @@ func New(cfg *config.Config, queries *sqlc.Queries, pool *pgxpool.Pool) (*Server
 		OrganizationService: orgService,
 		AuthService:         authService,
 	})
+	stockUseCase := stockUC.New(stockUC.StockUseCaseConfig{
+		StockService: stockService,
+		AuthService:  authService,
+	})
 
 	// Initialize auth middleware
 	e.Use(echo.WrapMiddleware(handlers.WithOrganizationID))
@@ -175,6 +212,7 @@ func New(cfg *config.Config, queries *sqlc.Queries, pool *pgxpool.Pool) (*Server
 		auditUseCase,
 		taskUseCase,
 		tvBoardUseCase,
+		stockUseCase,
 	)
 
 	// Setup API server with global telemetry providers
@@ -193,20 +231,29 @@ func New(cfg *config.Config, queries *sqlc.Queries, pool *pgxpool.Pool) (*Server
 	})
 	e.Any("/*", echo.WrapHandler(server))
 
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+
 	return &Server{
-		echo:         e,
-		config:       cfg,
-		auditService: auditService,
+		echo:           e,
+		config:         cfg,
+		auditService:   auditService,
+		taskService:    taskService,
+		backgroundCtx:  backgroundCtx,
+		stopBackground: stopBackground,
 	}, nil
 }
 
-// Start starts the server
+// Start starts background jobs and the server
 func (s *Server) Start() error {
+	go s.taskService.RunSlaChecker(s.backgroundCtx, s.config.Tasks.SlaCheckInterval)
+	go s.taskService.RunTaskScheduler(s.backgroundCtx, s.config.Tasks.SchedulerInterval)
+
 	return s.echo.Start(s.config.Server.ListenAddress)
 }
 
 // Shutdown gracefully shuts down the server
 func (s *Server) Shutdown(ctx context.Context) error {
+	s.stopBackground()
 	if err := telemetry.Shutdown(ctx); err != nil {
 		return err
 	}
@@ -143,14 +143,29 @@ func (s *AuditService) publishToKafka(ctx context.Context, objectChange *models.
 
 func (s *AuditService) CreateObjectChange(ctx context.Context, objectChange *models.ObjectChangeCreate) error {
 	return telemetry.WithVoidTrace(ctx, s.tracer, "CreateObjectChange", func(ctx context.Context, span trace.Span) error {
+		return database.WithVoidTransaction(ctx, s.pgxPool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			changed, err := s.RecordObjectChange(database.ContextWithTx(ctx, tx), objectChange)
+			if err != nil {
+				return err
+			}
+			return s.publishToKafka(ctx, changed)
+		})
+	})
+}
+
+// RecordObjectChange writes the change to the audit log without publishing it.
+// With database.ContextWithTx the record is kept in the caller's transaction,
+// which publishes it with PublishObjectChange once committed.
+func (s *AuditService) RecordObjectChange(ctx context.Context, objectChange *models.ObjectChangeCreate) (*models.ObjectChange, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "RecordObjectChange", func(ctx context.Context, span trace.Span) (*models.ObjectChange, error) {
 		userID, err := common.GetUserIDFromContextIfExists(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		orgID, err := common.GetOrganizationIDFromContext(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		span.SetAttributes(
@@ -161,22 +176,22 @@ func (s *AuditService) CreateObjectChange(ctx context.Context, objectChange *mod
 		)
 
 		if err := s.validateObjectChange(objectChange); err != nil {
-			return err
+			return nil, err
 		}
 
 		prechangeState, err := json.Marshal(objectChange.PrechangeState)
 		if err != nil {
-			return fmt.Errorf("failed to marshal prechange state: %w", err)
+			return nil, fmt.Errorf("failed to marshal prechange state: %w", err)
 		}
 		postchangeState, err := json.Marshal(objectChange.PostchangeState)
 		if err != nil {
-			return fmt.Errorf("failed to marshal postchange state: %w", err)
+			return nil, fmt.Errorf("failed to marshal postchange state: %w", err)
 		}
 
 		objectChange.PrechangeState = prechangeState
 		objectChange.PostchangeState = postchangeState
 
-		err = database.WithVoidTransaction(ctx, s.pgxPool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+		return database.WithTransaction(ctx, s.pgxPool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.ObjectChange, error) {
 			qtx := s.queries.WithTx(tx)
 			changed, err := qtx.CreateObjectChange(ctx, sqlc.CreateObjectChangeParams{
 				OrgID:            database.PgUUID(orgID),
@@ -186,41 +201,39 @@ func (s *AuditService) CreateObjectChange(ctx context.Context, objectChange *mod
 				TargetObjectID:   database.PgUUID(objectChange.TargetObjectID),
 				PrechangeState:   prechangeState,
 				PostchangeState:  postchangeState,
+				Reason:           database.PgTextPtr((*string)(objectChange.Reason)),
 			})
 			if err != nil {
-				return fmt.Errorf("failed to create object change: %w", err)
+				return nil, fmt.Errorf("failed to create object change: %w", err)
 			}
 
 			changedModel := toObjectChange(changed)
 			if userID != nil {
 				employee, err := s.employeeService.GetEmployee(ctx, orgID, *userID)
 				if err != nil {
-					return fmt.Errorf("failed to get employee: %w", err)
+					return nil, fmt.Errorf("failed to get employee: %w", err)
 				}
 				changedModel.Employee = employee
 			}
 			objectType, err := s.getObjectTypeInfo(ctx, int32(objectChange.TargetObjectType))
 			if err != nil {
-				return fmt.Errorf("failed to get object type: %w", err)
+				return nil, fmt.Errorf("failed to get object type: %w", err)
 			}
 			changedModel.ObjectType = objectType
 
-			if err := s.publishToKafka(ctx, changedModel); err != nil {
-				return err
-			}
 			span.SetAttributes(
 				attribute.String("change.id", changedModel.ID.String()),
 			)
-			return nil
+			return changedModel, nil
 		})
-		if err != nil {
-			return err
-		}
-
-		return nil
 	})
 }
 
+// PublishObjectChange publishes a change written by RecordObjectChange
+func (s *AuditService) PublishObjectChange(ctx context.Context, objectChange *models.ObjectChange) error {
+	return s.publishToKafka(ctx, objectChange)
+}
+
 func (s *AuditService) GetObjectChanges(ctx context.Context, orgID uuid.UUID, targetObjectTypeId models.ObjectTypeId, targetObjectID uuid.UUID) ([]*models.ObjectChange, error) {
 	return telemetry.WithTrace(ctx, s.tracer, "GetObjectChanges", func(ctx context.Context, span trace.Span) ([]*models.ObjectChange, error) {
 		span.SetAttributes(
@@ -243,16 +256,9 @@ func (s *AuditService) GetObjectChanges(ctx context.Context, orgID uuid.UUID, ta
 			return nil, err
 		}
 
-		objectChangesModels := make([]*models.ObjectChange, len(objectChanges))
-		for i, change := range objectChanges {
-			employee, err := s.employeeService.GetEmployee(ctx, change.OrgID.Bytes, change.UserID.Bytes)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get employee info for change %s: %w", change.ID.Bytes, err)
-			}
-
-			objectChangesModels[i] = toObjectChange(change)
-			objectChangesModels[i].Employee = employee
-			objectChangesModels[i].ObjectType = objectType
+		objectChangesModels, err := s.toObjectChangesWithEmployees(ctx, objectChanges, objectType)
+		if err != nil {
+			return nil, err
 		}
 
 		span.SetAttributes(
@@ -262,3 +268,51 @@ func (s *AuditService) GetObjectChanges(ctx context.Context, orgID uuid.UUID, ta
 		return objectChangesModels, nil
 	})
 }
+
+// GetItemInstanceChangesBySerialNumber returns the history of every instance
+// which carried the serial number, deleted instances included, oldest first
+func (s *AuditService) GetItemInstanceChangesBySerialNumber(ctx context.Context, orgID uuid.UUID, serialNumber string) ([]*models.ObjectChange, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetItemInstanceChangesBySerialNumber", func(ctx context.Context, span trace.Span) ([]*models.ObjectChange, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		objectChanges, err := s.queries.GetItemInstanceChangesBySerialNumber(ctx, sqlc.GetItemInstanceChangesBySerialNumberParams{
+			OrgID:        database.PgUUID(orgID),
+			SerialNumber: serialNumber,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get object changes: %w", err)
+		}
+
+		objectType, err := s.getObjectTypeInfo(ctx, int32(models.ObjectTypeItemInstance))
+		if err != nil {
+			return nil, err
+		}
+
+		objectChangesModels, err := s.toObjectChangesWithEmployees(ctx, objectChanges, objectType)
+		if err != nil {
+			return nil, err
+		}
+
+		span.SetAttributes(
+			attribute.Int("changes.count", len(objectChangesModels)),
+		)
+		return objectChangesModels, nil
+	})
+}
+
+func (s *AuditService) toObjectChangesWithEmployees(ctx context.Context, objectChanges []sqlc.AppObjectChange, objectType *models.ObjectType) ([]*models.ObjectChange, error) {
+	objectChangesModels := make([]*models.ObjectChange, len(objectChanges))
+	for i, change := range objectChanges {
+		employee, err := s.employeeService.GetEmployee(ctx, change.OrgID.Bytes, change.UserID.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get employee info for change %s: %w", change.ID.Bytes, err)
+		}
+
+		objectChangesModels[i] = toObjectChange(change)
+		objectChangesModels[i].Employee = employee
+		objectChangesModels[i].ObjectType = objectType
+	}
+	return objectChangesModels, nil
+}
@@ -18,5 +18,9 @@ func toObjectChange(change sqlc.AppObjectChange) *models.ObjectChange {
 		PostchangeState:  change.PostchangeState,
 		Timestamp:        change.Time.Time,
 	}
+	if change.Reason.Valid {
+		reason := models.ObjectChangeReason(change.Reason.String)
+		res.Reason = &reason
+	}
 	return &res
 }
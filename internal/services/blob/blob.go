@@ -0,0 +1,46 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+
+// Storage keeps binary objects such as task attachments. Keys are slash
+// separated paths chosen by the caller.
+type Storage interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	// Get returns ErrBlobNotFound when there is no object under the key
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete succeeds when there is no object under the key
+	Delete(ctx context.Context, key string) error
+}
+
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+type StorageConfig struct {
+	Backend string
+
+	// Directory used by the local backend
+	LocalPath string
+
+	S3 S3Config
+}
+
+// New creates the storage selected by cfg.Backend
+func New(cfg StorageConfig) (Storage, error) {
+	switch cfg.Backend {
+	case BackendLocal, "":
+		return NewLocalStorage(cfg.LocalPath)
+	case BackendS3:
+		return NewS3Storage(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown blob storage backend %q", cfg.Backend)
+	}
+}
@@ -72,6 +72,48 @@ func (s *EmployeeService) GetEmployees(ctx context.Context, orgID uuid.UUID) ([]
 	})
 }
 
+func (s *EmployeeService) GetEmployeesByUserIDs(ctx context.Context, orgID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID]*models.Employee, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetEmployeesByUserIDs", func(ctx context.Context, span trace.Span) (map[uuid.UUID]*models.Employee, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.Int("users.count", len(userIDs)),
+		)
+
+		result := make(map[uuid.UUID]*models.Employee, len(userIDs))
+		if len(userIDs) == 0 {
+			return result, nil
+		}
+
+		employees, err := s.queries.GetEmployeesByUserIds(ctx, sqlc.GetEmployeesByUserIdsParams{
+			OrgID:   database.PgUUID(orgID),
+			UserIds: database.PgUUIDs(userIDs),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		for _, employee := range employees {
+			userID := database.UUIDFromPgx(employee.AppUser.ID)
+			result[userID] = &models.Employee{
+				UserID:     userID,
+				Email:      employee.AppUser.Email,
+				FirstName:  employee.AppUser.FirstName,
+				LastName:   employee.AppUser.LastName,
+				MiddleName: database.PgTextPtrFromPgx(employee.AppUser.MiddleName),
+				RoleID:     int(employee.AppRole.ID),
+				Role: &models.Role{
+					ID:          int(employee.AppRole.ID),
+					Name:        models.RoleName(employee.AppRole.Name),
+					Description: employee.AppRole.Description,
+					DisplayName: employee.AppRole.DisplayName,
+				},
+			}
+		}
+
+		return result, nil
+	})
+}
+
 func (s *EmployeeService) GetEmployee(ctx context.Context, orgID, userID uuid.UUID) (*models.Employee, error) {
 	return telemetry.WithTrace(ctx, s.tracer, "GetUserAsEmployeeInOrg", func(ctx context.Context, span trace.Span) (*models.Employee, error) {
 		span.SetAttributes(
@@ -118,3 +160,120 @@ func (s *EmployeeService) GetEmployee(ctx context.Context, orgID, userID uuid.UU
 		}, nil
 	})
 }
+
+func toWorkerProfile(profile sqlc.AppWorkerProfile) *models.WorkerProfile {
+	skills := make([]models.TaskType, len(profile.Skills))
+	for i, skill := range profile.Skills {
+		skills[i] = models.TaskType(skill)
+	}
+
+	return &models.WorkerProfile{
+		UserID:    database.UUIDFromPgx(profile.UserID),
+		UnitIDs:   database.UUIDsFromPgx(profile.UnitIds),
+		Skills:    skills,
+		OnShift:   profile.OnShift,
+		UpdatedAt: profile.UpdatedAt.Time,
+	}
+}
+
+// GetWorkerProfiles returns the worker profiles of the organization keyed by user id
+func (s *EmployeeService) GetWorkerProfiles(ctx context.Context, orgID uuid.UUID) (map[uuid.UUID]*models.WorkerProfile, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetWorkerProfiles", func(ctx context.Context, span trace.Span) (map[uuid.UUID]*models.WorkerProfile, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		profiles, err := s.queries.GetWorkerProfiles(ctx, database.PgUUID(orgID))
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		result := make(map[uuid.UUID]*models.WorkerProfile, len(profiles))
+		for _, profile := range profiles {
+			model := toWorkerProfile(profile)
+			result[model.UserID] = model
+		}
+
+		span.SetAttributes(attribute.Int("response.count", len(result)))
+		return result, nil
+	})
+}
+
+// GetWorkerProfile returns the worker profile of the employee. Employees who
+// never had a profile set get an empty off shift one.
+func (s *EmployeeService) GetWorkerProfile(ctx context.Context, orgID, userID uuid.UUID) (*models.WorkerProfile, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetWorkerProfile", func(ctx context.Context, span trace.Span) (*models.WorkerProfile, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("user.id", userID.String()),
+		)
+
+		profile, err := s.queries.GetWorkerProfile(ctx, sqlc.GetWorkerProfileParams{
+			OrgID:  database.PgUUID(orgID),
+			UserID: database.PgUUID(userID),
+		})
+		if err != nil {
+			if database.IsNotFound(err) {
+				return &models.WorkerProfile{
+					UserID:  userID,
+					UnitIDs: []uuid.UUID{},
+					Skills:  []models.TaskType{},
+				}, nil
+			}
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		return toWorkerProfile(profile), nil
+	})
+}
+
+func (s *EmployeeService) SetWorkerProfile(ctx context.Context, orgID uuid.UUID, profile *models.WorkerProfile) (*models.WorkerProfile, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "SetWorkerProfile", func(ctx context.Context, span trace.Span) (*models.WorkerProfile, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("user.id", profile.UserID.String()),
+			attribute.Bool("worker.on_shift", profile.OnShift),
+		)
+
+		skills := make([]string, len(profile.Skills))
+		for i, skill := range profile.Skills {
+			skills[i] = string(skill)
+		}
+
+		updated, err := s.queries.UpsertWorkerProfile(ctx, sqlc.UpsertWorkerProfileParams{
+			OrgID:   database.PgUUID(orgID),
+			UserID:  database.PgUUID(profile.UserID),
+			UnitIds: database.PgUUIDs(profile.UnitIDs),
+			Skills:  skills,
+			OnShift: profile.OnShift,
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		return toWorkerProfile(updated), nil
+	})
+}
+
+// SetWorkerOnShift starts or ends the shift of the employee keeping the rest
+// of the profile
+func (s *EmployeeService) SetWorkerOnShift(ctx context.Context, orgID, userID uuid.UUID, onShift bool) (*models.WorkerProfile, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "SetWorkerOnShift", func(ctx context.Context, span trace.Span) (*models.WorkerProfile, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("user.id", userID.String()),
+			attribute.Bool("worker.on_shift", onShift),
+		)
+
+		updated, err := s.queries.SetWorkerOnShift(ctx, sqlc.SetWorkerOnShiftParams{
+			OrgID:   database.PgUUID(orgID),
+			UserID:  database.PgUUID(userID),
+			OnShift: onShift,
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		return toWorkerProfile(updated), nil
+	})
+}
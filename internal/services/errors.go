@@ -20,5 +20,11 @@ func MapDbErrorToService(err error) error {
 		return common.ErrDuplicationError
 	}
 
+	// CHECK constraints guard invariants of the stored state, violating one
+	// means the change conflicts with the current state of the object
+	if database.IsCheckViolation(err) {
+		return fmt.Errorf("%w: %w", common.ErrConflict, err)
+	}
+
 	return err
 }
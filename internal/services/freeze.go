@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+)
+
+var ErrCellFrozen = fmt.Errorf("%w: cell is frozen by an inventory count", common.ErrConflict)
+
+// EnsureCellsNotFrozen fails with ErrCellFrozen if any of the cells is covered
+// by an open inventory count task. Nil cell ids are skipped.
+func EnsureCellsNotFrozen(ctx context.Context, queries *sqlc.Queries, orgID uuid.UUID, cellIDs ...*uuid.UUID) error {
+	ids := make([]uuid.UUID, 0, len(cellIDs))
+	for _, id := range cellIDs {
+		if id != nil {
+			ids = append(ids, *id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	frozen, err := queries.GetFrozenCellIds(ctx, sqlc.GetFrozenCellIdsParams{
+		OrgID:   database.PgUUID(orgID),
+		CellIds: database.PgUUIDs(ids),
+	})
+	if err != nil {
+		return MapDbErrorToService(err)
+	}
+	if len(frozen) > 0 {
+		return ErrCellFrozen
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/models"
+)
+
+// gs1Separator is the ASCII group separator scanners send for FNC1
+const gs1Separator = "\x1d"
+
+// gs1SymbologyIdentifiers are the AIM prefixes scanners add to GS1 symbols:
+// GS1-128, GS1 DataBar, GS1 DataMatrix and GS1 QR Code
+var gs1SymbologyIdentifiers = []string{"]C1", "]e0", "]d2", "]Q3"}
+
+type gs1ApplicationIdentifier struct {
+	// Length of fixed length values, 0 for variable length ones
+	length int
+	// Maximum length of variable length values
+	maxLength int
+	numeric   bool
+}
+
+// gs1ApplicationIdentifiers are the supported two digit identifiers. Codes
+// with any other identifier can't be split into elements and are rejected.
+var gs1ApplicationIdentifiers = map[string]gs1ApplicationIdentifier{
+	"00": {length: 18, numeric: true},   // SSCC
+	"01": {length: 14, numeric: true},   // GTIN
+	"02": {length: 14, numeric: true},   // GTIN of contained trade items
+	"10": {maxLength: 20},               // Batch or lot number
+	"11": {length: 6, numeric: true},    // Production date
+	"13": {length: 6, numeric: true},    // Packaging date
+	"15": {length: 6, numeric: true},    // Best before date
+	"16": {length: 6, numeric: true},    // Sell by date
+	"17": {length: 6, numeric: true},    // Expiration date
+	"20": {length: 2, numeric: true},    // Internal product variant
+	"21": {maxLength: 20},               // Serial number
+	"37": {maxLength: 8, numeric: true}, // Count of trade items
+}
+
+type gs1Element struct {
+	ai    string
+	value string
+}
+
+// ParseGS1 parses a GS1 element string in raw form, with FNC1 sent as the
+// group separator, or in the human readable form with parenthesized
+// identifiers. ok is false when the code is not a GS1 one. Raw codes without
+// a symbology identifier or separator are taken as GS1 ones only when they
+// start with a valid GTIN, so articles and aliases are not misread.
+func ParseGS1(code string) (data *models.GS1Data, ok bool, err error) {
+	explicit := false
+	for _, prefix := range gs1SymbologyIdentifiers {
+		if strings.HasPrefix(code, prefix) {
+			code = code[len(prefix):]
+			explicit = true
+			break
+		}
+	}
+	if strings.HasPrefix(code, gs1Separator) {
+		code = code[len(gs1Separator):]
+		explicit = true
+	}
+
+	var elements []gs1Element
+	switch {
+	case strings.HasPrefix(code, "("):
+		explicit = true
+		elements, err = parseGS1Bracketed(code)
+	case explicit || strings.Contains(code, gs1Separator):
+		explicit = true
+		elements, err = parseGS1Raw(code)
+	case strings.HasPrefix(code, "01") && len(code) >= 16 && IsValidGTIN(code[2:16]):
+		elements, err = parseGS1Raw(code)
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		if !explicit {
+			return nil, false, nil
+		}
+		return nil, true, err
+	}
+
+	data, err = toGS1Data(elements)
+	if err != nil {
+		return nil, true, err
+	}
+	return data, true, nil
+}
+
+func parseGS1Raw(code string) ([]gs1Element, error) {
+	var elements []gs1Element
+	for len(code) > 0 {
+		if strings.HasPrefix(code, gs1Separator) {
+			code = code[len(gs1Separator):]
+			continue
+		}
+		if len(code) < 2 {
+			return nil, common.ErrDetailedValidationErrorWithMessage("truncated GS1 application identifier")
+		}
+		ai := code[:2]
+		spec, known := gs1ApplicationIdentifiers[ai]
+		if !known {
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("unsupported GS1 application identifier %s", ai))
+		}
+		code = code[2:]
+
+		var value string
+		if spec.length > 0 {
+			if len(code) < spec.length {
+				return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("GS1 element %s must be %d characters", ai, spec.length))
+			}
+			value, code = code[:spec.length], code[spec.length:]
+		} else {
+			end := strings.Index(code, gs1Separator)
+			if end < 0 {
+				end = len(code)
+			}
+			value, code = code[:end], code[end:]
+		}
+		elements = append(elements, gs1Element{ai: ai, value: value})
+	}
+	return elements, validateGS1Elements(elements)
+}
+
+func parseGS1Bracketed(code string) ([]gs1Element, error) {
+	var elements []gs1Element
+	for len(code) > 0 {
+		end := strings.Index(code, ")")
+		if !strings.HasPrefix(code, "(") || end < 0 {
+			return nil, common.ErrDetailedValidationErrorWithMessage("malformed GS1 application identifier")
+		}
+		ai := code[1:end]
+		if _, known := gs1ApplicationIdentifiers[ai]; !known {
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("unsupported GS1 application identifier %s", ai))
+		}
+		code = code[end+1:]
+
+		next := strings.Index(code, "(")
+		if next < 0 {
+			next = len(code)
+		}
+		elements = append(elements, gs1Element{ai: ai, value: code[:next]})
+		code = code[next:]
+	}
+	return elements, validateGS1Elements(elements)
+}
+
+func validateGS1Elements(elements []gs1Element) error {
+	if len(elements) == 0 {
+		return common.ErrDetailedValidationErrorWithMessage("empty GS1 code")
+	}
+	for _, element := range elements {
+		spec := gs1ApplicationIdentifiers[element.ai]
+		switch {
+		case spec.length > 0 && len(element.value) != spec.length:
+			return common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("GS1 element %s must be %d characters", element.ai, spec.length))
+		case spec.length == 0 && (element.value == "" || len(element.value) > spec.maxLength):
+			return common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("GS1 element %s must be 1 to %d characters", element.ai, spec.maxLength))
+		case spec.numeric && !isDigits(element.value):
+			return common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("GS1 element %s must be numeric", element.ai))
+		}
+	}
+	return nil
+}
+
+func toGS1Data(elements []gs1Element) (*models.GS1Data, error) {
+	data := &models.GS1Data{}
+	var bestBefore *time.Time
+	for _, element := range elements {
+		value := element.value
+		switch element.ai {
+		case "01":
+			if !IsValidGTIN(value) {
+				return nil, common.ErrDetailedValidationErrorWithMessage("invalid GTIN check digit")
+			}
+			data.GTIN = &value
+		case "10":
+			data.Lot.LotNumber = &value
+		case "11", "15", "17":
+			date, err := parseGS1Date(value)
+			if err != nil {
+				return nil, err
+			}
+			switch element.ai {
+			case "11":
+				data.Lot.ManufacturedAt = &date
+			case "15":
+				bestBefore = &date
+			case "17":
+				data.Lot.ExpiresAt = &date
+			}
+		case "21":
+			data.SerialNumber = &value
+		}
+	}
+	if data.Lot.ExpiresAt == nil {
+		data.Lot.ExpiresAt = bestBefore
+	}
+	if err := ValidateItemLot(data.Lot); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// parseGS1Date parses a YYMMDD date. A zero day stands for the last day of the
+// month. Years are taken in the 2000s, older dates are of no use for stock.
+func parseGS1Date(value string) (time.Time, error) {
+	year, _ := strconv.Atoi(value[0:2])
+	month, _ := strconv.Atoi(value[2:4])
+	day, _ := strconv.Atoi(value[4:6])
+	if month < 1 || month > 12 {
+		return time.Time{}, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("invalid GS1 date %s", value))
+	}
+	if day == 0 {
+		return time.Date(2000+year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC), nil
+	}
+	date := time.Date(2000+year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day {
+		return time.Time{}, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("invalid GS1 date %s", value))
+	}
+	return date, nil
+}
+
+// IsValidGTIN checks the length and the check digit of a GTIN-8, GTIN-12,
+// EAN-13 or GTIN-14
+func IsValidGTIN(gtin string) bool {
+	switch len(gtin) {
+	case 8, 12, 13, 14:
+	default:
+		return false
+	}
+	if !isDigits(gtin) {
+		return false
+	}
+	sum := 0
+	for i := len(gtin) - 2; i >= 0; i-- {
+		digit := int(gtin[i] - '0')
+		if (len(gtin)-2-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return (10-sum%10)%10 == int(gtin[len(gtin)-1]-'0')
+}
+
+// GTINToEAN13 returns the EAN-13 stored on variants for a GTIN-14. GTINs
+// with a packaging indicator have no EAN-13 counterpart.
+func GTINToEAN13(gtin string) (int64, bool) {
+	if len(gtin) != 14 || gtin[0] != '0' {
+		return 0, false
+	}
+	ean13, err := strconv.ParseInt(gtin[1:], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return ean13, true
+}
+
+func isDigits(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
@@ -3,10 +3,12 @@ package item
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
 	"github.com/let-store-it/backend/internal/database"
 	"github.com/let-store-it/backend/internal/models"
 	"github.com/let-store-it/backend/internal/services"
@@ -222,14 +224,56 @@ func (s *ItemService) DeleteItem(ctx context.Context, orgID uuid.UUID, id uuid.U
 
 // Item Variants
 
+func validateTrackingMode(mode *models.ItemTrackingMode) error {
+	switch *mode {
+	case "":
+		*mode = models.ItemTrackingModeSerialized
+	case models.ItemTrackingModeSerialized, models.ItemTrackingModeQuantity:
+	default:
+		return common.ErrDetailedValidationErrorWithMessage("invalid tracking mode")
+	}
+	return nil
+}
+
+func validateRequiresSerial(variant *models.ItemVariant) error {
+	if variant.RequiresSerial && variant.TrackingMode != models.ItemTrackingModeSerialized {
+		return common.ErrDetailedValidationErrorWithMessage("only serialized variants can require serial numbers")
+	}
+	return nil
+}
+
+func validateAllocationPolicy(policy *models.AllocationPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	switch *policy {
+	case models.AllocationPolicyFIFO, models.AllocationPolicyFEFO:
+		return nil
+	}
+	return common.ErrDetailedValidationErrorWithMessage("invalid allocation policy")
+}
+
 func (s *ItemService) CreateItemVariant(ctx context.Context, orgID uuid.UUID, variant *models.ItemVariant) (*models.ItemVariant, error) {
 	return telemetry.WithTrace(ctx, s.tracer, "CreateItemVariant", func(ctx context.Context, span trace.Span) (*models.ItemVariant, error) {
+		if err := validateTrackingMode(&variant.TrackingMode); err != nil {
+			return nil, err
+		}
+		if err := validateAllocationPolicy(variant.AllocationPolicy); err != nil {
+			return nil, err
+		}
+		if err := validateRequiresSerial(variant); err != nil {
+			return nil, err
+		}
+
 		createdVariant, err := s.queries.CreateItemVariant(ctx, sqlc.CreateItemVariantParams{
-			OrgID:   database.PgUUID(orgID),
-			ItemID:  database.PgUUID(variant.ItemID),
-			Name:    variant.Name,
-			Article: database.PgTextPtr(variant.Article),
-			Ean13:   database.PgInt8Ptr(variant.EAN13),
+			OrgID:            database.PgUUID(orgID),
+			ItemID:           database.PgUUID(variant.ItemID),
+			Name:             variant.Name,
+			Article:          database.PgTextPtr(variant.Article),
+			Ean13:            database.PgInt8Ptr(variant.EAN13),
+			TrackingMode:     sqlc.ItemTrackingMode(variant.TrackingMode),
+			AllocationPolicy: toNullAllocationPolicy(variant.AllocationPolicy),
+			RequiresSerial:   variant.RequiresSerial,
 		})
 		if err != nil {
 			return nil, services.MapDbErrorToService(err)
@@ -310,6 +354,73 @@ func (s *ItemService) GetItemVariantById(ctx context.Context, orgID uuid.UUID, i
 	})
 }
 
+// GetItemVariantsByIDs returns variants of any items keyed by variant id.
+// Deleted and missing variants are absent from the result.
+func (s *ItemService) GetItemVariantsByIDs(ctx context.Context, orgID uuid.UUID, variantIDs []uuid.UUID) (map[uuid.UUID]*models.ItemVariant, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetItemVariantsByIDs", func(ctx context.Context, span trace.Span) (map[uuid.UUID]*models.ItemVariant, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.Int("variants.count", len(variantIDs)),
+		)
+
+		result := make(map[uuid.UUID]*models.ItemVariant, len(variantIDs))
+		if len(variantIDs) == 0 {
+			return result, nil
+		}
+
+		variants, err := s.queries.GetItemVariantsByIds(ctx, sqlc.GetItemVariantsByIdsParams{
+			OrgID: database.PgUUID(orgID),
+			Ids:   database.PgUUIDs(variantIDs),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		for _, variant := range variants {
+			model := toItemVariantModel(variant)
+			result[model.ID] = model
+		}
+
+		return result, nil
+	})
+}
+
+// GetItemVariantsByBarcode returns variants of any items with the EAN-13 or
+// the article given. Both are looked up in one query using their indexes.
+func (s *ItemService) GetItemVariantsByBarcode(ctx context.Context, orgID uuid.UUID, ean13 *int64, article *string) ([]*models.ItemVariant, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetItemVariantsByBarcode", func(ctx context.Context, span trace.Span) ([]*models.ItemVariant, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		params := sqlc.GetItemVariantsByBarcodesParams{
+			OrgID:    database.PgUUID(orgID),
+			Ean13s:   []int64{},
+			Articles: []string{},
+		}
+		if ean13 != nil {
+			params.Ean13s = append(params.Ean13s, *ean13)
+		}
+		if article != nil {
+			params.Articles = append(params.Articles, *article)
+		}
+		if len(params.Ean13s) == 0 && len(params.Articles) == 0 {
+			return []*models.ItemVariant{}, nil
+		}
+
+		variants, err := s.queries.GetItemVariantsByBarcodes(ctx, params)
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		result := make([]*models.ItemVariant, len(variants))
+		for i, variant := range variants {
+			result[i] = toItemVariantModel(variant)
+		}
+		return result, nil
+	})
+}
+
 func (s *ItemService) UpdateItemVariant(ctx context.Context, orgID uuid.UUID, variant *models.ItemVariant) (*models.ItemVariant, error) {
 	return telemetry.WithTrace(ctx, s.tracer, "UpdateItemVariant", func(ctx context.Context, span trace.Span) (*models.ItemVariant, error) {
 		variantBeforeUpdate, err := s.GetItemVariantById(ctx, orgID, variant.ItemID, variant.ID)
@@ -317,13 +428,43 @@ func (s *ItemService) UpdateItemVariant(ctx context.Context, orgID uuid.UUID, va
 			return nil, services.MapDbErrorToService(err)
 		}
 
+		// The tracking mode is kept unless given, and only changes while the
+		// variant has no stock in the other mode
+		if variant.TrackingMode == "" {
+			variant.TrackingMode = variantBeforeUpdate.TrackingMode
+		}
+		if err := validateTrackingMode(&variant.TrackingMode); err != nil {
+			return nil, err
+		}
+		if err := validateAllocationPolicy(variant.AllocationPolicy); err != nil {
+			return nil, err
+		}
+		if err := validateRequiresSerial(variant); err != nil {
+			return nil, err
+		}
+		if variant.TrackingMode != variantBeforeUpdate.TrackingMode {
+			hasStock, err := s.queries.ItemVariantHasStock(ctx, sqlc.ItemVariantHasStockParams{
+				OrgID:     database.PgUUID(orgID),
+				VariantID: database.PgUUID(variant.ID),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+			if hasStock {
+				return nil, ErrTrackingModeInUse
+			}
+		}
+
 		updatedVariant, err := s.queries.UpdateItemVariant(ctx, sqlc.UpdateItemVariantParams{
-			OrgID:   database.PgUUID(orgID),
-			ItemID:  database.PgUUID(variant.ItemID),
-			ID:      database.PgUUID(variant.ID),
-			Name:    variant.Name,
-			Article: database.PgTextPtr(variant.Article),
-			Ean13:   database.PgInt8Ptr(variant.EAN13),
+			OrgID:            database.PgUUID(orgID),
+			ItemID:           database.PgUUID(variant.ItemID),
+			ID:               database.PgUUID(variant.ID),
+			Name:             variant.Name,
+			Article:          database.PgTextPtr(variant.Article),
+			Ean13:            database.PgInt8Ptr(variant.EAN13),
+			TrackingMode:     sqlc.ItemTrackingMode(variant.TrackingMode),
+			AllocationPolicy: toNullAllocationPolicy(variant.AllocationPolicy),
+			RequiresSerial:   variant.RequiresSerial,
 		})
 		if err != nil {
 			return nil, services.MapDbErrorToService(err)
@@ -351,18 +492,11 @@ func (s *ItemService) CreateItemInstance(ctx context.Context, itemInstance *mode
 			attribute.String("cell.id", itemInstance.CellID.String()),
 		)
 
-		createdInstance, err := s.queries.CreateItemInstance(ctx, sqlc.CreateItemInstanceParams{
-			OrgID:     database.PgUUID(itemInstance.OrgID),
-			ItemID:    database.PgUUID(itemInstance.ItemID),
-			VariantID: database.PgUUID(itemInstance.VariantID),
-			CellID:    database.PgUUIDPtr(itemInstance.CellID),
-			Status:    sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
-		})
+		model, err := s.CreateItemInstanceInTx(ctx, s.queries, itemInstance)
 		if err != nil {
-			return nil, services.MapDbErrorToService(err)
+			return nil, err
 		}
 
-		model := toItemInstance(createdInstance)
 		err = s.auditService.CreateObjectChange(ctx, &models.ObjectChangeCreate{
 			Action:           models.ObjectChangeActionCreate,
 			TargetObjectType: models.ObjectTypeItemInstance,
@@ -373,7 +507,7 @@ func (s *ItemService) CreateItemInstance(ctx context.Context, itemInstance *mode
 			return nil, fmt.Errorf("failed to create audit log: %w", err)
 		}
 
-		result, err := s.GetItemInstanceFull(ctx, itemInstance.OrgID, database.UUIDFromPgx(createdInstance.ID))
+		result, err := s.GetItemInstanceFull(ctx, itemInstance.OrgID, model.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -382,6 +516,47 @@ func (s *ItemService) CreateItemInstance(ctx context.Context, itemInstance *mode
 	})
 }
 
+// CreateItemInstanceInTx validates and creates an available instance with
+// qtx of the caller's transaction, optionally marked as affected by a task.
+// The instance is not audited, the caller audits it once committed.
+func (s *ItemService) CreateItemInstanceInTx(ctx context.Context, qtx *sqlc.Queries, itemInstance *models.ItemInstance) (*models.ItemInstance, error) {
+	variant, err := s.ensureTrackingMode(ctx, itemInstance.OrgID, itemInstance.VariantID, models.ItemTrackingModeSerialized)
+	if err != nil {
+		return nil, err
+	}
+	if err := services.ValidateSerialNumber(itemInstance.SerialNumber, variant); err != nil {
+		return nil, err
+	}
+	if err := services.ValidateItemLot(models.ItemLot{
+		LotNumber:      itemInstance.LotNumber,
+		ManufacturedAt: itemInstance.ManufacturedAt,
+		ExpiresAt:      itemInstance.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+	if err := services.EnsureCellsNotFrozen(ctx, qtx, itemInstance.OrgID, itemInstance.CellID); err != nil {
+		return nil, err
+	}
+
+	createdInstance, err := qtx.CreateItemInstance(ctx, sqlc.CreateItemInstanceParams{
+		OrgID:            database.PgUUID(itemInstance.OrgID),
+		ItemID:           database.PgUUID(itemInstance.ItemID),
+		VariantID:        database.PgUUID(itemInstance.VariantID),
+		CellID:           database.PgUUIDPtr(itemInstance.CellID),
+		Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
+		AffectedByTaskID: database.PgUUIDPtr(itemInstance.AffectedByTaskID),
+		SerialNumber:     database.PgTextPtr(itemInstance.SerialNumber),
+		LotNumber:        database.PgTextPtr(itemInstance.LotNumber),
+		ManufacturedAt:   database.PgDatePtr(itemInstance.ManufacturedAt),
+		ExpiresAt:        database.PgDatePtr(itemInstance.ExpiresAt),
+	})
+	if err != nil {
+		return nil, services.MapSerialNumberError(err)
+	}
+
+	return toItemInstance(createdInstance), nil
+}
+
 func (s *ItemService) GetItemInstances(ctx context.Context, orgID uuid.UUID, itemID uuid.UUID) ([]*models.ItemInstance, error) {
 	return telemetry.WithTrace(ctx, s.tracer, "GetItemInstances", func(ctx context.Context, span trace.Span) ([]*models.ItemInstance, error) {
 		instances, err := s.queries.GetItemInstancesForItem(ctx, sqlc.GetItemInstancesForItemParams{
@@ -448,6 +623,75 @@ func (s *ItemService) GetItemInstanceFull(ctx context.Context, orgID uuid.UUID,
 	})
 }
 
+// GetItemInstancesFull is a batched GetItemInstanceFull. Items are loaded with
+// their variants but without the item instance list.
+func (s *ItemService) GetItemInstancesFull(ctx context.Context, orgID uuid.UUID, instanceIDs []uuid.UUID) (map[uuid.UUID]*models.ItemInstance, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetItemInstancesFull", func(ctx context.Context, span trace.Span) (map[uuid.UUID]*models.ItemInstance, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.Int("instances.count", len(instanceIDs)),
+		)
+
+		result := make(map[uuid.UUID]*models.ItemInstance, len(instanceIDs))
+		if len(instanceIDs) == 0 {
+			return result, nil
+		}
+
+		rows, err := s.queries.GetItemInstancesWithItemByIds(ctx, sqlc.GetItemInstancesWithItemByIdsParams{
+			OrgID: database.PgUUID(orgID),
+			Ids:   database.PgUUIDs(instanceIDs),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		items := make(map[uuid.UUID]*models.Item)
+		itemIDs := make([]uuid.UUID, 0)
+		cellIDs := make([]uuid.UUID, 0)
+		for _, row := range rows {
+			instance := toItemInstance(row.ItemInstance)
+			instance.Variant = toItemVariantModel(row.ItemVariant)
+
+			item, ok := items[instance.ItemID]
+			if !ok {
+				item = toItemModel(toItemModelParams{item: row.Item})
+				items[instance.ItemID] = item
+				itemIDs = append(itemIDs, instance.ItemID)
+			}
+			instance.Item = item
+
+			if instance.CellID != nil {
+				cellIDs = append(cellIDs, *instance.CellID)
+			}
+			result[instance.ID] = instance
+		}
+
+		variants, err := s.queries.GetItemVariantsByItemIds(ctx, sqlc.GetItemVariantsByItemIdsParams{
+			OrgID:   database.PgUUID(orgID),
+			ItemIds: database.PgUUIDs(itemIDs),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+		for _, variant := range variants {
+			item := items[database.UUIDFromPgx(variant.ItemID)]
+			item.Variants = append(item.Variants, toItemVariantModel(variant))
+		}
+
+		cells, err := s.storageService.GetCellsFull(ctx, orgID, cellIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range result {
+			if instance.CellID != nil {
+				instance.Cell = cells[*instance.CellID]
+			}
+		}
+
+		return result, nil
+	})
+}
+
 func (s *ItemService) SetItemInstanceStatus(ctx context.Context, itemInstance *models.ItemInstance) error {
 	return telemetry.WithVoidTrace(ctx, s.tracer, "SetItemInstanceStatus", func(ctx context.Context, span trace.Span) error {
 		span.SetAttributes(
@@ -542,14 +786,37 @@ func (s *ItemService) UpdateItemInstance(ctx context.Context, orgID uuid.UUID, i
 			return nil, services.MapDbErrorToService(err)
 		}
 
+		if err := services.EnsureCellsNotFrozen(ctx, s.queries, orgID, instanceBeforeUpdate.CellID, itemInstance.CellID); err != nil {
+			return nil, err
+		}
+
+		variant, err := s.ensureTrackingMode(ctx, orgID, itemInstance.VariantID, models.ItemTrackingModeSerialized)
+		if err != nil {
+			return nil, err
+		}
+		if err := services.ValidateSerialNumber(itemInstance.SerialNumber, variant); err != nil {
+			return nil, err
+		}
+		if err := services.ValidateItemLot(models.ItemLot{
+			LotNumber:      itemInstance.LotNumber,
+			ManufacturedAt: itemInstance.ManufacturedAt,
+			ExpiresAt:      itemInstance.ExpiresAt,
+		}); err != nil {
+			return nil, err
+		}
+
 		instance, err := s.queries.UpdateItemInstance(ctx, sqlc.UpdateItemInstanceParams{
-			OrgID:     database.PgUUID(orgID),
-			ID:        database.PgUUID(itemInstance.ID),
-			CellID:    database.PgUUIDPtr(itemInstance.CellID),
-			VariantID: database.PgUUID(itemInstance.VariantID),
+			OrgID:          database.PgUUID(orgID),
+			ID:             database.PgUUID(itemInstance.ID),
+			CellID:         database.PgUUIDPtr(itemInstance.CellID),
+			VariantID:      database.PgUUID(itemInstance.VariantID),
+			SerialNumber:   database.PgTextPtr(itemInstance.SerialNumber),
+			LotNumber:      database.PgTextPtr(itemInstance.LotNumber),
+			ManufacturedAt: database.PgDatePtr(itemInstance.ManufacturedAt),
+			ExpiresAt:      database.PgDatePtr(itemInstance.ExpiresAt),
 		})
 		if err != nil {
-			return nil, services.MapDbErrorToService(err)
+			return nil, services.MapSerialNumberError(err)
 		}
 
 		model := toItemInstance(instance)
@@ -580,6 +847,10 @@ func (s *ItemService) DeleteItemInstance(ctx context.Context, orgID uuid.UUID, i
 			return services.MapDbErrorToService(err)
 		}
 
+		if err := services.EnsureCellsNotFrozen(ctx, s.queries, orgID, database.UUIDPtrFromPgx(instanceBeforeDelete.CellID)); err != nil {
+			return err
+		}
+
 		err = s.queries.DeleteItemInstance(ctx, sqlc.DeleteItemInstanceParams{
 			ID:    database.PgUUID(instanceID),
 			OrgID: database.PgUUID(orgID),
@@ -592,7 +863,7 @@ func (s *ItemService) DeleteItemInstance(ctx context.Context, orgID uuid.UUID, i
 			Action:           models.ObjectChangeActionDelete,
 			TargetObjectType: models.ObjectTypeItemInstance,
 			TargetObjectID:   instanceID,
-			PrechangeState:   instanceBeforeDelete,
+			PrechangeState:   toItemInstance(instanceBeforeDelete),
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create audit log: %w", err)
@@ -602,6 +873,52 @@ func (s *ItemService) DeleteItemInstance(ctx context.Context, orgID uuid.UUID, i
 	})
 }
 
+const maxSerialNumberMatches = 100
+
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchItemInstancesBySerialNumber looks instances up by the exact serial
+// number or by a part of it. Serial numbers are unique per variant only, so
+// an exact lookup can match instances of several variants.
+func (s *ItemService) SearchItemInstancesBySerialNumber(ctx context.Context, orgID uuid.UUID, serialNumber *string, search *string) ([]*models.ItemInstance, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "SearchItemInstancesBySerialNumber", func(ctx context.Context, span trace.Span) ([]*models.ItemInstance, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		if (serialNumber == nil || *serialNumber == "") && (search == nil || *search == "") {
+			return nil, common.ErrDetailedValidationErrorWithMessage("serial number or search is required")
+		}
+
+		params := sqlc.SearchItemInstanceIdsBySerialNumberParams{
+			OrgID:        database.PgUUID(orgID),
+			SerialNumber: database.PgTextPtr(serialNumber),
+			MaxRows:      maxSerialNumberMatches,
+		}
+		if search != nil && *search != "" {
+			params.Pattern = database.PgText("%" + likePatternEscaper.Replace(*search) + "%")
+		}
+		ids, err := s.queries.SearchItemInstanceIdsBySerialNumber(ctx, params)
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		instanceIDs := database.UUIDsFromPgx(ids)
+		instances, err := s.GetItemInstancesFull(ctx, orgID, instanceIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*models.ItemInstance, 0, len(instanceIDs))
+		for _, id := range instanceIDs {
+			if instance, ok := instances[id]; ok {
+				result = append(result, instance)
+			}
+		}
+		return result, nil
+	})
+}
+
 func (s *ItemService) GetItemInstancesAll(ctx context.Context, orgID uuid.UUID) ([]*models.ItemInstance, error) {
 	return telemetry.WithTrace(ctx, s.tracer, "GetItemInstancesAll", func(ctx context.Context, span trace.Span) ([]*models.ItemInstance, error) {
 		instances, err := s.queries.GetItemInstancesAll(ctx, database.PgUUID(orgID))
@@ -15,7 +15,26 @@ func toItemVariantModel(variant sqlc.ItemVariant) *models.ItemVariant {
 		EAN13:     database.PgInt64PtrFromPgx(variant.Ean13),
 		CreatedAt: variant.CreatedAt.Time,
 		DeletedAt: database.PgTimePtrFromPgx(variant.DeletedAt),
+
+		TrackingMode:     models.ItemTrackingMode(variant.TrackingMode),
+		AllocationPolicy: toAllocationPolicyPtr(variant.AllocationPolicy),
+		RequiresSerial:   variant.RequiresSerial,
+	}
+}
+
+func toAllocationPolicyPtr(policy sqlc.NullAllocationPolicy) *models.AllocationPolicy {
+	if !policy.Valid {
+		return nil
 	}
+	result := models.AllocationPolicy(policy.AllocationPolicy)
+	return &result
+}
+
+func toNullAllocationPolicy(policy *models.AllocationPolicy) sqlc.NullAllocationPolicy {
+	if policy == nil {
+		return sqlc.NullAllocationPolicy{}
+	}
+	return sqlc.NullAllocationPolicy{AllocationPolicy: sqlc.AllocationPolicy(*policy), Valid: true}
 }
 
 type toItemModelParams struct {
@@ -59,6 +78,10 @@ func toItemInstance(instance sqlc.ItemInstance) *models.ItemInstance {
 		CellID:           database.UUIDPtrFromPgx(instance.CellID),
 		Status:           models.ItemInstanceStatus(instance.Status),
 		AffectedByTaskID: database.UUIDPtrFromPgx(instance.AffectedByTaskID),
+		SerialNumber:     database.PgTextPtrFromPgx(instance.SerialNumber),
+		LotNumber:        database.PgTextPtrFromPgx(instance.LotNumber),
+		ManufacturedAt:   database.PgDatePtrFromPgx(instance.ManufacturedAt),
+		ExpiresAt:        database.PgDatePtrFromPgx(instance.ExpiresAt),
 	}
 }
 
@@ -70,6 +93,11 @@ func toItemInstanceModel(instance sqlc.ItemInstance) *models.ItemInstance {
 		VariantID: database.UUIDFromPgx(instance.VariantID),
 		CellID:    database.UUIDPtrFromPgx(instance.CellID),
 		Status:    models.ItemInstanceStatus(instance.Status),
+
+		SerialNumber:   database.PgTextPtrFromPgx(instance.SerialNumber),
+		LotNumber:      database.PgTextPtrFromPgx(instance.LotNumber),
+		ManufacturedAt: database.PgDatePtrFromPgx(instance.ManufacturedAt),
+		ExpiresAt:      database.PgDatePtrFromPgx(instance.ExpiresAt),
 	}
 }
 
@@ -80,3 +108,17 @@ func toItemInstancesModel(instances []sqlc.ItemInstance) []*models.ItemInstance
 	}
 	return instancesModels
 }
+
+func toStockLine(line sqlc.StockLine) *models.StockLine {
+	return &models.StockLine{
+		ID:               database.UUIDFromPgx(line.ID),
+		OrgID:            database.UUIDFromPgx(line.OrgID),
+		ItemID:           database.UUIDFromPgx(line.ItemID),
+		VariantID:        database.UUIDFromPgx(line.VariantID),
+		CellID:           database.UUIDPtrFromPgx(line.CellID),
+		Status:           models.ItemInstanceStatus(line.Status),
+		Quantity:         int(line.Quantity),
+		AffectedByTaskID: database.UUIDPtrFromPgx(line.AffectedByTaskID),
+		CreatedAt:        line.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,405 @@
+package item
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrTrackingModeInUse      = fmt.Errorf("%w: tracking mode cannot change while the variant has stock", common.ErrConflict)
+	ErrStockLineNotAvailable  = fmt.Errorf("%w: stock line is not available", common.ErrConflict)
+	ErrStockLinesNotMergeable = fmt.Errorf("%w: only available lines of the same variant in the same cell can be merged", common.ErrConflict)
+	ErrStockLineInOpenTask    = fmt.Errorf("%w: stock line is used by an open task", common.ErrConflict)
+)
+
+// maxMergedStockLines bounds the number of lines merged at once
+const maxMergedStockLines = 100
+
+// ensureTrackingMode checks that the variant stores its stock in mode, so
+// serialized variants only get instances and quantity tracked ones lines
+func (s *ItemService) ensureTrackingMode(ctx context.Context, orgID uuid.UUID, variantID uuid.UUID, mode models.ItemTrackingMode) (*models.ItemVariant, error) {
+	variants, err := s.GetItemVariantsByIDs(ctx, orgID, []uuid.UUID{variantID})
+	if err != nil {
+		return nil, err
+	}
+	variant, ok := variants[variantID]
+	if !ok {
+		return nil, fmt.Errorf("failed to get variant: %w", common.ErrNotFound)
+	}
+	if variant.TrackingMode == mode {
+		return variant, nil
+	}
+	if mode == models.ItemTrackingModeQuantity {
+		return nil, common.ErrDetailedValidationErrorWithMessage("variant is serialized, its stock is kept as instances")
+	}
+	return nil, common.ErrDetailedValidationErrorWithMessage("variant is quantity tracked, its stock is kept as stock lines")
+}
+
+// CreateStockLine puts quantity of a quantity tracked variant available in
+// the cell. The item of the line is taken from the variant.
+func (s *ItemService) CreateStockLine(ctx context.Context, line *models.StockLine) (*models.StockLine, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CreateStockLine", func(ctx context.Context, span trace.Span) (*models.StockLine, error) {
+		span.SetAttributes(
+			attribute.String("org.id", line.OrgID.String()),
+			attribute.String("variant.id", line.VariantID.String()),
+			attribute.Int("quantity", line.Quantity),
+		)
+
+		if line.Quantity <= 0 {
+			return nil, common.ErrDetailedValidationErrorWithMessage("quantity must be positive")
+		}
+		if line.CellID == nil {
+			return nil, common.ErrDetailedValidationErrorWithMessage("cell is required for stock lines")
+		}
+		variant, err := s.ensureTrackingMode(ctx, line.OrgID, line.VariantID, models.ItemTrackingModeQuantity)
+		if err != nil {
+			return nil, err
+		}
+		line.ItemID = variant.ItemID
+		if err := services.EnsureCellsNotFrozen(ctx, s.queries, line.OrgID, line.CellID); err != nil {
+			return nil, err
+		}
+
+		created, err := s.queries.CreateStockLine(ctx, sqlc.CreateStockLineParams{
+			OrgID:     database.PgUUID(line.OrgID),
+			ItemID:    database.PgUUID(line.ItemID),
+			VariantID: database.PgUUID(line.VariantID),
+			CellID:    database.PgUUIDPtr(line.CellID),
+			Status:    sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
+			Quantity:  int32(line.Quantity),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		model := toStockLine(created)
+		err = s.auditService.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionCreate,
+			TargetObjectType: models.ObjectTypeStockLine,
+			TargetObjectID:   model.ID,
+			PostchangeState:  model,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create audit log: %w", err)
+		}
+
+		return s.GetStockLineById(ctx, line.OrgID, model.ID)
+	})
+}
+
+func (s *ItemService) GetStockLineById(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (*models.StockLine, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetStockLineById", func(ctx context.Context, span trace.Span) (*models.StockLine, error) {
+		lines, err := s.GetStockLinesFull(ctx, orgID, []uuid.UUID{id})
+		if err != nil {
+			return nil, err
+		}
+		line, ok := lines[id]
+		if !ok {
+			return nil, fmt.Errorf("failed to get stock line: %w", common.ErrNotFound)
+		}
+		return line, nil
+	})
+}
+
+func (s *ItemService) GetStockLines(ctx context.Context, orgID uuid.UUID, filter *models.StockLineFilter) ([]*models.StockLine, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetStockLines", func(ctx context.Context, span trace.Span) ([]*models.StockLine, error) {
+		span.SetAttributes(attribute.String("org.id", orgID.String()))
+
+		params := sqlc.GetStockLinesParams{
+			OrgID:     database.PgUUID(orgID),
+			ItemID:    database.PgUUIDPtr(filter.ItemID),
+			VariantID: database.PgUUIDPtr(filter.VariantID),
+			CellID:    database.PgUUIDPtr(filter.CellID),
+		}
+		if filter.Status != nil {
+			params.Status = sqlc.NullItemInstanceStatus{
+				ItemInstanceStatus: sqlc.ItemInstanceStatus(*filter.Status),
+				Valid:              true,
+			}
+		}
+
+		rows, err := s.queries.GetStockLines(ctx, params)
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		ids := make([]uuid.UUID, len(rows))
+		for i, row := range rows {
+			ids[i] = database.UUIDFromPgx(row.ID)
+		}
+		lines, err := s.GetStockLinesFull(ctx, orgID, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		res := make([]*models.StockLine, 0, len(ids))
+		for _, id := range ids {
+			if line, ok := lines[id]; ok {
+				res = append(res, line)
+			}
+		}
+		return res, nil
+	})
+}
+
+// GetStockLinesFull loads stock lines with their item, variant and cell.
+// Items are loaded without their variants and instances.
+func (s *ItemService) GetStockLinesFull(ctx context.Context, orgID uuid.UUID, ids []uuid.UUID) (map[uuid.UUID]*models.StockLine, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetStockLinesFull", func(ctx context.Context, span trace.Span) (map[uuid.UUID]*models.StockLine, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.Int("stock_lines.count", len(ids)),
+		)
+
+		result := make(map[uuid.UUID]*models.StockLine, len(ids))
+		if len(ids) == 0 {
+			return result, nil
+		}
+
+		rows, err := s.queries.GetStockLinesWithItemByIds(ctx, sqlc.GetStockLinesWithItemByIdsParams{
+			OrgID: database.PgUUID(orgID),
+			Ids:   database.PgUUIDs(ids),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		cellIDs := make([]uuid.UUID, 0, len(rows))
+		for _, row := range rows {
+			line := toStockLine(row.StockLine)
+			line.Variant = toItemVariantModel(row.ItemVariant)
+			line.Item = toItemModel(toItemModelParams{item: row.Item})
+			if line.CellID != nil {
+				cellIDs = append(cellIDs, *line.CellID)
+			}
+			result[line.ID] = line
+		}
+
+		cells, err := s.storageService.GetCellsFull(ctx, orgID, cellIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range result {
+			if line.CellID != nil {
+				line.Cell = cells[*line.CellID]
+			}
+		}
+
+		return result, nil
+	})
+}
+
+// SplitStockLine takes quantity off an available line into a new line in
+// the same cell. The new line is returned.
+func (s *ItemService) SplitStockLine(ctx context.Context, orgID uuid.UUID, id uuid.UUID, quantity int) (*models.StockLine, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "SplitStockLine", func(ctx context.Context, span trace.Span) (*models.StockLine, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("stock_line.id", id.String()),
+			attribute.Int("quantity", quantity),
+		)
+
+		if quantity <= 0 {
+			return nil, common.ErrDetailedValidationErrorWithMessage("quantity must be positive")
+		}
+
+		before, err := s.GetStockLineById(ctx, orgID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		created, err := database.WithTransaction(ctx, s.pgxPool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.StockLine, error) {
+			qtx := s.queries.WithTx(tx)
+
+			line, err := qtx.GetStockLineForUpdate(ctx, sqlc.GetStockLineForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(id),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+			if models.ItemInstanceStatus(line.Status) != models.ItemInstanceStatusAvailable {
+				return nil, ErrStockLineNotAvailable
+			}
+			if quantity >= int(line.Quantity) {
+				return nil, common.ErrDetailedValidationErrorWithMessage("split quantity must be less than the line quantity")
+			}
+			if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, database.UUIDPtrFromPgx(line.CellID)); err != nil {
+				return nil, err
+			}
+
+			created, err := services.SplitStockLine(ctx, qtx, line, int32(quantity), line.Status, line.AffectedByTaskID)
+			if err != nil {
+				return nil, err
+			}
+			return toStockLine(created), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		after, err := s.GetStockLinesFull(ctx, orgID, []uuid.UUID{id, created.ID})
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.auditService.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeStockLine,
+			TargetObjectID:   id,
+			PrechangeState:   before,
+			PostchangeState:  after[id],
+		})
+		if err != nil {
+			return nil, err
+		}
+		err = s.auditService.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionCreate,
+			TargetObjectType: models.ObjectTypeStockLine,
+			TargetObjectID:   created.ID,
+			PostchangeState:  after[created.ID],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return after[created.ID], nil
+	})
+}
+
+// MergeStockLines adds the quantities of the lines to the first one and
+// deletes the others. Lines must be available, of the same variant, in the
+// same cell and not used by open tasks.
+func (s *ItemService) MergeStockLines(ctx context.Context, orgID uuid.UUID, ids []uuid.UUID) (*models.StockLine, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "MergeStockLines", func(ctx context.Context, span trace.Span) (*models.StockLine, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.Int("stock_lines.count", len(ids)),
+		)
+
+		if len(ids) < 2 {
+			return nil, common.ErrDetailedValidationErrorWithMessage("at least two stock lines are required")
+		}
+		if len(ids) > maxMergedStockLines {
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("at most %d stock lines can be merged at once", maxMergedStockLines))
+		}
+		seen := make(map[uuid.UUID]bool, len(ids))
+		for _, id := range ids {
+			if seen[id] {
+				return nil, common.ErrDetailedValidationErrorWithMessage("stock lines must be distinct")
+			}
+			seen[id] = true
+		}
+
+		before, err := s.GetStockLinesFull(ctx, orgID, ids)
+		if err != nil {
+			return nil, err
+		}
+		if len(before) != len(ids) {
+			return nil, fmt.Errorf("failed to get stock line: %w", common.ErrNotFound)
+		}
+
+		target := ids[0]
+		err = database.WithVoidTransaction(ctx, s.pgxPool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			lines, err := qtx.GetStockLinesForUpdate(ctx, sqlc.GetStockLinesForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				Ids:   database.PgUUIDs(ids),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if len(lines) != len(ids) {
+				return fmt.Errorf("failed to get stock line: %w", common.ErrNotFound)
+			}
+
+			inTasks, err := qtx.StockLinesInOpenTasks(ctx, sqlc.StockLinesInOpenTasksParams{
+				OrgID: database.PgUUID(orgID),
+				Ids:   database.PgUUIDs(ids),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if inTasks {
+				return ErrStockLineInOpenTask
+			}
+
+			first := lines[0]
+			total := int32(0)
+			for _, line := range lines {
+				if models.ItemInstanceStatus(line.Status) != models.ItemInstanceStatusAvailable {
+					return ErrStockLinesNotMergeable
+				}
+				if line.VariantID != first.VariantID || line.CellID != first.CellID {
+					return ErrStockLinesNotMergeable
+				}
+				total += line.Quantity
+			}
+			if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, database.UUIDPtrFromPgx(first.CellID)); err != nil {
+				return err
+			}
+
+			err = qtx.DeleteStockLines(ctx, sqlc.DeleteStockLinesParams{
+				OrgID: database.PgUUID(orgID),
+				Ids:   database.PgUUIDs(ids[1:]),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetStockLineQuantity(ctx, sqlc.SetStockLineQuantityParams{
+				OrgID:    database.PgUUID(orgID),
+				ID:       database.PgUUID(target),
+				Quantity: total,
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		merged, err := s.GetStockLineById(ctx, orgID, target)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.auditService.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeStockLine,
+			TargetObjectID:   target,
+			PrechangeState:   before[target],
+			PostchangeState:  merged,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids[1:] {
+			err = s.auditService.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionDelete,
+				TargetObjectType: models.ObjectTypeStockLine,
+				TargetObjectID:   id,
+				PrechangeState:   before[id],
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return merged, nil
+	})
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/models"
+)
+
+// ValidateItemLot checks the batch data given for new instances
+func ValidateItemLot(lot models.ItemLot) error {
+	if lot.LotNumber != nil && len(*lot.LotNumber) > 255 {
+		return common.ErrDetailedValidationErrorWithMessage("lot number must be at most 255 characters")
+	}
+	if lot.ManufacturedAt != nil && lot.ExpiresAt != nil && lot.ManufacturedAt.After(*lot.ExpiresAt) {
+		return common.ErrDetailedValidationErrorWithMessage("manufacture date must not be after expiry date")
+	}
+	return nil
+}
@@ -11,6 +11,9 @@ func toOrganizationModel(org sqlc.Org) *models.Organization {
 		ID:        database.UUIDFromPgx(org.ID),
 		Name:      org.Name,
 		Subdomain: org.Subdomain,
+
+		TaskAssignmentStrategy: models.TaskAssignmentStrategy(org.TaskAssignmentStrategy),
+		AllocationPolicy:       models.AllocationPolicy(org.AllocationPolicy),
 	}
 }
 
@@ -23,5 +26,7 @@ func toOrganizationUnitModel(unit sqlc.OrgUnit) *models.OrganizationUnit {
 		Address:   database.PgTextPtrFromPgx(unit.Address),
 		CreatedAt: unit.CreatedAt.Time,
 		DeletedAt: database.PgTimePtrFromPgx(unit.DeletedAt),
+
+		PickPathStrategy: models.PickPathStrategy(unit.PickPathStrategy),
 	}
 }
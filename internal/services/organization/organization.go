@@ -50,6 +50,36 @@ func validateSubdomain(subdomain string) error {
 	return nil
 }
 
+func validatePickPathStrategy(strategy *models.PickPathStrategy) error {
+	switch *strategy {
+	case "":
+		*strategy = models.PickPathStrategySerpentine
+	case models.PickPathStrategySerpentine, models.PickPathStrategySequential:
+	default:
+		return fmt.Errorf("%w: invalid pick path strategy", common.ErrValidationError)
+	}
+	return nil
+}
+
+func validateTaskAssignmentStrategy(strategy models.TaskAssignmentStrategy) error {
+	switch strategy {
+	case models.TaskAssignmentStrategyManual, models.TaskAssignmentStrategyLeastOpenTasks,
+		models.TaskAssignmentStrategyRoundRobin, models.TaskAssignmentStrategySkills:
+		return nil
+	default:
+		return fmt.Errorf("%w: invalid task assignment strategy", common.ErrValidationError)
+	}
+}
+
+func validateAllocationPolicy(policy models.AllocationPolicy) error {
+	switch policy {
+	case models.AllocationPolicyFIFO, models.AllocationPolicyFEFO:
+		return nil
+	default:
+		return fmt.Errorf("%w: invalid allocation policy", common.ErrValidationError)
+	}
+}
+
 func validateAlias(alias string) error {
 	if strings.TrimSpace(alias) == "" {
 		return fmt.Errorf("%w: alias cannot be empty", common.ErrValidationError)
@@ -212,9 +242,27 @@ func (s *OrganizationService) UpdateOrganization(ctx context.Context, org *model
 			return nil, err
 		}
 
+		// The strategy and the policy are kept when not given
+		strategy := org.TaskAssignmentStrategy
+		if strategy == "" {
+			strategy = beforeUpdateOrg.TaskAssignmentStrategy
+		}
+		if err := validateTaskAssignmentStrategy(strategy); err != nil {
+			return nil, err
+		}
+		policy := org.AllocationPolicy
+		if policy == "" {
+			policy = beforeUpdateOrg.AllocationPolicy
+		}
+		if err := validateAllocationPolicy(policy); err != nil {
+			return nil, err
+		}
+
 		updatedOrg, err := s.queries.UpdateOrganization(ctx, sqlc.UpdateOrganizationParams{
-			ID:   database.PgUUID(org.ID),
-			Name: org.Name,
+			ID:                     database.PgUUID(org.ID),
+			Name:                   org.Name,
+			TaskAssignmentStrategy: sqlc.TaskAssignmentStrategy(strategy),
+			AllocationPolicy:       sqlc.AllocationPolicy(policy),
 		})
 		if err != nil {
 			return nil, services.MapDbErrorToService(err)
@@ -236,7 +284,7 @@ func (s *OrganizationService) UpdateOrganization(ctx context.Context, org *model
 	})
 }
 
-func (s *OrganizationService) CreateUnit(ctx context.Context, orgID uuid.UUID, name string, alias string, address string) (*models.OrganizationUnit, error) {
+func (s *OrganizationService) CreateUnit(ctx context.Context, orgID uuid.UUID, name string, alias string, address string, pickPathStrategy models.PickPathStrategy) (*models.OrganizationUnit, error) {
 	return telemetry.WithTrace(ctx, s.tracer, "CreateUnit", func(ctx context.Context, span trace.Span) (*models.OrganizationUnit, error) {
 		span.SetAttributes(
 			attribute.String("org.id", orgID.String()),
@@ -251,12 +299,16 @@ func (s *OrganizationService) CreateUnit(ctx context.Context, orgID uuid.UUID, n
 		if err := validateAlias(alias); err != nil {
 			return nil, err
 		}
+		if err := validatePickPathStrategy(&pickPathStrategy); err != nil {
+			return nil, err
+		}
 
 		unit, err := s.queries.CreateOrgUnit(ctx, sqlc.CreateOrgUnitParams{
-			OrgID:   database.PgUUID(orgID),
-			Name:    name,
-			Alias:   alias,
-			Address: database.PgText(address),
+			OrgID:            database.PgUUID(orgID),
+			Name:             name,
+			Alias:            alias,
+			Address:          database.PgText(address),
+			PickPathStrategy: sqlc.PickPathStrategy(pickPathStrategy),
 		})
 		if err != nil {
 			return nil, services.MapDbErrorToService(err)
@@ -321,6 +373,35 @@ func (s *OrganizationService) GetUnitByID(ctx context.Context, orgID uuid.UUID,
 	})
 }
 
+func (s *OrganizationService) GetUnitsByIDs(ctx context.Context, orgID uuid.UUID, ids []uuid.UUID) (map[uuid.UUID]*models.OrganizationUnit, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetUnitsByIDs", func(ctx context.Context, span trace.Span) (map[uuid.UUID]*models.OrganizationUnit, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.Int("units.count", len(ids)),
+		)
+
+		result := make(map[uuid.UUID]*models.OrganizationUnit, len(ids))
+		if len(ids) == 0 {
+			return result, nil
+		}
+
+		units, err := s.queries.GetOrgUnitsByIds(ctx, sqlc.GetOrgUnitsByIdsParams{
+			OrgID: database.PgUUID(orgID),
+			Ids:   database.PgUUIDs(ids),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		for _, unit := range units {
+			model := toOrganizationUnitModel(unit)
+			result[model.ID] = model
+		}
+
+		return result, nil
+	})
+}
+
 func (s *OrganizationService) DeleteUnit(ctx context.Context, orgID uuid.UUID, id uuid.UUID) error {
 	return telemetry.WithVoidTrace(ctx, s.tracer, "DeleteUnit", func(ctx context.Context, span trace.Span) error {
 		span.SetAttributes(
@@ -368,6 +449,9 @@ func (s *OrganizationService) UpdateUnit(ctx context.Context, unit *models.Organ
 		if err := validateAlias(unit.Alias); err != nil {
 			return nil, err
 		}
+		if err := validatePickPathStrategy(&unit.PickPathStrategy); err != nil {
+			return nil, err
+		}
 
 		unitBeforeUpdate, err := s.GetUnitByID(ctx, unit.OrgID, unit.ID)
 		if err != nil {
@@ -375,11 +459,12 @@ func (s *OrganizationService) UpdateUnit(ctx context.Context, unit *models.Organ
 		}
 
 		updatedUnit, err := s.queries.UpdateOrgUnit(ctx, sqlc.UpdateOrgUnitParams{
-			ID:      database.PgUUID(unit.ID),
-			OrgID:   database.PgUUID(unit.OrgID),
-			Name:    unit.Name,
-			Alias:   unit.Alias,
-			Address: database.PgTextPtr(unit.Address),
+			ID:               database.PgUUID(unit.ID),
+			OrgID:            database.PgUUID(unit.OrgID),
+			Name:             unit.Name,
+			Alias:            unit.Alias,
+			Address:          database.PgTextPtr(unit.Address),
+			PickPathStrategy: sqlc.PickPathStrategy(unit.PickPathStrategy),
 		})
 		if err != nil {
 			return nil, services.MapDbErrorToService(err)
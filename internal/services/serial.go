@@ -0,0 +1,39 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/models"
+)
+
+var (
+	ErrSerialNumberTaken    = fmt.Errorf("%w: serial number is already used by another instance of the variant", common.ErrDuplicationError)
+	ErrSerialNumberRequired = common.ErrDetailedValidationErrorWithMessage("serial number is required for instances of the variant")
+)
+
+// ValidateSerialNumber checks the serial number given for an instance of the
+// variant. Empty serial numbers count as missing.
+func ValidateSerialNumber(serialNumber *string, variant *models.ItemVariant) error {
+	if serialNumber == nil || *serialNumber == "" {
+		if variant.RequiresSerial {
+			return ErrSerialNumberRequired
+		}
+		return nil
+	}
+	if len(*serialNumber) > 255 {
+		return common.ErrDetailedValidationErrorWithMessage("serial number must be at most 255 characters")
+	}
+	return nil
+}
+
+// MapSerialNumberError maps a failed instance write, the only unique index
+// an instance write can violate is the serial number of the variant
+func MapSerialNumberError(err error) error {
+	err = MapDbErrorToService(err)
+	if errors.Is(err, common.ErrDuplicationError) {
+		return ErrSerialNumberTaken
+	}
+	return err
+}
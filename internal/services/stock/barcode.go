@@ -0,0 +1,184 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const maxBarcodeLength = 512
+
+// ResolveBarcode finds the objects of the organization a scanned code stands
+// for. GS1 codes are resolved by their GTIN, and by the serial number to the
+// instances of the found variants. Other codes are matched against instance
+// ids, variant EAN-13s and articles, cell aliases and TV board tokens. Codes
+// matching nothing resolve to no matches.
+func (s *StockService) ResolveBarcode(ctx context.Context, orgID uuid.UUID, code string) (*models.BarcodeResolution, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "ResolveBarcode", func(ctx context.Context, span trace.Span) (*models.BarcodeResolution, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		code = strings.TrimSpace(code)
+		if code == "" {
+			return nil, common.ErrDetailedValidationErrorWithMessage("code is required")
+		}
+		if len(code) > maxBarcodeLength {
+			return nil, common.ErrDetailedValidationErrorWithMessage("code is too long")
+		}
+
+		gs1, isGS1, err := services.ParseGS1(code)
+		if err != nil {
+			return nil, err
+		}
+		span.SetAttributes(attribute.Bool("barcode.gs1", isGS1))
+
+		res := &models.BarcodeResolution{
+			Code:    code,
+			GS1:     gs1,
+			Matches: []models.BarcodeMatch{},
+		}
+		if isGS1 {
+			res.Matches, err = s.resolveGS1(ctx, orgID, gs1)
+			if err != nil {
+				return nil, err
+			}
+			return res, nil
+		}
+
+		if id, err := uuid.Parse(code); err == nil {
+			instances, err := s.item.GetItemInstancesFull(ctx, orgID, []uuid.UUID{id})
+			if err != nil {
+				return nil, err
+			}
+			if instance, ok := instances[id]; ok {
+				res.Matches = append(res.Matches, models.BarcodeMatch{
+					ObjectType: models.BarcodeObjectTypeInstance,
+					MatchedBy:  models.BarcodeMatchFieldID,
+					Instance:   instance,
+				})
+			}
+		}
+
+		var ean13 *int64
+		if value, err := strconv.ParseInt(code, 10, 64); err == nil && value > 0 {
+			ean13 = &value
+		}
+		variants, err := s.item.GetItemVariantsByBarcode(ctx, orgID, ean13, &code)
+		if err != nil {
+			return nil, err
+		}
+		for _, variant := range variants {
+			matchedBy := models.BarcodeMatchFieldArticle
+			if ean13 != nil && variant.EAN13 != nil && *variant.EAN13 == *ean13 {
+				matchedBy = models.BarcodeMatchFieldEAN13
+			}
+			match, err := s.variantMatch(ctx, orgID, variant, matchedBy)
+			if err != nil {
+				return nil, err
+			}
+			res.Matches = append(res.Matches, match)
+		}
+
+		cells, err := s.storageService.GetCellsByAlias(ctx, orgID, code)
+		if err != nil {
+			return nil, err
+		}
+		for _, cell := range cells {
+			res.Matches = append(res.Matches, models.BarcodeMatch{
+				ObjectType: models.BarcodeObjectTypeCell,
+				MatchedBy:  models.BarcodeMatchFieldAlias,
+				Cell:       cell,
+			})
+		}
+
+		tvBoard, err := s.tvBoard.GetTvBoardByToken(ctx, code)
+		if err != nil && !errors.Is(err, common.ErrNotFound) {
+			return nil, err
+		}
+		// Tokens are unique across organizations, boards of others are not
+		// disclosed
+		if tvBoard != nil && tvBoard.OrgID == orgID {
+			tvBoard.Unit, err = s.org.GetUnitByID(ctx, orgID, tvBoard.UnitID)
+			if err != nil {
+				return nil, err
+			}
+			res.Matches = append(res.Matches, models.BarcodeMatch{
+				ObjectType: models.BarcodeObjectTypeTvBoard,
+				MatchedBy:  models.BarcodeMatchFieldToken,
+				TvBoard:    tvBoard,
+			})
+		}
+
+		return res, nil
+	})
+}
+
+func (s *StockService) resolveGS1(ctx context.Context, orgID uuid.UUID, gs1 *models.GS1Data) ([]models.BarcodeMatch, error) {
+	matches := []models.BarcodeMatch{}
+	if gs1.GTIN == nil {
+		return matches, nil
+	}
+	ean13, ok := services.GTINToEAN13(*gs1.GTIN)
+	if !ok {
+		return matches, nil
+	}
+
+	variants, err := s.item.GetItemVariantsByBarcode(ctx, orgID, &ean13, nil)
+	if err != nil {
+		return nil, err
+	}
+	variantIDs := make(map[uuid.UUID]bool, len(variants))
+	for _, variant := range variants {
+		match, err := s.variantMatch(ctx, orgID, variant, models.BarcodeMatchFieldEAN13)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+		variantIDs[variant.ID] = true
+	}
+
+	if gs1.SerialNumber == nil || len(variants) == 0 {
+		return matches, nil
+	}
+	instances, err := s.item.SearchItemInstancesBySerialNumber(ctx, orgID, gs1.SerialNumber, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if variantIDs[instance.VariantID] {
+			matches = append(matches, models.BarcodeMatch{
+				ObjectType: models.BarcodeObjectTypeInstance,
+				MatchedBy:  models.BarcodeMatchFieldSerialNumber,
+				Instance:   instance,
+			})
+		}
+	}
+	return matches, nil
+}
+
+// variantMatch attaches the stock of the variant per unit
+func (s *StockService) variantMatch(ctx context.Context, orgID uuid.UUID, variant *models.ItemVariant, matchedBy models.BarcodeMatchField) (models.BarcodeMatch, error) {
+	stock, err := s.GetStockLevels(ctx, orgID, &models.StockFilter{
+		VariantID: &variant.ID,
+		GroupBy:   models.CellPathObjectTypeUnit,
+	})
+	if err != nil {
+		return models.BarcodeMatch{}, err
+	}
+	return models.BarcodeMatch{
+		ObjectType: models.BarcodeObjectTypeVariant,
+		MatchedBy:  matchedBy,
+		Variant:    variant,
+		Stock:      stock,
+	}, nil
+}
@@ -0,0 +1,308 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/services/item"
+	"github.com/let-store-it/backend/internal/services/organization"
+	"github.com/let-store-it/backend/internal/services/storage"
+	"github.com/let-store-it/backend/internal/services/tvboard"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// stockStatuses are the instance statuses counted in stock levels
+var stockStatuses = []models.ItemInstanceStatus{
+	models.ItemInstanceStatusAvailable,
+	models.ItemInstanceStatusReserved,
+	models.ItemInstanceStatusConsumed,
+	models.ItemInstanceStatusInTransit,
+}
+
+type StockService struct {
+	queries        *sqlc.Queries
+	tracer         trace.Tracer
+	storageService *storage.StorageService
+	item           *item.ItemService
+	org            *organization.OrganizationService
+	tvBoard        *tvboard.TvBoardService
+}
+
+type StockServiceConfig struct {
+	Queries        *sqlc.Queries
+	StorageService *storage.StorageService
+	ItemService    *item.ItemService
+	OrgService     *organization.OrganizationService
+	TvBoardService *tvboard.TvBoardService
+}
+
+func New(cfg StockServiceConfig) *StockService {
+	return &StockService{
+		queries:        cfg.Queries,
+		tracer:         otel.GetTracerProvider().Tracer("stock-service"),
+		storageService: cfg.StorageService,
+		item:           cfg.ItemService,
+		org:            cfg.OrgService,
+		tvBoard:        cfg.TvBoardService,
+	}
+}
+
+func validateStockFilter(filter *models.StockFilter) error {
+	switch filter.GroupBy {
+	case "":
+		filter.GroupBy = models.CellPathObjectTypeUnit
+	case models.CellPathObjectTypeCell, models.CellPathObjectTypeCellsGroup,
+		models.CellPathObjectTypeStorageGroup, models.CellPathObjectTypeUnit:
+	default:
+		return common.ErrDetailedValidationErrorWithMessage("invalid stock grouping")
+	}
+
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = stockStatuses
+		return nil
+	}
+	for _, status := range filter.Statuses {
+		valid := false
+		for _, stockStatus := range stockStatuses {
+			if status == stockStatus {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return common.ErrDetailedValidationErrorWithMessage("invalid stock status")
+		}
+	}
+	return nil
+}
+
+type stockKey struct {
+	variantID  uuid.UUID
+	locationID uuid.UUID
+}
+
+// GetStockLevels counts instances per variant and rolls the counts up to
+// filter.GroupBy along the cell path: cell, cells group, storage groups and
+// unit. Nested storage groups include the stock of their subgroups. Instances
+// out of any cell, consumed or in transit, are only counted for the unit of
+// the task which last affected them.
+func (s *StockService) GetStockLevels(ctx context.Context, orgID uuid.UUID, filter *models.StockFilter) ([]*models.StockLevel, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetStockLevels", func(ctx context.Context, span trace.Span) ([]*models.StockLevel, error) {
+		if err := validateStockFilter(filter); err != nil {
+			return nil, err
+		}
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("stock.group_by", string(filter.GroupBy)),
+		)
+
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+
+		rows, err := s.queries.GetStockCounts(ctx, sqlc.GetStockCountsParams{
+			OrgID:     database.PgUUID(orgID),
+			Statuses:  statuses,
+			ItemID:    database.PgUUIDPtr(filter.ItemID),
+			VariantID: database.PgUUIDPtr(filter.VariantID),
+			UnitID:    database.PgUUIDPtr(filter.UnitID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		cellIDs := make([]uuid.UUID, 0, len(rows))
+		unitIDs := make([]uuid.UUID, 0)
+		for _, row := range rows {
+			switch {
+			case row.CellID.Valid:
+				cellIDs = append(cellIDs, database.UUIDFromPgx(row.CellID))
+			case row.UnitID.Valid:
+				unitIDs = append(unitIDs, database.UUIDFromPgx(row.UnitID))
+			}
+		}
+
+		cells, err := s.storageService.GetCellsFull(ctx, orgID, uniqueIDs(cellIDs))
+		if err != nil {
+			return nil, err
+		}
+		units, err := s.org.GetUnitsByIDs(ctx, orgID, uniqueIDs(unitIDs))
+		if err != nil {
+			return nil, err
+		}
+
+		levels := make(map[stockKey]*models.StockLevel)
+		order := make([]stockKey, 0)
+		for _, row := range rows {
+			for _, location := range stockLocations(row, filter.GroupBy, cells, units) {
+				key := stockKey{variantID: database.UUIDFromPgx(row.VariantID), locationID: location.ID}
+				level, ok := levels[key]
+				if !ok {
+					level = &models.StockLevel{
+						ItemID:    database.UUIDFromPgx(row.ItemID),
+						VariantID: key.variantID,
+						Location:  location,
+					}
+					levels[key] = level
+					order = append(order, key)
+				}
+				addStockCount(level, models.ItemInstanceStatus(row.Status), int(row.Quantity))
+			}
+		}
+
+		variantIDs := make([]uuid.UUID, 0, len(order))
+		for _, key := range order {
+			variantIDs = append(variantIDs, key.variantID)
+		}
+
+		variants, err := s.item.GetItemVariantsByIDs(ctx, orgID, uniqueIDs(variantIDs))
+		if err != nil {
+			return nil, err
+		}
+
+		// Stock of deleted variants is not reported
+		res := make([]*models.StockLevel, 0, len(order))
+		for _, key := range order {
+			level := levels[key]
+			if level.Variant = variants[level.VariantID]; level.Variant != nil {
+				res = append(res, level)
+			}
+		}
+
+		sort.SliceStable(res, func(i, j int) bool {
+			if res[i].Location.Alias != res[j].Location.Alias {
+				return res[i].Location.Alias < res[j].Location.Alias
+			}
+			if res[i].Location.ID != res[j].Location.ID {
+				return res[i].Location.ID.String() < res[j].Location.ID.String()
+			}
+			return res[i].VariantID.String() < res[j].VariantID.String()
+		})
+
+		span.SetAttributes(attribute.Int("response.count", len(res)))
+		return res, nil
+	})
+}
+
+// stockLocations returns the nodes of groupBy level the counted row belongs
+// to. A cell may be under several nested storage groups, or under none.
+func stockLocations(row sqlc.GetStockCountsRow, groupBy models.CellPathObjectType, cells map[uuid.UUID]*models.Cell, units map[uuid.UUID]*models.OrganizationUnit) []models.CellPathSegment {
+	if !row.CellID.Valid {
+		unit := units[database.UUIDFromPgx(row.UnitID)]
+		if groupBy != models.CellPathObjectTypeUnit || unit == nil {
+			return nil
+		}
+		return []models.CellPathSegment{{
+			ID:         unit.ID,
+			Name:       unit.Name,
+			ObjectType: models.CellPathObjectTypeUnit,
+			Alias:      unit.Alias,
+		}}
+	}
+
+	cell := cells[database.UUIDFromPgx(row.CellID)]
+	if cell == nil {
+		return nil
+	}
+	if groupBy == models.CellPathObjectTypeCell {
+		return []models.CellPathSegment{{
+			ID:         cell.ID,
+			Name:       cell.Alias,
+			ObjectType: models.CellPathObjectTypeCell,
+			Alias:      cell.Alias,
+		}}
+	}
+
+	var res []models.CellPathSegment
+	if cell.Path != nil {
+		for _, segment := range *cell.Path {
+			if segment.ObjectType == groupBy {
+				res = append(res, segment)
+			}
+		}
+	}
+	return res
+}
+
+func addStockCount(level *models.StockLevel, status models.ItemInstanceStatus, quantity int) {
+	switch status {
+	case models.ItemInstanceStatusAvailable:
+		level.Available += quantity
+	case models.ItemInstanceStatusReserved:
+		level.Reserved += quantity
+	case models.ItemInstanceStatusConsumed:
+		level.Consumed += quantity
+	case models.ItemInstanceStatusInTransit:
+		level.InTransit += quantity
+	}
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	res := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+const maxExpiryHorizonDays = 3650
+
+// GetExpiringInstances lists available and reserved instances expiring within
+// the given number of days, already expired ones included, soonest first.
+// Reserved instances belong to the unit of the task holding them.
+func (s *StockService) GetExpiringInstances(ctx context.Context, orgID uuid.UUID, days int, unitID *uuid.UUID) ([]*models.ItemInstance, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetExpiringInstances", func(ctx context.Context, span trace.Span) ([]*models.ItemInstance, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.Int("days", days),
+		)
+
+		if days < 0 || days > maxExpiryHorizonDays {
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("days must be between 0 and %d", maxExpiryHorizonDays))
+		}
+
+		y, m, d := time.Now().UTC().Date()
+		until := time.Date(y, m, d+days, 0, 0, 0, 0, time.UTC)
+		rows, err := s.queries.GetExpiringInstances(ctx, sqlc.GetExpiringInstancesParams{
+			OrgID:  database.PgUUID(orgID),
+			Until:  database.PgDate(until),
+			UnitID: database.PgUUIDPtr(unitID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		ids := make([]uuid.UUID, len(rows))
+		for i, row := range rows {
+			ids[i] = database.UUIDFromPgx(row.ID)
+		}
+		instances, err := s.item.GetItemInstancesFull(ctx, orgID, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*models.ItemInstance, 0, len(ids))
+		for _, id := range ids {
+			if instance, ok := instances[id]; ok {
+				result = append(result, instance)
+			}
+		}
+		return result, nil
+	})
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/let-store-it/backend/generated/sqlc"
+)
+
+// SplitStockLine takes quantity off a line locked by the caller into a new
+// line in the same cell with the given status and task. The quantity must be
+// less than the line quantity.
+func SplitStockLine(ctx context.Context, queries *sqlc.Queries, line sqlc.StockLine, quantity int32, status sqlc.ItemInstanceStatus, taskID pgtype.UUID) (sqlc.StockLine, error) {
+	err := queries.SetStockLineQuantity(ctx, sqlc.SetStockLineQuantityParams{
+		OrgID:    line.OrgID,
+		ID:       line.ID,
+		Quantity: line.Quantity - quantity,
+	})
+	if err != nil {
+		return sqlc.StockLine{}, MapDbErrorToService(err)
+	}
+
+	created, err := queries.CreateStockLine(ctx, sqlc.CreateStockLineParams{
+		OrgID:            line.OrgID,
+		ItemID:           line.ItemID,
+		VariantID:        line.VariantID,
+		CellID:           line.CellID,
+		Status:           status,
+		Quantity:         quantity,
+		AffectedByTaskID: taskID,
+	})
+	if err != nil {
+		return sqlc.StockLine{}, MapDbErrorToService(err)
+	}
+	return created, nil
+}
@@ -175,6 +175,94 @@ func (s *StorageService) GetCellFull(ctx context.Context, orgID uuid.UUID, cellI
 	})
 }
 
+// GetCellsFull loads cells with their paths in two queries. Missing or deleted
+// cells are absent from the result.
+func (s *StorageService) GetCellsFull(ctx context.Context, orgID uuid.UUID, cellIDs []uuid.UUID) (map[uuid.UUID]*models.Cell, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetCellsFull", func(ctx context.Context, span trace.Span) (map[uuid.UUID]*models.Cell, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.Int("cells.count", len(cellIDs)),
+		)
+
+		result := make(map[uuid.UUID]*models.Cell, len(cellIDs))
+		if len(cellIDs) == 0 {
+			return result, nil
+		}
+
+		cells, err := s.queries.GetCellsByIds(ctx, sqlc.GetCellsByIdsParams{
+			OrgID: database.PgUUID(orgID),
+			Ids:   database.PgUUIDs(cellIDs),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		segments, err := s.queries.GetCellsPaths(ctx, sqlc.GetCellsPathsParams{
+			OrgID:   database.PgUUID(orgID),
+			CellIds: database.PgUUIDs(cellIDs),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		paths := make(map[uuid.UUID][]models.CellPathSegment, len(cells))
+		for _, segment := range segments {
+			cellID := database.UUIDFromPgx(segment.CellID)
+			paths[cellID] = append(paths[cellID], models.CellPathSegment{
+				ID:         database.UUIDFromPgx(segment.ID),
+				Name:       segment.Name,
+				ObjectType: models.CellPathObjectType(segment.Type),
+				Alias:      segment.Alias,
+			})
+		}
+
+		for _, cellDb := range cells {
+			cell := toCellModel(cellDb)
+			path := paths[cell.ID]
+			if path == nil {
+				path = []models.CellPathSegment{}
+			}
+			cell.Path = &path
+			result[cell.ID] = cell
+		}
+
+		return result, nil
+	})
+}
+
+// GetCellsByAlias returns cells of any unit with the alias given, with their
+// paths
+func (s *StorageService) GetCellsByAlias(ctx context.Context, orgID uuid.UUID, alias string) ([]*models.Cell, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetCellsByAlias", func(ctx context.Context, span trace.Span) ([]*models.Cell, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("cell.alias", alias),
+		)
+
+		ids, err := s.queries.GetCellIdsByAlias(ctx, sqlc.GetCellIdsByAliasParams{
+			OrgID: database.PgUUID(orgID),
+			Alias: alias,
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		cellIDs := database.UUIDsFromPgx(ids)
+		cells, err := s.GetCellsFull(ctx, orgID, cellIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*models.Cell, 0, len(cellIDs))
+		for _, id := range cellIDs {
+			if cell, ok := cells[id]; ok {
+				result = append(result, cell)
+			}
+		}
+		return result, nil
+	})
+}
+
 func (s *StorageService) GetCellPath(ctx context.Context, orgID uuid.UUID, cellID uuid.UUID) ([]models.CellPathSegment, error) {
 	return telemetry.WithTrace(ctx, s.tracer, "GetCellPath", func(ctx context.Context, span trace.Span) ([]models.CellPathSegment, error) {
 		span.SetAttributes(
@@ -159,3 +159,41 @@ func (s *StorageService) UpdateStorageGroup(ctx context.Context, group *models.S
 		return result, nil
 	})
 }
+
+// GetStorageGroupCellIDs returns ids of all cells in the storage group and its
+// nested storage groups.
+func (s *StorageService) GetStorageGroupCellIDs(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (*models.StorageGroup, []uuid.UUID, error) {
+	ctx, span := s.tracer.Start(ctx, "GetStorageGroupCellIDs",
+		trace.WithAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("storage_group.id", id.String()),
+		),
+	)
+	defer span.End()
+
+	group, err := s.queries.GetStorageGroupById(ctx, sqlc.GetStorageGroupByIdParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(id),
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+
+	cellIDs, err := s.queries.GetCellIdsInStorageGroup(ctx, sqlc.GetCellIdsInStorageGroupParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(id),
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+
+	result := make([]uuid.UUID, len(cellIDs))
+	for i, cellID := range cellIDs {
+		result[i] = database.UUIDFromPgx(cellID)
+	}
+
+	span.SetStatus(codes.Ok, "storage group cells retrieved successfully")
+	return toStorageGroupModel(group), result, nil
+}
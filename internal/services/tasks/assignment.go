@@ -0,0 +1,220 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrTaskAlreadyAssigned = fmt.Errorf("%w: task is already assigned", common.ErrConflict)
+	ErrTaskNotAssigned     = fmt.Errorf("%w: task is not assigned", common.ErrConflict)
+)
+
+// AssignTask assigns an unassigned open task to the employee.
+func (s *TaskService) AssignTask(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, userID uuid.UUID) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "AssignTask", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("user.id", userID.String()),
+		)
+
+		return s.setAssignee(ctx, orgID, taskID, &userID, func(task *models.Task) error {
+			if task.AssignedToUserID != nil {
+				return ErrTaskAlreadyAssigned
+			}
+			return nil
+		})
+	})
+}
+
+// ReassignTask moves an already assigned open task to another employee.
+func (s *TaskService) ReassignTask(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, userID uuid.UUID) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "ReassignTask", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("user.id", userID.String()),
+		)
+
+		return s.setAssignee(ctx, orgID, taskID, &userID, func(task *models.Task) error {
+			if task.AssignedToUserID == nil {
+				return ErrTaskNotAssigned
+			}
+			return nil
+		})
+	})
+}
+
+func (s *TaskService) UnassignTask(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "UnassignTask", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		return s.setAssignee(ctx, orgID, taskID, nil, func(task *models.Task) error {
+			if task.AssignedToUserID == nil {
+				return ErrTaskNotAssigned
+			}
+			return nil
+		})
+	})
+}
+
+// ClaimTask assigns an unassigned open task to the calling user. The update is
+// conditional on the task being open and unassigned, so only one of several
+// concurrent claims succeeds and closed tasks are never claimed.
+func (s *TaskService) ClaimTask(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, userID uuid.UUID) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "ClaimTask", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("user.id", userID.String()),
+		)
+
+		before, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if !isTaskOpen(before.Status) {
+			return nil, ErrTaskClosed
+		}
+		if before.AssignedToUserID != nil {
+			if *before.AssignedToUserID == userID {
+				return before, nil
+			}
+			return nil, ErrTaskAlreadyAssigned
+		}
+
+		_, err = s.employee.GetEmployee(ctx, orgID, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		updated, err := s.queries.ClaimTask(ctx, sqlc.ClaimTaskParams{
+			OrgID:            database.PgUUID(orgID),
+			ID:               database.PgUUID(taskID),
+			AssignedToUserID: database.PgUUID(userID),
+		})
+		if err != nil {
+			if !database.IsNotFound(err) {
+				return nil, services.MapDbErrorToService(err)
+			}
+			// Another claim or a status change won the race
+			current, err := s.GetTaskById(ctx, orgID, taskID)
+			if err != nil {
+				return nil, err
+			}
+			if !isTaskOpen(current.Status) {
+				return nil, ErrTaskClosed
+			}
+			return nil, ErrTaskAlreadyAssigned
+		}
+
+		return s.auditAssignment(ctx, before, updated)
+	})
+}
+
+func (s *TaskService) GetTasksAssignedToUser(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) ([]*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTasksAssignedToUser", func(ctx context.Context, span trace.Span) ([]*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("user.id", userID.String()),
+		)
+
+		tasks, err := s.queries.GetTasksAssignedToUser(ctx, sqlc.GetTasksAssignedToUserParams{
+			OrgID:            database.PgUUID(orgID),
+			AssignedToUserID: database.PgUUID(userID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		return s.toTasksWithRelations(ctx, orgID, tasks)
+	})
+}
+
+// setAssignee changes the assignee of an open task. The status and check are
+// evaluated on the locked task row, so concurrent assignments and status
+// changes can't interleave with the update.
+func (s *TaskService) setAssignee(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, userID *uuid.UUID, check func(task *models.Task) error) (*models.Task, error) {
+	before, err := s.GetTaskById(ctx, orgID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignedAt *time.Time
+	if userID != nil {
+		_, err = s.employee.GetEmployee(ctx, orgID, *userID)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		assignedAt = &now
+	}
+
+	updated, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (sqlc.Task, error) {
+		qtx := s.queries.WithTx(tx)
+
+		task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+			OrgID: database.PgUUID(orgID),
+			ID:    database.PgUUID(taskID),
+		})
+		if err != nil {
+			return sqlc.Task{}, services.MapDbErrorToService(err)
+		}
+		locked := toTask(task)
+		if !isTaskOpen(locked.Status) {
+			return sqlc.Task{}, ErrTaskClosed
+		}
+		if err := check(locked); err != nil {
+			return sqlc.Task{}, err
+		}
+
+		updated, err := qtx.SetTaskAssignee(ctx, sqlc.SetTaskAssigneeParams{
+			OrgID:            database.PgUUID(orgID),
+			ID:               database.PgUUID(taskID),
+			AssignedToUserID: database.PgUUIDPtr(userID),
+			AssignedAt:       database.PgTimestampPtr(assignedAt),
+		})
+		if err != nil {
+			return sqlc.Task{}, services.MapDbErrorToService(err)
+		}
+		return updated, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.auditAssignment(ctx, before, updated)
+}
+
+func (s *TaskService) auditAssignment(ctx context.Context, before *models.Task, updated sqlc.Task) (*models.Task, error) {
+	model := toTask(updated)
+
+	err := s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+		Action:           models.ObjectChangeActionUpdate,
+		TargetObjectType: models.ObjectTypeTask,
+		TargetObjectID:   model.ID,
+		PrechangeState:   before,
+		PostchangeState:  model,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
@@ -0,0 +1,339 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/services/blob"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultAttachmentMaxSize = 10 << 20
+	maxAttachmentNameLength  = 255
+	// Enough for http.DetectContentType
+	attachmentSniffLength = 512
+)
+
+// Attachments are photos taken as evidence, the type is detected from the
+// content and the type sent by the client is ignored
+var allowedAttachmentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+var (
+	ErrAttachmentTooLarge  = common.ErrDetailedValidationErrorWithMessage("attachment is too large")
+	ErrAttachmentEmpty     = common.ErrDetailedValidationErrorWithMessage("attachment is empty")
+	ErrAttachmentType      = common.ErrDetailedValidationErrorWithMessage("only JPEG, PNG, GIF and WebP images can be attached")
+	ErrCommentNotInTask    = fmt.Errorf("%w: comment does not belong to the task", common.ErrConflict)
+	ErrAttachmentNotStored = errors.New("attachment content is missing in the blob storage")
+)
+
+func toTaskAttachment(attachment sqlc.TaskAttachment) *models.TaskAttachment {
+	return &models.TaskAttachment{
+		ID:               database.UUIDFromPgx(attachment.ID),
+		OrgID:            database.UUIDFromPgx(attachment.OrgID),
+		TaskID:           database.UUIDFromPgx(attachment.TaskID),
+		InstanceID:       database.UUIDPtrFromPgx(attachment.ItemInstanceID),
+		CommentID:        database.UUIDPtrFromPgx(attachment.CommentID),
+		FileName:         attachment.FileName,
+		ContentType:      attachment.ContentType,
+		Size:             attachment.SizeBytes,
+		StorageKey:       attachment.StorageKey,
+		UploadedByUserID: database.UUIDPtrFromPgx(attachment.UploadedByUserID),
+		CreatedAt:        attachment.CreatedAt.Time,
+	}
+}
+
+func attachmentStorageKey(orgID uuid.UUID, taskID uuid.UUID, attachmentID uuid.UUID) string {
+	return fmt.Sprintf("tasks/%s/%s/%s", orgID, taskID, attachmentID)
+}
+
+// attachmentFileName keeps only the base name sent by the client
+func attachmentFileName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+	for utf8.RuneCountInString(name) > maxAttachmentNameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
+
+// sniffAttachmentType detects the type of the content and returns a reader
+// yielding the whole content again
+func sniffAttachmentType(content io.Reader) (string, io.Reader, error) {
+	head := make([]byte, attachmentSniffLength)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", nil, err
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	if !allowedAttachmentTypes[contentType] {
+		return "", nil, ErrAttachmentType
+	}
+	return contentType, io.MultiReader(bytes.NewReader(head), content), nil
+}
+
+func (s *TaskService) loadTaskAttachmentsUploaders(ctx context.Context, orgID uuid.UUID, attachments []*models.TaskAttachment) error {
+	userIDs := make([]uuid.UUID, 0, len(attachments))
+	for _, attachment := range attachments {
+		if attachment.UploadedByUserID != nil {
+			userIDs = append(userIDs, *attachment.UploadedByUserID)
+		}
+	}
+
+	employees, err := s.employee.GetEmployeesByUserIDs(ctx, orgID, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		if attachment.UploadedByUserID != nil {
+			attachment.UploadedBy = employees[*attachment.UploadedByUserID]
+		}
+	}
+	return nil
+}
+
+// UploadTaskAttachment stores the file in the blob storage and records its
+// metadata. The content is written first, so metadata never points to a
+// missing object, and removed again when the metadata can not be saved.
+func (s *TaskService) UploadTaskAttachment(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, uploaderID *uuid.UUID, upload *models.TaskAttachmentUpload) (*models.TaskAttachment, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "UploadTaskAttachment", func(ctx context.Context, span trace.Span) (*models.TaskAttachment, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.Int64("attachment.size", upload.Size),
+		)
+
+		if upload.Size <= 0 {
+			return nil, ErrAttachmentEmpty
+		}
+		if upload.Size > s.attachmentMaxSize {
+			return nil, ErrAttachmentTooLarge
+		}
+		if err := s.ensureTaskTarget(ctx, orgID, taskID, upload.InstanceID); err != nil {
+			return nil, err
+		}
+		if upload.CommentID != nil {
+			_, err := s.queries.GetTaskComment(ctx, sqlc.GetTaskCommentParams{
+				OrgID:  database.PgUUID(orgID),
+				TaskID: database.PgUUID(taskID),
+				ID:     database.PgUUID(*upload.CommentID),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return nil, ErrCommentNotInTask
+				}
+				return nil, services.MapDbErrorToService(err)
+			}
+		}
+
+		contentType, content, err := sniffAttachmentType(upload.Content)
+		if err != nil {
+			return nil, err
+		}
+		span.SetAttributes(attribute.String("attachment.content_type", contentType))
+
+		attachmentID := uuid.New()
+		key := attachmentStorageKey(orgID, taskID, attachmentID)
+		if err := s.blob.Put(ctx, key, content, upload.Size, contentType); err != nil {
+			return nil, fmt.Errorf("failed to store attachment: %w", err)
+		}
+
+		created, err := s.queries.CreateTaskAttachment(ctx, sqlc.CreateTaskAttachmentParams{
+			ID:               database.PgUUID(attachmentID),
+			OrgID:            database.PgUUID(orgID),
+			TaskID:           database.PgUUID(taskID),
+			ItemInstanceID:   database.PgUUIDPtr(upload.InstanceID),
+			CommentID:        database.PgUUIDPtr(upload.CommentID),
+			FileName:         attachmentFileName(upload.FileName),
+			ContentType:      contentType,
+			SizeBytes:        upload.Size,
+			StorageKey:       key,
+			UploadedByUserID: database.PgUUIDPtr(uploaderID),
+		})
+		if err != nil {
+			if deleteErr := s.blob.Delete(context.WithoutCancel(ctx), key); deleteErr != nil {
+				slog.Error("Failed to remove orphaned attachment", "key", key, "error", deleteErr)
+			}
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		model := toTaskAttachment(created)
+		if err := s.loadTaskAttachmentsUploaders(ctx, orgID, []*models.TaskAttachment{model}); err != nil {
+			return nil, err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionCreate,
+			TargetObjectType: models.ObjectTypeTaskAttachment,
+			TargetObjectID:   model.ID,
+			PostchangeState:  model,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return model, nil
+	})
+}
+
+// GetTaskAttachments returns the attachments of the task, or only those of
+// the task item of instanceID when it is set
+func (s *TaskService) GetTaskAttachments(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, instanceID *uuid.UUID) ([]*models.TaskAttachment, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTaskAttachments", func(ctx context.Context, span trace.Span) ([]*models.TaskAttachment, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		if err := s.ensureTaskTarget(ctx, orgID, taskID, nil); err != nil {
+			return nil, err
+		}
+
+		rows, err := s.queries.GetTaskAttachments(ctx, sqlc.GetTaskAttachmentsParams{
+			OrgID:          database.PgUUID(orgID),
+			TaskID:         database.PgUUID(taskID),
+			ItemInstanceID: database.PgUUIDPtr(instanceID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		attachments := make([]*models.TaskAttachment, len(rows))
+		for i, row := range rows {
+			attachments[i] = toTaskAttachment(row)
+		}
+		if err := s.loadTaskAttachmentsUploaders(ctx, orgID, attachments); err != nil {
+			return nil, err
+		}
+
+		span.SetAttributes(attribute.Int("response.count", len(attachments)))
+		return attachments, nil
+	})
+}
+
+func (s *TaskService) GetTaskAttachment(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, attachmentID uuid.UUID) (*models.TaskAttachment, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTaskAttachment", func(ctx context.Context, span trace.Span) (*models.TaskAttachment, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("attachment.id", attachmentID.String()),
+		)
+
+		attachment, err := s.queries.GetTaskAttachment(ctx, sqlc.GetTaskAttachmentParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+			ID:     database.PgUUID(attachmentID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		model := toTaskAttachment(attachment)
+		if err := s.loadTaskAttachmentsUploaders(ctx, orgID, []*models.TaskAttachment{model}); err != nil {
+			return nil, err
+		}
+		return model, nil
+	})
+}
+
+// GetTaskAttachmentContent reads the attached file. Attachments are small,
+// so the content is read whole and the blob is not held open while the
+// response is written.
+func (s *TaskService) GetTaskAttachmentContent(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, attachmentID uuid.UUID) (*models.TaskAttachment, []byte, error) {
+	var content []byte
+	attachment, err := telemetry.WithTrace(ctx, s.tracer, "GetTaskAttachmentContent", func(ctx context.Context, span trace.Span) (*models.TaskAttachment, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("attachment.id", attachmentID.String()),
+		)
+
+		attachment, err := s.GetTaskAttachment(ctx, orgID, taskID, attachmentID)
+		if err != nil {
+			return nil, err
+		}
+
+		reader, err := s.blob.Get(ctx, attachment.StorageKey)
+		if err != nil {
+			if errors.Is(err, blob.ErrBlobNotFound) {
+				return nil, fmt.Errorf("%w: %s", ErrAttachmentNotStored, attachment.StorageKey)
+			}
+			return nil, err
+		}
+		defer reader.Close()
+
+		content, err = io.ReadAll(io.LimitReader(reader, attachment.Size))
+		if err != nil {
+			return nil, err
+		}
+		return attachment, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachment, content, nil
+}
+
+// DeleteTaskAttachment removes the attachment. The content is removed from
+// the blob storage once the metadata is deleted, a failure there only leaves
+// an unreferenced object behind.
+func (s *TaskService) DeleteTaskAttachment(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, attachmentID uuid.UUID) error {
+	return telemetry.WithVoidTrace(ctx, s.tracer, "DeleteTaskAttachment", func(ctx context.Context, span trace.Span) error {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("attachment.id", attachmentID.String()),
+		)
+
+		before, err := s.GetTaskAttachment(ctx, orgID, taskID, attachmentID)
+		if err != nil {
+			return err
+		}
+
+		err = s.queries.DeleteTaskAttachment(ctx, sqlc.DeleteTaskAttachmentParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+			ID:     database.PgUUID(attachmentID),
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+
+		if err := s.blob.Delete(ctx, before.StorageKey); err != nil {
+			slog.Error("Failed to remove attachment content", "key", before.StorageKey, "error", err)
+		}
+
+		return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionDelete,
+			TargetObjectType: models.ObjectTypeTaskAttachment,
+			TargetObjectID:   attachmentID,
+			PrechangeState:   before,
+		})
+	})
+}
@@ -0,0 +1,456 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// assignmentCandidate is an on shift worker who may get tasks of the units
+// listed in the worker profile
+type assignmentCandidate struct {
+	userID    uuid.UUID
+	unitIDs   []uuid.UUID
+	skills    []models.TaskType
+	openTasks int
+}
+
+// assignmentStrategy picks the worker for a task among the candidates of the
+// task unit, which are sorted by user id. last is the worker who got the
+// previous task of the unit. Returns nil when nobody fits.
+type assignmentStrategy interface {
+	pick(task *models.Task, candidates []*assignmentCandidate, last *uuid.UUID) *assignmentCandidate
+}
+
+var assignmentStrategies = map[models.TaskAssignmentStrategy]assignmentStrategy{
+	models.TaskAssignmentStrategyLeastOpenTasks: leastOpenTasksStrategy{},
+	models.TaskAssignmentStrategyRoundRobin:     roundRobinStrategy{},
+	models.TaskAssignmentStrategySkills:         skillsStrategy{},
+}
+
+// leastOpenTasksStrategy picks the worker with the fewest pending and in
+// progress tasks, ties go to the lowest user id
+type leastOpenTasksStrategy struct{}
+
+func (leastOpenTasksStrategy) pick(_ *models.Task, candidates []*assignmentCandidate, _ *uuid.UUID) *assignmentCandidate {
+	var best *assignmentCandidate
+	for _, candidate := range candidates {
+		if best == nil || candidate.openTasks < best.openTasks {
+			best = candidate
+		}
+	}
+	return best
+}
+
+// roundRobinStrategy picks the worker following the previous one in user id
+// order, wrapping around
+type roundRobinStrategy struct{}
+
+func (roundRobinStrategy) pick(_ *models.Task, candidates []*assignmentCandidate, last *uuid.UUID) *assignmentCandidate {
+	if len(candidates) == 0 {
+		return nil
+	}
+	if last == nil {
+		return candidates[0]
+	}
+	for _, candidate := range candidates {
+		if bytes.Compare(candidate.userID[:], last[:]) > 0 {
+			return candidate
+		}
+	}
+	return candidates[0]
+}
+
+// skillsStrategy picks the least loaded worker qualified for the task type
+type skillsStrategy struct{}
+
+func (skillsStrategy) pick(task *models.Task, candidates []*assignmentCandidate, last *uuid.UUID) *assignmentCandidate {
+	qualified := make([]*assignmentCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if slices.Contains(candidate.skills, task.Type) {
+			qualified = append(qualified, candidate)
+		}
+	}
+	return leastOpenTasksStrategy{}.pick(task, qualified, last)
+}
+
+// getAssignmentCandidates returns the on shift workers of the organization
+// with their open task counts, sorted by user id
+func (s *TaskService) getAssignmentCandidates(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID) ([]*assignmentCandidate, error) {
+	employees, err := s.employee.GetEmployees(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := s.employee.GetWorkerProfiles(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*assignmentCandidate, 0, len(employees))
+	for _, employee := range employees {
+		if employee.Role == nil || employee.Role.Name != models.RoleWorker {
+			continue
+		}
+		profile, ok := profiles[employee.UserID]
+		if !ok || !profile.OnShift || len(profile.UnitIDs) == 0 {
+			continue
+		}
+		candidates = append(candidates, &assignmentCandidate{
+			userID:  employee.UserID,
+			unitIDs: profile.UnitIDs,
+			skills:  profile.Skills,
+		})
+	}
+	if len(candidates) == 0 {
+		return candidates, nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return bytes.Compare(candidates[i].userID[:], candidates[j].userID[:]) < 0
+	})
+
+	userIDs := make([]uuid.UUID, len(candidates))
+	for i, candidate := range candidates {
+		userIDs[i] = candidate.userID
+	}
+	counts, err := qtx.CountOpenTasksByAssignee(ctx, sqlc.CountOpenTasksByAssigneeParams{
+		OrgID:   database.PgUUID(orgID),
+		UserIds: database.PgUUIDs(userIDs),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	openTasks := make(map[uuid.UUID]int, len(counts))
+	for _, count := range counts {
+		openTasks[database.UUIDFromPgx(count.AssignedToUserID)] = int(count.OpenTasks)
+	}
+	for _, candidate := range candidates {
+		candidate.openTasks = openTasks[candidate.userID]
+	}
+
+	return candidates, nil
+}
+
+// lockUnitAssignment serializes automatic assignment in the unit until the
+// transaction ends and returns the worker who got the previous task
+func lockUnitAssignment(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, unitID uuid.UUID) (*uuid.UUID, error) {
+	last, err := qtx.GetUnitLastAssignedUserForUpdate(ctx, sqlc.GetUnitLastAssignedUserForUpdateParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(unitID),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	return database.UUIDPtrFromPgx(last), nil
+}
+
+// pickAssignee picks the worker for the task among the candidates working in
+// its unit, except the excluded one, and advances the unit cursor. The unit
+// must be locked with lockUnitAssignment.
+func pickAssignee(ctx context.Context, qtx *sqlc.Queries, strategy assignmentStrategy, task *models.Task, candidates []*assignmentCandidate, last *uuid.UUID, exclude *uuid.UUID) (*assignmentCandidate, error) {
+	unitCandidates := make([]*assignmentCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if exclude != nil && candidate.userID == *exclude {
+			continue
+		}
+		if slices.Contains(candidate.unitIDs, task.UnitID) {
+			unitCandidates = append(unitCandidates, candidate)
+		}
+	}
+
+	picked := strategy.pick(task, unitCandidates, last)
+	if picked == nil {
+		return nil, nil
+	}
+	picked.openTasks++
+
+	err := qtx.SetUnitLastAssignedUser(ctx, sqlc.SetUnitLastAssignedUserParams{
+		OrgID:              database.PgUUID(task.OrgID),
+		ID:                 database.PgUUID(task.UnitID),
+		LastAssignedUserID: database.PgUUID(picked.userID),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	return picked, nil
+}
+
+// getAssignmentStrategy returns the automatic assignment strategy of the
+// organization, nil when tasks are assigned manually
+func (s *TaskService) getAssignmentStrategy(ctx context.Context, orgID uuid.UUID) (assignmentStrategy, error) {
+	org, err := s.org.GetOrganizationByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return assignmentStrategies[org.TaskAssignmentStrategy], nil
+}
+
+// autoAssignTask assigns a just created unassigned task with the strategy of
+// the organization. The task stays unassigned when the organization assigns
+// tasks manually or no worker fits.
+func (s *TaskService) autoAssignTask(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task *models.Task) error {
+	strategy, err := s.getAssignmentStrategy(ctx, orgID)
+	if err != nil || strategy == nil {
+		return err
+	}
+
+	last, err := lockUnitAssignment(ctx, qtx, orgID, task.UnitID)
+	if err != nil {
+		return err
+	}
+	candidates, err := s.getAssignmentCandidates(ctx, qtx, orgID)
+	if err != nil {
+		return err
+	}
+	picked, err := pickAssignee(ctx, qtx, strategy, task, candidates, last, nil)
+	if err != nil || picked == nil {
+		return err
+	}
+
+	now := time.Now()
+	updated, err := qtx.SetTaskAssignee(ctx, sqlc.SetTaskAssigneeParams{
+		OrgID:            database.PgUUID(orgID),
+		ID:               database.PgUUID(task.ID),
+		AssignedToUserID: database.PgUUID(picked.userID),
+		AssignedAt:       database.PgTimestamp(now),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+
+	task.AssignedToUserID = database.UUIDPtrFromPgx(updated.AssignedToUserID)
+	task.AssignedAt = database.PgTimePtrFromPgx(updated.AssignedAt)
+	return nil
+}
+
+// RebalanceWorkerTasks hands the pending tasks of a worker who went off shift
+// over to other workers with the strategy of the organization. Tasks nobody
+// fits are unassigned, so they can be claimed. Tasks in progress stay with the
+// worker. Returns the number of tasks moved.
+func (s *TaskService) RebalanceWorkerTasks(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (int, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "RebalanceWorkerTasks", func(ctx context.Context, span trace.Span) (int, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("user.id", userID.String()),
+		)
+
+		strategy, err := s.getAssignmentStrategy(ctx, orgID)
+		if err != nil || strategy == nil {
+			return 0, err
+		}
+
+		type reassignment struct {
+			before  *models.Task
+			updated sqlc.Task
+		}
+		var moved []reassignment
+
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			rows, err := qtx.GetPendingTasksAssignedToUserForUpdate(ctx, sqlc.GetPendingTasksAssignedToUserForUpdateParams{
+				OrgID:            database.PgUUID(orgID),
+				AssignedToUserID: database.PgUUID(userID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if len(rows) == 0 {
+				return nil
+			}
+
+			// Units are locked in a fixed order, so concurrent rebalances do
+			// not deadlock
+			tasks := make([]*models.Task, len(rows))
+			var unitIDs []uuid.UUID
+			for i, row := range rows {
+				tasks[i] = toTask(row)
+				if !slices.Contains(unitIDs, tasks[i].UnitID) {
+					unitIDs = append(unitIDs, tasks[i].UnitID)
+				}
+			}
+			sort.Slice(unitIDs, func(i, j int) bool {
+				return bytes.Compare(unitIDs[i][:], unitIDs[j][:]) < 0
+			})
+			last := make(map[uuid.UUID]*uuid.UUID, len(unitIDs))
+			for _, unitID := range unitIDs {
+				last[unitID], err = lockUnitAssignment(ctx, qtx, orgID, unitID)
+				if err != nil {
+					return err
+				}
+			}
+
+			candidates, err := s.getAssignmentCandidates(ctx, qtx, orgID)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			for _, task := range tasks {
+				picked, err := pickAssignee(ctx, qtx, strategy, task, candidates, last[task.UnitID], &userID)
+				if err != nil {
+					return err
+				}
+
+				var assignee *uuid.UUID
+				var assignedAt *time.Time
+				if picked != nil {
+					assignee = &picked.userID
+					assignedAt = &now
+					last[task.UnitID] = &picked.userID
+				}
+
+				updated, err := qtx.SetTaskAssignee(ctx, sqlc.SetTaskAssigneeParams{
+					OrgID:            database.PgUUID(orgID),
+					ID:               database.PgUUID(task.ID),
+					AssignedToUserID: database.PgUUIDPtr(assignee),
+					AssignedAt:       database.PgTimestampPtr(assignedAt),
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+				moved = append(moved, reassignment{before: task, updated: updated})
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		for _, m := range moved {
+			if _, err := s.auditAssignment(ctx, m.before, m.updated); err != nil {
+				return 0, err
+			}
+		}
+
+		span.SetAttributes(attribute.Int("tasks.moved", len(moved)))
+		return len(moved), nil
+	})
+}
+
+func validateWorkerSkills(skills []models.TaskType) error {
+	for _, skill := range skills {
+		switch skill {
+		case models.TaskTypePickmentItem, models.TaskTypeMovement, models.TaskTypeReceiving,
+			models.TaskTypeInventoryCount, models.TaskTypeReturn:
+		default:
+			return common.ErrDetailedValidationErrorWithMessage("invalid skill, must be a task type")
+		}
+	}
+	return nil
+}
+
+func (s *TaskService) GetWorkerProfile(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (*models.WorkerProfile, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetWorkerProfile", func(ctx context.Context, span trace.Span) (*models.WorkerProfile, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("user.id", userID.String()),
+		)
+
+		if _, err := s.employee.GetEmployee(ctx, orgID, userID); err != nil {
+			return nil, err
+		}
+		return s.employee.GetWorkerProfile(ctx, orgID, userID)
+	})
+}
+
+// SetWorkerProfile replaces the worker profile of the employee. Pending tasks
+// of a worker going off shift are rebalanced.
+func (s *TaskService) SetWorkerProfile(ctx context.Context, orgID uuid.UUID, profile *models.WorkerProfile) (*models.WorkerProfile, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "SetWorkerProfile", func(ctx context.Context, span trace.Span) (*models.WorkerProfile, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("user.id", profile.UserID.String()),
+		)
+
+		if err := validateWorkerSkills(profile.Skills); err != nil {
+			return nil, err
+		}
+		profile.Skills = slices.Compact(slices.Sorted(slices.Values(profile.Skills)))
+
+		unitIDs := make([]uuid.UUID, 0, len(profile.UnitIDs))
+		for _, unitID := range profile.UnitIDs {
+			if !slices.Contains(unitIDs, unitID) {
+				unitIDs = append(unitIDs, unitID)
+			}
+		}
+		units, err := s.org.GetUnitsByIDs(ctx, orgID, unitIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(units) != len(unitIDs) {
+			return nil, common.ErrDetailedValidationErrorWithMessage("unit not found")
+		}
+		profile.UnitIDs = unitIDs
+
+		before, err := s.GetWorkerProfile(ctx, orgID, profile.UserID)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := s.employee.SetWorkerProfile(ctx, orgID, profile)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.rebalanceIfLeftShift(ctx, orgID, before, updated); err != nil {
+			return nil, err
+		}
+		return updated, nil
+	})
+}
+
+// SetWorkerOnShift starts or ends the shift of the employee. Pending tasks of
+// a worker going off shift are rebalanced.
+func (s *TaskService) SetWorkerOnShift(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, onShift bool) (*models.WorkerProfile, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "SetWorkerOnShift", func(ctx context.Context, span trace.Span) (*models.WorkerProfile, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("user.id", userID.String()),
+			attribute.Bool("worker.on_shift", onShift),
+		)
+
+		before, err := s.GetWorkerProfile(ctx, orgID, userID)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := s.employee.SetWorkerOnShift(ctx, orgID, userID, onShift)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.rebalanceIfLeftShift(ctx, orgID, before, updated); err != nil {
+			return nil, err
+		}
+		return updated, nil
+	})
+}
+
+func (s *TaskService) rebalanceIfLeftShift(ctx context.Context, orgID uuid.UUID, before *models.WorkerProfile, after *models.WorkerProfile) error {
+	if !before.OnShift || after.OnShift {
+		return nil
+	}
+
+	moved, err := s.RebalanceWorkerTasks(ctx, orgID, after.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to rebalance worker tasks: %w", err)
+	}
+	if moved > 0 {
+		slog.Info("Worker tasks rebalanced", "user_id", after.UserID, "count", moved)
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package tasks
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const maxCommentLength = 4096
+
+func toTaskComment(comment sqlc.TaskComment) *models.TaskComment {
+	return &models.TaskComment{
+		ID:           database.UUIDFromPgx(comment.ID),
+		OrgID:        database.UUIDFromPgx(comment.OrgID),
+		TaskID:       database.UUIDFromPgx(comment.TaskID),
+		InstanceID:   database.UUIDPtrFromPgx(comment.ItemInstanceID),
+		Body:         comment.Body,
+		AuthorUserID: database.UUIDPtrFromPgx(comment.AuthorUserID),
+		CreatedAt:    comment.CreatedAt.Time,
+		EditedAt:     database.PgTimePtrFromPgx(comment.EditedAt),
+	}
+}
+
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", common.ErrDetailedValidationErrorWithMessage("comment can not be empty")
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return "", common.ErrDetailedValidationErrorWithMessage("comment is too long")
+	}
+	return body, nil
+}
+
+// ensureTaskTarget checks the task exists and, when instanceID is set, that
+// the instance is one of its items
+func (s *TaskService) ensureTaskTarget(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, instanceID *uuid.UUID) error {
+	_, err := s.queries.GetTaskById(ctx, sqlc.GetTaskByIdParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(taskID),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+
+	if instanceID == nil {
+		return nil
+	}
+	_, err = s.queries.GetTaskItem(ctx, sqlc.GetTaskItemParams{
+		OrgID:          database.PgUUID(orgID),
+		TaskID:         database.PgUUID(taskID),
+		ItemInstanceID: database.PgUUID(*instanceID),
+	})
+	if err != nil {
+		if database.IsNotFound(err) {
+			return ErrInstanceNotInTask
+		}
+		return services.MapDbErrorToService(err)
+	}
+	return nil
+}
+
+func (s *TaskService) loadTaskCommentsAuthors(ctx context.Context, orgID uuid.UUID, comments []*models.TaskComment) error {
+	userIDs := make([]uuid.UUID, 0, len(comments))
+	for _, comment := range comments {
+		if comment.AuthorUserID != nil {
+			userIDs = append(userIDs, *comment.AuthorUserID)
+		}
+	}
+
+	employees, err := s.employee.GetEmployeesByUserIDs(ctx, orgID, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, comment := range comments {
+		if comment.AuthorUserID != nil {
+			comment.Author = employees[*comment.AuthorUserID]
+		}
+	}
+	return nil
+}
+
+func (s *TaskService) auditTaskComment(ctx context.Context, action models.ObjectChangeAction, before *models.TaskComment, after *models.TaskComment) error {
+	target := after
+	if target == nil {
+		target = before
+	}
+	return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+		Action:           action,
+		TargetObjectType: models.ObjectTypeTaskComment,
+		TargetObjectID:   target.ID,
+		PrechangeState:   before,
+		PostchangeState:  after,
+	})
+}
+
+// CreateTaskComment leaves a comment on the task or on the task item of
+// comment.InstanceID. authorID is nil for comments left with an API token.
+func (s *TaskService) CreateTaskComment(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, authorID *uuid.UUID, comment *models.TaskComment) (*models.TaskComment, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CreateTaskComment", func(ctx context.Context, span trace.Span) (*models.TaskComment, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		body, err := validateCommentBody(comment.Body)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.ensureTaskTarget(ctx, orgID, taskID, comment.InstanceID); err != nil {
+			return nil, err
+		}
+
+		created, err := s.queries.CreateTaskComment(ctx, sqlc.CreateTaskCommentParams{
+			OrgID:          database.PgUUID(orgID),
+			TaskID:         database.PgUUID(taskID),
+			ItemInstanceID: database.PgUUIDPtr(comment.InstanceID),
+			Body:           body,
+			AuthorUserID:   database.PgUUIDPtr(authorID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		model := toTaskComment(created)
+		if err := s.loadTaskCommentsAuthors(ctx, orgID, []*models.TaskComment{model}); err != nil {
+			return nil, err
+		}
+
+		if err := s.auditTaskComment(ctx, models.ObjectChangeActionCreate, nil, model); err != nil {
+			return nil, err
+		}
+		return model, nil
+	})
+}
+
+// GetTaskComments returns the comments of the task, or only those left on
+// the task item of instanceID when it is set
+func (s *TaskService) GetTaskComments(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, instanceID *uuid.UUID) ([]*models.TaskComment, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTaskComments", func(ctx context.Context, span trace.Span) ([]*models.TaskComment, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		if err := s.ensureTaskTarget(ctx, orgID, taskID, nil); err != nil {
+			return nil, err
+		}
+
+		rows, err := s.queries.GetTaskComments(ctx, sqlc.GetTaskCommentsParams{
+			OrgID:          database.PgUUID(orgID),
+			TaskID:         database.PgUUID(taskID),
+			ItemInstanceID: database.PgUUIDPtr(instanceID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		comments := make([]*models.TaskComment, len(rows))
+		for i, row := range rows {
+			comments[i] = toTaskComment(row)
+		}
+		if err := s.loadTaskCommentsAuthors(ctx, orgID, comments); err != nil {
+			return nil, err
+		}
+
+		span.SetAttributes(attribute.Int("response.count", len(comments)))
+		return comments, nil
+	})
+}
+
+func (s *TaskService) GetTaskComment(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, commentID uuid.UUID) (*models.TaskComment, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTaskComment", func(ctx context.Context, span trace.Span) (*models.TaskComment, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("comment.id", commentID.String()),
+		)
+
+		comment, err := s.queries.GetTaskComment(ctx, sqlc.GetTaskCommentParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+			ID:     database.PgUUID(commentID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		model := toTaskComment(comment)
+		if err := s.loadTaskCommentsAuthors(ctx, orgID, []*models.TaskComment{model}); err != nil {
+			return nil, err
+		}
+		return model, nil
+	})
+}
+
+// UpdateTaskComment replaces the body of the comment and marks it as edited.
+// Previous versions stay in the audit log.
+func (s *TaskService) UpdateTaskComment(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, commentID uuid.UUID, body string) (*models.TaskComment, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "UpdateTaskComment", func(ctx context.Context, span trace.Span) (*models.TaskComment, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("comment.id", commentID.String()),
+		)
+
+		body, err := validateCommentBody(body)
+		if err != nil {
+			return nil, err
+		}
+
+		before, err := s.GetTaskComment(ctx, orgID, taskID, commentID)
+		if err != nil {
+			return nil, err
+		}
+
+		updated, err := s.queries.UpdateTaskComment(ctx, sqlc.UpdateTaskCommentParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+			ID:     database.PgUUID(commentID),
+			Body:   body,
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		model := toTaskComment(updated)
+		model.Author = before.Author
+
+		if err := s.auditTaskComment(ctx, models.ObjectChangeActionUpdate, before, model); err != nil {
+			return nil, err
+		}
+		return model, nil
+	})
+}
+
+func (s *TaskService) DeleteTaskComment(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, commentID uuid.UUID) error {
+	return telemetry.WithVoidTrace(ctx, s.tracer, "DeleteTaskComment", func(ctx context.Context, span trace.Span) error {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("comment.id", commentID.String()),
+		)
+
+		before, err := s.GetTaskComment(ctx, orgID, taskID, commentID)
+		if err != nil {
+			return err
+		}
+
+		err = s.queries.DeleteTaskComment(ctx, sqlc.DeleteTaskCommentParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+			ID:     database.PgUUID(commentID),
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+
+		return s.auditTaskComment(ctx, models.ObjectChangeActionDelete, before, nil)
+	})
+}
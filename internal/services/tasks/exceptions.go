@@ -0,0 +1,413 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	maxExceptionNoteLength   = 1024
+	maxSubstituteSuggestions = 10
+	defaultExceptionsLimit   = 100
+	maxExceptionsLimit       = 1000
+)
+
+var (
+	ErrTaskExceptionsNotSupported = fmt.Errorf("%w: exceptions can be reported only for pickment and movement tasks", common.ErrConflict)
+	ErrSubstituteNotAvailable     = fmt.Errorf("%w: substitute instance is not available", common.ErrConflict)
+)
+
+func validateTaskItemException(create *models.TaskItemExceptionCreate) error {
+	switch create.Reason {
+	case models.TaskItemExceptionReasonNotFound, models.TaskItemExceptionReasonDamaged, models.TaskItemExceptionReasonWrongItem:
+	default:
+		return common.ErrDetailedValidationErrorWithMessage("invalid exception reason")
+	}
+	if create.Note != nil && len(*create.Note) > maxExceptionNoteLength {
+		return common.ErrDetailedValidationErrorWithMessage("note is too long (max 1024 characters)")
+	}
+	if create.SubstituteInstanceID != nil && *create.SubstituteInstanceID == create.InstanceID {
+		return common.ErrDetailedValidationErrorWithMessage("instance cannot substitute itself")
+	}
+	return nil
+}
+
+// ReportTaskItemException marks a pending task item as short. A damaged
+// instance is quarantined in its cell. If a substitute is requested, another
+// available instance of the same variant from the task unit is added to the
+// task with the target cell of the short item.
+func (s *TaskService) ReportTaskItemException(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, create *models.TaskItemExceptionCreate) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "ReportTaskItemException", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("instance.id", create.InstanceID.String()),
+			attribute.String("exception.reason", string(create.Reason)),
+		)
+
+		if err := validateTaskItemException(create); err != nil {
+			return nil, err
+		}
+
+		userID, err := common.GetUserIDFromContextIfExists(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		taskBefore, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		instanceBefore, err := s.item.GetItemInstanceById(ctx, orgID, create.InstanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			switch models.TaskType(task.Type) {
+			case models.TaskTypePickmentItem, models.TaskTypeMovement:
+			default:
+				return ErrTaskExceptionsNotSupported
+			}
+
+			status := models.TaskStatus(task.Status)
+			if !isTaskOpen(status) {
+				return ErrTaskClosed
+			}
+			if status != models.TaskStatusPending && status != models.TaskStatusInProgress {
+				return fmt.Errorf("%w: cannot report exceptions for task in status %s", ErrInvalidStatusTransition, status)
+			}
+
+			taskItem, err := qtx.GetTaskItemForUpdate(ctx, sqlc.GetTaskItemForUpdateParams{
+				OrgID:          database.PgUUID(orgID),
+				TaskID:         database.PgUUID(taskID),
+				ItemInstanceID: database.PgUUID(create.InstanceID),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return ErrInstanceNotInTask
+				}
+				return services.MapDbErrorToService(err)
+			}
+			if models.TaskItemStatus(taskItem.Status) != models.TaskItemStatusPending {
+				return ErrTaskItemNotPending
+			}
+
+			instance, err := qtx.GetItemInstanceForUpdate(ctx, sqlc.GetItemInstanceForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(create.InstanceID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			var substituteID *uuid.UUID
+			if create.SubstituteInstanceID != nil || create.AutoSubstitute {
+				candidates, err := qtx.GetSubstituteInstances(ctx, sqlc.GetSubstituteInstancesParams{
+					OrgID:      database.PgUUID(orgID),
+					VariantID:  instance.VariantID,
+					UnitID:     task.UnitID,
+					InstanceID: database.PgUUIDPtr(create.SubstituteInstanceID),
+					MaxRows:    1,
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+				if len(candidates) == 0 && create.SubstituteInstanceID != nil {
+					return ErrSubstituteNotAvailable
+				}
+				if len(candidates) > 0 {
+					substituteID = database.UUIDPtrFromPgx(candidates[0].ID)
+				}
+			}
+
+			if substituteID != nil {
+				substitute, err := qtx.GetItemInstanceForUpdate(ctx, sqlc.GetItemInstanceForUpdateParams{
+					OrgID: database.PgUUID(orgID),
+					ID:    database.PgUUIDPtr(substituteID),
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+				if models.ItemInstanceStatus(substitute.Status) != models.ItemInstanceStatusAvailable {
+					return ErrSubstituteNotAvailable
+				}
+
+				_, err = qtx.CreateTaskItem(ctx, sqlc.CreateTaskItemParams{
+					OrgID:             database.PgUUID(orgID),
+					TaskID:            task.ID,
+					ItemInstanceID:    substitute.ID,
+					SourceCellID:      substitute.CellID,
+					DestinationCellID: taskItem.DestinationCellID,
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+			}
+
+			err = qtx.SetTaskItemStatus(ctx, sqlc.SetTaskItemStatusParams{
+				OrgID:          database.PgUUID(orgID),
+				TaskID:         task.ID,
+				ItemInstanceID: instance.ID,
+				Status:         sqlc.TaskItemStatus(models.TaskItemStatusShort),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			// A damaged instance must not be offered to other tasks
+			if create.Reason == models.TaskItemExceptionReasonDamaged &&
+				models.ItemInstanceStatus(instance.Status) == models.ItemInstanceStatusAvailable {
+				err = qtx.SetItemInstanceTaskStatus(ctx, sqlc.SetItemInstanceTaskStatusParams{
+					OrgID:            database.PgUUID(orgID),
+					ID:               instance.ID,
+					Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusQuarantined),
+					AffectedByTaskID: task.ID,
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+			}
+
+			_, err = qtx.CreateTaskItemException(ctx, sqlc.CreateTaskItemExceptionParams{
+				OrgID:                database.PgUUID(orgID),
+				TaskID:               task.ID,
+				ItemInstanceID:       instance.ID,
+				Reason:               sqlc.TaskItemExceptionReason(create.Reason),
+				Note:                 database.PgTextPtr(create.Note),
+				SubstituteInstanceID: database.PgUUIDPtr(substituteID),
+				ReportedByUserID:     database.PgUUIDPtr(userID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		taskAfter, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeTask,
+			TargetObjectID:   taskID,
+			PrechangeState:   taskBefore,
+			PostchangeState:  taskAfter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		instanceAfter, err := s.item.GetItemInstanceById(ctx, orgID, create.InstanceID)
+		if err != nil {
+			return nil, err
+		}
+		if instanceAfter.Status != instanceBefore.Status {
+			reason := models.ObjectChangeReasonItemDamaged
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypeItemInstance,
+				TargetObjectID:   create.InstanceID,
+				PrechangeState:   instanceBefore,
+				PostchangeState:  instanceAfter,
+				Reason:           &reason,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return taskAfter, nil
+	})
+}
+
+// GetSubstituteInstances suggests available instances of the same variant
+// from the task unit to replace a task item, ordered by their place in the
+// cells group
+func (s *TaskService) GetSubstituteInstances(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, instanceID uuid.UUID) ([]*models.ItemInstance, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetSubstituteInstances", func(ctx context.Context, span trace.Span) ([]*models.ItemInstance, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("instance.id", instanceID.String()),
+		)
+
+		task, err := s.queries.GetTaskById(ctx, sqlc.GetTaskByIdParams{
+			OrgID: database.PgUUID(orgID),
+			ID:    database.PgUUID(taskID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		items, err := s.queries.GetTaskItems(ctx, sqlc.GetTaskItemsParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: task.ID,
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+		inTask := false
+		for _, item := range items {
+			if database.UUIDFromPgx(item.ItemInstanceID) == instanceID {
+				inTask = true
+				break
+			}
+		}
+		if !inTask {
+			return nil, ErrInstanceNotInTask
+		}
+
+		instance, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		candidates, err := s.queries.GetSubstituteInstances(ctx, sqlc.GetSubstituteInstancesParams{
+			OrgID:     database.PgUUID(orgID),
+			VariantID: database.PgUUID(instance.VariantID),
+			UnitID:    task.UnitID,
+			MaxRows:   maxSubstituteSuggestions,
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		ids := make([]uuid.UUID, len(candidates))
+		for i, candidate := range candidates {
+			ids[i] = database.UUIDFromPgx(candidate.ID)
+		}
+		instances, err := s.item.GetItemInstancesFull(ctx, orgID, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		res := make([]*models.ItemInstance, 0, len(ids))
+		for _, id := range ids {
+			if instance, ok := instances[id]; ok {
+				res = append(res, instance)
+			}
+		}
+		return res, nil
+	})
+}
+
+// GetTaskExceptionsReport lists reported exceptions, newest first, with the
+// number of exceptions per reason among them
+func (s *TaskService) GetTaskExceptionsReport(ctx context.Context, orgID uuid.UUID, filter *models.TaskExceptionFilter) (*models.TaskExceptionReport, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTaskExceptionsReport", func(ctx context.Context, span trace.Span) (*models.TaskExceptionReport, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		if filter.Limit == 0 {
+			filter.Limit = defaultExceptionsLimit
+		}
+		if filter.Limit < 0 || filter.Limit > maxExceptionsLimit {
+			return nil, common.ErrDetailedValidationErrorWithMessage("limit must be between 1 and 1000")
+		}
+
+		var reason sqlc.NullTaskItemExceptionReason
+		if filter.Reason != nil {
+			reason = sqlc.NullTaskItemExceptionReason{TaskItemExceptionReason: sqlc.TaskItemExceptionReason(*filter.Reason), Valid: true}
+		}
+
+		rows, err := s.queries.GetTaskItemExceptionsReport(ctx, sqlc.GetTaskItemExceptionsReportParams{
+			OrgID:       database.PgUUID(orgID),
+			UnitID:      database.PgUUIDPtr(filter.UnitID),
+			Reason:      reason,
+			CreatedFrom: database.PgTimestampPtr(filter.CreatedFrom),
+			CreatedTo:   database.PgTimestampPtr(filter.CreatedTo),
+			MaxRows:     int32(filter.Limit),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		res := &models.TaskExceptionReport{
+			Exceptions:     make([]*models.TaskItemException, len(rows)),
+			CountsByReason: make(map[models.TaskItemExceptionReason]int),
+		}
+		for i, row := range rows {
+			res.Exceptions[i] = toTaskItemException(row)
+			res.CountsByReason[res.Exceptions[i].Reason]++
+		}
+
+		if err := s.loadTaskExceptionsRelations(ctx, orgID, res.Exceptions); err != nil {
+			return nil, fmt.Errorf("failed to load exceptions: %w", err)
+		}
+		return res, nil
+	})
+}
+
+func (s *TaskService) loadTaskExceptions(ctx context.Context, orgID uuid.UUID, task *models.Task) error {
+	rows, err := s.queries.GetTaskItemExceptions(ctx, sqlc.GetTaskItemExceptionsParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: database.PgUUID(task.ID),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+
+	task.Exceptions = make([]*models.TaskItemException, len(rows))
+	for i, row := range rows {
+		task.Exceptions[i] = toTaskItemException(row)
+	}
+	return s.loadTaskExceptionsRelations(ctx, orgID, task.Exceptions)
+}
+
+func (s *TaskService) loadTaskExceptionsRelations(ctx context.Context, orgID uuid.UUID, exceptions []*models.TaskItemException) error {
+	instanceIDs := make([]uuid.UUID, 0, len(exceptions))
+	userIDs := make([]uuid.UUID, 0, len(exceptions))
+	for _, exception := range exceptions {
+		instanceIDs = append(instanceIDs, exception.InstanceID)
+		if exception.ReportedByUserID != nil {
+			userIDs = append(userIDs, *exception.ReportedByUserID)
+		}
+	}
+
+	instances, err := s.item.GetItemInstancesFull(ctx, orgID, instanceIDs)
+	if err != nil {
+		return err
+	}
+
+	employees, err := s.employee.GetEmployeesByUserIDs(ctx, orgID, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, exception := range exceptions {
+		exception.Instance = instances[exception.InstanceID]
+		if exception.ReportedByUserID != nil {
+			exception.ReportedBy = employees[*exception.ReportedByUserID]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const maxStatusCommentLength = 1024
+
+func validateStatusComment(comment *string) error {
+	if comment != nil && len(*comment) > maxStatusCommentLength {
+		return common.ErrDetailedValidationErrorWithMessage("comment is too long (max 1024 characters)")
+	}
+	return nil
+}
+
+// updateTaskStatus changes the task status and records the transition in the
+// task status history. It has to be called in the transaction that changes
+// the task, so the history never diverges from the task itself.
+func updateTaskStatus(ctx context.Context, qtx *sqlc.Queries, from models.TaskStatus, comment *string, params sqlc.UpdateTaskParams) (sqlc.Task, error) {
+	updated, err := qtx.UpdateTask(ctx, params)
+	if err != nil {
+		return sqlc.Task{}, services.MapDbErrorToService(err)
+	}
+
+	if err := recordTaskStatusChange(ctx, qtx, updated, &from, comment); err != nil {
+		return sqlc.Task{}, err
+	}
+	return updated, nil
+}
+
+// recordTaskStatusChange adds a history entry for the current status of the
+// task. The actor is taken from the context and is nil for API tokens and
+// background jobs.
+func recordTaskStatusChange(ctx context.Context, qtx *sqlc.Queries, task sqlc.Task, from *models.TaskStatus, comment *string) error {
+	userID, err := common.GetUserIDFromContextIfExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	var fromStatus sqlc.NullTaskStatus
+	if from != nil {
+		fromStatus = sqlc.NullTaskStatus{TaskStatus: sqlc.TaskStatus(*from), Valid: true}
+	}
+
+	err = qtx.CreateTaskStatusHistory(ctx, sqlc.CreateTaskStatusHistoryParams{
+		OrgID:           task.OrgID,
+		TaskID:          task.ID,
+		FromStatus:      fromStatus,
+		ToStatus:        task.Status,
+		ChangedByUserID: database.PgUUIDPtr(userID),
+		Comment:         database.PgTextPtr(comment),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	return nil
+}
+
+// GetTaskStatusHistory returns status transitions of the task from the
+// oldest to the newest, with the time spent in each status.
+func (s *TaskService) GetTaskStatusHistory(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID) ([]*models.TaskStatusChange, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTaskStatusHistory", func(ctx context.Context, span trace.Span) ([]*models.TaskStatusChange, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		_, err := s.queries.GetTaskById(ctx, sqlc.GetTaskByIdParams{
+			OrgID: database.PgUUID(orgID),
+			ID:    database.PgUUID(taskID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		rows, err := s.queries.GetTaskStatusHistory(ctx, sqlc.GetTaskStatusHistoryParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		res := make([]*models.TaskStatusChange, len(rows))
+		userIDs := make([]uuid.UUID, 0, len(rows))
+		for i, row := range rows {
+			res[i] = toTaskStatusChange(row)
+			if res[i].ChangedByUserID != nil {
+				userIDs = append(userIDs, *res[i].ChangedByUserID)
+			}
+			if i > 0 {
+				duration := res[i].ChangedAt.Sub(res[i-1].ChangedAt)
+				res[i-1].Duration = &duration
+			}
+		}
+
+		employees, err := s.employee.GetEmployeesByUserIDs(ctx, orgID, userIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range res {
+			if change.ChangedByUserID != nil {
+				change.ChangedBy = employees[*change.ChangedByUserID]
+			}
+		}
+
+		return res, nil
+	})
+}
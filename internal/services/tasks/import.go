@@ -0,0 +1,654 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	maxTaskImportSize     = 10 << 20
+	maxTaskImportRows     = 5000
+	maxTaskImportQuantity = 10000
+	maxTaskNameLength     = 255
+)
+
+var (
+	ErrTaskImportTooLarge    = common.ErrDetailedValidationErrorWithMessage("import file is too large")
+	ErrTaskImportTooManyRows = common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("import can not have more than %d rows", maxTaskImportRows))
+	ErrTaskImportEmpty       = common.ErrDetailedValidationErrorWithMessage("import has no rows")
+
+	ErrTaskImportInstancesTaken = fmt.Errorf("%w: instances of the import were taken by other tasks", common.ErrConflict)
+)
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// Columns of the CSV header. JSON lines use the same keys in camel case.
+const (
+	taskImportColumnName       = "name"
+	taskImportColumnType       = "type"
+	taskImportColumnUnit       = "unit"
+	taskImportColumnInstanceID = "instance_id"
+	taskImportColumnArticle    = "article"
+	taskImportColumnQuantity   = "quantity"
+	taskImportColumnTargetCell = "target_cell"
+)
+
+// taskImportFields are the values of a row as they are written in the file
+type taskImportFields struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Unit       string `json:"unit"`
+	InstanceID string `json:"instanceId"`
+	Article    string `json:"article"`
+	Quantity   *int   `json:"quantity"`
+	TargetCell string `json:"targetCell"`
+}
+
+func addTaskImportError(report *models.TaskImportReport, line int, format string, args ...any) {
+	report.Errors = append(report.Errors, models.TaskImportError{
+		Line:    line,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// toTaskImportRow parses the values of a row. Rows with malformed values are
+// reported and skipped.
+func toTaskImportRow(line int, fields taskImportFields, report *models.TaskImportReport) *models.TaskImportRow {
+	row := &models.TaskImportRow{
+		Line:      line,
+		Name:      strings.TrimSpace(fields.Name),
+		Type:      models.TaskType(strings.TrimSpace(fields.Type)),
+		UnitAlias: strings.TrimSpace(fields.Unit),
+		Quantity:  fields.Quantity,
+	}
+
+	valid := true
+	if instanceID := strings.TrimSpace(fields.InstanceID); instanceID != "" {
+		id, err := uuid.Parse(instanceID)
+		if err != nil {
+			addTaskImportError(report, line, "invalid instance id %q", instanceID)
+			valid = false
+		}
+		row.InstanceID = &id
+	}
+	if article := strings.TrimSpace(fields.Article); article != "" {
+		row.Article = &article
+	}
+	if targetCell := strings.TrimSpace(fields.TargetCell); targetCell != "" {
+		row.TargetCellAlias = &targetCell
+	}
+
+	if !valid {
+		return nil
+	}
+	return row
+}
+
+func parseTaskImportCSV(data []byte, report *models.TaskImportReport) ([]*models.TaskImportRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrTaskImportEmpty
+	}
+	if err != nil {
+		return nil, common.ErrDetailedValidationErrorWithMessage(err.Error())
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		switch column {
+		case taskImportColumnName, taskImportColumnType, taskImportColumnUnit, taskImportColumnInstanceID,
+			taskImportColumnArticle, taskImportColumnQuantity, taskImportColumnTargetCell:
+		default:
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("unknown column %q", column))
+		}
+		if _, ok := columns[column]; ok {
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("duplicate column %q", column))
+		}
+		columns[column] = i
+	}
+	for _, column := range []string{taskImportColumnName, taskImportColumnUnit} {
+		if _, ok := columns[column]; !ok {
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("column %q is required", column))
+		}
+	}
+
+	value := func(record []string, column string) string {
+		if i, ok := columns[column]; ok {
+			return record[i]
+		}
+		return ""
+	}
+
+	rows := make([]*models.TaskImportRow, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, common.ErrDetailedValidationErrorWithMessage(err.Error())
+		}
+
+		report.RowsCount++
+		if report.RowsCount > maxTaskImportRows {
+			return nil, ErrTaskImportTooManyRows
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) != len(header) {
+			addTaskImportError(report, line, "row has %d fields, expected %d", len(record), len(header))
+			continue
+		}
+
+		fields := taskImportFields{
+			Name:       value(record, taskImportColumnName),
+			Type:       value(record, taskImportColumnType),
+			Unit:       value(record, taskImportColumnUnit),
+			InstanceID: value(record, taskImportColumnInstanceID),
+			Article:    value(record, taskImportColumnArticle),
+			TargetCell: value(record, taskImportColumnTargetCell),
+		}
+		if quantity := strings.TrimSpace(value(record, taskImportColumnQuantity)); quantity != "" {
+			parsed, err := strconv.Atoi(quantity)
+			if err != nil {
+				addTaskImportError(report, line, "invalid quantity %q", quantity)
+				continue
+			}
+			fields.Quantity = &parsed
+		}
+
+		if row := toTaskImportRow(line, fields, report); row != nil {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func parseTaskImportNDJSON(data []byte, report *models.TaskImportReport) ([]*models.TaskImportRow, error) {
+	rows := make([]*models.TaskImportRow, 0)
+	for i, raw := range bytes.Split(bytes.TrimPrefix(data, utf8BOM), []byte("\n")) {
+		raw = bytes.TrimSpace(raw)
+		if len(raw) == 0 {
+			continue
+		}
+
+		report.RowsCount++
+		if report.RowsCount > maxTaskImportRows {
+			return nil, ErrTaskImportTooManyRows
+		}
+
+		line := i + 1
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+
+		var fields taskImportFields
+		if err := decoder.Decode(&fields); err != nil {
+			addTaskImportError(report, line, "invalid JSON: %v", err)
+			continue
+		}
+		if decoder.More() {
+			addTaskImportError(report, line, "invalid JSON: one object per line is expected")
+			continue
+		}
+
+		if row := toTaskImportRow(line, fields, report); row != nil {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// plannedImportRow is a row which passed the checks of its own fields
+type plannedImportRow struct {
+	row          *models.TaskImportRow
+	task         *models.TaskImportTask
+	targetCellID *uuid.UUID
+	instanceIDs  []uuid.UUID
+	failed       bool
+}
+
+func (p *plannedImportRow) fail(report *models.TaskImportReport, format string, args ...any) {
+	addTaskImportError(report, p.row.Line, format, args...)
+	p.failed = true
+}
+
+// planTaskImport resolves the aliases, articles and instances of the rows and
+// groups them into tasks. Problems are added to the report, in which case no
+// tasks are returned.
+func (s *TaskService) planTaskImport(ctx context.Context, orgID uuid.UUID, rows []*models.TaskImportRow, report *models.TaskImportReport) ([]*models.TaskImportTask, error) {
+	units, err := s.org.GetAllUnits(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	unitsByAlias := make(map[string]*models.OrganizationUnit, len(units))
+	for _, unit := range units {
+		unitsByAlias[unit.Alias] = unit
+	}
+
+	tasks := make([]*models.TaskImportTask, 0)
+	tasksByName := make(map[string]*models.TaskImportTask)
+	planned := make([]*plannedImportRow, 0, len(rows))
+
+	for _, row := range rows {
+		line := row.Line
+
+		valid := true
+		if row.Name == "" {
+			addTaskImportError(report, line, "name is required")
+			valid = false
+		} else if len(row.Name) > maxTaskNameLength {
+			addTaskImportError(report, line, "name is too long")
+			valid = false
+		}
+
+		switch row.Type {
+		case "":
+			row.Type = models.TaskTypePickmentItem
+		case models.TaskTypePickmentItem, models.TaskTypeMovement:
+		default:
+			addTaskImportError(report, line, "type must be pickment or movement")
+			valid = false
+		}
+
+		unit, ok := unitsByAlias[row.UnitAlias]
+		if row.UnitAlias == "" {
+			addTaskImportError(report, line, "unit is required")
+			valid = false
+		} else if !ok {
+			addTaskImportError(report, line, "unknown unit %q", row.UnitAlias)
+			valid = false
+		}
+
+		switch {
+		case row.InstanceID == nil && row.Article == nil:
+			addTaskImportError(report, line, "either instance id or article is required")
+			valid = false
+		case row.InstanceID != nil && row.Article != nil:
+			addTaskImportError(report, line, "instance id and article can not be set together")
+			valid = false
+		case row.InstanceID != nil && row.Quantity != nil:
+			addTaskImportError(report, line, "quantity can be set only with article")
+			valid = false
+		case row.Article != nil && (row.Quantity == nil || *row.Quantity < 1 || *row.Quantity > maxTaskImportQuantity):
+			addTaskImportError(report, line, "quantity must be between 1 and %d", maxTaskImportQuantity)
+			valid = false
+		}
+
+		if !valid {
+			continue
+		}
+
+		task, ok := tasksByName[row.Name]
+		if !ok {
+			task = &models.TaskImportTask{
+				Name:   row.Name,
+				Type:   row.Type,
+				UnitID: unit.ID,
+				Lines:  make([]int, 0),
+				Items:  make([]*models.TaskItem, 0),
+			}
+			tasksByName[row.Name] = task
+			tasks = append(tasks, task)
+		} else if task.UnitID != unit.ID {
+			addTaskImportError(report, line, "rows of task %q have different units", row.Name)
+			continue
+		} else if task.Type != row.Type {
+			addTaskImportError(report, line, "rows of task %q have different types", row.Name)
+			continue
+		}
+
+		planned = append(planned, &plannedImportRow{row: row, task: task})
+	}
+
+	if err := s.resolveImportTargetCells(ctx, orgID, planned, report); err != nil {
+		return nil, err
+	}
+	if err := s.resolveImportInstances(ctx, orgID, planned, report); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(report.Errors, func(i, j int) bool {
+		return report.Errors[i].Line < report.Errors[j].Line
+	})
+	if len(report.Errors) > 0 {
+		return nil, nil
+	}
+
+	for _, p := range planned {
+		p.task.Lines = append(p.task.Lines, p.row.Line)
+		for _, instanceID := range p.instanceIDs {
+			p.task.Items = append(p.task.Items, &models.TaskItem{
+				InstanceID:   &instanceID,
+				TargetCellID: p.targetCellID,
+			})
+		}
+	}
+	return tasks, nil
+}
+
+// resolveImportTargetCells looks the target cells up by alias within the unit
+// of the task. Cell aliases are unique only within a cells group, so an alias
+// shared by several cells of the unit is rejected.
+func (s *TaskService) resolveImportTargetCells(ctx context.Context, orgID uuid.UUID, planned []*plannedImportRow, report *models.TaskImportReport) error {
+	aliasesByUnit := make(map[uuid.UUID][]string)
+	for _, p := range planned {
+		if p.row.TargetCellAlias != nil {
+			aliasesByUnit[p.task.UnitID] = append(aliasesByUnit[p.task.UnitID], *p.row.TargetCellAlias)
+		}
+	}
+
+	cellsByUnit := make(map[uuid.UUID]map[string][]uuid.UUID, len(aliasesByUnit))
+	for unitID, aliases := range aliasesByUnit {
+		cells, err := s.queries.GetUnitCellsByAliases(ctx, sqlc.GetUnitCellsByAliasesParams{
+			OrgID:   database.PgUUID(orgID),
+			UnitID:  database.PgUUID(unitID),
+			Aliases: aliases,
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+
+		byAlias := make(map[string][]uuid.UUID, len(cells))
+		for _, cell := range cells {
+			byAlias[cell.Alias] = append(byAlias[cell.Alias], database.UUIDFromPgx(cell.ID))
+		}
+		cellsByUnit[unitID] = byAlias
+	}
+
+	cellIDs := make([]uuid.UUID, 0)
+	for _, p := range planned {
+		if p.row.TargetCellAlias == nil {
+			continue
+		}
+		alias := *p.row.TargetCellAlias
+		switch matches := cellsByUnit[p.task.UnitID][alias]; len(matches) {
+		case 0:
+			p.fail(report, "unknown cell %q", alias)
+		case 1:
+			p.targetCellID = &matches[0]
+			cellIDs = append(cellIDs, matches[0])
+		default:
+			p.fail(report, "cell alias %q matches several cells of the unit", alias)
+		}
+	}
+	if len(cellIDs) == 0 {
+		return nil
+	}
+
+	frozen, err := s.queries.GetFrozenCellIds(ctx, sqlc.GetFrozenCellIdsParams{
+		OrgID:   database.PgUUID(orgID),
+		CellIds: database.PgUUIDs(cellIDs),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	frozenIDs := make(map[uuid.UUID]bool, len(frozen))
+	for _, id := range frozen {
+		frozenIDs[database.UUIDFromPgx(id)] = true
+	}
+	for _, p := range planned {
+		if p.targetCellID != nil && frozenIDs[*p.targetCellID] {
+			p.fail(report, "cell %q is frozen by an inventory count", *p.row.TargetCellAlias)
+		}
+	}
+	return nil
+}
+
+// resolveImportInstances checks the listed instances can be put into a task
+// and selects available instances for rows with an article. Listed instances
+// are taken first, so article rows never pick them.
+func (s *TaskService) resolveImportInstances(ctx context.Context, orgID uuid.UUID, planned []*plannedImportRow, report *models.TaskImportReport) error {
+	idsByUnit := make(map[uuid.UUID][]uuid.UUID)
+	articles := make([]string, 0)
+	for _, p := range planned {
+		switch {
+		case p.row.InstanceID != nil:
+			idsByUnit[p.task.UnitID] = append(idsByUnit[p.task.UnitID], *p.row.InstanceID)
+		case p.row.Article != nil:
+			articles = append(articles, *p.row.Article)
+		}
+	}
+
+	available := make(map[uuid.UUID]bool)
+	for unitID, ids := range idsByUnit {
+		rows, err := s.queries.GetAvailableUnitInstanceIds(ctx, sqlc.GetAvailableUnitInstanceIdsParams{
+			OrgID:  database.PgUUID(orgID),
+			UnitID: database.PgUUID(unitID),
+			Ids:    database.PgUUIDs(ids),
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+		for _, id := range rows {
+			available[database.UUIDFromPgx(id)] = true
+		}
+	}
+
+	taken := make(map[uuid.UUID]bool)
+	for _, p := range planned {
+		if p.row.InstanceID == nil {
+			continue
+		}
+		instanceID := *p.row.InstanceID
+		switch {
+		case taken[instanceID]:
+			p.fail(report, "instance %s is listed more than once", instanceID)
+		case !available[instanceID]:
+			p.fail(report, "instance %s is not available in the unit", instanceID)
+		default:
+			taken[instanceID] = true
+			p.instanceIDs = []uuid.UUID{instanceID}
+		}
+	}
+
+	if len(articles) == 0 {
+		return nil
+	}
+	variants, err := s.queries.GetItemVariantsByBarcodes(ctx, sqlc.GetItemVariantsByBarcodesParams{
+		OrgID:    database.PgUUID(orgID),
+		Ean13s:   []int64{},
+		Articles: articles,
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	variantsByArticle := make(map[string][]uuid.UUID, len(variants))
+	for _, variant := range variants {
+		if variant.Article.Valid {
+			variantsByArticle[variant.Article.String] = append(variantsByArticle[variant.Article.String], database.UUIDFromPgx(variant.ID))
+		}
+	}
+
+	for _, p := range planned {
+		if p.row.Article == nil || p.failed {
+			continue
+		}
+		article := *p.row.Article
+		quantity := *p.row.Quantity
+
+		matches := variantsByArticle[article]
+		if len(matches) == 0 {
+			p.fail(report, "unknown article %q", article)
+			continue
+		}
+		if len(matches) > 1 {
+			p.fail(report, "article %q matches several variants", article)
+			continue
+		}
+
+		instances, err := s.queries.GetTemplateRuleInstances(ctx, sqlc.GetTemplateRuleInstancesParams{
+			OrgID:        database.PgUUID(orgID),
+			UnitID:       database.PgUUID(p.task.UnitID),
+			VariantID:    database.PgUUID(matches[0]),
+			TargetCellID: database.PgUUIDPtr(p.targetCellID),
+			// Instances taken by previous rows are skipped afterwards
+			MaxRows: int32(quantity + len(taken)),
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+
+		instanceIDs := make([]uuid.UUID, 0, quantity)
+		for _, instance := range instances {
+			instanceID := database.UUIDFromPgx(instance.ID)
+			if len(instanceIDs) == quantity || taken[instanceID] {
+				continue
+			}
+			instanceIDs = append(instanceIDs, instanceID)
+		}
+		if len(instanceIDs) < quantity {
+			p.fail(report, "only %d of %d instances of article %q are available in the unit", len(instanceIDs), quantity, article)
+			continue
+		}
+
+		for _, instanceID := range instanceIDs {
+			taken[instanceID] = true
+		}
+		p.instanceIDs = instanceIDs
+	}
+	return nil
+}
+
+// ImportTasks creates tasks from a CSV or JSON lines file. All rows are
+// validated first and nothing is created when any of them is invalid,
+// otherwise the tasks are created in one transaction. Instances of each task
+// are locked and checked to be still available before it is created. With
+// dryRun the report lists the tasks which would be created.
+func (s *TaskService) ImportTasks(ctx context.Context, orgID uuid.UUID, format models.TaskImportFormat, content io.Reader, dryRun bool) (*models.TaskImportReport, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "ImportTasks", func(ctx context.Context, span trace.Span) (*models.TaskImportReport, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("import.format", string(format)),
+			attribute.Bool("import.dry_run", dryRun),
+		)
+
+		data, err := io.ReadAll(io.LimitReader(content, maxTaskImportSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read import: %w", err)
+		}
+		if len(data) > maxTaskImportSize {
+			return nil, ErrTaskImportTooLarge
+		}
+
+		report := &models.TaskImportReport{
+			DryRun: dryRun,
+			Tasks:  make([]*models.TaskImportTask, 0),
+			Errors: make([]models.TaskImportError, 0),
+		}
+
+		var rows []*models.TaskImportRow
+		switch format {
+		case models.TaskImportFormatCSV:
+			rows, err = parseTaskImportCSV(data, report)
+		case models.TaskImportFormatNDJSON:
+			rows, err = parseTaskImportNDJSON(data, report)
+		default:
+			return nil, common.ErrDetailedValidationErrorWithMessage("unsupported import format")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if report.RowsCount == 0 {
+			return nil, ErrTaskImportEmpty
+		}
+
+		tasks, err := s.planTaskImport(ctx, orgID, rows, report)
+		if err != nil {
+			return nil, err
+		}
+
+		span.SetAttributes(
+			attribute.Int("import.rows", report.RowsCount),
+			attribute.Int("import.tasks", len(tasks)),
+			attribute.Int("import.errors", len(report.Errors)),
+		)
+
+		if len(report.Errors) > 0 {
+			return report, nil
+		}
+		if dryRun {
+			report.Tasks = tasks
+			return report, nil
+		}
+
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+			for _, task := range tasks {
+				if err := lockImportInstances(ctx, qtx, orgID, task); err != nil {
+					return fmt.Errorf("failed to create task %q from line %d: %w", task.Name, task.Lines[0], err)
+				}
+				created, err := s.createTask(ctx, qtx, orgID, &models.Task{
+					OrgID:  orgID,
+					UnitID: task.UnitID,
+					Type:   task.Type,
+					Name:   task.Name,
+					Items:  task.Items,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create task %q from line %d: %w", task.Name, task.Lines[0], err)
+				}
+				task.TaskID = &created.ID
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		report.Tasks = tasks
+		return report, nil
+	})
+}
+
+// lockImportInstances locks the instances of the planned task and checks they
+// are still available, they were selected before the import transaction
+// began and could be taken by other tasks since
+func lockImportInstances(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task *models.TaskImportTask) error {
+	instanceIDs, _ := taskItemsStockIDs(task.Items)
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+
+	if _, err := qtx.LockItemInstances(ctx, sqlc.LockItemInstancesParams{
+		OrgID: database.PgUUID(orgID),
+		Ids:   database.PgUUIDs(instanceIDs),
+	}); err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	available, err := qtx.GetAvailableUnitInstanceIds(ctx, sqlc.GetAvailableUnitInstanceIdsParams{
+		OrgID:  database.PgUUID(orgID),
+		UnitID: database.PgUUID(task.UnitID),
+		Ids:    database.PgUUIDs(instanceIDs),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	if len(available) < len(instanceIDs) {
+		return ErrTaskImportInstancesTaken
+	}
+	return nil
+}
@@ -0,0 +1,822 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrTaskNotInventoryCount = fmt.Errorf("%w: task is not an inventory count task", common.ErrConflict)
+	ErrCellNotInCount        = fmt.Errorf("%w: cell is not covered by the inventory count", common.ErrConflict)
+	ErrCountCellsNotCounted  = fmt.Errorf("%w: not all cells are counted", common.ErrConflict)
+	ErrCountStockChanged     = fmt.Errorf("%w: stock changed since the report was built", common.ErrConflict)
+)
+
+// resolveCountCells expands the count target of an inventory count task into
+// cell ids. All cells must belong to the task unit and must not be counted by
+// another open task.
+func (s *TaskService) resolveCountCells(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task *models.Task) ([]uuid.UUID, error) {
+	if len(task.Items) > 0 {
+		return nil, common.ErrDetailedValidationErrorWithMessage("inventory count tasks cannot contain items")
+	}
+
+	target := task.CountTarget
+	if target == nil {
+		return nil, common.ErrDetailedValidationErrorWithMessage("count target is required for inventory count tasks")
+	}
+
+	set := 0
+	if target.CellsGroupID != nil {
+		set++
+	}
+	if target.StorageGroupID != nil {
+		set++
+	}
+	if len(target.CellIDs) > 0 {
+		set++
+	}
+	if set != 1 {
+		return nil, common.ErrDetailedValidationErrorWithMessage("count target must have exactly one of cells group, storage group or cells")
+	}
+
+	var cellIDs []uuid.UUID
+	switch {
+	case target.CellsGroupID != nil:
+		group, err := s.storageService.GetCellsGroup(ctx, orgID, *target.CellsGroupID)
+		if err != nil {
+			return nil, err
+		}
+		if group.UnitID != task.UnitID {
+			return nil, common.ErrDetailedValidationErrorWithMessage("cells group must belong to the task unit")
+		}
+		cells, err := s.storageService.GetCells(ctx, orgID, group.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, cell := range cells {
+			cellIDs = append(cellIDs, cell.ID)
+		}
+	case target.StorageGroupID != nil:
+		group, ids, err := s.storageService.GetStorageGroupCellIDs(ctx, orgID, *target.StorageGroupID)
+		if err != nil {
+			return nil, err
+		}
+		if group.UnitID != task.UnitID {
+			return nil, common.ErrDetailedValidationErrorWithMessage("storage group must belong to the task unit")
+		}
+		cellIDs = ids
+	default:
+		seen := make(map[uuid.UUID]bool, len(target.CellIDs))
+		for _, id := range target.CellIDs {
+			if !seen[id] {
+				seen[id] = true
+				cellIDs = append(cellIDs, id)
+			}
+		}
+		cells, err := s.storageService.GetCellsFull(ctx, orgID, cellIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range cellIDs {
+			cell, ok := cells[id]
+			if !ok {
+				return nil, fmt.Errorf("failed to get cell: %w", common.ErrNotFound)
+			}
+			if !cellInUnit(cell, task.UnitID) {
+				return nil, common.ErrDetailedValidationErrorWithMessage("cells must belong to the task unit")
+			}
+		}
+	}
+
+	if len(cellIDs) == 0 {
+		return nil, common.ErrDetailedValidationErrorWithMessage("count target has no cells")
+	}
+
+	ids := make([]*uuid.UUID, len(cellIDs))
+	for i := range cellIDs {
+		ids[i] = &cellIDs[i]
+	}
+	if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, ids...); err != nil {
+		return nil, err
+	}
+
+	return cellIDs, nil
+}
+
+func (s *TaskService) createTaskCountCells(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, taskID uuid.UUID, cellIDs []uuid.UUID) error {
+	for _, cellID := range cellIDs {
+		err := qtx.CreateTaskCountCell(ctx, sqlc.CreateTaskCountCellParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+			CellID: database.PgUUID(cellID),
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+	}
+	return nil
+}
+
+func (s *TaskService) loadTaskCountCells(ctx context.Context, q *sqlc.Queries, orgID uuid.UUID, task *models.Task) error {
+	if task.Type != models.TaskTypeInventoryCount {
+		return nil
+	}
+
+	rows, err := q.GetTaskCountCells(ctx, sqlc.GetTaskCountCellsParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: database.PgUUID(task.ID),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+
+	cellIDs := make([]uuid.UUID, len(rows))
+	task.CountCells = make([]*models.TaskCountCell, len(rows))
+	for i, row := range rows {
+		task.CountCells[i] = toTaskCountCell(row)
+		cellIDs[i] = task.CountCells[i].CellID
+	}
+
+	cells, err := s.storageService.GetCellsFull(ctx, orgID, cellIDs)
+	if err != nil {
+		return err
+	}
+	for _, countCell := range task.CountCells {
+		countCell.Cell = cells[countCell.CellID]
+	}
+
+	return nil
+}
+
+func ensureAllCellsCounted(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, taskID uuid.UUID) error {
+	rows, err := qtx.GetTaskCountCells(ctx, sqlc.GetTaskCountCellsParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: database.PgUUID(taskID),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	for _, row := range rows {
+		if !row.CountedAt.Valid {
+			return ErrCountCellsNotCounted
+		}
+	}
+	return nil
+}
+
+// resolveBarcodes maps variant barcodes (EAN-13 or article) to variant ids
+func (s *TaskService) resolveBarcodes(ctx context.Context, q *sqlc.Queries, orgID uuid.UUID, barcodes []string) (map[string]uuid.UUID, error) {
+	result := make(map[string]uuid.UUID, len(barcodes))
+	if len(barcodes) == 0 {
+		return result, nil
+	}
+
+	ean13s := make([]int64, 0, len(barcodes))
+	for _, barcode := range barcodes {
+		if ean13, err := strconv.ParseInt(barcode, 10, 64); err == nil {
+			ean13s = append(ean13s, ean13)
+		}
+	}
+
+	variants, err := q.GetItemVariantsByBarcodes(ctx, sqlc.GetItemVariantsByBarcodesParams{
+		OrgID:    database.PgUUID(orgID),
+		Ean13s:   ean13s,
+		Articles: barcodes,
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+
+	for _, barcode := range barcodes {
+		ean13, ean13Err := strconv.ParseInt(barcode, 10, 64)
+		var matched []uuid.UUID
+		for _, variant := range variants {
+			if (variant.Article.Valid && variant.Article.String == barcode) ||
+				(ean13Err == nil && variant.Ean13.Valid && variant.Ean13.Int64 == ean13) {
+				matched = append(matched, database.UUIDFromPgx(variant.ID))
+			}
+		}
+		switch len(matched) {
+		case 0:
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("unknown barcode %s", barcode))
+		case 1:
+			result[barcode] = matched[0]
+		default:
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("barcode %s matches several variants", barcode))
+		}
+	}
+
+	return result, nil
+}
+
+// SubmitCount records what was found in a cell of an inventory count task.
+// A new submission for the same cell replaces the previous one, so a cell can
+// be recounted until the task is ready.
+func (s *TaskService) SubmitCount(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, userID *uuid.UUID, submission *models.TaskCountSubmission) error {
+	return telemetry.WithVoidTrace(ctx, s.tracer, "SubmitCount", func(ctx context.Context, span trace.Span) error {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("cell.id", submission.CellID.String()),
+			attribute.Int("instances.count", len(submission.InstanceIDs)),
+			attribute.Int("barcodes.count", len(submission.Barcodes)),
+		)
+
+		taskBefore, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return err
+		}
+
+		var taskAfter *models.Task
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if models.TaskType(task.Type) != models.TaskTypeInventoryCount {
+				return ErrTaskNotInventoryCount
+			}
+
+			status := models.TaskStatus(task.Status)
+			if !isTaskOpen(status) {
+				return ErrTaskClosed
+			}
+			if status != models.TaskStatusPending && status != models.TaskStatusInProgress {
+				return fmt.Errorf("%w: cannot count cells for task in status %s", ErrInvalidStatusTransition, status)
+			}
+
+			_, err = qtx.GetTaskCountCellForUpdate(ctx, sqlc.GetTaskCountCellForUpdateParams{
+				OrgID:  database.PgUUID(orgID),
+				TaskID: database.PgUUID(taskID),
+				CellID: database.PgUUID(submission.CellID),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return ErrCellNotInCount
+				}
+				return services.MapDbErrorToService(err)
+			}
+
+			instances, err := qtx.GetItemInstancesWithItemByIds(ctx, sqlc.GetItemInstancesWithItemByIdsParams{
+				OrgID: database.PgUUID(orgID),
+				Ids:   database.PgUUIDs(submission.InstanceIDs),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			variantByInstance := make(map[uuid.UUID]uuid.UUID, len(instances))
+			for _, row := range instances {
+				variantByInstance[database.UUIDFromPgx(row.ItemInstance.ID)] = database.UUIDFromPgx(row.ItemInstance.VariantID)
+			}
+
+			variantByBarcode, err := s.resolveBarcodes(ctx, qtx, orgID, submission.Barcodes)
+			if err != nil {
+				return err
+			}
+
+			err = qtx.DeleteTaskCountEntriesForCell(ctx, sqlc.DeleteTaskCountEntriesForCellParams{
+				OrgID:  database.PgUUID(orgID),
+				TaskID: database.PgUUID(taskID),
+				CellID: database.PgUUID(submission.CellID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			seen := make(map[uuid.UUID]bool, len(submission.InstanceIDs))
+			for _, instanceID := range submission.InstanceIDs {
+				variantID, ok := variantByInstance[instanceID]
+				if !ok {
+					return fmt.Errorf("failed to get instance: %w", common.ErrNotFound)
+				}
+				if seen[instanceID] {
+					continue
+				}
+				seen[instanceID] = true
+
+				err = qtx.CreateTaskCountEntry(ctx, sqlc.CreateTaskCountEntryParams{
+					OrgID:          database.PgUUID(orgID),
+					TaskID:         database.PgUUID(taskID),
+					CellID:         database.PgUUID(submission.CellID),
+					ItemInstanceID: database.PgUUID(instanceID),
+					VariantID:      database.PgUUID(variantID),
+					Quantity:       1,
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+			}
+
+			// Every scan of a variant barcode counts as one unit
+			quantities := make(map[uuid.UUID]int)
+			variantIDs := make([]uuid.UUID, 0)
+			for _, barcode := range submission.Barcodes {
+				variantID := variantByBarcode[barcode]
+				if quantities[variantID] == 0 {
+					variantIDs = append(variantIDs, variantID)
+				}
+				quantities[variantID]++
+			}
+			for _, variantID := range variantIDs {
+				err = qtx.CreateTaskCountEntry(ctx, sqlc.CreateTaskCountEntryParams{
+					OrgID:          database.PgUUID(orgID),
+					TaskID:         database.PgUUID(taskID),
+					CellID:         database.PgUUID(submission.CellID),
+					ItemInstanceID: database.PgUUIDPtr(nil),
+					VariantID:      database.PgUUID(variantID),
+					Quantity:       int32(quantities[variantID]),
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+			}
+
+			err = qtx.SetTaskCountCellCounted(ctx, sqlc.SetTaskCountCellCountedParams{
+				OrgID:           database.PgUUID(orgID),
+				TaskID:          database.PgUUID(taskID),
+				CellID:          database.PgUUID(submission.CellID),
+				CountedByUserID: database.PgUUIDPtr(userID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			// The first counted cell starts the task
+			if status == models.TaskStatusPending {
+				updated, err := updateTaskStatus(ctx, qtx, status, nil, sqlc.UpdateTaskParams{
+					OrgID:       database.PgUUID(orgID),
+					ID:          database.PgUUID(taskID),
+					Status:      sqlc.TaskStatus(models.TaskStatusInProgress),
+					CompletedAt: task.CompletedAt,
+				})
+				if err != nil {
+					return err
+				}
+				taskAfter = toTask(updated)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if taskAfter != nil {
+			return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypeTask,
+				TargetObjectID:   taskID,
+				PrechangeState:   taskBefore,
+				PostchangeState:  taskAfter,
+			})
+		}
+
+		return nil
+	})
+}
+
+type countCellVariant struct {
+	cellID    uuid.UUID
+	variantID uuid.UUID
+}
+
+// buildCountReport reconciles count entries with recorded stock. Instances
+// scanned by id are matched exactly; instances left in a counted cell are
+// matched against variant barcode quantities of that cell.
+func (s *TaskService) buildCountReport(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID) (*models.InventoryCountReport, error) {
+	report := &models.InventoryCountReport{
+		TaskID:           taskID,
+		Missing:          []*models.InventoryCountDiscrepancy{},
+		Unexpected:       []*models.InventoryCountDiscrepancy{},
+		Misplaced:        []*models.InventoryCountDiscrepancy{},
+		UncountedCellIDs: []uuid.UUID{},
+	}
+
+	countCells, err := s.queries.GetTaskCountCells(ctx, sqlc.GetTaskCountCellsParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: database.PgUUID(taskID),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+
+	countedCellIDs := make([]uuid.UUID, 0, len(countCells))
+	for _, countCell := range countCells {
+		if countCell.CountedAt.Valid {
+			countedCellIDs = append(countedCellIDs, database.UUIDFromPgx(countCell.CellID))
+		} else {
+			report.UncountedCellIDs = append(report.UncountedCellIDs, database.UUIDFromPgx(countCell.CellID))
+		}
+	}
+
+	entries, err := s.queries.GetTaskCountEntries(ctx, sqlc.GetTaskCountEntriesParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: database.PgUUID(taskID),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+
+	foundCells := make(map[uuid.UUID]uuid.UUID)
+	scannedIDs := make([]uuid.UUID, 0)
+	barcodeQuantities := make(map[countCellVariant]int)
+	barcodeKeys := make([]countCellVariant, 0)
+	for _, entry := range entries {
+		cellID := database.UUIDFromPgx(entry.CellID)
+		if entry.ItemInstanceID.Valid {
+			instanceID := database.UUIDFromPgx(entry.ItemInstanceID)
+			foundCells[instanceID] = cellID
+			scannedIDs = append(scannedIDs, instanceID)
+			continue
+		}
+		key := countCellVariant{cellID: cellID, variantID: database.UUIDFromPgx(entry.VariantID)}
+		if _, ok := barcodeQuantities[key]; !ok {
+			barcodeKeys = append(barcodeKeys, key)
+		}
+		barcodeQuantities[key] += int(entry.Quantity)
+	}
+
+	scanned, err := s.queries.GetItemInstancesWithItemByIds(ctx, sqlc.GetItemInstancesWithItemByIdsParams{
+		OrgID: database.PgUUID(orgID),
+		Ids:   database.PgUUIDs(scannedIDs),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	for _, row := range scanned {
+		instance := row.ItemInstance
+		instanceID := database.UUIDFromPgx(instance.ID)
+		foundCellID := foundCells[instanceID]
+		line := &models.InventoryCountDiscrepancy{
+			InstanceID:     &instanceID,
+			VariantID:      database.UUIDFromPgx(instance.VariantID),
+			ExpectedCellID: database.UUIDPtrFromPgx(instance.CellID),
+			FoundCellID:    &foundCellID,
+			Quantity:       1,
+		}
+
+		switch {
+		case models.ItemInstanceStatus(instance.Status) == models.ItemInstanceStatusConsumed,
+			models.ItemInstanceStatus(instance.Status) == models.ItemInstanceStatusDisposed,
+			models.ItemInstanceStatus(instance.Status) == models.ItemInstanceStatusShipped:
+			line.ExpectedCellID = nil
+			report.Unexpected = append(report.Unexpected, line)
+		case line.ExpectedCellID == nil || *line.ExpectedCellID != foundCellID:
+			report.Misplaced = append(report.Misplaced, line)
+		}
+	}
+
+	recorded, err := s.queries.GetItemInstancesForCells(ctx, sqlc.GetItemInstancesForCellsParams{
+		OrgID:   database.PgUUID(orgID),
+		CellIds: database.PgUUIDs(countedCellIDs),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	for _, instance := range recorded {
+		instanceID := database.UUIDFromPgx(instance.ID)
+		if _, ok := foundCells[instanceID]; ok {
+			continue
+		}
+
+		cellID := database.UUIDFromPgx(instance.CellID)
+		key := countCellVariant{cellID: cellID, variantID: database.UUIDFromPgx(instance.VariantID)}
+		if barcodeQuantities[key] > 0 {
+			barcodeQuantities[key]--
+			continue
+		}
+		// Instances reserved by open tasks, like ones sorted by a pick wave,
+		// are matched by barcode counts but left to those tasks, they are
+		// never written off
+		if models.ItemInstanceStatus(instance.Status) == models.ItemInstanceStatusReserved {
+			continue
+		}
+
+		report.Missing = append(report.Missing, &models.InventoryCountDiscrepancy{
+			InstanceID:     &instanceID,
+			VariantID:      key.variantID,
+			ExpectedCellID: &cellID,
+			Quantity:       1,
+		})
+	}
+
+	for _, key := range barcodeKeys {
+		if quantity := barcodeQuantities[key]; quantity > 0 {
+			cellID := key.cellID
+			report.Unexpected = append(report.Unexpected, &models.InventoryCountDiscrepancy{
+				VariantID:   key.variantID,
+				FoundCellID: &cellID,
+				Quantity:    quantity,
+			})
+		}
+	}
+
+	if err := s.loadCountReportRelations(ctx, orgID, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (s *TaskService) loadCountReportRelations(ctx context.Context, orgID uuid.UUID, report *models.InventoryCountReport) error {
+	lines := make([]*models.InventoryCountDiscrepancy, 0, len(report.Missing)+len(report.Unexpected)+len(report.Misplaced))
+	lines = append(lines, report.Missing...)
+	lines = append(lines, report.Unexpected...)
+	lines = append(lines, report.Misplaced...)
+
+	instanceIDs := make([]uuid.UUID, 0, len(lines))
+	variantIDs := make([]uuid.UUID, 0, len(lines))
+	cellIDs := append([]uuid.UUID{}, report.UncountedCellIDs...)
+	for _, line := range lines {
+		variantIDs = append(variantIDs, line.VariantID)
+		if line.InstanceID != nil {
+			instanceIDs = append(instanceIDs, *line.InstanceID)
+		}
+		if line.ExpectedCellID != nil {
+			cellIDs = append(cellIDs, *line.ExpectedCellID)
+		}
+		if line.FoundCellID != nil {
+			cellIDs = append(cellIDs, *line.FoundCellID)
+		}
+	}
+
+	instances, err := s.item.GetItemInstancesFull(ctx, orgID, instanceIDs)
+	if err != nil {
+		return err
+	}
+	variants, err := s.item.GetItemVariantsByIDs(ctx, orgID, variantIDs)
+	if err != nil {
+		return err
+	}
+	cells, err := s.storageService.GetCellsFull(ctx, orgID, cellIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		line.Variant = variants[line.VariantID]
+		if line.InstanceID != nil {
+			line.Instance = instances[*line.InstanceID]
+		}
+		if line.ExpectedCellID != nil {
+			line.ExpectedCell = cells[*line.ExpectedCellID]
+		}
+		if line.FoundCellID != nil {
+			line.FoundCell = cells[*line.FoundCellID]
+		}
+	}
+
+	report.UncountedCells = make([]*models.Cell, 0, len(report.UncountedCellIDs))
+	for _, cellID := range report.UncountedCellIDs {
+		if cell, ok := cells[cellID]; ok {
+			report.UncountedCells = append(report.UncountedCells, cell)
+		}
+	}
+
+	return nil
+}
+
+// GetCountReport returns the reconciliation report of an inventory count task
+// built from the current counts and stock.
+func (s *TaskService) GetCountReport(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID) (*models.InventoryCountReport, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetCountReport", func(ctx context.Context, span trace.Span) (*models.InventoryCountReport, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		task, err := s.queries.GetTaskById(ctx, sqlc.GetTaskByIdParams{
+			OrgID: database.PgUUID(orgID),
+			ID:    database.PgUUID(taskID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+		if models.TaskType(task.Type) != models.TaskTypeInventoryCount {
+			return nil, ErrTaskNotInventoryCount
+		}
+
+		return s.buildCountReport(ctx, orgID, taskID)
+	})
+}
+
+type countAdjustment struct {
+	instanceID uuid.UUID
+	before     *models.ItemInstance
+	reason     models.ObjectChangeReason
+}
+
+// ApplyCountAdjustments brings recorded stock in line with the counts of a
+// ready inventory count task and completes it. Missing instances are written
+// off as consumed, misplaced ones are moved to the cell they were found in
+// and unexpected goods are put on record. Every change is audited with a
+// reason code. The applied report is returned.
+func (s *TaskService) ApplyCountAdjustments(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID) (*models.InventoryCountReport, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "ApplyCountAdjustments", func(ctx context.Context, span trace.Span) (*models.InventoryCountReport, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		taskBefore, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if taskBefore.Type != models.TaskTypeInventoryCount {
+			return nil, ErrTaskNotInventoryCount
+		}
+
+		report, err := s.buildCountReport(ctx, orgID, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		var adjustments []countAdjustment
+		var created []uuid.UUID
+		var taskAfter *models.Task
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			status := models.TaskStatus(task.Status)
+			if !isTaskOpen(status) {
+				return ErrTaskClosed
+			}
+			if err := validateTransition(status, models.TaskStatusCompleted); err != nil {
+				return err
+			}
+
+			// move locks the instance, checks it still matches the report and
+			// updates its cell and status
+			move := func(line *models.InventoryCountDiscrepancy, cellID *uuid.UUID, instanceStatus models.ItemInstanceStatus, reason models.ObjectChangeReason) error {
+				if line.Instance == nil {
+					return nil
+				}
+				instance, err := qtx.GetItemInstanceForUpdate(ctx, sqlc.GetItemInstanceForUpdateParams{
+					OrgID: database.PgUUID(orgID),
+					ID:    database.PgUUID(*line.InstanceID),
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+				if instance.CellID != database.PgUUIDPtr(line.Instance.CellID) ||
+					models.ItemInstanceStatus(instance.Status) != line.Instance.Status {
+					return ErrCountStockChanged
+				}
+
+				err = qtx.MoveItemInstance(ctx, sqlc.MoveItemInstanceParams{
+					OrgID:            database.PgUUID(orgID),
+					ID:               instance.ID,
+					CellID:           database.PgUUIDPtr(cellID),
+					Status:           sqlc.ItemInstanceStatus(instanceStatus),
+					AffectedByTaskID: database.PgUUID(taskID),
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+
+				adjustments = append(adjustments, countAdjustment{
+					instanceID: *line.InstanceID,
+					before:     line.Instance,
+					reason:     reason,
+				})
+				return nil
+			}
+
+			for _, line := range report.Missing {
+				if err := move(line, nil, models.ItemInstanceStatusConsumed, models.ObjectChangeReasonCountMissing); err != nil {
+					return err
+				}
+			}
+
+			for _, line := range report.Misplaced {
+				// Instances picked by other tasks are left to those tasks
+				if line.Instance != nil && line.Instance.Status != models.ItemInstanceStatusAvailable {
+					continue
+				}
+				if err := move(line, line.FoundCellID, models.ItemInstanceStatusAvailable, models.ObjectChangeReasonCountMisplaced); err != nil {
+					return err
+				}
+			}
+
+			for _, line := range report.Unexpected {
+				if line.InstanceID != nil {
+					if err := move(line, line.FoundCellID, models.ItemInstanceStatusAvailable, models.ObjectChangeReasonCountUnexpected); err != nil {
+						return err
+					}
+					continue
+				}
+				if line.Variant == nil {
+					continue
+				}
+				for range line.Quantity {
+					instance, err := qtx.CreateItemInstance(ctx, sqlc.CreateItemInstanceParams{
+						OrgID:            database.PgUUID(orgID),
+						ItemID:           database.PgUUID(line.Variant.ItemID),
+						VariantID:        database.PgUUID(line.VariantID),
+						CellID:           database.PgUUIDPtr(line.FoundCellID),
+						Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
+						AffectedByTaskID: database.PgUUID(taskID),
+					})
+					if err != nil {
+						return services.MapDbErrorToService(err)
+					}
+					created = append(created, database.UUIDFromPgx(instance.ID))
+				}
+			}
+
+			completedAt := time.Now()
+			updated, err := updateTaskStatus(ctx, qtx, status, nil, sqlc.UpdateTaskParams{
+				OrgID:       database.PgUUID(orgID),
+				ID:          database.PgUUID(taskID),
+				Status:      sqlc.TaskStatus(models.TaskStatusCompleted),
+				CompletedAt: database.PgTimestampPtr(&completedAt),
+			})
+			if err != nil {
+				return err
+			}
+			taskAfter = toTask(updated)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		instanceIDs := make([]uuid.UUID, 0, len(adjustments)+len(created))
+		for _, adjustment := range adjustments {
+			instanceIDs = append(instanceIDs, adjustment.instanceID)
+		}
+		instanceIDs = append(instanceIDs, created...)
+		instances, err := s.item.GetItemInstancesFull(ctx, orgID, instanceIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, adjustment := range adjustments {
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypeItemInstance,
+				TargetObjectID:   adjustment.instanceID,
+				PrechangeState:   adjustment.before,
+				PostchangeState:  instances[adjustment.instanceID],
+				Reason:           &adjustment.reason,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		reason := models.ObjectChangeReasonCountUnexpected
+		for _, instanceID := range created {
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionCreate,
+				TargetObjectType: models.ObjectTypeItemInstance,
+				TargetObjectID:   instanceID,
+				PostchangeState:  instances[instanceID],
+				Reason:           &reason,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeTask,
+			TargetObjectID:   taskID,
+			PrechangeState:   taskBefore,
+			PostchangeState:  taskAfter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return report, nil
+	})
+}
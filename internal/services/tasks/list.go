@@ -0,0 +1,271 @@
+package tasks
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultTaskListLimit = 50
+	maxTaskListLimit     = 200
+)
+
+// taskCursor points at the last task of a page and holds the values of the
+// sort columns of its ListTasksBy* query. Sort settings are stored too, so a
+// cursor can not be reused with a different ordering.
+type taskCursor struct {
+	SortBy    models.TaskSortField `json:"s"`
+	SortDesc  bool                 `json:"d"`
+	CreatedAt *time.Time           `json:"c,omitempty"`
+	Name      *string              `json:"n,omitempty"`
+	Priority  *models.TaskPriority `json:"p,omitempty"`
+	ID        uuid.UUID            `json:"id"`
+}
+
+func newTaskCursor(task sqlc.Task, filter *models.TaskFilter) taskCursor {
+	cursor := taskCursor{
+		SortBy:   filter.SortBy,
+		SortDesc: filter.SortDesc,
+		ID:       database.UUIDFromPgx(task.ID),
+	}
+	switch filter.SortBy {
+	case models.TaskSortFieldName:
+		cursor.Name = &task.Name
+	case models.TaskSortFieldPriority:
+		priority := models.TaskPriority(task.Priority)
+		cursor.Priority = &priority
+		cursor.CreatedAt = &task.CreatedAt.Time
+	default:
+		cursor.CreatedAt = &task.CreatedAt.Time
+	}
+	return cursor
+}
+
+func encodeTaskCursor(cursor taskCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeTaskCursor(value string, filter *models.TaskFilter) (*taskCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, common.ErrDetailedValidationErrorWithMessage("invalid cursor")
+	}
+
+	var cursor taskCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, common.ErrDetailedValidationErrorWithMessage("invalid cursor")
+	}
+	if cursor.SortBy != filter.SortBy || cursor.SortDesc != filter.SortDesc {
+		return nil, common.ErrDetailedValidationErrorWithMessage("cursor does not match sort parameters")
+	}
+
+	valid := cursor.ID != uuid.Nil
+	switch cursor.SortBy {
+	case models.TaskSortFieldName:
+		valid = valid && cursor.Name != nil
+	case models.TaskSortFieldPriority:
+		valid = valid && cursor.Priority != nil && cursor.CreatedAt != nil
+		if valid {
+			switch *cursor.Priority {
+			case models.TaskPriorityLow, models.TaskPriorityNormal, models.TaskPriorityHigh, models.TaskPriorityUrgent:
+			default:
+				valid = false
+			}
+		}
+	default:
+		valid = valid && cursor.CreatedAt != nil
+	}
+	if !valid {
+		return nil, common.ErrDetailedValidationErrorWithMessage("invalid cursor")
+	}
+	return &cursor, nil
+}
+
+func validateTaskFilter(filter *models.TaskFilter) error {
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = models.TaskSortFieldCreatedAt
+	case models.TaskSortFieldCreatedAt, models.TaskSortFieldName, models.TaskSortFieldPriority:
+	default:
+		return common.ErrDetailedValidationErrorWithMessage("invalid sort field")
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultTaskListLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxTaskListLimit {
+		return common.ErrDetailedValidationErrorWithMessage("limit must be between 1 and 200")
+	}
+
+	if filter.Search != nil {
+		search := strings.TrimSpace(*filter.Search)
+		if search == "" {
+			filter.Search = nil
+		} else {
+			filter.Search = &search
+		}
+	}
+
+	return nil
+}
+
+func (s *TaskService) ListTasks(ctx context.Context, orgID uuid.UUID, filter *models.TaskFilter) (*models.TaskList, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "ListTasks", func(ctx context.Context, span trace.Span) (*models.TaskList, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		if err := validateTaskFilter(filter); err != nil {
+			return nil, err
+		}
+
+		var cursor *taskCursor
+		if filter.Cursor != nil {
+			var err error
+			cursor, err = decodeTaskCursor(*filter.Cursor, filter)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		tasks, err := s.listTasksPage(ctx, orgID, filter, cursor)
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		var nextCursor *string
+		if len(tasks) > filter.Limit {
+			tasks = tasks[:filter.Limit]
+			encoded := encodeTaskCursor(newTaskCursor(tasks[len(tasks)-1], filter))
+			nextCursor = &encoded
+		}
+
+		res, err := s.toTasksWithRelations(ctx, orgID, tasks)
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.TaskList{
+			Tasks:      res,
+			NextCursor: nextCursor,
+		}, nil
+	})
+}
+
+// listTasksPage runs the ListTasksBy* query of the sort field and order. Asc
+// and Desc queries of a field take the same parameters.
+func (s *TaskService) listTasksPage(ctx context.Context, orgID uuid.UUID, filter *models.TaskFilter, cursor *taskCursor) ([]sqlc.Task, error) {
+	var statuses []string
+	if len(filter.Statuses) > 0 {
+		statuses = make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+	}
+
+	var taskType sqlc.NullTaskType
+	if filter.Type != nil {
+		taskType = sqlc.NullTaskType{
+			TaskType: sqlc.TaskType(*filter.Type),
+			Valid:    true,
+		}
+	}
+
+	var cursorID pgtype.UUID
+	var cursorCreatedAt pgtype.Timestamp
+	var cursorName pgtype.Text
+	var cursorPriority sqlc.NullTaskPriority
+	if cursor != nil {
+		cursorID = database.PgUUID(cursor.ID)
+		cursorCreatedAt = database.PgTimestampPtr(cursor.CreatedAt)
+		cursorName = database.PgTextPtr(cursor.Name)
+		if cursor.Priority != nil {
+			cursorPriority = sqlc.NullTaskPriority{
+				TaskPriority: sqlc.TaskPriority(*cursor.Priority),
+				Valid:        true,
+			}
+		}
+	}
+
+	// one extra row tells whether there is a next page
+	pageSize := int32(filter.Limit + 1)
+
+	switch filter.SortBy {
+	case models.TaskSortFieldName:
+		params := sqlc.ListTasksByNameAscParams{
+			OrgID:            database.PgUUID(orgID),
+			Statuses:         statuses,
+			Type:             taskType,
+			UnitID:           database.PgUUIDPtr(filter.UnitID),
+			AssignedToUserID: database.PgUUIDPtr(filter.AssignedToUserID),
+			CreatedFrom:      database.PgTimestampPtr(filter.CreatedFrom),
+			CreatedTo:        database.PgTimestampPtr(filter.CreatedTo),
+			CompletedFrom:    database.PgTimestampPtr(filter.CompletedFrom),
+			CompletedTo:      database.PgTimestampPtr(filter.CompletedTo),
+			Search:           database.PgTextPtr(filter.Search),
+			CursorID:         cursorID,
+			CursorName:       cursorName,
+			PageSize:         pageSize,
+		}
+		if filter.SortDesc {
+			return s.queries.ListTasksByNameDesc(ctx, sqlc.ListTasksByNameDescParams(params))
+		}
+		return s.queries.ListTasksByNameAsc(ctx, params)
+	case models.TaskSortFieldPriority:
+		params := sqlc.ListTasksByPriorityAscParams{
+			OrgID:            database.PgUUID(orgID),
+			Statuses:         statuses,
+			Type:             taskType,
+			UnitID:           database.PgUUIDPtr(filter.UnitID),
+			AssignedToUserID: database.PgUUIDPtr(filter.AssignedToUserID),
+			CreatedFrom:      database.PgTimestampPtr(filter.CreatedFrom),
+			CreatedTo:        database.PgTimestampPtr(filter.CreatedTo),
+			CompletedFrom:    database.PgTimestampPtr(filter.CompletedFrom),
+			CompletedTo:      database.PgTimestampPtr(filter.CompletedTo),
+			Search:           database.PgTextPtr(filter.Search),
+			CursorID:         cursorID,
+			CursorPriority:   cursorPriority,
+			CursorCreatedAt:  cursorCreatedAt,
+			PageSize:         pageSize,
+		}
+		if filter.SortDesc {
+			return s.queries.ListTasksByPriorityDesc(ctx, sqlc.ListTasksByPriorityDescParams(params))
+		}
+		return s.queries.ListTasksByPriorityAsc(ctx, params)
+	default:
+		params := sqlc.ListTasksByCreatedAtAscParams{
+			OrgID:            database.PgUUID(orgID),
+			Statuses:         statuses,
+			Type:             taskType,
+			UnitID:           database.PgUUIDPtr(filter.UnitID),
+			AssignedToUserID: database.PgUUIDPtr(filter.AssignedToUserID),
+			CreatedFrom:      database.PgTimestampPtr(filter.CreatedFrom),
+			CreatedTo:        database.PgTimestampPtr(filter.CreatedTo),
+			CompletedFrom:    database.PgTimestampPtr(filter.CompletedFrom),
+			CompletedTo:      database.PgTimestampPtr(filter.CompletedTo),
+			Search:           database.PgTextPtr(filter.Search),
+			CursorID:         cursorID,
+			CursorCreatedAt:  cursorCreatedAt,
+			PageSize:         pageSize,
+		}
+		if filter.SortDesc {
+			return s.queries.ListTasksByCreatedAtDesc(ctx, sqlc.ListTasksByCreatedAtDescParams(params))
+		}
+		return s.queries.ListTasksByCreatedAtAsc(ctx, params)
+	}
+}
@@ -15,8 +15,14 @@ func toTask(task sqlc.Task) *models.Task {
 		Description: database.PgTextPtrFromPgx(task.Description),
 
 		Status:           models.TaskStatus(task.Status),
+		Priority:         models.TaskPriority(task.Priority),
 		AssignedToUserID: database.UUIDPtrFromPgx(task.AssignedToUserID),
 		Type:             models.TaskType(task.Type),
+		ReceivingCellID:  database.UUIDPtrFromPgx(task.ReceivingCellID),
+		OriginalTaskID:   database.UUIDPtrFromPgx(task.OriginalTaskID),
+		WaveID:           database.UUIDPtrFromPgx(task.WaveID),
+		TemplateID:       database.UUIDPtrFromPgx(task.TemplateID),
+		TransferOrderID:  database.UUIDPtrFromPgx(task.TransferOrderID),
 
 		// Items: []models.TaskItem{},
 
@@ -24,6 +30,9 @@ func toTask(task sqlc.Task) *models.Task {
 		AssignedAt:  database.PgTimePtrFromPgx(task.AssignedAt),
 		CompletedAt: database.PgTimePtrFromPgx(task.CompletedAt),
 
+		DueAt:         database.PgTimePtrFromPgx(task.DueAt),
+		SlaBreachedAt: database.PgTimePtrFromPgx(task.SlaBreachedAt),
+
 		AssignedTo: nil,
 	}
 
@@ -31,12 +40,131 @@ func toTask(task sqlc.Task) *models.Task {
 }
 
 func toTaskItem(taskItem sqlc.TaskItem) *models.TaskItem {
-	return &models.TaskItem{
+	model := &models.TaskItem{
+		ID:           database.UUIDFromPgx(taskItem.ID),
 		OrgID:        database.UUIDFromPgx(taskItem.OrgID),
 		TaskID:       database.UUIDFromPgx(taskItem.TaskID),
-		InstanceID:   database.UUIDFromPgx(taskItem.ItemInstanceID),
+		InstanceID:   database.UUIDPtrFromPgx(taskItem.ItemInstanceID),
+		StockLineID:  database.UUIDPtrFromPgx(taskItem.StockLineID),
+		Quantity:     int(taskItem.Quantity),
 		SourceCellID: database.UUIDPtrFromPgx(taskItem.SourceCellID),
 		TargetCellID: database.UUIDPtrFromPgx(taskItem.DestinationCellID),
 		Status:       models.TaskItemStatus(taskItem.Status),
 	}
+	if taskItem.Disposition.Valid {
+		disposition := models.ReturnDisposition(taskItem.Disposition.ReturnDisposition)
+		model.Disposition = &disposition
+	}
+	return model
+}
+
+func toTaskExpectedItem(expected sqlc.TaskExpectedItem) *models.TaskExpectedItem {
+	model := &models.TaskExpectedItem{
+		ID:               database.UUIDFromPgx(expected.ID),
+		OrgID:            database.UUIDFromPgx(expected.OrgID),
+		TaskID:           database.UUIDFromPgx(expected.TaskID),
+		VariantID:        database.UUIDFromPgx(expected.VariantID),
+		ExpectedQuantity: int(expected.ExpectedQuantity),
+		ReceivedQuantity: int(expected.ReceivedQuantity),
+	}
+	if expected.Discrepancy.Valid {
+		discrepancy := models.TaskDiscrepancy(expected.Discrepancy.TaskDiscrepancyType)
+		model.Discrepancy = &discrepancy
+	}
+	return model
+}
+
+func toTaskCountCell(countCell sqlc.TaskCountCell) *models.TaskCountCell {
+	return &models.TaskCountCell{
+		CellID:          database.UUIDFromPgx(countCell.CellID),
+		CountedAt:       database.PgTimePtrFromPgx(countCell.CountedAt),
+		CountedByUserID: database.UUIDPtrFromPgx(countCell.CountedByUserID),
+	}
+}
+
+func toTaskStatusChange(change sqlc.TaskStatusHistory) *models.TaskStatusChange {
+	model := &models.TaskStatusChange{
+		ID:              database.UUIDFromPgx(change.ID),
+		TaskID:          database.UUIDFromPgx(change.TaskID),
+		ToStatus:        models.TaskStatus(change.ToStatus),
+		ChangedByUserID: database.UUIDPtrFromPgx(change.ChangedByUserID),
+		Comment:         database.PgTextPtrFromPgx(change.Comment),
+		ChangedAt:       change.ChangedAt.Time,
+	}
+	if change.FromStatus.Valid {
+		from := models.TaskStatus(change.FromStatus.TaskStatus)
+		model.FromStatus = &from
+	}
+	return model
+}
+
+func toTaskItemException(exception sqlc.TaskItemException) *models.TaskItemException {
+	return &models.TaskItemException{
+		ID:                   database.UUIDFromPgx(exception.ID),
+		OrgID:                database.UUIDFromPgx(exception.OrgID),
+		TaskID:               database.UUIDFromPgx(exception.TaskID),
+		InstanceID:           database.UUIDFromPgx(exception.ItemInstanceID),
+		Reason:               models.TaskItemExceptionReason(exception.Reason),
+		Note:                 database.PgTextPtrFromPgx(exception.Note),
+		SubstituteInstanceID: database.UUIDPtrFromPgx(exception.SubstituteInstanceID),
+		ReportedByUserID:     database.UUIDPtrFromPgx(exception.ReportedByUserID),
+		CreatedAt:            exception.CreatedAt.Time,
+	}
+}
+
+func toPickWave(wave sqlc.PickWave) *models.PickWave {
+	return &models.PickWave{
+		ID:                  database.UUIDFromPgx(wave.ID),
+		OrgID:               database.UUIDFromPgx(wave.OrgID),
+		UnitID:              database.UUIDFromPgx(wave.UnitID),
+		Name:                wave.Name,
+		Status:              models.PickWaveStatus(wave.Status),
+		ConsolidationCellID: database.UUIDFromPgx(wave.ConsolidationCellID),
+		CreatedAt:           wave.CreatedAt.Time,
+		CompletedAt:         database.PgTimePtrFromPgx(wave.CompletedAt),
+	}
+}
+
+func toTransferOrder(order sqlc.TransferOrder) *models.TransferOrder {
+	return &models.TransferOrder{
+		ID:                database.UUIDFromPgx(order.ID),
+		OrgID:             database.UUIDFromPgx(order.OrgID),
+		SourceUnitID:      database.UUIDFromPgx(order.SourceUnitID),
+		DestinationUnitID: database.UUIDFromPgx(order.DestinationUnitID),
+		ReceivingCellID:   database.UUIDFromPgx(order.ReceivingCellID),
+		Name:              order.Name,
+		Status:            models.TransferOrderStatus(order.Status),
+		CreatedAt:         order.CreatedAt.Time,
+		DispatchedAt:      database.PgTimePtrFromPgx(order.DispatchedAt),
+		ReceivedAt:        database.PgTimePtrFromPgx(order.ReceivedAt),
+	}
+}
+
+func toTaskTemplate(template sqlc.TaskTemplate) *models.TaskTemplate {
+	return &models.TaskTemplate{
+		ID:               database.UUIDFromPgx(template.ID),
+		OrgID:            database.UUIDFromPgx(template.OrgID),
+		UnitID:           database.UUIDFromPgx(template.UnitID),
+		Type:             models.TaskType(template.Type),
+		Priority:         models.TaskPriority(template.Priority),
+		Name:             template.Name,
+		Description:      database.PgTextPtrFromPgx(template.Description),
+		AssignedToUserID: database.UUIDPtrFromPgx(template.AssignedToUserID),
+		DueInMinutes:     database.PgInt32PtrFromPgx(template.DueInMinutes),
+		Schedule:         database.PgTextPtrFromPgx(template.Schedule),
+		IsActive:         template.IsActive,
+		NextRunAt:        database.PgTimePtrFromPgx(template.NextRunAt),
+		LastRunAt:        database.PgTimePtrFromPgx(template.LastRunAt),
+		CreatedAt:        template.CreatedAt.Time,
+	}
+}
+
+func toTaskTemplateRule(rule sqlc.TaskTemplateRule) *models.TaskTemplateRule {
+	return &models.TaskTemplateRule{
+		ItemID:             database.UUIDPtrFromPgx(rule.ItemID),
+		VariantID:          database.UUIDPtrFromPgx(rule.VariantID),
+		SourceCellsGroupID: database.UUIDPtrFromPgx(rule.SourceCellsGroupID),
+		TargetCellID:       database.UUIDPtrFromPgx(rule.TargetCellID),
+		MaxInstances:       database.PgInt32PtrFromPgx(rule.MaxInstances),
+	}
 }
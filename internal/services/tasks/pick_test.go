@@ -0,0 +1,212 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services/audit"
+	"github.com/let-store-it/backend/internal/services/auth"
+	"github.com/let-store-it/backend/internal/services/employee"
+	"github.com/let-store-it/backend/internal/services/item"
+	"github.com/let-store-it/backend/internal/services/organization"
+	"github.com/let-store-it/backend/internal/services/storage"
+	"github.com/let-store-it/backend/internal/services/tasks"
+)
+
+// newTaskService connects to the database at TEST_DATABASE_URL, which must
+// have schema.sql applied. The test is skipped when it is not set.
+func newTaskService(t *testing.T) (*tasks.TaskService, *sqlc.Queries) {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	pool, err := pgxpool.New(t.Context(), url)
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	queries := sqlc.New(pool)
+	employeeService := employee.New(employee.EmployeeServiceConfig{
+		Queries: queries,
+		PGXPool: pool,
+	})
+	auditService, err := audit.New(audit.AuditServiceConfig{
+		Queries:         queries,
+		PGXPool:         pool,
+		EmployeeService: employeeService,
+	})
+	if err != nil {
+		t.Fatalf("failed to create audit service: %v", err)
+	}
+	storageService := storage.New(&storage.StorageServiceConfig{
+		Queries: queries,
+		PGXPool: pool,
+		Audit:   auditService,
+	})
+	itemService := item.New(item.ItemServiceConfig{
+		Queries:        queries,
+		PGXPool:        pool,
+		StorageService: storageService,
+		AuditService:   auditService,
+	})
+
+	return tasks.New(tasks.TaskServiceConfig{
+		Queries: queries,
+		PGXPool: pool,
+		Auth: auth.New(auth.AuthServiceConfig{
+			Queries:      queries,
+			PGXPool:      pool,
+			AuditService: auditService,
+		}),
+		Org: organization.New(organization.OrganizationServiceConfig{
+			Queries:      queries,
+			PGXPool:      pool,
+			AuditService: auditService,
+		}),
+		ItemService:     itemService,
+		StorageService:  storageService,
+		EmployeeService: employeeService,
+		AuditService:    auditService,
+	}), queries
+}
+
+// createPickmentTask creates an organization with a single available
+// instance and a pending pickment task holding it
+func createPickmentTask(t *testing.T, ctx context.Context, queries *sqlc.Queries) (orgID, taskID, instanceID uuid.UUID) {
+	t.Helper()
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("failed to create test data: %v", err)
+		}
+	}
+
+	org, err := queries.CreateOrganization(ctx, sqlc.CreateOrganizationParams{
+		Name:      "Pick test",
+		Subdomain: "pick-" + uuid.NewString(),
+	})
+	check(err)
+	unit, err := queries.CreateOrgUnit(ctx, sqlc.CreateOrgUnitParams{
+		OrgID:            org.ID,
+		Name:             "Unit",
+		Alias:            "U1",
+		PickPathStrategy: sqlc.PickPathStrategySerpentine,
+	})
+	check(err)
+	group, err := queries.CreateCellsGroup(ctx, sqlc.CreateCellsGroupParams{
+		OrgID:  org.ID,
+		UnitID: unit.ID,
+		Name:   "Cells",
+		Alias:  "C1",
+	})
+	check(err)
+	cell, err := queries.CreateCell(ctx, sqlc.CreateCellParams{
+		OrgID:        org.ID,
+		CellsGroupID: group.ID,
+		Alias:        "A1",
+		Row:          1,
+		Level:        1,
+		Position:     1,
+	})
+	check(err)
+	createdItem, err := queries.CreateItem(ctx, sqlc.CreateItemParams{
+		OrgID: org.ID,
+		Name:  "Item",
+	})
+	check(err)
+	variant, err := queries.CreateItemVariant(ctx, sqlc.CreateItemVariantParams{
+		OrgID:        org.ID,
+		ItemID:       createdItem.ID,
+		Name:         "Variant",
+		TrackingMode: sqlc.ItemTrackingModeSerialized,
+	})
+	check(err)
+	instance, err := queries.CreateItemInstance(ctx, sqlc.CreateItemInstanceParams{
+		OrgID:     org.ID,
+		ItemID:    createdItem.ID,
+		VariantID: variant.ID,
+		CellID:    cell.ID,
+		Status:    sqlc.ItemInstanceStatusAvailable,
+	})
+	check(err)
+	task, err := queries.CreateTask(ctx, sqlc.CreateTaskParams{
+		OrgID:    org.ID,
+		UnitID:   unit.ID,
+		Type:     sqlc.TaskTypePickment,
+		Name:     "Pick",
+		Priority: sqlc.TaskPriorityNormal,
+	})
+	check(err)
+	_, err = queries.CreateTaskItem(ctx, sqlc.CreateTaskItemParams{
+		OrgID:          org.ID,
+		TaskID:         task.ID,
+		ItemInstanceID: instance.ID,
+		SourceCellID:   cell.ID,
+	})
+	check(err)
+
+	return database.UUIDFromPgx(org.ID), database.UUIDFromPgx(task.ID), database.UUIDFromPgx(instance.ID)
+}
+
+func TestPickInstanceConcurrently(t *testing.T) {
+	service, queries := newTaskService(t)
+
+	orgID, taskID, instanceID := createPickmentTask(t, t.Context(), queries)
+	ctx := context.WithValue(t.Context(), models.OrganizationIDContextKey, orgID)
+
+	const pickers = 2
+	start := make(chan struct{})
+	errs := make([]error, pickers)
+	var wg sync.WaitGroup
+	for i := range pickers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			errs[i] = service.PickInstance(ctx, orgID, taskID, instanceID)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	var picked int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			picked++
+		case errors.Is(err, tasks.ErrInstanceAlreadyPicked), errors.Is(err, tasks.ErrInstanceNotAvailable):
+		default:
+			t.Fatalf("unexpected pick error: %v", err)
+		}
+	}
+	if picked != 1 {
+		t.Fatalf("expected exactly one successful pick, got %d: %v", picked, errs)
+	}
+
+	instance, err := queries.GetItemInstance(ctx, sqlc.GetItemInstanceParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(instanceID),
+	})
+	if err != nil {
+		t.Fatalf("failed to get instance: %v", err)
+	}
+	if models.ItemInstanceStatus(instance.Status) != models.ItemInstanceStatusReserved {
+		t.Fatalf("expected instance to be reserved, got %s", instance.Status)
+	}
+	if database.UUIDFromPgx(instance.AffectedByTaskID) != taskID {
+		t.Fatalf("expected instance to be affected by task %s", taskID)
+	}
+}
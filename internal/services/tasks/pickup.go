@@ -0,0 +1,345 @@
+package tasks
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	pickupCodeDigits = 6
+	// Failed attempts after which the code is locked and a new one has to be
+	// issued by a manager
+	maxPickupCodeAttempts = 5
+	// QR codes carry the task id along with the code, so a code scanned for
+	// another task is rejected
+	pickupQRPrefix = "STOREIT-PICKUP:"
+)
+
+var (
+	ErrPickupCodeRequired     = common.ErrDetailedValidationErrorWithMessage("pickup code is required to complete the task")
+	ErrPickupCodeInvalid      = common.ErrDetailedValidationErrorWithMessage("invalid pickup code")
+	ErrPickupCodeLocked       = fmt.Errorf("%w: pickup code is locked after too many failed attempts, issue a new one", common.ErrConflict)
+	ErrPickupCodeNotAvailable = fmt.Errorf("%w: pickup codes are issued only for ready pickment tasks", common.ErrConflict)
+)
+
+func toTaskPickupCode(code sqlc.TaskPickupCode) *models.TaskPickupCode {
+	taskID := database.UUIDFromPgx(code.TaskID)
+	return &models.TaskPickupCode{
+		TaskID:         taskID,
+		UnitID:         database.UUIDFromPgx(code.UnitID),
+		Code:           code.Code,
+		QRPayload:      pickupQRPayload(taskID, code.Code),
+		FailedAttempts: int(code.FailedAttempts),
+		AttemptsLeft:   max(maxPickupCodeAttempts-int(code.FailedAttempts), 0),
+		CreatedAt:      code.CreatedAt.Time,
+		LockedAt:       database.PgTimePtrFromPgx(code.LockedAt),
+		UsedAt:         database.PgTimePtrFromPgx(code.UsedAt),
+	}
+}
+
+func pickupQRPayload(taskID uuid.UUID, code string) string {
+	return pickupQRPrefix + taskID.String() + ":" + code
+}
+
+func generatePickupCode() (string, error) {
+	var sb strings.Builder
+	for range pickupCodeDigits {
+		digit, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(byte('0' + digit.Int64()))
+	}
+	return sb.String(), nil
+}
+
+// matchesPickupCode reports whether the presented digits or scanned QR
+// payload match the code of the task
+func matchesPickupCode(code *models.TaskPickupCode, presented string) bool {
+	presented = strings.TrimSpace(presented)
+	if strings.HasPrefix(presented, pickupQRPrefix) {
+		return subtle.ConstantTimeCompare([]byte(presented), []byte(code.QRPayload)) == 1
+	}
+	return subtle.ConstantTimeCompare([]byte(presented), []byte(code.Code)) == 1
+}
+
+// issuePickupCode issues a new pickup code for the task, replacing the
+// previous one
+func issuePickupCode(ctx context.Context, qtx *sqlc.Queries, task *models.Task) (*models.TaskPickupCode, error) {
+	code, err := generatePickupCode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate pickup code: %w", err)
+	}
+
+	created, err := qtx.UpsertTaskPickupCode(ctx, sqlc.UpsertTaskPickupCodeParams{
+		TaskID: database.PgUUID(task.ID),
+		OrgID:  database.PgUUID(task.OrgID),
+		UnitID: database.PgUUID(task.UnitID),
+		Code:   code,
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	return toTaskPickupCode(created), nil
+}
+
+func (s *TaskService) auditPickupCode(ctx context.Context, action models.ObjectChangeAction, before *models.TaskPickupCode, after *models.TaskPickupCode, reason *models.ObjectChangeReason) error {
+	return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+		Action:           action,
+		TargetObjectType: models.ObjectTypeTaskPickupCode,
+		TargetObjectID:   after.TaskID,
+		PrechangeState:   before,
+		PostchangeState:  after,
+		Reason:           reason,
+	})
+}
+
+func (s *TaskService) GetTaskPickupCode(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID) (*models.TaskPickupCode, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTaskPickupCode", func(ctx context.Context, span trace.Span) (*models.TaskPickupCode, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		code, err := s.queries.GetTaskPickupCode(ctx, sqlc.GetTaskPickupCodeParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+		return toTaskPickupCode(code), nil
+	})
+}
+
+// RegeneratePickupCode issues a new code for a ready pickment task, used when
+// the code was lost or locked after too many failed attempts
+func (s *TaskService) RegeneratePickupCode(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID) (*models.TaskPickupCode, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "RegeneratePickupCode", func(ctx context.Context, span trace.Span) (*models.TaskPickupCode, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		var before *models.TaskPickupCode
+		created, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.TaskPickupCode, error) {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+			if models.TaskType(task.Type) != models.TaskTypePickmentItem || models.TaskStatus(task.Status) != models.TaskStatusReady {
+				return nil, ErrPickupCodeNotAvailable
+			}
+
+			previous, err := qtx.GetTaskPickupCode(ctx, sqlc.GetTaskPickupCodeParams{
+				OrgID:  database.PgUUID(orgID),
+				TaskID: database.PgUUID(taskID),
+			})
+			switch {
+			case err == nil:
+				before = toTaskPickupCode(previous)
+			case !database.IsNotFound(err):
+				return nil, services.MapDbErrorToService(err)
+			}
+
+			return issuePickupCode(ctx, qtx, toTask(task))
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.auditPickupCode(ctx, models.ObjectChangeActionCreate, before, created, nil); err != nil {
+			return nil, err
+		}
+		return created, nil
+	})
+}
+
+// completeWithPickupCode completes a ready pickment task once the presented
+// code matches. Failed attempts are counted even though the completion is
+// rejected, and lock the code once the limit is reached.
+func (s *TaskService) completeWithPickupCode(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, comment *string, presented *string) (*models.Task, error) {
+	before, err := s.GetTaskById(ctx, orgID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if !isTaskOpen(before.Status) {
+		return nil, ErrTaskClosed
+	}
+	if err := validateTransition(before.Status, models.TaskStatusCompleted); err != nil {
+		return nil, err
+	}
+
+	if presented == nil || strings.TrimSpace(*presented) == "" {
+		return nil, ErrPickupCodeRequired
+	}
+
+	shipped, err := s.getTaskReservedInstances(ctx, orgID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	shippedLines, err := s.getTaskReservedStockLines(ctx, orgID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var codeBefore, codeAfter *models.TaskPickupCode
+	var orderBefore, orderAfter *models.TransferOrder
+	var inbound *models.Task
+	var rejected error
+	task, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.Task, error) {
+		qtx := s.queries.WithTx(tx)
+
+		// The task is locked before its code, in the same order as
+		// RegeneratePickupCode and MarkTaskAsReady
+		if _, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+			OrgID: database.PgUUID(orgID),
+			ID:    database.PgUUID(taskID),
+		}); err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		code, err := qtx.GetTaskPickupCodeForUpdate(ctx, sqlc.GetTaskPickupCodeForUpdateParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+		})
+		if err != nil {
+			// Codes are issued with the ready transition, a missing one
+			// has to be issued again by a manager
+			if database.IsNotFound(err) {
+				return nil, ErrPickupCodeInvalid
+			}
+			return nil, services.MapDbErrorToService(err)
+		}
+		codeBefore = toTaskPickupCode(code)
+		if codeBefore.UsedAt != nil {
+			return nil, ErrTaskClosed
+		}
+		if codeBefore.LockedAt != nil {
+			return nil, ErrPickupCodeLocked
+		}
+
+		if !matchesPickupCode(codeBefore, *presented) {
+			failed, err := qtx.RecordTaskPickupCodeFailure(ctx, sqlc.RecordTaskPickupCodeFailureParams{
+				OrgID:       database.PgUUID(orgID),
+				TaskID:      database.PgUUID(taskID),
+				MaxAttempts: maxPickupCodeAttempts,
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+			codeAfter = toTaskPickupCode(failed)
+			// Committed on purpose, the attempt has to be counted
+			rejected = ErrPickupCodeInvalid
+			return nil, nil
+		}
+
+		completed, err := transitionTaskStatus(ctx, qtx, orgID, taskID, models.TaskStatusCompleted, comment)
+		if err != nil {
+			return nil, err
+		}
+		orderBefore, orderAfter, inbound, err = s.advanceTransferOrder(ctx, tx, completed)
+		if err != nil {
+			return nil, err
+		}
+		used, err := qtx.UseTaskPickupCode(ctx, sqlc.UseTaskPickupCodeParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(taskID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+		codeAfter = toTaskPickupCode(used)
+		return completed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reason := models.ObjectChangeReasonPickupCodeAccepted
+	switch {
+	case codeAfter.LockedAt != nil:
+		reason = models.ObjectChangeReasonPickupCodeLocked
+	case rejected != nil:
+		reason = models.ObjectChangeReasonPickupCodeRejected
+	}
+	if err := s.auditPickupCode(ctx, models.ObjectChangeActionUpdate, codeBefore, codeAfter, &reason); err != nil {
+		return nil, err
+	}
+	if rejected != nil {
+		return nil, rejected
+	}
+
+	if err := s.auditShippedInstances(ctx, orgID, shipped); err != nil {
+		return nil, err
+	}
+	if err := s.auditShippedStockLines(ctx, orgID, shippedLines); err != nil {
+		return nil, err
+	}
+	if err := s.auditTransferOrder(ctx, orderBefore, orderAfter); err != nil {
+		return nil, err
+	}
+	if err := s.auditTransferLegs(ctx, []*models.Task{inbound}); err != nil {
+		return nil, err
+	}
+
+	err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+		Action:           models.ObjectChangeActionUpdate,
+		TargetObjectType: models.ObjectTypeTask,
+		TargetObjectID:   taskID,
+		PrechangeState:   before,
+		PostchangeState:  task,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// GetUnitPickupCodes returns the codes of the unit's ready tasks which can
+// still be used, shown on the TV boards of the unit
+func (s *TaskService) GetUnitPickupCodes(ctx context.Context, orgID uuid.UUID, unitID uuid.UUID) ([]*models.TaskPickupCode, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetUnitPickupCodes", func(ctx context.Context, span trace.Span) ([]*models.TaskPickupCode, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("unit.id", unitID.String()),
+		)
+
+		rows, err := s.queries.GetActiveUnitPickupCodes(ctx, sqlc.GetActiveUnitPickupCodesParams{
+			OrgID:  database.PgUUID(orgID),
+			UnitID: database.PgUUID(unitID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		codes := make([]*models.TaskPickupCode, len(rows))
+		for i, row := range rows {
+			codes[i] = toTaskPickupCode(row.TaskPickupCode)
+			codes[i].TaskName = row.TaskName
+		}
+
+		span.SetAttributes(attribute.Int("response.count", len(codes)))
+		return codes, nil
+	})
+}
@@ -0,0 +1,484 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Quantity received by a single scan, each unit of a serialized variant is
+// created as an instance
+const maxReceiveQuantity = 1000
+
+var (
+	ErrTaskNotReceiving = fmt.Errorf("%w: task is not a receiving task", common.ErrConflict)
+)
+
+// validateReceivingTask checks the manifest of a receiving task. Receiving
+// tasks are created from expected variants instead of existing instances.
+func (s *TaskService) validateReceivingTask(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task *models.Task) error {
+	if task.ReceivingCellID == nil {
+		return common.ErrDetailedValidationErrorWithMessage("receiving cell is required for receiving tasks")
+	}
+	if len(task.Items) > 0 {
+		return common.ErrDetailedValidationErrorWithMessage("receiving tasks cannot contain items")
+	}
+	if len(task.ExpectedItems) == 0 {
+		return common.ErrDetailedValidationErrorWithMessage("expected items are required for receiving tasks")
+	}
+
+	variantIDs := make([]uuid.UUID, 0, len(task.ExpectedItems))
+	seen := make(map[uuid.UUID]bool, len(task.ExpectedItems))
+	for _, expected := range task.ExpectedItems {
+		if expected.ExpectedQuantity <= 0 {
+			return common.ErrDetailedValidationErrorWithMessage("expected quantity must be positive")
+		}
+		if seen[expected.VariantID] {
+			return common.ErrDetailedValidationErrorWithMessage("expected items must have distinct variants")
+		}
+		seen[expected.VariantID] = true
+		variantIDs = append(variantIDs, expected.VariantID)
+	}
+
+	variants, err := s.item.GetItemVariantsByIDs(ctx, orgID, variantIDs)
+	if err != nil {
+		return err
+	}
+	for _, variantID := range variantIDs {
+		if _, ok := variants[variantID]; !ok {
+			return fmt.Errorf("failed to get variant: %w", common.ErrNotFound)
+		}
+	}
+
+	cell, err := s.storageService.GetCellFull(ctx, orgID, *task.ReceivingCellID)
+	if err != nil {
+		return err
+	}
+	if !cellInUnit(cell, task.UnitID) {
+		return common.ErrDetailedValidationErrorWithMessage("receiving cell must belong to the task unit")
+	}
+
+	return services.EnsureCellsNotFrozen(ctx, qtx, orgID, task.ReceivingCellID)
+}
+
+// validateReceivedSerialNumbers checks that serial numbers, when given, are
+// distinct and given for every received instance. Variants requiring serials
+// cannot be received without them.
+func validateReceivedSerialNumbers(variant *models.ItemVariant, quantity int, serialNumbers []string) error {
+	if len(serialNumbers) == 0 {
+		if variant.RequiresSerial {
+			return services.ErrSerialNumberRequired
+		}
+		return nil
+	}
+	if variant.TrackingMode == models.ItemTrackingModeQuantity {
+		return common.ErrDetailedValidationErrorWithMessage("serial numbers cannot be set for quantity tracked variants")
+	}
+	if len(serialNumbers) != quantity {
+		return common.ErrDetailedValidationErrorWithMessage("serial numbers must be given for every received instance")
+	}
+	seen := make(map[string]bool, len(serialNumbers))
+	for i := range serialNumbers {
+		if err := services.ValidateSerialNumber(&serialNumbers[i], variant); err != nil {
+			return err
+		}
+		if serialNumbers[i] == "" {
+			return common.ErrDetailedValidationErrorWithMessage("serial numbers cannot be empty")
+		}
+		if seen[serialNumbers[i]] {
+			return common.ErrDetailedValidationErrorWithMessage("serial numbers must be distinct")
+		}
+		seen[serialNumbers[i]] = true
+	}
+	return nil
+}
+
+func cellInUnit(cell *models.Cell, unitID uuid.UUID) bool {
+	if cell.Path == nil {
+		return false
+	}
+	for _, segment := range *cell.Path {
+		if segment.ObjectType == models.CellPathObjectTypeUnit && segment.ID == unitID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *TaskService) createTaskExpectedItems(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, taskID uuid.UUID, expectedItems []*models.TaskExpectedItem) ([]*models.TaskExpectedItem, error) {
+	result := make([]*models.TaskExpectedItem, 0, len(expectedItems))
+	for _, expected := range expectedItems {
+		created, err := qtx.CreateTaskExpectedItem(ctx, sqlc.CreateTaskExpectedItemParams{
+			OrgID:            database.PgUUID(orgID),
+			TaskID:           database.PgUUID(taskID),
+			VariantID:        database.PgUUID(expected.VariantID),
+			ExpectedQuantity: int32(expected.ExpectedQuantity),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+		result = append(result, toTaskExpectedItem(created))
+	}
+	return result, nil
+}
+
+func (s *TaskService) loadTaskExpectedItems(ctx context.Context, orgID uuid.UUID, task *models.Task) error {
+	if task.Type != models.TaskTypeReceiving {
+		return nil
+	}
+
+	rows, err := s.queries.GetTaskExpectedItems(ctx, sqlc.GetTaskExpectedItemsParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: database.PgUUID(task.ID),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+
+	task.ExpectedItems = make([]*models.TaskExpectedItem, len(rows))
+	for i, row := range rows {
+		task.ExpectedItems[i] = toTaskExpectedItem(row)
+	}
+
+	return s.loadTaskExpectedItemsRelations(ctx, orgID, task)
+}
+
+func (s *TaskService) loadTaskExpectedItemsRelations(ctx context.Context, orgID uuid.UUID, task *models.Task) error {
+	variantIDs := make([]uuid.UUID, len(task.ExpectedItems))
+	for i, expected := range task.ExpectedItems {
+		variantIDs[i] = expected.VariantID
+	}
+
+	variants, err := s.item.GetItemVariantsByIDs(ctx, orgID, variantIDs)
+	if err != nil {
+		return err
+	}
+	for _, expected := range task.ExpectedItems {
+		expected.Variant = variants[expected.VariantID]
+	}
+
+	if task.ReceivingCellID != nil {
+		cells, err := s.storageService.GetCellsFull(ctx, orgID, []uuid.UUID{*task.ReceivingCellID})
+		if err != nil {
+			return err
+		}
+		task.ReceivingCell = cells[*task.ReceivingCellID]
+	}
+
+	return nil
+}
+
+// ReceiveItems registers quantity arrived instances of the variant. Instances
+// are created available in the receiving cell, a quantity tracked variant
+// gets a single stock line holding the quantity instead. Variants missing from the
+// manifest are accepted and added to it with zero expected quantity, so they
+// show up as an over-delivery. The inbound leg of a transfer order receives
+// the instances dispatched by its outbound leg instead of creating new ones.
+// The lot and the serial numbers, one per instance, are recorded on created
+// instances and are not accepted for stock lines or transferred instances,
+// which keep their own.
+func (s *TaskService) ReceiveItems(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, variantID uuid.UUID, quantity int, lot models.ItemLot, serialNumbers []string) (*models.TaskReceipt, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "ReceiveItems", func(ctx context.Context, span trace.Span) (*models.TaskReceipt, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("variant.id", variantID.String()),
+			attribute.Int("quantity", quantity),
+		)
+
+		if quantity <= 0 {
+			return nil, common.ErrDetailedValidationErrorWithMessage("quantity must be positive")
+		}
+		if quantity > maxReceiveQuantity {
+			return nil, common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("quantity must not exceed %d", maxReceiveQuantity))
+		}
+		if err := services.ValidateItemLot(lot); err != nil {
+			return nil, err
+		}
+
+		variants, err := s.item.GetItemVariantsByIDs(ctx, orgID, []uuid.UUID{variantID})
+		if err != nil {
+			return nil, err
+		}
+		variant, ok := variants[variantID]
+		if !ok {
+			return nil, fmt.Errorf("failed to get variant: %w", common.ErrNotFound)
+		}
+		if variant.TrackingMode == models.ItemTrackingModeQuantity && !lot.IsEmpty() {
+			return nil, common.ErrDetailedValidationErrorWithMessage("lot cannot be set for quantity tracked variants")
+		}
+
+		taskBefore, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return nil, err
+		}
+		// Transferred instances keep their serial numbers
+		if taskBefore.TransferOrderID == nil {
+			if err := validateReceivedSerialNumbers(variant, quantity, serialNumbers); err != nil {
+				return nil, err
+			}
+		}
+
+		inTransit, err := s.getTransferInstancesInTransit(ctx, orgID, taskBefore, variantID)
+		if err != nil {
+			return nil, err
+		}
+
+		var taskAfter *models.Task
+		var stockLineID *uuid.UUID
+		instanceIDs := make([]uuid.UUID, 0, quantity)
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if models.TaskType(task.Type) != models.TaskTypeReceiving {
+				return ErrTaskNotReceiving
+			}
+
+			status := models.TaskStatus(task.Status)
+			if !isTaskOpen(status) {
+				return ErrTaskClosed
+			}
+			if status != models.TaskStatusPending && status != models.TaskStatusInProgress {
+				return fmt.Errorf("%w: cannot receive items for task in status %s", ErrInvalidStatusTransition, status)
+			}
+
+			if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, database.UUIDPtrFromPgx(task.ReceivingCellID)); err != nil {
+				return err
+			}
+
+			var transferred []sqlc.ItemInstance
+			if task.TransferOrderID.Valid {
+				if !lot.IsEmpty() || len(serialNumbers) > 0 {
+					return common.ErrDetailedValidationErrorWithMessage("lot and serial numbers cannot be set for transferred instances")
+				}
+				transferred, err = lockTransferInstances(ctx, qtx, task, variantID, quantity)
+				if err != nil {
+					return err
+				}
+			}
+
+			expected, err := qtx.GetTaskExpectedItemForUpdate(ctx, sqlc.GetTaskExpectedItemForUpdateParams{
+				OrgID:     database.PgUUID(orgID),
+				TaskID:    database.PgUUID(taskID),
+				VariantID: database.PgUUID(variantID),
+			})
+			if err != nil {
+				if !database.IsNotFound(err) {
+					return services.MapDbErrorToService(err)
+				}
+				expected, err = qtx.CreateTaskExpectedItem(ctx, sqlc.CreateTaskExpectedItemParams{
+					OrgID:            database.PgUUID(orgID),
+					TaskID:           database.PgUUID(taskID),
+					VariantID:        database.PgUUID(variantID),
+					ExpectedQuantity: 0,
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+			}
+
+			_, err = qtx.AddTaskExpectedItemReceived(ctx, sqlc.AddTaskExpectedItemReceivedParams{
+				OrgID:    database.PgUUID(orgID),
+				ID:       expected.ID,
+				Quantity: int32(quantity),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			if variant.TrackingMode == models.ItemTrackingModeQuantity && !task.TransferOrderID.Valid {
+				line, err := qtx.CreateStockLine(ctx, sqlc.CreateStockLineParams{
+					OrgID:            database.PgUUID(orgID),
+					ItemID:           database.PgUUID(variant.ItemID),
+					VariantID:        database.PgUUID(variantID),
+					CellID:           task.ReceivingCellID,
+					Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
+					Quantity:         int32(quantity),
+					AffectedByTaskID: database.PgUUID(taskID),
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+
+				_, err = qtx.CreateTaskStockLineItem(ctx, sqlc.CreateTaskStockLineItemParams{
+					OrgID:             database.PgUUID(orgID),
+					TaskID:            database.PgUUID(taskID),
+					StockLineID:       line.ID,
+					Quantity:          int32(quantity),
+					DestinationCellID: task.ReceivingCellID,
+					Status:            sqlc.TaskItemStatus(models.TaskItemStatusDone),
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+				stockLineID = database.UUIDPtrFromPgx(line.ID)
+			} else {
+				for i := range quantity {
+					var instanceID uuid.UUID
+					if transferred != nil {
+						instanceID = database.UUIDFromPgx(transferred[i].ID)
+						err = qtx.MoveItemInstance(ctx, sqlc.MoveItemInstanceParams{
+							OrgID:            database.PgUUID(orgID),
+							ID:               transferred[i].ID,
+							CellID:           task.ReceivingCellID,
+							Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
+							AffectedByTaskID: database.PgUUID(taskID),
+						})
+						if err != nil {
+							return services.MapDbErrorToService(err)
+						}
+					} else {
+						var serialNumber *string
+						if len(serialNumbers) > 0 {
+							serialNumber = &serialNumbers[i]
+						}
+						created, err := s.item.CreateItemInstanceInTx(ctx, qtx, &models.ItemInstance{
+							OrgID:            orgID,
+							ItemID:           variant.ItemID,
+							VariantID:        variantID,
+							CellID:           database.UUIDPtrFromPgx(task.ReceivingCellID),
+							AffectedByTaskID: &taskID,
+							SerialNumber:     serialNumber,
+							LotNumber:        lot.LotNumber,
+							ManufacturedAt:   lot.ManufacturedAt,
+							ExpiresAt:        lot.ExpiresAt,
+						})
+						if err != nil {
+							return err
+						}
+						instanceID = created.ID
+					}
+
+					_, err = qtx.CreateTaskItem(ctx, sqlc.CreateTaskItemParams{
+						OrgID:             database.PgUUID(orgID),
+						TaskID:            database.PgUUID(taskID),
+						ItemInstanceID:    database.PgUUID(instanceID),
+						DestinationCellID: task.ReceivingCellID,
+					})
+					if err != nil {
+						return services.MapDbErrorToService(err)
+					}
+
+					err = qtx.SetTaskItemStatus(ctx, sqlc.SetTaskItemStatusParams{
+						OrgID:          database.PgUUID(orgID),
+						TaskID:         database.PgUUID(taskID),
+						ItemInstanceID: database.PgUUID(instanceID),
+						Status:         sqlc.TaskItemStatus(models.TaskItemStatusDone),
+					})
+					if err != nil {
+						return services.MapDbErrorToService(err)
+					}
+
+					instanceIDs = append(instanceIDs, instanceID)
+				}
+			}
+
+			// The first received item starts the task
+			if status == models.TaskStatusPending {
+				updated, err := updateTaskStatus(ctx, qtx, status, nil, sqlc.UpdateTaskParams{
+					OrgID:       database.PgUUID(orgID),
+					ID:          database.PgUUID(taskID),
+					Status:      sqlc.TaskStatus(models.TaskStatusInProgress),
+					CompletedAt: task.CompletedAt,
+				})
+				if err != nil {
+					return err
+				}
+				taskAfter = toTask(updated)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		instances, err := s.item.GetItemInstancesFull(ctx, orgID, instanceIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &models.TaskReceipt{Instances: make([]*models.ItemInstance, 0, len(instanceIDs))}
+		if stockLineID != nil {
+			result.StockLine, err = s.item.GetStockLineById(ctx, orgID, *stockLineID)
+			if err != nil {
+				return nil, err
+			}
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionCreate,
+				TargetObjectType: models.ObjectTypeStockLine,
+				TargetObjectID:   *stockLineID,
+				PrechangeState:   nil,
+				PostchangeState:  result.StockLine,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, id := range instanceIDs {
+			instance := instances[id]
+			action := models.ObjectChangeActionCreate
+			before, ok := inTransit[id]
+			if ok {
+				action = models.ObjectChangeActionUpdate
+			}
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           action,
+				TargetObjectType: models.ObjectTypeItemInstance,
+				TargetObjectID:   id,
+				PrechangeState:   before,
+				PostchangeState:  instance,
+			})
+			if err != nil {
+				return nil, err
+			}
+			result.Instances = append(result.Instances, instance)
+		}
+
+		if taskAfter != nil {
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypeTask,
+				TargetObjectID:   taskID,
+				PrechangeState:   taskBefore,
+				PostchangeState:  taskAfter,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return result, nil
+	})
+}
+
+// recordReceivingDiscrepancies compares received quantities with the manifest
+// and marks every line that is over or under delivered.
+func recordReceivingDiscrepancies(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, taskID uuid.UUID) error {
+	err := qtx.SetTaskExpectedItemsDiscrepancies(ctx, sqlc.SetTaskExpectedItemsDiscrepanciesParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: database.PgUUID(taskID),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/models"
+)
+
+// Loaders below fetch related objects for a whole set of tasks with a
+// constant number of queries.
+
+func (s *TaskService) toTasksWithRelations(ctx context.Context, orgID uuid.UUID, tasks []sqlc.Task) ([]*models.Task, error) {
+	res := make([]*models.Task, len(tasks))
+	for i, task := range tasks {
+		res[i] = toTask(task)
+	}
+
+	if err := s.loadTasksRelations(ctx, orgID, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *TaskService) loadTasksRelations(ctx context.Context, orgID uuid.UUID, tasks []*models.Task) error {
+	unitIDs := make([]uuid.UUID, 0, len(tasks))
+	userIDs := make([]uuid.UUID, 0, len(tasks))
+	for _, task := range tasks {
+		unitIDs = append(unitIDs, task.UnitID)
+		if task.AssignedToUserID != nil {
+			userIDs = append(userIDs, *task.AssignedToUserID)
+		}
+	}
+
+	units, err := s.org.GetUnitsByIDs(ctx, orgID, unitIDs)
+	if err != nil {
+		return err
+	}
+
+	employees, err := s.employee.GetEmployeesByUserIDs(ctx, orgID, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		task.Unit = units[task.UnitID]
+		if task.AssignedToUserID != nil {
+			task.AssignedTo = employees[*task.AssignedToUserID]
+		}
+	}
+
+	return nil
+}
+
+func (s *TaskService) loadTaskItemsRelations(ctx context.Context, orgID uuid.UUID, items []*models.TaskItem) error {
+	instanceIDs := make([]uuid.UUID, 0, len(items))
+	stockLineIDs := make([]uuid.UUID, 0)
+	cellIDs := make([]uuid.UUID, 0, len(items)*2)
+	for _, item := range items {
+		if item.InstanceID != nil {
+			instanceIDs = append(instanceIDs, *item.InstanceID)
+		}
+		if item.StockLineID != nil {
+			stockLineIDs = append(stockLineIDs, *item.StockLineID)
+		}
+		if item.SourceCellID != nil {
+			cellIDs = append(cellIDs, *item.SourceCellID)
+		}
+		if item.TargetCellID != nil {
+			cellIDs = append(cellIDs, *item.TargetCellID)
+		}
+	}
+
+	instances, err := s.item.GetItemInstancesFull(ctx, orgID, instanceIDs)
+	if err != nil {
+		return err
+	}
+
+	stockLines, err := s.item.GetStockLinesFull(ctx, orgID, stockLineIDs)
+	if err != nil {
+		return err
+	}
+
+	cells, err := s.storageService.GetCellsFull(ctx, orgID, cellIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if item.InstanceID != nil {
+			item.Instance = instances[*item.InstanceID]
+		}
+		if item.StockLineID != nil {
+			item.StockLine = stockLines[*item.StockLineID]
+		}
+		if item.SourceCellID != nil {
+			item.SourceCell = cells[*item.SourceCellID]
+		}
+		if item.TargetCellID != nil {
+			item.TargetCell = cells[*item.TargetCellID]
+		}
+	}
+
+	return nil
+}
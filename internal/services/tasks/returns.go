@@ -0,0 +1,325 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrTaskNotReturn           = fmt.Errorf("%w: task is not a return task", common.ErrConflict)
+	ErrReturnTaskNotPickable   = fmt.Errorf("%w: return task items are processed by inspection", common.ErrConflict)
+	ErrInstanceNotReturnable   = fmt.Errorf("%w: instance was not handed out by the original task", common.ErrConflict)
+	ErrInstanceAlreadyInReturn = fmt.Errorf("%w: instance is already in an open return task", common.ErrConflict)
+)
+
+// validateReturnTask checks that a return task references a completed
+// pickment task and contains only instances handed out by it that are not
+// returned yet.
+func (s *TaskService) validateReturnTask(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task *models.Task) error {
+	if task.OriginalTaskID == nil {
+		return common.ErrDetailedValidationErrorWithMessage("original task is required for return tasks")
+	}
+	if len(task.Items) == 0 {
+		return common.ErrDetailedValidationErrorWithMessage("items are required for return tasks")
+	}
+
+	original, err := qtx.GetTaskById(ctx, sqlc.GetTaskByIdParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(*task.OriginalTaskID),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	if models.TaskType(original.Type) != models.TaskTypePickmentItem {
+		return common.ErrDetailedValidationErrorWithMessage("original task must be a pickment task")
+	}
+	if models.TaskStatus(original.Status) != models.TaskStatusCompleted {
+		return fmt.Errorf("%w: original task is not completed", common.ErrConflict)
+	}
+
+	originalItems, err := qtx.GetTaskItems(ctx, sqlc.GetTaskItemsParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: original.ID,
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	handedOut := make(map[uuid.UUID]bool, len(originalItems))
+	for _, item := range originalItems {
+		switch models.TaskItemStatus(item.Status) {
+		case models.TaskItemStatusPicked, models.TaskItemStatusDone:
+			handedOut[database.UUIDFromPgx(item.ItemInstanceID)] = true
+		}
+	}
+
+	instanceIDs := make([]uuid.UUID, 0, len(task.Items))
+	seen := make(map[uuid.UUID]bool, len(task.Items))
+	for _, item := range task.Items {
+		instanceID := *item.InstanceID
+		if seen[instanceID] {
+			return common.ErrDetailedValidationErrorWithMessage("return task items must have distinct instances")
+		}
+		seen[instanceID] = true
+		if !handedOut[instanceID] {
+			return ErrInstanceNotReturnable
+		}
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+
+	instances, err := s.item.GetItemInstancesFull(ctx, orgID, instanceIDs)
+	if err != nil {
+		return err
+	}
+	for _, id := range instanceIDs {
+		instance, ok := instances[id]
+		if !ok {
+			return fmt.Errorf("failed to get instance: %w", common.ErrNotFound)
+		}
+		if !isInstanceOutOfStock(instance.Status) {
+			return ErrInstanceNotReturnable
+		}
+	}
+
+	inReturn, err := qtx.GetOpenReturnTaskItemsForInstances(ctx, sqlc.GetOpenReturnTaskItemsForInstancesParams{
+		OrgID:       database.PgUUID(orgID),
+		InstanceIds: database.PgUUIDs(instanceIDs),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	if len(inReturn) > 0 {
+		return ErrInstanceAlreadyInReturn
+	}
+
+	return nil
+}
+
+// isInstanceOutOfStock reports whether the instance has left the warehouse
+// and can be brought back by a return task.
+func isInstanceOutOfStock(status models.ItemInstanceStatus) bool {
+	return status == models.ItemInstanceStatusReserved || status == models.ItemInstanceStatusConsumed ||
+		status == models.ItemInstanceStatusShipped
+}
+
+func validateReturnDisposition(disposition models.ReturnDisposition, cellID *uuid.UUID) error {
+	switch disposition {
+	case models.ReturnDispositionRestock:
+		if cellID == nil {
+			return common.ErrDetailedValidationErrorWithMessage("cell is required to restock an instance")
+		}
+	case models.ReturnDispositionQuarantine:
+	case models.ReturnDispositionDispose:
+		if cellID != nil {
+			return common.ErrDetailedValidationErrorWithMessage("disposed instances cannot be put to a cell")
+		}
+	default:
+		return common.ErrDetailedValidationErrorWithMessage("unknown disposition")
+	}
+	return nil
+}
+
+var returnDispositionInstanceStatus = map[models.ReturnDisposition]models.ItemInstanceStatus{
+	models.ReturnDispositionRestock:    models.ItemInstanceStatusAvailable,
+	models.ReturnDispositionQuarantine: models.ItemInstanceStatusQuarantined,
+	models.ReturnDispositionDispose:    models.ItemInstanceStatusDisposed,
+}
+
+// InspectReturnedInstance records the decision made for a returned instance.
+// Restocked instances become available in the cell, quarantined ones are
+// kept aside, optionally in a cell, and disposed ones are written off. The
+// item of the original task is marked as returned.
+func (s *TaskService) InspectReturnedInstance(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, instanceID uuid.UUID, disposition models.ReturnDisposition, cellID *uuid.UUID) (*models.ItemInstance, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "InspectReturnedInstance", func(ctx context.Context, span trace.Span) (*models.ItemInstance, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("instance.id", instanceID.String()),
+			attribute.String("disposition", string(disposition)),
+		)
+
+		if err := validateReturnDisposition(disposition, cellID); err != nil {
+			return nil, err
+		}
+
+		taskBefore, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		if cellID != nil {
+			cell, err := s.storageService.GetCellFull(ctx, orgID, *cellID)
+			if err != nil {
+				return nil, err
+			}
+			if !cellInUnit(cell, taskBefore.UnitID) {
+				return nil, common.ErrDetailedValidationErrorWithMessage("cell must belong to the task unit")
+			}
+		}
+
+		instanceBefore, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		var taskAfter *models.Task
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if models.TaskType(task.Type) != models.TaskTypeReturn {
+				return ErrTaskNotReturn
+			}
+
+			status := models.TaskStatus(task.Status)
+			if !isTaskOpen(status) {
+				return ErrTaskClosed
+			}
+			if status != models.TaskStatusPending && status != models.TaskStatusInProgress {
+				return fmt.Errorf("%w: cannot inspect items for task in status %s", ErrInvalidStatusTransition, status)
+			}
+
+			taskItem, err := qtx.GetTaskItemForUpdate(ctx, sqlc.GetTaskItemForUpdateParams{
+				OrgID:          database.PgUUID(orgID),
+				TaskID:         database.PgUUID(taskID),
+				ItemInstanceID: database.PgUUID(instanceID),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return ErrInstanceNotInTask
+				}
+				return services.MapDbErrorToService(err)
+			}
+			if models.TaskItemStatus(taskItem.Status) != models.TaskItemStatusPending {
+				return ErrTaskItemNotPending
+			}
+
+			originalItem, err := qtx.GetTaskItemForUpdate(ctx, sqlc.GetTaskItemForUpdateParams{
+				OrgID:          database.PgUUID(orgID),
+				TaskID:         task.OriginalTaskID,
+				ItemInstanceID: database.PgUUID(instanceID),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return ErrInstanceNotReturnable
+				}
+				return services.MapDbErrorToService(err)
+			}
+			if models.TaskItemStatus(originalItem.Status) == models.TaskItemStatusReturned {
+				return ErrInstanceNotReturnable
+			}
+
+			instance, err := qtx.GetItemInstanceForUpdate(ctx, sqlc.GetItemInstanceForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(instanceID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if !isInstanceOutOfStock(models.ItemInstanceStatus(instance.Status)) {
+				return ErrInstanceNotReturnable
+			}
+			if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, cellID); err != nil {
+				return err
+			}
+
+			err = qtx.MoveItemInstance(ctx, sqlc.MoveItemInstanceParams{
+				OrgID:            database.PgUUID(orgID),
+				ID:               database.PgUUID(instanceID),
+				CellID:           database.PgUUIDPtr(cellID),
+				Status:           sqlc.ItemInstanceStatus(returnDispositionInstanceStatus[disposition]),
+				AffectedByTaskID: database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetTaskItemDisposition(ctx, sqlc.SetTaskItemDispositionParams{
+				OrgID:             database.PgUUID(orgID),
+				TaskID:            database.PgUUID(taskID),
+				ItemInstanceID:    database.PgUUID(instanceID),
+				Disposition:       sqlc.NullReturnDisposition{ReturnDisposition: sqlc.ReturnDisposition(disposition), Valid: true},
+				DestinationCellID: database.PgUUIDPtr(cellID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetTaskItemStatus(ctx, sqlc.SetTaskItemStatusParams{
+				OrgID:          database.PgUUID(orgID),
+				TaskID:         task.OriginalTaskID,
+				ItemInstanceID: database.PgUUID(instanceID),
+				Status:         sqlc.TaskItemStatus(models.TaskItemStatusReturned),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			// The first inspected item starts the task
+			if status == models.TaskStatusPending {
+				updated, err := updateTaskStatus(ctx, qtx, status, nil, sqlc.UpdateTaskParams{
+					OrgID:       database.PgUUID(orgID),
+					ID:          database.PgUUID(taskID),
+					Status:      sqlc.TaskStatus(models.TaskStatusInProgress),
+					CompletedAt: task.CompletedAt,
+				})
+				if err != nil {
+					return err
+				}
+				taskAfter = toTask(updated)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		instanceAfter, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeItemInstance,
+			TargetObjectID:   instanceID,
+			PrechangeState:   instanceBefore,
+			PostchangeState:  instanceAfter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if taskAfter != nil {
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypeTask,
+				TargetObjectID:   taskID,
+				PrechangeState:   taskBefore,
+				PostchangeState:  taskAfter,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return instanceAfter, nil
+	})
+}
@@ -0,0 +1,152 @@
+package tasks
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/let-store-it/backend/internal/models"
+)
+
+// pickPathStrategy orders the cells of a single cells group into a walking
+// route. Cells groups themselves are visited in the order of the storage
+// group hierarchy regardless of the strategy.
+type pickPathStrategy interface {
+	sortGroup(stops []*routeStop)
+}
+
+var pickPathStrategies = map[models.PickPathStrategy]pickPathStrategy{
+	models.PickPathStrategySerpentine: serpentineStrategy{},
+	models.PickPathStrategySequential: sequentialStrategy{},
+}
+
+// routeStop is a task item together with the cell the worker walks to for it
+type routeStop struct {
+	item *models.TaskItem
+	cell *models.Cell
+}
+
+// routeCell is the cell visited first for the item: the source cell, or the
+// target cell for items which are only put
+func routeCell(item *models.TaskItem) *models.Cell {
+	if item.SourceCell != nil {
+		return item.SourceCell
+	}
+	return item.TargetCell
+}
+
+// serpentineStrategy walks rows with picks in alternating directions, so the
+// worker never returns to the start of a row
+type serpentineStrategy struct{}
+
+func (serpentineStrategy) sortGroup(stops []*routeStop) {
+	slices.SortStableFunc(stops, func(a, b *routeStop) int {
+		return cmp.Compare(a.cell.Row, b.cell.Row)
+	})
+
+	rowIndex := -1
+	for start := 0; start < len(stops); {
+		end := start
+		for end < len(stops) && stops[end].cell.Row == stops[start].cell.Row {
+			end++
+		}
+		rowIndex++
+		descending := rowIndex%2 == 1
+		slices.SortStableFunc(stops[start:end], func(a, b *routeStop) int {
+			c := cmp.Compare(a.cell.Position, b.cell.Position)
+			if descending {
+				c = -c
+			}
+			return cmp.Or(c, cmp.Compare(a.cell.Level, b.cell.Level))
+		})
+		start = end
+	}
+}
+
+// sequentialStrategy walks every row from its first position
+type sequentialStrategy struct{}
+
+func (sequentialStrategy) sortGroup(stops []*routeStop) {
+	slices.SortStableFunc(stops, func(a, b *routeStop) int {
+		return cmp.Or(
+			cmp.Compare(a.cell.Row, b.cell.Row),
+			cmp.Compare(a.cell.Position, b.cell.Position),
+			cmp.Compare(a.cell.Level, b.cell.Level),
+		)
+	})
+}
+
+// comparePaths orders cells groups by their storage group hierarchy, segment
+// by segment from the unit down
+func comparePaths(a, b *models.Cell) int {
+	var pathA, pathB []models.CellPathSegment
+	if a.Path != nil {
+		pathA = *a.Path
+	}
+	if b.Path != nil {
+		pathB = *b.Path
+	}
+	for i := 0; i < len(pathA) && i < len(pathB); i++ {
+		if c := cmp.Or(
+			cmp.Compare(pathA[i].Alias, pathB[i].Alias),
+			cmp.Compare(pathA[i].ID.String(), pathB[i].ID.String()),
+		); c != 0 {
+			return c
+		}
+	}
+	return cmp.Or(
+		cmp.Compare(len(pathA), len(pathB)),
+		cmp.Compare(a.CellsGroupID.String(), b.CellsGroupID.String()),
+	)
+}
+
+// orderRoute sorts items into a walking sequence and numbers them from 1.
+// Items without a cell are left at the end in their original order.
+func orderRoute(strategy pickPathStrategy, items []*models.TaskItem) {
+	stops := make([]*routeStop, 0, len(items))
+	var rest []*models.TaskItem
+	for _, item := range items {
+		if cell := routeCell(item); cell != nil {
+			stops = append(stops, &routeStop{item: item, cell: cell})
+		} else {
+			rest = append(rest, item)
+		}
+	}
+
+	slices.SortStableFunc(stops, func(a, b *routeStop) int {
+		return comparePaths(a.cell, b.cell)
+	})
+	for start := 0; start < len(stops); {
+		end := start
+		for end < len(stops) && stops[end].cell.CellsGroupID == stops[start].cell.CellsGroupID {
+			end++
+		}
+		strategy.sortGroup(stops[start:end])
+		start = end
+	}
+
+	for i, stop := range stops {
+		items[i] = stop.item
+	}
+	copy(items[len(stops):], rest)
+	for i, item := range items {
+		item.Sequence = i + 1
+	}
+}
+
+// sequenceTaskItems orders items with the pick path strategy of the unit.
+// Item relations have to be loaded.
+func (s *TaskService) sequenceTaskItems(ctx context.Context, orgID uuid.UUID, unitID uuid.UUID, items []*models.TaskItem) error {
+	unit, err := s.org.GetUnitByID(ctx, orgID, unitID)
+	if err != nil {
+		return err
+	}
+
+	strategy, ok := pickPathStrategies[unit.PickPathStrategy]
+	if !ok {
+		strategy = pickPathStrategies[models.PickPathStrategySerpentine]
+	}
+	orderRoute(strategy, items)
+	return nil
+}
@@ -0,0 +1,147 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Templates handled by a single scheduler pass, the rest waits for the next one
+const maxDueTaskTemplates = 100
+
+// RunDueTaskTemplates creates tasks for scheduled templates whose next run
+// has come. Occurrences missed while no scheduler was running are collapsed
+// into a single task. Returns the number of created tasks.
+//
+// Several replicas may run the scheduler at the same time. Each template run
+// holds a transaction level advisory lock and re-reads the template under it,
+// so only one replica creates a task for each occurrence.
+func (s *TaskService) RunDueTaskTemplates(ctx context.Context) (int, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "RunDueTaskTemplates", func(ctx context.Context, span trace.Span) (int, error) {
+		now := time.Now().UTC()
+		rows, err := s.queries.GetDueTaskTemplates(ctx, sqlc.GetDueTaskTemplatesParams{
+			Now:     database.PgTimestamp(now),
+			MaxRows: maxDueTaskTemplates,
+		})
+		if err != nil {
+			return 0, services.MapDbErrorToService(err)
+		}
+		span.SetAttributes(attribute.Int("templates.due", len(rows)))
+
+		var created int
+		var errs []error
+		for _, row := range rows {
+			ok, err := s.runScheduledTaskTemplate(ctx, toTaskTemplate(row), now)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			if ok {
+				created++
+			}
+		}
+
+		return created, errors.Join(errs...)
+	})
+}
+
+// runScheduledTaskTemplate runs a single occurrence of the template and
+// advances its schedule. Returns whether a task was created.
+func (s *TaskService) runScheduledTaskTemplate(ctx context.Context, due *models.TaskTemplate, now time.Time) (bool, error) {
+	ctx = context.WithValue(ctx, models.OrganizationIDContextKey, due.OrgID)
+
+	var task *models.Task
+	var skipped bool
+	err := database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+		qtx := s.queries.WithTx(tx)
+
+		locked, err := qtx.TryLockTaskTemplate(ctx, due.ID.String())
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+		if !locked {
+			// Another replica is running the template
+			return nil
+		}
+
+		template, err := s.getTaskTemplate(ctx, qtx, due.OrgID, due.ID)
+		if err != nil {
+			return err
+		}
+		// The occurrence was already run by another replica
+		if !template.IsActive || template.Schedule == nil || template.NextRunAt == nil ||
+			!template.NextRunAt.Equal(*due.NextRunAt) {
+			return nil
+		}
+
+		// The template was validated on save, a schedule which fails to parse
+		// or never matches again simply stops
+		var nextRunAt *time.Time
+		if schedule, err := parseCronSchedule(*template.Schedule); err == nil {
+			if next := schedule.next(now); !next.IsZero() {
+				nextRunAt = &next
+			}
+		}
+
+		// The task is created in the same transaction, so it is never left
+		// behind by a failed schedule update, or created twice
+		task, err = s.instantiateTaskTemplate(database.ContextWithTx(ctx, tx), template, now)
+		switch {
+		case errors.Is(err, ErrTaskTemplateNoInstances):
+			skipped = true
+		case err != nil:
+			return err
+		}
+
+		err = qtx.SetTaskTemplateRun(ctx, sqlc.SetTaskTemplateRunParams{
+			ID:        database.PgUUID(template.ID),
+			LastRunAt: database.PgTimestamp(now),
+			NextRunAt: database.PgTimestampPtr(nextRunAt),
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case skipped:
+		slog.Info("Task template skipped, nothing to move", "template_id", due.ID)
+	case task != nil:
+		slog.Info("Task created from template", "template_id", due.ID, "task_id", task.ID)
+	}
+	return task != nil, nil
+}
+
+// RunTaskScheduler calls RunDueTaskTemplates every interval until ctx is done
+func (s *TaskService) RunTaskScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := s.RunDueTaskTemplates(ctx)
+			if err != nil {
+				slog.Error("Failed to run task templates", "error", err)
+			}
+			if count > 0 {
+				slog.Info("Tasks created from templates", "count", count)
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Overdue tasks handled by a single checker pass, the rest waits for the next one
+const maxOverdueTasks = 500
+
+// CheckSlaBreaches flags open tasks which are past their due date. Each
+// breach is written to the audit log and published to Kafka.
+// Returns the number of newly breached tasks.
+//
+// Every task is flagged in its own transaction together with its audit
+// record, the record is published once the transaction is committed.
+func (s *TaskService) CheckSlaBreaches(ctx context.Context) (int, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CheckSlaBreaches", func(ctx context.Context, span trace.Span) (int, error) {
+		rows, err := s.queries.GetOverdueTasks(ctx, maxOverdueTasks)
+		if err != nil {
+			return 0, services.MapDbErrorToService(err)
+		}
+		span.SetAttributes(attribute.Int("tasks.overdue", len(rows)))
+
+		var breached int
+		var errs []error
+		for _, row := range rows {
+			ok, err := s.markTaskSlaBreached(ctx, row)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			if ok {
+				breached++
+			}
+		}
+		span.SetAttributes(attribute.Int("tasks.breached", breached))
+
+		return breached, errors.Join(errs...)
+	})
+}
+
+// markTaskSlaBreached flags a single overdue task and audits the breach.
+// Returns false when the task was already flagged by another replica or is
+// no longer overdue.
+func (s *TaskService) markTaskSlaBreached(ctx context.Context, overdue sqlc.Task) (bool, error) {
+	ctx = context.WithValue(ctx, models.OrganizationIDContextKey, database.UUIDFromPgx(overdue.OrgID))
+
+	change, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.ObjectChange, error) {
+		row, err := s.queries.WithTx(tx).MarkTaskSlaBreached(ctx, sqlc.MarkTaskSlaBreachedParams{
+			OrgID: overdue.OrgID,
+			ID:    overdue.ID,
+		})
+		if err != nil {
+			if database.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		after := toTask(row)
+		before := *after
+		before.SlaBreachedAt = nil
+
+		reason := models.ObjectChangeReasonSlaBreached
+		return s.audit.RecordObjectChange(database.ContextWithTx(ctx, tx), &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeTask,
+			TargetObjectID:   after.ID,
+			PrechangeState:   &before,
+			PostchangeState:  after,
+			Reason:           &reason,
+		})
+	})
+	if err != nil || change == nil {
+		return false, err
+	}
+
+	// The breach is flagged even if publishing fails, it stays in the audit log
+	if err := s.audit.PublishObjectChange(ctx, change); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// RunSlaChecker calls CheckSlaBreaches every interval until ctx is done
+func (s *TaskService) RunSlaChecker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := s.CheckSlaBreaches(ctx)
+			if err != nil {
+				slog.Error("Failed to check task SLA", "error", err)
+			}
+			if count > 0 {
+				slog.Info("Task SLA breached", "count", count)
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/models"
+)
+
+var (
+	ErrInvalidStatusTransition = fmt.Errorf("%w: invalid task status transition", common.ErrConflict)
+	ErrTaskClosed              = fmt.Errorf("%w: task is already completed or cancelled", common.ErrConflict)
+	ErrTaskItemNotPicked       = fmt.Errorf("%w: task item is not picked", common.ErrConflict)
+	ErrTaskItemNotPending      = fmt.Errorf("%w: task item is not pending", common.ErrConflict)
+	ErrTaskItemsNotProcessed   = fmt.Errorf("%w: not all task items are processed", common.ErrConflict)
+	ErrTaskItemNoTargetCell    = fmt.Errorf("%w: task item has no target cell", common.ErrConflict)
+	ErrInstanceNotInTask       = fmt.Errorf("%w: instance does not belong to the task", common.ErrConflict)
+	ErrInstanceAlreadyPicked   = fmt.Errorf("%w: instance is already picked", common.ErrConflict)
+	ErrInstanceNotAvailable    = fmt.Errorf("%w: instance is not available", common.ErrConflict)
+	ErrInstanceNotInSourceCell = fmt.Errorf("%w: instance is not in the source cell", common.ErrConflict)
+	ErrInstanceExpired         = fmt.Errorf("%w: instance is expired", common.ErrConflict)
+)
+
+// taskStatusTransitions lists statuses reachable from each task status.
+// Completed and cancelled tasks are final.
+var taskStatusTransitions = map[models.TaskStatus][]models.TaskStatus{
+	models.TaskStatusPending:    {models.TaskStatusInProgress, models.TaskStatusCancelled},
+	models.TaskStatusInProgress: {models.TaskStatusReady, models.TaskStatusCancelled},
+	models.TaskStatusReady:      {models.TaskStatusCompleted, models.TaskStatusCancelled},
+}
+
+func canTransition(from models.TaskStatus, to models.TaskStatus) bool {
+	for _, status := range taskStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+func validateTransition(from models.TaskStatus, to models.TaskStatus) error {
+	if !canTransition(from, to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, from, to)
+	}
+	return nil
+}
+
+func isTaskOpen(status models.TaskStatus) bool {
+	return status != models.TaskStatusCompleted && status != models.TaskStatusCancelled
+}
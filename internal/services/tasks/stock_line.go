@@ -0,0 +1,488 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrStockLineNotInTask        = fmt.Errorf("%w: stock line does not belong to the task", common.ErrConflict)
+	ErrStockLineAlreadyPicked    = fmt.Errorf("%w: stock line is already picked", common.ErrConflict)
+	ErrStockLineNotAvailable     = fmt.Errorf("%w: stock line is not available", common.ErrConflict)
+	ErrStockLineNotInSourceCell  = fmt.Errorf("%w: stock line is not in the source cell", common.ErrConflict)
+	ErrStockLineQuantityTooSmall = fmt.Errorf("%w: stock line holds less than the task item quantity", common.ErrConflict)
+)
+
+// taskItemsStockIDs splits the items into ids of the instances and ids of
+// the stock lines they refer to
+func taskItemsStockIDs(items []*models.TaskItem) ([]uuid.UUID, []uuid.UUID) {
+	instanceIDs := make([]uuid.UUID, 0, len(items))
+	stockLineIDs := make([]uuid.UUID, 0)
+	for _, item := range items {
+		switch {
+		case item.StockLineID != nil:
+			stockLineIDs = append(stockLineIDs, *item.StockLineID)
+		case item.InstanceID != nil:
+			instanceIDs = append(instanceIDs, *item.InstanceID)
+		}
+	}
+	return instanceIDs, stockLineIDs
+}
+
+// validateTaskItemsStock checks that every item refers to either an instance
+// or a quantity of a stock line. Stock lines are picked by pickment and
+// movement tasks only, other flows work with instances.
+func validateTaskItemsStock(task *models.Task) error {
+	seen := make(map[uuid.UUID]bool, len(task.Items))
+	for _, item := range task.Items {
+		if (item.InstanceID == nil) == (item.StockLineID == nil) {
+			return common.ErrDetailedValidationErrorWithMessage("task item must refer to either an instance or a stock line")
+		}
+		if item.InstanceID != nil {
+			if item.Quantity == 0 {
+				item.Quantity = 1
+			}
+			if item.Quantity != 1 {
+				return common.ErrDetailedValidationErrorWithMessage("quantity is allowed only for stock line items")
+			}
+			continue
+		}
+
+		if task.Type != models.TaskTypePickmentItem && task.Type != models.TaskTypeMovement {
+			return common.ErrDetailedValidationErrorWithMessage("stock lines are allowed only for pickment and movement tasks")
+		}
+		if item.Quantity <= 0 {
+			return common.ErrDetailedValidationErrorWithMessage("task item quantity must be positive")
+		}
+		if seen[*item.StockLineID] {
+			return common.ErrDetailedValidationErrorWithMessage("stock line is used by several task items")
+		}
+		seen[*item.StockLineID] = true
+	}
+	return nil
+}
+
+// validateTaskStockLines checks that the stock lines of the items are
+// available and hold the requested quantities. Cells of the lines are
+// returned for the unit check.
+func (s *TaskService) validateTaskStockLines(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, items []*models.TaskItem, ids []uuid.UUID) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	lines, err := s.item.GetStockLinesFull(ctx, orgID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	cellIDs := make([]uuid.UUID, 0, len(ids))
+	for _, item := range items {
+		if item.StockLineID == nil {
+			continue
+		}
+		line, ok := lines[*item.StockLineID]
+		if !ok {
+			return nil, fmt.Errorf("failed to get stock line: %w", common.ErrNotFound)
+		}
+		if line.Status != models.ItemInstanceStatusAvailable || line.CellID == nil {
+			return nil, ErrStockLineNotAvailable
+		}
+		if line.Quantity < item.Quantity {
+			return nil, ErrStockLineQuantityTooSmall
+		}
+		cellIDs = append(cellIDs, *line.CellID)
+	}
+	return cellIDs, nil
+}
+
+// PickStockLine takes the quantity of the task item off the stock line. A
+// part of the line is split off into a reserved line which the task item
+// follows from then on, a whole line is reserved as is.
+func (s *TaskService) PickStockLine(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, stockLineID uuid.UUID) error {
+	return telemetry.WithVoidTrace(ctx, s.tracer, "PickStockLine", func(ctx context.Context, span trace.Span) error {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("stock_line.id", stockLineID.String()),
+		)
+
+		taskBefore, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return err
+		}
+
+		lineBefore, err := s.item.GetStockLineById(ctx, orgID, stockLineID)
+		if err != nil {
+			return err
+		}
+
+		var taskAfter *models.Task
+		var splitID *uuid.UUID
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			status := models.TaskStatus(task.Status)
+			if !isTaskOpen(status) {
+				return ErrTaskClosed
+			}
+			if status != models.TaskStatusPending && status != models.TaskStatusInProgress {
+				return fmt.Errorf("%w: cannot pick items for task in status %s", ErrInvalidStatusTransition, status)
+			}
+
+			taskItem, err := qtx.GetTaskStockLineItemForUpdate(ctx, sqlc.GetTaskStockLineItemForUpdateParams{
+				OrgID:       database.PgUUID(orgID),
+				TaskID:      database.PgUUID(taskID),
+				StockLineID: database.PgUUID(stockLineID),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return ErrStockLineNotInTask
+				}
+				return services.MapDbErrorToService(err)
+			}
+			switch models.TaskItemStatus(taskItem.Status) {
+			case models.TaskItemStatusPending:
+			case models.TaskItemStatusPicked:
+				return ErrStockLineAlreadyPicked
+			default:
+				return ErrTaskItemNotPending
+			}
+
+			line, err := qtx.GetStockLineForUpdate(ctx, sqlc.GetStockLineForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(stockLineID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if models.ItemInstanceStatus(line.Status) != models.ItemInstanceStatusAvailable {
+				return ErrStockLineNotAvailable
+			}
+			if line.CellID != taskItem.SourceCellID {
+				return ErrStockLineNotInSourceCell
+			}
+			if line.Quantity < taskItem.Quantity {
+				return ErrStockLineQuantityTooSmall
+			}
+			if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, database.UUIDPtrFromPgx(line.CellID)); err != nil {
+				return err
+			}
+
+			reservedID := line.ID
+			if line.Quantity > taskItem.Quantity {
+				created, err := services.SplitStockLine(ctx, qtx, line, taskItem.Quantity,
+					sqlc.ItemInstanceStatus(models.ItemInstanceStatusReserved), database.PgUUID(taskID))
+				if err != nil {
+					return err
+				}
+				reservedID = created.ID
+				splitID = database.UUIDPtrFromPgx(created.ID)
+			}
+
+			err = qtx.MoveStockLine(ctx, sqlc.MoveStockLineParams{
+				OrgID:            database.PgUUID(orgID),
+				ID:               reservedID,
+				CellID:           database.PgUUIDPtr(nil),
+				Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusReserved),
+				AffectedByTaskID: database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetTaskStockLineItem(ctx, sqlc.SetTaskStockLineItemParams{
+				OrgID:       database.PgUUID(orgID),
+				ID:          taskItem.ID,
+				StockLineID: reservedID,
+				Status:      sqlc.TaskItemStatus(models.TaskItemStatusPicked),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			// The first picked item starts the task
+			if status == models.TaskStatusPending {
+				updated, err := updateTaskStatus(ctx, qtx, status, nil, sqlc.UpdateTaskParams{
+					OrgID:       database.PgUUID(orgID),
+					ID:          database.PgUUID(taskID),
+					Status:      sqlc.TaskStatus(models.TaskStatusInProgress),
+					CompletedAt: task.CompletedAt,
+				})
+				if err != nil {
+					return err
+				}
+				taskAfter = toTask(updated)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		lineAfter, err := s.item.GetStockLineById(ctx, orgID, stockLineID)
+		if err != nil {
+			return err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeStockLine,
+			TargetObjectID:   stockLineID,
+			PrechangeState:   lineBefore,
+			PostchangeState:  lineAfter,
+		})
+		if err != nil {
+			return err
+		}
+
+		if splitID != nil {
+			split, err := s.item.GetStockLineById(ctx, orgID, *splitID)
+			if err != nil {
+				return err
+			}
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionCreate,
+				TargetObjectType: models.ObjectTypeStockLine,
+				TargetObjectID:   split.ID,
+				PrechangeState:   nil,
+				PostchangeState:  split,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if taskAfter != nil {
+			return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypeTask,
+				TargetObjectID:   taskID,
+				PrechangeState:   taskBefore,
+				PostchangeState:  taskAfter,
+			})
+		}
+
+		return nil
+	})
+}
+
+// PutStockLine puts the reserved line picked by the task item into the
+// target cell. stockLineID is the line the task item follows after picking.
+func (s *TaskService) PutStockLine(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, stockLineID uuid.UUID) error {
+	return telemetry.WithVoidTrace(ctx, s.tracer, "PutStockLine", func(ctx context.Context, span trace.Span) error {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("stock_line.id", stockLineID.String()),
+		)
+
+		lineBefore, err := s.item.GetStockLineById(ctx, orgID, stockLineID)
+		if err != nil {
+			return err
+		}
+
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			status := models.TaskStatus(task.Status)
+			if !isTaskOpen(status) {
+				return ErrTaskClosed
+			}
+			if status != models.TaskStatusInProgress {
+				return fmt.Errorf("%w: cannot put items for task in status %s", ErrInvalidStatusTransition, status)
+			}
+
+			taskItem, err := qtx.GetTaskStockLineItemForUpdate(ctx, sqlc.GetTaskStockLineItemForUpdateParams{
+				OrgID:       database.PgUUID(orgID),
+				TaskID:      database.PgUUID(taskID),
+				StockLineID: database.PgUUID(stockLineID),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return ErrStockLineNotInTask
+				}
+				return services.MapDbErrorToService(err)
+			}
+			if models.TaskItemStatus(taskItem.Status) != models.TaskItemStatusPicked {
+				return ErrTaskItemNotPicked
+			}
+			if !taskItem.DestinationCellID.Valid {
+				return ErrTaskItemNoTargetCell
+			}
+			if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, database.UUIDPtrFromPgx(taskItem.DestinationCellID)); err != nil {
+				return err
+			}
+
+			err = qtx.MoveStockLine(ctx, sqlc.MoveStockLineParams{
+				OrgID:            database.PgUUID(orgID),
+				ID:               database.PgUUID(stockLineID),
+				CellID:           taskItem.DestinationCellID,
+				Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
+				AffectedByTaskID: database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetTaskStockLineItem(ctx, sqlc.SetTaskStockLineItemParams{
+				OrgID:       database.PgUUID(orgID),
+				ID:          taskItem.ID,
+				StockLineID: taskItem.StockLineID,
+				Status:      sqlc.TaskItemStatus(models.TaskItemStatusDone),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		lineAfter, err := s.item.GetStockLineById(ctx, orgID, stockLineID)
+		if err != nil {
+			return err
+		}
+
+		return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeStockLine,
+			TargetObjectID:   stockLineID,
+			PrechangeState:   lineBefore,
+			PostchangeState:  lineAfter,
+		})
+	})
+}
+
+// cancelStockLineItem releases the stock line of an item of a cancelled
+// task. A picked line goes back to the source cell as available.
+func cancelStockLineItem(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, item sqlc.TaskItem) error {
+	var itemStatus models.TaskItemStatus
+	switch models.TaskItemStatus(item.Status) {
+	case models.TaskItemStatusPending:
+		itemStatus = models.TaskItemStatusCanceled
+	case models.TaskItemStatusPicked:
+		err := qtx.MoveStockLine(ctx, sqlc.MoveStockLineParams{
+			OrgID:            database.PgUUID(orgID),
+			ID:               item.StockLineID,
+			CellID:           item.SourceCellID,
+			Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
+			AffectedByTaskID: database.PgUUIDPtr(nil),
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+		itemStatus = models.TaskItemStatusReturned
+	default:
+		return nil
+	}
+
+	err := qtx.SetTaskStockLineItem(ctx, sqlc.SetTaskStockLineItemParams{
+		OrgID:       database.PgUUID(orgID),
+		ID:          item.ID,
+		StockLineID: item.StockLineID,
+		Status:      sqlc.TaskItemStatus(itemStatus),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	return nil
+}
+
+// settleTaskStockLines ships the lines picked by a pickment task. Other
+// tasks must have put every picked line into its target cell.
+func settleTaskStockLines(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task sqlc.Task) error {
+	if models.TaskType(task.Type) == models.TaskTypePickmentItem && !task.TransferOrderID.Valid {
+		err := qtx.ShipTaskStockLines(ctx, sqlc.ShipTaskStockLinesParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: task.ID,
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+		return nil
+	}
+
+	reserved, err := qtx.GetTaskReservedStockLines(ctx, sqlc.GetTaskReservedStockLinesParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: task.ID,
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	if len(reserved) > 0 {
+		return ErrTaskItemsNotProcessed
+	}
+	return nil
+}
+
+// getTaskReservedStockLines returns the stock lines still reserved by the
+// task, captured before completion to record the lines it ships
+func (s *TaskService) getTaskReservedStockLines(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID) (map[uuid.UUID]*models.StockLine, error) {
+	lines, err := s.queries.GetTaskReservedStockLines(ctx, sqlc.GetTaskReservedStockLinesParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: database.PgUUID(taskID),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(lines))
+	for i, line := range lines {
+		ids[i] = database.UUIDFromPgx(line.ID)
+	}
+	return s.item.GetStockLinesFull(ctx, orgID, ids)
+}
+
+func (s *TaskService) auditShippedStockLines(ctx context.Context, orgID uuid.UUID, before map[uuid.UUID]*models.StockLine) error {
+	for id, line := range before {
+		after, err := s.item.GetStockLineById(ctx, orgID, id)
+		if err != nil {
+			return err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeStockLine,
+			TargetObjectID:   id,
+			PrechangeState:   line,
+			PostchangeState:  after,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package tasks
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -14,6 +15,7 @@ import (
 	"github.com/let-store-it/backend/internal/services"
 	"github.com/let-store-it/backend/internal/services/audit"
 	"github.com/let-store-it/backend/internal/services/auth"
+	"github.com/let-store-it/backend/internal/services/blob"
 	"github.com/let-store-it/backend/internal/services/employee"
 	"github.com/let-store-it/backend/internal/services/item"
 	"github.com/let-store-it/backend/internal/services/organization"
@@ -34,6 +36,9 @@ type TaskService struct {
 	item           *item.ItemService
 	employee       *employee.EmployeeService
 	audit          *audit.AuditService
+	blob           blob.Storage
+
+	attachmentMaxSize int64
 }
 
 type TaskServiceConfig struct {
@@ -45,9 +50,18 @@ type TaskServiceConfig struct {
 	ItemService     *item.ItemService
 	EmployeeService *employee.EmployeeService
 	AuditService    *audit.AuditService
+	// Keeps task attachments
+	BlobStorage blob.Storage
+	// Largest accepted attachment in bytes, 10 MiB when not set
+	AttachmentMaxSize int64
 }
 
 func New(cfg TaskServiceConfig) *TaskService {
+	attachmentMaxSize := cfg.AttachmentMaxSize
+	if attachmentMaxSize <= 0 {
+		attachmentMaxSize = defaultAttachmentMaxSize
+	}
+
 	return &TaskService{
 		queries:        cfg.Queries,
 		pgxpool:        cfg.PGXPool,
@@ -58,6 +72,9 @@ func New(cfg TaskServiceConfig) *TaskService {
 		item:           cfg.ItemService,
 		employee:       cfg.EmployeeService,
 		audit:          cfg.AuditService,
+		blob:           cfg.BlobStorage,
+
+		attachmentMaxSize: attachmentMaxSize,
 	}
 }
 
@@ -71,77 +88,271 @@ func (s *TaskService) CreateTask(ctx context.Context, orgID uuid.UUID, task *mod
 		)
 
 		return database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.Task, error) {
-			qtx := s.queries.WithTx(tx)
+			return s.createTask(ctx, s.queries.WithTx(tx), orgID, task)
+		})
+	})
+}
 
-			createdTask, err := qtx.CreateTask(ctx, sqlc.CreateTaskParams{
-				OrgID:            database.PgUUID(orgID),
-				UnitID:           database.PgUUID(task.UnitID),
-				Type:             sqlc.TaskType(task.Type),
-				Name:             task.Name,
-				Description:      database.PgTextPtr(task.Description),
-				AssignedToUserID: database.PgUUIDPtr(task.AssignedToUserID),
-			})
-			if err != nil {
-				return nil, services.MapDbErrorToService(err)
-			}
+// createTask validates and creates the task inside the caller's transaction.
+// Validation reads through qtx, so tasks created earlier in the same
+// transaction are seen, and the instances of the task are locked until the
+// transaction ends.
+func (s *TaskService) createTask(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task *models.Task) (*models.Task, error) {
+	if err := validateTaskPriority(&task.Priority); err != nil {
+		return nil, err
+	}
+	// due_at is stored without time zone in UTC and compared with the
+	// current UTC time
+	if task.DueAt != nil {
+		dueAt := task.DueAt.UTC()
+		task.DueAt = &dueAt
+	}
 
-			resultTask := toTask(createdTask)
-			resultTask.Items = make([]*models.TaskItem, 0, len(task.Items))
+	instanceIDs, stockLineIDs := taskItemsStockIDs(task.Items)
+	if len(instanceIDs) > 0 {
+		if _, err := qtx.LockItemInstances(ctx, sqlc.LockItemInstancesParams{
+			OrgID: database.PgUUID(orgID),
+			Ids:   database.PgUUIDs(instanceIDs),
+		}); err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+	}
 
-			for _, item := range task.Items {
-				sourceCell, err := s.item.GetItemInstanceFull(ctx, orgID, item.InstanceID)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get instance cell: %w", err)
-				}
+	countCellIDs, err := s.validateTaskContent(ctx, qtx, orgID, task)
+	if err != nil {
+		return nil, err
+	}
 
-				taskItemDB, err := qtx.CreateTaskItem(ctx, sqlc.CreateTaskItemParams{
-					OrgID:             database.PgUUID(orgID),
-					TaskID:            createdTask.ID,
-					ItemInstanceID:    database.PgUUID(item.InstanceID),
-					DestinationCellID: database.PgUUIDPtr(item.TargetCellID),
-					SourceCellID:      database.PgUUIDPtr(sourceCell.CellID),
-				})
-				if err != nil {
-					return nil, services.MapDbErrorToService(err)
-				}
-				taskItem := toTaskItem(taskItemDB)
+	createdTask, err := qtx.CreateTask(ctx, sqlc.CreateTaskParams{
+		OrgID:            database.PgUUID(orgID),
+		UnitID:           database.PgUUID(task.UnitID),
+		Type:             sqlc.TaskType(task.Type),
+		Name:             task.Name,
+		Description:      database.PgTextPtr(task.Description),
+		AssignedToUserID: database.PgUUIDPtr(task.AssignedToUserID),
+		ReceivingCellID:  database.PgUUIDPtr(task.ReceivingCellID),
+		OriginalTaskID:   database.PgUUIDPtr(task.OriginalTaskID),
+		Priority:         sqlc.TaskPriority(task.Priority),
+		DueAt:            database.PgTimestampPtr(task.DueAt),
+		TemplateID:       database.PgUUIDPtr(task.TemplateID),
+		TransferOrderID:  database.PgUUIDPtr(task.TransferOrderID),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
 
-				taskItem.SourceCell = sourceCell.Cell
+	if err := recordTaskStatusChange(ctx, qtx, createdTask, nil, nil); err != nil {
+		return nil, err
+	}
 
-				if item.TargetCellID != nil {
-					cell, err := s.storageService.GetCellFull(ctx, orgID, *item.TargetCellID)
-					if err != nil {
-						return nil, fmt.Errorf("failed to get cell: %w", err)
-					}
-					taskItem.TargetCell = cell
-				}
+	resultTask := toTask(createdTask)
 
-				instance, err := s.item.GetItemInstanceFull(ctx, orgID, item.InstanceID)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get instance: %w", err)
-				}
-				taskItem.Instance = instance
+	if resultTask.Type == models.TaskTypeInventoryCount {
+		if err := s.createTaskCountCells(ctx, qtx, orgID, resultTask.ID, countCellIDs); err != nil {
+			return nil, err
+		}
+		if err := s.loadTaskCountCells(ctx, qtx, orgID, resultTask); err != nil {
+			return nil, fmt.Errorf("failed to load count cells: %w", err)
+		}
+	}
+
+	if resultTask.Type == models.TaskTypeReceiving {
+		resultTask.ExpectedItems, err = s.createTaskExpectedItems(ctx, qtx, orgID, resultTask.ID, task.ExpectedItems)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.loadTaskExpectedItemsRelations(ctx, orgID, resultTask); err != nil {
+			return nil, fmt.Errorf("failed to load expected items: %w", err)
+		}
+	}
+	resultTask.Items = make([]*models.TaskItem, 0, len(task.Items))
+
+	instances, err := s.item.GetItemInstancesFull(ctx, orgID, instanceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instances: %w", err)
+	}
+	stockLines, err := s.item.GetStockLinesFull(ctx, orgID, stockLineIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock lines: %w", err)
+	}
 
-				resultTask.Items = append(resultTask.Items, taskItem)
+	cellIDs := make([]*uuid.UUID, 0, len(task.Items)*2)
+	for _, item := range task.Items {
+		var taskItemDB sqlc.TaskItem
+		var sourceCellID *uuid.UUID
+		if item.StockLineID != nil {
+			line, ok := stockLines[*item.StockLineID]
+			if !ok {
+				return nil, fmt.Errorf("failed to get stock line: %w", common.ErrNotFound)
 			}
+			sourceCellID = line.CellID
 
-			unit, err := s.org.GetUnitByID(ctx, orgID, resultTask.UnitID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get unit: %w", err)
+			taskItemDB, err = qtx.CreateTaskStockLineItem(ctx, sqlc.CreateTaskStockLineItemParams{
+				OrgID:             database.PgUUID(orgID),
+				TaskID:            createdTask.ID,
+				StockLineID:       database.PgUUID(line.ID),
+				Quantity:          int32(item.Quantity),
+				DestinationCellID: database.PgUUIDPtr(item.TargetCellID),
+				SourceCellID:      database.PgUUIDPtr(sourceCellID),
+				Status:            sqlc.TaskItemStatus(models.TaskItemStatusPending),
+			})
+		} else {
+			instance, ok := instances[*item.InstanceID]
+			if !ok {
+				return nil, fmt.Errorf("failed to get instance: %w", common.ErrNotFound)
 			}
-			resultTask.Unit = unit
+			sourceCellID = instance.CellID
 
-			if resultTask.AssignedToUserID != nil {
-				assignedTo, err := s.employee.GetEmployee(ctx, orgID, *resultTask.AssignedToUserID)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get assigned to: %w", err)
-				}
-				resultTask.AssignedTo = assignedTo
+			taskItemDB, err = qtx.CreateTaskItem(ctx, sqlc.CreateTaskItemParams{
+				OrgID:             database.PgUUID(orgID),
+				TaskID:            createdTask.ID,
+				ItemInstanceID:    database.PgUUID(instance.ID),
+				DestinationCellID: database.PgUUIDPtr(item.TargetCellID),
+				SourceCellID:      database.PgUUIDPtr(sourceCellID),
+			})
+		}
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		resultTask.Items = append(resultTask.Items, toTaskItem(taskItemDB))
+		cellIDs = append(cellIDs, sourceCellID, item.TargetCellID)
+	}
+
+	if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, cellIDs...); err != nil {
+		return nil, err
+	}
+
+	if err := s.loadTaskItemsRelations(ctx, orgID, resultTask.Items); err != nil {
+		return nil, fmt.Errorf("failed to load task items: %w", err)
+	}
+	if err := s.sequenceTaskItems(ctx, orgID, resultTask.UnitID, resultTask.Items); err != nil {
+		return nil, fmt.Errorf("failed to order task items: %w", err)
+	}
+
+	if resultTask.AssignedToUserID == nil {
+		if err := s.autoAssignTask(ctx, qtx, orgID, resultTask); err != nil {
+			return nil, fmt.Errorf("failed to assign task: %w", err)
+		}
+	}
+
+	if err := s.loadTasksRelations(ctx, orgID, []*models.Task{resultTask}); err != nil {
+		return nil, fmt.Errorf("failed to load task relations: %w", err)
+	}
+
+	return resultTask, nil
+}
+
+func validateTaskPriority(priority *models.TaskPriority) error {
+	switch *priority {
+	case "":
+		*priority = models.TaskPriorityNormal
+	case models.TaskPriorityLow, models.TaskPriorityNormal, models.TaskPriorityHigh, models.TaskPriorityUrgent:
+	default:
+		return common.ErrDetailedValidationErrorWithMessage("invalid task priority")
+	}
+	return nil
+}
+
+// validateTaskContent checks fields that depend on the task type. Receiving
+// tasks carry a manifest, inventory count tasks a set of cells, return tasks
+// instances handed out by the original task and other tasks a list of
+// instances. For inventory count tasks the resolved cell ids are returned.
+func (s *TaskService) validateTaskContent(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task *models.Task) ([]uuid.UUID, error) {
+	if task.Type != models.TaskTypeReceiving {
+		if task.ReceivingCellID != nil {
+			return nil, common.ErrDetailedValidationErrorWithMessage("receiving cell is allowed only for receiving tasks")
+		}
+		if len(task.ExpectedItems) > 0 {
+			return nil, common.ErrDetailedValidationErrorWithMessage("expected items are allowed only for receiving tasks")
+		}
+	}
+	if task.Type != models.TaskTypeInventoryCount && task.CountTarget != nil {
+		return nil, common.ErrDetailedValidationErrorWithMessage("count target is allowed only for inventory count tasks")
+	}
+	if task.Type != models.TaskTypeReturn && task.OriginalTaskID != nil {
+		return nil, common.ErrDetailedValidationErrorWithMessage("original task is allowed only for return tasks")
+	}
+	if err := validateTaskItemsStock(task); err != nil {
+		return nil, err
+	}
+
+	switch task.Type {
+	case models.TaskTypeReceiving:
+		return nil, s.validateReceivingTask(ctx, qtx, orgID, task)
+	case models.TaskTypeInventoryCount:
+		return s.resolveCountCells(ctx, qtx, orgID, task)
+	case models.TaskTypeReturn:
+		if err := s.validateReturnTask(ctx, qtx, orgID, task); err != nil {
+			return nil, err
+		}
+	}
+	return nil, s.validateTaskItemsUnit(ctx, qtx, orgID, task)
+}
+
+// validateTaskItemsUnit checks that target cells of the items belong to the
+// task unit. Instances and stock lines picked or moved by the task must be
+// stored in the task unit as well, returned instances are not in any cell.
+// Expired instances cannot be picked.
+func (s *TaskService) validateTaskItemsUnit(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task *models.Task) error {
+	if len(task.Items) == 0 {
+		return nil
+	}
+
+	targetCellIDs := make([]uuid.UUID, 0, len(task.Items))
+	for _, item := range task.Items {
+		if item.TargetCellID != nil {
+			targetCellIDs = append(targetCellIDs, *item.TargetCellID)
+		}
+	}
+
+	sourceCellIDs := make([]uuid.UUID, 0, len(task.Items))
+	if task.Type != models.TaskTypeReturn {
+		instanceIDs, stockLineIDs := taskItemsStockIDs(task.Items)
+		instances, err := s.item.GetItemInstancesFull(ctx, orgID, instanceIDs)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, id := range instanceIDs {
+			instance, ok := instances[id]
+			if !ok {
+				return fmt.Errorf("failed to get instance: %w", common.ErrNotFound)
 			}
+			if task.Type == models.TaskTypePickmentItem && models.IsExpired(instance.ExpiresAt, now) {
+				return ErrInstanceExpired
+			}
+			if instance.CellID != nil {
+				sourceCellIDs = append(sourceCellIDs, *instance.CellID)
+			}
+		}
 
-			return resultTask, nil
-		})
-	})
+		cellIDs, err := s.validateTaskStockLines(ctx, qtx, orgID, task.Items, stockLineIDs)
+		if err != nil {
+			return err
+		}
+		sourceCellIDs = append(sourceCellIDs, cellIDs...)
+	}
+
+	cells, err := s.storageService.GetCellsFull(ctx, orgID, append(targetCellIDs, sourceCellIDs...))
+	if err != nil {
+		return err
+	}
+	for _, id := range targetCellIDs {
+		cell, ok := cells[id]
+		if !ok {
+			return fmt.Errorf("failed to get cell: %w", common.ErrNotFound)
+		}
+		if !cellInUnit(cell, task.UnitID) {
+			return common.ErrDetailedValidationErrorWithMessage("target cells must belong to the task unit")
+		}
+	}
+	for _, id := range sourceCellIDs {
+		if cell, ok := cells[id]; !ok || !cellInUnit(cell, task.UnitID) {
+			return common.ErrDetailedValidationErrorWithMessage("instances must be stored in the task unit")
+		}
+	}
+	return nil
 }
 
 func (s *TaskService) GetTaskById(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (*models.Task, error) {
@@ -169,47 +380,33 @@ func (s *TaskService) GetTaskById(ctx context.Context, orgID uuid.UUID, id uuid.
 			return nil, services.MapDbErrorToService(err)
 		}
 
-		taskItems := make([]*models.TaskItem, len(items))
+		res.Items = make([]*models.TaskItem, len(items))
 		for i, item := range items {
-			taskItems[i] = toTaskItem(item)
+			res.Items[i] = toTaskItem(item)
+		}
 
-			if taskItems[i].SourceCellID != nil {
-				sourceCell, err := s.storageService.GetCellFull(ctx, orgID, *taskItems[i].SourceCellID)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get source cell: %w", err)
-				}
-				taskItems[i].SourceCell = sourceCell
-			}
+		if err := s.loadTaskItemsRelations(ctx, orgID, res.Items); err != nil {
+			return nil, fmt.Errorf("failed to load task items: %w", err)
+		}
+		if err := s.sequenceTaskItems(ctx, orgID, res.UnitID, res.Items); err != nil {
+			return nil, fmt.Errorf("failed to order task items: %w", err)
+		}
 
-			if taskItems[i].TargetCellID != nil {
-				targetCell, err := s.storageService.GetCellFull(ctx, orgID, *taskItems[i].TargetCellID)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get target cell: %w", err)
-				}
-				taskItems[i].TargetCell = targetCell
-			}
+		if err := s.loadTaskExpectedItems(ctx, orgID, res); err != nil {
+			return nil, fmt.Errorf("failed to load expected items: %w", err)
+		}
 
-			instance, err := s.item.GetItemInstanceFull(ctx, orgID, taskItems[i].InstanceID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get instance: %w", err)
-			}
-			taskItems[i].Instance = instance
+		if err := s.loadTaskCountCells(ctx, s.queries, orgID, res); err != nil {
+			return nil, fmt.Errorf("failed to load count cells: %w", err)
 		}
-		res.Items = taskItems
 
-		if res.AssignedToUserID != nil {
-			assignedTo, err := s.employee.GetEmployee(ctx, orgID, *res.AssignedToUserID)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get assigned to: %w", err)
-			}
-			res.AssignedTo = assignedTo
+		if err := s.loadTaskExceptions(ctx, orgID, res); err != nil {
+			return nil, fmt.Errorf("failed to load exceptions: %w", err)
 		}
 
-		unit, err := s.org.GetUnitByID(ctx, orgID, res.UnitID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get unit: %w", err)
+		if err := s.loadTasksRelations(ctx, orgID, []*models.Task{res}); err != nil {
+			return nil, fmt.Errorf("failed to load task relations: %w", err)
 		}
-		res.Unit = unit
 
 		return res, nil
 	})
@@ -229,64 +426,603 @@ func (s *TaskService) GetTasks(ctx context.Context, orgID uuid.UUID) ([]*models.
 			return nil, services.MapDbErrorToService(err)
 		}
 
-		models := make([]*models.Task, len(tasks))
-		for i, task := range tasks {
-			models[i] = toTask(task)
-			if models[i].AssignedToUserID != nil {
-				empl, err := s.employee.GetEmployee(ctx, orgID, *models[i].AssignedToUserID)
+		return s.toTasksWithRelations(ctx, orgID, tasks)
+	})
+}
+
+// pickTaskInstance picks the instance for the task inside the caller's
+// transaction. Returns the task before and after it was started by the first
+// pick, both nil when it was already in progress.
+func pickTaskInstance(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, taskID uuid.UUID, instanceID uuid.UUID) (*models.Task, *models.Task, error) {
+	task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(taskID),
+	})
+	if err != nil {
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+	if models.TaskType(task.Type) == models.TaskTypeReturn {
+		return nil, nil, ErrReturnTaskNotPickable
+	}
+
+	status := models.TaskStatus(task.Status)
+	if !isTaskOpen(status) {
+		return nil, nil, ErrTaskClosed
+	}
+	if status != models.TaskStatusPending && status != models.TaskStatusInProgress {
+		return nil, nil, fmt.Errorf("%w: cannot pick items for task in status %s", ErrInvalidStatusTransition, status)
+	}
+
+	taskItem, err := qtx.GetTaskItemForUpdate(ctx, sqlc.GetTaskItemForUpdateParams{
+		OrgID:          database.PgUUID(orgID),
+		TaskID:         database.PgUUID(taskID),
+		ItemInstanceID: database.PgUUID(instanceID),
+	})
+	if err != nil {
+		if database.IsNotFound(err) {
+			return nil, nil, ErrInstanceNotInTask
+		}
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+	switch models.TaskItemStatus(taskItem.Status) {
+	case models.TaskItemStatusPending:
+	case models.TaskItemStatusPicked:
+		return nil, nil, ErrInstanceAlreadyPicked
+	default:
+		return nil, nil, ErrTaskItemNotPending
+	}
+
+	instance, err := qtx.GetItemInstanceForUpdate(ctx, sqlc.GetItemInstanceForUpdateParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(instanceID),
+	})
+	if err != nil {
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+	if models.ItemInstanceStatus(instance.Status) != models.ItemInstanceStatusAvailable {
+		return nil, nil, ErrInstanceNotAvailable
+	}
+	if instance.CellID != taskItem.SourceCellID {
+		return nil, nil, ErrInstanceNotInSourceCell
+	}
+	if models.TaskType(task.Type) == models.TaskTypePickmentItem &&
+		models.IsExpired(database.PgDatePtrFromPgx(instance.ExpiresAt), time.Now()) {
+		return nil, nil, ErrInstanceExpired
+	}
+	if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, database.UUIDPtrFromPgx(instance.CellID)); err != nil {
+		return nil, nil, err
+	}
+
+	err = qtx.SetItemInstanceTaskStatus(ctx, sqlc.SetItemInstanceTaskStatusParams{
+		OrgID:            database.PgUUID(orgID),
+		ID:               database.PgUUID(instanceID),
+		Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusReserved),
+		AffectedByTaskID: database.PgUUID(taskID),
+	})
+	if err != nil {
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+
+	err = qtx.SetItemInstanceCell(ctx, sqlc.SetItemInstanceCellParams{
+		OrgID:  database.PgUUID(orgID),
+		ID:     database.PgUUID(instanceID),
+		CellID: database.PgUUIDPtr(nil),
+	})
+	if err != nil {
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+
+	err = qtx.SetTaskItemStatus(ctx, sqlc.SetTaskItemStatusParams{
+		OrgID:          database.PgUUID(orgID),
+		TaskID:         database.PgUUID(taskID),
+		ItemInstanceID: database.PgUUID(instanceID),
+		Status:         sqlc.TaskItemStatus(models.TaskItemStatusPicked),
+	})
+	if err != nil {
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+
+	// The first picked item starts the task
+	if status == models.TaskStatusPending {
+		updated, err := updateTaskStatus(ctx, qtx, status, nil, sqlc.UpdateTaskParams{
+			OrgID:       database.PgUUID(orgID),
+			ID:          database.PgUUID(taskID),
+			Status:      sqlc.TaskStatus(models.TaskStatusInProgress),
+			CompletedAt: task.CompletedAt,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return toTask(task), toTask(updated), nil
+	}
+
+	return nil, nil, nil
+}
+
+// PickInstance takes the instance out of its source cell. The task, task item
+// and instance rows are locked, so concurrent picks of the same instance are
+// serialized and only the first one succeeds.
+func (s *TaskService) PickInstance(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, instanceID uuid.UUID) error {
+	return telemetry.WithVoidTrace(ctx, s.tracer, "PickInstance", func(ctx context.Context, span trace.Span) error {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("instance.id", instanceID.String()),
+		)
+
+		taskBefore, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return err
+		}
+
+		instanceBefore, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return err
+		}
+
+		var taskAfter *models.Task
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			_, taskAfter, err = pickTaskInstance(ctx, s.queries.WithTx(tx), orgID, taskID, instanceID)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		instanceAfter, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeItemInstance,
+			TargetObjectID:   instanceID,
+			PrechangeState:   instanceBefore,
+			PostchangeState:  instanceAfter,
+		})
+		if err != nil {
+			return err
+		}
+
+		if taskAfter != nil {
+			return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypeTask,
+				TargetObjectID:   taskID,
+				PrechangeState:   taskBefore,
+				PostchangeState:  taskAfter,
+			})
+		}
+
+		return nil
+	})
+}
+
+func (s *TaskService) PutInstance(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, instanceID uuid.UUID) error {
+	return telemetry.WithVoidTrace(ctx, s.tracer, "PutInstance", func(ctx context.Context, span trace.Span) error {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+			attribute.String("instance.id", instanceID.String()),
+		)
+
+		instanceBefore, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return err
+		}
+
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			// Wave items are sorted at the consolidation cell instead
+			if task.WaveID.Valid {
+				return ErrTaskInPickWave
+			}
+
+			status := models.TaskStatus(task.Status)
+			if !isTaskOpen(status) {
+				return ErrTaskClosed
+			}
+			if status != models.TaskStatusInProgress {
+				return fmt.Errorf("%w: cannot put items for task in status %s", ErrInvalidStatusTransition, status)
+			}
+
+			taskItem, err := qtx.GetTaskItemForUpdate(ctx, sqlc.GetTaskItemForUpdateParams{
+				OrgID:          database.PgUUID(orgID),
+				TaskID:         database.PgUUID(taskID),
+				ItemInstanceID: database.PgUUID(instanceID),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return ErrInstanceNotInTask
+				}
+				return services.MapDbErrorToService(err)
+			}
+			if models.TaskItemStatus(taskItem.Status) != models.TaskItemStatusPicked {
+				return ErrTaskItemNotPicked
+			}
+			if !taskItem.DestinationCellID.Valid {
+				return ErrTaskItemNoTargetCell
+			}
+			if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, database.UUIDPtrFromPgx(taskItem.DestinationCellID)); err != nil {
+				return err
+			}
+
+			err = qtx.SetItemInstanceCell(ctx, sqlc.SetItemInstanceCellParams{
+				OrgID:  database.PgUUID(orgID),
+				ID:     database.PgUUID(instanceID),
+				CellID: taskItem.DestinationCellID,
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetItemInstanceTaskStatus(ctx, sqlc.SetItemInstanceTaskStatusParams{
+				OrgID:            database.PgUUID(orgID),
+				ID:               database.PgUUID(instanceID),
+				Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
+				AffectedByTaskID: database.PgUUID(taskID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetTaskItemStatus(ctx, sqlc.SetTaskItemStatusParams{
+				OrgID:          database.PgUUID(orgID),
+				TaskID:         database.PgUUID(taskID),
+				ItemInstanceID: database.PgUUID(instanceID),
+				Status:         sqlc.TaskItemStatus(models.TaskItemStatusDone),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		instanceAfter, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return err
+		}
+
+		return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeItemInstance,
+			TargetObjectID:   instanceID,
+			PrechangeState:   instanceBefore,
+			PostchangeState:  instanceAfter,
+		})
+	})
+}
+
+func (s *TaskService) MarkTaskAsReady(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, comment *string) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "MarkTaskAsReady", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		if err := validateStatusComment(comment); err != nil {
+			return nil, err
+		}
+
+		before, err := s.GetTaskById(ctx, orgID, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		var code *models.TaskPickupCode
+		task, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.Task, error) {
+			qtx := s.queries.WithTx(tx)
+
+			// Items are checked on the locked task, so none can be returned
+			// to pending while the task becomes ready
+			current, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+			if err := ensureTaskItemsProcessed(ctx, qtx, current); err != nil {
+				return nil, err
+			}
+
+			ready, err := transitionTaskStatus(ctx, qtx, orgID, taskID, models.TaskStatusReady, comment)
+			if err != nil {
+				return nil, err
+			}
+
+			switch ready.Type {
+			case models.TaskTypePickmentItem:
+				code, err = issuePickupCode(ctx, qtx, ready)
 				if err != nil {
 					return nil, err
 				}
-				models[i].AssignedTo = empl
+			case models.TaskTypeReceiving:
+				if err := recordReceivingDiscrepancies(ctx, qtx, orgID, taskID); err != nil {
+					return nil, err
+				}
 			}
-			unit, err := s.org.GetUnitByID(ctx, orgID, models[i].UnitID)
-			if err != nil {
+			return ready, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeTask,
+			TargetObjectID:   taskID,
+			PrechangeState:   before,
+			PostchangeState:  task,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if code != nil {
+			if err := s.auditPickupCode(ctx, models.ObjectChangeActionCreate, nil, code, nil); err != nil {
 				return nil, err
 			}
-			models[i].Unit = unit
 		}
 
-		return models, nil
+		if err := s.loadTaskExpectedItems(ctx, orgID, task); err != nil {
+			return nil, fmt.Errorf("failed to load expected items: %w", err)
+		}
+
+		return task, nil
 	})
 }
 
-func (s *TaskService) PickInstance(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, instanceID uuid.UUID) error {
-	return telemetry.WithVoidTrace(ctx, s.tracer, "PickInstance", func(ctx context.Context, span trace.Span) error {
+// ensureTaskItemsProcessed checks that the task can become ready: every item
+// is picked, items with a target cell are also put, and every cell of an
+// inventory count is counted
+func ensureTaskItemsProcessed(ctx context.Context, qtx *sqlc.Queries, task sqlc.Task) error {
+	taskType := models.TaskType(task.Type)
+	if taskType == models.TaskTypeInventoryCount {
+		if err := ensureAllCellsCounted(ctx, qtx, database.UUIDFromPgx(task.OrgID), database.UUIDFromPgx(task.ID)); err != nil {
+			return err
+		}
+	}
+
+	items, err := qtx.GetTaskItems(ctx, sqlc.GetTaskItemsParams{
+		OrgID:  task.OrgID,
+		TaskID: task.ID,
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	for _, item := range items {
+		switch models.TaskItemStatus(item.Status) {
+		case models.TaskItemStatusPending:
+			return ErrTaskItemsNotProcessed
+		case models.TaskItemStatusPicked:
+			// Only pickment items stay picked until the task is completed
+			if item.DestinationCellID.Valid || taskType != models.TaskTypePickmentItem {
+				return ErrTaskItemsNotProcessed
+			}
+		}
+	}
+	return nil
+}
+
+// CompleteTask completes a ready task. Pickment tasks are only completed
+// when their pickup code is presented. Instances picked by a pickment task
+// are shipped and leave the stock.
+func (s *TaskService) CompleteTask(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, comment *string, pickupCode *string) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CompleteTask", func(ctx context.Context, span trace.Span) (*models.Task, error) {
 		span.SetAttributes(
 			attribute.String("org.id", orgID.String()),
 			attribute.String("task.id", taskID.String()),
-			attribute.String("instance.id", instanceID.String()),
 		)
 
-		err := s.item.SetItemInstanceStatus(ctx, &models.ItemInstance{
-			OrgID:            orgID,
-			ID:               instanceID,
-			Status:           models.ItemInstanceStatusReserved,
-			AffectedByTaskID: &taskID,
+		if err := validateStatusComment(comment); err != nil {
+			return nil, err
+		}
+
+		task, err := s.queries.GetTaskById(ctx, sqlc.GetTaskByIdParams{
+			OrgID: database.PgUUID(orgID),
+			ID:    database.PgUUID(taskID),
 		})
 		if err != nil {
-			return services.MapDbErrorToService(err)
+			return nil, services.MapDbErrorToService(err)
+		}
+		if models.TaskType(task.Type) == models.TaskTypePickmentItem {
+			return s.completeWithPickupCode(ctx, orgID, taskID, comment, pickupCode)
 		}
 
-		err = s.item.SetInstanceCell(ctx, orgID, instanceID, nil)
+		return s.setTaskStatus(ctx, orgID, taskID, models.TaskStatusCompleted, comment)
+	})
+}
+
+// CancelTask cancels an open task. Picked instances and stock lines go back
+// to their source cells, pending items are canceled and already put items are left as is.
+// Instances sorted by a pick wave are still reserved and go back as picked ones.
+// Cancelling the outbound leg of a transfer order cancels the order.
+func (s *TaskService) CancelTask(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, comment *string) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CancelTask", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("task.id", taskID.String()),
+		)
+
+		if err := validateStatusComment(comment); err != nil {
+			return nil, err
+		}
+
+		before, err := s.GetTaskById(ctx, orgID, taskID)
 		if err != nil {
-			return services.MapDbErrorToService(err)
+			return nil, err
 		}
 
-		err = s.queries.SetTaskItemStatus(ctx, sqlc.SetTaskItemStatusParams{
-			OrgID:          database.PgUUID(orgID),
-			ItemInstanceID: database.PgUUID(instanceID),
-			Status:         sqlc.TaskItemStatus(models.TaskItemStatusPicked),
+		var orderBefore, orderAfter *models.TransferOrder
+		updated, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.Task, error) {
+			qtx := s.queries.WithTx(tx)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(taskID),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+
+			status := models.TaskStatus(task.Status)
+			if !isTaskOpen(status) {
+				return nil, ErrTaskClosed
+			}
+			if err := validateTransition(status, models.TaskStatusCancelled); err != nil {
+				return nil, err
+			}
+			if err := ensureNotInOpenWave(ctx, qtx, task); err != nil {
+				return nil, err
+			}
+			orderBefore, orderAfter, err = cancelTransferOrderLeg(ctx, qtx, task)
+			if err != nil {
+				return nil, err
+			}
+
+			items, err := qtx.GetTaskItems(ctx, sqlc.GetTaskItemsParams{
+				OrgID:  database.PgUUID(orgID),
+				TaskID: database.PgUUID(taskID),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+
+			for _, item := range items {
+				if item.StockLineID.Valid {
+					if err := cancelStockLineItem(ctx, qtx, orgID, item); err != nil {
+						return nil, err
+					}
+					continue
+				}
+
+				var itemStatus models.TaskItemStatus
+				switch models.TaskItemStatus(item.Status) {
+				case models.TaskItemStatusPending:
+					itemStatus = models.TaskItemStatusCanceled
+				case models.TaskItemStatusPicked:
+					if err := restoreTaskItemInstance(ctx, qtx, orgID, item); err != nil {
+						return nil, err
+					}
+					itemStatus = models.TaskItemStatusReturned
+				case models.TaskItemStatusDone:
+					// Items sorted at the consolidation cell of a wave are done,
+					// while the instance is still reserved by the task
+					instance, err := qtx.GetItemInstanceForUpdate(ctx, sqlc.GetItemInstanceForUpdateParams{
+						OrgID: database.PgUUID(orgID),
+						ID:    item.ItemInstanceID,
+					})
+					if err != nil {
+						return nil, services.MapDbErrorToService(err)
+					}
+					if models.ItemInstanceStatus(instance.Status) != models.ItemInstanceStatusReserved || instance.AffectedByTaskID != task.ID {
+						continue
+					}
+					if err := restoreTaskItemInstance(ctx, qtx, orgID, item); err != nil {
+						return nil, err
+					}
+					itemStatus = models.TaskItemStatusReturned
+				default:
+					continue
+				}
+
+				err = qtx.SetTaskItemStatus(ctx, sqlc.SetTaskItemStatusParams{
+					OrgID:          database.PgUUID(orgID),
+					TaskID:         database.PgUUID(taskID),
+					ItemInstanceID: item.ItemInstanceID,
+					Status:         sqlc.TaskItemStatus(itemStatus),
+				})
+				if err != nil {
+					return nil, services.MapDbErrorToService(err)
+				}
+			}
+
+			updated, err := updateTaskStatus(ctx, qtx, status, comment, sqlc.UpdateTaskParams{
+				OrgID:       database.PgUUID(orgID),
+				ID:          database.PgUUID(taskID),
+				Status:      sqlc.TaskStatus(models.TaskStatusCancelled),
+				CompletedAt: task.CompletedAt,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return toTask(updated), nil
 		})
 		if err != nil {
-			return services.MapDbErrorToService(err)
+			return nil, err
 		}
 
-		return nil
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeTask,
+			TargetObjectID:   taskID,
+			PrechangeState:   before,
+			PostchangeState:  updated,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := s.auditTransferOrder(ctx, orderBefore, orderAfter); err != nil {
+			return nil, err
+		}
+
+		return updated, nil
+	})
+}
+
+// restoreTaskItemInstance moves the instance of a cancelled task item back
+// to its source cell and releases it
+func restoreTaskItemInstance(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, item sqlc.TaskItem) error {
+	err := qtx.SetItemInstanceCell(ctx, sqlc.SetItemInstanceCellParams{
+		OrgID:  database.PgUUID(orgID),
+		ID:     item.ItemInstanceID,
+		CellID: item.SourceCellID,
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+
+	err = qtx.SetItemInstanceTaskStatus(ctx, sqlc.SetItemInstanceTaskStatusParams{
+		OrgID:            database.PgUUID(orgID),
+		ID:               item.ItemInstanceID,
+		Status:           sqlc.ItemInstanceStatus(models.ItemInstanceStatusAvailable),
+		AffectedByTaskID: database.PgUUIDPtr(nil),
 	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	return nil
+}
+
+func (s *TaskService) setTaskStatus(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID, status models.TaskStatus, comment *string) (*models.Task, error) {
+	return s.UpdateTask(ctx, &models.Task{
+		ID:     taskID,
+		OrgID:  orgID,
+		Status: status,
+	}, comment)
 }
 
-func (s *TaskService) UpdateTask(ctx context.Context, task *models.Task) (*models.Task, error) {
+// UpdateTask moves the task to task.Status, rejecting transitions not
+// allowed by the task state machine. The transition is recorded in the task
+// status history with the optional comment.
+func (s *TaskService) UpdateTask(ctx context.Context, task *models.Task, comment *string) (*models.Task, error) {
 	return telemetry.WithTrace(ctx, s.tracer, "UpdateTask", func(ctx context.Context, span trace.Span) (*models.Task, error) {
 		span.SetAttributes(
 			attribute.String("org.id", task.OrgID.String()),
@@ -294,21 +1030,59 @@ func (s *TaskService) UpdateTask(ctx context.Context, task *models.Task) (*model
 			attribute.String("task.status", string(task.Status)),
 		)
 
+		if err := validateStatusComment(comment); err != nil {
+			return nil, err
+		}
+
 		before, err := s.GetTaskById(ctx, task.OrgID, task.ID)
 		if err != nil {
 			return nil, err
 		}
 
-		updated, err := s.queries.UpdateTask(ctx, sqlc.UpdateTaskParams{
-			OrgID:  database.PgUUID(task.OrgID),
-			ID:     database.PgUUID(task.ID),
-			Status: sqlc.TaskStatus(task.Status),
+		var shipped map[uuid.UUID]*models.ItemInstance
+		var shippedLines map[uuid.UUID]*models.StockLine
+		if task.Status == models.TaskStatusCompleted {
+			shipped, err = s.getTaskReservedInstances(ctx, task.OrgID, task.ID)
+			if err != nil {
+				return nil, err
+			}
+			shippedLines, err = s.getTaskReservedStockLines(ctx, task.OrgID, task.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var orderBefore, orderAfter *models.TransferOrder
+		var inbound *models.Task
+		model, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.Task, error) {
+			updated, err := transitionTaskStatus(ctx, s.queries.WithTx(tx), task.OrgID, task.ID, task.Status, comment)
+			if err != nil {
+				return nil, err
+			}
+			if updated.Status == models.TaskStatusCompleted {
+				orderBefore, orderAfter, inbound, err = s.advanceTransferOrder(ctx, tx, updated)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return updated, nil
 		})
 		if err != nil {
-			return nil, services.MapDbErrorToService(err)
+			return nil, err
 		}
 
-		model := toTask(updated)
+		if err := s.auditShippedInstances(ctx, task.OrgID, shipped); err != nil {
+			return nil, err
+		}
+		if err := s.auditShippedStockLines(ctx, task.OrgID, shippedLines); err != nil {
+			return nil, err
+		}
+		if err := s.auditTransferOrder(ctx, orderBefore, orderAfter); err != nil {
+			return nil, err
+		}
+		if err := s.auditTransferLegs(ctx, []*models.Task{inbound}); err != nil {
+			return nil, err
+		}
 
 		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
 			Action:           models.ObjectChangeActionUpdate,
@@ -320,6 +1094,144 @@ func (s *TaskService) UpdateTask(ctx context.Context, task *models.Task) (*model
 		if err != nil {
 			return nil, err
 		}
-		return toTask(updated), nil
+		return model, nil
+	})
+}
+
+// transitionTaskStatus locks the task and moves it to status inside the
+// caller's transaction, recording the change in the status history
+func transitionTaskStatus(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, taskID uuid.UUID, status models.TaskStatus, comment *string) (*models.Task, error) {
+	current, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(taskID),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+
+	from := models.TaskStatus(current.Status)
+	if !isTaskOpen(from) {
+		return nil, ErrTaskClosed
+	}
+	if err := validateTransition(from, status); err != nil {
+		return nil, err
+	}
+
+	completedAt := current.CompletedAt
+	if status == models.TaskStatusCompleted {
+		now := time.Now()
+		completedAt = database.PgTimestampPtr(&now)
+	}
+
+	if status == models.TaskStatusCompleted {
+		if err := settleTaskInstances(ctx, qtx, orgID, current); err != nil {
+			return nil, err
+		}
+		if err := settleTaskStockLines(ctx, qtx, orgID, current); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := updateTaskStatus(ctx, qtx, from, comment, sqlc.UpdateTaskParams{
+		OrgID:       database.PgUUID(orgID),
+		ID:          database.PgUUID(taskID),
+		Status:      sqlc.TaskStatus(status),
+		CompletedAt: completedAt,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toTask(updated), nil
+}
+
+// settleTaskInstances releases the instances held by a task being completed.
+// Instances picked by a pickment task leave the warehouse as shipped, or as
+// in transit for the outbound leg of a transfer order. Other tasks must not
+// hold reserved instances at all, so a movement ends with every instance
+// available in its destination cell.
+func settleTaskInstances(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, task sqlc.Task) error {
+	if models.TaskType(task.Type) == models.TaskTypePickmentItem {
+		var err error
+		if task.TransferOrderID.Valid {
+			err = qtx.DispatchTaskInstances(ctx, sqlc.DispatchTaskInstancesParams{
+				OrgID:  database.PgUUID(orgID),
+				TaskID: task.ID,
+			})
+		} else {
+			err = qtx.ShipTaskInstances(ctx, sqlc.ShipTaskInstancesParams{
+				OrgID:  database.PgUUID(orgID),
+				TaskID: task.ID,
+			})
+		}
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+
+		err = qtx.SetPickedTaskItemsDone(ctx, sqlc.SetPickedTaskItemsDoneParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: task.ID,
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+		return nil
+	}
+
+	reserved, err := qtx.GetTaskReservedInstanceIds(ctx, sqlc.GetTaskReservedInstanceIdsParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: task.ID,
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	if len(reserved) > 0 {
+		return ErrTaskItemsNotProcessed
+	}
+	return nil
+}
+
+// getTaskReservedInstances returns the instances still reserved by the task,
+// captured before completion to record the instances it ships
+func (s *TaskService) getTaskReservedInstances(ctx context.Context, orgID uuid.UUID, taskID uuid.UUID) (map[uuid.UUID]*models.ItemInstance, error) {
+	ids, err := s.queries.GetTaskReservedInstanceIds(ctx, sqlc.GetTaskReservedInstanceIdsParams{
+		OrgID:  database.PgUUID(orgID),
+		TaskID: database.PgUUID(taskID),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.item.GetItemInstancesFull(ctx, orgID, database.UUIDsFromPgx(ids))
+}
+
+func (s *TaskService) auditShippedInstances(ctx context.Context, orgID uuid.UUID, before map[uuid.UUID]*models.ItemInstance) error {
+	if len(before) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(before))
+	for id := range before {
+		ids = append(ids, id)
+	}
+	after, err := s.item.GetItemInstancesFull(ctx, orgID, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeItemInstance,
+			TargetObjectID:   id,
+			PrechangeState:   before[id],
+			PostchangeState:  after[id],
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
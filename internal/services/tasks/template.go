@@ -0,0 +1,460 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	maxTemplateRules = 50
+	// Upper bound of instances taken by a single rule
+	maxTemplateRuleInstances = 500
+)
+
+var ErrTaskTemplateNoInstances = fmt.Errorf("%w: no available instances match the task template rules", common.ErrConflict)
+
+// validateTaskTemplate checks the template and computes its next run from the
+// schedule
+func (s *TaskService) validateTaskTemplate(ctx context.Context, orgID uuid.UUID, template *models.TaskTemplate, now time.Time) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return common.ErrDetailedValidationErrorWithMessage("name is required")
+	}
+	if template.Type != models.TaskTypePickmentItem && template.Type != models.TaskTypeMovement {
+		return common.ErrDetailedValidationErrorWithMessage("task templates support only pickment and movement tasks")
+	}
+	if err := validateTaskPriority(&template.Priority); err != nil {
+		return err
+	}
+	if template.DueInMinutes != nil && *template.DueInMinutes <= 0 {
+		return common.ErrDetailedValidationErrorWithMessage("due in minutes must be positive")
+	}
+
+	template.NextRunAt = nil
+	if template.Schedule != nil {
+		schedule, err := parseCronSchedule(*template.Schedule)
+		if err != nil {
+			return common.ErrDetailedValidationErrorWithMessage(fmt.Sprintf("invalid schedule: %s", err))
+		}
+		next := schedule.next(now)
+		if next.IsZero() {
+			return common.ErrDetailedValidationErrorWithMessage("schedule never matches")
+		}
+		template.NextRunAt = &next
+	}
+
+	if _, err := s.org.GetUnitByID(ctx, orgID, template.UnitID); err != nil {
+		return err
+	}
+	if template.AssignedToUserID != nil {
+		if _, err := s.employee.GetEmployee(ctx, orgID, *template.AssignedToUserID); err != nil {
+			return err
+		}
+	}
+
+	if len(template.Rules) == 0 {
+		return common.ErrDetailedValidationErrorWithMessage("at least one rule is required")
+	}
+	if len(template.Rules) > maxTemplateRules {
+		return common.ErrDetailedValidationErrorWithMessage("too many rules in a task template (max 50)")
+	}
+	for _, rule := range template.Rules {
+		if err := s.validateTaskTemplateRule(ctx, orgID, template, rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *TaskService) validateTaskTemplateRule(ctx context.Context, orgID uuid.UUID, template *models.TaskTemplate, rule *models.TaskTemplateRule) error {
+	if rule.ItemID == nil && rule.VariantID == nil && rule.SourceCellsGroupID == nil {
+		return common.ErrDetailedValidationErrorWithMessage("rule must select an item, a variant or a source cells group")
+	}
+	if rule.MaxInstances != nil && (*rule.MaxInstances <= 0 || *rule.MaxInstances > maxTemplateRuleInstances) {
+		return common.ErrDetailedValidationErrorWithMessage("rule max instances must be between 1 and 500")
+	}
+	if template.Type == models.TaskTypeMovement && rule.TargetCellID == nil {
+		return common.ErrDetailedValidationErrorWithMessage("movement rules require a target cell")
+	}
+
+	if rule.ItemID != nil {
+		if _, err := s.item.GetItemByID(ctx, orgID, *rule.ItemID); err != nil {
+			return err
+		}
+	}
+	if rule.VariantID != nil {
+		variants, err := s.item.GetItemVariantsByIDs(ctx, orgID, []uuid.UUID{*rule.VariantID})
+		if err != nil {
+			return err
+		}
+		variant, ok := variants[*rule.VariantID]
+		if !ok {
+			return fmt.Errorf("variant not found: %w", common.ErrNotFound)
+		}
+		if rule.ItemID != nil && variant.ItemID != *rule.ItemID {
+			return common.ErrDetailedValidationErrorWithMessage("rule variant does not belong to the rule item")
+		}
+	}
+	if rule.SourceCellsGroupID != nil {
+		cellsGroup, err := s.storageService.GetCellsGroup(ctx, orgID, *rule.SourceCellsGroupID)
+		if err != nil {
+			return err
+		}
+		if cellsGroup.UnitID != template.UnitID {
+			return common.ErrDetailedValidationErrorWithMessage("rule source cells group must belong to the template unit")
+		}
+	}
+	if rule.TargetCellID != nil {
+		cell, err := s.storageService.GetCellFull(ctx, orgID, *rule.TargetCellID)
+		if err != nil {
+			return err
+		}
+		if !cellInUnit(cell, template.UnitID) {
+			return common.ErrDetailedValidationErrorWithMessage("rule target cell must belong to the template unit")
+		}
+	}
+	return nil
+}
+
+func createTaskTemplateRules(ctx context.Context, qtx *sqlc.Queries, orgID uuid.UUID, templateID uuid.UUID, rules []*models.TaskTemplateRule) error {
+	for i, rule := range rules {
+		_, err := qtx.CreateTaskTemplateRule(ctx, sqlc.CreateTaskTemplateRuleParams{
+			OrgID:              database.PgUUID(orgID),
+			TemplateID:         database.PgUUID(templateID),
+			Position:           int32(i),
+			ItemID:             database.PgUUIDPtr(rule.ItemID),
+			VariantID:          database.PgUUIDPtr(rule.VariantID),
+			SourceCellsGroupID: database.PgUUIDPtr(rule.SourceCellsGroupID),
+			TargetCellID:       database.PgUUIDPtr(rule.TargetCellID),
+			MaxInstances:       database.PgInt4Ptr(rule.MaxInstances),
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+	}
+	return nil
+}
+
+func (s *TaskService) CreateTaskTemplate(ctx context.Context, orgID uuid.UUID, template *models.TaskTemplate) (*models.TaskTemplate, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CreateTaskTemplate", func(ctx context.Context, span trace.Span) (*models.TaskTemplate, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("unit.id", template.UnitID.String()),
+			attribute.String("task.type", string(template.Type)),
+		)
+
+		if err := s.validateTaskTemplate(ctx, orgID, template, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+
+		created, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.TaskTemplate, error) {
+			qtx := s.queries.WithTx(tx)
+
+			row, err := qtx.CreateTaskTemplate(ctx, sqlc.CreateTaskTemplateParams{
+				OrgID:            database.PgUUID(orgID),
+				UnitID:           database.PgUUID(template.UnitID),
+				Type:             sqlc.TaskType(template.Type),
+				Priority:         sqlc.TaskPriority(template.Priority),
+				Name:             template.Name,
+				Description:      database.PgTextPtr(template.Description),
+				AssignedToUserID: database.PgUUIDPtr(template.AssignedToUserID),
+				DueInMinutes:     database.PgInt4Ptr(template.DueInMinutes),
+				Schedule:         database.PgTextPtr(template.Schedule),
+				IsActive:         template.IsActive,
+				NextRunAt:        database.PgTimestampPtr(template.NextRunAt),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+
+			res := toTaskTemplate(row)
+			if err := createTaskTemplateRules(ctx, qtx, orgID, res.ID, template.Rules); err != nil {
+				return nil, err
+			}
+			res.Rules = template.Rules
+			return res, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionCreate,
+			TargetObjectType: models.ObjectTypeTaskTemplate,
+			TargetObjectID:   created.ID,
+			PostchangeState:  created,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return created, nil
+	})
+}
+
+// UpdateTaskTemplate replaces the template and its rules. The next run is
+// computed again from the schedule.
+func (s *TaskService) UpdateTaskTemplate(ctx context.Context, orgID uuid.UUID, template *models.TaskTemplate) (*models.TaskTemplate, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "UpdateTaskTemplate", func(ctx context.Context, span trace.Span) (*models.TaskTemplate, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("template.id", template.ID.String()),
+		)
+
+		before, err := s.GetTaskTemplateById(ctx, orgID, template.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.validateTaskTemplate(ctx, orgID, template, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+
+		updated, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.TaskTemplate, error) {
+			qtx := s.queries.WithTx(tx)
+
+			row, err := qtx.UpdateTaskTemplate(ctx, sqlc.UpdateTaskTemplateParams{
+				OrgID:            database.PgUUID(orgID),
+				ID:               database.PgUUID(template.ID),
+				UnitID:           database.PgUUID(template.UnitID),
+				Type:             sqlc.TaskType(template.Type),
+				Priority:         sqlc.TaskPriority(template.Priority),
+				Name:             template.Name,
+				Description:      database.PgTextPtr(template.Description),
+				AssignedToUserID: database.PgUUIDPtr(template.AssignedToUserID),
+				DueInMinutes:     database.PgInt4Ptr(template.DueInMinutes),
+				Schedule:         database.PgTextPtr(template.Schedule),
+				IsActive:         template.IsActive,
+				NextRunAt:        database.PgTimestampPtr(template.NextRunAt),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+
+			err = qtx.DeleteTaskTemplateRules(ctx, sqlc.DeleteTaskTemplateRulesParams{
+				OrgID:      database.PgUUID(orgID),
+				TemplateID: database.PgUUID(template.ID),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+
+			res := toTaskTemplate(row)
+			if err := createTaskTemplateRules(ctx, qtx, orgID, res.ID, template.Rules); err != nil {
+				return nil, err
+			}
+			res.Rules = template.Rules
+			return res, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeTaskTemplate,
+			TargetObjectID:   updated.ID,
+			PrechangeState:   before,
+			PostchangeState:  updated,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return updated, nil
+	})
+}
+
+func (s *TaskService) GetTaskTemplates(ctx context.Context, orgID uuid.UUID) ([]*models.TaskTemplate, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTaskTemplates", func(ctx context.Context, span trace.Span) ([]*models.TaskTemplate, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		rows, err := s.queries.GetTaskTemplates(ctx, database.PgUUID(orgID))
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		templates := make([]*models.TaskTemplate, len(rows))
+		for i, row := range rows {
+			templates[i] = toTaskTemplate(row)
+		}
+		if err := s.loadTaskTemplateRules(ctx, s.queries, orgID, templates); err != nil {
+			return nil, fmt.Errorf("failed to load task template rules: %w", err)
+		}
+		return templates, nil
+	})
+}
+
+func (s *TaskService) GetTaskTemplateById(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (*models.TaskTemplate, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTaskTemplateById", func(ctx context.Context, span trace.Span) (*models.TaskTemplate, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("template.id", id.String()),
+		)
+
+		return s.getTaskTemplate(ctx, s.queries, orgID, id)
+	})
+}
+
+func (s *TaskService) getTaskTemplate(ctx context.Context, q *sqlc.Queries, orgID uuid.UUID, id uuid.UUID) (*models.TaskTemplate, error) {
+	row, err := q.GetTaskTemplateById(ctx, sqlc.GetTaskTemplateByIdParams{
+		OrgID: database.PgUUID(orgID),
+		ID:    database.PgUUID(id),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+
+	template := toTaskTemplate(row)
+	if err := s.loadTaskTemplateRules(ctx, q, orgID, []*models.TaskTemplate{template}); err != nil {
+		return nil, fmt.Errorf("failed to load task template rules: %w", err)
+	}
+	return template, nil
+}
+
+func (s *TaskService) loadTaskTemplateRules(ctx context.Context, q *sqlc.Queries, orgID uuid.UUID, templates []*models.TaskTemplate) error {
+	if len(templates) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(templates))
+	byID := make(map[uuid.UUID]*models.TaskTemplate, len(templates))
+	for i, template := range templates {
+		ids[i] = template.ID
+		byID[template.ID] = template
+		template.Rules = []*models.TaskTemplateRule{}
+	}
+
+	rules, err := q.GetTaskTemplateRules(ctx, sqlc.GetTaskTemplateRulesParams{
+		OrgID:       database.PgUUID(orgID),
+		TemplateIds: database.PgUUIDs(ids),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	for _, rule := range rules {
+		template := byID[database.UUIDFromPgx(rule.TemplateID)]
+		template.Rules = append(template.Rules, toTaskTemplateRule(rule))
+	}
+	return nil
+}
+
+func (s *TaskService) DeleteTaskTemplate(ctx context.Context, orgID uuid.UUID, id uuid.UUID) error {
+	return telemetry.WithVoidTrace(ctx, s.tracer, "DeleteTaskTemplate", func(ctx context.Context, span trace.Span) error {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("template.id", id.String()),
+		)
+
+		before, err := s.GetTaskTemplateById(ctx, orgID, id)
+		if err != nil {
+			return err
+		}
+
+		err = s.queries.DeleteTaskTemplate(ctx, sqlc.DeleteTaskTemplateParams{
+			OrgID: database.PgUUID(orgID),
+			ID:    database.PgUUID(id),
+		})
+		if err != nil {
+			return services.MapDbErrorToService(err)
+		}
+
+		return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionDelete,
+			TargetObjectType: models.ObjectTypeTaskTemplate,
+			TargetObjectID:   id,
+			PrechangeState:   before,
+		})
+	})
+}
+
+// RunTaskTemplate creates a task from the template right away. The schedule
+// of the template is not affected.
+func (s *TaskService) RunTaskTemplate(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "RunTaskTemplate", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("template.id", id.String()),
+		)
+
+		template, err := s.GetTaskTemplateById(ctx, orgID, id)
+		if err != nil {
+			return nil, err
+		}
+		return s.instantiateTaskTemplate(ctx, template, time.Now().UTC())
+	})
+}
+
+// instantiateTaskTemplate selects instances with the template rules and
+// creates the task through CreateTask. An instance matched by several rules
+// is taken by the first one.
+func (s *TaskService) instantiateTaskTemplate(ctx context.Context, template *models.TaskTemplate, now time.Time) (*models.Task, error) {
+	task := &models.Task{
+		OrgID:            template.OrgID,
+		UnitID:           template.UnitID,
+		Type:             template.Type,
+		Priority:         template.Priority,
+		Name:             template.Name,
+		Description:      template.Description,
+		AssignedToUserID: template.AssignedToUserID,
+		TemplateID:       &template.ID,
+	}
+	if template.DueInMinutes != nil {
+		dueAt := now.Add(time.Duration(*template.DueInMinutes) * time.Minute)
+		task.DueAt = &dueAt
+	}
+
+	taken := make(map[uuid.UUID]bool)
+	for _, rule := range template.Rules {
+		limit := int32(maxTemplateRuleInstances)
+		if rule.MaxInstances != nil {
+			limit = *rule.MaxInstances
+		}
+
+		instances, err := s.queries.GetTemplateRuleInstances(ctx, sqlc.GetTemplateRuleInstancesParams{
+			OrgID:              database.PgUUID(template.OrgID),
+			UnitID:             database.PgUUID(template.UnitID),
+			ItemID:             database.PgUUIDPtr(rule.ItemID),
+			VariantID:          database.PgUUIDPtr(rule.VariantID),
+			SourceCellsGroupID: database.PgUUIDPtr(rule.SourceCellsGroupID),
+			TargetCellID:       database.PgUUIDPtr(rule.TargetCellID),
+			// Instances taken by previous rules are skipped afterwards
+			MaxRows: limit + int32(len(taken)),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		var count int32
+		for _, instance := range instances {
+			instanceID := database.UUIDFromPgx(instance.ID)
+			if count == limit || taken[instanceID] {
+				continue
+			}
+			taken[instanceID] = true
+			count++
+			task.Items = append(task.Items, &models.TaskItem{
+				InstanceID:   &instanceID,
+				TargetCellID: rule.TargetCellID,
+			})
+		}
+	}
+
+	if len(task.Items) == 0 {
+		return nil, ErrTaskTemplateNoInstances
+	}
+
+	return s.CreateTask(ctx, template.OrgID, task)
+}
@@ -0,0 +1,501 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const maxTransferInstances = 1000
+
+var (
+	ErrTransferOrderNotPending       = fmt.Errorf("%w: only pending transfer orders can be cancelled", common.ErrConflict)
+	ErrTransferInboundNotCancellable = fmt.Errorf("%w: inbound leg of a transfer order cannot be cancelled", common.ErrConflict)
+	ErrTransferInstancesNotInTransit = fmt.Errorf("%w: not enough instances of the variant are in transit", common.ErrConflict)
+)
+
+// CreateTransferOrder creates the order together with its outbound leg, a
+// pickment task of the source unit holding the instances. The inbound leg is
+// created once the outbound one is completed.
+func (s *TaskService) CreateTransferOrder(ctx context.Context, orgID uuid.UUID, create *models.TransferOrderCreate) (*models.TransferOrder, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CreateTransferOrder", func(ctx context.Context, span trace.Span) (*models.TransferOrder, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("source_unit.id", create.SourceUnitID.String()),
+			attribute.String("destination_unit.id", create.DestinationUnitID.String()),
+			attribute.Int("transfer.instances", len(create.InstanceIDs)),
+		)
+
+		create.Name = strings.TrimSpace(create.Name)
+		if create.Name == "" {
+			return nil, common.ErrDetailedValidationErrorWithMessage("name is required")
+		}
+		if len(create.InstanceIDs) == 0 {
+			return nil, common.ErrDetailedValidationErrorWithMessage("instances are required")
+		}
+		if len(create.InstanceIDs) > maxTransferInstances {
+			return nil, common.ErrDetailedValidationErrorWithMessage("too many instances in a transfer order (max 1000)")
+		}
+		if create.SourceUnitID == create.DestinationUnitID {
+			return nil, common.ErrDetailedValidationErrorWithMessage("source and destination units must differ")
+		}
+
+		units, err := s.org.GetUnitsByIDs(ctx, orgID, []uuid.UUID{create.SourceUnitID, create.DestinationUnitID})
+		if err != nil {
+			return nil, err
+		}
+		if units[create.SourceUnitID] == nil || units[create.DestinationUnitID] == nil {
+			return nil, fmt.Errorf("failed to get unit: %w", common.ErrNotFound)
+		}
+
+		cell, err := s.storageService.GetCellFull(ctx, orgID, create.ReceivingCellID)
+		if err != nil {
+			return nil, err
+		}
+		if !cellInUnit(cell, create.DestinationUnitID) {
+			return nil, common.ErrDetailedValidationErrorWithMessage("receiving cell must belong to the destination unit")
+		}
+		if err := services.EnsureCellsNotFrozen(ctx, s.queries, orgID, &create.ReceivingCellID); err != nil {
+			return nil, err
+		}
+
+		items := make([]*models.TaskItem, 0, len(create.InstanceIDs))
+		seen := make(map[uuid.UUID]bool, len(create.InstanceIDs))
+		for _, id := range create.InstanceIDs {
+			if seen[id] {
+				return nil, common.ErrDetailedValidationErrorWithMessage("transfer order instances must be distinct")
+			}
+			seen[id] = true
+			items = append(items, &models.TaskItem{InstanceID: &id})
+		}
+
+		// Legs are created inside the transaction and audited after commit
+		var legs []*models.Task
+		order, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.TransferOrder, error) {
+			created, err := s.queries.WithTx(tx).CreateTransferOrder(ctx, sqlc.CreateTransferOrderParams{
+				OrgID:             database.PgUUID(orgID),
+				SourceUnitID:      database.PgUUID(create.SourceUnitID),
+				DestinationUnitID: database.PgUUID(create.DestinationUnitID),
+				ReceivingCellID:   database.PgUUID(create.ReceivingCellID),
+				Name:              create.Name,
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+			order := toTransferOrder(created)
+
+			outbound, err := s.CreateTask(database.ContextWithTx(ctx, tx), orgID, &models.Task{
+				OrgID:           orgID,
+				UnitID:          create.SourceUnitID,
+				Type:            models.TaskTypePickmentItem,
+				Name:            create.Name,
+				TransferOrderID: &order.ID,
+				Items:           items,
+			})
+			if err != nil {
+				return nil, err
+			}
+			order.OutboundTaskID = &outbound.ID
+			legs = append(legs, outbound)
+
+			return order, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.auditTransferOrder(ctx, nil, order); err != nil {
+			return nil, err
+		}
+		if err := s.auditTransferLegs(ctx, legs); err != nil {
+			return nil, err
+		}
+
+		return s.GetTransferOrderById(ctx, orgID, order.ID)
+	})
+}
+
+// GetTransferOrders returns transfer orders of the organization, newest
+// first, optionally only those in status
+func (s *TaskService) GetTransferOrders(ctx context.Context, orgID uuid.UUID, status *models.TransferOrderStatus) ([]*models.TransferOrder, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTransferOrders", func(ctx context.Context, span trace.Span) ([]*models.TransferOrder, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		filter := sqlc.NullTransferOrderStatus{}
+		if status != nil {
+			filter = sqlc.NullTransferOrderStatus{TransferOrderStatus: sqlc.TransferOrderStatus(*status), Valid: true}
+		}
+
+		rows, err := s.queries.GetTransferOrders(ctx, sqlc.GetTransferOrdersParams{
+			OrgID:  database.PgUUID(orgID),
+			Status: filter,
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		orders := make([]*models.TransferOrder, len(rows))
+		for i, row := range rows {
+			orders[i] = toTransferOrder(row)
+		}
+		if err := s.loadTransferOrdersLegs(ctx, orgID, orders); err != nil {
+			return nil, err
+		}
+
+		span.SetAttributes(attribute.Int("response.count", len(orders)))
+		return orders, nil
+	})
+}
+
+// GetTransferOrderById returns the transfer order with the instances of its
+// outbound leg
+func (s *TaskService) GetTransferOrderById(ctx context.Context, orgID uuid.UUID, orderID uuid.UUID) (*models.TransferOrder, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetTransferOrderById", func(ctx context.Context, span trace.Span) (*models.TransferOrder, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("transfer_order.id", orderID.String()),
+		)
+
+		row, err := s.queries.GetTransferOrderById(ctx, sqlc.GetTransferOrderByIdParams{
+			OrgID: database.PgUUID(orgID),
+			ID:    database.PgUUID(orderID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		order := toTransferOrder(row)
+		if err := s.loadTransferOrdersLegs(ctx, orgID, []*models.TransferOrder{order}); err != nil {
+			return nil, err
+		}
+		if order.OutboundTaskID == nil {
+			return order, nil
+		}
+
+		items, err := s.queries.GetTaskItems(ctx, sqlc.GetTaskItemsParams{
+			OrgID:  database.PgUUID(orgID),
+			TaskID: database.PgUUID(*order.OutboundTaskID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		instanceIDs := make([]uuid.UUID, 0, len(items))
+		for _, item := range items {
+			switch models.TaskItemStatus(item.Status) {
+			case models.TaskItemStatusCanceled, models.TaskItemStatusShort, models.TaskItemStatusReturned:
+				continue
+			}
+			instanceIDs = append(instanceIDs, database.UUIDFromPgx(item.ItemInstanceID))
+		}
+
+		instances, err := s.item.GetItemInstancesFull(ctx, orgID, instanceIDs)
+		if err != nil {
+			return nil, err
+		}
+		order.Instances = make([]*models.ItemInstance, 0, len(instanceIDs))
+		for _, id := range instanceIDs {
+			if instance, ok := instances[id]; ok {
+				order.Instances = append(order.Instances, instance)
+			}
+		}
+
+		return order, nil
+	})
+}
+
+func (s *TaskService) loadTransferOrdersLegs(ctx context.Context, orgID uuid.UUID, orders []*models.TransferOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*models.TransferOrder, len(orders))
+	ids := make([]uuid.UUID, len(orders))
+	for i, order := range orders {
+		byID[order.ID] = order
+		ids[i] = order.ID
+	}
+
+	legs, err := s.queries.GetTransferOrdersTasks(ctx, sqlc.GetTransferOrdersTasksParams{
+		OrgID:            database.PgUUID(orgID),
+		TransferOrderIds: database.PgUUIDs(ids),
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+
+	for _, leg := range legs {
+		order := byID[database.UUIDFromPgx(leg.TransferOrderID)]
+		if order == nil {
+			continue
+		}
+		legID := database.UUIDFromPgx(leg.ID)
+		switch models.TaskType(leg.Type) {
+		case models.TaskTypePickmentItem:
+			order.OutboundTaskID = &legID
+		case models.TaskTypeReceiving:
+			order.InboundTaskID = &legID
+		}
+	}
+	return nil
+}
+
+// CancelTransferOrder cancels a pending transfer order together with its
+// outbound leg. Once dispatched the order is finished by its inbound leg.
+func (s *TaskService) CancelTransferOrder(ctx context.Context, orgID uuid.UUID, orderID uuid.UUID, comment *string) (*models.TransferOrder, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CancelTransferOrder", func(ctx context.Context, span trace.Span) (*models.TransferOrder, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("transfer_order.id", orderID.String()),
+		)
+
+		order, err := s.GetTransferOrderById(ctx, orgID, orderID)
+		if err != nil {
+			return nil, err
+		}
+		if order.Status != models.TransferOrderStatusPending || order.OutboundTaskID == nil {
+			return nil, ErrTransferOrderNotPending
+		}
+
+		// The order itself is cancelled together with its outbound leg
+		if _, err := s.CancelTask(ctx, orgID, *order.OutboundTaskID, comment); err != nil {
+			return nil, err
+		}
+
+		return s.GetTransferOrderById(ctx, orgID, orderID)
+	})
+}
+
+// advanceTransferOrder moves the transfer order of a leg being completed
+// inside the caller's transaction. Completing the outbound leg dispatches the
+// order and creates the inbound leg expecting the instances in transit, an
+// outbound leg which picked nothing cancels the order. Completing the inbound
+// leg receives the order. Returns the order before and after the change,
+// both nil for tasks outside transfer orders, and the created inbound leg.
+// The caller audits them after its transaction is committed.
+func (s *TaskService) advanceTransferOrder(ctx context.Context, tx pgx.Tx, task *models.Task) (*models.TransferOrder, *models.TransferOrder, *models.Task, error) {
+	if task.TransferOrderID == nil {
+		return nil, nil, nil, nil
+	}
+	qtx := s.queries.WithTx(tx)
+
+	current, err := qtx.GetTransferOrderByIdForUpdate(ctx, sqlc.GetTransferOrderByIdForUpdateParams{
+		OrgID: database.PgUUID(task.OrgID),
+		ID:    database.PgUUID(*task.TransferOrderID),
+	})
+	if err != nil {
+		return nil, nil, nil, services.MapDbErrorToService(err)
+	}
+	before := toTransferOrder(current)
+
+	var inbound *models.Task
+	now := time.Now()
+	params := sqlc.SetTransferOrderStatusParams{
+		OrgID:        current.OrgID,
+		ID:           current.ID,
+		DispatchedAt: current.DispatchedAt,
+		ReceivedAt:   current.ReceivedAt,
+	}
+
+	switch task.Type {
+	case models.TaskTypePickmentItem:
+		counts, err := qtx.GetTaskInTransitVariantCounts(ctx, sqlc.GetTaskInTransitVariantCountsParams{
+			OrgID:  current.OrgID,
+			TaskID: database.PgUUID(task.ID),
+		})
+		if err != nil {
+			return nil, nil, nil, services.MapDbErrorToService(err)
+		}
+		if len(counts) == 0 {
+			params.Status = sqlc.TransferOrderStatus(models.TransferOrderStatusCancelled)
+			break
+		}
+
+		expected := make([]*models.TaskExpectedItem, len(counts))
+		for i, count := range counts {
+			expected[i] = &models.TaskExpectedItem{
+				VariantID:        database.UUIDFromPgx(count.VariantID),
+				ExpectedQuantity: int(count.Quantity),
+			}
+		}
+		inbound, err = s.CreateTask(database.ContextWithTx(ctx, tx), task.OrgID, &models.Task{
+			OrgID:           task.OrgID,
+			UnitID:          before.DestinationUnitID,
+			Type:            models.TaskTypeReceiving,
+			Name:            before.Name,
+			Priority:        task.Priority,
+			ReceivingCellID: &before.ReceivingCellID,
+			ExpectedItems:   expected,
+			TransferOrderID: &before.ID,
+		})
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to create inbound leg: %w", err)
+		}
+		params.Status = sqlc.TransferOrderStatus(models.TransferOrderStatusInTransit)
+		params.DispatchedAt = database.PgTimestampPtr(&now)
+	case models.TaskTypeReceiving:
+		params.Status = sqlc.TransferOrderStatus(models.TransferOrderStatusReceived)
+		params.ReceivedAt = database.PgTimestampPtr(&now)
+	default:
+		return nil, nil, nil, nil
+	}
+
+	updated, err := qtx.SetTransferOrderStatus(ctx, params)
+	if err != nil {
+		return nil, nil, nil, services.MapDbErrorToService(err)
+	}
+	return before, toTransferOrder(updated), inbound, nil
+}
+
+// cancelTransferOrderLeg cancels the transfer order of an outbound leg being
+// cancelled inside the caller's transaction. Inbound legs cannot be
+// cancelled, the instances are already in transit.
+func cancelTransferOrderLeg(ctx context.Context, qtx *sqlc.Queries, task sqlc.Task) (*models.TransferOrder, *models.TransferOrder, error) {
+	if !task.TransferOrderID.Valid {
+		return nil, nil, nil
+	}
+	if models.TaskType(task.Type) != models.TaskTypePickmentItem {
+		return nil, nil, ErrTransferInboundNotCancellable
+	}
+
+	current, err := qtx.GetTransferOrderByIdForUpdate(ctx, sqlc.GetTransferOrderByIdForUpdateParams{
+		OrgID: task.OrgID,
+		ID:    task.TransferOrderID,
+	})
+	if err != nil {
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+
+	updated, err := qtx.SetTransferOrderStatus(ctx, sqlc.SetTransferOrderStatusParams{
+		OrgID:        current.OrgID,
+		ID:           current.ID,
+		Status:       sqlc.TransferOrderStatus(models.TransferOrderStatusCancelled),
+		DispatchedAt: current.DispatchedAt,
+		ReceivedAt:   current.ReceivedAt,
+	})
+	if err != nil {
+		return nil, nil, services.MapDbErrorToService(err)
+	}
+	return toTransferOrder(current), toTransferOrder(updated), nil
+}
+
+// lockTransferInstances locks quantity instances of the variant dispatched by
+// the outbound leg of the inbound task's transfer order
+func lockTransferInstances(ctx context.Context, qtx *sqlc.Queries, task sqlc.Task, variantID uuid.UUID, quantity int) ([]sqlc.ItemInstance, error) {
+	outboundID, err := getTransferOutboundTaskID(ctx, qtx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	instances, err := qtx.GetTaskInTransitInstancesForUpdate(ctx, sqlc.GetTaskInTransitInstancesForUpdateParams{
+		OrgID:     task.OrgID,
+		TaskID:    outboundID,
+		VariantID: database.PgUUID(variantID),
+		MaxRows:   int32(quantity),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	if len(instances) < quantity {
+		return nil, ErrTransferInstancesNotInTransit
+	}
+	return instances, nil
+}
+
+func getTransferOutboundTaskID(ctx context.Context, qtx *sqlc.Queries, task sqlc.Task) (pgtype.UUID, error) {
+	legs, err := qtx.GetTransferOrdersTasks(ctx, sqlc.GetTransferOrdersTasksParams{
+		OrgID:            task.OrgID,
+		TransferOrderIds: []pgtype.UUID{task.TransferOrderID},
+	})
+	if err != nil {
+		return pgtype.UUID{}, services.MapDbErrorToService(err)
+	}
+	for _, leg := range legs {
+		if models.TaskType(leg.Type) == models.TaskTypePickmentItem {
+			return leg.ID, nil
+		}
+	}
+	return pgtype.UUID{}, fmt.Errorf("failed to get outbound leg: %w", common.ErrNotFound)
+}
+
+// getTransferInstancesInTransit returns the instances of the variant still in
+// transit to the inbound task, captured before receiving to record the
+// instances it moves
+func (s *TaskService) getTransferInstancesInTransit(ctx context.Context, orgID uuid.UUID, task *models.Task, variantID uuid.UUID) (map[uuid.UUID]*models.ItemInstance, error) {
+	if task.TransferOrderID == nil || task.Type != models.TaskTypeReceiving {
+		return nil, nil
+	}
+
+	outboundID, err := getTransferOutboundTaskID(ctx, s.queries, sqlc.Task{
+		OrgID:           database.PgUUID(orgID),
+		TransferOrderID: database.PgUUID(*task.TransferOrderID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.queries.GetTaskInTransitInstanceIds(ctx, sqlc.GetTaskInTransitInstanceIdsParams{
+		OrgID:     database.PgUUID(orgID),
+		TaskID:    outboundID,
+		VariantID: database.PgUUID(variantID),
+	})
+	if err != nil {
+		return nil, services.MapDbErrorToService(err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.item.GetItemInstancesFull(ctx, orgID, database.UUIDsFromPgx(ids))
+}
+
+// auditTransferLegs records the creation of transfer order legs, called after
+// the transaction creating them is committed
+func (s *TaskService) auditTransferLegs(ctx context.Context, legs []*models.Task) error {
+	for _, leg := range legs {
+		if leg == nil {
+			continue
+		}
+		err := s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionCreate,
+			TargetObjectType: models.ObjectTypeTask,
+			TargetObjectID:   leg.ID,
+			PostchangeState:  leg,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *TaskService) auditTransferOrder(ctx context.Context, before *models.TransferOrder, after *models.TransferOrder) error {
+	if after == nil {
+		return nil
+	}
+	action := models.ObjectChangeActionUpdate
+	if before == nil {
+		action = models.ObjectChangeActionCreate
+	}
+	return s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+		Action:           action,
+		TargetObjectType: models.ObjectTypeTransferOrder,
+		TargetObjectID:   after.ID,
+		PrechangeState:   before,
+		PostchangeState:  after,
+	})
+}
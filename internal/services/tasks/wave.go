@@ -0,0 +1,845 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/let-store-it/backend/generated/sqlc"
+	"github.com/let-store-it/backend/internal/common"
+	"github.com/let-store-it/backend/internal/database"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services"
+	"github.com/let-store-it/backend/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultWaveMaxTasks = 20
+	maxWaveTasks        = 100
+)
+
+var (
+	ErrPickWaveClosed         = fmt.Errorf("%w: pick wave is already completed or cancelled", common.ErrConflict)
+	ErrPickWaveNoTasks        = fmt.Errorf("%w: no pickment tasks to put into the pick wave", common.ErrConflict)
+	ErrTaskNotWaveable        = fmt.Errorf("%w: only pending pickment tasks without target cells can be put into a pick wave", common.ErrConflict)
+	ErrTaskInPickWave         = fmt.Errorf("%w: task is picked in a pick wave", common.ErrConflict)
+	ErrInstanceNotInPickWave  = fmt.Errorf("%w: instance does not belong to the pick wave", common.ErrConflict)
+	ErrPickWaveItemsNotSorted = fmt.Errorf("%w: not all pick wave items are sorted", common.ErrConflict)
+)
+
+// pickWaveStatusTransitions lists statuses reachable from each wave status.
+// A wave is picked first and then sorted at the consolidation cell, picking
+// and sorting may overlap. A pending wave goes straight to sorting when its
+// items were picked through the tasks themselves.
+var pickWaveStatusTransitions = map[models.PickWaveStatus][]models.PickWaveStatus{
+	models.PickWaveStatusPending: {models.PickWaveStatusPicking, models.PickWaveStatusSorting, models.PickWaveStatusCancelled},
+	models.PickWaveStatusPicking: {models.PickWaveStatusSorting, models.PickWaveStatusCancelled},
+	models.PickWaveStatusSorting: {models.PickWaveStatusCompleted, models.PickWaveStatusCancelled},
+}
+
+func validateWaveTransition(from models.PickWaveStatus, to models.PickWaveStatus) error {
+	for _, status := range pickWaveStatusTransitions[from] {
+		if status == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, from, to)
+}
+
+func isWaveOpen(status models.PickWaveStatus) bool {
+	return status != models.PickWaveStatusCompleted && status != models.PickWaveStatusCancelled
+}
+
+// ensureNotInOpenWave rejects changes of a task which is still picked in a
+// wave, the wave has to be cancelled first
+func ensureNotInOpenWave(ctx context.Context, qtx *sqlc.Queries, task sqlc.Task) error {
+	if !task.WaveID.Valid {
+		return nil
+	}
+	wave, err := qtx.GetPickWaveById(ctx, sqlc.GetPickWaveByIdParams{
+		OrgID: task.OrgID,
+		ID:    task.WaveID,
+	})
+	if err != nil {
+		return services.MapDbErrorToService(err)
+	}
+	if isWaveOpen(models.PickWaveStatus(wave.Status)) {
+		return ErrTaskInPickWave
+	}
+	return nil
+}
+
+// isWaveable reports whether the pickment task can join a wave. Its items get
+// the consolidation cell as the target, so they must not have one yet. Waves
+// are picked and sorted by instance, so stock line items keep a task out.
+// GetWaveCandidateTasks applies the same conditions when planning a wave.
+func isWaveable(task sqlc.Task, items []sqlc.TaskItem, unitID uuid.UUID) bool {
+	if models.TaskType(task.Type) != models.TaskTypePickmentItem ||
+		models.TaskStatus(task.Status) != models.TaskStatusPending ||
+		task.WaveID.Valid ||
+		database.UUIDFromPgx(task.UnitID) != unitID ||
+		len(items) == 0 {
+		return false
+	}
+	for _, item := range items {
+		if item.DestinationCellID.Valid || item.StockLineID.Valid {
+			return false
+		}
+	}
+	return true
+}
+
+// CreatePickWave groups pickment tasks of a unit into a wave. Without explicit
+// task ids the planner takes open pickment tasks of the unit ordered by
+// priority and due date. Items of the wave tasks get the consolidation cell as
+// their target cell.
+func (s *TaskService) CreatePickWave(ctx context.Context, orgID uuid.UUID, create *models.PickWaveCreate) (*models.PickWave, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CreatePickWave", func(ctx context.Context, span trace.Span) (*models.PickWave, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("unit.id", create.UnitID.String()),
+			attribute.Int("wave.task_ids", len(create.TaskIDs)),
+		)
+
+		create.Name = strings.TrimSpace(create.Name)
+		if create.Name == "" {
+			return nil, common.ErrDetailedValidationErrorWithMessage("name is required")
+		}
+		if len(create.TaskIDs) > maxWaveTasks {
+			return nil, common.ErrDetailedValidationErrorWithMessage("too many tasks in a pick wave (max 100)")
+		}
+		if create.MaxTasks == 0 {
+			create.MaxTasks = defaultWaveMaxTasks
+		}
+		if create.MaxTasks < 0 || create.MaxTasks > maxWaveTasks {
+			return nil, common.ErrDetailedValidationErrorWithMessage("max tasks must be between 1 and 100")
+		}
+
+		cell, err := s.storageService.GetCellFull(ctx, orgID, create.ConsolidationCellID)
+		if err != nil {
+			return nil, err
+		}
+		if !cellInUnit(cell, create.UnitID) {
+			return nil, common.ErrDetailedValidationErrorWithMessage("consolidation cell must belong to the wave unit")
+		}
+
+		var tasksBefore []*models.Task
+		wave, err := database.WithTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) (*models.PickWave, error) {
+			qtx := s.queries.WithTx(tx)
+
+			if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, &create.ConsolidationCellID); err != nil {
+				return nil, err
+			}
+
+			explicit := len(create.TaskIDs) > 0
+			taskIDs := create.TaskIDs
+			if !explicit {
+				candidates, err := qtx.GetWaveCandidateTasks(ctx, sqlc.GetWaveCandidateTasksParams{
+					OrgID:    database.PgUUID(orgID),
+					UnitID:   database.PgUUID(create.UnitID),
+					MaxTasks: int32(create.MaxTasks),
+				})
+				if err != nil {
+					return nil, services.MapDbErrorToService(err)
+				}
+				taskIDs = make([]uuid.UUID, len(candidates))
+				for i, candidate := range candidates {
+					taskIDs[i] = database.UUIDFromPgx(candidate.ID)
+				}
+			}
+
+			// Candidates are re-checked under the lock, a concurrent planner
+			// may have taken them already
+			tasks, err := qtx.GetTasksByIdsForUpdate(ctx, sqlc.GetTasksByIdsForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				Ids:   database.PgUUIDs(taskIDs),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+			if explicit && len(tasks) != len(uniqueUUIDs(taskIDs)) {
+				return nil, fmt.Errorf("failed to get task: %w", common.ErrNotFound)
+			}
+
+			waveTaskIDs := make([]uuid.UUID, 0, len(tasks))
+			for _, task := range tasks {
+				items, err := qtx.GetTaskItems(ctx, sqlc.GetTaskItemsParams{
+					OrgID:  database.PgUUID(orgID),
+					TaskID: task.ID,
+				})
+				if err != nil {
+					return nil, services.MapDbErrorToService(err)
+				}
+				if !isWaveable(task, items, create.UnitID) {
+					if explicit {
+						return nil, ErrTaskNotWaveable
+					}
+					continue
+				}
+				waveTaskIDs = append(waveTaskIDs, database.UUIDFromPgx(task.ID))
+				tasksBefore = append(tasksBefore, toTask(task))
+			}
+			if len(waveTaskIDs) == 0 {
+				return nil, ErrPickWaveNoTasks
+			}
+
+			created, err := qtx.CreatePickWave(ctx, sqlc.CreatePickWaveParams{
+				OrgID:               database.PgUUID(orgID),
+				UnitID:              database.PgUUID(create.UnitID),
+				Name:                create.Name,
+				ConsolidationCellID: database.PgUUID(create.ConsolidationCellID),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetTasksWave(ctx, sqlc.SetTasksWaveParams{
+				OrgID:  database.PgUUID(orgID),
+				WaveID: created.ID,
+				Ids:    database.PgUUIDs(waveTaskIDs),
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetWaveTaskItemsDestination(ctx, sqlc.SetWaveTaskItemsDestinationParams{
+				OrgID:             database.PgUUID(orgID),
+				DestinationCellID: created.ConsolidationCellID,
+				WaveID:            created.ID,
+			})
+			if err != nil {
+				return nil, services.MapDbErrorToService(err)
+			}
+
+			return toPickWave(created), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionCreate,
+			TargetObjectType: models.ObjectTypePickWave,
+			TargetObjectID:   wave.ID,
+			PostchangeState:  wave,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, before := range tasksBefore {
+			after := *before
+			after.WaveID = &wave.ID
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypeTask,
+				TargetObjectID:   before.ID,
+				PrechangeState:   before,
+				PostchangeState:  &after,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return s.GetPickWaveById(ctx, orgID, wave.ID)
+	})
+}
+
+func uniqueUUIDs(ids []uuid.UUID) map[uuid.UUID]bool {
+	res := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		res[id] = true
+	}
+	return res
+}
+
+func (s *TaskService) GetPickWaves(ctx context.Context, orgID uuid.UUID) ([]*models.PickWave, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetPickWaves", func(ctx context.Context, span trace.Span) ([]*models.PickWave, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+		)
+
+		waves, err := s.queries.GetPickWaves(ctx, database.PgUUID(orgID))
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+
+		res := make([]*models.PickWave, len(waves))
+		for i, wave := range waves {
+			res[i] = toPickWave(wave)
+		}
+		return res, nil
+	})
+}
+
+// GetPickWaveById returns the wave with its tasks and the combined list of
+// their items
+func (s *TaskService) GetPickWaveById(ctx context.Context, orgID uuid.UUID, waveID uuid.UUID) (*models.PickWave, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "GetPickWaveById", func(ctx context.Context, span trace.Span) (*models.PickWave, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("wave.id", waveID.String()),
+		)
+
+		wave, err := s.queries.GetPickWaveById(ctx, sqlc.GetPickWaveByIdParams{
+			OrgID: database.PgUUID(orgID),
+			ID:    database.PgUUID(waveID),
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+		res := toPickWave(wave)
+
+		tasks, err := s.queries.GetWaveTasks(ctx, sqlc.GetWaveTasksParams{
+			OrgID:  database.PgUUID(orgID),
+			WaveID: wave.ID,
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+		res.Tasks, err = s.toTasksWithRelations(ctx, orgID, tasks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load task relations: %w", err)
+		}
+
+		items, err := s.queries.GetWaveTaskItems(ctx, sqlc.GetWaveTaskItemsParams{
+			OrgID:  database.PgUUID(orgID),
+			WaveID: wave.ID,
+		})
+		if err != nil {
+			return nil, services.MapDbErrorToService(err)
+		}
+		res.Items = make([]*models.TaskItem, len(items))
+		for i, item := range items {
+			res.Items[i] = toTaskItem(item)
+		}
+		if err := s.loadTaskItemsRelations(ctx, orgID, res.Items); err != nil {
+			return nil, fmt.Errorf("failed to load task items: %w", err)
+		}
+		// The combined list is a single route through the unit
+		if err := s.sequenceTaskItems(ctx, orgID, res.UnitID, res.Items); err != nil {
+			return nil, fmt.Errorf("failed to order task items: %w", err)
+		}
+
+		res.ConsolidationCell, err = s.storageService.GetCellFull(ctx, orgID, res.ConsolidationCellID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load consolidation cell: %w", err)
+		}
+
+		return res, nil
+	})
+}
+
+// PickWaveInstance picks the instance for the wave task it belongs to. The
+// first pick moves the wave to picking. Returns the originating task.
+func (s *TaskService) PickWaveInstance(ctx context.Context, orgID uuid.UUID, waveID uuid.UUID, instanceID uuid.UUID) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "PickWaveInstance", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("wave.id", waveID.String()),
+			attribute.String("instance.id", instanceID.String()),
+		)
+
+		instanceBefore, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		var taskID uuid.UUID
+		var taskBefore, taskAfter *models.Task
+		var waveBefore, waveAfter *models.PickWave
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			// The wave stays locked until the pick is committed, so it can't
+			// be completed or cancelled in between
+			wave, err := qtx.GetPickWaveByIdForUpdate(ctx, sqlc.GetPickWaveByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(waveID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			status := models.PickWaveStatus(wave.Status)
+			if !isWaveOpen(status) {
+				return ErrPickWaveClosed
+			}
+
+			item, err := qtx.GetWaveTaskItemByInstance(ctx, sqlc.GetWaveTaskItemByInstanceParams{
+				OrgID:          database.PgUUID(orgID),
+				WaveID:         wave.ID,
+				ItemInstanceID: database.PgUUID(instanceID),
+				Status:         sqlc.TaskItemStatus(models.TaskItemStatusPending),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return ErrInstanceNotInPickWave
+				}
+				return services.MapDbErrorToService(err)
+			}
+			taskID = database.UUIDFromPgx(item.TaskID)
+
+			taskBefore, taskAfter, err = pickTaskInstance(ctx, qtx, orgID, taskID, instanceID)
+			if err != nil {
+				return err
+			}
+
+			// The first pick starts picking of the wave
+			if status == models.PickWaveStatusPending {
+				updated, err := qtx.SetPickWaveStatus(ctx, sqlc.SetPickWaveStatusParams{
+					OrgID:       database.PgUUID(orgID),
+					ID:          wave.ID,
+					Status:      sqlc.PickWaveStatus(models.PickWaveStatusPicking),
+					CompletedAt: wave.CompletedAt,
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+				waveBefore = toPickWave(wave)
+				waveAfter = toPickWave(updated)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		instanceAfter, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeItemInstance,
+			TargetObjectID:   instanceID,
+			PrechangeState:   instanceBefore,
+			PostchangeState:  instanceAfter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if taskAfter != nil {
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypeTask,
+				TargetObjectID:   taskID,
+				PrechangeState:   taskBefore,
+				PostchangeState:  taskAfter,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if waveAfter != nil {
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypePickWave,
+				TargetObjectID:   waveID,
+				PrechangeState:   waveBefore,
+				PostchangeState:  waveAfter,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return s.GetTaskById(ctx, orgID, taskID)
+	})
+}
+
+// SortWaveInstance puts a picked instance into the consolidation cell for its
+// originating task. The instance stays reserved by the task until the task is
+// completed. The first sorted item moves the wave to sorting. Returns the
+// originating task.
+func (s *TaskService) SortWaveInstance(ctx context.Context, orgID uuid.UUID, waveID uuid.UUID, instanceID uuid.UUID) (*models.Task, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "SortWaveInstance", func(ctx context.Context, span trace.Span) (*models.Task, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("wave.id", waveID.String()),
+			attribute.String("instance.id", instanceID.String()),
+		)
+
+		instanceBefore, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		var waveBefore, waveAfter *models.PickWave
+		var taskID uuid.UUID
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			wave, err := qtx.GetPickWaveByIdForUpdate(ctx, sqlc.GetPickWaveByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(waveID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			status := models.PickWaveStatus(wave.Status)
+			if !isWaveOpen(status) {
+				return ErrPickWaveClosed
+			}
+			item, err := qtx.GetWaveTaskItemByInstance(ctx, sqlc.GetWaveTaskItemByInstanceParams{
+				OrgID:          database.PgUUID(orgID),
+				WaveID:         wave.ID,
+				ItemInstanceID: database.PgUUID(instanceID),
+				Status:         sqlc.TaskItemStatus(models.TaskItemStatusPicked),
+			})
+			if err != nil {
+				if database.IsNotFound(err) {
+					return ErrTaskItemNotPicked
+				}
+				return services.MapDbErrorToService(err)
+			}
+			taskID = database.UUIDFromPgx(item.TaskID)
+
+			task, err := qtx.GetTaskByIdForUpdate(ctx, sqlc.GetTaskByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    item.TaskID,
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if !isTaskOpen(models.TaskStatus(task.Status)) {
+				return ErrTaskClosed
+			}
+
+			taskItem, err := qtx.GetTaskItemForUpdate(ctx, sqlc.GetTaskItemForUpdateParams{
+				OrgID:          database.PgUUID(orgID),
+				TaskID:         item.TaskID,
+				ItemInstanceID: database.PgUUID(instanceID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			if models.TaskItemStatus(taskItem.Status) != models.TaskItemStatusPicked {
+				return ErrTaskItemNotPicked
+			}
+			if err := services.EnsureCellsNotFrozen(ctx, qtx, orgID, database.UUIDPtrFromPgx(wave.ConsolidationCellID)); err != nil {
+				return err
+			}
+
+			_, err = qtx.GetItemInstanceForUpdate(ctx, sqlc.GetItemInstanceForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(instanceID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetItemInstanceCell(ctx, sqlc.SetItemInstanceCellParams{
+				OrgID:  database.PgUUID(orgID),
+				ID:     database.PgUUID(instanceID),
+				CellID: wave.ConsolidationCellID,
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.SetTaskItemStatus(ctx, sqlc.SetTaskItemStatusParams{
+				OrgID:          database.PgUUID(orgID),
+				TaskID:         item.TaskID,
+				ItemInstanceID: database.PgUUID(instanceID),
+				Status:         sqlc.TaskItemStatus(models.TaskItemStatusDone),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			// The first sorted item starts sorting of the wave
+			if status != models.PickWaveStatusSorting {
+				updated, err := qtx.SetPickWaveStatus(ctx, sqlc.SetPickWaveStatusParams{
+					OrgID:       database.PgUUID(orgID),
+					ID:          wave.ID,
+					Status:      sqlc.PickWaveStatus(models.PickWaveStatusSorting),
+					CompletedAt: wave.CompletedAt,
+				})
+				if err != nil {
+					return services.MapDbErrorToService(err)
+				}
+				waveBefore = toPickWave(wave)
+				waveAfter = toPickWave(updated)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		instanceAfter, err := s.item.GetItemInstanceById(ctx, orgID, instanceID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeItemInstance,
+			TargetObjectID:   instanceID,
+			PrechangeState:   instanceBefore,
+			PostchangeState:  instanceAfter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if waveAfter != nil {
+			err = s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+				Action:           models.ObjectChangeActionUpdate,
+				TargetObjectType: models.ObjectTypePickWave,
+				TargetObjectID:   waveID,
+				PrechangeState:   waveBefore,
+				PostchangeState:  waveAfter,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return s.GetTaskById(ctx, orgID, taskID)
+	})
+}
+
+// CompletePickWave completes a wave whose items are all sorted. Every wave
+// task is marked as ready to be handed out from the consolidation cell.
+func (s *TaskService) CompletePickWave(ctx context.Context, orgID uuid.UUID, waveID uuid.UUID) (*models.PickWave, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CompletePickWave", func(ctx context.Context, span trace.Span) (*models.PickWave, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("wave.id", waveID.String()),
+		)
+
+		before, err := s.GetPickWaveById(ctx, orgID, waveID)
+		if err != nil {
+			return nil, err
+		}
+
+		var tasksBefore, tasksAfter []*models.Task
+		var pickupCodes []*models.TaskPickupCode
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			wave, err := qtx.GetPickWaveByIdForUpdate(ctx, sqlc.GetPickWaveByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(waveID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			status := models.PickWaveStatus(wave.Status)
+			if !isWaveOpen(status) {
+				return ErrPickWaveClosed
+			}
+			if err := validateWaveTransition(status, models.PickWaveStatusCompleted); err != nil {
+				return err
+			}
+
+			items, err := qtx.GetWaveTaskItems(ctx, sqlc.GetWaveTaskItemsParams{
+				OrgID:  database.PgUUID(orgID),
+				WaveID: wave.ID,
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			for _, item := range items {
+				switch models.TaskItemStatus(item.Status) {
+				case models.TaskItemStatusPending, models.TaskItemStatusPicked:
+					return ErrPickWaveItemsNotSorted
+				}
+			}
+
+			tasks, err := qtx.GetWaveTasks(ctx, sqlc.GetWaveTasksParams{
+				OrgID:  database.PgUUID(orgID),
+				WaveID: wave.ID,
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			taskIDs := make([]uuid.UUID, len(tasks))
+			for i, task := range tasks {
+				taskIDs[i] = database.UUIDFromPgx(task.ID)
+			}
+			tasks, err = qtx.GetTasksByIdsForUpdate(ctx, sqlc.GetTasksByIdsForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				Ids:   database.PgUUIDs(taskIDs),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			for _, task := range tasks {
+				taskStatus := models.TaskStatus(task.Status)
+				if taskStatus != models.TaskStatusInProgress {
+					continue
+				}
+				updated, err := updateTaskStatus(ctx, qtx, taskStatus, nil, sqlc.UpdateTaskParams{
+					OrgID:       database.PgUUID(orgID),
+					ID:          task.ID,
+					Status:      sqlc.TaskStatus(models.TaskStatusReady),
+					CompletedAt: task.CompletedAt,
+				})
+				if err != nil {
+					return err
+				}
+				tasksBefore = append(tasksBefore, toTask(task))
+				tasksAfter = append(tasksAfter, toTask(updated))
+
+				code, err := issuePickupCode(ctx, qtx, toTask(updated))
+				if err != nil {
+					return err
+				}
+				pickupCodes = append(pickupCodes, code)
+			}
+
+			now := time.Now()
+			_, err = qtx.SetPickWaveStatus(ctx, sqlc.SetPickWaveStatusParams{
+				OrgID:       database.PgUUID(orgID),
+				ID:          wave.ID,
+				Status:      sqlc.PickWaveStatus(models.PickWaveStatusCompleted),
+				CompletedAt: database.PgTimestampPtr(&now),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, code := range pickupCodes {
+			if err := s.auditPickupCode(ctx, models.ObjectChangeActionCreate, nil, code, nil); err != nil {
+				return nil, err
+			}
+		}
+
+		return s.auditPickWaveChange(ctx, orgID, before, tasksBefore, tasksAfter)
+	})
+}
+
+// CancelPickWave cancels an open wave and releases its tasks. Items which are
+// not sorted yet lose the consolidation cell target, picked instances stay
+// with their tasks and can be handed out as with a regular pickment.
+func (s *TaskService) CancelPickWave(ctx context.Context, orgID uuid.UUID, waveID uuid.UUID) (*models.PickWave, error) {
+	return telemetry.WithTrace(ctx, s.tracer, "CancelPickWave", func(ctx context.Context, span trace.Span) (*models.PickWave, error) {
+		span.SetAttributes(
+			attribute.String("org.id", orgID.String()),
+			attribute.String("wave.id", waveID.String()),
+		)
+
+		before, err := s.GetPickWaveById(ctx, orgID, waveID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = database.WithVoidTransaction(ctx, s.pgxpool, s.tracer, func(ctx context.Context, tx pgx.Tx) error {
+			qtx := s.queries.WithTx(tx)
+
+			wave, err := qtx.GetPickWaveByIdForUpdate(ctx, sqlc.GetPickWaveByIdForUpdateParams{
+				OrgID: database.PgUUID(orgID),
+				ID:    database.PgUUID(waveID),
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			status := models.PickWaveStatus(wave.Status)
+			if !isWaveOpen(status) {
+				return ErrPickWaveClosed
+			}
+			if err := validateWaveTransition(status, models.PickWaveStatusCancelled); err != nil {
+				return err
+			}
+
+			// Items are matched by the wave, so they are released before the tasks
+			err = qtx.SetWaveTaskItemsDestination(ctx, sqlc.SetWaveTaskItemsDestinationParams{
+				OrgID:             database.PgUUID(orgID),
+				DestinationCellID: database.PgUUIDPtr(nil),
+				WaveID:            wave.ID,
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			err = qtx.ReleaseWaveTasks(ctx, sqlc.ReleaseWaveTasksParams{
+				OrgID:  database.PgUUID(orgID),
+				WaveID: wave.ID,
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+
+			_, err = qtx.SetPickWaveStatus(ctx, sqlc.SetPickWaveStatusParams{
+				OrgID:       database.PgUUID(orgID),
+				ID:          wave.ID,
+				Status:      sqlc.PickWaveStatus(models.PickWaveStatusCancelled),
+				CompletedAt: wave.CompletedAt,
+			})
+			if err != nil {
+				return services.MapDbErrorToService(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		tasksAfter := make([]*models.Task, len(before.Tasks))
+		for i, task := range before.Tasks {
+			after := *task
+			after.WaveID = nil
+			tasksAfter[i] = &after
+		}
+
+		after, err := s.auditPickWaveChange(ctx, orgID, before, before.Tasks, tasksAfter)
+		if err != nil {
+			return nil, err
+		}
+		// Released tasks are no longer listed by the wave, the snapshot taken
+		// before the cancellation is returned instead
+		after.Tasks = tasksAfter
+		after.Items = before.Items
+		return after, nil
+	})
+}
+
+func (s *TaskService) auditPickWaveChange(ctx context.Context, orgID uuid.UUID, before *models.PickWave, tasksBefore []*models.Task, tasksAfter []*models.Task) (*models.PickWave, error) {
+	after, err := s.GetPickWaveById(ctx, orgID, before.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	errs = append(errs, s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+		Action:           models.ObjectChangeActionUpdate,
+		TargetObjectType: models.ObjectTypePickWave,
+		TargetObjectID:   before.ID,
+		PrechangeState:   before,
+		PostchangeState:  after,
+	}))
+	for i := range tasksAfter {
+		errs = append(errs, s.audit.CreateObjectChange(ctx, &models.ObjectChangeCreate{
+			Action:           models.ObjectChangeActionUpdate,
+			TargetObjectType: models.ObjectTypeTask,
+			TargetObjectID:   tasksAfter[i].ID,
+			PrechangeState:   tasksBefore[i],
+			PostchangeState:  tasksAfter[i],
+		}))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return after, nil
+}
@@ -46,3 +46,16 @@ func (uc *AuditUseCase) GetObjectChanges(ctx context.Context, targetObjectTypeId
 
 	return changes, nil
 }
+
+func (uc *AuditUseCase) GetItemInstanceChangesBySerialNumber(ctx context.Context, serialNumber string) ([]*models.ObjectChange, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.auditService.GetItemInstanceChangesBySerialNumber(ctx, validateResult.OrgID, serialNumber)
+}
@@ -254,7 +254,22 @@ func (uc *ItemUseCase) GetItemInstancesAll(ctx context.Context) ([]*models.ItemI
 	return uc.service.GetItemInstancesAll(ctx, validateResult.OrgID)
 }
 
-func (uc *ItemUseCase) UpdateItemInstance(ctx context.Context, instanceId uuid.UUID, variantId uuid.UUID, cellId *uuid.UUID) (*models.ItemInstance, error) {
+func (uc *ItemUseCase) SearchItemInstancesBySerialNumber(ctx context.Context, serialNumber *string, search *string) ([]*models.ItemInstance, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.service.SearchItemInstancesBySerialNumber(ctx, validateResult.OrgID, serialNumber, search)
+}
+
+// UpdateItemInstance moves the instance and changes its variant. The serial
+// number and the lot are kept when not given.
+func (uc *ItemUseCase) UpdateItemInstance(ctx context.Context, instanceId uuid.UUID, variantId uuid.UUID, cellId *uuid.UUID, serialNumber *string, lot models.ItemLot) (*models.ItemInstance, error) {
 	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
 	if err != nil {
 		return nil, err
@@ -271,6 +286,18 @@ func (uc *ItemUseCase) UpdateItemInstance(ctx context.Context, instanceId uuid.U
 
 	itemInstance.VariantID = variantId
 	itemInstance.CellID = cellId
+	if serialNumber != nil {
+		itemInstance.SerialNumber = serialNumber
+	}
+	if lot.LotNumber != nil {
+		itemInstance.LotNumber = lot.LotNumber
+	}
+	if lot.ManufacturedAt != nil {
+		itemInstance.ManufacturedAt = lot.ManufacturedAt
+	}
+	if lot.ExpiresAt != nil {
+		itemInstance.ExpiresAt = lot.ExpiresAt
+	}
 
 	updatedInstance, err := uc.service.UpdateItemInstance(ctx, validateResult.OrgID, itemInstance)
 	if err != nil {
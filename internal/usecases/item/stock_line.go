@@ -0,0 +1,75 @@
+package item
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/usecases"
+)
+
+func (uc *ItemUseCase) CreateStockLine(ctx context.Context, line *models.StockLine) (*models.StockLine, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	line.OrgID = validateResult.OrgID
+	return uc.service.CreateStockLine(ctx, line)
+}
+
+func (uc *ItemUseCase) GetStockLines(ctx context.Context, filter *models.StockLineFilter) ([]*models.StockLine, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.service.GetStockLines(ctx, validateResult.OrgID, filter)
+}
+
+func (uc *ItemUseCase) GetStockLineById(ctx context.Context, id uuid.UUID) (*models.StockLine, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.service.GetStockLineById(ctx, validateResult.OrgID, id)
+}
+
+func (uc *ItemUseCase) SplitStockLine(ctx context.Context, id uuid.UUID, quantity int) (*models.StockLine, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.service.SplitStockLine(ctx, validateResult.OrgID, id, quantity)
+}
+
+func (uc *ItemUseCase) MergeStockLines(ctx context.Context, ids []uuid.UUID) (*models.StockLine, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.service.MergeStockLines(ctx, validateResult.OrgID, ids)
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/let-store-it/backend/internal/usecases"
 )
 
-func (uc *OrganizationUseCase) CreateUnit(ctx context.Context, name string, alias string, address string) (*models.OrganizationUnit, error) {
+func (uc *OrganizationUseCase) CreateUnit(ctx context.Context, name string, alias string, address string, pickPathStrategy models.PickPathStrategy) (*models.OrganizationUnit, error) {
 	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelAdmin, true)
 	if err != nil {
 		return nil, err
@@ -18,7 +18,7 @@ func (uc *OrganizationUseCase) CreateUnit(ctx context.Context, name string, alia
 		return nil, usecases.ErrForbidden
 	}
 
-	createdUnit, err := uc.service.CreateUnit(ctx, validateResult.OrgID, name, alias, address)
+	createdUnit, err := uc.service.CreateUnit(ctx, validateResult.OrgID, name, alias, address, pickPathStrategy)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,71 @@
+package stock
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/let-store-it/backend/internal/models"
+	"github.com/let-store-it/backend/internal/services/auth"
+	"github.com/let-store-it/backend/internal/services/stock"
+	"github.com/let-store-it/backend/internal/usecases"
+)
+
+type StockUseCase struct {
+	stockService *stock.StockService
+	authService  *auth.AuthService
+}
+
+type StockUseCaseConfig struct {
+	StockService *stock.StockService
+	AuthService  *auth.AuthService
+}
+
+func New(config StockUseCaseConfig) *StockUseCase {
+	if config.StockService == nil || config.AuthService == nil {
+		panic("StockService and AuthService are required")
+	}
+	return &StockUseCase{
+		stockService: config.StockService,
+		authService:  config.AuthService,
+	}
+}
+
+func (uc *StockUseCase) GetStockLevels(ctx context.Context, filter *models.StockFilter) ([]*models.StockLevel, error) {
+	valRes, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !valRes.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.stockService.GetStockLevels(ctx, valRes.OrgID, filter)
+}
+
+func (uc *StockUseCase) GetExpiringInstances(ctx context.Context, days int, unitID *uuid.UUID) ([]*models.ItemInstance, error) {
+	valRes, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !valRes.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.stockService.GetExpiringInstances(ctx, valRes.OrgID, days, unitID)
+}
+
+func (uc *StockUseCase) ResolveBarcode(ctx context.Context, code string) (*models.BarcodeResolution, error) {
+	valRes, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !valRes.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.stockService.ResolveBarcode(ctx, valRes.OrgID, code)
+}
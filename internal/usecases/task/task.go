@@ -2,8 +2,10 @@ package task
 
 import (
 	"context"
+	"io"
 
 	"github.com/google/uuid"
+	"github.com/let-store-it/backend/internal/common"
 	"github.com/let-store-it/backend/internal/models"
 	"github.com/let-store-it/backend/internal/services/auth"
 	"github.com/let-store-it/backend/internal/services/organization"
@@ -60,6 +62,19 @@ func (uc *TaskUseCase) CreateTask(ctx context.Context, task *models.Task) (*mode
 	return createdTask, nil
 }
 
+func (uc *TaskUseCase) ImportTasks(ctx context.Context, format models.TaskImportFormat, content io.Reader, dryRun bool) (*models.TaskImportReport, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.ImportTasks(ctx, validateResult.OrgID, format, content, dryRun)
+}
+
 func (uc *TaskUseCase) GetTaskById(ctx context.Context, id uuid.UUID) (*models.Task, error) {
 	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
 	if err != nil {
@@ -77,6 +92,19 @@ func (uc *TaskUseCase) GetTaskById(ctx context.Context, id uuid.UUID) (*models.T
 	return task, nil
 }
 
+func (uc *TaskUseCase) GetTaskStatusHistory(ctx context.Context, id uuid.UUID) ([]*models.TaskStatusChange, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTaskStatusHistory(ctx, validateResult.OrgID, id)
+}
+
 func (uc *TaskUseCase) GetTasks(ctx context.Context) ([]*models.Task, error) {
 	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
 	if err != nil {
@@ -94,6 +122,19 @@ func (uc *TaskUseCase) GetTasks(ctx context.Context) ([]*models.Task, error) {
 	return tasks, nil
 }
 
+func (uc *TaskUseCase) ListTasks(ctx context.Context, filter *models.TaskFilter) (*models.TaskList, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.ListTasks(ctx, validateResult.OrgID, filter)
+}
+
 func (uc *TaskUseCase) PickInstanceFromCellForTask(ctx context.Context, taskID uuid.UUID, instanceID uuid.UUID) error {
 	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
 	if err != nil {
@@ -107,23 +148,82 @@ func (uc *TaskUseCase) PickInstanceFromCellForTask(ctx context.Context, taskID u
 	return uc.taskService.PickInstance(ctx, validateResult.OrgID, taskID, instanceID)
 }
 
-func (uc *TaskUseCase) MarkTaskAsAwaiting(ctx context.Context, taskID uuid.UUID) error {
+func (uc *TaskUseCase) PutInstanceToTargetCellForTask(ctx context.Context, taskID uuid.UUID, instanceID uuid.UUID) error {
 	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
 	if err != nil {
 		return err
 	}
 
 	if !validateResult.IsAllowed {
-		return usecases.ErrNotAuthorized
+		return usecases.ErrForbidden
+	}
+
+	return uc.taskService.PutInstance(ctx, validateResult.OrgID, taskID, instanceID)
+}
+
+func (uc *TaskUseCase) PickStockLineForTask(ctx context.Context, taskID uuid.UUID, stockLineID uuid.UUID) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	return uc.taskService.PickStockLine(ctx, validateResult.OrgID, taskID, stockLineID)
+}
+
+func (uc *TaskUseCase) PutStockLineToTargetCellForTask(ctx context.Context, taskID uuid.UUID, stockLineID uuid.UUID) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	return uc.taskService.PutStockLine(ctx, validateResult.OrgID, taskID, stockLineID)
+}
+
+func (uc *TaskUseCase) ReceiveItems(ctx context.Context, taskID uuid.UUID, variantID uuid.UUID, quantity int, lot models.ItemLot, serialNumbers []string) (*models.TaskReceipt, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
 	}
 
-	task, err := uc.taskService.GetTaskById(ctx, validateResult.OrgID, taskID)
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.ReceiveItems(ctx, validateResult.OrgID, taskID, variantID, quantity, lot, serialNumbers)
+}
+
+func (uc *TaskUseCase) InspectReturnedInstance(ctx context.Context, taskID uuid.UUID, instanceID uuid.UUID, disposition models.ReturnDisposition, cellID *uuid.UUID) (*models.ItemInstance, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.InspectReturnedInstance(ctx, validateResult.OrgID, taskID, instanceID, disposition, cellID)
+}
+
+func (uc *TaskUseCase) MarkTaskAsAwaiting(ctx context.Context, taskID uuid.UUID, comment *string) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
 	if err != nil {
 		return err
 	}
-	task.Status = models.TaskStatusReady
 
-	_, err = uc.taskService.UpdateTask(ctx, task)
+	if !validateResult.IsAllowed {
+		return usecases.ErrNotAuthorized
+	}
+
+	_, err = uc.taskService.MarkTaskAsReady(ctx, validateResult.OrgID, taskID, comment)
 	if err != nil {
 		return err
 	}
@@ -131,7 +231,7 @@ func (uc *TaskUseCase) MarkTaskAsAwaiting(ctx context.Context, taskID uuid.UUID)
 	return nil
 }
 
-func (uc *TaskUseCase) MarkTaskAsCompleted(ctx context.Context, taskID uuid.UUID) error {
+func (uc *TaskUseCase) MarkTaskAsCompleted(ctx context.Context, taskID uuid.UUID, comment *string, pickupCode *string) error {
 	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
 	if err != nil {
 		return err
@@ -141,16 +241,643 @@ func (uc *TaskUseCase) MarkTaskAsCompleted(ctx context.Context, taskID uuid.UUID
 		return usecases.ErrForbidden
 	}
 
-	task, err := uc.taskService.GetTaskById(ctx, validateResult.OrgID, taskID)
+	_, err = uc.taskService.CompleteTask(ctx, validateResult.OrgID, taskID, comment, pickupCode)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTaskPickupCode returns the code to be handed to the customer or courier,
+// so it is shown to managers only. Workers completing the task get it from them.
+func (uc *TaskUseCase) GetTaskPickupCode(ctx context.Context, taskID uuid.UUID) (*models.TaskPickupCode, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTaskPickupCode(ctx, validateResult.OrgID, taskID)
+}
+
+func (uc *TaskUseCase) RegeneratePickupCode(ctx context.Context, taskID uuid.UUID) (*models.TaskPickupCode, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.RegeneratePickupCode(ctx, validateResult.OrgID, taskID)
+}
+
+// GetUnitPickupCodes returns the pickup codes shown on the TV boards of the unit.
+// Only TV boards get them, for other callers codes are as secret as in GetTaskPickupCode.
+func (uc *TaskUseCase) GetUnitPickupCodes(ctx context.Context, unitID uuid.UUID) ([]*models.TaskPickupCode, error) {
+	if _, err := common.GetTvBoardIDFromContext(ctx); err != nil {
+		return nil, usecases.ErrForbidden
+	}
+
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetUnitPickupCodes(ctx, validateResult.OrgID, unitID)
+}
+
+func (uc *TaskUseCase) CancelTask(ctx context.Context, taskID uuid.UUID, comment *string) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
 	if err != nil {
 		return err
 	}
-	task.Status = models.TaskStatusCompleted
 
-	_, err = uc.taskService.UpdateTask(ctx, task)
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	_, err = uc.taskService.CancelTask(ctx, validateResult.OrgID, taskID, comment)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (uc *TaskUseCase) AssignTask(ctx context.Context, taskID uuid.UUID, userID uuid.UUID) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	_, err = uc.taskService.AssignTask(ctx, validateResult.OrgID, taskID, userID)
+	return err
+}
+
+func (uc *TaskUseCase) ReassignTask(ctx context.Context, taskID uuid.UUID, userID uuid.UUID) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	_, err = uc.taskService.ReassignTask(ctx, validateResult.OrgID, taskID, userID)
+	return err
+}
+
+func (uc *TaskUseCase) UnassignTask(ctx context.Context, taskID uuid.UUID) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	_, err = uc.taskService.UnassignTask(ctx, validateResult.OrgID, taskID)
+	return err
+}
+
+func (uc *TaskUseCase) ClaimTask(ctx context.Context, taskID uuid.UUID) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, false)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	_, err = uc.taskService.ClaimTask(ctx, validateResult.OrgID, taskID, *validateResult.UserID)
+	return err
+}
+
+// GetMyTasks returns open tasks assigned to the calling user.
+func (uc *TaskUseCase) GetMyTasks(ctx context.Context) ([]*models.Task, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTasksAssignedToUser(ctx, validateResult.OrgID, *validateResult.UserID)
+}
+
+func (uc *TaskUseCase) GetWorkerProfile(ctx context.Context, userID uuid.UUID) (*models.WorkerProfile, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetWorkerProfile(ctx, validateResult.OrgID, userID)
+}
+
+func (uc *TaskUseCase) SetWorkerProfile(ctx context.Context, profile *models.WorkerProfile) (*models.WorkerProfile, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.SetWorkerProfile(ctx, validateResult.OrgID, profile)
+}
+
+// SetMyShift starts or ends the shift of the calling user.
+func (uc *TaskUseCase) SetMyShift(ctx context.Context, onShift bool) (*models.WorkerProfile, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.SetWorkerOnShift(ctx, validateResult.OrgID, *validateResult.UserID, onShift)
+}
+
+func (uc *TaskUseCase) SubmitCount(ctx context.Context, taskID uuid.UUID, submission *models.TaskCountSubmission) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	return uc.taskService.SubmitCount(ctx, validateResult.OrgID, taskID, validateResult.UserID, submission)
+}
+
+func (uc *TaskUseCase) GetCountReport(ctx context.Context, taskID uuid.UUID) (*models.InventoryCountReport, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetCountReport(ctx, validateResult.OrgID, taskID)
+}
+
+func (uc *TaskUseCase) ApplyCountAdjustments(ctx context.Context, taskID uuid.UUID) (*models.InventoryCountReport, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.ApplyCountAdjustments(ctx, validateResult.OrgID, taskID)
+}
+
+func (uc *TaskUseCase) CreatePickWave(ctx context.Context, create *models.PickWaveCreate) (*models.PickWave, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.CreatePickWave(ctx, validateResult.OrgID, create)
+}
+
+func (uc *TaskUseCase) GetPickWaves(ctx context.Context) ([]*models.PickWave, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetPickWaves(ctx, validateResult.OrgID)
+}
+
+func (uc *TaskUseCase) GetPickWaveById(ctx context.Context, waveID uuid.UUID) (*models.PickWave, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetPickWaveById(ctx, validateResult.OrgID, waveID)
+}
+
+func (uc *TaskUseCase) PickWaveInstance(ctx context.Context, waveID uuid.UUID, instanceID uuid.UUID) (*models.Task, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.PickWaveInstance(ctx, validateResult.OrgID, waveID, instanceID)
+}
+
+func (uc *TaskUseCase) SortWaveInstance(ctx context.Context, waveID uuid.UUID, instanceID uuid.UUID) (*models.Task, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.SortWaveInstance(ctx, validateResult.OrgID, waveID, instanceID)
+}
+
+func (uc *TaskUseCase) CompletePickWave(ctx context.Context, waveID uuid.UUID) (*models.PickWave, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.CompletePickWave(ctx, validateResult.OrgID, waveID)
+}
+
+func (uc *TaskUseCase) CancelPickWave(ctx context.Context, waveID uuid.UUID) (*models.PickWave, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.CancelPickWave(ctx, validateResult.OrgID, waveID)
+}
+
+func (uc *TaskUseCase) CreateTransferOrder(ctx context.Context, create *models.TransferOrderCreate) (*models.TransferOrder, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.CreateTransferOrder(ctx, validateResult.OrgID, create)
+}
+
+func (uc *TaskUseCase) GetTransferOrders(ctx context.Context, status *models.TransferOrderStatus) ([]*models.TransferOrder, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTransferOrders(ctx, validateResult.OrgID, status)
+}
+
+func (uc *TaskUseCase) GetTransferOrderById(ctx context.Context, orderID uuid.UUID) (*models.TransferOrder, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTransferOrderById(ctx, validateResult.OrgID, orderID)
+}
+
+func (uc *TaskUseCase) CancelTransferOrder(ctx context.Context, orderID uuid.UUID, comment *string) (*models.TransferOrder, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.CancelTransferOrder(ctx, validateResult.OrgID, orderID, comment)
+}
+
+func (uc *TaskUseCase) ReportTaskItemException(ctx context.Context, taskID uuid.UUID, create *models.TaskItemExceptionCreate) (*models.Task, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.ReportTaskItemException(ctx, validateResult.OrgID, taskID, create)
+}
+
+func (uc *TaskUseCase) GetSubstituteInstances(ctx context.Context, taskID uuid.UUID, instanceID uuid.UUID) ([]*models.ItemInstance, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetSubstituteInstances(ctx, validateResult.OrgID, taskID, instanceID)
+}
+
+func (uc *TaskUseCase) GetTaskExceptionsReport(ctx context.Context, filter *models.TaskExceptionFilter) (*models.TaskExceptionReport, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTaskExceptionsReport(ctx, validateResult.OrgID, filter)
+}
+
+func (uc *TaskUseCase) CreateTaskTemplate(ctx context.Context, template *models.TaskTemplate) (*models.TaskTemplate, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.CreateTaskTemplate(ctx, validateResult.OrgID, template)
+}
+
+func (uc *TaskUseCase) GetTaskTemplates(ctx context.Context) ([]*models.TaskTemplate, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTaskTemplates(ctx, validateResult.OrgID)
+}
+
+func (uc *TaskUseCase) GetTaskTemplateById(ctx context.Context, id uuid.UUID) (*models.TaskTemplate, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTaskTemplateById(ctx, validateResult.OrgID, id)
+}
+
+func (uc *TaskUseCase) UpdateTaskTemplate(ctx context.Context, template *models.TaskTemplate) (*models.TaskTemplate, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.UpdateTaskTemplate(ctx, validateResult.OrgID, template)
+}
+
+func (uc *TaskUseCase) DeleteTaskTemplate(ctx context.Context, id uuid.UUID) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	return uc.taskService.DeleteTaskTemplate(ctx, validateResult.OrgID, id)
+}
+
+func (uc *TaskUseCase) RunTaskTemplate(ctx context.Context, id uuid.UUID) (*models.Task, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelManager, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	task, err := uc.taskService.RunTaskTemplate(ctx, validateResult.OrgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Unit, err = uc.orgService.GetUnitByID(ctx, validateResult.OrgID, task.UnitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// isOwnerOrManager reports whether the calling user left the comment or
+// attachment owned by ownerID, or is a manager allowed to remove anyone's
+func (uc *TaskUseCase) isOwnerOrManager(ctx context.Context, validateResult usecases.ValidateAccessResult, ownerID *uuid.UUID) (bool, error) {
+	if ownerID != nil && *ownerID == *validateResult.UserID {
+		return true, nil
+	}
+	return uc.authService.CheckUserAccess(ctx, validateResult.OrgID, *validateResult.UserID, models.AccessLevelManager)
+}
+
+func (uc *TaskUseCase) GetTaskComments(ctx context.Context, taskID uuid.UUID, instanceID *uuid.UUID) ([]*models.TaskComment, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTaskComments(ctx, validateResult.OrgID, taskID, instanceID)
+}
+
+func (uc *TaskUseCase) CreateTaskComment(ctx context.Context, taskID uuid.UUID, comment *models.TaskComment) (*models.TaskComment, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.CreateTaskComment(ctx, validateResult.OrgID, taskID, validateResult.UserID, comment)
+}
+
+// UpdateTaskComment edits the comment, only its author can do it.
+func (uc *TaskUseCase) UpdateTaskComment(ctx context.Context, taskID uuid.UUID, commentID uuid.UUID, body string) (*models.TaskComment, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	comment, err := uc.taskService.GetTaskComment(ctx, validateResult.OrgID, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AuthorUserID == nil || *comment.AuthorUserID != *validateResult.UserID {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.UpdateTaskComment(ctx, validateResult.OrgID, taskID, commentID, body)
+}
+
+// DeleteTaskComment deletes the comment, allowed to its author and managers.
+func (uc *TaskUseCase) DeleteTaskComment(ctx context.Context, taskID uuid.UUID, commentID uuid.UUID) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, false)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	comment, err := uc.taskService.GetTaskComment(ctx, validateResult.OrgID, taskID, commentID)
+	if err != nil {
+		return err
+	}
+	allowed, err := uc.isOwnerOrManager(ctx, validateResult, comment.AuthorUserID)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return usecases.ErrForbidden
+	}
+
+	return uc.taskService.DeleteTaskComment(ctx, validateResult.OrgID, taskID, commentID)
+}
+
+func (uc *TaskUseCase) GetTaskAttachments(ctx context.Context, taskID uuid.UUID, instanceID *uuid.UUID) ([]*models.TaskAttachment, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTaskAttachments(ctx, validateResult.OrgID, taskID, instanceID)
+}
+
+func (uc *TaskUseCase) UploadTaskAttachment(ctx context.Context, taskID uuid.UUID, upload *models.TaskAttachmentUpload) (*models.TaskAttachment, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.UploadTaskAttachment(ctx, validateResult.OrgID, taskID, validateResult.UserID, upload)
+}
+
+func (uc *TaskUseCase) GetTaskAttachmentContent(ctx context.Context, taskID uuid.UUID, attachmentID uuid.UUID) (*models.TaskAttachment, []byte, error) {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !validateResult.IsAllowed {
+		return nil, nil, usecases.ErrForbidden
+	}
+
+	return uc.taskService.GetTaskAttachmentContent(ctx, validateResult.OrgID, taskID, attachmentID)
+}
+
+// DeleteTaskAttachment deletes the attachment, allowed to its uploader and
+// managers.
+func (uc *TaskUseCase) DeleteTaskAttachment(ctx context.Context, taskID uuid.UUID, attachmentID uuid.UUID) error {
+	validateResult, err := usecases.ValidateAccessWithOptionalApiToken(ctx, uc.authService, models.AccessLevelWorker, false)
+	if err != nil {
+		return err
+	}
+
+	if !validateResult.IsAllowed {
+		return usecases.ErrForbidden
+	}
+
+	attachment, err := uc.taskService.GetTaskAttachment(ctx, validateResult.OrgID, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+	allowed, err := uc.isOwnerOrManager(ctx, validateResult, attachment.UploadedByUserID)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return usecases.ErrForbidden
+	}
+
+	return uc.taskService.DeleteTaskAttachment(ctx, validateResult.OrgID, taskID, attachmentID)
+}
@@ -10,14 +10,14 @@ INSERT INTO org (name, subdomain) VALUES ($1, $2) RETURNING *;
 SELECT * FROM org WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: UpdateOrganization :one
-UPDATE org SET name = $2 WHERE id = $1 RETURNING *;
+UPDATE org SET name = $2, task_assignment_strategy = $3, allocation_policy = $4 WHERE id = $1 RETURNING *;
 
 -- name: DeleteOrganization :exec
 UPDATE org SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1;
 
 -- Units
 -- name: CreateOrgUnit :one
-INSERT INTO org_unit (org_id, name, alias, address) VALUES ($1, $2, $3, $4) RETURNING *;
+INSERT INTO org_unit (org_id, name, alias, address, pick_path_strategy) VALUES ($1, $2, $3, $4, $5) RETURNING *;
 
 -- name: GetOrgUnits :many
 SELECT * FROM org_unit WHERE org_id = $1 AND deleted_at IS NULL;
@@ -25,8 +25,11 @@ SELECT * FROM org_unit WHERE org_id = $1 AND deleted_at IS NULL;
 -- name: GetOrgUnitById :one
 SELECT * FROM org_unit WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL;
 
+-- name: GetOrgUnitsByIds :many
+SELECT * FROM org_unit WHERE org_id = $1 AND id = ANY(@ids::uuid[]) AND deleted_at IS NULL;
+
 -- name: UpdateOrgUnit :one
-UPDATE org_unit SET name = $3, alias = $4, address = $5 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING *;
+UPDATE org_unit SET name = $3, alias = $4, address = $5, pick_path_strategy = $6 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING *;
 
 -- name: DeleteOrgUnit :exec
 UPDATE org_unit SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2;
@@ -70,9 +73,31 @@ INSERT INTO cell (org_id, cells_group_id, alias, row, level, position) VALUES ($
 -- name: GetCellById :one
 SELECT * FROM cell WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL;
 
+-- name: GetCellsByIds :many
+SELECT * FROM cell WHERE org_id = $1 AND id = ANY(@ids::uuid[]) AND deleted_at IS NULL;
+
+-- name: GetCellIdsByAlias :many
+-- Aliases are unique within a cells group only, cells of different groups
+-- may share one
+SELECT cell.id FROM cell
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+WHERE cell.org_id = $1 AND cell.alias = @alias AND cell.deleted_at IS NULL
+ORDER BY cell.id;
+
 -- name: GetCells :many
 SELECT * FROM cell WHERE org_id = $1 AND cells_group_id = $2 AND deleted_at IS NULL;
 
+-- name: GetCellIdsInStorageGroup :many
+WITH RECURSIVE groups AS (
+    SELECT id FROM storage_group WHERE storage_group.org_id = $1 AND storage_group.id = $2 AND deleted_at IS NULL
+    UNION ALL
+    SELECT sg.id FROM storage_group sg JOIN groups ON sg.parent_id = groups.id WHERE sg.deleted_at IS NULL
+)
+SELECT cell.id FROM cell
+JOIN cells_group ON cells_group.id = cell.cells_group_id
+WHERE cell.org_id = $1 AND cells_group.storage_group_id IN (SELECT id FROM groups)
+  AND cell.deleted_at IS NULL AND cells_group.deleted_at IS NULL;
+
 -- name: UpdateCell :one
 UPDATE cell SET alias = $3, row = $4, level = $5, position = $6 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING *;
 
@@ -87,7 +112,7 @@ WITH RECURSIVE path AS (
     cg.alias,
     cg.name,
     cg.storage_group_id AS parent_group_id,
-    NULL::UUID         AS unit_id,
+    cg.unit_id         AS unit_id,
     1                  AS lvl
   FROM cell c
   JOIN cells_group cg
@@ -133,6 +158,64 @@ FROM (
 ) t
 ORDER BY lvl;
 
+-- name: GetCellsPaths :many
+-- Same as GetCellPath for several cells at once, segments go from unit down to cells group
+WITH RECURSIVE path AS (
+  SELECT
+    c.id               AS cell_id,
+    cg.id,
+    'cells_group'      AS type,
+    cg.alias,
+    cg.name,
+    cg.storage_group_id AS parent_group_id,
+    cg.unit_id         AS unit_id,
+    1                  AS lvl
+  FROM cell c
+  JOIN cells_group cg
+    ON c.cells_group_id = cg.id
+   AND c.org_id         = cg.org_id
+  WHERE c.org_id = $1
+    AND c.id     = ANY(@cell_ids::uuid[])
+
+  UNION ALL
+
+  SELECT
+    p.cell_id,
+    sg.id,
+    'storage_group'    AS type,
+    sg.alias,
+    sg.name,
+    sg.parent_id       AS parent_group_id,
+    sg.unit_id,
+    p.lvl + 1          AS lvl
+  FROM path p
+  JOIN storage_group sg
+    ON sg.id     = p.parent_group_id
+   AND sg.org_id = $1
+)
+
+SELECT cell_id, id, type, alias, name
+FROM (
+  SELECT cell_id, id, type, alias, name, lvl
+  FROM path
+
+  UNION ALL
+
+  SELECT
+    p.cell_id,
+    ou.id,
+    'unit'            AS type,
+    ou.alias,
+    ou.name,
+    MAX(p.lvl) + 1    AS lvl
+  FROM path p
+  JOIN org_unit ou
+    ON ou.id     = p.unit_id
+   AND ou.org_id = $1
+  GROUP BY p.cell_id, ou.id, ou.alias, ou.name
+) t
+ORDER BY cell_id, lvl DESC;
+
 -- Items
 -- name: CreateItem :one
 INSERT INTO item (org_id, name, description) VALUES ($1, $2, $3) RETURNING *;
@@ -151,7 +234,7 @@ UPDATE item SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2;
 
 -- Item Variants
 -- name: CreateItemVariant :one
-INSERT INTO item_variant (org_id, item_id, name, article, ean13) VALUES ($1, $2, $3, $4, $5) RETURNING *;
+INSERT INTO item_variant (org_id, item_id, name, article, ean13, tracking_mode, allocation_policy, requires_serial) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
 
 -- name: GetItemVariantById :one
 SELECT * FROM item_variant WHERE org_id = $1 AND item_id = $2 AND id = $3 AND deleted_at IS NULL;
@@ -159,8 +242,22 @@ SELECT * FROM item_variant WHERE org_id = $1 AND item_id = $2 AND id = $3 AND de
 -- name: GetItemVariants :many
 SELECT * FROM item_variant WHERE org_id = $1 AND item_id = $2 AND deleted_at IS NULL;
 
+-- name: GetItemVariantsByIds :many
+SELECT * FROM item_variant WHERE org_id = $1 AND id = ANY(@ids::uuid[]) AND deleted_at IS NULL;
+
+-- name: GetItemVariantsByBarcodes :many
+SELECT * FROM item_variant WHERE org_id = $1 AND deleted_at IS NULL
+  AND (ean13 = ANY(@ean13s::bigint[]) OR article = ANY(@articles::text[]));
+
+-- name: GetItemVariantsByItemIds :many
+SELECT * FROM item_variant WHERE org_id = $1 AND item_id = ANY(@item_ids::uuid[]) AND deleted_at IS NULL;
+
 -- name: UpdateItemVariant :one
-UPDATE item_variant SET name = $4, article = $5, ean13 = $6 WHERE org_id = $1 AND item_id = $2 AND id = $3 AND deleted_at IS NULL RETURNING *;
+UPDATE item_variant SET name = $4, article = $5, ean13 = $6, tracking_mode = $7, allocation_policy = $8, requires_serial = $9 WHERE org_id = $1 AND item_id = $2 AND id = $3 AND deleted_at IS NULL RETURNING *;
+
+-- name: ItemVariantHasStock :one
+SELECT (EXISTS (SELECT 1 FROM item_instance WHERE item_instance.org_id = $1 AND item_instance.variant_id = @variant_id AND item_instance.deleted_at IS NULL)
+    OR EXISTS (SELECT 1 FROM stock_line WHERE stock_line.org_id = $1 AND stock_line.variant_id = @variant_id AND stock_line.deleted_at IS NULL))::boolean AS has_stock;
 
 -- name: DeleteItemVariant :exec
 UPDATE item_variant SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND item_id = $2 AND id = $3;
@@ -179,7 +276,7 @@ UPDATE app_user_session SET revoked_at = CURRENT_TIMESTAMP WHERE id = $1;
 
 -- Item Instances
 -- name: CreateItemInstance :one
-INSERT INTO item_instance (org_id, item_id, variant_id, cell_id, status) VALUES ($1, $2, $3, $4, $5) RETURNING *;
+INSERT INTO item_instance (org_id, item_id, variant_id, cell_id, status, affected_by_task_id, serial_number, lot_number, manufactured_at, expires_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *;
 
 -- name: GetItemInstancesForItem :many
 SELECT * FROM item_instance WHERE org_id = $1 AND item_id = $2 AND deleted_at IS NULL;
@@ -190,6 +287,20 @@ SELECT * FROM item_instance WHERE org_id = $1 AND cell_id = $2 AND deleted_at IS
 -- name: GetItemInstance :one
 SELECT * FROM item_instance WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL;
 
+-- name: GetItemInstanceForUpdate :one
+SELECT * FROM item_instance WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE;
+
+-- name: LockItemInstances :many
+-- Rows are locked in id order, so concurrent callers do not deadlock
+SELECT id FROM item_instance WHERE org_id = $1 AND id = ANY(@ids::uuid[]) AND deleted_at IS NULL
+ORDER BY id FOR UPDATE;
+
+-- name: GetItemInstancesWithItemByIds :many
+SELECT sqlc.embed(item_instance), sqlc.embed(item_variant), sqlc.embed(item) FROM item_instance
+JOIN item_variant ON item_variant.id = item_instance.variant_id
+JOIN item ON item.id = item_instance.item_id
+WHERE item_instance.org_id = $1 AND item_instance.id = ANY(@ids::uuid[]) AND item_instance.deleted_at IS NULL;
+
 -- name: GetItemInstancesForCellsGroup :many
 SELECT * FROM item_instance WHERE item_instance.org_id = $1 AND cell_id IN (SELECT id FROM cell WHERE cells_group_id = $2 AND deleted_at IS NULL) AND deleted_at IS NULL;
 
@@ -199,8 +310,89 @@ UPDATE item_instance SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id
 -- name: GetItemInstancesAll :many
 SELECT * FROM item_instance WHERE org_id = $1 AND deleted_at IS NULL;
 
+-- name: GetItemInstancesForCells :many
+SELECT * FROM item_instance WHERE org_id = $1 AND cell_id = ANY(@cell_ids::uuid[]) AND status NOT IN ('consumed', 'disposed', 'shipped') AND deleted_at IS NULL;
+
+-- name: SearchItemInstanceIdsBySerialNumber :many
+-- Instances with the exact serial number or a serial number containing the
+-- search pattern
+SELECT id FROM item_instance
+WHERE org_id = $1 AND deleted_at IS NULL AND serial_number IS NOT NULL
+  AND (sqlc.narg(serial_number)::text IS NULL OR serial_number = sqlc.narg(serial_number))
+  AND (sqlc.narg(pattern)::text IS NULL OR serial_number ILIKE sqlc.narg(pattern))
+ORDER BY serial_number, id
+LIMIT @max_rows::int;
+
+-- name: GetExpiringInstances :many
+-- Instances in stock expiring up to the given day, already expired ones
+-- included. Reserved instances are out of cells and belong to the unit of
+-- the task holding them.
+SELECT item_instance.* FROM item_instance
+WHERE item_instance.org_id = $1 AND item_instance.deleted_at IS NULL
+  AND item_instance.status IN ('available', 'reserved')
+  AND item_instance.expires_at <= @until::date
+  AND (
+    sqlc.narg(unit_id)::uuid IS NULL
+    OR EXISTS (
+      SELECT 1 FROM cell
+      JOIN cells_group ON cells_group.id = cell.cells_group_id
+      WHERE cell.id = item_instance.cell_id AND cells_group.unit_id = sqlc.narg(unit_id)::uuid
+    )
+    OR EXISTS (
+      SELECT 1 FROM task
+      WHERE item_instance.cell_id IS NULL AND task.id = item_instance.affected_by_task_id
+        AND task.unit_id = sqlc.narg(unit_id)::uuid
+    )
+  )
+ORDER BY item_instance.expires_at, item_instance.id;
+
 -- name: UpdateItemInstance :one
-UPDATE item_instance SET cell_id = $3, variant_id = $4 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING *;
+UPDATE item_instance SET cell_id = $3, variant_id = $4, serial_number = $5, lot_number = $6, manufactured_at = $7, expires_at = $8 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING *;
+
+-- Stock Lines
+-- name: CreateStockLine :one
+INSERT INTO stock_line (org_id, item_id, variant_id, cell_id, status, quantity, affected_by_task_id) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
+
+-- name: GetStockLine :one
+SELECT * FROM stock_line WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL;
+
+-- name: GetStockLineForUpdate :one
+SELECT * FROM stock_line WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE;
+
+-- name: GetStockLinesForUpdate :many
+SELECT * FROM stock_line WHERE org_id = $1 AND id = ANY(@ids::uuid[]) AND deleted_at IS NULL ORDER BY id FOR UPDATE;
+
+-- name: GetStockLinesWithItemByIds :many
+SELECT sqlc.embed(stock_line), sqlc.embed(item_variant), sqlc.embed(item) FROM stock_line
+JOIN item_variant ON item_variant.id = stock_line.variant_id
+JOIN item ON item.id = stock_line.item_id
+WHERE stock_line.org_id = $1 AND stock_line.id = ANY(@ids::uuid[]) AND stock_line.deleted_at IS NULL;
+
+-- name: GetStockLines :many
+SELECT * FROM stock_line
+WHERE org_id = $1 AND deleted_at IS NULL
+  AND (sqlc.narg(item_id)::uuid IS NULL OR item_id = sqlc.narg(item_id))
+  AND (sqlc.narg(variant_id)::uuid IS NULL OR variant_id = sqlc.narg(variant_id))
+  AND (sqlc.narg(cell_id)::uuid IS NULL OR cell_id = sqlc.narg(cell_id))
+  AND (sqlc.narg(status)::item_instance_status IS NULL OR status = sqlc.narg(status))
+ORDER BY created_at, id;
+
+-- name: SetStockLineQuantity :exec
+UPDATE stock_line SET quantity = $3 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL;
+
+-- name: MoveStockLine :exec
+UPDATE stock_line SET cell_id = $3, status = $4, affected_by_task_id = $5 WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL;
+
+-- name: StockLinesInOpenTasks :one
+SELECT EXISTS (
+    SELECT 1 FROM task_item
+    JOIN task ON task.id = task_item.task_id
+    WHERE task_item.org_id = $1 AND task_item.stock_line_id = ANY(@ids::uuid[])
+      AND task_item.status IN ('pending', 'picked') AND task.status IN ('pending', 'in_progress', 'ready')
+);
+
+-- name: DeleteStockLines :exec
+UPDATE stock_line SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = ANY(@ids::uuid[]) AND deleted_at IS NULL;
 
 -- User
 -- name: CreateUser :one
@@ -245,16 +437,47 @@ JOIN app_role_binding ON app_user.id = app_role_binding.user_id
 JOIN app_role ON app_role_binding.role_id = app_role.id
 WHERE app_role_binding.org_id = $1 AND app_role_binding.user_id = $2;
 
+-- name: GetEmployeesByUserIds :many
+SELECT sqlc.embed(app_user), sqlc.embed(app_role) FROM app_user
+JOIN app_role_binding ON app_user.id = app_role_binding.user_id
+JOIN app_role ON app_role_binding.role_id = app_role.id
+WHERE app_role_binding.org_id = $1 AND app_role_binding.user_id = ANY(@user_ids::uuid[]);
+
+-- name: GetWorkerProfile :one
+SELECT * FROM app_worker_profile WHERE org_id = $1 AND user_id = $2;
+
+-- name: GetWorkerProfiles :many
+SELECT * FROM app_worker_profile WHERE org_id = $1;
+
+-- name: UpsertWorkerProfile :one
+INSERT INTO app_worker_profile (org_id, user_id, unit_ids, skills, on_shift) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (org_id, user_id) DO UPDATE SET
+    unit_ids = EXCLUDED.unit_ids, skills = EXCLUDED.skills, on_shift = EXCLUDED.on_shift, updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: SetWorkerOnShift :one
+INSERT INTO app_worker_profile (org_id, user_id, on_shift) VALUES ($1, $2, $3)
+ON CONFLICT (org_id, user_id) DO UPDATE SET on_shift = EXCLUDED.on_shift, updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
 -- Audit Log
 -- name: GetObjectTypeById :one
 SELECT * FROM object_type WHERE id = $1;
 
 -- name: CreateObjectChange :one
-INSERT INTO app_object_change (org_id, user_id, action, target_object_type, target_object_id, prechange_state, postchange_state) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
+INSERT INTO app_object_change (org_id, user_id, action, target_object_type, target_object_id, prechange_state, postchange_state, reason) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
 
 -- name: GetObjectChanges :many
 SELECT * FROM app_object_change WHERE org_id = $1 AND target_object_type = $2 AND target_object_id = $3;
 
+-- name: GetItemInstanceChangesBySerialNumber :many
+-- Changes of every instance which carried the serial number, including
+-- deleted ones
+SELECT * FROM app_object_change
+WHERE org_id = $1 AND target_object_type = 7
+  AND (postchange_state->>'serial_number' = @serial_number::text OR prechange_state->>'serial_number' = @serial_number::text)
+ORDER BY time, id;
+
 
 -- name: GetEmployeeByUserId :one
 SELECT 
@@ -287,17 +510,134 @@ SELECT * FROM app_api_token WHERE org_id = $1 AND id = $2 AND revoked_at IS NULL
 
 -- Tasks
 -- name: CreateTask :one
-INSERT INTO task (org_id, unit_id, type, name, description, assigned_to_user_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
+INSERT INTO task (org_id, unit_id, type, name, description, assigned_to_user_id, receiving_cell_id, original_task_id, priority, due_at, template_id, transfer_order_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *;
 
 -- name: CreateTaskItem :one
 INSERT INTO task_item (org_id, task_id, item_instance_id, source_cell_id, destination_cell_id) VALUES ($1, $2, $3, $4, $5) RETURNING *;
 
+-- name: CreateTaskStockLineItem :one
+INSERT INTO task_item (org_id, task_id, stock_line_id, quantity, source_cell_id, destination_cell_id, status) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
+
 -- name: GetTasks :many
-SELECT * FROM task WHERE org_id = $1;
+SELECT * FROM task WHERE org_id = $1 AND deleted_at IS NULL
+ORDER BY priority DESC, due_at ASC NULLS LAST, created_at ASC;
+
+-- Task listing uses keyset pagination with one query per sort field and
+-- direction. The cursor compares raw columns in the order of the matching
+-- task_org_*_idx index, so pages are read from the index instead of sorting
+-- every task of the organization.
+-- name: ListTasksByCreatedAtAsc :many
+SELECT * FROM task
+WHERE org_id = @org_id
+  AND deleted_at IS NULL
+  AND (sqlc.narg(statuses)::text[] IS NULL OR status::text = ANY(sqlc.narg(statuses)::text[]))
+  AND (sqlc.narg(type)::task_type IS NULL OR type = sqlc.narg(type)::task_type)
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id = sqlc.narg(unit_id)::uuid)
+  AND (sqlc.narg(assigned_to_user_id)::uuid IS NULL OR assigned_to_user_id = sqlc.narg(assigned_to_user_id)::uuid)
+  AND (sqlc.narg(created_from)::timestamp IS NULL OR created_at >= sqlc.narg(created_from)::timestamp)
+  AND (sqlc.narg(created_to)::timestamp IS NULL OR created_at < sqlc.narg(created_to)::timestamp)
+  AND (sqlc.narg(completed_from)::timestamp IS NULL OR completed_at >= sqlc.narg(completed_from)::timestamp)
+  AND (sqlc.narg(completed_to)::timestamp IS NULL OR completed_at < sqlc.narg(completed_to)::timestamp)
+  AND (sqlc.narg(search)::text IS NULL OR name ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(cursor_id)::uuid IS NULL OR (created_at, id) > (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid))
+ORDER BY created_at, id
+LIMIT @page_size::int;
+
+-- name: ListTasksByCreatedAtDesc :many
+SELECT * FROM task
+WHERE org_id = @org_id
+  AND deleted_at IS NULL
+  AND (sqlc.narg(statuses)::text[] IS NULL OR status::text = ANY(sqlc.narg(statuses)::text[]))
+  AND (sqlc.narg(type)::task_type IS NULL OR type = sqlc.narg(type)::task_type)
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id = sqlc.narg(unit_id)::uuid)
+  AND (sqlc.narg(assigned_to_user_id)::uuid IS NULL OR assigned_to_user_id = sqlc.narg(assigned_to_user_id)::uuid)
+  AND (sqlc.narg(created_from)::timestamp IS NULL OR created_at >= sqlc.narg(created_from)::timestamp)
+  AND (sqlc.narg(created_to)::timestamp IS NULL OR created_at < sqlc.narg(created_to)::timestamp)
+  AND (sqlc.narg(completed_from)::timestamp IS NULL OR completed_at >= sqlc.narg(completed_from)::timestamp)
+  AND (sqlc.narg(completed_to)::timestamp IS NULL OR completed_at < sqlc.narg(completed_to)::timestamp)
+  AND (sqlc.narg(search)::text IS NULL OR name ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(cursor_id)::uuid IS NULL OR (created_at, id) < (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT @page_size::int;
+
+-- name: ListTasksByNameAsc :many
+SELECT * FROM task
+WHERE org_id = @org_id
+  AND deleted_at IS NULL
+  AND (sqlc.narg(statuses)::text[] IS NULL OR status::text = ANY(sqlc.narg(statuses)::text[]))
+  AND (sqlc.narg(type)::task_type IS NULL OR type = sqlc.narg(type)::task_type)
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id = sqlc.narg(unit_id)::uuid)
+  AND (sqlc.narg(assigned_to_user_id)::uuid IS NULL OR assigned_to_user_id = sqlc.narg(assigned_to_user_id)::uuid)
+  AND (sqlc.narg(created_from)::timestamp IS NULL OR created_at >= sqlc.narg(created_from)::timestamp)
+  AND (sqlc.narg(created_to)::timestamp IS NULL OR created_at < sqlc.narg(created_to)::timestamp)
+  AND (sqlc.narg(completed_from)::timestamp IS NULL OR completed_at >= sqlc.narg(completed_from)::timestamp)
+  AND (sqlc.narg(completed_to)::timestamp IS NULL OR completed_at < sqlc.narg(completed_to)::timestamp)
+  AND (sqlc.narg(search)::text IS NULL OR name ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(cursor_id)::uuid IS NULL OR (name, id) > (sqlc.narg(cursor_name)::text, sqlc.narg(cursor_id)::uuid))
+ORDER BY name, id
+LIMIT @page_size::int;
+
+-- name: ListTasksByNameDesc :many
+SELECT * FROM task
+WHERE org_id = @org_id
+  AND deleted_at IS NULL
+  AND (sqlc.narg(statuses)::text[] IS NULL OR status::text = ANY(sqlc.narg(statuses)::text[]))
+  AND (sqlc.narg(type)::task_type IS NULL OR type = sqlc.narg(type)::task_type)
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id = sqlc.narg(unit_id)::uuid)
+  AND (sqlc.narg(assigned_to_user_id)::uuid IS NULL OR assigned_to_user_id = sqlc.narg(assigned_to_user_id)::uuid)
+  AND (sqlc.narg(created_from)::timestamp IS NULL OR created_at >= sqlc.narg(created_from)::timestamp)
+  AND (sqlc.narg(created_to)::timestamp IS NULL OR created_at < sqlc.narg(created_to)::timestamp)
+  AND (sqlc.narg(completed_from)::timestamp IS NULL OR completed_at >= sqlc.narg(completed_from)::timestamp)
+  AND (sqlc.narg(completed_to)::timestamp IS NULL OR completed_at < sqlc.narg(completed_to)::timestamp)
+  AND (sqlc.narg(search)::text IS NULL OR name ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(cursor_id)::uuid IS NULL OR (name, id) < (sqlc.narg(cursor_name)::text, sqlc.narg(cursor_id)::uuid))
+ORDER BY name DESC, id DESC
+LIMIT @page_size::int;
+
+-- name: ListTasksByPriorityAsc :many
+SELECT * FROM task
+WHERE org_id = @org_id
+  AND deleted_at IS NULL
+  AND (sqlc.narg(statuses)::text[] IS NULL OR status::text = ANY(sqlc.narg(statuses)::text[]))
+  AND (sqlc.narg(type)::task_type IS NULL OR type = sqlc.narg(type)::task_type)
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id = sqlc.narg(unit_id)::uuid)
+  AND (sqlc.narg(assigned_to_user_id)::uuid IS NULL OR assigned_to_user_id = sqlc.narg(assigned_to_user_id)::uuid)
+  AND (sqlc.narg(created_from)::timestamp IS NULL OR created_at >= sqlc.narg(created_from)::timestamp)
+  AND (sqlc.narg(created_to)::timestamp IS NULL OR created_at < sqlc.narg(created_to)::timestamp)
+  AND (sqlc.narg(completed_from)::timestamp IS NULL OR completed_at >= sqlc.narg(completed_from)::timestamp)
+  AND (sqlc.narg(completed_to)::timestamp IS NULL OR completed_at < sqlc.narg(completed_to)::timestamp)
+  AND (sqlc.narg(search)::text IS NULL OR name ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(cursor_id)::uuid IS NULL OR (priority, created_at, id) > (sqlc.narg(cursor_priority)::task_priority, sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid))
+ORDER BY priority, created_at, id
+LIMIT @page_size::int;
+
+-- name: ListTasksByPriorityDesc :many
+SELECT * FROM task
+WHERE org_id = @org_id
+  AND deleted_at IS NULL
+  AND (sqlc.narg(statuses)::text[] IS NULL OR status::text = ANY(sqlc.narg(statuses)::text[]))
+  AND (sqlc.narg(type)::task_type IS NULL OR type = sqlc.narg(type)::task_type)
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id = sqlc.narg(unit_id)::uuid)
+  AND (sqlc.narg(assigned_to_user_id)::uuid IS NULL OR assigned_to_user_id = sqlc.narg(assigned_to_user_id)::uuid)
+  AND (sqlc.narg(created_from)::timestamp IS NULL OR created_at >= sqlc.narg(created_from)::timestamp)
+  AND (sqlc.narg(created_to)::timestamp IS NULL OR created_at < sqlc.narg(created_to)::timestamp)
+  AND (sqlc.narg(completed_from)::timestamp IS NULL OR completed_at >= sqlc.narg(completed_from)::timestamp)
+  AND (sqlc.narg(completed_to)::timestamp IS NULL OR completed_at < sqlc.narg(completed_to)::timestamp)
+  AND (sqlc.narg(search)::text IS NULL OR name ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(cursor_id)::uuid IS NULL OR (priority, created_at, id) < (sqlc.narg(cursor_priority)::task_priority, sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid))
+ORDER BY priority DESC, created_at DESC, id DESC
+LIMIT @page_size::int;
+
+-- name: GetTasksAssignedToUser :many
+SELECT * FROM task WHERE org_id = $1 AND assigned_to_user_id = $2 AND status IN ('pending', 'in_progress', 'ready') AND deleted_at IS NULL
+ORDER BY priority DESC, due_at ASC NULLS LAST, created_at ASC;
 
 -- name: GetTaskById :one
 SELECT * FROM task WHERE org_id = $1 AND id = $2;
 
+-- name: GetTaskByIdForUpdate :one
+SELECT * FROM task WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE;
+
 -- name: GetTaskItems :many
 SELECT * FROM task_item WHERE org_id = $1 AND task_id = $2;
 
@@ -307,11 +647,479 @@ UPDATE item_instance SET status = $3, affected_by_task_id = $4 WHERE org_id = $1
 -- name: SetItemInstanceCell :exec
 UPDATE item_instance SET cell_id = $3 WHERE org_id = $1 AND id = $2;
 
+-- name: MoveItemInstance :exec
+UPDATE item_instance SET cell_id = $3, status = $4, affected_by_task_id = $5 WHERE org_id = $1 AND id = $2;
+
+-- name: GetTaskReservedStockLines :many
+SELECT * FROM stock_line WHERE org_id = $1 AND affected_by_task_id = @task_id AND status = 'reserved' AND deleted_at IS NULL;
+
+-- name: ShipTaskStockLines :exec
+UPDATE stock_line SET status = 'shipped', cell_id = NULL
+WHERE org_id = $1 AND affected_by_task_id = @task_id AND status = 'reserved' AND deleted_at IS NULL;
+
+-- name: GetTaskReservedInstanceIds :many
+SELECT id FROM item_instance WHERE org_id = $1 AND affected_by_task_id = @task_id AND status = 'reserved' AND deleted_at IS NULL;
+
+-- name: ShipTaskInstances :exec
+UPDATE item_instance SET status = 'shipped', cell_id = NULL
+WHERE org_id = $1 AND affected_by_task_id = @task_id AND status = 'reserved' AND deleted_at IS NULL;
+
+-- name: DispatchTaskInstances :exec
+-- Instances picked by the outbound leg of a transfer order leave the unit
+UPDATE item_instance SET status = 'in_transit', cell_id = NULL
+WHERE org_id = $1 AND affected_by_task_id = @task_id AND status = 'reserved' AND deleted_at IS NULL;
+
+-- name: SetPickedTaskItemsDone :exec
+UPDATE task_item SET status = 'done' WHERE org_id = $1 AND task_id = $2 AND status = 'picked';
+
+-- name: GetTaskItem :one
+SELECT * FROM task_item WHERE org_id = $1 AND task_id = $2 AND item_instance_id = $3;
+
+-- name: GetTaskItemForUpdate :one
+SELECT * FROM task_item WHERE org_id = $1 AND task_id = $2 AND item_instance_id = $3 FOR UPDATE;
+
 -- name: SetTaskItemStatus :exec
-UPDATE task_item SET status = $3 WHERE org_id = $1 AND item_instance_id = $2;
+UPDATE task_item SET status = $4 WHERE org_id = $1 AND task_id = $2 AND item_instance_id = $3;
+
+-- name: GetTaskStockLineItemForUpdate :one
+SELECT * FROM task_item WHERE org_id = $1 AND task_id = $2 AND stock_line_id = $3 FOR UPDATE;
+
+-- name: SetTaskStockLineItem :exec
+-- Picking a part of a stock line moves the task item to the split off line
+UPDATE task_item SET stock_line_id = @stock_line_id, status = @status WHERE org_id = $1 AND id = @id;
+
+-- name: SetTaskAssignee :one
+UPDATE task SET assigned_to_user_id = $3, assigned_at = $4 WHERE org_id = $1 AND id = $2 RETURNING *;
+
+-- name: UpsertTaskPickupCode :one
+INSERT INTO task_pickup_code (task_id, org_id, unit_id, code) VALUES ($1, $2, $3, $4)
+ON CONFLICT (task_id) DO UPDATE SET
+    code = EXCLUDED.code, failed_attempts = 0, created_at = CURRENT_TIMESTAMP, locked_at = NULL, used_at = NULL
+RETURNING *;
+
+-- name: GetTaskPickupCode :one
+SELECT * FROM task_pickup_code WHERE org_id = $1 AND task_id = $2;
+
+-- name: GetTaskPickupCodeForUpdate :one
+SELECT * FROM task_pickup_code WHERE org_id = $1 AND task_id = $2 FOR UPDATE;
+
+-- name: RecordTaskPickupCodeFailure :one
+UPDATE task_pickup_code SET
+    failed_attempts = failed_attempts + 1,
+    locked_at = CASE WHEN failed_attempts + 1 >= @max_attempts::int THEN CURRENT_TIMESTAMP ELSE locked_at END
+WHERE org_id = $1 AND task_id = $2
+RETURNING *;
+
+-- name: UseTaskPickupCode :one
+UPDATE task_pickup_code SET used_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND task_id = $2 RETURNING *;
+
+-- name: GetActiveUnitPickupCodes :many
+SELECT sqlc.embed(task_pickup_code), task.name AS task_name FROM task_pickup_code
+JOIN task ON task.id = task_pickup_code.task_id
+WHERE task_pickup_code.org_id = $1 AND task_pickup_code.unit_id = $2
+  AND task_pickup_code.used_at IS NULL AND task_pickup_code.locked_at IS NULL
+  AND task.status = 'ready' AND task.deleted_at IS NULL
+ORDER BY task_pickup_code.created_at;
+
+-- name: CountOpenTasksByAssignee :many
+SELECT assigned_to_user_id, COUNT(*)::int AS open_tasks FROM task
+WHERE org_id = $1 AND assigned_to_user_id = ANY(@user_ids::uuid[])
+  AND status IN ('pending', 'in_progress') AND deleted_at IS NULL
+GROUP BY assigned_to_user_id;
+
+-- name: GetPendingTasksAssignedToUserForUpdate :many
+SELECT * FROM task
+WHERE org_id = $1 AND assigned_to_user_id = $2 AND status = 'pending' AND deleted_at IS NULL
+ORDER BY created_at
+FOR UPDATE;
+
+-- name: GetUnitLastAssignedUserForUpdate :one
+-- Serializes round robin assignment in the unit, does not block task inserts
+SELECT last_assigned_user_id FROM org_unit WHERE org_id = $1 AND id = $2 FOR NO KEY UPDATE;
+
+-- name: SetUnitLastAssignedUser :exec
+UPDATE org_unit SET last_assigned_user_id = $3 WHERE org_id = $1 AND id = $2;
+
+-- name: ClaimTask :one
+UPDATE task SET assigned_to_user_id = $3, assigned_at = CURRENT_TIMESTAMP
+WHERE org_id = $1 AND id = $2 AND assigned_to_user_id IS NULL
+  AND status IN ('pending', 'in_progress', 'ready') AND deleted_at IS NULL
+RETURNING *;
+
+-- name: CreateTaskExpectedItem :one
+INSERT INTO task_expected_item (org_id, task_id, variant_id, expected_quantity) VALUES ($1, $2, $3, $4) RETURNING *;
+
+-- name: GetTaskExpectedItems :many
+SELECT * FROM task_expected_item WHERE org_id = $1 AND task_id = $2 ORDER BY created_at, id;
+
+-- name: GetTaskExpectedItemForUpdate :one
+SELECT * FROM task_expected_item WHERE org_id = $1 AND task_id = $2 AND variant_id = $3 FOR UPDATE;
+
+-- name: AddTaskExpectedItemReceived :one
+UPDATE task_expected_item SET received_quantity = received_quantity + @quantity::int WHERE org_id = $1 AND id = $2 RETURNING *;
+
+-- name: SetTaskExpectedItemsDiscrepancies :exec
+UPDATE task_expected_item SET discrepancy = CASE
+    WHEN received_quantity > expected_quantity THEN 'over'::task_discrepancy_type
+    WHEN received_quantity < expected_quantity THEN 'under'::task_discrepancy_type
+END
+WHERE org_id = $1 AND task_id = $2;
+
+-- name: CreateTaskCountCell :exec
+INSERT INTO task_count_cell (org_id, task_id, cell_id) VALUES ($1, $2, $3);
+
+-- name: GetTaskCountCells :many
+SELECT * FROM task_count_cell WHERE org_id = $1 AND task_id = $2;
+
+-- name: GetTaskCountCellForUpdate :one
+SELECT * FROM task_count_cell WHERE org_id = $1 AND task_id = $2 AND cell_id = $3 FOR UPDATE;
+
+-- name: SetTaskCountCellCounted :exec
+UPDATE task_count_cell SET counted_at = CURRENT_TIMESTAMP, counted_by_user_id = $4 WHERE org_id = $1 AND task_id = $2 AND cell_id = $3;
+
+-- name: DeleteTaskCountEntriesForCell :exec
+DELETE FROM task_count_entry WHERE org_id = $1 AND task_id = $2 AND cell_id = $3;
+
+-- name: CreateTaskCountEntry :exec
+INSERT INTO task_count_entry (org_id, task_id, cell_id, item_instance_id, variant_id, quantity) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetTaskCountEntries :many
+SELECT * FROM task_count_entry WHERE org_id = $1 AND task_id = $2;
+
+-- name: GetFrozenCellIds :many
+SELECT DISTINCT task_count_cell.cell_id FROM task_count_cell
+JOIN task ON task.id = task_count_cell.task_id
+WHERE task_count_cell.org_id = $1 AND task_count_cell.cell_id = ANY(@cell_ids::uuid[])
+  AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL;
+
+-- name: GetOpenReturnTaskItemsForInstances :many
+SELECT task_item.* FROM task_item
+JOIN task ON task.id = task_item.task_id
+WHERE task_item.org_id = $1 AND task_item.item_instance_id = ANY(@instance_ids::uuid[])
+  AND task_item.status = 'pending' AND task.type = 'return'
+  AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL;
+
+-- name: SetTaskItemDisposition :exec
+UPDATE task_item SET status = 'done', disposition = @disposition, destination_cell_id = @destination_cell_id
+WHERE org_id = $1 AND task_id = $2 AND item_instance_id = $3;
+
+-- name: CreateTaskStatusHistory :exec
+INSERT INTO task_status_history (org_id, task_id, from_status, to_status, changed_by_user_id, comment) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetTaskStatusHistory :many
+SELECT * FROM task_status_history WHERE org_id = $1 AND task_id = $2 ORDER BY changed_at, id;
+
+-- name: GetOverdueTasks :many
+SELECT * FROM task
+WHERE due_at < (now() AT TIME ZONE 'UTC') AND sla_breached_at IS NULL
+  AND status IN ('pending', 'in_progress', 'ready') AND deleted_at IS NULL
+ORDER BY due_at
+LIMIT @max_rows::int;
+
+-- name: MarkTaskSlaBreached :one
+-- Every overdue open task is marked once, even with several server instances
+UPDATE task SET sla_breached_at = (now() AT TIME ZONE 'UTC')
+WHERE org_id = $1 AND id = $2
+  AND due_at < (now() AT TIME ZONE 'UTC') AND sla_breached_at IS NULL
+  AND status IN ('pending', 'in_progress', 'ready') AND deleted_at IS NULL
+RETURNING *;
 
 -- name: UpdateTask :one
-UPDATE task SET status = $3 WHERE org_id = $1 AND id = $2 RETURNING *;
+UPDATE task SET status = $3, completed_at = $4 WHERE org_id = $1 AND id = $2 RETURNING *;
+
+-- Task Item Exceptions
+-- name: CreateTaskItemException :one
+INSERT INTO task_item_exception (org_id, task_id, item_instance_id, reason, note, substitute_instance_id, reported_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
+
+-- name: GetTaskItemExceptions :many
+SELECT * FROM task_item_exception WHERE org_id = $1 AND task_id = $2 ORDER BY created_at ASC;
+
+-- name: GetTaskItemExceptionsReport :many
+SELECT task_item_exception.* FROM task_item_exception
+JOIN task ON task.id = task_item_exception.task_id
+WHERE task_item_exception.org_id = $1 AND task.deleted_at IS NULL
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR task.unit_id = sqlc.narg(unit_id))
+  AND (sqlc.narg(reason)::task_item_exception_reason IS NULL OR task_item_exception.reason = sqlc.narg(reason))
+  AND (sqlc.narg(created_from)::timestamp IS NULL OR task_item_exception.created_at >= sqlc.narg(created_from))
+  AND (sqlc.narg(created_to)::timestamp IS NULL OR task_item_exception.created_at < sqlc.narg(created_to))
+ORDER BY task_item_exception.created_at DESC
+LIMIT @max_rows::int;
+
+-- Task Comments
+-- name: CreateTaskComment :one
+INSERT INTO task_comment (org_id, task_id, item_instance_id, body, author_user_id) VALUES ($1, $2, $3, $4, $5) RETURNING *;
+
+-- name: GetTaskComments :many
+SELECT * FROM task_comment
+WHERE org_id = $1 AND task_id = $2 AND deleted_at IS NULL
+  AND (sqlc.narg(item_instance_id)::uuid IS NULL OR item_instance_id = sqlc.narg(item_instance_id))
+ORDER BY created_at, id;
+
+-- name: GetTaskComment :one
+SELECT * FROM task_comment WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL;
+
+-- name: UpdateTaskComment :one
+UPDATE task_comment SET body = $4, edited_at = CURRENT_TIMESTAMP
+WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL RETURNING *;
+
+-- name: DeleteTaskComment :exec
+UPDATE task_comment SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL;
+
+-- Task Attachments
+-- name: CreateTaskAttachment :one
+INSERT INTO task_attachment (id, org_id, task_id, item_instance_id, comment_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *;
+
+-- name: GetTaskAttachments :many
+SELECT * FROM task_attachment
+WHERE org_id = $1 AND task_id = $2 AND deleted_at IS NULL
+  AND (sqlc.narg(item_instance_id)::uuid IS NULL OR item_instance_id = sqlc.narg(item_instance_id))
+ORDER BY created_at, id;
+
+-- name: GetTaskAttachment :one
+SELECT * FROM task_attachment WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL;
+
+-- name: DeleteTaskAttachment :exec
+UPDATE task_attachment SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND task_id = $2 AND id = $3 AND deleted_at IS NULL;
+
+-- name: GetSubstituteInstances :many
+-- Available unexpired instances of the variant stored in the unit which are
+-- not taken by an open task and not in a frozen cell. Instances expiring first
+-- come first when the variant is allocated first expired first out.
+SELECT item_instance.* FROM item_instance
+JOIN cell ON cell.id = item_instance.cell_id AND cell.deleted_at IS NULL
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+JOIN item_variant ON item_variant.id = item_instance.variant_id
+JOIN org ON org.id = item_instance.org_id
+WHERE item_instance.org_id = $1 AND item_instance.variant_id = @variant_id
+  AND cells_group.unit_id = @unit_id
+  AND item_instance.status = 'available' AND item_instance.deleted_at IS NULL
+  AND (item_instance.expires_at IS NULL OR item_instance.expires_at >= CURRENT_DATE)
+  AND (sqlc.narg(instance_id)::uuid IS NULL OR item_instance.id = sqlc.narg(instance_id))
+  AND NOT EXISTS (
+    SELECT 1 FROM task_item
+    JOIN task ON task.id = task_item.task_id
+    WHERE task_item.item_instance_id = item_instance.id AND task_item.status IN ('pending', 'picked')
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM task_count_cell
+    JOIN task ON task.id = task_count_cell.task_id
+    WHERE task_count_cell.cell_id = item_instance.cell_id
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+ORDER BY
+  CASE WHEN COALESCE(item_variant.allocation_policy, org.allocation_policy) = 'fefo' THEN item_instance.expires_at END NULLS LAST,
+  cell.row, cell.position, cell.level, item_instance.created_at
+LIMIT @max_rows::int;
+
+-- Pick Waves
+-- name: CreatePickWave :one
+INSERT INTO pick_wave (org_id, unit_id, name, consolidation_cell_id) VALUES ($1, $2, $3, $4) RETURNING *;
+
+-- name: GetPickWaves :many
+SELECT * FROM pick_wave WHERE org_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC;
+
+-- name: GetPickWaveById :one
+SELECT * FROM pick_wave WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL;
+
+-- name: GetPickWaveByIdForUpdate :one
+SELECT * FROM pick_wave WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE;
+
+-- name: SetPickWaveStatus :one
+UPDATE pick_wave SET status = $3, completed_at = $4 WHERE org_id = $1 AND id = $2 RETURNING *;
+
+-- Transfer Orders
+-- name: CreateTransferOrder :one
+INSERT INTO transfer_order (org_id, source_unit_id, destination_unit_id, receiving_cell_id, name) VALUES ($1, $2, $3, $4, $5) RETURNING *;
+
+-- name: GetTransferOrders :many
+SELECT * FROM transfer_order WHERE org_id = $1 AND deleted_at IS NULL
+  AND (sqlc.narg(status)::transfer_order_status IS NULL OR status = sqlc.narg(status))
+ORDER BY created_at DESC;
+
+-- name: GetTransferOrderById :one
+SELECT * FROM transfer_order WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL;
+
+-- name: GetTransferOrderByIdForUpdate :one
+SELECT * FROM transfer_order WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL FOR UPDATE;
+
+-- name: SetTransferOrderStatus :one
+UPDATE transfer_order SET status = $3, dispatched_at = $4, received_at = $5 WHERE org_id = $1 AND id = $2 RETURNING *;
+
+-- name: GetTransferOrdersTasks :many
+SELECT * FROM task WHERE org_id = $1 AND transfer_order_id = ANY(@transfer_order_ids::uuid[]) AND deleted_at IS NULL;
+
+-- name: GetTaskInTransitVariantCounts :many
+SELECT variant_id, COUNT(*)::int AS quantity FROM item_instance
+WHERE org_id = $1 AND affected_by_task_id = @task_id AND status = 'in_transit' AND deleted_at IS NULL
+GROUP BY variant_id ORDER BY variant_id;
+
+-- name: GetTaskInTransitInstanceIds :many
+SELECT id FROM item_instance
+WHERE org_id = $1 AND affected_by_task_id = @task_id AND status = 'in_transit' AND deleted_at IS NULL
+  AND (sqlc.narg(variant_id)::uuid IS NULL OR variant_id = sqlc.narg(variant_id))
+ORDER BY created_at, id;
+
+-- name: GetTaskInTransitInstancesForUpdate :many
+SELECT * FROM item_instance
+WHERE org_id = $1 AND affected_by_task_id = @task_id AND variant_id = @variant_id
+  AND status = 'in_transit' AND deleted_at IS NULL
+ORDER BY created_at, id
+LIMIT @max_rows::int
+FOR UPDATE;
+
+-- name: GetWaveCandidateTasks :many
+-- Pending pickment tasks of the unit which are not in a wave yet, most urgent
+-- first. Must match isWaveable, so the limit counts only tasks the wave can take.
+SELECT * FROM task
+WHERE task.org_id = $1 AND task.unit_id = $2 AND task.type = 'pickment' AND task.status = 'pending'
+  AND task.wave_id IS NULL AND task.deleted_at IS NULL
+  AND EXISTS (SELECT 1 FROM task_item WHERE task_item.task_id = task.id)
+  AND NOT EXISTS (
+    SELECT 1 FROM task_item WHERE task_item.task_id = task.id
+      AND (task_item.destination_cell_id IS NOT NULL OR task_item.stock_line_id IS NOT NULL)
+  )
+ORDER BY priority DESC, due_at ASC NULLS LAST, created_at ASC
+LIMIT @max_tasks::int;
+
+-- name: GetTasksByIdsForUpdate :many
+SELECT * FROM task WHERE org_id = $1 AND id = ANY(@ids::uuid[]) AND deleted_at IS NULL ORDER BY id FOR UPDATE;
+
+-- name: SetTasksWave :exec
+UPDATE task SET wave_id = @wave_id WHERE org_id = $1 AND id = ANY(@ids::uuid[]);
+
+-- name: ReleaseWaveTasks :exec
+UPDATE task SET wave_id = NULL WHERE org_id = $1 AND wave_id = @wave_id;
+
+-- name: SetWaveTaskItemsDestination :exec
+-- Items still to be sorted are sent to the consolidation cell or released with NULL
+UPDATE task_item SET destination_cell_id = sqlc.narg(destination_cell_id)
+WHERE task_item.org_id = $1 AND task_item.status IN ('pending', 'picked')
+  AND task_item.task_id IN (SELECT id FROM task WHERE task.org_id = $1 AND task.wave_id = @wave_id);
+
+-- name: GetWaveTasks :many
+SELECT * FROM task WHERE org_id = $1 AND wave_id = @wave_id AND deleted_at IS NULL
+ORDER BY priority DESC, due_at ASC NULLS LAST, created_at ASC;
+
+-- name: GetWaveTaskItems :many
+SELECT task_item.* FROM task_item
+JOIN task ON task.id = task_item.task_id
+WHERE task_item.org_id = $1 AND task.wave_id = @wave_id AND task.deleted_at IS NULL;
+
+-- name: GetWaveTaskItemByInstance :one
+SELECT task_item.* FROM task_item
+JOIN task ON task.id = task_item.task_id
+WHERE task_item.org_id = $1 AND task.wave_id = @wave_id AND task.deleted_at IS NULL
+  AND task_item.item_instance_id = @item_instance_id AND task_item.status = @status
+LIMIT 1;
+
+-- Task Templates
+-- name: CreateTaskTemplate :one
+INSERT INTO task_template (org_id, unit_id, type, priority, name, description, assigned_to_user_id, due_in_minutes, schedule, is_active, next_run_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING *;
+
+-- name: UpdateTaskTemplate :one
+UPDATE task_template SET
+    unit_id = $3, type = $4, priority = $5, name = $6, description = $7, assigned_to_user_id = $8,
+    due_in_minutes = $9, schedule = $10, is_active = $11, next_run_at = $12
+WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL RETURNING *;
+
+-- name: GetTaskTemplates :many
+SELECT * FROM task_template WHERE org_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC;
+
+-- name: GetTaskTemplateById :one
+SELECT * FROM task_template WHERE org_id = $1 AND id = $2 AND deleted_at IS NULL;
+
+-- name: DeleteTaskTemplate :exec
+UPDATE task_template SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2;
+
+-- name: CreateTaskTemplateRule :one
+INSERT INTO task_template_rule (org_id, template_id, position, item_id, variant_id, source_cells_group_id, target_cell_id, max_instances)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
+
+-- name: DeleteTaskTemplateRules :exec
+DELETE FROM task_template_rule WHERE org_id = $1 AND template_id = $2;
+
+-- name: GetTaskTemplateRules :many
+SELECT * FROM task_template_rule WHERE org_id = $1 AND template_id = ANY(@template_ids::uuid[]) ORDER BY template_id, position;
+
+-- name: GetDueTaskTemplates :many
+-- Active scheduled templates of all organizations whose next run has come
+SELECT * FROM task_template
+WHERE is_active AND deleted_at IS NULL AND next_run_at <= @now
+ORDER BY next_run_at
+LIMIT @max_rows::int;
+
+-- name: TryLockTaskTemplate :one
+-- Transaction level advisory lock taken by the replica which runs the template
+SELECT pg_try_advisory_xact_lock(hashtext('task_template'), hashtext(@template_id::text)) AS locked;
+
+-- name: SetTaskTemplateRun :exec
+UPDATE task_template SET last_run_at = @last_run_at, next_run_at = @next_run_at WHERE id = @id;
+
+-- name: GetTemplateRuleInstances :many
+-- Available unexpired instances of the unit matching a template rule which are
+-- not taken by an open task, not in a frozen cell and not in the target cell
+-- already, in allocation order of their variant
+SELECT item_instance.* FROM item_instance
+JOIN cell ON cell.id = item_instance.cell_id AND cell.deleted_at IS NULL
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+JOIN item_variant ON item_variant.id = item_instance.variant_id
+JOIN org ON org.id = item_instance.org_id
+WHERE item_instance.org_id = $1 AND cells_group.unit_id = @unit_id
+  AND item_instance.status = 'available' AND item_instance.deleted_at IS NULL
+  AND (item_instance.expires_at IS NULL OR item_instance.expires_at >= CURRENT_DATE)
+  AND (sqlc.narg(item_id)::uuid IS NULL OR item_instance.item_id = sqlc.narg(item_id))
+  AND (sqlc.narg(variant_id)::uuid IS NULL OR item_instance.variant_id = sqlc.narg(variant_id))
+  AND (sqlc.narg(source_cells_group_id)::uuid IS NULL OR cell.cells_group_id = sqlc.narg(source_cells_group_id))
+  AND (sqlc.narg(target_cell_id)::uuid IS NULL OR item_instance.cell_id <> sqlc.narg(target_cell_id))
+  AND NOT EXISTS (
+    SELECT 1 FROM task_item
+    JOIN task ON task.id = task_item.task_id
+    WHERE task_item.item_instance_id = item_instance.id AND task_item.status IN ('pending', 'picked')
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM task_count_cell
+    JOIN task ON task.id = task_count_cell.task_id
+    WHERE task_count_cell.cell_id = item_instance.cell_id
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+ORDER BY
+  CASE WHEN COALESCE(item_variant.allocation_policy, org.allocation_policy) = 'fefo' THEN item_instance.expires_at END NULLS LAST,
+  item_instance.created_at, item_instance.id
+LIMIT @max_rows::int;
+
+-- Task Import
+-- name: GetUnitCellsByAliases :many
+SELECT cell.* FROM cell
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+WHERE cell.org_id = $1 AND cells_group.unit_id = @unit_id
+  AND cell.alias = ANY(@aliases::text[]) AND cell.deleted_at IS NULL;
+
+-- name: GetAvailableUnitInstanceIds :many
+-- Instances of the unit which can be put into a new task, same conditions as
+-- for template rules
+SELECT item_instance.id FROM item_instance
+JOIN cell ON cell.id = item_instance.cell_id AND cell.deleted_at IS NULL
+JOIN cells_group ON cells_group.id = cell.cells_group_id AND cells_group.deleted_at IS NULL
+WHERE item_instance.org_id = $1 AND cells_group.unit_id = @unit_id
+  AND item_instance.id = ANY(@ids::uuid[])
+  AND item_instance.status = 'available' AND item_instance.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM task_item
+    JOIN task ON task.id = task_item.task_id
+    WHERE task_item.item_instance_id = item_instance.id AND task_item.status IN ('pending', 'picked')
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM task_count_cell
+    JOIN task ON task.id = task_count_cell.task_id
+    WHERE task_count_cell.cell_id = item_instance.cell_id
+      AND task.status IN ('pending', 'in_progress', 'ready') AND task.deleted_at IS NULL
+  );
 
 -- TV Boards
 -- name: CreateTvBoard :one
@@ -328,3 +1136,32 @@ UPDATE tv_board SET deleted_at = CURRENT_TIMESTAMP WHERE org_id = $1 AND id = $2
 
 -- name: GetTvBoardByToken :one
 SELECT * FROM tv_board WHERE token = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- Stock
+-- name: GetStockCounts :many
+-- Quantities per variant, cell and status, counting instances one by one
+-- and stock lines by their quantity. Stock out of any cell is attributed to
+-- the unit of the task which last affected it.
+WITH stock AS (
+  SELECT item_id, variant_id, cell_id, status, affected_by_task_id, 1 AS quantity
+  FROM item_instance WHERE item_instance.org_id = $1 AND item_instance.deleted_at IS NULL
+  UNION ALL
+  SELECT item_id, variant_id, cell_id, status, affected_by_task_id, quantity
+  FROM stock_line WHERE stock_line.org_id = $1 AND stock_line.deleted_at IS NULL
+)
+SELECT
+  stock.item_id::uuid AS item_id,
+  stock.variant_id::uuid AS variant_id,
+  stock.cell_id::uuid AS cell_id,
+  COALESCE(cells_group.unit_id, task.unit_id)::uuid AS unit_id,
+  stock.status::item_instance_status AS status,
+  SUM(stock.quantity)::int AS quantity
+FROM stock
+LEFT JOIN cell ON cell.id = stock.cell_id
+LEFT JOIN cells_group ON cells_group.id = cell.cells_group_id
+LEFT JOIN task ON task.id = stock.affected_by_task_id AND stock.cell_id IS NULL
+WHERE stock.status::text = ANY(@statuses::text[])
+  AND (sqlc.narg(item_id)::uuid IS NULL OR stock.item_id = sqlc.narg(item_id))
+  AND (sqlc.narg(variant_id)::uuid IS NULL OR stock.variant_id = sqlc.narg(variant_id))
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR COALESCE(cells_group.unit_id, task.unit_id) = sqlc.narg(unit_id))
+GROUP BY stock.item_id, stock.variant_id, stock.cell_id, COALESCE(cells_group.unit_id, task.unit_id), stock.status;
@@ -1,7 +1,18 @@
-CREATE TYPE task_type AS ENUM ('movement', 'pickment');
+CREATE TYPE task_type AS ENUM ('movement', 'pickment', 'receiving', 'inventory_count', 'return');
 CREATE TYPE task_status AS ENUM ('pending', 'in_progress', 'ready', 'completed', 'cancelled');
-CREATE TYPE task_item_status AS ENUM ('pending', 'picked', 'done', 'returned', 'canceled');
-CREATE TYPE item_instance_status AS ENUM ('available', 'reserved', 'consumed');
+-- declared from the lowest to the highest, so ORDER BY priority DESC puts urgent tasks first
+CREATE TYPE task_priority AS ENUM ('low', 'normal', 'high', 'urgent');
+-- short items could not be picked, the reason is kept in task_item_exception
+CREATE TYPE task_item_status AS ENUM ('pending', 'picked', 'done', 'returned', 'canceled', 'short');
+CREATE TYPE item_instance_status AS ENUM ('available', 'reserved', 'consumed', 'quarantined', 'disposed', 'shipped', 'in_transit');
+CREATE TYPE task_discrepancy_type AS ENUM ('over', 'under');
+CREATE TYPE return_disposition AS ENUM ('restock', 'quarantine', 'dispose');
+CREATE TYPE task_item_exception_reason AS ENUM ('not_found', 'damaged', 'wrong_item');
+CREATE TYPE pick_wave_status AS ENUM ('pending', 'picking', 'sorting', 'completed', 'cancelled');
+CREATE TYPE transfer_order_status AS ENUM ('pending', 'in_transit', 'received', 'cancelled');
+-- serialized variants are stored as one item_instance per piece, quantity
+-- tracked variants as stock_line rows holding a quantity
+CREATE TYPE item_tracking_mode AS ENUM ('serialized', 'quantity');
 
 
 CREATE TABLE app_user (
@@ -18,17 +29,29 @@ CREATE TABLE app_user (
 );
 CREATE INDEX app_user_email_idx ON app_user(email);
 
+-- How tasks created without an assignee are assigned to workers
+CREATE TYPE task_assignment_strategy AS ENUM ('manual', 'least_open_tasks', 'round_robin', 'skills');
+
+-- Order in which available instances are allocated to tasks: first in first
+-- out by creation, or first expired first out by expiry date
+CREATE TYPE allocation_policy AS ENUM ('fifo', 'fefo');
+
 CREATE TABLE org (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
 
     name VARCHAR(255) NOT NULL,
     subdomain VARCHAR(255) NOT NULL UNIQUE CHECK (subdomain ~ '^[a-z0-9](?:[a-z0-9-]*[a-z0-9])?$'),
+    task_assignment_strategy task_assignment_strategy NOT NULL DEFAULT 'manual',
+    allocation_policy allocation_policy NOT NULL DEFAULT 'fifo',
     
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     deleted_at TIMESTAMP
 );
 CREATE INDEX org_subdomain_idx ON org(subdomain);
 
+-- How items of a task are ordered into a walking route
+CREATE TYPE pick_path_strategy AS ENUM ('serpentine', 'sequential');
+
 CREATE TABLE org_unit (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     org_id UUID NOT NULL REFERENCES org(id),
@@ -36,6 +59,9 @@ CREATE TABLE org_unit (
     name VARCHAR(255) NOT NULL,
     alias VARCHAR(255) NOT NULL,
     address VARCHAR(255),
+    pick_path_strategy pick_path_strategy NOT NULL DEFAULT 'serpentine',
+    -- worker who got the previous task of the unit with the round robin strategy
+    last_assigned_user_id UUID REFERENCES app_user(id),
 
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     deleted_at TIMESTAMP,
@@ -91,6 +117,11 @@ CREATE TABLE item_variant (
 
     article VARCHAR(255),
     ean13 BIGINT CHECK (ean13::text ~ '^[0-9]{13}$'),
+    tracking_mode item_tracking_mode NOT NULL DEFAULT 'serialized',
+    -- overrides the allocation policy of the org when set
+    allocation_policy allocation_policy,
+    -- instances must get a manufacturer serial number when created or received
+    requires_serial BOOLEAN NOT NULL DEFAULT false,
 
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     deleted_at TIMESTAMP,
@@ -136,7 +167,98 @@ CREATE TABLE cell (
     UNIQUE (cells_group_id, row, level, position)
 );
 CREATE INDEX cell_cells_group_id_idx ON cell(cells_group_id, id);
+CREATE INDEX cell_alias_idx ON cell(org_id, alias);
+
+
+-- Pickment tasks of a unit picked together and then sorted back to the tasks
+-- at the consolidation cell
+CREATE TABLE pick_wave (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    unit_id UUID NOT NULL REFERENCES org_unit(id),
+
+    name VARCHAR(255) NOT NULL,
+    status pick_wave_status NOT NULL DEFAULT 'pending',
+    consolidation_cell_id UUID NOT NULL REFERENCES cell(id) ON DELETE RESTRICT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+CREATE INDEX pick_wave_org_idx ON pick_wave(org_id, created_at) WHERE deleted_at IS NULL;
+
+-- Moves instances between two units of the organization. The outbound leg is
+-- a pickment task of the source unit, instances it picks are in transit
+-- until the inbound leg, a receiving task of the destination unit, receives
+-- them into the receiving cell
+CREATE TABLE transfer_order (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    source_unit_id UUID NOT NULL REFERENCES org_unit(id),
+    destination_unit_id UUID NOT NULL REFERENCES org_unit(id),
+    receiving_cell_id UUID NOT NULL REFERENCES cell(id) ON DELETE RESTRICT,
+
+    name VARCHAR(255) NOT NULL,
+    status transfer_order_status NOT NULL DEFAULT 'pending',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    dispatched_at TIMESTAMP,
+    received_at TIMESTAMP,
+    deleted_at TIMESTAMP,
+
+    CONSTRAINT transfer_order_units_check CHECK (source_unit_id <> destination_unit_id)
+);
+CREATE INDEX transfer_order_org_idx ON transfer_order(org_id, created_at) WHERE deleted_at IS NULL;
+
+-- Recurring or repeated task. Tasks are created from the template manually or
+-- by the scheduler when a schedule is set
+CREATE TABLE task_template (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    unit_id UUID NOT NULL REFERENCES org_unit(id),
+
+    type task_type NOT NULL,
+    priority task_priority NOT NULL DEFAULT 'normal',
+    name VARCHAR(255) NOT NULL,
+    description VARCHAR(255),
+
+    -- assignee of created tasks
+    assigned_to_user_id UUID REFERENCES app_user(id),
+    -- due date of created tasks relative to their creation
+    due_in_minutes INTEGER CHECK (due_in_minutes > 0),
 
+    -- five field cron expression evaluated in UTC
+    schedule VARCHAR(100),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    next_run_at TIMESTAMP,
+    last_run_at TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+
+    CONSTRAINT task_template_type_check CHECK (type IN ('movement', 'pickment')),
+    CONSTRAINT task_template_schedule_check CHECK (schedule IS NOT NULL OR next_run_at IS NULL)
+);
+CREATE INDEX task_template_org_idx ON task_template(org_id, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX task_template_next_run_idx ON task_template(next_run_at) WHERE deleted_at IS NULL AND is_active AND next_run_at IS NOT NULL;
+
+-- Selects available instances of the unit for tasks created from a template
+CREATE TABLE task_template_rule (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    template_id UUID NOT NULL REFERENCES task_template(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+
+    item_id UUID REFERENCES item(id) ON DELETE CASCADE,
+    variant_id UUID REFERENCES item_variant(id) ON DELETE CASCADE,
+    source_cells_group_id UUID REFERENCES cells_group(id) ON DELETE CASCADE,
+    target_cell_id UUID REFERENCES cell(id) ON DELETE CASCADE,
+    -- all matching instances are taken when NULL
+    max_instances INTEGER CHECK (max_instances > 0),
+
+    CONSTRAINT task_template_rule_selector_check CHECK (item_id IS NOT NULL OR variant_id IS NOT NULL OR source_cells_group_id IS NOT NULL)
+);
+CREATE INDEX task_template_rule_template_idx ON task_template_rule(template_id, position);
 
 CREATE TABLE task (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -145,6 +267,7 @@ CREATE TABLE task (
 
     type task_type NOT NULL,
     status task_status NOT NULL DEFAULT 'pending',
+    priority task_priority NOT NULL DEFAULT 'normal',
     
     name VARCHAR(255) NOT NULL,
     description VARCHAR(255),
@@ -153,13 +276,74 @@ CREATE TABLE task (
     assigned_at TIMESTAMP,
     completed_at TIMESTAMP,
 
+    due_at TIMESTAMP,
+    -- set by the SLA checker once an open task passes due_at
+    sla_breached_at TIMESTAMP,
+
+    -- cell where received goods are put, only for receiving tasks
+    receiving_cell_id UUID REFERENCES cell(id) ON DELETE RESTRICT,
+    -- pickment task the goods are returned from, only for return tasks
+    original_task_id UUID REFERENCES task(id) ON DELETE RESTRICT,
+    -- pick wave the pickment task is picked in
+    wave_id UUID REFERENCES pick_wave(id) ON DELETE SET NULL,
+    -- template the task was created from
+    template_id UUID REFERENCES task_template(id) ON DELETE SET NULL,
+    -- transfer order the task is the outbound (pickment) or inbound
+    -- (receiving) leg of
+    transfer_order_id UUID REFERENCES transfer_order(id) ON DELETE RESTRICT,
+
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    deleted_at TIMESTAMP
+    deleted_at TIMESTAMP,
+
+    CONSTRAINT task_receiving_cell_check CHECK ((type = 'receiving') = (receiving_cell_id IS NOT NULL)),
+    CONSTRAINT task_original_task_check CHECK ((type = 'return') = (original_task_id IS NOT NULL)),
+    CONSTRAINT task_wave_check CHECK (wave_id IS NULL OR type = 'pickment'),
+    CONSTRAINT task_transfer_order_check CHECK (transfer_order_id IS NULL OR type IN ('pickment', 'receiving'))
 );
 CREATE INDEX task_org_id_idx ON task(org_id);
 CREATE INDEX task_status_idx ON task(status) WHERE deleted_at IS NULL;
 CREATE INDEX task_assigned_user_idx ON task(assigned_to_user_id, status) WHERE deleted_at IS NULL;
 CREATE INDEX task_unit_id_idx ON task(unit_id);
+CREATE INDEX task_org_created_at_idx ON task(org_id, created_at, id) WHERE deleted_at IS NULL;
+-- a transfer order has one leg of each type
+CREATE UNIQUE INDEX task_transfer_order_leg_idx ON task(transfer_order_id, type) WHERE transfer_order_id IS NOT NULL;
+CREATE INDEX task_org_completed_at_idx ON task(org_id, completed_at) WHERE deleted_at IS NULL AND completed_at IS NOT NULL;
+CREATE INDEX task_sla_due_at_idx ON task(due_at) WHERE deleted_at IS NULL AND due_at IS NOT NULL AND sla_breached_at IS NULL;
+CREATE INDEX task_original_task_idx ON task(original_task_id) WHERE original_task_id IS NOT NULL;
+CREATE INDEX task_wave_idx ON task(wave_id) WHERE wave_id IS NOT NULL;
+CREATE INDEX task_template_idx ON task(template_id) WHERE template_id IS NOT NULL;
+CREATE INDEX task_org_name_idx ON task(org_id, name, id) WHERE deleted_at IS NULL;
+CREATE INDEX task_org_priority_idx ON task(org_id, priority, created_at, id) WHERE deleted_at IS NULL;
+
+-- Every status transition of a task. from_status is NULL for the creation
+CREATE TABLE task_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    task_id UUID NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+
+    from_status task_status,
+    to_status task_status NOT NULL,
+    changed_by_user_id UUID REFERENCES app_user(id),
+    comment VARCHAR(1024),
+
+    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX task_status_history_task_idx ON task_status_history(org_id, task_id, changed_at);
+
+-- One-time code a customer or courier presents to collect a ready pickment
+-- task. Regenerating the code replaces the row.
+CREATE TABLE task_pickup_code (
+    task_id UUID PRIMARY KEY REFERENCES task(id) ON DELETE CASCADE,
+    org_id UUID NOT NULL REFERENCES org(id),
+    unit_id UUID NOT NULL REFERENCES org_unit(id),
+    code VARCHAR(16) NOT NULL,
+    failed_attempts INTEGER NOT NULL DEFAULT 0 CHECK (failed_attempts >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- set once failed attempts reach the limit, a new code has to be issued
+    locked_at TIMESTAMP,
+    used_at TIMESTAMP
+);
+CREATE INDEX task_pickup_code_unit_idx ON task_pickup_code(org_id, unit_id) WHERE used_at IS NULL AND locked_at IS NULL;
 
 CREATE TABLE item_instance (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -171,27 +355,184 @@ CREATE TABLE item_instance (
     status item_instance_status NOT NULL DEFAULT 'available',
     affected_by_task_id UUID REFERENCES task(id) ON DELETE SET NULL,
 
+    serial_number VARCHAR(255),
+    lot_number VARCHAR(255),
+    manufactured_at DATE,
+    -- instances are not allocated to tasks after this day
+    expires_at DATE,
+
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    deleted_at TIMESTAMP
+    deleted_at TIMESTAMP,
+
+    CONSTRAINT item_instance_expiry_check CHECK (manufactured_at IS NULL OR expires_at IS NULL OR manufactured_at <= expires_at),
+    -- reserved, shipped and in transit instances always point to the task
+    -- holding them
+    CONSTRAINT item_instance_reserved_check CHECK (status NOT IN ('reserved', 'shipped', 'in_transit') OR affected_by_task_id IS NOT NULL),
+    -- instances which left the warehouse are not in any cell
+    CONSTRAINT item_instance_out_of_stock_check CHECK (status NOT IN ('consumed', 'disposed', 'shipped', 'in_transit') OR cell_id IS NULL)
 );
 
 CREATE INDEX item_instance_status_idx ON item_instance(status) WHERE deleted_at IS NULL;
 CREATE INDEX item_instance_task_idx ON item_instance(affected_by_task_id) WHERE affected_by_task_id IS NOT NULL;
 CREATE INDEX item_instance_cell_idx ON item_instance(cell_id) WHERE cell_id IS NOT NULL;
+-- serial numbers are unique per variant, deleted instances release theirs
+CREATE UNIQUE INDEX item_instance_serial_number_idx ON item_instance(variant_id, serial_number) WHERE serial_number IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX item_instance_expires_at_idx ON item_instance(org_id, expires_at) WHERE expires_at IS NOT NULL AND deleted_at IS NULL;
+
+-- Quantity of a quantity tracked variant sharing a cell and a status. Lines
+-- are split when a task takes a part of them and merged back on request.
+-- Statuses follow the ones of item_instance
+CREATE TABLE stock_line (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    item_id UUID NOT NULL REFERENCES item(id) ON DELETE RESTRICT,
+    variant_id UUID NOT NULL REFERENCES item_variant(id) ON DELETE RESTRICT,
+
+    cell_id UUID REFERENCES cell(id) ON DELETE RESTRICT,
+    status item_instance_status NOT NULL DEFAULT 'available',
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    affected_by_task_id UUID REFERENCES task(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+
+    CONSTRAINT stock_line_reserved_check CHECK (status NOT IN ('reserved', 'shipped', 'in_transit') OR affected_by_task_id IS NOT NULL),
+    CONSTRAINT stock_line_out_of_stock_check CHECK (status NOT IN ('consumed', 'disposed', 'shipped', 'in_transit') OR cell_id IS NULL)
+);
+CREATE INDEX stock_line_variant_idx ON stock_line(org_id, variant_id) WHERE deleted_at IS NULL;
+CREATE INDEX stock_line_task_idx ON stock_line(affected_by_task_id) WHERE affected_by_task_id IS NOT NULL;
+CREATE INDEX stock_line_cell_idx ON stock_line(cell_id) WHERE cell_id IS NOT NULL;
 
 CREATE TABLE task_item (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     org_id UUID NOT NULL REFERENCES org(id),
     task_id UUID NOT NULL REFERENCES task(id) ON DELETE CASCADE,
-    item_instance_id UUID NOT NULL REFERENCES item_instance(id) ON DELETE RESTRICT,
+    -- serialized variants are moved by instance, quantity tracked ones by
+    -- stock line, exactly one of them is set
+    item_instance_id UUID REFERENCES item_instance(id) ON DELETE RESTRICT,
+    stock_line_id UUID REFERENCES stock_line(id) ON DELETE RESTRICT,
+    quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
     status task_item_status NOT NULL DEFAULT 'pending',
     source_cell_id UUID REFERENCES cell(id) ON DELETE RESTRICT,
     destination_cell_id UUID REFERENCES cell(id) ON DELETE RESTRICT,
-    PRIMARY KEY (task_id, item_instance_id)
+    -- decision made on inspection, only for return tasks
+    disposition return_disposition,
+    UNIQUE (task_id, item_instance_id),
+    UNIQUE (task_id, stock_line_id),
+    CONSTRAINT task_item_target_check CHECK ((item_instance_id IS NULL) <> (stock_line_id IS NULL)),
+    CONSTRAINT task_item_instance_quantity_check CHECK (item_instance_id IS NULL OR quantity = 1)
 );
 CREATE INDEX task_item_instance_idx ON task_item(item_instance_id);
+CREATE INDEX task_item_stock_line_idx ON task_item(stock_line_id) WHERE stock_line_id IS NOT NULL;
 CREATE INDEX task_item_source_cell_idx ON task_item(source_cell_id) WHERE source_cell_id IS NOT NULL;
 CREATE INDEX task_item_dest_cell_idx ON task_item(destination_cell_id) WHERE destination_cell_id IS NOT NULL;
 
+-- Task item a worker could not pick, optionally replaced by another instance
+-- of the same variant
+CREATE TABLE task_item_exception (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    task_id UUID NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    item_instance_id UUID NOT NULL REFERENCES item_instance(id) ON DELETE RESTRICT,
+
+    reason task_item_exception_reason NOT NULL,
+    note VARCHAR(1024),
+    substitute_instance_id UUID REFERENCES item_instance(id) ON DELETE RESTRICT,
+    reported_by_user_id UUID REFERENCES app_user(id),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX task_item_exception_task_idx ON task_item_exception(org_id, task_id);
+CREATE INDEX task_item_exception_created_idx ON task_item_exception(org_id, created_at);
+
+-- Manifest of a receiving task: variants and quantities expected to arrive
+CREATE TABLE task_expected_item (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    task_id UUID NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    variant_id UUID NOT NULL REFERENCES item_variant(id) ON DELETE RESTRICT,
+
+    expected_quantity INTEGER NOT NULL CHECK (expected_quantity >= 0),
+    received_quantity INTEGER NOT NULL DEFAULT 0 CHECK (received_quantity >= 0),
+    -- filled in when receiving is finished
+    discrepancy task_discrepancy_type,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (task_id, variant_id)
+);
+CREATE INDEX task_expected_item_task_idx ON task_expected_item(org_id, task_id);
+
+-- Cells covered by an inventory count task. They are frozen while the task is open
+CREATE TABLE task_count_cell (
+    org_id UUID NOT NULL REFERENCES org(id),
+    task_id UUID NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    cell_id UUID NOT NULL REFERENCES cell(id) ON DELETE RESTRICT,
+
+    counted_at TIMESTAMP,
+    counted_by_user_id UUID REFERENCES app_user(id),
+
+    PRIMARY KEY (task_id, cell_id)
+);
+CREATE INDEX task_count_cell_cell_idx ON task_count_cell(cell_id);
+
+-- What workers found in a cell: scanned instances or variant barcodes with quantity
+CREATE TABLE task_count_entry (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    task_id UUID NOT NULL,
+    cell_id UUID NOT NULL,
+
+    item_instance_id UUID REFERENCES item_instance(id) ON DELETE CASCADE,
+    variant_id UUID NOT NULL REFERENCES item_variant(id) ON DELETE RESTRICT,
+    quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (task_id, cell_id) REFERENCES task_count_cell(task_id, cell_id) ON DELETE CASCADE
+);
+CREATE INDEX task_count_entry_task_idx ON task_count_entry(org_id, task_id);
+
+-- Notes left on a task, or on one of its items when item_instance_id is set
+CREATE TABLE task_comment (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    task_id UUID NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    item_instance_id UUID,
+
+    body VARCHAR(4096) NOT NULL,
+    -- NULL when left with an API token
+    author_user_id UUID REFERENCES app_user(id),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    edited_at TIMESTAMP,
+    deleted_at TIMESTAMP,
+
+    FOREIGN KEY (task_id, item_instance_id) REFERENCES task_item(task_id, item_instance_id) ON DELETE CASCADE
+);
+CREATE INDEX task_comment_task_idx ON task_comment(org_id, task_id, created_at) WHERE deleted_at IS NULL;
+
+-- Files attached to a task or one of its items. The content is kept in the
+-- blob storage under storage_key, only the metadata lives here
+CREATE TABLE task_attachment (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES org(id),
+    task_id UUID NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+    item_instance_id UUID,
+    comment_id UUID REFERENCES task_comment(id) ON DELETE SET NULL,
+
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    storage_key VARCHAR(512) NOT NULL UNIQUE,
+    uploaded_by_user_id UUID REFERENCES app_user(id),
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+
+    FOREIGN KEY (task_id, item_instance_id) REFERENCES task_item(task_id, item_instance_id) ON DELETE CASCADE
+);
+CREATE INDEX task_attachment_task_idx ON task_attachment(org_id, task_id, created_at) WHERE deleted_at IS NULL;
+
 
 CREATE TABLE app_user_session (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -224,6 +565,19 @@ CREATE TABLE app_role_binding (
     UNIQUE (user_id, org_id)
 );
 
+-- Worker settings used by automatic task assignment
+CREATE TABLE app_worker_profile (
+    org_id UUID NOT NULL REFERENCES org(id),
+    user_id UUID NOT NULL REFERENCES app_user(id),
+    -- units the worker takes tasks in
+    unit_ids UUID[] NOT NULL DEFAULT '{}',
+    -- task types the worker is qualified for, used by the skills strategy
+    skills VARCHAR(32)[] NOT NULL DEFAULT '{}',
+    on_shift BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (org_id, user_id)
+);
+
 CREATE TABLE object_type (
     id INTEGER PRIMARY KEY,
     object_group VARCHAR(100) NOT NULL,
@@ -243,7 +597,14 @@ INSERT INTO object_type (id, object_group, object_name) VALUES
     (8, 'rbac', 'employee'),
     (9, 'tasks', 'task'),
     (10, 'items', 'variant'),
-    (11, 'org', 'api-token');
+    (11, 'org', 'api-token'),
+    (12, 'tasks', 'pick-wave'),
+    (13, 'tasks', 'task-template'),
+    (14, 'tasks', 'task-pickup-code'),
+    (15, 'tasks', 'task-comment'),
+    (16, 'tasks', 'task-attachment'),
+    (17, 'tasks', 'transfer-order'),
+    (18, 'items', 'stock-line');
 
 
 CREATE TABLE app_object_change (
@@ -255,11 +616,17 @@ CREATE TABLE app_object_change (
     target_object_type INTEGER NOT NULL REFERENCES object_type(id),
     target_object_id UUID NOT NULL,
     prechange_state JSONB,
-    postchange_state JSONB
+    postchange_state JSONB,
+    -- why the change was made, e.g. inventory count adjustment reason code
+    reason VARCHAR(255)
 );
 CREATE INDEX app_object_change_org_id_idx ON app_object_change(org_id);
 CREATE INDEX app_object_change_target_object_type_idx ON app_object_change(target_object_type);
 CREATE INDEX app_object_change_target_object_id_idx ON app_object_change(target_object_id);
+-- instance history by serial number, deleted instances only have the
+-- prechange state
+CREATE INDEX app_object_change_post_serial_number_idx ON app_object_change(org_id, (postchange_state->>'serial_number')) WHERE target_object_type = 7;
+CREATE INDEX app_object_change_pre_serial_number_idx ON app_object_change(org_id, (prechange_state->>'serial_number')) WHERE target_object_type = 7;
 
 CREATE TABLE app_api_token (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
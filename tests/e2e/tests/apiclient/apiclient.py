@@ -34,6 +34,25 @@ class APIClient:
             self.base_url + path, json=data, cookies=self.cookies, headers=self.headers
         )
 
+    def upload(
+        self, path: str, files: dict[str, Any], data: dict[str, Any] | None = None
+    ) -> requests.Response:
+        return requests.post(
+            self.base_url + path,
+            files=files,
+            data=data,
+            cookies=self.cookies,
+            headers=self.headers,
+        )
+
+    def post_raw(self, path: str, body: str, content_type: str) -> requests.Response:
+        return requests.post(
+            self.base_url + path,
+            data=body.encode(),
+            cookies=self.cookies,
+            headers={**self.headers, "Content-Type": content_type},
+        )
+
     def delete(self, path: str) -> requests.Response:
         return requests.delete(
             self.base_url + path, cookies=self.cookies, headers=self.headers
@@ -1,8 +1,13 @@
+import base64
+import datetime
 import json
 import os
 import random
 import string
+import threading
+import urllib.parse
 import uuid
+from concurrent.futures import ThreadPoolExecutor
 from typing import Generator
 
 import pytest
@@ -26,7 +31,11 @@ def test_user() -> dict[str, str]:
 @pytest.fixture(scope="session")
 def session_cookie(test_user: dict[str, str]) -> str:
     """Get session cookie for the test user"""
-    response = requests.post(f"{API_BASE}/auth/test", json=test_user)
+    return authenticate(test_user)
+
+
+def authenticate(user: dict[str, str]) -> str:
+    response = requests.post(f"{API_BASE}/auth/test", json=user)
     assert response.status_code == 200, (
         f"Authentication failed: {response.status_code} {response.text}"
     )
@@ -699,3 +708,3072 @@ class TestInstance:
         )
 
         assert response.status_code == 200, print(response.text)
+
+
+@pytest.fixture
+def cells_group(
+    api_client_with_organization: APIClient, organization_unit: dict
+) -> dict:
+    response = api_client_with_organization.post(
+        "/cells-groups",
+        {
+            "name": str(uuid.uuid4()),
+            "alias": generate_random_string(),
+            "unitId": organization_unit["id"],
+        },
+    )
+    assert response.status_code == 200, response.text
+    return response.json()["data"]
+
+
+@pytest.fixture
+def variant(api_client_with_organization: APIClient, item: dict) -> dict:
+    response = api_client_with_organization.post(
+        f"/items/{item['id']}/variants",
+        {
+            "name": str(uuid.uuid4()),
+            "article": str(random.randint(100000000000, 999999999999)),
+        },
+    )
+    assert response.status_code == 200, response.text
+    return response.json()["data"]
+
+
+def create_cell(
+    client: APIClient, cells_group: dict, row: int = 1, position: int = 1
+) -> dict:
+    response = client.post(
+        f"/cells-groups/{cells_group['id']}/cells",
+        {
+            "alias": generate_random_string(),
+            "row": row,
+            "level": 1,
+            "position": position,
+        },
+    )
+    assert response.status_code == 200, response.text
+    return response.json()["data"]
+
+
+def create_instance(client: APIClient, item: dict, variant: dict, cell: dict) -> dict:
+    response = client.post(
+        f"/items/{item['id']}/instances",
+        {"variantId": variant["id"], "cellId": cell["id"]},
+    )
+    assert response.status_code == 200, response.text
+    return response.json()["data"]
+
+
+def complete_with_pickup_code(client: APIClient, task_id: str) -> None:
+    response = client.get(f"/tasks/{task_id}/pickup-code")
+    assert response.status_code == 200, response.text
+    code = response.json()["data"]["code"]
+    response = client.post(f"/tasks/{task_id}/completed", {"pickupCode": code})
+    assert response.status_code == 204, response.text
+
+
+class TestTaskPicking:
+    def test_concurrent_pick_succeeds_once(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        source_cell = create_cell(api_client_with_organization, cells_group, row=1)
+        target_cell = create_cell(api_client_with_organization, cells_group, row=2)
+        instance = create_instance(
+            api_client_with_organization, item, variant, source_cell
+        )
+
+        response = api_client_with_organization.post(
+            "/tasks",
+            {
+                "name": "Concurrent pick",
+                "type": "movement",
+                "unitId": organization_unit["id"],
+                "items": [
+                    {"instanceId": instance["id"], "targetCellId": target_cell["id"]}
+                ],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+
+        barrier = threading.Barrier(2)
+
+        def pick() -> int:
+            barrier.wait()
+            response = api_client_with_organization.post(
+                f"/tasks/{task['id']}/pick-instance",
+                {"instanceId": instance["id"]},
+            )
+            return response.status_code
+
+        with ThreadPoolExecutor(max_workers=2) as executor:
+            statuses = sorted(executor.map(lambda _: pick(), range(2)))
+
+        assert statuses == [204, 409]
+
+        response = api_client_with_organization.get(f"/tasks/{task['id']}")
+        assert response.status_code == 200, response.text
+        task_data = response.json()["data"]
+        assert task_data["status"] == "in_progress"
+        assert task_data["items"][0]["status"] == "picked"
+        assert task_data["items"][0]["instance"]["status"] == "reserved"
+
+    def test_pick_instance_not_in_task(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        cell = create_cell(api_client_with_organization, cells_group)
+        instance = create_instance(api_client_with_organization, item, variant, cell)
+        other_instance = create_instance(
+            api_client_with_organization, item, variant, cell
+        )
+
+        response = api_client_with_organization.post(
+            "/tasks",
+            {
+                "name": "Pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+
+        response = api_client_with_organization.post(
+            f"/tasks/{task['id']}/pick-instance",
+            {"instanceId": other_instance["id"]},
+        )
+        assert response.status_code == 409, response.text
+
+
+
+class TestTaskStatusMachine:
+    def create_movement(
+        self, client: APIClient, organization_unit: dict, instance: dict, target: dict
+    ) -> dict:
+        response = client.post(
+            "/tasks",
+            {
+                "name": f"Move {uuid.uuid4()}",
+                "type": "movement",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"], "targetCellId": target["id"]}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        return response.json()["data"]
+
+    def get_task(self, client: APIClient, task_id: str) -> dict:
+        response = client.get(f"/tasks/{task_id}")
+        assert response.status_code == 200, response.text
+        return response.json()["data"]
+
+    def test_transitions_and_put_instance(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        source = create_cell(client, cells_group, row=1)
+        target = create_cell(client, cells_group, row=2)
+        instance = create_instance(client, item, variant, source)
+        task = self.create_movement(client, organization_unit, instance, target)
+        assert task["status"] == "pending"
+        url = f"/tasks/{task['id']}"
+        body = {"instanceId": instance["id"]}
+
+        # pending -> ready and pending -> completed are not allowed
+        assert client.post(f"{url}/ready", {}).status_code == 409
+        assert client.post(f"{url}/completed", {}).status_code == 409
+        assert client.post(f"{url}/put-instance", body).status_code == 409
+
+        response = client.post(f"{url}/pick-instance", body)
+        assert response.status_code == 204, response.text
+        task = self.get_task(client, task["id"])
+        assert task["status"] == "in_progress"
+        assert task["items"][0]["status"] == "picked"
+        assert task["items"][0]["instance"]["status"] == "reserved"
+        assert task["items"][0]["instance"]["cell"] is None
+
+        # Items with a target cell have to be put before the task is ready
+        assert client.post(f"{url}/ready", {}).status_code == 409
+        assert client.post(f"{url}/completed", {}).status_code == 409
+
+        response = client.post(f"{url}/put-instance", body)
+        assert response.status_code == 201, response.text
+        assert client.post(f"{url}/put-instance", body).status_code == 409
+        task = self.get_task(client, task["id"])
+        assert task["items"][0]["status"] == "done"
+        assert task["items"][0]["instance"]["status"] == "available"
+        assert task["items"][0]["instance"]["cell"]["id"] == target["id"]
+
+        response = client.post(f"{url}/ready", {})
+        assert response.status_code == 204, response.text
+        assert self.get_task(client, task["id"])["status"] == "ready"
+        assert client.post(f"{url}/pick-instance", body).status_code == 409
+        assert client.post(f"{url}/ready", {}).status_code == 409
+
+        response = client.post(f"{url}/completed", {})
+        assert response.status_code == 204, response.text
+        assert self.get_task(client, task["id"])["status"] == "completed"
+
+        # Completed tasks are final
+        for action in ["ready", "completed", "cancel"]:
+            response = client.post(f"{url}/{action}", {})
+            assert response.status_code == 409, (action, response.text)
+
+    def test_cancel_from_each_open_state(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        source = create_cell(client, cells_group, row=1)
+        target = create_cell(client, cells_group, row=2)
+
+        # pending: the items are canceled and the instance stays in place
+        instance = create_instance(client, item, variant, source)
+        task = self.create_movement(client, organization_unit, instance, target)
+        response = client.post(f"/tasks/{task['id']}/cancel", {})
+        assert response.status_code == 201, response.text
+        task = self.get_task(client, task["id"])
+        assert task["status"] == "cancelled"
+        assert task["items"][0]["status"] == "canceled"
+        assert task["items"][0]["instance"]["cell"]["id"] == source["id"]
+
+        # in_progress: picked instances go back to their source cell
+        instance = create_instance(client, item, variant, source)
+        task = self.create_movement(client, organization_unit, instance, target)
+        response = client.post(
+            f"/tasks/{task['id']}/pick-instance", {"instanceId": instance["id"]}
+        )
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{task['id']}/cancel", {})
+        assert response.status_code == 201, response.text
+        task = self.get_task(client, task["id"])
+        assert task["status"] == "cancelled"
+        assert task["items"][0]["status"] == "returned"
+        restored = task["items"][0]["instance"]
+        assert restored["status"] == "available"
+        assert restored["cell"]["id"] == source["id"]
+        assert restored["affectedByTaskId"] is None
+
+        # ready: already put instances are left in the target cell
+        instance = create_instance(client, item, variant, source)
+        task = self.create_movement(client, organization_unit, instance, target)
+        body = {"instanceId": instance["id"]}
+        for action, status in [("pick-instance", 204), ("put-instance", 201)]:
+            response = client.post(f"/tasks/{task['id']}/{action}", body)
+            assert response.status_code == status, response.text
+        response = client.post(f"/tasks/{task['id']}/ready", {})
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{task['id']}/cancel", {})
+        assert response.status_code == 201, response.text
+        task = self.get_task(client, task["id"])
+        assert task["status"] == "cancelled"
+        assert task["items"][0]["status"] == "done"
+        assert task["items"][0]["instance"]["cell"]["id"] == target["id"]
+
+        # Cancelled tasks are final
+        for action in ["cancel", "ready", "completed"]:
+            response = client.post(f"/tasks/{task['id']}/{action}", {})
+            assert response.status_code == 409, (action, response.text)
+
+
+class TestTaskReceiving:
+    def test_receiving_records_discrepancies(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        receiving_cell = create_cell(api_client_with_organization, cells_group)
+
+        response = api_client_with_organization.post(
+            f"/items/{item['id']}/variants",
+            {"name": str(uuid.uuid4())},
+        )
+        assert response.status_code == 200, response.text
+        unexpected_variant = response.json()["data"]
+
+        response = api_client_with_organization.post(
+            "/tasks",
+            {
+                "name": "Inbound",
+                "type": "receiving",
+                "unitId": organization_unit["id"],
+                "receivingCellId": receiving_cell["id"],
+                "expectedItems": [{"variantId": variant["id"], "quantity": 3}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        assert task["receivingCell"]["id"] == receiving_cell["id"]
+        assert task["expectedItems"][0]["expectedQuantity"] == 3
+
+        response = api_client_with_organization.post(
+            f"/tasks/{task['id']}/receive",
+            {"variantId": variant["id"], "quantity": 2},
+        )
+        assert response.status_code == 201, response.text
+        instances = response.json()["data"]
+        assert len(instances) == 2
+        assert all(i["cell"]["id"] == receiving_cell["id"] for i in instances)
+        assert all(i["status"] == "available" for i in instances)
+        assert all(i["affectedByTaskId"] == task["id"] for i in instances)
+
+        response = api_client_with_organization.post(
+            f"/tasks/{task['id']}/receive",
+            {"variantId": variant["id"], "quantity": 1001},
+        )
+        assert response.status_code == 400, response.text
+
+        response = api_client_with_organization.post(
+            f"/tasks/{task['id']}/receive",
+            {"variantId": unexpected_variant["id"]},
+        )
+        assert response.status_code == 201, response.text
+
+        response = api_client_with_organization.post(f"/tasks/{task['id']}/ready", {})
+        assert response.status_code == 204, response.text
+
+        response = api_client_with_organization.get(f"/tasks/{task['id']}")
+        assert response.status_code == 200, response.text
+        task_data = response.json()["data"]
+        assert task_data["status"] == "ready"
+        assert len(task_data["items"]) == 3
+        discrepancies = {
+            e["variant"]["id"]: (e["receivedQuantity"], e["discrepancy"])
+            for e in task_data["expectedItems"]
+        }
+        assert discrepancies == {
+            variant["id"]: (2, "under"),
+            unexpected_variant["id"]: (1, "over"),
+        }
+
+    def test_receiving_requires_receiving_cell(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        variant: dict,
+    ) -> None:
+        response = api_client_with_organization.post(
+            "/tasks",
+            {
+                "name": "Inbound",
+                "type": "receiving",
+                "unitId": organization_unit["id"],
+                "expectedItems": [{"variantId": variant["id"], "quantity": 1}],
+            },
+        )
+        assert response.status_code == 400, response.text
+
+
+class TestInventoryCount:
+    def test_count_reconciliation_and_adjustments(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group, row=1)
+        other_cell = create_cell(client, cells_group, row=2)
+        counted = create_instance(client, item, variant, cell)
+        missing = create_instance(client, item, variant, cell)
+        in_other_cell = create_instance(client, item, variant, other_cell)
+
+        response = client.post(
+            "/cells-groups",
+            {
+                "name": str(uuid.uuid4()),
+                "alias": generate_random_string(),
+                "unitId": organization_unit["id"],
+            },
+        )
+        assert response.status_code == 200, response.text
+        outside_cell = create_cell(client, response.json()["data"])
+        misplaced = create_instance(client, item, variant, outside_cell)
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Stocktake",
+                "type": "inventory_count",
+                "unitId": organization_unit["id"],
+                "countTarget": {"cellsGroupId": cells_group["id"]},
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        assert len(task["countCells"]) == 2
+
+        # Counted cells are frozen for other tasks
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": counted["id"]}],
+            },
+        )
+        assert response.status_code == 409, response.text
+
+        response = client.post(
+            f"/tasks/{task['id']}/count",
+            {"cellId": cell["id"], "instanceIds": [counted["id"]]},
+        )
+        assert response.status_code == 204, response.text
+
+        response = client.post(f"/tasks/{task['id']}/ready", {})
+        assert response.status_code == 409, response.text
+
+        response = client.post(
+            f"/tasks/{task['id']}/count",
+            {
+                "cellId": other_cell["id"],
+                "instanceIds": [misplaced["id"]],
+                "barcodes": [variant["article"], variant["article"]],
+            },
+        )
+        assert response.status_code == 204, response.text
+
+        response = client.get(f"/tasks/{task['id']}/count-report")
+        assert response.status_code == 200, response.text
+        report = response.json()["data"]
+        assert [d["instance"]["id"] for d in report["missing"]] == [missing["id"]]
+        assert [d["instance"]["id"] for d in report["misplaced"]] == [misplaced["id"]]
+        assert report["misplaced"][0]["foundCell"]["id"] == other_cell["id"]
+        assert len(report["unexpected"]) == 1
+        assert report["unexpected"][0]["quantity"] == 1
+        assert report["uncountedCells"] == []
+
+        response = client.post(f"/tasks/{task['id']}/ready", {})
+        assert response.status_code == 204, response.text
+
+        response = client.post(f"/tasks/{task['id']}/count-adjustments", {})
+        assert response.status_code == 200, response.text
+
+        response = client.get(f"/instances/{missing['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["status"] == "consumed"
+
+        response = client.get(f"/instances/{misplaced['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["cell"]["id"] == other_cell["id"]
+
+        response = client.get(f"/instances/{in_other_cell['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["cell"]["id"] == other_cell["id"]
+
+        response = client.get(f"/tasks/{task['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["status"] == "completed"
+
+
+    def test_reserved_instances_are_not_written_off(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        response = client.post(
+            "/cells-groups",
+            {
+                "name": str(uuid.uuid4()),
+                "alias": generate_random_string(),
+                "unitId": organization_unit["id"],
+            },
+        )
+        assert response.status_code == 200, response.text
+        counted_group = response.json()["data"]
+        consolidation_cell = create_cell(client, counted_group)
+        on_shelf = create_instance(client, item, variant, consolidation_cell)
+
+        # The sorted instance waits in the consolidation cell, reserved by its task
+        sorted_instance = create_instance(client, item, variant, cell)
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": sorted_instance["id"]}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        response = client.post(
+            "/waves",
+            {
+                "unitId": organization_unit["id"],
+                "name": "Wave",
+                "consolidationCellId": consolidation_cell["id"],
+                "taskIds": [response.json()["data"]["id"]],
+            },
+        )
+        assert response.status_code == 200, response.text
+        wave = response.json()["data"]
+        body = {"instanceId": sorted_instance["id"]}
+        for action in ("pick-instance", "sort-instance"):
+            response = client.post(f"/waves/{wave['id']}/{action}", body)
+            assert response.status_code == 200, response.text
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Stocktake",
+                "type": "inventory_count",
+                "unitId": organization_unit["id"],
+                "countTarget": {"cellsGroupId": counted_group["id"]},
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        response = client.post(
+            f"/tasks/{task['id']}/count",
+            {"cellId": consolidation_cell["id"], "instanceIds": [on_shelf["id"]]},
+        )
+        assert response.status_code == 204, response.text
+
+        response = client.get(f"/tasks/{task['id']}/count-report")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["missing"] == []
+
+        response = client.post(f"/tasks/{task['id']}/ready", {})
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{task['id']}/count-adjustments", {})
+        assert response.status_code == 200, response.text
+
+        response = client.get(f"/instances/{sorted_instance['id']}")
+        assert response.status_code == 200, response.text
+        instance = response.json()["data"]
+        assert instance["status"] == "reserved"
+        assert instance["cell"]["id"] == consolidation_cell["id"]
+
+class TestTaskReturns:
+    def test_return_restock_and_dispose(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group, row=1)
+        restock_cell = create_cell(client, cells_group, row=2)
+        restocked = create_instance(client, item, variant, cell)
+        disposed = create_instance(client, item, variant, cell)
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [
+                    {"instanceId": restocked["id"]},
+                    {"instanceId": disposed["id"]},
+                ],
+            },
+        )
+        assert response.status_code == 200, response.text
+        pickment = response.json()["data"]
+
+        # Goods can be returned only from a completed pickment
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Return",
+                "type": "return",
+                "unitId": organization_unit["id"],
+                "originalTaskId": pickment["id"],
+                "items": [{"instanceId": restocked["id"]}],
+            },
+        )
+        assert response.status_code == 409, response.text
+
+        for instance in (restocked, disposed):
+            response = client.post(
+                f"/tasks/{pickment['id']}/pick-instance",
+                {"instanceId": instance["id"]},
+            )
+            assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{pickment['id']}/ready", {})
+        assert response.status_code == 204, response.text
+        complete_with_pickup_code(client, pickment["id"])
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Return",
+                "type": "return",
+                "unitId": organization_unit["id"],
+                "originalTaskId": pickment["id"],
+                "items": [
+                    {"instanceId": restocked["id"]},
+                    {"instanceId": disposed["id"]},
+                ],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        assert task["originalTaskId"] == pickment["id"]
+
+        # Restocking needs a cell
+        response = client.post(
+            f"/tasks/{task['id']}/inspect-return",
+            {"instanceId": restocked["id"], "disposition": "restock"},
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.post(
+            f"/tasks/{task['id']}/inspect-return",
+            {
+                "instanceId": restocked["id"],
+                "disposition": "restock",
+                "cellId": restock_cell["id"],
+            },
+        )
+        assert response.status_code == 200, response.text
+        instance = response.json()["data"]
+        assert instance["status"] == "available"
+        assert instance["cell"]["id"] == restock_cell["id"]
+
+        response = client.post(
+            f"/tasks/{task['id']}/inspect-return",
+            {"instanceId": disposed["id"], "disposition": "dispose"},
+        )
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["status"] == "disposed"
+
+        response = client.get(f"/tasks/{task['id']}")
+        assert response.status_code == 200, response.text
+        task_data = response.json()["data"]
+        assert task_data["status"] == "in_progress"
+        assert sorted(i["disposition"] for i in task_data["items"]) == [
+            "dispose",
+            "restock",
+        ]
+
+        response = client.get(f"/tasks/{pickment['id']}")
+        assert response.status_code == 200, response.text
+        assert [i["status"] for i in response.json()["data"]["items"]] == [
+            "returned",
+            "returned",
+        ]
+
+        # Already returned instances cannot be returned again
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Return again",
+                "type": "return",
+                "unitId": organization_unit["id"],
+                "originalTaskId": pickment["id"],
+                "items": [{"instanceId": restocked["id"]}],
+            },
+        )
+        assert response.status_code == 409, response.text
+
+        response = client.post(f"/tasks/{task['id']}/ready", {})
+        assert response.status_code == 204, response.text
+
+
+class TestTaskListing:
+    PRIORITY_RANKS = {"low": 0, "normal": 1, "high": 2, "urgent": 3}
+
+    def list_pages(
+        self, client: APIClient, prefix: str, sort_by: str, order: str
+    ) -> list[str]:
+        ids = []
+        query = f"/tasks?search={prefix}&sort_by={sort_by}&sort_order={order}&limit=2"
+        url = query
+        for _ in range(10):
+            response = client.get(url)
+            assert response.status_code == 200, response.text
+            page = response.json()
+            assert len(page["data"]) <= 2
+            ids.extend(t["id"] for t in page["data"])
+            if page.get("nextCursor") is None:
+                return ids
+            url = f"{query}&cursor={page['nextCursor']}"
+        raise AssertionError("pagination did not end")
+
+    def test_sort_orders_across_pages(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        prefix = str(uuid.uuid4())
+
+        # Repeated names and priorities make the id break the ties
+        specs = [
+            ("b", "low"),
+            ("a", "urgent"),
+            ("b", "low"),
+            ("c", "normal"),
+            ("a", "urgent"),
+        ]
+        tasks = []
+        for name, priority in specs:
+            instance = create_instance(client, item, variant, cell)
+            response = client.post(
+                "/tasks",
+                {
+                    "name": f"{prefix} {name}",
+                    "type": "pickment",
+                    "unitId": organization_unit["id"],
+                    "items": [{"instanceId": instance["id"]}],
+                    "priority": priority,
+                },
+            )
+            assert response.status_code == 200, response.text
+            tasks.append(response.json()["data"])
+
+        # Tasks are created one after another, so the creation order is the
+        # created_at order
+        keys = {
+            "created_at": lambda i: (i, tasks[i]["id"]),
+            "name": lambda i: (tasks[i]["name"], tasks[i]["id"]),
+            "priority": lambda i: (
+                self.PRIORITY_RANKS[tasks[i]["priority"]],
+                i,
+                tasks[i]["id"],
+            ),
+        }
+        for sort_by, key in keys.items():
+            for order in ("asc", "desc"):
+                indexes = sorted(
+                    range(len(tasks)), key=key, reverse=order == "desc"
+                )
+                expected = [tasks[i]["id"] for i in indexes]
+                assert self.list_pages(client, prefix, sort_by, order) == expected, (
+                    sort_by,
+                    order,
+                )
+
+    def test_tampered_cursor_is_rejected(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        prefix = str(uuid.uuid4())
+
+        for _ in range(2):
+            instance = create_instance(client, item, variant, cell)
+            response = client.post(
+                "/tasks",
+                {
+                    "name": prefix,
+                    "type": "pickment",
+                    "unitId": organization_unit["id"],
+                    "items": [{"instanceId": instance["id"]}],
+                },
+            )
+            assert response.status_code == 200, response.text
+
+        query = f"/tasks?search={prefix}&sort_by=priority&sort_order=desc&limit=1"
+        response = client.get(query)
+        assert response.status_code == 200, response.text
+        cursor = response.json()["nextCursor"]
+        assert cursor is not None
+
+        padded = cursor + "=" * (-len(cursor) % 4)
+        payload = json.loads(base64.urlsafe_b64decode(padded))
+
+        def encode(value: dict) -> str:
+            data = json.dumps(value).encode()
+            return base64.urlsafe_b64encode(data).decode().rstrip("=")
+
+        tampered = [
+            "not a cursor!",
+            base64.urlsafe_b64encode(b"{broken").decode().rstrip("="),
+            encode({**payload, "p": "critical"}),
+            encode({**payload, "c": "yesterday"}),
+            encode({**payload, "id": "not-an-id"}),
+            encode({k: v for k, v in payload.items() if k != "p"}),
+            encode({**payload, "s": "name"}),
+        ]
+        for value in tampered:
+            response = client.get(
+                f"{query}&cursor={urllib.parse.quote(value)}"
+            )
+            assert response.status_code == 400, (value, response.text)
+
+        response = client.get(f"{query}&cursor={encode(payload)}")
+        assert response.status_code == 200, response.text
+        assert len(response.json()["data"]) == 1
+
+
+class TestTaskPriority:
+    def test_priority_ordering_and_due_date(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        prefix = str(uuid.uuid4())
+
+        for priority in ("low", "urgent", "normal"):
+            instance = create_instance(client, item, variant, cell)
+            data = {
+                "name": f"{prefix} {priority}",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+                "dueAt": "2030-01-01T12:00:00Z",
+            }
+            if priority != "normal":
+                data["priority"] = priority
+            response = client.post("/tasks", data)
+            assert response.status_code == 200, response.text
+            task = response.json()["data"]
+            assert task["priority"] == priority
+            assert task["dueAt"].startswith("2030-01-01T12:00:00")
+            assert task["slaBreachedAt"] is None
+
+        # Due dates with an offset are stored in UTC
+        instance = create_instance(client, item, variant, cell)
+        response = client.post(
+            "/tasks",
+            {
+                "name": f"{prefix} offset",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+                "priority": "low",
+                "dueAt": "2030-01-01T15:00:00+03:00",
+            },
+        )
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["dueAt"].startswith("2030-01-01T12:00:00")
+        response = client.post(
+            f"/tasks/{response.json()['data']['id']}/cancel", {}
+        )
+        assert response.status_code == 201, response.text
+
+        response = client.get(
+            f"/tasks?search={prefix}&status=pending"
+            "&sort_by=priority&sort_order=desc&limit=2"
+        )
+        assert response.status_code == 200, response.text
+        page = response.json()
+        assert [t["priority"] for t in page["data"]] == ["urgent", "normal"]
+
+        response = client.get(
+            f"/tasks?search={prefix}&status=pending"
+            "&sort_by=priority&sort_order=desc&limit=2"
+            f"&cursor={page['nextCursor']}"
+        )
+        assert response.status_code == 200, response.text
+        assert [t["priority"] for t in response.json()["data"]] == ["low"]
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": f"{prefix} invalid",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+                "priority": "critical",
+            },
+        )
+        assert response.status_code == 400, response.text
+
+
+class TestTaskStatusHistory:
+    def test_history_records_transitions(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        instance = create_instance(client, item, variant, cell)
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+
+        response = client.post(
+            f"/tasks/{task['id']}/pick-instance", {"instanceId": instance["id"]}
+        )
+        assert response.status_code == 204, response.text
+        response = client.post(
+            f"/tasks/{task['id']}/ready", {"comment": "Left at the pickup desk"}
+        )
+        assert response.status_code == 204, response.text
+        complete_with_pickup_code(client, task["id"])
+
+        response = client.get(f"/tasks/{task['id']}/history")
+        assert response.status_code == 200, response.text
+        history = response.json()["data"]
+        assert [(h["fromStatus"], h["toStatus"]) for h in history] == [
+            (None, "pending"),
+            ("pending", "in_progress"),
+            ("in_progress", "ready"),
+            ("ready", "completed"),
+        ]
+        assert history[2]["comment"] == "Left at the pickup desk"
+        assert all(h["changedBy"] is not None for h in history)
+        assert all(h["durationSeconds"] is not None for h in history[:-1])
+        assert history[-1]["durationSeconds"] is None
+
+        response = client.get(f"/tasks/{task['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["completedAt"] is not None
+
+        response = client.get(f"/tasks/{uuid.uuid4()}/history")
+        assert response.status_code == 404, response.text
+
+
+class TestPickWaves:
+    def test_wave_pick_sort_and_complete(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group, row=1)
+        consolidation_cell = create_cell(client, cells_group, row=2)
+        instances = [create_instance(client, item, variant, cell) for _ in range(3)]
+
+        tasks = []
+        for task_instances in (instances[:2], instances[2:]):
+            response = client.post(
+                "/tasks",
+                {
+                    "name": "Pick",
+                    "type": "pickment",
+                    "unitId": organization_unit["id"],
+                    "items": [{"instanceId": i["id"]} for i in task_instances],
+                },
+            )
+            assert response.status_code == 200, response.text
+            tasks.append(response.json()["data"])
+
+        response = client.post(
+            "/waves",
+            {
+                "unitId": organization_unit["id"],
+                "name": "Morning wave",
+                "consolidationCellId": consolidation_cell["id"],
+                "taskIds": [t["id"] for t in tasks],
+            },
+        )
+        assert response.status_code == 200, response.text
+        wave = response.json()["data"]
+        assert wave["status"] == "pending"
+        assert {t["id"] for t in wave["tasks"]} == {t["id"] for t in tasks}
+        assert len(wave["items"]) == 3
+        assert all(
+            i["targetCell"]["id"] == consolidation_cell["id"] for i in wave["items"]
+        )
+
+        # A task can be in a single open wave only
+        response = client.post(
+            "/waves",
+            {
+                "unitId": organization_unit["id"],
+                "name": "Second wave",
+                "consolidationCellId": consolidation_cell["id"],
+                "taskIds": [tasks[0]["id"]],
+            },
+        )
+        assert response.status_code == 409, response.text
+
+        for instance in instances:
+            response = client.post(
+                f"/waves/{wave['id']}/pick-instance", {"instanceId": instance["id"]}
+            )
+            assert response.status_code == 200, response.text
+
+        response = client.post(f"/waves/{wave['id']}/complete", {})
+        assert response.status_code == 409, response.text
+
+        for instance in instances:
+            response = client.post(
+                f"/waves/{wave['id']}/sort-instance", {"instanceId": instance["id"]}
+            )
+            assert response.status_code == 200, response.text
+            task = response.json()["data"]
+            assert instance["id"] in [i["instance"]["id"] for i in task["items"]]
+            assert task["waveId"] == wave["id"]
+
+        response = client.get(f"/instances/{instances[0]['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["status"] == "reserved"
+
+        response = client.post(f"/waves/{wave['id']}/complete", {})
+        assert response.status_code == 200, response.text
+        wave = response.json()["data"]
+        assert wave["status"] == "completed"
+        assert wave["completedAt"] is not None
+        assert all(t["status"] == "ready" for t in wave["tasks"])
+
+        response = client.get("/waves")
+        assert response.status_code == 200, response.text
+        assert wave["id"] in [w["id"] for w in response.json()["data"]]
+
+        # Sorted instances of a cancelled task go back to their source cell
+        response = client.post(f"/tasks/{tasks[1]['id']}/cancel", {})
+        assert response.status_code == 201, response.text
+        response = client.get(f"/tasks/{tasks[1]['id']}")
+        assert response.status_code == 200, response.text
+        cancelled = response.json()["data"]
+        assert cancelled["items"][0]["status"] == "returned"
+        restored = cancelled["items"][0]["instance"]
+        assert restored["status"] == "available"
+        assert restored["cell"]["id"] == cell["id"]
+        assert restored["affectedByTaskId"] is None
+
+    def test_cancel_wave_releases_tasks(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group, row=1)
+        consolidation_cell = create_cell(client, cells_group, row=2)
+        instance = create_instance(client, item, variant, cell)
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+                "priority": "urgent",
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+
+        # Without task ids the planner takes open pickment tasks of the unit
+        response = client.post(
+            "/waves",
+            {
+                "unitId": organization_unit["id"],
+                "name": "Planned wave",
+                "consolidationCellId": consolidation_cell["id"],
+            },
+        )
+        assert response.status_code == 200, response.text
+        wave = response.json()["data"]
+        assert task["id"] in [t["id"] for t in wave["tasks"]]
+
+        # Tasks of an open wave are not cancelled on their own
+        response = client.post(f"/tasks/{task['id']}/cancel", {})
+        assert response.status_code == 409, response.text
+
+        response = client.post(f"/waves/{wave['id']}/cancel", {})
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["status"] == "cancelled"
+
+        response = client.get(f"/tasks/{task['id']}")
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        assert task["waveId"] is None
+        assert task["items"][0]["targetCell"] is None
+
+        response = client.post(f"/waves/{wave['id']}/cancel", {})
+        assert response.status_code == 409, response.text
+
+
+    def test_planner_skips_unwaveable_tasks(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group, row=1)
+        consolidation_cell = create_cell(client, cells_group, row=2)
+
+        response = client.post(
+            f"/items/{item['id']}/variants",
+            {"name": str(uuid.uuid4()), "trackingMode": "quantity"},
+        )
+        assert response.status_code == 200, response.text
+        quantity_variant = response.json()["data"]
+        response = client.post(
+            "/stock-lines",
+            {"variantId": quantity_variant["id"], "cellId": cell["id"], "quantity": 5},
+        )
+        assert response.status_code == 201, response.text
+        line = response.json()["data"]
+
+        # Stock line tasks are more urgent but can't be picked in a wave
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick lines",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"stockLineId": line["id"], "quantity": 1}],
+                "priority": "urgent",
+            },
+        )
+        assert response.status_code == 200, response.text
+
+        instance = create_instance(client, item, variant, cell)
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick instance",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+                "priority": "low",
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+
+        response = client.post(
+            "/waves",
+            {
+                "unitId": organization_unit["id"],
+                "name": "Planned wave",
+                "consolidationCellId": consolidation_cell["id"],
+                "maxTasks": 1,
+            },
+        )
+        assert response.status_code == 200, response.text
+        assert [t["id"] for t in response.json()["data"]["tasks"]] == [task["id"]]
+
+class TestPickPath:
+    def test_items_follow_unit_strategy(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        assert organization_unit["pickPathStrategy"] == "serpentine"
+
+        cells = {
+            (row, position): create_cell(client, cells_group, row, position)
+            for row, position in ((2, 1), (1, 3), (2, 3), (1, 1))
+        }
+        instances = {
+            key: create_instance(client, item, variant, cell)
+            for key, cell in cells.items()
+        }
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": i["id"]} for i in instances.values()],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+
+        def route() -> list:
+            response = client.get(f"/tasks/{task['id']}")
+            assert response.status_code == 200, response.text
+            items = response.json()["data"]["items"]
+            assert [i["sequence"] for i in items] == [1, 2, 3, 4]
+            by_instance = {i["id"]: key for key, i in instances.items()}
+            return [by_instance[i["instance"]["id"]] for i in items]
+
+        # The second row is walked backwards
+        assert route() == [(1, 1), (1, 3), (2, 3), (2, 1)]
+
+        response = client.put(
+            f"/units/{organization_unit['id']}",
+            {
+                "name": organization_unit["name"],
+                "alias": organization_unit["alias"],
+                "pickPathStrategy": "sequential",
+            },
+        )
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["pickPathStrategy"] == "sequential"
+
+        assert route() == [(1, 1), (1, 3), (2, 1), (2, 3)]
+
+
+class TestTaskItemExceptions:
+    def test_short_pick_with_substitute(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        missing = create_instance(
+            client, item, variant, create_cell(client, cells_group)
+        )
+        damaged = create_instance(
+            client, item, variant, create_cell(client, cells_group, position=2)
+        )
+        spare = create_instance(
+            client, item, variant, create_cell(client, cells_group, row=2)
+        )
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [
+                    {"instanceId": missing["id"]},
+                    {"instanceId": damaged["id"]},
+                ],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+
+        response = client.get(f"/tasks/{task['id']}/items/{missing['id']}/substitutes")
+        assert response.status_code == 200, response.text
+        assert [i["id"] for i in response.json()["data"]] == [spare["id"]]
+
+        response = client.post(
+            f"/tasks/{task['id']}/exceptions",
+            {
+                "instanceId": missing["id"],
+                "reason": "not_found",
+                "autoSubstitute": True,
+            },
+        )
+        assert response.status_code == 200, response.text
+        task_data = response.json()["data"]
+        statuses = {i["instance"]["id"]: i["status"] for i in task_data["items"]}
+        assert statuses == {
+            missing["id"]: "short",
+            damaged["id"]: "pending",
+            spare["id"]: "pending",
+        }
+        assert task_data["exceptions"][0]["substituteInstanceId"] == spare["id"]
+
+        # A reported item can not be reported again
+        response = client.post(
+            f"/tasks/{task['id']}/exceptions",
+            {"instanceId": missing["id"], "reason": "not_found"},
+        )
+        assert response.status_code == 409, response.text
+
+        response = client.post(
+            f"/tasks/{task['id']}/exceptions",
+            {"instanceId": damaged["id"], "reason": "damaged", "note": "Torn"},
+        )
+        assert response.status_code == 200, response.text
+        assert len(response.json()["data"]["exceptions"]) == 2
+
+        response = client.get(f"/instances/{damaged['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["status"] == "quarantined"
+
+        response = client.get(f"/task-exceptions?unit_id={organization_unit['id']}")
+        assert response.status_code == 200, response.text
+        report = response.json()
+        assert len(report["data"]) == 2
+        assert report["countsByReason"] == {
+            "notFound": 1,
+            "damaged": 1,
+            "wrongItem": 0,
+        }
+
+
+class TestTaskTemplates:
+    def test_template_creates_movement_task(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        source = create_cell(client, cells_group)
+        target = create_cell(client, cells_group, position=2)
+        instances = [create_instance(client, item, variant, source) for _ in range(3)]
+
+        response = client.post(
+            "/task-templates",
+            {
+                "unitId": organization_unit["id"],
+                "name": "Restock packing cell",
+                "type": "movement",
+                "schedule": "0 6 * * 1",
+                "dueInMinutes": 60,
+                "rules": [
+                    {
+                        "variantId": variant["id"],
+                        "targetCellId": target["id"],
+                        "maxInstances": 2,
+                    }
+                ],
+            },
+        )
+        assert response.status_code == 200, response.text
+        template = response.json()["data"]
+        assert template["isActive"] is True
+        assert template["priority"] == "normal"
+        assert template["nextRunAt"] is not None
+        assert template["lastRunAt"] is None
+
+        response = client.post(f"/task-templates/{template['id']}/run", {})
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        assert task["type"] == "movement"
+        assert task["templateId"] == template["id"]
+        assert task["dueAt"] is not None
+        assert len(task["items"]) == 2
+        assert {i["instance"]["id"] for i in task["items"]} <= {
+            i["id"] for i in instances
+        }
+
+        # Instances taken by the open task are not selected again
+        response = client.post(f"/task-templates/{template['id']}/run", {})
+        assert response.status_code == 200, response.text
+        assert len(response.json()["data"]["items"]) == 1
+
+        response = client.post(f"/task-templates/{template['id']}/run", {})
+        assert response.status_code == 409, response.text
+
+        response = client.delete(f"/task-templates/{template['id']}")
+        assert response.status_code == 204, response.text
+
+        response = client.get(f"/task-templates/{template['id']}")
+        assert response.status_code == 404, response.text
+
+    def test_template_validation(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        target = create_cell(client, cells_group)
+        payload = {
+            "unitId": organization_unit["id"],
+            "name": "Weekly shift",
+            "type": "movement",
+            "schedule": "0 25 * * *",
+            "rules": [{"variantId": variant["id"], "targetCellId": target["id"]}],
+        }
+
+        response = client.post("/task-templates", payload)
+        assert response.status_code == 400, response.text
+
+        payload["schedule"] = "0 0 30 2 *"
+        response = client.post("/task-templates", payload)
+        assert response.status_code == 400, response.text
+
+        payload["schedule"] = None
+        payload["rules"] = [{"variantId": variant["id"]}]
+        response = client.post("/task-templates", payload)
+        assert response.status_code == 400, response.text
+
+        payload["rules"] = [{"variantId": variant["id"], "targetCellId": target["id"]}]
+        response = client.post("/task-templates", payload)
+        assert response.status_code == 200, response.text
+        template = response.json()["data"]
+        assert template["nextRunAt"] is None
+
+        payload["schedule"] = "*/5 * * * *"
+        payload["isActive"] = False
+        response = client.put(f"/task-templates/{template['id']}", payload)
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["schedule"] == "*/5 * * * *"
+        assert response.json()["data"]["isActive"] is False
+
+        response = client.get("/task-templates")
+        assert response.status_code == 200, response.text
+        assert template["id"] in [t["id"] for t in response.json()["data"]]
+
+
+def create_worker(client: APIClient) -> tuple[APIClient, str]:
+    user = {
+        "email": f"e2e.test.worker.{uuid.uuid4()}@example.com",
+        "firstName": "Worker",
+        "lastName": generate_random_string(),
+    }
+    worker = APIClient(API_BASE, authenticate(user))
+    response = worker.get("/me")
+    assert response.status_code == 200, response.text
+    user_id = response.json()["data"]["id"]
+
+    response = client.post("/employees/invite", {"email": user["email"], "roleId": 4})
+    assert response.status_code == 200, response.text
+    worker.set_organization(client.headers["X-Organization-Id"])
+    return worker, user_id
+
+
+
+class TestTaskAssignment:
+    def test_assign_reassign_unassign_and_claim(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        worker, worker_id = create_worker(client)
+        _, other_id = create_worker(client)
+        cell = create_cell(client, cells_group)
+        instance = create_instance(client, item, variant, cell)
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Assigned pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        url = f"/tasks/{response.json()['data']['id']}"
+
+        def assignee() -> str | None:
+            response = client.get(url)
+            assert response.status_code == 200, response.text
+            assigned = response.json()["data"]["assignedTo"]
+            return assigned["userId"] if assigned else None
+
+        assert client.post(f"{url}/unassign", {}).status_code == 409
+        response = client.post(f"{url}/reassign", {"userId": worker_id})
+        assert response.status_code == 409, response.text
+
+        response = client.post(f"{url}/assign", {"userId": worker_id})
+        assert response.status_code == 204, response.text
+        assert assignee() == worker_id
+        response = client.post(f"{url}/assign", {"userId": other_id})
+        assert response.status_code == 409, response.text
+
+        response = client.post(f"{url}/reassign", {"userId": other_id})
+        assert response.status_code == 204, response.text
+        assert assignee() == other_id
+
+        # Claiming a task assigned to someone else is a conflict
+        assert worker.post(f"{url}/claim", {}).status_code == 409
+        response = client.post(f"{url}/unassign", {})
+        assert response.status_code == 204, response.text
+        assert assignee() is None
+        assert worker.post(f"{url}/claim", {}).status_code == 204
+        assert worker.post(f"{url}/claim", {}).status_code == 204
+        assert assignee() == worker_id
+
+        response = client.post(f"{url}/unassign", {})
+        assert response.status_code == 204, response.text
+        response = client.post(f"{url}/cancel", {})
+        assert response.status_code == 201, response.text
+        response = client.post(f"{url}/assign", {"userId": worker_id})
+        assert response.status_code == 409, response.text
+        assert worker.post(f"{url}/claim", {}).status_code == 409
+        assert assignee() is None
+
+    def test_concurrent_assignments_succeed_once(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        workers = [create_worker(client) for _ in range(2)]
+        cell = create_cell(client, cells_group)
+        instance = create_instance(client, item, variant, cell)
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Contended pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        url = f"/tasks/{response.json()['data']['id']}"
+
+        barrier = threading.Barrier(2)
+
+        def assign(user_id: str) -> int:
+            barrier.wait()
+            return client.post(f"{url}/assign", {"userId": user_id}).status_code
+
+        with ThreadPoolExecutor(max_workers=2) as executor:
+            statuses = sorted(executor.map(assign, [uid for _, uid in workers]))
+        assert statuses == [204, 409]
+
+        response = client.post(f"{url}/unassign", {})
+        assert response.status_code == 204, response.text
+
+        def claim(worker: APIClient) -> int:
+            barrier.wait()
+            return worker.post(f"{url}/claim", {}).status_code
+
+        with ThreadPoolExecutor(max_workers=2) as executor:
+            statuses = sorted(executor.map(claim, [w for w, _ in workers]))
+        assert statuses == [204, 409]
+
+
+class TestTaskAutoAssignment:
+    def set_strategy(self, client: APIClient, strategy: str) -> None:
+        org_id = client.headers["X-Organization-Id"]
+        response = client.get(f"/orgs/{org_id}")
+        assert response.status_code == 200, response.text
+        name = response.json()["data"]["name"]
+        response = client.put(
+            f"/orgs/{org_id}", {"name": name, "taskAssignmentStrategy": strategy}
+        )
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["taskAssignmentStrategy"] == strategy
+
+    def test_strategies_and_shift_rebalance(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        target_cell = create_cell(client, cells_group, position=2)
+        unit_id = organization_unit["id"]
+        first, first_id = create_worker(client)
+        second, second_id = create_worker(client)
+
+        def assignee(task: dict) -> str | None:
+            return task["assignedTo"]["userId"] if task["assignedTo"] else None
+
+        def new_task(task_type: str = "pickment") -> dict:
+            instance = create_instance(client, item, variant, cell)
+            task_item = {"instanceId": instance["id"]}
+            if task_type == "movement":
+                task_item["targetCellId"] = target_cell["id"]
+            response = client.post(
+                "/tasks",
+                {
+                    "name": str(uuid.uuid4()),
+                    "type": task_type,
+                    "unitId": unit_id,
+                    "items": [task_item],
+                },
+            )
+            assert response.status_code == 200, response.text
+            return response.json()["data"]
+
+        response = client.get(f"/employees/{first_id}/work-profile")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["onShift"] is False
+
+        response = client.put(
+            f"/employees/{first_id}/work-profile",
+            {"unitIds": [unit_id], "skills": ["movement"], "onShift": True},
+        )
+        assert response.status_code == 200, response.text
+        response = client.put(
+            f"/employees/{second_id}/work-profile",
+            {"unitIds": [unit_id], "skills": ["pickment"], "onShift": False},
+        )
+        assert response.status_code == 200, response.text
+        response = second.post("/me/shift", {"onShift": True})
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["skills"] == ["pickment"]
+
+        try:
+            assert assignee(new_task()) is None
+
+            self.set_strategy(client, "round_robin")
+            round_robin = {assignee(new_task()), assignee(new_task())}
+            assert round_robin == {first_id, second_id}
+
+            self.set_strategy(client, "skills")
+            assert assignee(new_task("pickment")) == second_id
+            assert assignee(new_task("movement")) == first_id
+
+            # Both workers have 2 open tasks, so each gets one more
+            self.set_strategy(client, "least_open_tasks")
+            least_open = {assignee(new_task()), assignee(new_task())}
+            assert least_open == {first_id, second_id}
+
+            response = second.get("/me/tasks")
+            assert response.status_code == 200, response.text
+            assert len(response.json()["data"]) == 3
+
+            response = second.post("/me/shift", {"onShift": False})
+            assert response.status_code == 200, response.text
+            response = second.get("/me/tasks")
+            assert response.status_code == 200, response.text
+            assert response.json()["data"] == []
+            response = first.get("/me/tasks")
+            assert response.status_code == 200, response.text
+            assert len(response.json()["data"]) == 6
+
+            response = first.post("/me/shift", {"onShift": False})
+            assert response.status_code == 200, response.text
+            assert assignee(new_task()) is None
+            response = first.get("/me/tasks")
+            assert response.status_code == 200, response.text
+            assert response.json()["data"] == []
+        finally:
+            self.set_strategy(client, "manual")
+
+    def test_validation(
+        self, api_client_with_organization: APIClient, organization_unit: dict
+    ) -> None:
+        client = api_client_with_organization
+        _, worker_id = create_worker(client)
+        org_id = client.headers["X-Organization-Id"]
+
+        response = client.put(
+            f"/orgs/{org_id}", {"name": "org", "taskAssignmentStrategy": "random"}
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.put(
+            f"/employees/{worker_id}/work-profile",
+            {"unitIds": [str(uuid.uuid4())], "skills": [], "onShift": True},
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.put(
+            f"/employees/{worker_id}/work-profile",
+            {"unitIds": [organization_unit["id"]], "skills": ["fly"], "onShift": True},
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.get(f"/employees/{uuid.uuid4()}/work-profile")
+        assert response.status_code == 404, response.text
+
+
+class TestPickupCodes:
+    def ready_pickment(
+        self,
+        client: APIClient,
+        organization_unit: dict,
+        item: dict,
+        variant: dict,
+        cell: dict,
+    ) -> dict:
+        instance = create_instance(client, item, variant, cell)
+        response = client.post(
+            "/tasks",
+            {
+                "name": f"Pickup {uuid.uuid4()}",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+
+        response = client.post(
+            f"/tasks/{task['id']}/pick-instance", {"instanceId": instance["id"]}
+        )
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{task['id']}/ready", {})
+        assert response.status_code == 204, response.text
+        return task
+
+    def test_ready_pickment_requires_code(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        task = self.ready_pickment(client, organization_unit, item, variant, cell)
+
+        # The code is issued together with the ready transition
+        response = client.get(f"/tasks/{task['id']}/pickup-code")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["attemptsLeft"] == 5
+
+        # Workers completing the task get the code from the customer
+        worker, _ = create_worker(client)
+        response = worker.get(f"/tasks/{task['id']}/pickup-code")
+        assert response.status_code == 403, response.text
+
+        for body in ({}, {"pickupCode": ""}, {"pickupCode": "   "}):
+            response = client.post(f"/tasks/{task['id']}/completed", body)
+            assert response.status_code == 400, (body, response.text)
+
+        response = client.get(f"/tasks/{task['id']}")
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        assert task["status"] == "ready"
+        assert task["items"][0]["instance"]["status"] == "reserved"
+
+        # Missing codes are not counted as failed attempts
+        response = client.get(f"/tasks/{task['id']}/pickup-code")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["attemptsLeft"] == 5
+
+        complete_with_pickup_code(client, task["id"])
+
+    def test_complete_with_code_and_tv_board(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        task = self.ready_pickment(client, organization_unit, item, variant, cell)
+
+        response = client.get(f"/tasks/{task['id']}/pickup-code")
+        assert response.status_code == 200, response.text
+        code = response.json()["data"]
+        assert len(code["code"]) == 6 and code["code"].isdigit()
+        assert code["qrPayload"].endswith(f"{task['id']}:{code['code']}")
+        assert code["attemptsLeft"] == 5
+
+        response = client.post(
+            "/tv-boards", {"name": "Pickup", "unitId": organization_unit["id"]}
+        )
+        assert response.status_code == 200, response.text
+        token = response.json()["data"]["token"]
+        response = requests.get(f"{API_BASE}/tv-boards/{token}/data")
+        assert response.status_code == 200, response.text
+        shown = response.json()["data"]["pickupCodes"]
+        assert [(c["taskId"], c["code"]) for c in shown] == [
+            (task["id"], code["code"])
+        ]
+
+        response = client.post(f"/tasks/{task['id']}/completed", {})
+        assert response.status_code == 400, response.text
+        response = client.post(
+            f"/tasks/{task['id']}/completed", {"pickupCode": "not-a-code"}
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.get(f"/tasks/{task['id']}/pickup-code")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["attemptsLeft"] == 4
+
+        response = client.post(
+            f"/tasks/{task['id']}/completed", {"pickupCode": code["qrPayload"]}
+        )
+        assert response.status_code == 204, response.text
+
+        response = client.get(f"/tasks/{task['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["status"] == "completed"
+        response = client.get(f"/tasks/{task['id']}/pickup-code")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["usedAt"] is not None
+
+        response = requests.get(f"{API_BASE}/tv-boards/{token}/data")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["pickupCodes"] == []
+
+        response = client.get(
+            f"/audit-logs?object_type_id=14&object_id={task['id']}"
+        )
+        assert response.status_code == 200, response.text
+        reasons = {log.get("reason") for log in response.json()["data"]}
+        assert {"pickup_code_rejected", "pickup_code_accepted"} <= reasons
+
+    def test_attempt_limit_and_regenerate(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        task = self.ready_pickment(client, organization_unit, item, variant, cell)
+
+        response = client.get(f"/tasks/{task['id']}/pickup-code")
+        assert response.status_code == 200, response.text
+        old_code = response.json()["data"]["code"]
+        wrong = "000000" if old_code != "000000" else "111111"
+
+        for _ in range(5):
+            response = client.post(
+                f"/tasks/{task['id']}/completed", {"pickupCode": wrong}
+            )
+            assert response.status_code == 400, response.text
+        response = client.post(
+            f"/tasks/{task['id']}/completed", {"pickupCode": old_code}
+        )
+        assert response.status_code == 409, response.text
+
+        response = client.get(f"/tasks/{task['id']}/pickup-code")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["lockedAt"] is not None
+        assert response.json()["data"]["attemptsLeft"] == 0
+
+        response = client.post(f"/tasks/{task['id']}/pickup-code", {})
+        assert response.status_code == 200, response.text
+        regenerated = response.json()["data"]
+        assert regenerated["lockedAt"] is None
+        assert regenerated["attemptsLeft"] == 5
+
+        complete_with_pickup_code(client, task["id"])
+
+        response = client.post(f"/tasks/{task['id']}/pickup-code", {})
+        assert response.status_code == 409, response.text
+
+
+class TestInstanceSettlement:
+    def test_completed_tasks_release_instances(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        source_cell = create_cell(client, cells_group, row=1)
+        target_cell = create_cell(client, cells_group, row=2)
+        shipped = create_instance(client, item, variant, source_cell)
+        moved = create_instance(client, item, variant, source_cell)
+
+        def new_task(task_type: str, task_item: dict) -> dict:
+            response = client.post(
+                "/tasks",
+                {
+                    "name": f"Settlement {uuid.uuid4()}",
+                    "type": task_type,
+                    "unitId": organization_unit["id"],
+                    "items": [task_item],
+                },
+            )
+            assert response.status_code == 200, response.text
+            return response.json()["data"]
+
+        def get_instance(instance_id: str) -> dict:
+            response = client.get(f"/instances/{instance_id}")
+            assert response.status_code == 200, response.text
+            return response.json()["data"]
+
+        # Picked instances of a pickment task are shipped on completion
+        pickment = new_task("pickment", {"instanceId": shipped["id"]})
+        response = client.post(
+            f"/tasks/{pickment['id']}/pick-instance", {"instanceId": shipped["id"]}
+        )
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{pickment['id']}/ready", {})
+        assert response.status_code == 204, response.text
+        assert get_instance(shipped["id"])["status"] == "reserved"
+
+        complete_with_pickup_code(client, pickment["id"])
+        instance = get_instance(shipped["id"])
+        assert instance["status"] == "shipped"
+        assert instance["cell"] is None
+        assert instance["affectedByTaskId"] == pickment["id"]
+
+        response = client.get(f"/tasks/{pickment['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["items"][0]["status"] == "done"
+
+        # A shipped instance can not be picked again
+        again = new_task("pickment", {"instanceId": shipped["id"]})
+        response = client.post(
+            f"/tasks/{again['id']}/pick-instance", {"instanceId": shipped["id"]}
+        )
+        assert response.status_code == 409, response.text
+
+        # Movements end with instances available in the destination cell
+        movement = new_task(
+            "movement", {"instanceId": moved["id"], "targetCellId": target_cell["id"]}
+        )
+        response = client.post(
+            f"/tasks/{movement['id']}/pick-instance", {"instanceId": moved["id"]}
+        )
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{movement['id']}/ready", {})
+        assert response.status_code == 409, response.text
+
+        response = client.post(
+            f"/tasks/{movement['id']}/put-instance", {"instanceId": moved["id"]}
+        )
+        assert response.status_code == 201, response.text
+        response = client.post(f"/tasks/{movement['id']}/ready", {})
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{movement['id']}/completed", {})
+        assert response.status_code == 204, response.text
+
+        instance = get_instance(moved["id"])
+        assert instance["status"] == "available"
+        assert instance["cell"]["id"] == target_cell["id"]
+
+        # Picked movement items without a target cell can not become ready
+        stranded = new_task("movement", {"instanceId": moved["id"]})
+        response = client.post(
+            f"/tasks/{stranded['id']}/pick-instance", {"instanceId": moved["id"]}
+        )
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{stranded['id']}/ready", {})
+        assert response.status_code == 409, response.text
+
+        response = client.post(f"/tasks/{stranded['id']}/cancel", {})
+        assert response.status_code == 201, response.text
+        instance = get_instance(moved["id"])
+        assert instance["status"] == "available"
+        assert instance["cell"]["id"] == target_cell["id"]
+
+
+# Smallest content recognized as a PNG image
+PNG_CONTENT = b"\x89PNG\r\n\x1a\n" + b"\x00" * 32
+
+
+class TestTaskCommentsAndAttachments:
+    def new_task(
+        self,
+        client: APIClient,
+        organization_unit: dict,
+        item: dict,
+        variant: dict,
+        cells_group: dict,
+    ) -> tuple[dict, dict]:
+        cell = create_cell(client, cells_group)
+        instance = create_instance(client, item, variant, cell)
+        response = client.post(
+            "/tasks",
+            {
+                "name": f"Notes {uuid.uuid4()}",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": instance["id"]}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        return response.json()["data"], instance
+
+    def test_comments(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        task, instance = self.new_task(
+            client, organization_unit, item, variant, cells_group
+        )
+        worker, worker_id = create_worker(client)
+        comments_path = f"/tasks/{task['id']}/comments"
+
+        response = worker.post(comments_path, {"body": "Box damaged"})
+        assert response.status_code == 200, response.text
+        comment = response.json()["data"]
+        assert comment["body"] == "Box damaged"
+        assert comment["author"]["userId"] == worker_id
+        assert comment["instanceId"] is None
+        assert comment["editedAt"] is None
+
+        response = client.post(
+            comments_path, {"body": "Moved to overflow", "instanceId": instance["id"]}
+        )
+        assert response.status_code == 200, response.text
+        item_comment = response.json()["data"]
+        assert item_comment["instanceId"] == instance["id"]
+
+        response = client.post(
+            comments_path, {"body": "Wrong item", "instanceId": str(uuid.uuid4())}
+        )
+        assert response.status_code == 409, response.text
+        response = client.post(comments_path, {"body": "   "})
+        assert response.status_code == 400, response.text
+
+        response = client.get(comments_path)
+        assert response.status_code == 200, response.text
+        assert [c["id"] for c in response.json()["data"]] == [
+            comment["id"],
+            item_comment["id"],
+        ]
+        response = client.get(f"{comments_path}?instanceId={instance['id']}")
+        assert response.status_code == 200, response.text
+        assert [c["id"] for c in response.json()["data"]] == [item_comment["id"]]
+
+        # Only the author edits a comment, the previous text stays in the audit log
+        comment_path = f"{comments_path}/{comment['id']}"
+        response = client.put(comment_path, {"body": "Edited by someone else"})
+        assert response.status_code == 403, response.text
+        response = worker.put(comment_path, {"body": "Box damaged, taped"})
+        assert response.status_code == 200, response.text
+        edited = response.json()["data"]
+        assert edited["body"] == "Box damaged, taped"
+        assert edited["editedAt"] is not None
+
+        response = client.get(
+            f"/audit-logs?object_type_id=15&object_id={comment['id']}"
+        )
+        assert response.status_code == 200, response.text
+        bodies = {
+            (log["prechangeState"] or {}).get("body")
+            for log in response.json()["data"]
+        }
+        assert "Box damaged" in bodies
+
+        # The author and managers delete comments
+        response = worker.delete(f"{comments_path}/{item_comment['id']}")
+        assert response.status_code == 403, response.text
+        response = client.delete(comment_path)
+        assert response.status_code == 204, response.text
+        response = client.get(comments_path)
+        assert [c["id"] for c in response.json()["data"]] == [item_comment["id"]]
+
+    def test_attachments(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        task, instance = self.new_task(
+            client, organization_unit, item, variant, cells_group
+        )
+        attachments_path = f"/tasks/{task['id']}/attachments"
+
+        response = client.post(
+            f"/tasks/{task['id']}/comments", {"body": "See the photo"}
+        )
+        assert response.status_code == 200, response.text
+        comment = response.json()["data"]
+
+        response = client.upload(
+            attachments_path,
+            {"file": ("damage.png", PNG_CONTENT, "image/png")},
+            {"instanceId": instance["id"], "commentId": comment["id"]},
+        )
+        assert response.status_code == 200, response.text
+        attachment = response.json()["data"]
+        assert attachment["fileName"] == "damage.png"
+        assert attachment["contentType"] == "image/png"
+        assert attachment["size"] == len(PNG_CONTENT)
+        assert attachment["instanceId"] == instance["id"]
+        assert attachment["commentId"] == comment["id"]
+        assert attachment["uploadedBy"] is not None
+
+        # The type comes from the content, not from the client
+        response = client.upload(
+            attachments_path,
+            {"file": ("notes.png", b"just some text", "image/png")},
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.get(attachments_path)
+        assert response.status_code == 200, response.text
+        assert [a["id"] for a in response.json()["data"]] == [attachment["id"]]
+
+        attachment_path = f"{attachments_path}/{attachment['id']}"
+        response = client.get(attachment_path)
+        assert response.status_code == 200, response.text
+        assert response.content == PNG_CONTENT
+
+        worker, _ = create_worker(client)
+        response = worker.get(attachment_path)
+        assert response.status_code == 200, response.text
+        response = worker.delete(attachment_path)
+        assert response.status_code == 403, response.text
+
+        response = client.delete(attachment_path)
+        assert response.status_code == 204, response.text
+        response = client.get(attachment_path)
+        assert response.status_code == 404, response.text
+
+
+class TestTaskImport:
+    def test_csv_dry_run_and_errors(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        target = create_cell(client, cells_group, position=2)
+        first = create_instance(client, item, variant, cell)
+        second = create_instance(client, item, variant, cell)
+        create_instance(client, item, variant, cell)
+        unit = organization_unit["alias"]
+        name = f"Order {uuid.uuid4()}"
+
+        csv = "\n".join(
+            [
+                "name,type,unit,instance_id,article,quantity,target_cell",
+                f"{name},,{unit},{first['id']},,,{target['alias']}",
+                f"{name},,{unit},,{variant['article']},1,",
+                f"Other {name},movement,{unit},{second['id']},,,{target['alias']}",
+            ]
+        )
+        response = client.post_raw("/tasks/import?dry_run=true", csv, "text/csv")
+        assert response.status_code == 200, response.text
+        report = response.json()["data"]
+        assert report["dryRun"] is True
+        assert report["rowsCount"] == 3
+        assert report["errors"] == []
+        assert [t["name"] for t in report["tasks"]] == [name, f"Other {name}"]
+        order, other = report["tasks"]
+        assert order["taskId"] is None
+        assert order["type"] == "pickment"
+        assert order["lines"] == [2, 3]
+        assert len(order["instanceIds"]) == 2
+        assert order["instanceIds"][0] == first["id"]
+        # Listed instances are never picked for article rows
+        assert second["id"] not in order["instanceIds"]
+        assert other["instanceIds"] == [second["id"]]
+
+        response = client.get(f"/tasks?search={name}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"] == []
+
+        csv = "\n".join(
+            [
+                "name,unit,instance_id,article,quantity",
+                f"{name},unknown-{unit},{first['id']},,",
+                f"{name},{unit},not-an-id,,",
+                f"{name},{unit},{first['id']},,",
+                f"{name},{unit},{first['id']},,",
+                f"{name},{unit},,{variant['article']},100",
+                f"{name},{unit},,,",
+            ]
+        )
+        response = client.post_raw("/tasks/import", csv, "text/csv")
+        assert response.status_code == 200, response.text
+        report = response.json()["data"]
+        assert report["dryRun"] is False
+        assert report["tasks"] == []
+        assert [e["line"] for e in report["errors"]] == [2, 3, 5, 6, 7]
+
+        response = client.post_raw("/tasks/import", "name,shelf\n", "text/csv")
+        assert response.status_code == 400, response.text
+
+    def test_ndjson_creates_tasks(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        target = create_cell(client, cells_group, position=2)
+        instances = [create_instance(client, item, variant, cell) for _ in range(3)]
+        unit = organization_unit["alias"]
+        name = f"Move {uuid.uuid4()}"
+
+        rows = [
+            {
+                "name": name,
+                "type": "movement",
+                "unit": unit,
+                "instanceId": instance["id"],
+                "targetCell": target["alias"],
+            }
+            for instance in instances[:2]
+        ]
+        rows.append(
+            {"name": f"Pick {name}", "unit": unit, "instanceId": instances[2]["id"]}
+        )
+        ndjson = "\n".join(json.dumps(row) for row in rows)
+
+        response = client.post_raw("/tasks/import", ndjson, "application/x-ndjson")
+        assert response.status_code == 200, response.text
+        report = response.json()["data"]
+        assert report["errors"] == []
+        assert len(report["tasks"]) == 2
+        movement = report["tasks"][0]
+        assert movement["taskId"] is not None
+
+        response = client.get(f"/tasks/{movement['taskId']}")
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        assert task["type"] == "movement"
+        assert task["name"] == name
+        assert {i["instance"]["id"] for i in task["items"]} == {
+            instance["id"] for instance in instances[:2]
+        }
+
+        # Instances of the created tasks are not available anymore
+        response = client.post_raw("/tasks/import", ndjson, "application/x-ndjson")
+        assert response.status_code == 200, response.text
+        report = response.json()["data"]
+        assert report["tasks"] == []
+        assert [e["line"] for e in report["errors"]] == [1, 2, 3]
+
+        response = client.post_raw(
+            "/tasks/import", '{"name": "x", "shelf": 1}', "application/x-ndjson"
+        )
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["errors"][0]["line"] == 1
+
+
+class TestTransferOrders:
+    @pytest.fixture
+    def destination_cell(self, api_client_with_organization: APIClient) -> dict:
+        client = api_client_with_organization
+        response = client.post(
+            "/units",
+            {
+                "name": str(uuid.uuid4()),
+                "alias": generate_random_string(),
+                "address": generate_random_string(),
+            },
+        )
+        assert response.status_code == 200, response.text
+        unit = response.json()["data"]
+        response = client.post(
+            "/cells-groups",
+            {
+                "name": str(uuid.uuid4()),
+                "alias": generate_random_string(),
+                "unitId": unit["id"],
+            },
+        )
+        assert response.status_code == 200, response.text
+        cell = create_cell(client, response.json()["data"])
+        cell["unitId"] = unit["id"]
+        return cell
+
+    def create_order(
+        self,
+        client: APIClient,
+        source_unit: dict,
+        destination_cell: dict,
+        instances: list[dict],
+    ) -> dict:
+        response = client.post(
+            "/transfer-orders",
+            {
+                "name": f"Transfer {uuid.uuid4()}",
+                "sourceUnitId": source_unit["id"],
+                "destinationUnitId": destination_cell["unitId"],
+                "receivingCellId": destination_cell["id"],
+                "instanceIds": [i["id"] for i in instances],
+            },
+        )
+        assert response.status_code == 200, response.text
+        return response.json()["data"]
+
+    def test_transfer_between_units(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+        destination_cell: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        instances = [create_instance(client, item, variant, cell) for _ in range(2)]
+
+        order = self.create_order(
+            client, organization_unit, destination_cell, instances
+        )
+        assert order["status"] == "pending"
+        assert order["inboundTaskId"] is None
+        assert sorted(i["id"] for i in order["instances"]) == sorted(
+            i["id"] for i in instances
+        )
+        outbound_id = order["outboundTaskId"]
+
+        response = client.get(f"/tasks/{outbound_id}")
+        assert response.status_code == 200, response.text
+        outbound = response.json()["data"]
+        assert outbound["type"] == "pickment"
+        assert outbound["unitId"] == organization_unit["id"]
+        assert outbound["transferOrderId"] == order["id"]
+
+        for instance in instances:
+            response = client.post(
+                f"/tasks/{outbound_id}/pick-instance", {"instanceId": instance["id"]}
+            )
+            assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{outbound_id}/ready", {})
+        assert response.status_code == 204, response.text
+        complete_with_pickup_code(client, outbound_id)
+
+        response = client.get(f"/transfer-orders/{order['id']}")
+        assert response.status_code == 200, response.text
+        order = response.json()["data"]
+        assert order["status"] == "in_transit"
+        assert order["dispatchedAt"] is not None
+        assert all(i["status"] == "in_transit" for i in order["instances"])
+        assert all(i["cell"] is None for i in order["instances"])
+
+        # In transit stock stays visible in inventory
+        response = client.get(f"/items/{item['id']}/instances")
+        assert response.status_code == 200, response.text
+        statuses = {i["id"]: i["status"] for i in response.json()["data"]}
+        assert all(statuses[i["id"]] == "in_transit" for i in instances)
+
+        inbound_id = order["inboundTaskId"]
+        response = client.get(f"/tasks/{inbound_id}")
+        assert response.status_code == 200, response.text
+        inbound = response.json()["data"]
+        assert inbound["type"] == "receiving"
+        assert inbound["unitId"] == destination_cell["unitId"]
+        assert inbound["expectedItems"][0]["expectedQuantity"] == 2
+
+        # Both legs are audited once their transactions are committed
+        for task_id in (outbound_id, inbound_id):
+            response = client.get(f"/audit-logs?object_type_id=9&object_id={task_id}")
+            assert response.status_code == 200, response.text
+            assert "create" in [log["action"] for log in response.json()["data"]]
+
+        response = client.post(
+            f"/tasks/{inbound_id}/receive", {"variantId": variant["id"], "quantity": 3}
+        )
+        assert response.status_code == 409, response.text
+
+        response = client.post(
+            f"/tasks/{inbound_id}/receive", {"variantId": variant["id"], "quantity": 2}
+        )
+        assert response.status_code == 201, response.text
+        received = response.json()["data"]
+        assert sorted(i["id"] for i in received) == sorted(i["id"] for i in instances)
+        assert all(i["cell"]["id"] == destination_cell["id"] for i in received)
+        assert all(i["status"] == "available" for i in received)
+
+        response = client.post(f"/tasks/{inbound_id}/ready", {})
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{inbound_id}/completed", {})
+        assert response.status_code == 204, response.text
+
+        response = client.get(f"/transfer-orders/{order['id']}")
+        assert response.status_code == 200, response.text
+        order = response.json()["data"]
+        assert order["status"] == "received"
+        assert order["receivedAt"] is not None
+
+        response = client.get("/transfer-orders?status=received")
+        assert response.status_code == 200, response.text
+        assert order["id"] in [o["id"] for o in response.json()["data"]]
+
+    def test_cancel_pending_transfer(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+        destination_cell: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        instance = create_instance(client, item, variant, cell)
+        order = self.create_order(
+            client, organization_unit, destination_cell, [instance]
+        )
+
+        response = client.post(f"/transfer-orders/{order['id']}/cancel", {})
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["status"] == "cancelled"
+
+        response = client.get(f"/tasks/{order['outboundTaskId']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["status"] == "cancelled"
+
+        response = client.post(f"/transfer-orders/{order['id']}/cancel", {})
+        assert response.status_code == 409, response.text
+
+    def test_cells_must_belong_to_units(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+        destination_cell: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        instance = create_instance(client, item, variant, cell)
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Move across units",
+                "type": "movement",
+                "unitId": organization_unit["id"],
+                "items": [
+                    {
+                        "instanceId": instance["id"],
+                        "targetCellId": destination_cell["id"],
+                    }
+                ],
+            },
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick in other unit",
+                "type": "pickment",
+                "unitId": destination_cell["unitId"],
+                "items": [{"instanceId": instance["id"]}],
+            },
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.post(
+            "/transfer-orders",
+            {
+                "name": "Same unit",
+                "sourceUnitId": organization_unit["id"],
+                "destinationUnitId": organization_unit["id"],
+                "receivingCellId": cell["id"],
+                "instanceIds": [instance["id"]],
+            },
+        )
+        assert response.status_code == 400, response.text
+
+
+class TestStockLevels:
+    def get_stock(self, client: APIClient, **params: str) -> list[dict]:
+        query = "&".join(f"{key}={value}" for key, value in params.items())
+        response = client.get(f"/stock?{query}")
+        assert response.status_code == 200, response.text
+        return response.json()["data"]
+
+    def test_rollup_through_hierarchy(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        first_cell = create_cell(client, cells_group, row=1)
+        second_cell = create_cell(client, cells_group, row=2)
+        create_instance(client, item, variant, first_cell)
+        create_instance(client, item, variant, first_cell)
+        picked = create_instance(client, item, variant, second_cell)
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Pick",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": picked["id"]}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        response = client.post(
+            f"/tasks/{task['id']}/pick-instance", {"instanceId": picked["id"]}
+        )
+        assert response.status_code == 204, response.text
+
+        levels = self.get_stock(client, group_by="cell", variant_id=variant["id"])
+        by_cell = {level["location"]["id"]: level for level in levels}
+        assert by_cell[first_cell["id"]]["available"] == 2
+        # Picked instances leave their cell
+        assert second_cell["id"] not in by_cell
+
+        levels = self.get_stock(
+            client, group_by="cells_group", variant_id=variant["id"]
+        )
+        assert len(levels) == 1
+        assert levels[0]["location"]["id"] == cells_group["id"]
+        assert levels[0]["location"]["objectType"] == "cells_group"
+        assert levels[0]["available"] == 2
+
+        levels = self.get_stock(
+            client, unit_id=organization_unit["id"], item_id=item["id"]
+        )
+        assert len(levels) == 1
+        level = levels[0]
+        assert level["location"]["id"] == organization_unit["id"]
+        assert level["variant"]["id"] == variant["id"]
+        assert level["available"] == 2
+        assert level["reserved"] == 1
+        assert level["total"] == 3
+
+        levels = self.get_stock(client, variant_id=variant["id"], status="reserved")
+        assert len(levels) == 1
+        assert levels[0]["available"] == 0
+        assert levels[0]["reserved"] == 1
+
+    def test_validation(self, api_client_with_organization: APIClient) -> None:
+        client = api_client_with_organization
+        response = client.get("/stock?group_by=shelf")
+        assert response.status_code == 400, response.text
+        response = client.get("/stock?status=disposed")
+        assert response.status_code == 400, response.text
+
+
+class TestStockLines:
+    @pytest.fixture
+    def quantity_variant(
+        self, api_client_with_organization: APIClient, item: dict
+    ) -> dict:
+        response = api_client_with_organization.post(
+            f"/items/{item['id']}/variants",
+            {"name": str(uuid.uuid4()), "trackingMode": "quantity"},
+        )
+        assert response.status_code == 200, response.text
+        variant = response.json()["data"]
+        assert variant["trackingMode"] == "quantity"
+        return variant
+
+    def create_line(
+        self, client: APIClient, variant: dict, cell: dict, quantity: int
+    ) -> dict:
+        response = client.post(
+            "/stock-lines",
+            {"variantId": variant["id"], "cellId": cell["id"], "quantity": quantity},
+        )
+        assert response.status_code == 201, response.text
+        return response.json()["data"]
+
+    def test_split_and_merge(
+        self,
+        api_client_with_organization: APIClient,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+        quantity_variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        line = self.create_line(client, quantity_variant, cell, 10)
+        assert line["status"] == "available"
+        assert line["item"]["id"] == item["id"]
+
+        # Stock of a variant is kept either as instances or as lines
+        response = client.post(
+            "/stock-lines",
+            {"variantId": variant["id"], "cellId": cell["id"], "quantity": 1},
+        )
+        assert response.status_code == 400, response.text
+        response = client.post(
+            f"/items/{item['id']}/instances",
+            {"variantId": quantity_variant["id"], "cellId": cell["id"]},
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.post(f"/stock-lines/{line['id']}/split", {"quantity": 10})
+        assert response.status_code == 400, response.text
+        response = client.post(f"/stock-lines/{line['id']}/split", {"quantity": 4})
+        assert response.status_code == 201, response.text
+        split = response.json()["data"]
+        assert split["quantity"] == 4
+        assert split["cell"]["id"] == cell["id"]
+
+        response = client.get(f"/stock-lines/{line['id']}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["quantity"] == 6
+
+        response = client.get(f"/stock-lines?variant_id={quantity_variant['id']}")
+        assert response.status_code == 200, response.text
+        assert len(response.json()["data"]) == 2
+
+        response = client.post(
+            "/stock-lines/merge", {"stockLineIds": [line["id"], split["id"]]}
+        )
+        assert response.status_code == 200, response.text
+        merged = response.json()["data"]
+        assert merged["id"] == line["id"]
+        assert merged["quantity"] == 10
+        response = client.get(f"/stock-lines/{split['id']}")
+        assert response.status_code == 404, response.text
+
+        # Lines in other cells are not merged
+        other = self.create_line(
+            client, quantity_variant, create_cell(client, cells_group, row=2), 1
+        )
+        response = client.post(
+            "/stock-lines/merge", {"stockLineIds": [line["id"], other["id"]]}
+        )
+        assert response.status_code == 409, response.text
+
+        # Tracking mode can not change while the variant has stock
+        response = client.put(
+            f"/items/{item['id']}/variants/{quantity_variant['id']}",
+            {"name": quantity_variant["name"], "trackingMode": "serialized"},
+        )
+        assert response.status_code == 409, response.text
+
+    def test_movement_of_partial_quantity(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        quantity_variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        source_cell = create_cell(client, cells_group, row=1)
+        target_cell = create_cell(client, cells_group, row=2)
+        line = self.create_line(client, quantity_variant, source_cell, 10)
+
+        def new_task(task_type: str, task_item: dict) -> dict:
+            response = client.post(
+                "/tasks",
+                {
+                    "name": f"Stock line {uuid.uuid4()}",
+                    "type": task_type,
+                    "unitId": organization_unit["id"],
+                    "items": [task_item],
+                },
+            )
+            return response
+
+        response = new_task("movement", {"stockLineId": line["id"], "quantity": 11})
+        assert response.status_code == 409, response.text
+        response = new_task(
+            "movement",
+            {"stockLineId": line["id"], "instanceId": str(uuid.uuid4())},
+        )
+        assert response.status_code == 400, response.text
+
+        response = new_task(
+            "movement",
+            {
+                "stockLineId": line["id"],
+                "quantity": 3,
+                "targetCellId": target_cell["id"],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+        assert task["items"][0]["quantity"] == 3
+        assert task["items"][0]["stockLine"]["id"] == line["id"]
+
+        response = client.post(
+            f"/tasks/{task['id']}/pick-stock-line", {"stockLineId": line["id"]}
+        )
+        assert response.status_code == 204, response.text
+
+        response = client.get(f"/tasks/{task['id']}")
+        assert response.status_code == 200, response.text
+        picked = response.json()["data"]["items"][0]
+        assert picked["status"] == "picked"
+        # The task item follows the reserved part of the line
+        reserved = picked["stockLine"]
+        assert reserved["id"] != line["id"]
+        assert reserved["quantity"] == 3
+        assert reserved["status"] == "reserved"
+        response = client.get(f"/stock-lines/{line['id']}")
+        assert response.json()["data"]["quantity"] == 7
+
+        response = client.post(
+            f"/tasks/{task['id']}/put-stock-line", {"stockLineId": reserved["id"]}
+        )
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{task['id']}/ready", {})
+        assert response.status_code == 204, response.text
+        response = client.post(f"/tasks/{task['id']}/completed", {})
+        assert response.status_code == 204, response.text
+
+        response = client.get(f"/stock-lines/{reserved['id']}")
+        moved = response.json()["data"]
+        assert moved["status"] == "available"
+        assert moved["cell"]["id"] == target_cell["id"]
+
+        response = client.get(
+            f"/stock?group_by=cell&variant_id={quantity_variant['id']}"
+        )
+        assert response.status_code == 200, response.text
+        by_cell = {
+            level["location"]["id"]: level["available"]
+            for level in response.json()["data"]
+        }
+        assert by_cell == {source_cell["id"]: 7, target_cell["id"]: 3}
+
+
+class TestLotsAndExpiry:
+    def create_lot_instance(
+        self,
+        client: APIClient,
+        item: dict,
+        variant: dict,
+        cell: dict,
+        expires_in_days: int | None,
+    ) -> dict:
+        payload = {"variantId": variant["id"], "cellId": cell["id"]}
+        if expires_in_days is not None:
+            expires_at = datetime.date.today() + datetime.timedelta(expires_in_days)
+            payload["lotNumber"] = f"L-{expires_in_days}"
+            payload["expiresAt"] = expires_at.isoformat()
+        response = client.post(f"/items/{item['id']}/instances", payload)
+        assert response.status_code == 200, response.text
+        return response.json()["data"]
+
+    def test_fefo_allocation_and_expiry(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        org_id = client.headers["X-Organization-Id"]
+        response = client.get(f"/orgs/{org_id}")
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["allocationPolicy"] == "fifo"
+
+        response = client.put(
+            f"/items/{item['id']}/variants/{variant['id']}",
+            {"name": variant["name"], "allocationPolicy": "fefo"},
+        )
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["allocationPolicy"] == "fefo"
+
+        source = create_cell(client, cells_group)
+        target = create_cell(client, cells_group, position=2)
+        late = self.create_lot_instance(client, item, variant, source, 60)
+        assert late["lotNumber"] == "L-60"
+        soon = self.create_lot_instance(client, item, variant, source, 5)
+        expired = self.create_lot_instance(client, item, variant, source, -1)
+
+        response = client.post(
+            f"/items/{item['id']}/instances",
+            {
+                "variantId": variant["id"],
+                "cellId": source["id"],
+                "manufacturedAt": "2030-01-02",
+                "expiresAt": "2030-01-01",
+            },
+        )
+        assert response.status_code == 400, response.text
+
+        # The instance expiring first is allocated first, expired ones never
+        response = client.post(
+            "/task-templates",
+            {
+                "unitId": organization_unit["id"],
+                "name": "Rotate stock",
+                "type": "movement",
+                "rules": [
+                    {
+                        "variantId": variant["id"],
+                        "targetCellId": target["id"],
+                        "maxInstances": 3,
+                    }
+                ],
+            },
+        )
+        assert response.status_code == 200, response.text
+        template = response.json()["data"]
+        response = client.post(f"/task-templates/{template['id']}/run", {})
+        assert response.status_code == 200, response.text
+        items = response.json()["data"]["items"]
+        assert [i["instance"]["id"] for i in items][:2] == [soon["id"], late["id"]]
+        assert expired["id"] not in [i["instance"]["id"] for i in items]
+
+        response = client.post(
+            "/tasks",
+            {
+                "name": f"Pickment {uuid.uuid4()}",
+                "type": "pickment",
+                "unitId": organization_unit["id"],
+                "items": [{"instanceId": expired["id"]}],
+            },
+        )
+        assert response.status_code == 409, response.text
+
+        response = client.get(
+            f"/stock/expiring?days=10&unit_id={organization_unit['id']}"
+        )
+        assert response.status_code == 200, response.text
+        ids = [i["id"] for i in response.json()["data"]]
+        assert ids == [expired["id"], soon["id"]]
+
+        response = client.get("/stock/expiring?days=-1")
+        assert response.status_code == 400, response.text
+
+
+class TestSerialNumbers:
+    @pytest.fixture
+    def serial_variant(
+        self, api_client_with_organization: APIClient, item: dict
+    ) -> dict:
+        response = api_client_with_organization.post(
+            f"/items/{item['id']}/variants",
+            {"name": str(uuid.uuid4()), "requiresSerial": True},
+        )
+        assert response.status_code == 200, response.text
+        assert response.json()["data"]["requiresSerial"] is True
+        return response.json()["data"]
+
+    def test_serial_uniqueness_lookup_and_history(
+        self,
+        api_client_with_organization: APIClient,
+        cells_group: dict,
+        item: dict,
+        variant: dict,
+        serial_variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        cell = create_cell(client, cells_group)
+        serial = f"SN-{uuid.uuid4()}"
+
+        payload = {"variantId": serial_variant["id"], "cellId": cell["id"]}
+        response = client.post(f"/items/{item['id']}/instances", payload)
+        assert response.status_code == 400, response.text
+
+        payload["serialNumber"] = serial
+        response = client.post(f"/items/{item['id']}/instances", payload)
+        assert response.status_code == 200, response.text
+        instance = response.json()["data"]
+        assert instance["serialNumber"] == serial
+
+        response = client.post(f"/items/{item['id']}/instances", payload)
+        assert response.status_code == 409, response.text
+
+        # Serial numbers are unique per variant only
+        response = client.post(
+            f"/items/{item['id']}/instances",
+            {"variantId": variant["id"], "cellId": cell["id"], "serialNumber": serial},
+        )
+        assert response.status_code == 200, response.text
+        other = response.json()["data"]
+
+        response = client.get(f"/instances?serial_number={serial}")
+        assert response.status_code == 200, response.text
+        ids = {i["id"] for i in response.json()["data"]}
+        assert ids == {instance["id"], other["id"]}
+
+        response = client.get(f"/instances?search={serial[3:15].lower()}")
+        assert response.status_code == 200, response.text
+        assert instance["id"] in [i["id"] for i in response.json()["data"]]
+
+        response = client.delete(f"/instances/{instance['id']}")
+        assert response.status_code == 200, response.text
+
+        # The history outlives the instance
+        response = client.get(f"/audit-logs?serial_number={serial}")
+        assert response.status_code == 200, response.text
+        logs = response.json()["data"]
+        actions = [
+            log["action"] for log in logs if log["targetObjectId"] == instance["id"]
+        ]
+        assert actions == ["create", "delete"]
+
+    def test_receiving_with_serial_numbers(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        serial_variant: dict,
+    ) -> None:
+        client = api_client_with_organization
+        receiving_cell = create_cell(client, cells_group)
+        response = client.post(
+            "/tasks",
+            {
+                "name": "Inbound electronics",
+                "type": "receiving",
+                "unitId": organization_unit["id"],
+                "receivingCellId": receiving_cell["id"],
+                "expectedItems": [{"variantId": serial_variant["id"], "quantity": 2}],
+            },
+        )
+        assert response.status_code == 200, response.text
+        task = response.json()["data"]
+
+        url = f"/tasks/{task['id']}/receive"
+        response = client.post(url, {"variantId": serial_variant["id"]})
+        assert response.status_code == 400, response.text
+
+        serials = [f"SN-{uuid.uuid4()}", f"SN-{uuid.uuid4()}"]
+        response = client.post(
+            url,
+            {"variantId": serial_variant["id"], "serialNumbers": serials[:1] * 2},
+        )
+        assert response.status_code == 400, response.text
+
+        response = client.post(
+            url, {"variantId": serial_variant["id"], "serialNumbers": serials}
+        )
+        assert response.status_code == 201, response.text
+        received = response.json()["data"]
+        assert sorted(i["serialNumber"] for i in received) == sorted(serials)
+
+
+def ean13_with_check_digit(digits: str) -> str:
+    total = sum(int(d) * (3 if i % 2 else 1) for i, d in enumerate(digits))
+    return digits + str((10 - total % 10) % 10)
+
+
+class TestBarcodeResolution:
+    def resolve(self, client: APIClient, code: str) -> dict:
+        response = client.get(f"/barcodes/resolve?code={urllib.parse.quote(code)}")
+        assert response.status_code == 200, response.text
+        return response.json()["data"]
+
+    def test_resolves_each_object_type(
+        self,
+        api_client_with_organization: APIClient,
+        organization_unit: dict,
+        cells_group: dict,
+        item: dict,
+    ) -> None:
+        client = api_client_with_organization
+        ean13 = ean13_with_check_digit(str(random.randint(10**11, 10**12 - 1)))
+        article = f"ART-{uuid.uuid4()}"
+        response = client.post(
+            f"/items/{item['id']}/variants",
+            {"name": "Scanned", "article": article, "ean13": int(ean13)},
+        )
+        assert response.status_code == 200, response.text
+        variant = response.json()["data"]
+        cell = create_cell(client, cells_group)
+        instance = create_instance(client, item, variant, cell)
+
+        data = self.resolve(client, ean13)
+        assert data["gs1"] is None
+        [match] = data["matches"]
+        assert (match["objectType"], match["matchedBy"]) == ("variant", "ean13")
+        assert match["variant"]["id"] == variant["id"]
+        [level] = match["stock"]
+        assert level["location"]["id"] == organization_unit["id"]
+        assert level["available"] == 1
+
+        [match] = self.resolve(client, article)["matches"]
+        assert (match["objectType"], match["matchedBy"]) == ("variant", "article")
+
+        [match] = self.resolve(client, f" {cell['alias']} ")["matches"]
+        assert (match["objectType"], match["matchedBy"]) == ("cell", "alias")
+        assert match["cell"]["id"] == cell["id"]
+        assert cells_group["id"] in [p["id"] for p in match["cell"]["cellPath"]]
+
+        [match] = self.resolve(client, instance["id"])["matches"]
+        assert (match["objectType"], match["matchedBy"]) == ("instance", "id")
+        assert match["instance"]["cell"]["id"] == cell["id"]
+
+        response = client.post(
+            "/tv-boards", {"name": "Dock", "unitId": organization_unit["id"]}
+        )
+        assert response.status_code == 200, response.text
+        token = response.json()["data"]["token"]
+        [match] = self.resolve(client, token)["matches"]
+        assert (match["objectType"], match["matchedBy"]) == ("tv-board", "token")
+
+        assert self.resolve(client, f"NONE-{uuid.uuid4()}")["matches"] == []
+        response = client.get("/barcodes/resolve?code=%20")
+        assert response.status_code == 400, response.text
+
+    def test_gs1_lot_expiry_and_serial(
+        self,
+        api_client_with_organization: APIClient,
+        cells_group: dict,
+        item: dict,
+    ) -> None:
+        client = api_client_with_organization
+        ean13 = ean13_with_check_digit(str(random.randint(10**11, 10**12 - 1)))
+        response = client.post(
+            f"/items/{item['id']}/variants", {"name": "GS1", "ean13": int(ean13)}
+        )
+        assert response.status_code == 200, response.text
+        variant = response.json()["data"]
+        cell = create_cell(client, cells_group)
+        serial = f"S{random.randint(10**8, 10**9 - 1)}"
+        response = client.post(
+            f"/items/{item['id']}/instances",
+            {"variantId": variant["id"], "cellId": cell["id"], "serialNumber": serial},
+        )
+        assert response.status_code == 200, response.text
+        instance = response.json()["data"]
+
+        data = self.resolve(client, f"(01)0{ean13}(17)301200(10)LOT-7")
+        assert data["gs1"]["gtin"] == f"0{ean13}"
+        assert data["gs1"]["lotNumber"] == "LOT-7"
+        assert data["gs1"]["expiresAt"] == "2030-12-31"
+        assert [m["variant"]["id"] for m in data["matches"]] == [variant["id"]]
+
+        # Raw form with the group separator sent for FNC1
+        data = self.resolve(client, f"]C1010{ean13}10LOT-7\x1d21{serial}")
+        assert data["gs1"]["serialNumber"] == serial
+        kinds = {(m["objectType"], m["matchedBy"]) for m in data["matches"]}
+        assert kinds == {("variant", "ean13"), ("instance", "serial_number")}
+        [found] = [m["instance"] for m in data["matches"] if "instance" in m]
+        assert found["id"] == instance["id"]
+
+        for code in ["(99)X", f"(01)0{ean13}(17)301332"]:
+            response = client.get(f"/barcodes/resolve?code={urllib.parse.quote(code)}")
+            assert response.status_code == 400, response.text
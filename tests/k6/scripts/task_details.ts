@@ -0,0 +1,158 @@
+// Measures task detail and task list latency on large pick lists.
+// Run against builds before and after a change to compare p(95) of
+// task_details_duration and task_list_duration.
+
+// @ts-ignore
+import { check } from 'k6';
+// @ts-ignore
+import http from 'k6/http';
+// @ts-ignore
+import { Trend } from 'k6/metrics';
+// @ts-ignore
+import { randomString } from 'https://jslib.k6.io/k6-utils/1.2.0/index.js';
+
+declare global {
+  const __ENV: {
+    BASE_URL?: string;
+    PICK_LIST_SIZE?: string;
+    TASKS_COUNT?: string;
+    VUS?: string;
+    DURATION?: string;
+  };
+}
+
+const BASE_URL = __ENV.BASE_URL || 'http://host.docker.internal:8080';
+const PICK_LIST_SIZE = parseInt(__ENV.PICK_LIST_SIZE || '200');
+const TASKS_COUNT = parseInt(__ENV.TASKS_COUNT || '50');
+const VUS = parseInt(__ENV.VUS || '5');
+const DURATION = __ENV.DURATION || '1m';
+
+const taskDetailsDuration = new Trend('task_details_duration', true);
+const taskListDuration = new Trend('task_list_duration', true);
+
+export const options = {
+  setupTimeout: '10m',
+  scenarios: {
+    task_details: {
+      executor: 'constant-vus',
+      vus: VUS,
+      duration: DURATION,
+    },
+  },
+  thresholds: {
+    task_details_duration: ['p(95)<500'],
+    task_list_duration: ['p(95)<300'],
+    http_req_failed: ['rate<0.01'],
+  },
+};
+
+function post(url: string, body: any, headers: any) {
+  const res = http.post(url, JSON.stringify(body), { headers });
+  if (res.status >= 400) {
+    throw new Error(`Request failed: POST ${url} - Status: ${res.status}, Body: ${res.body}`);
+  }
+  return JSON.parse(res.body).data;
+}
+
+function authenticate(): string {
+  const res = http.post(`${BASE_URL}/auth/test`, JSON.stringify({
+    email: 'task.details.bench@example.com',
+    firstName: 'Bench',
+    lastName: 'User',
+  }), {
+    headers: { 'Content-Type': 'application/json' },
+  });
+
+  const session = res.headers['Set-Cookie'].toString().match(/storeit_session=([^;]+)/);
+  if (!session) {
+    throw new Error('Session cookie not found in response');
+  }
+  return session[1];
+}
+
+export function setup() {
+  const session = authenticate();
+  const baseHeaders = {
+    'Content-Type': 'application/json',
+    Cookie: `storeit_session=${session}`,
+  };
+
+  const orgName = `BenchOrg${randomString(8)}`;
+  const org = post(`${BASE_URL}/orgs`, { name: orgName, subdomain: orgName.toLowerCase() }, baseHeaders);
+  const headers = { ...baseHeaders, 'x-organization-id': org.id };
+
+  const unit = post(`${BASE_URL}/units`, {
+    name: `Warehouse${randomString(6)}`,
+    alias: `WH${randomString(3)}`,
+  }, headers);
+  const storageGroup = post(`${BASE_URL}/storage-groups`, {
+    name: `Storage${randomString(6)}`,
+    alias: `ST${randomString(3)}`,
+    unitId: unit.id,
+  }, headers);
+  const cellsGroup = post(`${BASE_URL}/cells-groups`, {
+    name: `CellGroup${randomString(6)}`,
+    alias: `CG${randomString(3)}`,
+    unitId: unit.id,
+    storageGroupId: storageGroup.id,
+  }, headers);
+
+  const cells: any[] = [];
+  for (let i = 0; i < 20; i++) {
+    cells.push(post(`${BASE_URL}/cells-groups/${cellsGroup.id}/cells`, {
+      alias: `C${i}`,
+      row: Math.floor(i / 10) + 1,
+      level: 1,
+      position: (i % 10) + 1,
+    }, headers));
+  }
+
+  const item = post(`${BASE_URL}/items`, { name: `Item${randomString(6)}` }, headers);
+  const variant = post(`${BASE_URL}/items/${item.id}/variants`, {
+    name: `Variant${randomString(6)}`,
+    article: randomString(12, '0123456789'),
+  }, headers);
+
+  const instanceIds: string[] = [];
+  for (let i = 0; i < PICK_LIST_SIZE; i++) {
+    const instance = post(`${BASE_URL}/items/${item.id}/instances`, {
+      variantId: variant.id,
+      cellId: cells[i % cells.length].id,
+    }, headers);
+    instanceIds.push(instance.id);
+  }
+
+  const task = post(`${BASE_URL}/tasks`, {
+    name: `PickList${randomString(6)}`,
+    type: 'pickment',
+    unitId: unit.id,
+    items: instanceIds.map((id) => ({ instanceId: id })),
+  }, headers);
+
+  // Tasks for the list endpoint, one instance each
+  for (let i = 1; i < TASKS_COUNT; i++) {
+    post(`${BASE_URL}/tasks`, {
+      name: `Task${i}`,
+      type: 'movement',
+      unitId: unit.id,
+      items: [{ instanceId: instanceIds[i % instanceIds.length], targetCellId: cells[0].id }],
+    }, headers);
+  }
+
+  return { headers, taskId: task.id };
+}
+
+export default function (data: { headers: any; taskId: string }) {
+  const details = http.get(`${BASE_URL}/tasks/${data.taskId}`, { headers: data.headers });
+  check(details, {
+    'task details status is 200': (r: any) => r.status === 200,
+    'task details has all items': (r: any) => JSON.parse(r.body).data.items.length === PICK_LIST_SIZE,
+  });
+  taskDetailsDuration.add(details.timings.duration);
+
+  const list = http.get(`${BASE_URL}/tasks?limit=50`, { headers: data.headers });
+  check(list, {
+    'task list status is 200': (r: any) => r.status === 200,
+  });
+  taskListDuration.add(list.timings.duration);
+}